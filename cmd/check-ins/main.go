@@ -0,0 +1,157 @@
+// Command check-ins inspects and corrects an event's gate check-ins. Gate
+// staff have no RPC for these operations, so operators run them on request.
+//
+// Usage:
+//
+//	go run ./cmd/check-ins status -event-id <uuid>
+//	go run ./cmd/check-ins count  -event-id <uuid>
+//	go run ./cmd/check-ins export -event-id <uuid>
+//	go run ./cmd/check-ins revoke -event-id <uuid> -nullifier <hex>
+//
+// status prints the check-in count against the tickets issued, count prints
+// the check-in count alone, export lists every recorded nullifier hash, and
+// revoke removes one so a wrongly scanned pass can be verified again. Results
+// are printed as JSON. Database settings come from the same environment
+// variables as the jobs.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	infratelemetry "github.com/liverty-music/backend/internal/infrastructure/telemetry"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/pannpers/go-logging/logging"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "check-ins:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return errors.New("a command is required: status, count, export or revoke")
+	}
+	command := args[0]
+
+	fs := flag.NewFlagSet("check-ins "+command, flag.ContinueOnError)
+	eventID := fs.String("event-id", "", "ID of the event whose check-ins are inspected")
+	nullifier := fs.String("nullifier", "", "hex nullifier hash of the check-in to revoke (revoke only)")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *eventID == "" {
+		return errors.New("-event-id is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load[config.JobConfig]()
+	if err != nil {
+		return err
+	}
+
+	logger, err := logging.New()
+	if err != nil {
+		return err
+	}
+
+	db, err := rdb.New(ctx, cfg.Database, cfg.IsLocal(), logger)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	entryUC := usecase.NewEntryUseCase(
+		nil, // verifier: not used by check-in operations
+		rdb.NewNullifierRepository(db),
+		nil, // merkleTree: not used by check-in operations
+		nil, // merkleBuilder: not used by check-in operations
+		nil, // eventRepo: not used by check-in operations
+		rdb.NewTicketRepository(db),
+		nil, // audits: not used by check-in operations
+		nil, // publisher: not used by check-in operations
+		infratelemetry.NewOTelEntryMetrics(),
+		entity.ZKPSignalLayoutV1, // signalLayout: not used by check-in operations
+		false,                    // strictFieldValidation: not used by check-in operations
+		logger,
+	)
+
+	return execute(ctx, entryUC, command, *eventID, *nullifier, os.Stdout)
+}
+
+// execute runs the command against the event and writes its result to w as
+// indented JSON.
+func execute(ctx context.Context, entryUC usecase.EntryUseCase, command, eventID, nullifier string, w io.Writer) error {
+	var result any
+	switch command {
+	case "status":
+		status, err := entryUC.GetCheckInStatus(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("get check-in status of event %s: %w", eventID, err)
+		}
+		result = struct {
+			EventID   string `json:"event_id"`
+			CheckedIn int    `json:"checked_in"`
+			Capacity  int    `json:"capacity"`
+			Remaining int    `json:"remaining"`
+		}{status.EventID, status.CheckedIn, status.Capacity, status.Remaining}
+	case "count":
+		count, err := entryUC.GetAttendanceCount(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("count check-ins of event %s: %w", eventID, err)
+		}
+		result = struct {
+			EventID   string `json:"event_id"`
+			CheckedIn int    `json:"checked_in"`
+		}{eventID, count}
+	case "export":
+		export, err := entryUC.ExportCheckIns(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("export check-ins of event %s: %w", eventID, err)
+		}
+		nullifiers := make([]string, len(export.Nullifiers))
+		for i, n := range export.Nullifiers {
+			nullifiers[i] = hex.EncodeToString(n)
+		}
+		result = struct {
+			EventID      string   `json:"event_id"`
+			CheckInCount int      `json:"check_in_count"`
+			Nullifiers   []string `json:"nullifiers"`
+		}{export.EventID, export.CheckInCount, nullifiers}
+	case "revoke":
+		hash, err := hex.DecodeString(nullifier)
+		if err != nil {
+			return fmt.Errorf("-nullifier is not hex: %w", err)
+		}
+		if err := entryUC.RevokeCheckIn(ctx, eventID, hash); err != nil {
+			return fmt.Errorf("revoke check-in %s of event %s: %w", nullifier, eventID, err)
+		}
+		result = struct {
+			EventID   string `json:"event_id"`
+			Nullifier string `json:"nullifier"`
+			Revoked   bool   `json:"revoked"`
+		}{eventID, nullifier, true}
+	default:
+		return fmt.Errorf("unknown command %q: want status, count, export or revoke", command)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/liverty-music/backend/internal/di"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
 	"github.com/liverty-music/backend/internal/infrastructure/server"
 	"github.com/liverty-music/backend/pkg/shutdown"
 	"github.com/pannpers/go-logging/logging"
@@ -85,22 +87,29 @@ func run() error {
 		return err
 	}
 
+	// /readyz stays 503 until the NATS connection is up, every durable is
+	// bound and the router is running, and drops to 503 while NATS is down.
+	// The probe is installed before SetReady so there is no window in which
+	// the pod reports ready without it.
+	healthSrv.SetReadiness(app.Health.Ready)
 	healthSrv.SetReady()
 	// Now that the router and subscriber exist, make /healthz reflect real
 	// consumption: unhealthy when the router has stopped, the NATS connection is
 	// down, or any expected durable is unbound. This lets Kubernetes restart a
 	// wedged pod instead of leaving it Running while it consumes nothing.
 	healthSrv.SetLiveness(app.Health.Live)
+	healthSrv.SetPoolStats(app.PoolStats)
 	shutdown.AddDrainPhase(healthSrv)
 
 	app.Logger.Info(ctx, "consumer router starting")
 
 	// Run the router in a goroutine so we can react to ctx cancellation.
-	// Router.Run(ctx) internally closes the router when ctx is cancelled,
-	// then blocks until all in-flight handlers complete (via closedCh).
+	// The router runs detached from the signal context: a signal drains it
+	// through Router.Drain below rather than closing it outright, which
+	// would cancel the contexts of in-flight handlers.
 	errChan := make(chan error, 1)
 	go func() {
-		if err := app.Router.Run(ctx); err != nil {
+		if err := app.Router.Run(context.WithoutCancel(ctx)); err != nil {
 			errChan <- err
 		}
 		close(errChan)
@@ -117,9 +126,26 @@ func run() error {
 		// the total does not exceed the K8s termination budget.
 		shutdownDeadline = time.Now().Add(app.ShutdownTimeout)
 
-		// Wait for Router.Run() to fully complete before proceeding to
-		// shutdown phases. This ensures all in-flight message handlers
-		// finish their DB writes and acks before publisher/DB are closed.
+		// Stop accepting messages and let in-flight handlers finish their
+		// DB writes before publisher/DB are closed. The drain gets only part
+		// of the budget so the shutdown phases keep theirs. Handlers still
+		// running at its deadline are abandoned and redelivered after a restart.
+		drainCtx, cancel := context.WithTimeout(context.Background(), messaging.DrainTimeout(app.ShutdownTimeout))
+		defer cancel()
+		if err := app.Router.Drain(drainCtx); err != nil {
+			var abandoned *messaging.AbandonedHandlersError
+			if errors.As(err, &abandoned) {
+				app.Logger.Warn(ctx, "shutdown deadline reached with handlers in flight",
+					slog.Int("in_flight", abandoned.InFlight),
+					slog.Any("cause", abandoned.Cause),
+				)
+				return nil
+			}
+			app.Logger.Error(ctx, "router drain failed during shutdown", err)
+			return nil
+		}
+
+		// The drain completed, so Router.Run() returns promptly.
 		if routerErr := <-errChan; routerErr != nil {
 			app.Logger.Error(ctx, "router stopped with error during shutdown", routerErr)
 		}
@@ -3,7 +3,6 @@ package main
 
 import (
 	"context"
-	"log/slog"
 	"os/signal"
 	"syscall"
 	"time"
@@ -13,13 +12,9 @@ import (
 	"github.com/pannpers/go-logging/logging"
 )
 
-const (
-	// maxConsecutiveErrors is the threshold for stopping the job due to systemic failures.
-	maxConsecutiveErrors = 3
-	// fallbackShutdownTimeout is used when DI initialization fails and
-	// app.ShutdownTimeout is unavailable.
-	fallbackShutdownTimeout = 10 * time.Second
-)
+// fallbackShutdownTimeout is used when DI initialization fails and
+// app.ShutdownTimeout is unavailable.
+const fallbackShutdownTimeout = 10 * time.Second
 
 func main() {
 	if err := run(); err != nil {
@@ -61,60 +56,7 @@ func run() error {
 		return err
 	}
 
-	artists, err := app.FollowRepo.ListAll(ctx)
-	if err != nil {
-		return err
-	}
-
-	app.Logger.Info(ctx, "followed artists loaded for processing",
-		slog.Int("count", len(artists)),
-	)
-
-	var totalFailed int
-	var consecutiveErrors int
-
-	for _, artist := range artists {
-		// Stop immediately on SIGTERM instead of waiting for the circuit
-		// breaker to trip after maxConsecutiveErrors cancelled API calls.
-		if ctx.Err() != nil {
-			break
-		}
-
-		// SearchNewConcerts calls the external API, deduplicates, and publishes
-		// a concert.discovered.v1 event. Concert persistence, notification, and
-		// venue enrichment are handled asynchronously by event consumers.
-		if _, err := app.ConcertUC.SearchNewConcerts(ctx, artist.ID); err != nil {
-			totalFailed++
-			consecutiveErrors++
-			app.Logger.Error(ctx, "failed to search concerts for artist", err,
-				slog.String("artist_id", artist.ID),
-				slog.String("artist_name", artist.Name),
-			)
-
-			if consecutiveErrors >= maxConsecutiveErrors {
-				app.Logger.Error(ctx, "circuit breaker activated: stopping after consecutive failures", nil,
-					slog.Int("consecutive_errors", consecutiveErrors),
-				)
-				break
-			}
-			continue
-		}
-
-		consecutiveErrors = 0
-	}
-
-	// Go 1.26: context.Cause returns the specific OS signal if shutdown was triggered.
-	if cause := context.Cause(ctx); cause != nil {
-		app.Logger.Info(ctx, "job interrupted by signal",
-			slog.String("cause", cause.Error()),
-		)
-	}
-
-	app.Logger.Info(ctx, "concert discovery job complete",
-		slog.Int("artists_attempted", len(artists)),
-		slog.Int("artists_succeeded", len(artists)-totalFailed),
-		slog.Int("failures", totalFailed),
-	)
-
-	return nil
+	// DiscoverAll runs the per-artist searches through a bounded worker pool
+	// and applies the consecutive-error circuit breaker across workers.
+	return app.DiscoveryUC.DiscoverAll(ctx)
 }
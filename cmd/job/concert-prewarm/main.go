@@ -0,0 +1,57 @@
+// Package main provides the concert prewarm CronJob entry point.
+//
+// The job refreshes the concerts of the most-followed artists ahead of
+// demand, so onboarding users who follow them find fresh results without
+// waiting on a Gemini search.
+package main
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/liverty-music/backend/internal/di"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// prewarmFallbackShutdownTimeout is used when DI initialization fails and
+// app.ShutdownTimeout is unavailable.
+const prewarmFallbackShutdownTimeout = 10 * time.Second
+
+func main() {
+	if err := run(); err != nil {
+		logger, _ := logging.New()
+		logger.Error(context.Background(), "concert prewarm job failed", err)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	bootLogger, _ := logging.New()
+	bootLogger.Info(ctx, "starting concert prewarm job")
+
+	var app *di.JobApp
+	defer func() {
+		timeout := prewarmFallbackShutdownTimeout
+		if app != nil {
+			timeout = app.ShutdownTimeout
+		}
+		sctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := shutdown.Shutdown(sctx); err != nil {
+			bootLogger.Error(context.Background(), "error during shutdown", err)
+		}
+	}()
+
+	var err error
+	app, err = di.InitializeJobApp(ctx)
+	if err != nil {
+		return err
+	}
+
+	return app.PrewarmUC.Prewarm(ctx, app.PrewarmLimit)
+}
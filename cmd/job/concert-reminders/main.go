@@ -0,0 +1,66 @@
+// Package main provides the concert-reminders CronJob entry point.
+//
+// The job runs hourly. Each run lists the concerts users hold a ticket
+// journey for, keeps those within each user's own reminder lead time on the
+// user's local date, applies quiet-hours logic, checks the sent-log, and
+// publishes CONCERT.reminder_due events for each eligible (user, event) pair.
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // embed IANA timezone DB; distroless/static has no system tzdata
+
+	"github.com/liverty-music/backend/internal/di"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/pannpers/go-logging/logging"
+)
+
+const remindersFallbackShutdownTimeout = 10 * time.Second
+
+func main() {
+	if err := run(); err != nil {
+		logger, _ := logging.New()
+		logger.Error(context.Background(), "concert-reminders job failed", err)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	bootLogger, _ := logging.New()
+	bootLogger.Info(ctx, "starting concert-reminders job")
+
+	var app *di.ConcertRemindersJobApp
+	defer func() {
+		timeout := remindersFallbackShutdownTimeout
+		if app != nil {
+			timeout = app.ShutdownTimeout
+		}
+		sctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := shutdown.Shutdown(sctx); err != nil {
+			bootLogger.Error(context.Background(), "error during shutdown", err)
+		}
+	}()
+
+	var err error
+	app, err = di.InitializeConcertRemindersJobApp(ctx)
+	if err != nil {
+		return err
+	}
+
+	published, err := app.ConcertReminderUC.ScanDueReminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	app.Logger.Info(ctx, "concert-reminders: scan complete",
+		slog.Int("reminders_published", published),
+	)
+	return nil
+}
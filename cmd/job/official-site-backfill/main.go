@@ -0,0 +1,75 @@
+// Package main provides the official site backfill CronJob entry point.
+//
+// The job resolves the official sites of artists that have none, through
+// MusicBrainz with a Wikidata fallback. It complements follow-time
+// resolution, which high-traffic deployments may disable.
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/liverty-music/backend/internal/di"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/pannpers/go-logging/logging"
+)
+
+const (
+	// batchLimit caps the number of artists processed per run. At the default
+	// MusicBrainz rate of one request per second a full batch takes about
+	// eight minutes.
+	batchLimit = 500
+	// fallbackShutdownTimeout is used when DI initialization fails and
+	// app.ShutdownTimeout is unavailable.
+	fallbackShutdownTimeout = 10 * time.Second
+)
+
+func main() {
+	if err := run(); err != nil {
+		logger, _ := logging.New()
+		logger.Error(context.Background(), "official site backfill job failed", err)
+		// Exit 0 to prevent K8s CronJob from retrying on systemic failures.
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	bootLogger, _ := logging.New()
+	bootLogger.Info(ctx, "starting official site backfill job")
+
+	// Register shutdown before DI so partially-initialized resources are
+	// cleaned up even when initialization fails partway through.
+	var app *di.OfficialSiteBackfillJobApp
+	defer func() {
+		timeout := fallbackShutdownTimeout
+		if app != nil {
+			timeout = app.ShutdownTimeout
+		}
+		sctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := shutdown.Shutdown(sctx); err != nil {
+			bootLogger.Error(context.Background(), "error during shutdown", err)
+		}
+	}()
+
+	var err error
+	app, err = di.InitializeOfficialSiteBackfillJobApp(ctx)
+	if err != nil {
+		return err
+	}
+
+	persisted, err := app.BackfillUC.Backfill(ctx, batchLimit)
+	if err != nil {
+		return err
+	}
+
+	app.Logger.Info(ctx, "official site backfill job complete",
+		slog.Int("sites_persisted", persisted),
+	)
+	return nil
+}
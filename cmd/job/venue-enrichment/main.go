@@ -0,0 +1,75 @@
+// Package main provides the venue enrichment CronJob entry point.
+//
+// The job retries the Google Places enrichment of venues whose earlier
+// attempts failed, once each venue's backoff has elapsed. A venue that fails
+// again waits twice as long before the next run retries it, until it reaches
+// the attempt cap.
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/liverty-music/backend/internal/di"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/pannpers/go-logging/logging"
+)
+
+const (
+	// batchLimit caps the number of venues retried per run; each issues one
+	// place search.
+	batchLimit = 200
+	// fallbackShutdownTimeout is used when DI initialization fails and
+	// app.ShutdownTimeout is unavailable.
+	fallbackShutdownTimeout = 10 * time.Second
+)
+
+func main() {
+	if err := run(); err != nil {
+		logger, _ := logging.New()
+		logger.Error(context.Background(), "venue enrichment job failed", err)
+		// Exit 0 to prevent K8s CronJob from retrying on systemic failures.
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	bootLogger, _ := logging.New()
+	bootLogger.Info(ctx, "starting venue enrichment job")
+
+	// Register shutdown before DI so partially-initialized resources are
+	// cleaned up even when initialization fails partway through.
+	var app *di.VenueEnrichmentJobApp
+	defer func() {
+		timeout := fallbackShutdownTimeout
+		if app != nil {
+			timeout = app.ShutdownTimeout
+		}
+		sctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := shutdown.Shutdown(sctx); err != nil {
+			bootLogger.Error(context.Background(), "error during shutdown", err)
+		}
+	}()
+
+	var err error
+	app, err = di.InitializeVenueEnrichmentJobApp(ctx)
+	if err != nil {
+		return err
+	}
+
+	attempted, err := app.RetryUC.RetryFailed(ctx, batchLimit)
+	if err != nil {
+		return err
+	}
+
+	app.Logger.Info(ctx, "venue enrichment job complete",
+		slog.Int("venues_attempted", attempted),
+	)
+	return nil
+}
@@ -0,0 +1,75 @@
+// Package main provides the venue resolution backfill CronJob entry point.
+//
+// The job links concerts recorded before their venue was resolved to a
+// venue, resolving each listed venue name through Google Places. Concerts
+// whose name cannot be resolved stay pending for the next run.
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/liverty-music/backend/internal/di"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/pannpers/go-logging/logging"
+)
+
+const (
+	// batchLimit caps the number of concerts processed per run. Concerts
+	// sharing a listed venue name need a single place search, so a full batch
+	// usually issues far fewer searches.
+	batchLimit = 500
+	// fallbackShutdownTimeout is used when DI initialization fails and
+	// app.ShutdownTimeout is unavailable.
+	fallbackShutdownTimeout = 10 * time.Second
+)
+
+func main() {
+	if err := run(); err != nil {
+		logger, _ := logging.New()
+		logger.Error(context.Background(), "venue resolution backfill job failed", err)
+		// Exit 0 to prevent K8s CronJob from retrying on systemic failures.
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	bootLogger, _ := logging.New()
+	bootLogger.Info(ctx, "starting venue resolution backfill job")
+
+	// Register shutdown before DI so partially-initialized resources are
+	// cleaned up even when initialization fails partway through.
+	var app *di.VenueResolutionBackfillJobApp
+	defer func() {
+		timeout := fallbackShutdownTimeout
+		if app != nil {
+			timeout = app.ShutdownTimeout
+		}
+		sctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if err := shutdown.Shutdown(sctx); err != nil {
+			bootLogger.Error(context.Background(), "error during shutdown", err)
+		}
+	}()
+
+	var err error
+	app, err = di.InitializeVenueResolutionBackfillJobApp(ctx)
+	if err != nil {
+		return err
+	}
+
+	linked, err := app.BackfillUC.Backfill(ctx, batchLimit)
+	if err != nil {
+		return err
+	}
+
+	app.Logger.Info(ctx, "venue resolution backfill job complete",
+		slog.Int("concerts_linked", linked),
+	)
+	return nil
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// progressLogInterval is how often a batch run logs its progress.
+const progressLogInterval = 30 * time.Second
+
+// batchTarget is one line of an -artists-file.
+type batchTarget struct {
+	artist *entity.Artist
+	sites  []*entity.OfficialSite
+}
+
+// batchResult is one NDJSON line of batch output.
+type batchResult struct {
+	Artist   string                   `json:"artist"`
+	Concerts []*entity.ScrapedConcert `json:"concerts"`
+	Sources  *sources                 `json:"sources,omitempty"`
+	Error    string                   `json:"error,omitempty"`
+}
+
+// parseTargets reads an -artists-file: one artist per line as "<name>" or
+// "<name>\t<official site URL>". Blank lines and lines starting with '#' are
+// skipped.
+func parseTargets(r io.Reader) ([]batchTarget, error) {
+	var targets []batchTarget
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, siteURL, _ := strings.Cut(line, "\t")
+		t := batchTarget{artist: &entity.Artist{Name: strings.TrimSpace(name)}}
+		if siteURL = strings.TrimSpace(siteURL); siteURL != "" {
+			t.sites = []*entity.OfficialSite{{URL: siteURL}}
+		}
+		targets = append(targets, t)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read artists file: %w", err)
+	}
+	return targets, nil
+}
+
+// progress tracks a batch run. It is safe for concurrent use.
+type progress struct {
+	mu        sync.Mutex
+	total     int
+	processed int
+	succeeded int
+}
+
+// progressSnapshot is a point-in-time copy of progress.
+type progressSnapshot struct {
+	Total     int
+	Processed int
+	Succeeded int
+}
+
+func newProgress(total int) *progress {
+	return &progress{total: total}
+}
+
+// record counts one finished artist and returns the updated snapshot.
+func (p *progress) record(ok bool) progressSnapshot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.processed++
+	if ok {
+		p.succeeded++
+	}
+	return progressSnapshot{Total: p.total, Processed: p.processed, Succeeded: p.succeeded}
+}
+
+// snapshot returns the current counts.
+func (p *progress) snapshot() progressSnapshot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return progressSnapshot{Total: p.total, Processed: p.processed, Succeeded: p.succeeded}
+}
+
+// successRate is the share of processed artists that succeeded, in [0, 1].
+// It is 0 before anything has been processed.
+func (s progressSnapshot) successRate() float64 {
+	if s.Processed == 0 {
+		return 0
+	}
+	return float64(s.Succeeded) / float64(s.Processed)
+}
+
+func (s progressSnapshot) attrs() []slog.Attr {
+	return []slog.Attr{
+		slog.Int("processed", s.Processed),
+		slog.Int("total", s.Total),
+		slog.Int("succeeded", s.Succeeded),
+		slog.String("success_rate", fmt.Sprintf("%.1f%%", s.successRate()*100)),
+	}
+}
+
+// runBatch searches every target with at most opts.concurrency searches in
+// flight, writing one NDJSON batchResult per artist to w as each finishes.
+// A failed artist is reported in its result line and does not stop the run;
+// cancelling ctx stops dispatching new artists.
+func runBatch(ctx context.Context, opts *options, s searcher, targets []batchTarget, w io.Writer, logger *logging.Logger) error {
+	prog := newProgress(len(targets))
+	logger.Info(ctx, "batch search started",
+		slog.Int("total", len(targets)),
+		slog.Int("concurrency", opts.concurrency),
+	)
+
+	done := make(chan struct{})
+	var logWG sync.WaitGroup
+	logWG.Go(func() {
+		ticker := time.NewTicker(progressLogInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				logger.Info(ctx, "batch search progress", prog.snapshot().attrs()...)
+			}
+		}
+	})
+
+	var (
+		writeMu  sync.Mutex
+		writeErr error
+	)
+	enc := json.NewEncoder(w)
+
+	jobs := make(chan batchTarget)
+	var wg sync.WaitGroup
+	for range min(opts.concurrency, len(targets)) {
+		wg.Go(func() {
+			for t := range jobs {
+				res := &batchResult{Artist: t.artist.Name, Concerts: []*entity.ScrapedConcert{}}
+				concerts, meta, err := s.SearchExt(ctx, t.artist, t.sites, opts.from)
+				if err != nil {
+					res.Error = err.Error()
+				} else {
+					if concerts = postProcess(concerts, opts); concerts != nil {
+						res.Concerts = concerts
+					}
+					if opts.withSources {
+						res.Sources = &buildOutput(concerts, meta).Sources
+					}
+				}
+
+				writeMu.Lock()
+				if err := enc.Encode(res); err != nil && writeErr == nil {
+					writeErr = fmt.Errorf("write result: %w", err)
+				}
+				writeMu.Unlock()
+
+				prog.record(err == nil)
+			}
+		})
+	}
+
+dispatch:
+	for _, t := range targets {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- t:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	close(done)
+	logWG.Wait()
+
+	logger.Info(ctx, "batch search complete", prog.snapshot().attrs()...)
+
+	if writeErr != nil {
+		return writeErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/gcp/gemini"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// poolSearcher is a concurrency-safe fake that fails for artists in failFor
+// and records the peak number of concurrent searches.
+type poolSearcher struct {
+	failFor  map[string]bool
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (p *poolSearcher) SearchExt(_ context.Context, artist *entity.Artist, _ []*entity.OfficialSite, _ time.Time) ([]*entity.ScrapedConcert, *gemini.SearchMetadata, error) {
+	n := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for {
+		peak := p.peak.Load()
+		if n <= peak || p.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if p.failFor[artist.Name] {
+		return nil, nil, errors.New("gemini: unavailable")
+	}
+	return []*entity.ScrapedConcert{{Title: artist.Name + " Tour"}}, nil, nil
+}
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+
+	p := newProgress(4)
+	assert.Equal(t, progressSnapshot{Total: 4}, p.snapshot())
+	assert.Zero(t, p.snapshot().successRate())
+
+	p.record(true)
+	p.record(false)
+	got := p.record(true)
+
+	assert.Equal(t, progressSnapshot{Total: 4, Processed: 3, Succeeded: 2}, got)
+	assert.Equal(t, got, p.snapshot())
+	assert.InDelta(t, 2.0/3.0, got.successRate(), 1e-9)
+}
+
+func TestProgress_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	p := newProgress(100)
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Go(func() { p.record(i%4 != 0) })
+	}
+	wg.Wait()
+
+	got := p.snapshot()
+	assert.Equal(t, 100, got.Processed)
+	assert.Equal(t, 75, got.Succeeded)
+	assert.InDelta(t, 0.75, got.successRate(), 1e-9)
+}
+
+func TestParseTargets(t *testing.T) {
+	t.Parallel()
+
+	in := "# popular artists\nFirst Artist\n\nSecond Artist\thttps://second.example\n"
+
+	got, err := parseTargets(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "First Artist", got[0].artist.Name)
+	assert.Empty(t, got[0].sites)
+	assert.Equal(t, "Second Artist", got[1].artist.Name)
+	require.Len(t, got[1].sites, 1)
+	assert.Equal(t, "https://second.example", got[1].sites[0].URL)
+}
+
+func TestRunBatch(t *testing.T) {
+	t.Parallel()
+
+	logger, err := logging.New()
+	require.NoError(t, err)
+
+	var targets []batchTarget
+	for _, name := range []string{"A", "B", "C", "D", "E", "F", "G", "H"} {
+		targets = append(targets, batchTarget{artist: &entity.Artist{Name: name}})
+	}
+	s := &poolSearcher{failFor: map[string]bool{"C": true}}
+	var out bytes.Buffer
+
+	require.NoError(t, runBatch(context.Background(), &options{concurrency: 3}, s, targets, &out, logger))
+
+	assert.LessOrEqual(t, s.peak.Load(), int32(3))
+
+	results := map[string]batchResult{}
+	sc := bufio.NewScanner(&out)
+	for sc.Scan() {
+		var r batchResult
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &r))
+		results[r.Artist] = r
+	}
+	assert.Len(t, results, len(targets))
+	assert.NotEmpty(t, results["C"].Error)
+	assert.Empty(t, results["C"].Concerts)
+	assert.Empty(t, results["A"].Error)
+	assert.Len(t, results["A"].Concerts, 1)
+}
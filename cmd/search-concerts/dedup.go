@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/gcp/gemini"
+)
+
+// Dedup key fields accepted by -dedup-key.
+const (
+	dedupFieldTitle = "title"
+	dedupFieldDate  = "date"
+	dedupFieldVenue = "venue"
+	dedupFieldStart = "start_time"
+)
+
+// defaultDedupKey mirrors the searcher's own (local_date, normalized venue,
+// start_time) key and the events natural key, so two shows at the same venue
+// on the same day with different start times (matinee/evening) are kept.
+const defaultDedupKey = "date,venue,start_time"
+
+// dedupKey is an ordered list of ScrapedConcert fields that together identify
+// a concert for post-processing dedup. An empty key disables dedup.
+type dedupKey []string
+
+// parseDedupKey parses a comma-separated -dedup-key value. "none" disables
+// dedup.
+func parseDedupKey(s string) (dedupKey, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "none" {
+		return nil, nil
+	}
+	var key dedupKey
+	seen := make(map[string]bool)
+	for f := range strings.SplitSeq(s, ",") {
+		f = strings.TrimSpace(f)
+		switch f {
+		case dedupFieldTitle, dedupFieldDate, dedupFieldVenue, dedupFieldStart:
+		default:
+			return nil, fmt.Errorf("invalid -dedup-key field %q (allowed: %s, %s, %s, %s, or none)",
+				f, dedupFieldTitle, dedupFieldDate, dedupFieldVenue, dedupFieldStart)
+		}
+		if seen[f] {
+			continue
+		}
+		seen[f] = true
+		key = append(key, f)
+	}
+	return key, nil
+}
+
+// of returns c's key value. Venue compares in normalized form, the same
+// normalization the searcher applies, so spelling variants of one venue
+// collapse; start_time uses entity.StartKey so an unknown start is its own
+// value.
+func (k dedupKey) of(c *entity.ScrapedConcert) string {
+	parts := make([]string, len(k))
+	for i, f := range k {
+		switch f {
+		case dedupFieldTitle:
+			parts[i] = strings.TrimSpace(c.Title)
+		case dedupFieldDate:
+			parts[i] = c.LocalDate.Format(time.DateOnly)
+		case dedupFieldVenue:
+			parts[i] = gemini.NormalizeVenue(c.ListedVenueName)
+		case dedupFieldStart:
+			parts[i] = entity.StartKey(entity.NullableTime(c.StartTime))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// dedupConcerts drops concerts whose key was already seen, keeping the first
+// occurrence and the input order. A nil key returns the input unchanged.
+func dedupConcerts(concerts []*entity.ScrapedConcert, key dedupKey) []*entity.ScrapedConcert {
+	if len(key) == 0 {
+		return concerts
+	}
+	seen := make(map[string]struct{}, len(concerts))
+	out := make([]*entity.ScrapedConcert, 0, len(concerts))
+	for _, c := range concerts {
+		k := key.of(c)
+		if _, dup := seen[k]; dup {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, c)
+	}
+	return out
+}
+
+// postProcess applies the CLI's post-processing to one search result: events
+// already past in their venue's time zone as of opts.from are dropped (the
+// same rule SearchNewConcerts applies), duplicates on opts.dedupKey are
+// dropped, and the rest is put in SearchNewConcerts' chronological order.
+func postProcess(concerts []*entity.ScrapedConcert, opts *options) []*entity.ScrapedConcert {
+	out := entity.ScrapedConcerts(dedupConcerts(entity.ScrapedConcerts(concerts).Upcoming(opts.from), opts.dedupKey))
+	out.Sort()
+	return out
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDedupKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    dedupKey
+		wantErr bool
+	}{
+		{name: "default", in: defaultDedupKey, want: dedupKey{"date", "venue", "start_time"}},
+		{name: "legacy title key", in: "title, date, venue", want: dedupKey{"title", "date", "venue"}},
+		{name: "repeated field collapses", in: "date,venue,date", want: dedupKey{"date", "venue"}},
+		{name: "none disables", in: "none"},
+		{name: "empty disables", in: ""},
+		{name: "unknown field", in: "date,city", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseDedupKey(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDedupConcerts(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	matinee := &entity.ScrapedConcert{
+		Title: "Test Artist Tour 2026", ListedVenueName: "Zepp Haneda", LocalDate: day,
+		StartTime: time.Date(2026, 11, 1, 4, 0, 0, 0, time.UTC),
+	}
+	evening := &entity.ScrapedConcert{
+		Title: "Test Artist Tour 2026", ListedVenueName: "Zepp Haneda", LocalDate: day,
+		StartTime: time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC),
+	}
+	eveningAgain := &entity.ScrapedConcert{
+		Title: "Test Artist Tour 2026 (追加公演)", ListedVenueName: "Zepp Haneda", LocalDate: day,
+		StartTime: time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("default key preserves same-venue shows with different start times", func(t *testing.T) {
+		t.Parallel()
+		key, err := parseDedupKey(defaultDedupKey)
+		require.NoError(t, err)
+
+		got := dedupConcerts([]*entity.ScrapedConcert{matinee, evening}, key)
+
+		assert.Equal(t, []*entity.ScrapedConcert{matinee, evening}, got)
+	})
+
+	t.Run("default key drops a repeat of the same show", func(t *testing.T) {
+		t.Parallel()
+		key, err := parseDedupKey(defaultDedupKey)
+		require.NoError(t, err)
+
+		got := dedupConcerts([]*entity.ScrapedConcert{matinee, evening, eveningAgain}, key)
+
+		assert.Equal(t, []*entity.ScrapedConcert{matinee, evening}, got)
+	})
+
+	t.Run("title-date-venue key collapses the two nights", func(t *testing.T) {
+		t.Parallel()
+		key, err := parseDedupKey("title,date,venue")
+		require.NoError(t, err)
+
+		got := dedupConcerts([]*entity.ScrapedConcert{matinee, evening}, key)
+
+		assert.Equal(t, []*entity.ScrapedConcert{matinee}, got)
+	})
+
+	t.Run("nil key disables dedup", func(t *testing.T) {
+		t.Parallel()
+		in := []*entity.ScrapedConcert{evening, eveningAgain}
+
+		assert.Equal(t, in, dedupConcerts(in, nil))
+	})
+}
+
+func TestPostProcess_PastFilterTimeZone(t *testing.T) {
+	t.Parallel()
+
+	tokyo := "JP-13"
+	// 00:30 JST on Nov 2 while UTC is still on Nov 1.
+	opts := &options{from: time.Date(2026, 11, 1, 15, 30, 0, 0, time.UTC), dedupKey: dedupKey{"date", "venue", "start_time"}}
+	yesterday := &entity.ScrapedConcert{Title: "yesterday", ListedVenueName: "Zepp Haneda", AdminArea: &tokyo, LocalDate: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)}
+	today := &entity.ScrapedConcert{Title: "today", ListedVenueName: "Zepp Haneda", AdminArea: &tokyo, LocalDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)}
+
+	got := postProcess([]*entity.ScrapedConcert{yesterday, today}, opts)
+
+	assert.Equal(t, []*entity.ScrapedConcert{today}, got)
+}
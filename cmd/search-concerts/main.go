@@ -0,0 +1,321 @@
+// Command search-concerts runs the production two-step Gemini concert search
+// for one artist and prints the scraped concerts as JSON. It is meant for
+// prompt tuning and spot checks: the artist and official-site inputs are the
+// same ones the discovery job passes to ConcertSearcher.Search, so the
+// grounding matches production.
+//
+// Usage:
+//
+//	go run ./cmd/search-concerts -artist <name> [-official-site <url>] [-from YYYY-MM-DD]
+//	go run ./cmd/search-concerts -artist-id <uuid> [-official-site <url>] [-from YYYY-MM-DD]
+//	go run ./cmd/search-concerts -artist <name> -with-sources
+//	go run ./cmd/search-concerts -artists-file <path> [-concurrency N]
+//	go run ./cmd/search-concerts -artist-id <uuid> -validate
+//
+// With -artist-id the artist name and official site are read from the
+// database; an explicit -official-site replaces the stored ones. With
+// -with-sources the output is an object that also carries the grounding
+// metadata (search queries issued, grounding chunk URLs, URLs fetched via
+// url_context) so operators can see where each event came from.
+//
+// With -artists-file every listed artist is searched by a pool of
+// -concurrency workers and one JSON result per artist is written per line as
+// it finishes; progress (processed/total, running success rate) is logged
+// periodically so long runs are observable.
+//
+// With -validate (requires -artist-id) the extraction is diffed against the
+// artist's stored upcoming concerts, reporting new, missing, and changed
+// concerts; useful for measuring the effect of a prompt change.
+//
+// Extracted concerts dated before -from in their venue's time zone are
+// dropped, and the rest are deduplicated on -dedup-key, a comma-separated list
+// of title, date, venue and start_time (default "date,venue,start_time",
+// matching production); "none" disables it. Gemini and
+// database settings come from the same environment variables as the
+// concert-discovery job.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/gcp/gemini"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// defaultConcurrency keeps batch runs well inside Gemini's per-minute quota:
+// each search already fans out into several parallel Step 1 slices.
+const defaultConcurrency = 4
+
+// options holds the parsed command-line flags.
+type options struct {
+	artistName   string
+	artistID     string
+	officialSite string
+	from         time.Time
+	withSources  bool
+	artistsFile  string
+	concurrency  int
+	validate     bool
+	dedupKey     dedupKey
+}
+
+// output is the -with-sources result: the concerts plus where they came from.
+type output struct {
+	Concerts []*entity.ScrapedConcert `json:"concerts"`
+	Sources  sources                  `json:"sources"`
+}
+
+// sources is the grounding metadata of one search.
+type sources struct {
+	// WebSearchQueries are the google_search queries the model issued.
+	WebSearchQueries []string `json:"web_search_queries"`
+	// GroundingURLs are the grounding chunk URLs cited by the response.
+	GroundingURLs []string `json:"grounding_urls"`
+	// FetchedURLs are the pages retrieved via url_context.
+	FetchedURLs []string `json:"fetched_urls"`
+}
+
+// searcher is the subset of gemini.ConcertSearcher the command depends on.
+type searcher interface {
+	SearchExt(ctx context.Context, artist *entity.Artist, officialSites []*entity.OfficialSite, from time.Time) ([]*entity.ScrapedConcert, *gemini.SearchMetadata, error)
+}
+
+// artistReader is the subset of entity.ArtistRepository used to resolve
+// -artist-id.
+type artistReader interface {
+	Get(ctx context.Context, id string) (*entity.Artist, error)
+	ListOfficialSites(ctx context.Context, artistID string) ([]*entity.OfficialSite, error)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "search-concerts:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	opts, err := parseOptions(os.Args[1:], time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load[config.JobConfig]()
+	if err != nil {
+		return err
+	}
+	if cfg.GCP.GeminiSearchAPIKey == "" {
+		return errors.New("GCP_GEMINI_SEARCH_API_KEY is required")
+	}
+
+	logger, err := logging.New()
+	if err != nil {
+		return err
+	}
+
+	var (
+		artists  artistReader
+		concerts concertLister
+	)
+	if opts.artistID != "" {
+		db, err := rdb.New(ctx, cfg.Database, cfg.IsLocal(), logger)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = db.Close() }()
+		artists = rdb.NewArtistRepository(db)
+		concerts = rdb.NewConcertRepository(db)
+	}
+
+	s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+		APIKey:          cfg.GCP.GeminiSearchAPIKey,
+		ModelExtract:    cfg.GCP.SearchModelExtract(),
+		ModelParse:      cfg.GCP.SearchModelParse(),
+		Temperature:     cfg.GCP.GeminiSearchTemperature,
+		ThinkingLevel:   cfg.GCP.GeminiSearchThinkingLevel,
+		ThinkingExtract: cfg.GCP.GeminiSearchThinkingExtract,
+		ThinkingParse:   cfg.GCP.GeminiSearchThinkingParse,
+	}, http.DefaultClient, logger)
+	if err != nil {
+		return err
+	}
+
+	if opts.artistsFile != "" {
+		f, err := os.Open(opts.artistsFile)
+		if err != nil {
+			return err
+		}
+		targets, err := parseTargets(f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		return runBatch(ctx, opts, s, targets, os.Stdout, logger)
+	}
+
+	if opts.validate {
+		return validate(ctx, opts, s, artists, concerts, os.Stdout)
+	}
+
+	return search(ctx, opts, s, artists, os.Stdout)
+}
+
+// parseOptions parses args into options. now supplies the default -from.
+func parseOptions(args []string, now time.Time) (*options, error) {
+	fs := flag.NewFlagSet("search-concerts", flag.ContinueOnError)
+	opts := &options{}
+	var from, dedup string
+	fs.StringVar(&opts.artistName, "artist", "", "artist name to search for")
+	fs.StringVar(&opts.artistID, "artist-id", "", "artist ID; name and official site are read from the database")
+	fs.StringVar(&opts.officialSite, "official-site", "", "official site URL used to ground the search (overrides the stored site)")
+	fs.StringVar(&from, "from", "", "search horizon start as YYYY-MM-DD (default: today)")
+	fs.BoolVar(&opts.withSources, "with-sources", false, "include grounding sources (search queries, source URLs) in the output")
+	fs.StringVar(&opts.artistsFile, "artists-file", "", "file with one artist per line (name, optionally a tab and the official site URL) to search in batch")
+	fs.IntVar(&opts.concurrency, "concurrency", defaultConcurrency, "maximum searches in flight in batch mode")
+	fs.StringVar(&dedup, "dedup-key", defaultDedupKey, "comma-separated fields (title, date, venue, start_time) identifying duplicate concerts, or none")
+	fs.BoolVar(&opts.validate, "validate", false, "diff the extraction against the artist's stored concerts (requires -artist-id)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	var modes int
+	for _, v := range []string{opts.artistName, opts.artistID, opts.artistsFile} {
+		if v != "" {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return nil, errors.New("exactly one of -artist, -artist-id or -artists-file is required")
+	}
+	if opts.validate && opts.artistID == "" {
+		return nil, errors.New("-validate requires -artist-id")
+	}
+	if opts.concurrency < 1 {
+		return nil, fmt.Errorf("invalid -concurrency %d (must be >= 1)", opts.concurrency)
+	}
+
+	key, err := parseDedupKey(dedup)
+	if err != nil {
+		return nil, err
+	}
+	opts.dedupKey = key
+
+	opts.from = now
+	if from != "" {
+		t, err := time.Parse(time.DateOnly, from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -from %q: %w", from, err)
+		}
+		opts.from = t
+	}
+	return opts, nil
+}
+
+// resolveTarget builds the artist and official sites passed to the searcher,
+// mirroring ConcertUseCase.SearchNewConcerts: an artist is searched with all
+// of its stored sites, or none. An explicit -official-site replaces them.
+func resolveTarget(ctx context.Context, opts *options, artists artistReader) (*entity.Artist, []*entity.OfficialSite, error) {
+	artist := &entity.Artist{Name: opts.artistName}
+	var sites []*entity.OfficialSite
+
+	if opts.artistID != "" {
+		a, err := artists.Get(ctx, opts.artistID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get artist: %w", err)
+		}
+		artist = a
+
+		sites, err = artists.ListOfficialSites(ctx, opts.artistID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("list official sites: %w", err)
+		}
+	}
+
+	if opts.officialSite != "" {
+		sites = []*entity.OfficialSite{{ArtistID: artist.ID, URL: opts.officialSite}}
+	}
+	return artist, sites, nil
+}
+
+// search resolves the target, runs the search, and writes the result to w as
+// indented JSON: a bare concert array, or an output object with -with-sources.
+func search(ctx context.Context, opts *options, s searcher, artists artistReader, w io.Writer) error {
+	artist, sites, err := resolveTarget(ctx, opts, artists)
+	if err != nil {
+		return err
+	}
+
+	concerts, meta, err := s.SearchExt(ctx, artist, sites, opts.from)
+	if err != nil {
+		return fmt.Errorf("search concerts for %q: %w", artist.Name, err)
+	}
+	concerts = postProcess(concerts, opts)
+	if concerts == nil {
+		concerts = []*entity.ScrapedConcert{}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if !opts.withSources {
+		return enc.Encode(concerts)
+	}
+	return enc.Encode(buildOutput(concerts, meta))
+}
+
+// buildOutput assembles the -with-sources result. Queries and URLs are taken
+// from the aggregated Step 1 (grounded) pass, deduplicated in first-seen
+// order since parallel slices often repeat the same query or page. A nil
+// meta yields empty source lists.
+func buildOutput(concerts []*entity.ScrapedConcert, meta *gemini.SearchMetadata) *output {
+	out := &output{
+		Concerts: concerts,
+		Sources: sources{
+			WebSearchQueries: []string{},
+			GroundingURLs:    []string{},
+			FetchedURLs:      []string{},
+		},
+	}
+	if meta == nil {
+		return out
+	}
+	if step1 := meta.Step1Grounded; step1 != nil {
+		out.Sources.WebSearchQueries = dedupe(step1.WebSearchQueriesList)
+		out.Sources.GroundingURLs = dedupe(step1.GroundingChunkURLs)
+	}
+	out.Sources.FetchedURLs = dedupe(meta.DiscoveredURLs)
+	return out
+}
+
+// dedupe returns the non-empty values of in without duplicates, preserving
+// first-seen order. The result is never nil.
+func dedupe(in []string) []string {
+	seen := make(map[string]struct{}, len(in))
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
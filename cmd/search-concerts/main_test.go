@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/gcp/gemini"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSearcher records the inputs of the last SearchExt call.
+type fakeSearcher struct {
+	artist   *entity.Artist
+	sites    []*entity.OfficialSite
+	from     time.Time
+	concerts []*entity.ScrapedConcert
+	meta     *gemini.SearchMetadata
+}
+
+func (f *fakeSearcher) SearchExt(_ context.Context, artist *entity.Artist, sites []*entity.OfficialSite, from time.Time) ([]*entity.ScrapedConcert, *gemini.SearchMetadata, error) {
+	f.artist, f.sites, f.from = artist, sites, from
+	return f.concerts, f.meta, nil
+}
+
+// fakeArtists serves one artist and, optionally, its stored official sites.
+type fakeArtists struct {
+	artist *entity.Artist
+	sites  []*entity.OfficialSite
+}
+
+func (f *fakeArtists) Get(_ context.Context, id string) (*entity.Artist, error) {
+	if f.artist == nil || f.artist.ID != id {
+		return nil, apperr.ErrNotFound
+	}
+	return f.artist, nil
+}
+
+func (f *fakeArtists) ListOfficialSites(_ context.Context, _ string) ([]*entity.OfficialSite, error) {
+	return f.sites, nil
+}
+
+func TestParseOptions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    *options
+		wantErr bool
+	}{
+		{
+			name: "artist name with official site",
+			args: []string{"-artist", "Test Artist", "-official-site", "https://test-artist.example"},
+			want: &options{artistName: "Test Artist", officialSite: "https://test-artist.example", from: now, concurrency: defaultConcurrency, dedupKey: dedupKey{"date", "venue", "start_time"}},
+		},
+		{
+			name: "artist id with explicit horizon",
+			args: []string{"-artist-id", "artist-1", "-from", "2026-11-01"},
+			want: &options{artistID: "artist-1", from: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), concurrency: defaultConcurrency, dedupKey: dedupKey{"date", "venue", "start_time"}},
+		},
+		{
+			name:    "neither artist nor artist id",
+			args:    []string{"-official-site", "https://test-artist.example"},
+			wantErr: true,
+		},
+		{
+			name:    "both artist and artist id",
+			args:    []string{"-artist", "Test Artist", "-artist-id", "artist-1"},
+			wantErr: true,
+		},
+		{
+			name: "artists file with concurrency",
+			args: []string{"-artists-file", "artists.txt", "-concurrency", "8"},
+			want: &options{artistsFile: "artists.txt", from: now, concurrency: 8, dedupKey: dedupKey{"date", "venue", "start_time"}},
+		},
+		{
+			name:    "artists file combined with artist",
+			args:    []string{"-artists-file", "artists.txt", "-artist", "Test Artist"},
+			wantErr: true,
+		},
+		{
+			name: "validate with artist id",
+			args: []string{"-artist-id", "artist-1", "-validate"},
+			want: &options{artistID: "artist-1", from: now, concurrency: defaultConcurrency, validate: true, dedupKey: dedupKey{"date", "venue", "start_time"}},
+		},
+		{
+			name:    "validate without artist id",
+			args:    []string{"-artist", "Test Artist", "-validate"},
+			wantErr: true,
+		},
+		{
+			name:    "non-positive concurrency",
+			args:    []string{"-artists-file", "artists.txt", "-concurrency", "0"},
+			wantErr: true,
+		},
+		{
+			name: "dedup disabled",
+			args: []string{"-artist", "Test Artist", "-dedup-key", "none"},
+			want: &options{artistName: "Test Artist", from: now, concurrency: defaultConcurrency},
+		},
+		{
+			name:    "unknown dedup field",
+			args:    []string{"-artist", "Test Artist", "-dedup-key", "date,city"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed horizon",
+			args:    []string{"-artist", "Test Artist", "-from", "11/01"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseOptions(tt.args, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSearch_OfficialSite(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stored := &entity.Artist{ID: "artist-1", Name: "Stored Artist"}
+	storedSite := &entity.OfficialSite{ArtistID: "artist-1", URL: "https://stored.example"}
+	storedTourSite := &entity.OfficialSite{ArtistID: "artist-1", URL: "https://tour.stored.example"}
+
+	tests := []struct {
+		name       string
+		opts       *options
+		artists    *fakeArtists
+		wantArtist string
+		wantSites  []string
+	}{
+		{
+			name:       "flag flows into the search by name",
+			opts:       &options{artistName: "Test Artist", officialSite: "https://test-artist.example"},
+			wantArtist: "Test Artist",
+			wantSites:  []string{"https://test-artist.example"},
+		},
+		{
+			name:       "search by name without flag is ungrounded",
+			opts:       &options{artistName: "Test Artist"},
+			wantArtist: "Test Artist",
+		},
+		{
+			name:       "artist id uses the stored site",
+			opts:       &options{artistID: "artist-1"},
+			artists:    &fakeArtists{artist: stored, sites: []*entity.OfficialSite{storedSite}},
+			wantArtist: "Stored Artist",
+			wantSites:  []string{"https://stored.example"},
+		},
+		{
+			name:       "artist id uses every stored site",
+			opts:       &options{artistID: "artist-1"},
+			artists:    &fakeArtists{artist: stored, sites: []*entity.OfficialSite{storedSite, storedTourSite}},
+			wantArtist: "Stored Artist",
+			wantSites:  []string{"https://stored.example", "https://tour.stored.example"},
+		},
+		{
+			name:       "flag overrides the stored sites",
+			opts:       &options{artistID: "artist-1", officialSite: "https://override.example"},
+			artists:    &fakeArtists{artist: stored, sites: []*entity.OfficialSite{storedSite, storedTourSite}},
+			wantArtist: "Stored Artist",
+			wantSites:  []string{"https://override.example"},
+		},
+		{
+			name:       "artist id without stored site is ungrounded",
+			opts:       &options{artistID: "artist-1"},
+			artists:    &fakeArtists{artist: stored},
+			wantArtist: "Stored Artist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := &fakeSearcher{concerts: []*entity.ScrapedConcert{{Title: "Tour 2026"}}}
+			var artists artistReader
+			if tt.artists != nil {
+				artists = tt.artists
+			}
+			var out bytes.Buffer
+
+			require.NoError(t, search(ctx, tt.opts, s, artists, &out))
+
+			assert.Equal(t, tt.wantArtist, s.artist.Name)
+			var gotSites []string
+			for _, site := range s.sites {
+				gotSites = append(gotSites, site.URL)
+			}
+			assert.Equal(t, tt.wantSites, gotSites)
+
+			var got []*entity.ScrapedConcert
+			require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+			assert.Len(t, got, 1)
+		})
+	}
+}
+
+func TestSearch_UnknownArtistID(t *testing.T) {
+	t.Parallel()
+
+	err := search(context.Background(), &options{artistID: "missing"}, &fakeSearcher{}, &fakeArtists{}, &bytes.Buffer{})
+	assert.ErrorIs(t, err, apperr.ErrNotFound)
+}
+
+func TestBuildOutput(t *testing.T) {
+	t.Parallel()
+
+	concerts := []*entity.ScrapedConcert{{Title: "Tour 2026", SourceURL: "https://test-artist.example/live"}}
+
+	t.Run("collects and dedupes grounding sources", func(t *testing.T) {
+		t.Parallel()
+		meta := &gemini.SearchMetadata{
+			Step1Grounded: &gemini.PassMetadata{
+				WebSearchQueriesList: []string{"Test Artist tour 2026", "Test Artist live", "Test Artist tour 2026"},
+				GroundingChunkURLs:   []string{"https://a.example", "", "https://b.example", "https://a.example"},
+			},
+			DiscoveredURLs: []string{"https://test-artist.example/live", "https://test-artist.example/live"},
+		}
+
+		got := buildOutput(concerts, meta)
+
+		assert.Equal(t, concerts, got.Concerts)
+		assert.Equal(t, []string{"Test Artist tour 2026", "Test Artist live"}, got.Sources.WebSearchQueries)
+		assert.Equal(t, []string{"https://a.example", "https://b.example"}, got.Sources.GroundingURLs)
+		assert.Equal(t, []string{"https://test-artist.example/live"}, got.Sources.FetchedURLs)
+	})
+
+	t.Run("missing metadata yields empty source lists", func(t *testing.T) {
+		t.Parallel()
+		got := buildOutput(concerts, nil)
+
+		b, err := json.Marshal(got)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"concerts": [{"title": "Tour 2026", "listed_venue_name": "", "local_date": "0001-01-01T00:00:00Z", "source_url": "https://test-artist.example/live"}],
+			"sources": {"web_search_queries": [], "grounding_urls": [], "fetched_urls": []}
+		}`, string(b))
+	})
+}
+
+func TestSearch_WithSources(t *testing.T) {
+	t.Parallel()
+
+	s := &fakeSearcher{
+		concerts: []*entity.ScrapedConcert{{Title: "Tour 2026"}},
+		meta: &gemini.SearchMetadata{
+			Step1Grounded: &gemini.PassMetadata{WebSearchQueriesList: []string{"Test Artist tour"}},
+		},
+	}
+	var out bytes.Buffer
+
+	require.NoError(t, search(context.Background(), &options{artistName: "Test Artist", withSources: true}, s, nil, &out))
+
+	var got output
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Len(t, got.Concerts, 1)
+	assert.Equal(t, []string{"Test Artist tour"}, got.Sources.WebSearchQueries)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+)
+
+// concertLister is the subset of entity.ConcertRepository used by -validate.
+type concertLister interface {
+	ListByArtist(ctx context.Context, artistID string, upcomingOnly bool) ([]*entity.Concert, error)
+}
+
+// diffReport is the -validate result: how a fresh extraction differs from the
+// artist's stored upcoming concerts.
+type diffReport struct {
+	// New are extracted concerts with no stored counterpart.
+	New []diffEntry `json:"new"`
+	// Missing are stored concerts the extraction did not return.
+	Missing []diffEntry `json:"missing"`
+	// Changed pairs an extracted concert with the stored one at the same
+	// date and venue whose details differ.
+	Changed []changedEntry `json:"changed"`
+	// Unchanged counts extracted concerts that match a stored one exactly.
+	Unchanged int `json:"unchanged"`
+}
+
+// diffEntry is the comparable projection of a scraped or stored concert.
+type diffEntry struct {
+	Title     string `json:"title"`
+	Venue     string `json:"venue"`
+	LocalDate string `json:"local_date"`
+	StartTime string `json:"start_time,omitempty"`
+	OpenTime  string `json:"open_time,omitempty"`
+}
+
+// changedEntry is one concert present on both sides with differing fields.
+type changedEntry struct {
+	Stored    diffEntry `json:"stored"`
+	Extracted diffEntry `json:"extracted"`
+	// Fields names the differing diffEntry fields.
+	Fields []string `json:"fields"`
+}
+
+func scrapedEntry(s *entity.ScrapedConcert) diffEntry {
+	return diffEntry{
+		Title:     s.Title,
+		Venue:     strings.TrimSpace(s.ListedVenueName),
+		LocalDate: s.LocalDate.Format(time.DateOnly),
+		StartTime: entity.StartKey(entity.NullableTime(s.StartTime)),
+		OpenTime:  entity.StartKey(entity.NullableTime(s.OpenTime)),
+	}
+}
+
+func storedEntry(c *entity.Concert) diffEntry {
+	e := diffEntry{
+		LocalDate: c.LocalDate.Format(time.DateOnly),
+		StartTime: entity.StartKey(c.StartTime),
+		OpenTime:  entity.StartKey(c.OpenTime),
+	}
+	if c.Series != nil {
+		e.Title = c.Series.Title
+	}
+	if c.ListedVenueName != nil {
+		e.Venue = strings.TrimSpace(*c.ListedVenueName)
+	}
+	return e
+}
+
+// diffConcerts compares extracted against stored concerts. Concerts are
+// paired in two passes, mirroring the events natural key
+// (venue, local date, start time) that ScrapedConcerts.FilterNew uses:
+// first on the full key, then leftovers on (date, venue) alone so a show
+// whose start time was announced or moved reports as changed rather than as
+// one new plus one missing concert. Venues compare on the listed name, since
+// extracted concerts are not venue-resolved.
+func diffConcerts(extracted []*entity.ScrapedConcert, stored []*entity.Concert) *diffReport {
+	report := &diffReport{New: []diffEntry{}, Missing: []diffEntry{}, Changed: []changedEntry{}}
+
+	storedEntries := make([]diffEntry, len(stored))
+	for i, c := range stored {
+		storedEntries[i] = storedEntry(c)
+	}
+	extractedEntries := make([]diffEntry, len(extracted))
+	for i, s := range extracted {
+		extractedEntries[i] = scrapedEntry(s)
+	}
+
+	matched := make([]bool, len(storedEntries))
+	pair := make([]int, len(extractedEntries))
+	for i := range pair {
+		pair[i] = -1
+	}
+	match := func(same func(a, b diffEntry) bool) {
+		for i, e := range extractedEntries {
+			if pair[i] >= 0 {
+				continue
+			}
+			for j, s := range storedEntries {
+				if !matched[j] && same(e, s) {
+					pair[i], matched[j] = j, true
+					break
+				}
+			}
+		}
+	}
+	match(func(a, b diffEntry) bool {
+		return a.LocalDate == b.LocalDate && a.Venue == b.Venue && a.StartTime == b.StartTime
+	})
+	match(func(a, b diffEntry) bool {
+		return a.LocalDate == b.LocalDate && a.Venue == b.Venue
+	})
+
+	for i, e := range extractedEntries {
+		if pair[i] < 0 {
+			report.New = append(report.New, e)
+			continue
+		}
+		s := storedEntries[pair[i]]
+		if fields := changedFields(s, e); len(fields) > 0 {
+			report.Changed = append(report.Changed, changedEntry{Stored: s, Extracted: e, Fields: fields})
+			continue
+		}
+		report.Unchanged++
+	}
+	for j, s := range storedEntries {
+		if !matched[j] {
+			report.Missing = append(report.Missing, s)
+		}
+	}
+	return report
+}
+
+// changedFields lists the fields that differ between a paired stored and
+// extracted concert. Date and venue always match by construction.
+func changedFields(stored, extracted diffEntry) []string {
+	var fields []string
+	if stored.Title != extracted.Title {
+		fields = append(fields, "title")
+	}
+	if stored.StartTime != extracted.StartTime {
+		fields = append(fields, "start_time")
+	}
+	if stored.OpenTime != extracted.OpenTime {
+		fields = append(fields, "open_time")
+	}
+	return fields
+}
+
+// validate runs the extraction for opts.artistID and writes the diff against
+// the artist's stored upcoming concerts to w as indented JSON.
+func validate(ctx context.Context, opts *options, s searcher, artists artistReader, concerts concertLister, w io.Writer) error {
+	artist, sites, err := resolveTarget(ctx, opts, artists)
+	if err != nil {
+		return err
+	}
+
+	stored, err := concerts.ListByArtist(ctx, artist.ID, true)
+	if err != nil {
+		return fmt.Errorf("list stored concerts: %w", err)
+	}
+
+	extracted, _, err := s.SearchExt(ctx, artist, sites, opts.from)
+	if err != nil {
+		return fmt.Errorf("search concerts for %q: %w", artist.Name, err)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diffConcerts(postProcess(extracted, opts), stored))
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadExtractedFixture reads testdata/diff_extracted.json: four extracted
+// concerts, one per diff outcome against storedFixture.
+func loadExtractedFixture(t *testing.T) []*entity.ScrapedConcert {
+	t.Helper()
+	b, err := os.ReadFile("testdata/diff_extracted.json")
+	require.NoError(t, err)
+	var out []*entity.ScrapedConcert
+	require.NoError(t, json.Unmarshal(b, &out))
+	return out
+}
+
+func storedConcert(title, venue, date string, start *time.Time) *entity.Concert {
+	d, _ := time.Parse(time.DateOnly, date)
+	return &entity.Concert{
+		Event: entity.Event{
+			ListedVenueName: &venue,
+			LocalDate:       d,
+			StartTime:       start,
+		},
+		Series: &entity.Series{Title: title},
+	}
+}
+
+func ptrTime(s string) *time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return &t
+}
+
+// storedFixture is the stored side of the diff fixture:
+//   - Zepp Haneda: identical to the extraction (unchanged).
+//   - Zepp Osaka Bayside: start time moved from 09:00Z to 10:00Z (changed).
+//   - Nippon Budokan: title gained "FINAL" (changed).
+//   - Yokohama Arena: no longer returned by the extraction (missing).
+//
+// Billboard Live Tokyo is only in the extraction (new).
+func storedFixture() []*entity.Concert {
+	return []*entity.Concert{
+		storedConcert("Test Artist Tour 2026", "Zepp Haneda", "2026-11-01", ptrTime("2026-11-01T09:00:00Z")),
+		storedConcert("Test Artist Tour 2026", "Zepp Osaka Bayside", "2026-11-08", ptrTime("2026-11-08T09:00:00Z")),
+		storedConcert("Test Artist Tour 2026", "Nippon Budokan", "2026-12-20", ptrTime("2026-12-20T09:00:00Z")),
+		storedConcert("Test Artist Tour 2026", "Yokohama Arena", "2026-11-22", nil),
+	}
+}
+
+func TestDiffConcerts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fixture reports new, missing and changed concerts", func(t *testing.T) {
+		t.Parallel()
+		got := diffConcerts(loadExtractedFixture(t), storedFixture())
+
+		assert.Equal(t, 1, got.Unchanged)
+		assert.Equal(t, []diffEntry{
+			{Title: "Test Artist Acoustic Night", Venue: "Billboard Live Tokyo", LocalDate: "2027-01-15"},
+		}, got.New)
+		assert.Equal(t, []diffEntry{
+			{Title: "Test Artist Tour 2026", Venue: "Yokohama Arena", LocalDate: "2026-11-22"},
+		}, got.Missing)
+		require.Len(t, got.Changed, 2)
+		assert.Equal(t, "Zepp Osaka Bayside", got.Changed[0].Stored.Venue)
+		assert.Equal(t, []string{"start_time"}, got.Changed[0].Fields)
+		assert.Equal(t, "2026-11-08T10:00:00Z", got.Changed[0].Extracted.StartTime)
+		assert.Equal(t, "Nippon Budokan", got.Changed[1].Stored.Venue)
+		assert.Equal(t, []string{"title"}, got.Changed[1].Fields)
+	})
+
+	t.Run("two nights at the same venue pair by start time", func(t *testing.T) {
+		t.Parallel()
+		day := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+		extracted := []*entity.ScrapedConcert{
+			{Title: "Tour", ListedVenueName: "Zepp Haneda", LocalDate: day, StartTime: *ptrTime("2026-11-01T09:00:00Z")},
+			{Title: "Tour", ListedVenueName: "Zepp Haneda", LocalDate: day, StartTime: *ptrTime("2026-11-01T04:00:00Z")},
+		}
+		stored := []*entity.Concert{
+			storedConcert("Tour", "Zepp Haneda", "2026-11-01", ptrTime("2026-11-01T04:00:00Z")),
+			storedConcert("Tour", "Zepp Haneda", "2026-11-01", ptrTime("2026-11-01T09:00:00Z")),
+		}
+
+		got := diffConcerts(extracted, stored)
+
+		assert.Equal(t, 2, got.Unchanged)
+		assert.Empty(t, got.New)
+		assert.Empty(t, got.Missing)
+		assert.Empty(t, got.Changed)
+	})
+
+	t.Run("empty sides", func(t *testing.T) {
+		t.Parallel()
+		got := diffConcerts(nil, nil)
+
+		b, err := json.Marshal(got)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"new": [], "missing": [], "changed": [], "unchanged": 0}`, string(b))
+	})
+}
+
+// fakeConcerts serves a fixed stored concert list.
+type fakeConcerts struct {
+	concerts []*entity.Concert
+	gotID    string
+}
+
+func (f *fakeConcerts) ListByArtist(_ context.Context, artistID string, _ bool) ([]*entity.Concert, error) {
+	f.gotID = artistID
+	return f.concerts, nil
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	artists := &fakeArtists{artist: &entity.Artist{ID: "artist-1", Name: "Test Artist"}}
+	concerts := &fakeConcerts{concerts: storedFixture()}
+	s := &fakeSearcher{concerts: loadExtractedFixture(t)}
+	var out bytes.Buffer
+
+	require.NoError(t, validate(context.Background(), &options{artistID: "artist-1", validate: true}, s, artists, concerts, &out))
+
+	assert.Equal(t, "artist-1", concerts.gotID)
+	var got diffReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Len(t, got.New, 1)
+	assert.Len(t, got.Missing, 1)
+	assert.Len(t, got.Changed, 2)
+	assert.Equal(t, 1, got.Unchanged)
+}
@@ -0,0 +1,52 @@
+package event
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertReminderConsumer handles CONCERT.reminder_due events by delegating
+// to the delivery use case. It is a thin adapter: parse the CloudEvent and hand
+// off to the use case — no repository lookups or business logic here.
+type ConcertReminderConsumer struct {
+	deliveryUC usecase.ConcertReminderDeliveryUseCase
+	logger     *logging.Logger
+}
+
+// NewConcertReminderConsumer creates a new ConcertReminderConsumer.
+func NewConcertReminderConsumer(
+	deliveryUC usecase.ConcertReminderDeliveryUseCase,
+	logger *logging.Logger,
+) *ConcertReminderConsumer {
+	return &ConcertReminderConsumer{
+		deliveryUC: deliveryUC,
+		logger:     logger,
+	}
+}
+
+// Handle processes a CONCERT.reminder_due event by delegating to the delivery use case.
+func (h *ConcertReminderConsumer) Handle(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var data entity.ConcertReminderDueData
+	if err := messaging.ParseCloudEventData(msg, &data); err != nil {
+		h.logger.Error(ctx, "concert_reminder_consumer: failed to parse event", err)
+		return fmt.Errorf("parse CONCERT.reminder_due: %w", err)
+	}
+
+	h.logger.Info(ctx, "concert_reminder_consumer: processing",
+		slog.String("user_id", data.UserID),
+		slog.String("event_id", data.EventID),
+	)
+
+	if err := h.deliveryUC.DeliverReminder(ctx, data); err != nil {
+		return fmt.Errorf("concert_reminder_consumer: deliver reminder: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package event_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/adapter/event"
+	"github.com/liverty-music/backend/internal/entity"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcertReminderConsumer_Handle(t *testing.T) {
+	t.Parallel()
+
+	validData := entity.ConcertReminderDueData{
+		UserID:  "user-001",
+		EventID: "event-001",
+		Payload: entity.NewNotificationPayload("Concert Tomorrow", "Autumn Tour", "/dashboard", "concert-reminder-event-001"),
+	}
+	makeMsg := func(t *testing.T, data entity.ConcertReminderDueData) *message.Message {
+		t.Helper()
+		payload, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := message.NewMessage("test-id", payload)
+		msg.SetContext(context.Background())
+		return msg
+	}
+
+	t.Run("delegates to use case on success", func(t *testing.T) {
+		t.Parallel()
+
+		uc := ucmocks.NewMockConcertReminderDeliveryUseCase(t)
+		uc.On("DeliverReminder", context.Background(), validData).Return(nil)
+
+		handler := event.NewConcertReminderConsumer(uc, newTestLogger(t))
+		require.NoError(t, handler.Handle(makeMsg(t, validData)))
+	})
+
+	t.Run("returns error when use case fails", func(t *testing.T) {
+		t.Parallel()
+
+		uc := ucmocks.NewMockConcertReminderDeliveryUseCase(t)
+		uc.On("DeliverReminder", context.Background(), validData).
+			Return(fmt.Errorf("db unavailable"))
+
+		handler := event.NewConcertReminderConsumer(uc, newTestLogger(t))
+		assert.Error(t, handler.Handle(makeMsg(t, validData)))
+	})
+
+	t.Run("returns error on invalid payload", func(t *testing.T) {
+		t.Parallel()
+
+		uc := ucmocks.NewMockConcertReminderDeliveryUseCase(t)
+		handler := event.NewConcertReminderConsumer(uc, newTestLogger(t))
+
+		msg := message.NewMessage("bad-id", []byte("not json"))
+		msg.SetContext(context.Background())
+		assert.Error(t, handler.Handle(msg))
+	})
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertRescheduleConsumer handles CONCERT.rescheduled events by delegating
+// to the reschedule notice use case. It is a thin adapter: parse the
+// CloudEvent and hand off to the use case — no repository lookups or business
+// logic here.
+type ConcertRescheduleConsumer struct {
+	noticeUC usecase.ConcertRescheduleNoticeUseCase
+	logger   *logging.Logger
+}
+
+// NewConcertRescheduleConsumer creates a new ConcertRescheduleConsumer.
+func NewConcertRescheduleConsumer(
+	noticeUC usecase.ConcertRescheduleNoticeUseCase,
+	logger *logging.Logger,
+) *ConcertRescheduleConsumer {
+	return &ConcertRescheduleConsumer{
+		noticeUC: noticeUC,
+		logger:   logger,
+	}
+}
+
+// Handle processes a CONCERT.rescheduled event by notifying the event's ticket holders.
+func (h *ConcertRescheduleConsumer) Handle(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var data entity.ConcertRescheduledData
+	if err := messaging.ParseCloudEventData(msg, &data); err != nil {
+		h.logger.Error(ctx, "concert_reschedule_consumer: failed to parse event", err)
+		return fmt.Errorf("parse CONCERT.rescheduled: %w", err)
+	}
+
+	h.logger.Info(ctx, "concert_reschedule_consumer: processing",
+		slog.String("event_id", data.EventID),
+		slog.String("local_date", data.LocalDate.Format("2006-01-02")),
+	)
+
+	if err := h.noticeUC.NotifyTicketHolders(ctx, data); err != nil {
+		return fmt.Errorf("concert_reschedule_consumer: notify ticket holders: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package event_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/adapter/event"
+	"github.com/liverty-music/backend/internal/entity"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcertRescheduleConsumer_Handle(t *testing.T) {
+	t.Parallel()
+
+	validData := entity.ConcertRescheduledData{
+		EventID:           "event-001",
+		ArtistID:          "artist-001",
+		Title:             "Autumn Tour",
+		ListedVenueName:   "Zepp Haneda",
+		PreviousLocalDate: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		LocalDate:         time.Date(2026, 11, 8, 0, 0, 0, 0, time.UTC),
+	}
+	makeMsg := func(t *testing.T, data entity.ConcertRescheduledData) *message.Message {
+		t.Helper()
+		payload, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := message.NewMessage("test-id", payload)
+		msg.SetContext(context.Background())
+		return msg
+	}
+
+	t.Run("delegates to use case on success", func(t *testing.T) {
+		t.Parallel()
+
+		uc := ucmocks.NewMockConcertRescheduleNoticeUseCase(t)
+		uc.On("NotifyTicketHolders", context.Background(), validData).Return(nil)
+
+		handler := event.NewConcertRescheduleConsumer(uc, newTestLogger(t))
+		require.NoError(t, handler.Handle(makeMsg(t, validData)))
+	})
+
+	t.Run("returns error when use case fails", func(t *testing.T) {
+		t.Parallel()
+
+		uc := ucmocks.NewMockConcertRescheduleNoticeUseCase(t)
+		uc.On("NotifyTicketHolders", context.Background(), validData).
+			Return(fmt.Errorf("db unavailable"))
+
+		handler := event.NewConcertRescheduleConsumer(uc, newTestLogger(t))
+		assert.Error(t, handler.Handle(makeMsg(t, validData)))
+	})
+
+	t.Run("returns error on invalid payload", func(t *testing.T) {
+		t.Parallel()
+
+		uc := ucmocks.NewMockConcertRescheduleNoticeUseCase(t)
+		handler := event.NewConcertRescheduleConsumer(uc, newTestLogger(t))
+
+		msg := message.NewMessage("bad-id", []byte("not json"))
+		msg.SetContext(context.Background())
+		assert.Error(t, handler.Handle(msg))
+	})
+}
@@ -0,0 +1,64 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// VenueConsumer handles VENUE.enrichment_requested events by delegating
+// place resolution to the VenueEnrichmentUseCase.
+type VenueConsumer struct {
+	enrichmentUC usecase.VenueEnrichmentUseCase
+	logger       *logging.Logger
+}
+
+// NewVenueConsumer creates a new VenueConsumer.
+func NewVenueConsumer(
+	enrichmentUC usecase.VenueEnrichmentUseCase,
+	logger *logging.Logger,
+) *VenueConsumer {
+	return &VenueConsumer{
+		enrichmentUC: enrichmentUC,
+		logger:       logger,
+	}
+}
+
+// Handle processes a VENUE.enrichment_requested event by resolving the newly
+// created venue to its canonical place. A place search outage is
+// acknowledged rather than redelivered into the dead-letter queue: the use
+// case has marked the venue retryable, and the venue enrichment retry job
+// picks it up once the search recovers.
+func (h *VenueConsumer) Handle(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var data entity.VenueEnrichmentRequestedData
+	if err := messaging.ParseCloudEventData(msg, &data); err != nil {
+		return fmt.Errorf("parse VENUE.enrichment_requested event: %w", err)
+	}
+
+	h.logger.Info(ctx, "processing VENUE.enrichment_requested event",
+		slog.String("venue_id", data.VenueID),
+		slog.String("listed_venue_name", data.ListedVenueName),
+	)
+
+	if err := h.enrichmentUC.EnrichVenue(ctx, data.VenueID); err != nil {
+		if errors.Is(err, apperr.ErrUnavailable) || errors.Is(err, apperr.ErrDeadlineExceeded) {
+			h.logger.Warn(ctx, "place search unavailable; venue left to the enrichment retry job",
+				slog.String("venue_id", data.VenueID),
+				slog.Any("error", err),
+			)
+			return nil
+		}
+		return fmt.Errorf("handle VENUE.enrichment_requested event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package event_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/adapter/event"
+	"github.com/liverty-music/backend/internal/entity"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeVenueEnrichmentRequestedMsg(t *testing.T, data entity.VenueEnrichmentRequestedData) *message.Message {
+	t.Helper()
+	payload, err := json.Marshal(data)
+	require.NoError(t, err)
+	return message.NewMessage("test-id", payload)
+}
+
+func TestVenueConsumer_Handle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delegates to use case", func(t *testing.T) {
+		t.Parallel()
+
+		enrichmentUC := ucmocks.NewMockVenueEnrichmentUseCase(t)
+		handler := event.NewVenueConsumer(enrichmentUC, newTestLogger(t))
+
+		enrichmentUC.EXPECT().EnrichVenue(anyCtx, "venue-1").Return(nil).Once()
+
+		msg := makeVenueEnrichmentRequestedMsg(t, entity.VenueEnrichmentRequestedData{
+			VenueID:         "venue-1",
+			ListedVenueName: "Zepp Haneda",
+		})
+
+		err := handler.Handle(msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when use case fails", func(t *testing.T) {
+		t.Parallel()
+
+		enrichmentUC := ucmocks.NewMockVenueEnrichmentUseCase(t)
+		handler := event.NewVenueConsumer(enrichmentUC, newTestLogger(t))
+
+		enrichmentUC.EXPECT().EnrichVenue(anyCtx, "venue-2").Return(fmt.Errorf("places unavailable")).Once()
+
+		msg := makeVenueEnrichmentRequestedMsg(t, entity.VenueEnrichmentRequestedData{
+			VenueID:         "venue-2",
+			ListedVenueName: "Budokan",
+		})
+
+		err := handler.Handle(msg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "handle VENUE.enrichment_requested event")
+	})
+
+	t.Run("acknowledges a place search outage left to the retry job", func(t *testing.T) {
+		t.Parallel()
+
+		enrichmentUC := ucmocks.NewMockVenueEnrichmentUseCase(t)
+		handler := event.NewVenueConsumer(enrichmentUC, newTestLogger(t))
+
+		enrichmentUC.EXPECT().EnrichVenue(anyCtx, "venue-3").Return(fmt.Errorf("search place: %w", apperr.ErrUnavailable)).Once()
+
+		msg := makeVenueEnrichmentRequestedMsg(t, entity.VenueEnrichmentRequestedData{
+			VenueID:         "venue-3",
+			ListedVenueName: "Budokan",
+		})
+
+		assert.NoError(t, handler.Handle(msg))
+	})
+
+	t.Run("returns error on invalid payload", func(t *testing.T) {
+		t.Parallel()
+
+		enrichmentUC := ucmocks.NewMockVenueEnrichmentUseCase(t)
+		handler := event.NewVenueConsumer(enrichmentUC, newTestLogger(t))
+
+		msg := message.NewMessage("bad-id", []byte("not json"))
+		err := handler.Handle(msg)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "parse VENUE.enrichment_requested event")
+	})
+}
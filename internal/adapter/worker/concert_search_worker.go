@@ -0,0 +1,92 @@
+// Package worker provides long-running background workers hosted by the API
+// server process.
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/throttle"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertSearchWorker drains the background concert search queue. Searches run
+// one at a time, spaced by the throttler interval so a burst of onboarding
+// users cannot fan out into parallel Gemini calls. When the queue is empty the
+// worker sleeps for the poll interval before checking again.
+//
+// The worker starts on construction; Close stops it and blocks until the
+// in-flight search (if any) has returned its task to the queue.
+type ConcertSearchWorker struct {
+	queue        usecase.ConcertSearchQueueUseCase
+	throttler    *throttle.Throttler
+	pollInterval time.Duration
+	logger       *logging.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewConcertSearchWorker creates and starts a worker that processes at most
+// one search task every interval, polling every pollInterval while idle.
+func NewConcertSearchWorker(
+	queue usecase.ConcertSearchQueueUseCase,
+	interval time.Duration,
+	pollInterval time.Duration,
+	logger *logging.Logger,
+) *ConcertSearchWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	w := &ConcertSearchWorker{
+		queue:        queue,
+		throttler:    throttle.New(interval, 1),
+		pollInterval: pollInterval,
+		logger:       logger,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+
+	go w.run(ctx)
+
+	return w
+}
+
+// Close stops the worker and waits for it to exit.
+func (w *ConcertSearchWorker) Close() error {
+	w.cancel()
+	<-w.done
+	w.throttler.Close()
+	return nil
+}
+
+func (w *ConcertSearchWorker) run(ctx context.Context) {
+	defer close(w.done)
+
+	for {
+		var processed bool
+		err := w.throttler.Do(ctx, func() error {
+			var err error
+			processed, err = w.queue.ProcessNext(ctx)
+			return err
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			// The failed task is already back in the queue; log and move on
+			// so one bad artist does not stall the rest.
+			w.logger.Warn(ctx, "concert search task failed", slog.Any("error", err))
+		}
+		if processed {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package worker_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/adapter/worker"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(t *testing.T) *logging.Logger {
+	t.Helper()
+	logger, err := logging.New()
+	require.NoError(t, err)
+	return logger
+}
+
+func TestConcertSearchWorker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("drains queued tasks and keeps going after a failure", func(t *testing.T) {
+		t.Parallel()
+		queue := ucmocks.NewMockConcertSearchQueueUseCase(t)
+
+		var idlePolls atomic.Int32
+		queue.EXPECT().ProcessNext(mock.Anything).Return(true, apperr.ErrDeadlineExceeded).Once()
+		queue.EXPECT().ProcessNext(mock.Anything).Return(true, nil).Once()
+		queue.EXPECT().ProcessNext(mock.Anything).RunAndReturn(func(context.Context) (bool, error) {
+			idlePolls.Add(1)
+			return false, nil
+		})
+
+		w := worker.NewConcertSearchWorker(queue, time.Millisecond, time.Millisecond, newTestLogger(t))
+
+		assert.Eventually(t, func() bool { return idlePolls.Load() > 0 }, 5*time.Second, time.Millisecond)
+		require.NoError(t, w.Close())
+	})
+
+	t.Run("close cancels an in-flight search", func(t *testing.T) {
+		t.Parallel()
+		queue := ucmocks.NewMockConcertSearchQueueUseCase(t)
+
+		started := make(chan struct{})
+		queue.EXPECT().ProcessNext(mock.Anything).RunAndReturn(func(ctx context.Context) (bool, error) {
+			close(started)
+			<-ctx.Done()
+			return true, ctx.Err()
+		}).Once()
+
+		w := worker.NewConcertSearchWorker(queue, time.Millisecond, time.Hour, newTestLogger(t))
+
+		<-started
+		require.NoError(t, w.Close())
+	})
+}
@@ -0,0 +1,94 @@
+package di
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/liverty-music/backend/pkg/telemetry"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertRemindersJobApp is the dependency bundle for the concert-reminders
+// CronJob. The job scans tracked concerts for reminders that became due on
+// each user's lead time and publishes CONCERT.reminder_due events for each
+// (user, event) pair not yet sent.
+type ConcertRemindersJobApp struct {
+	ConcertReminderUC usecase.ConcertReminderUseCase
+	Logger            *logging.Logger
+	ShutdownTimeout   time.Duration
+}
+
+// InitializeConcertRemindersJobApp wires the concert-reminders scan job.
+func InitializeConcertRemindersJobApp(ctx context.Context) (*ConcertRemindersJobApp, error) {
+	cfg, err := config.Load[config.JobConfig]()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger, err := provideLogger(cfg.BaseConfig)
+	if err != nil {
+		return nil, err
+	}
+	slog.SetDefault(logger.Slog())
+
+	db, err := rdb.New(ctx, cfg.Database, cfg.IsLocal(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryCloser, err := telemetry.SetupTelemetry(ctx, cfg.Telemetry, cfg.Environment, cfg.ShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Repositories
+	reminderRepo := rdb.NewConcertReminderRepository(db)
+
+	// Messaging
+	//
+	// Fail fast in non-local environments: a missing NATS_URL would silently
+	// route published events to an in-process GoChannel that nothing consumes,
+	// dropping every event. Local development still falls back to the
+	// in-process GoChannel below.
+	if !cfg.IsLocal() && cfg.NATS.URL == "" {
+		return nil, fmt.Errorf("NATS_URL is required for the concert-reminders job in non-local environments")
+	}
+	if err := messaging.EnsureStreams(ctx, cfg.NATS); err != nil {
+		return nil, fmt.Errorf("ensure NATS streams: %w", err)
+	}
+	wmLogger := watermill.NewSlogLogger(logger.Slog())
+	var goChannel *gochannel.GoChannel
+	if cfg.NATS.URL == "" {
+		goChannel = gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: 256}, wmLogger)
+	}
+	publisher, err := messaging.NewPublisher(cfg.NATS, wmLogger, goChannel)
+	if err != nil {
+		return nil, fmt.Errorf("create messaging publisher: %w", err)
+	}
+	eventPublisher := messaging.NewEventPublisher(publisher)
+
+	concertReminderUC := usecase.NewConcertReminderUseCase(reminderRepo, eventPublisher, logger)
+
+	shutdown.Init(logger)
+	shutdown.AddFlushPhase(publisher)
+	shutdown.AddObservePhase(telemetryCloser)
+	shutdown.AddDatastorePhase(db)
+
+	return &ConcertRemindersJobApp{
+		ConcertReminderUC: concertReminderUC,
+		Logger:            logger,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+	}, nil
+}
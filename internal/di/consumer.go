@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill"
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"golang.org/x/oauth2/google"
 
@@ -34,13 +33,16 @@ import (
 
 // ConsumerApp represents the event consumer application with a Watermill Router.
 type ConsumerApp struct {
-	Router          *message.Router
+	Router          *messaging.Router
 	Logger          *logging.Logger
 	ShutdownTimeout time.Duration
 	// Health reflects whether the consumer is actively consuming (NATS
 	// connected + all durables bound + router running). The entry point wires
 	// it into the liveness probe so a wedged pod is restarted.
 	Health *messaging.ConsumerHealth
+	// PoolStats reports the database connection pool's usage for the health
+	// server's /statz/db-pool endpoint.
+	PoolStats func() rdb.PoolStats
 }
 
 // InitializeConsumerApp creates a ConsumerApp with all event handler dependencies wired.
@@ -54,7 +56,7 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 		return nil, err
 	}
 
-	logger, err := provideLogger(cfg.Logging)
+	logger, err := provideLogger(cfg.BaseConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +66,11 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Open connections before traffic arrives. A partial warmup is logged and
+	// otherwise harmless; only a cancelled startup aborts.
+	if _, err := db.Warmup(ctx, cfg.Database.WarmupConns); err != nil && ctx.Err() != nil {
+		return nil, err
+	}
 
 	telemetryCloser, err := telemetry.SetupTelemetry(ctx, cfg.Telemetry, cfg.Environment, cfg.ShutdownTimeout)
 	if err != nil {
@@ -77,7 +84,11 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 	followRepo := rdb.NewFollowRepository(db)
 	ticketJourneyRepo := rdb.NewTicketJourneyRepository(db)
 	salesReminderRepo := rdb.NewSalesPhaseReminderRepository(db)
+	concertReminderRepo := rdb.NewConcertReminderRepository(db)
 	userRepo := rdb.NewUserRepository(db)
+	ticketRepo := rdb.NewTicketRepository(db)
+	venueRepo := rdb.NewVenueRepository(db)
+	processedMessageRepo := rdb.NewProcessedMessageRepository(db)
 
 	// Infrastructure - Messaging
 	if err := messaging.EnsureStreams(ctx, cfg.NATS); err != nil {
@@ -124,7 +135,7 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 
 	// Infrastructure - MusicBrainz (for artist name resolution)
 	extHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
-	musicbrainzClient := musicbrainz.NewClient(extHTTPClient, logger)
+	musicbrainzClient := musicbrainz.NewClient(extHTTPClient, musicbrainz.NewRateLimiter(cfg.MusicBrainzRPS), logger)
 
 	// Infrastructure - fanart.tv (for artist image resolution)
 	fanarttvClient := fanarttv.NewClient(cfg.FanartTVAPIKey, extHTTPClient, logger)
@@ -145,9 +156,11 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 		logger,
 	)
 	stagedConcertRepo := rdb.NewStagedConcertRepository(db)
-	concertCreationUC := usecase.NewConcertCreationUseCase(stagedConcertRepo, placeSearcher, logger)
+	seriesRepo := rdb.NewSeriesRepository(db)
+	concertCreationUC := usecase.NewConcertCreationUseCase(stagedConcertRepo, seriesRepo, concertRepo, placeSearcher, cfg.CreateVenuelessConcertsOnPlaceSearchError, logger)
 	artistNameResolutionUC := usecase.NewArtistNameResolutionUseCase(artistRepo, musicbrainzClient, logger)
 	artistImageSyncUC := usecase.NewArtistImageSyncUseCase(artistRepo, fanarttvClient, logoFetcher, logger)
+	venueEnrichmentUC := usecase.NewVenueEnrichmentUseCase(venueRepo, placeSearcher, musicbrainz.NewPlaceSearcher(musicbrainzClient), logger)
 
 	// Infrastructure - Zitadel API client (optional, nil in local dev).
 	var emailVerifier usecase.EmailVerifier
@@ -182,6 +195,17 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 		notificationUC,
 		logger,
 	)
+	concertReminderDeliveryUC := usecase.NewConcertReminderDeliveryUseCase(
+		concertReminderRepo,
+		notificationUC,
+		logger,
+	)
+	concertRescheduleNoticeUC := usecase.NewConcertRescheduleNoticeUseCase(
+		ticketRepo,
+		userRepo,
+		notificationUC,
+		logger,
+	)
 
 	// Event Consumers
 	concertConsumer := event.NewConcertConsumer(concertCreationUC, logger)
@@ -191,12 +215,14 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 	userConsumer := event.NewUserConsumer(emailVerifier, logger)
 	analyticsConsumerMetrics := infratelemetry.NewOTelAnalyticsConsumerMetrics()
 	analyticsConsumer := event.NewAnalyticsConsumer(analyticsClient, analyticsConsumerMetrics, logger)
-	poisonConsumer := event.NewPoisonConsumer(logger)
 	salesPhaseAnnouncementConsumer := event.NewSalesPhaseAnnouncementConsumer(salesPhaseAnnouncementUC, logger)
 	salesReminderConsumer := event.NewSalesReminderConsumer(salesReminderDeliveryUC, logger)
+	concertReminderConsumer := event.NewConcertReminderConsumer(concertReminderDeliveryUC, logger)
+	concertRescheduleConsumer := event.NewConcertRescheduleConsumer(concertRescheduleNoticeUC, logger)
+	venueConsumer := event.NewVenueConsumer(venueEnrichmentUC, logger)
 
 	// Router
-	router, err := messaging.NewRouter(wmLogger, publisher, messaging.PoisonQueueSubject)
+	router, err := messaging.NewRouter(wmLogger, publisher, cfg.ConsumerMaxRetries, cfg.ConsumerDeadLetterSuffix)
 	if err != nil {
 		return nil, fmt.Errorf("create messaging router: %w", err)
 	}
@@ -210,13 +236,21 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 	consumerHealth.SetRouterProbe(func() bool {
 		return !router.IsClosed()
 	})
+	// Readiness, in contrast, waits until the router has actually started
+	// consuming, so a rollout does not proceed on a pod that is not yet.
+	consumerHealth.SetRouterStartedProbe(func() bool {
+		return router.IsRunning() && !router.IsClosed()
+	})
 
+	// A redelivered concert.discovered batch must not resolve its venues and
+	// stage its concerts twice, so the handler records each message once it
+	// has been handled.
 	router.AddConsumerHandler(
 		"create-concerts",
 		entity.SubjectConcertDiscovered,
 		subscriber,
 		concertConsumer.Handle,
-	)
+	).AddMiddleware(messaging.Idempotent(processedMessageRepo, cfg.ConsumerDedupWindow, wmLogger))
 
 	router.AddConsumerHandler(
 		"notify-fans",
@@ -332,13 +366,6 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 		analyticsConsumer.HandleTicketEmailParsed,
 	)
 
-	router.AddConsumerHandler(
-		"log-poison-queue",
-		messaging.PoisonQueueSubject,
-		subscriber,
-		poisonConsumer.Handle,
-	)
-
 	router.AddConsumerHandler(
 		"announce-sales-phase",
 		entity.SubjectSalesPhaseDiscovered,
@@ -353,10 +380,30 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 		salesReminderConsumer.Handle,
 	)
 
+	router.AddConsumerHandler(
+		"send-concert-reminder",
+		entity.SubjectConcertReminderDue,
+		subscriber,
+		concertReminderConsumer.Handle,
+	)
+
+	router.AddConsumerHandler(
+		"notify-reschedule",
+		entity.SubjectConcertRescheduled,
+		subscriber,
+		concertRescheduleConsumer.Handle,
+	)
+
+	router.AddConsumerHandler(
+		"enrich-venue",
+		entity.SubjectVenueEnrichmentRequested,
+		subscriber,
+		venueConsumer.Handle,
+	)
+
 	// Register shutdown phases.
 	shutdown.Init(logger)
 	shutdown.AddFlushPhase(publisher)
-	shutdown.AddExternalPhase(musicbrainzClient)
 	shutdown.AddExternalPhase(fanarttvClient)
 	if analyticsClient != nil {
 		shutdown.AddExternalPhase(analyticsClient.(*posthog.AnalyticsClient))
@@ -368,6 +415,7 @@ func InitializeConsumerApp(ctx context.Context) (*ConsumerApp, error) {
 		Router:          router,
 		Logger:          logger,
 		ShutdownTimeout: cfg.ShutdownTimeout,
+		PoolStats:       db.PoolStats,
 		Health:          consumerHealth,
 	}, nil
 }
@@ -35,7 +35,7 @@ func InitializeImageSyncJobApp(ctx context.Context) (*ImageSyncJobApp, error) {
 		return nil, err
 	}
 
-	logger, err := provideLogger(cfg.Logging)
+	logger, err := provideLogger(cfg.BaseConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -16,11 +16,11 @@ import (
 	"github.com/liverty-music/backend/internal/infrastructure/messaging"
 	infratelemetry "github.com/liverty-music/backend/internal/infrastructure/telemetry"
 	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/cache"
 	"github.com/liverty-music/backend/pkg/config"
 	"github.com/liverty-music/backend/pkg/shutdown"
 	"github.com/liverty-music/backend/pkg/telemetry"
 	"github.com/pannpers/go-logging/logging"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // JobApp represents a lightweight application for batch jobs without an HTTP server.
@@ -29,6 +29,9 @@ import (
 type JobApp struct {
 	FollowRepo      entity.FollowRepository
 	ConcertUC       usecase.ConcertUseCase
+	PrewarmUC       usecase.ConcertPrewarmUseCase
+	DiscoveryUC     usecase.ConcertDiscoveryUseCase
+	PrewarmLimit    int
 	Logger          *logging.Logger
 	ShutdownTimeout time.Duration
 }
@@ -44,7 +47,7 @@ func InitializeJobApp(ctx context.Context) (*JobApp, error) {
 		return nil, err
 	}
 
-	logger, err := provideLogger(cfg.Logging)
+	logger, err := provideLogger(cfg.BaseConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +65,7 @@ func InitializeJobApp(ctx context.Context) (*JobApp, error) {
 
 	// Repositories
 	artistRepo := rdb.NewArtistRepository(db)
+	userRepo := rdb.NewUserRepository(db)
 	followRepo := rdb.NewFollowRepository(db)
 	concertRepo := rdb.NewConcertRepository(db)
 	venueRepo := rdb.NewVenueRepository(db)
@@ -71,17 +75,24 @@ func InitializeJobApp(ctx context.Context) (*JobApp, error) {
 	rejectedConcertRepo := rdb.NewRejectedConcertLogRepository(db)
 
 	// Infrastructure - Gemini
+	//
+	// The searcher reports each search's token usage into the tally the
+	// discovery use case logs per artist and at completion.
+	tokenUsage := usecase.NewTokenUsageTally()
 	var geminiSearcher entity.ConcertSearcher
 	if cfg.GCP.GeminiSearchAPIKey != "" {
-		geminiHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+		geminiHTTPClient := newGeminiHTTPClient(http.DefaultTransport)
 		searcher, err := gemini.NewConcertSearcher(ctx, gemini.Config{
-			APIKey:          cfg.GCP.GeminiSearchAPIKey,
-			ModelExtract:    cfg.GCP.SearchModelExtract(),
-			ModelParse:      cfg.GCP.SearchModelParse(),
-			Temperature:     cfg.GCP.GeminiSearchTemperature,
-			ThinkingLevel:   cfg.GCP.GeminiSearchThinkingLevel,
-			ThinkingExtract: cfg.GCP.GeminiSearchThinkingExtract,
-			ThinkingParse:   cfg.GCP.GeminiSearchThinkingParse,
+			APIKey:             cfg.GCP.GeminiSearchAPIKey,
+			ModelExtract:       cfg.GCP.SearchModelExtract(),
+			ModelParse:         cfg.GCP.SearchModelParse(),
+			Temperature:        cfg.GCP.GeminiSearchTemperature,
+			ThinkingLevel:      cfg.GCP.GeminiSearchThinkingLevel,
+			ThinkingExtract:    cfg.GCP.GeminiSearchThinkingExtract,
+			ThinkingParse:      cfg.GCP.GeminiSearchThinkingParse,
+			MaxEventsPerArtist: cfg.GCP.GeminiSearchMaxEventsPerArtist,
+			DiscoverFestivals:  cfg.GCP.GeminiSearchDiscoverFestivals,
+			OnTokenUsage:       tokenUsage.Record,
 		}, geminiHTTPClient, logger)
 		if err != nil {
 			return nil, err
@@ -117,10 +128,16 @@ func InitializeJobApp(ctx context.Context) (*JobApp, error) {
 	// Use Cases
 	eventPublisher := messaging.NewEventPublisher(publisher)
 	centroidResolver := geo.NewCentroidResolver()
-	concertUC := usecase.NewConcertUseCase(artistRepo, concertRepo, venueRepo, seriesRepo, searchLogRepo, stagedConcertRepo, rejectedConcertRepo, geminiSearcher, centroidResolver, eventPublisher, infratelemetry.NewBusinessMetrics(), cfg.GCP.SearchCacheTTL(), cfg.GCP.SearchDiscoveryWindow(), logger)
+	// The job never reads follower feeds; the cache only satisfies the
+	// concert use case's dependency.
+	followerFeedCache := cache.NewMemoryCache(2 * time.Minute)
+	concertUC := usecase.NewConcertUseCase(artistRepo, userRepo, concertRepo, venueRepo, seriesRepo, searchLogRepo, followRepo, stagedConcertRepo, rejectedConcertRepo, geminiSearcher, centroidResolver, eventPublisher, infratelemetry.NewBusinessMetrics(), followerFeedCache, newSearchFreshness(cfg.GCP), cfg.GCP.SearchDiscoveryWindow(), cfg.GCP.GeminiSearchTimeout, newConcertSearchBreaker(), logger)
+	prewarmUC := usecase.NewConcertPrewarmUseCase(followRepo, concertUC, cfg.GCP.SearchQueueInterval(), logger)
+	discoveryUC := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, tokenUsage, cfg.DiscoveryConcurrency, logger)
 
 	// Register shutdown phases.
 	shutdown.Init(logger)
+	shutdown.AddDrainPhase(followerFeedCache)
 	shutdown.AddFlushPhase(publisher)
 	shutdown.AddObservePhase(telemetryCloser)
 	shutdown.AddDatastorePhase(db)
@@ -128,6 +145,9 @@ func InitializeJobApp(ctx context.Context) (*JobApp, error) {
 	return &JobApp{
 		FollowRepo:      followRepo,
 		ConcertUC:       concertUC,
+		PrewarmUC:       prewarmUC,
+		DiscoveryUC:     discoveryUC,
+		PrewarmLimit:    cfg.GCP.SearchPrewarmArtistLimit(),
 		Logger:          logger,
 		ShutdownTimeout: cfg.ShutdownTimeout,
 	}, nil
@@ -15,7 +15,6 @@ import (
 	"github.com/liverty-music/backend/pkg/shutdown"
 	"github.com/liverty-music/backend/pkg/telemetry"
 	"github.com/pannpers/go-logging/logging"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // MerchDiscoveryJobApp is the dependency bundle for the merch-url discovery
@@ -41,7 +40,7 @@ func InitializeMerchDiscoveryJobApp(ctx context.Context) (*MerchDiscoveryJobApp,
 		return nil, err
 	}
 
-	logger, err := provideLogger(cfg.Logging)
+	logger, err := provideLogger(cfg.BaseConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +66,7 @@ func InitializeMerchDiscoveryJobApp(ctx context.Context) (*MerchDiscoveryJobApp,
 		Model:         cfg.GCP.MerchModel(),
 		Temperature:   cfg.GCP.GeminiSearchTemperature,
 		ThinkingLevel: cfg.GCP.MerchThinking(),
-	}, &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}, logger)
+	}, newGeminiHTTPClient(http.DefaultTransport), logger)
 	if err != nil {
 		return nil, err
 	}
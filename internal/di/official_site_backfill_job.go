@@ -0,0 +1,76 @@
+package di
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/music/musicbrainz"
+	"github.com/liverty-music/backend/internal/infrastructure/music/wikidata"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/liverty-music/backend/pkg/telemetry"
+	"github.com/pannpers/go-logging/logging"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// OfficialSiteBackfillJobApp represents the official site backfill CronJob
+// application. The job resolves official sites for artists that have none,
+// covering deployments where follow-time resolution is disabled.
+type OfficialSiteBackfillJobApp struct {
+	BackfillUC      usecase.OfficialSiteBackfillUseCase
+	Logger          *logging.Logger
+	ShutdownTimeout time.Duration
+}
+
+// InitializeOfficialSiteBackfillJobApp wires the official site backfill job.
+func InitializeOfficialSiteBackfillJobApp(ctx context.Context) (*OfficialSiteBackfillJobApp, error) {
+	cfg, err := config.Load[config.JobConfig]()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger, err := provideLogger(cfg.BaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := rdb.New(ctx, cfg.Database, cfg.IsLocal(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryCloser, err := telemetry.SetupTelemetry(ctx, cfg.Telemetry, cfg.Environment, cfg.ShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Repositories
+	artistRepo := rdb.NewArtistRepository(db)
+
+	// Infrastructure - Music. Every MusicBrainz call in this process waits
+	// on the same limiter, so the run stays within the per-IP rate limit.
+	musicHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	musicbrainzClient := musicbrainz.NewClient(musicHTTPClient, musicbrainz.NewRateLimiter(cfg.MusicBrainzRPS), logger)
+	siteResolver := wikidata.NewFallbackResolver(musicbrainzClient, wikidata.NewClient(musicHTTPClient, logger), logger)
+
+	// Use Cases
+	backfillUC := usecase.NewOfficialSiteBackfillUseCase(artistRepo, siteResolver, logger)
+
+	// Register shutdown phases.
+	shutdown.Init(logger)
+	shutdown.AddObservePhase(telemetryCloser)
+	shutdown.AddDatastorePhase(db)
+
+	return &OfficialSiteBackfillJobApp{
+		BackfillUC:      backfillUC,
+		Logger:          logger,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+	}, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/liverty-music/backend/internal/adapter/rpc"
 	"github.com/liverty-music/backend/internal/adapter/webhook"
+	"github.com/liverty-music/backend/internal/adapter/worker"
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/infrastructure/auth"
 	"github.com/liverty-music/backend/internal/infrastructure/blockchain/safe"
@@ -36,6 +37,7 @@ import (
 	"github.com/liverty-music/backend/internal/infrastructure/messaging"
 	"github.com/liverty-music/backend/internal/infrastructure/music/lastfm"
 	"github.com/liverty-music/backend/internal/infrastructure/music/musicbrainz"
+	"github.com/liverty-music/backend/internal/infrastructure/music/wikidata"
 	"github.com/liverty-music/backend/internal/infrastructure/server"
 	"github.com/liverty-music/backend/internal/infrastructure/server/ratelimit"
 	infratelemetry "github.com/liverty-music/backend/internal/infrastructure/telemetry"
@@ -62,7 +64,7 @@ func InitializeApp(ctx context.Context) (*App, error) {
 		return nil, err
 	}
 
-	logger, err := provideLogger(cfg.Logging)
+	logger, err := provideLogger(cfg.BaseConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +78,11 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Open connections before traffic arrives. A partial warmup is logged and
+	// otherwise harmless; only a cancelled startup aborts.
+	if _, err := db.Warmup(ctx, cfg.Database.WarmupConns); err != nil && ctx.Err() != nil {
+		return nil, err
+	}
 
 	telemetryCloser, err := telemetry.SetupTelemetry(ctx, cfg.Telemetry, cfg.Environment, cfg.ShutdownTimeout)
 	if err != nil {
@@ -90,6 +97,7 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	venueRepo := rdb.NewVenueRepository(db)
 	seriesRepo := rdb.NewSeriesRepository(db)
 	searchLogRepo := rdb.NewSearchLogRepository(db)
+	searchTaskRepo := rdb.NewSearchTaskRepository(db)
 	stagedConcertRepo := rdb.NewStagedConcertRepository(db)
 	rejectedConcertRepo := rdb.NewRejectedConcertLogRepository(db)
 	ticketRepo := rdb.NewTicketRepository(db)
@@ -98,23 +106,29 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	ticketEmailRepo := rdb.NewTicketEmailRepository(db)
 
 	// Infrastructure - Gemini (optional)
+	//
+	// Concert search responses are cached briefly so onboarding users who
+	// follow the same artist minutes apart share one Gemini call.
+	searchResponseCache := cache.NewMemoryCache(cfg.GCP.SearchResponseCacheTTL(), cache.WithMaxEntries(1_000))
 	var geminiSearcher entity.ConcertSearcher
 	var emailParser entity.TicketEmailParser
 	if cfg.GCP.GeminiSearchAPIKey != "" {
-		geminiHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+		geminiHTTPClient := newGeminiHTTPClient(http.DefaultTransport)
 		searcher, err := gemini.NewConcertSearcher(ctx, gemini.Config{
-			APIKey:          cfg.GCP.GeminiSearchAPIKey,
-			ModelExtract:    cfg.GCP.SearchModelExtract(),
-			ModelParse:      cfg.GCP.SearchModelParse(),
-			Temperature:     cfg.GCP.GeminiSearchTemperature,
-			ThinkingLevel:   cfg.GCP.GeminiSearchThinkingLevel,
-			ThinkingExtract: cfg.GCP.GeminiSearchThinkingExtract,
-			ThinkingParse:   cfg.GCP.GeminiSearchThinkingParse,
+			APIKey:             cfg.GCP.GeminiSearchAPIKey,
+			ModelExtract:       cfg.GCP.SearchModelExtract(),
+			ModelParse:         cfg.GCP.SearchModelParse(),
+			Temperature:        cfg.GCP.GeminiSearchTemperature,
+			ThinkingLevel:      cfg.GCP.GeminiSearchThinkingLevel,
+			ThinkingExtract:    cfg.GCP.GeminiSearchThinkingExtract,
+			ThinkingParse:      cfg.GCP.GeminiSearchThinkingParse,
+			MaxEventsPerArtist: cfg.GCP.GeminiSearchMaxEventsPerArtist,
+			DiscoverFestivals:  cfg.GCP.GeminiSearchDiscoverFestivals,
 		}, geminiHTTPClient, logger)
 		if err != nil {
 			return nil, err
 		}
-		geminiSearcher = searcher
+		geminiSearcher = gemini.NewCachedConcertSearcher(searcher, searchResponseCache, logger)
 
 		parser, err := gemini.NewEmailParser(ctx, gemini.EmailParserConfig{
 			ProjectID: cfg.GCP.ProjectID,
@@ -130,10 +144,24 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	// Infrastructure - Music
 	musicHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 	lastfmClient := lastfm.NewClient(cfg.LastFMAPIKey, musicHTTPClient, logger)
-	musicbrainzClient := musicbrainz.NewClient(musicHTTPClient, logger)
+	musicbrainzClient := musicbrainz.NewClient(musicHTTPClient, musicbrainz.NewRateLimiter(cfg.MusicBrainzRPS), logger)
+	// MusicBrainz url-rels are sparse for Japanese indie artists; fall back
+	// to the official website on the artist's Wikidata item. Left nil when
+	// follow-time resolution is disabled.
+	var officialSiteResolver entity.OfficialSiteResolver
+	if cfg.FollowResolveOfficialSite {
+		officialSiteResolver = wikidata.NewFallbackResolver(musicbrainzClient, wikidata.NewClient(musicHTTPClient, logger), logger)
+	}
 
-	// Cache - Artist discovery results with 1 hour TTL
-	artistCache := cache.NewMemoryCache(1 * time.Hour)
+	// Cache - Artist discovery results. Each operation sets its own TTL
+	// (ARTIST_*_CACHE_TTL); the 1 hour default only paces the expiry sweep.
+	// Search queries are user-supplied, so the key space is unbounded; cap it
+	// by LRU.
+	artistCache := cache.NewMemoryCache(1*time.Hour, cache.WithMaxEntries(10_000))
+
+	// Cache - Per-user followed-concert feed. Follow changes evict the entry
+	// directly; the short TTL only bounds staleness from new concerts.
+	followerFeedCache := cache.NewMemoryCache(2*time.Minute, cache.WithMaxEntries(10_000))
 
 	// Initialize the shutdown package for phased resource teardown.
 	shutdown.Init(logger)
@@ -182,6 +210,7 @@ func InitializeApp(ctx context.Context) (*App, error) {
 			cfg.Blockchain.TicketSBTAddress,
 			cfg.Blockchain.ChainID,
 			logger,
+			ticketsbt.WithConfirmTimeout(cfg.Blockchain.ConfirmTimeout),
 		)
 		if err != nil {
 			return nil, err
@@ -195,9 +224,14 @@ func InitializeApp(ctx context.Context) (*App, error) {
 
 	userUC := usecase.NewUserUseCase(userRepo, eventPublisher, logger)
 	centroidResolver := geo.NewCentroidResolver()
-	concertUC := usecase.NewConcertUseCase(artistRepo, concertRepo, venueRepo, seriesRepo, searchLogRepo, stagedConcertRepo, rejectedConcertRepo, geminiSearcher, centroidResolver, eventPublisher, businessMetrics, cfg.GCP.SearchCacheTTL(), cfg.GCP.SearchDiscoveryWindow(), logger)
-	artistUC := usecase.NewArtistUseCase(artistRepo, lastfmClient, musicbrainzClient, eventPublisher, artistCache, logger)
-	followUC := usecase.NewFollowUseCase(followRepo, artistRepo, musicbrainzClient, concertUC, searchLogRepo, eventPublisher, businessMetrics, logger)
+	concertUC := usecase.NewConcertUseCase(artistRepo, userRepo, concertRepo, venueRepo, seriesRepo, searchLogRepo, followRepo, stagedConcertRepo, rejectedConcertRepo, geminiSearcher, centroidResolver, eventPublisher, businessMetrics, followerFeedCache, newSearchFreshness(cfg.GCP), cfg.GCP.SearchDiscoveryWindow(), cfg.GCP.GeminiSearchTimeout, newConcertSearchBreaker(), logger)
+	artistUC := usecase.NewArtistUseCase(artistRepo, lastfmClient, musicbrainzClient, eventPublisher, artistCache, usecase.ArtistCacheTTLs{
+		Search:  cfg.ArtistSearchCacheTTL,
+		Similar: cfg.ArtistSimilarCacheTTL,
+		Top:     cfg.ArtistTopCacheTTL,
+	}, logger)
+	searchQueueUC := usecase.NewConcertSearchQueueUseCase(searchTaskRepo, concertUC, cfg.GCP.SearchQueueMaxAttempts(), logger)
+	followUC := usecase.NewFollowUseCase(followRepo, artistRepo, musicbrainzClient, officialSiteResolver, searchQueueUC, searchLogRepo, concertUC, eventPublisher, businessMetrics, logger)
 	ticketJourneyUC := usecase.NewTicketJourneyUseCase(ticketJourneyRepo, eventPublisher, logger)
 	var ticketEmailUC usecase.TicketEmailUseCase
 	if emailParser != nil {
@@ -332,13 +366,18 @@ func InitializeApp(ctx context.Context) (*App, error) {
 		if err != nil {
 			return nil, err
 		}
+		signalLayout, err := entity.LookupZKPSignalLayout(cfg.ZKP.CircuitVersion)
+		if err != nil {
+			return nil, err
+		}
 
 		nullifierRepo := rdb.NewNullifierRepository(db)
 		merkleTreeRepo := rdb.NewMerkleTreeRepository(db)
 		eventEntryRepo := rdb.NewEventEntryRepository(db)
-		merkleBuilder := inframerkle.NewBuilder(usecase.DefaultTreeDepth)
+		entryAuditRepo := rdb.NewEntryVerificationAuditRepository(db)
+		merkleBuilder := inframerkle.NewBuilder(inframerkle.MaxDepth)
 
-		entryUC := usecase.NewEntryUseCase(verifier, nullifierRepo, merkleTreeRepo, merkleBuilder, eventEntryRepo, ticketRepo, eventPublisher, logger)
+		entryUC := usecase.NewEntryUseCase(verifier, nullifierRepo, merkleTreeRepo, merkleBuilder, eventEntryRepo, ticketRepo, entryAuditRepo, eventPublisher, infratelemetry.NewOTelEntryMetrics(), signalLayout, cfg.ZKP.StrictFieldValidation, logger)
 		handlers = append(handlers, func(opts ...connect.HandlerOption) (string, http.Handler) {
 			return entryconnect.NewEntryServiceHandler(
 				rpc.NewEntryHandler(entryUC, userRepo, logger),
@@ -409,12 +448,20 @@ func InitializeApp(ctx context.Context) (*App, error) {
 		"/account-login-event": loginEventHandler,
 	})
 
+	// Background concert search worker. Without a Gemini searcher the queue
+	// simply accumulates until an instance with the API key drains it.
+	drainClosers := []io.Closer{healthChecker, srv, adminSrv, webhookSrv, rateLimiter, artistCache, searchResponseCache, followerFeedCache}
+	if geminiSearcher != nil {
+		searchWorker := worker.NewConcertSearchWorker(searchQueueUC, cfg.GCP.SearchQueueInterval(), cfg.GCP.SearchQueuePollInterval(), logger)
+		drainClosers = append(drainClosers, searchWorker)
+	}
+
 	// Register shutdown phases.
 	// Drain: health → NOT_SERVING, then servers drain in-flight requests,
-	// then cache cleanup goroutine stops.
-	shutdown.AddDrainPhase(healthChecker, srv, adminSrv, webhookSrv, rateLimiter, artistCache)
+	// then cache cleanup goroutines and search worker stop.
+	shutdown.AddDrainPhase(drainClosers...)
 	shutdown.AddFlushPhase(publisher)
-	externalClosers := []io.Closer{lastfmClient, musicbrainzClient}
+	externalClosers := []io.Closer{lastfmClient}
 	if sbtCloser != nil {
 		externalClosers = append(externalClosers, sbtCloser)
 	}
@@ -431,7 +478,43 @@ func InitializeApp(ctx context.Context) (*App, error) {
 	}, nil
 }
 
-func provideLogger(logCfg config.LoggingConfig) (*logging.Logger, error) {
+// newGeminiHTTPClient returns the HTTP client for every Gemini client,
+// sending requests through base. It adds tracing only: retries belong to the
+// gemini package's per-call policy, which needs the upstream status code and
+// Retry-After header on the genai APIError to classify the failure, trip the
+// search breaker, and honor the server's wait. A transport-level retry would
+// consume those responses before the policy ever saw them.
+func newGeminiHTTPClient(base http.RoundTripper) *http.Client {
+	return &http.Client{Transport: otelhttp.NewTransport(base)}
+}
+
+// newSearchFreshness returns the concert-search freshness windows configured
+// for the environment.
+func newSearchFreshness(cfg config.GCPConfig) usecase.SearchFreshness {
+	f := usecase.SearchFreshness{Window: cfg.SearchCacheTTL()}
+	if len(cfg.GeminiSearchCacheTTLByHype) > 0 {
+		f.ByHype = make(map[entity.Hype]time.Duration, len(cfg.GeminiSearchCacheTTLByHype))
+		for hype, ttl := range cfg.GeminiSearchCacheTTLByHype {
+			f.ByHype[entity.Hype(hype)] = ttl
+		}
+	}
+	return f
+}
+
+// newConcertSearchBreaker returns the breaker that fails concert searches
+// fast once Gemini has been unavailable or timing out for three searches in
+// a row, then probes it again after a minute.
+func newConcertSearchBreaker() *usecase.SearchBreaker {
+	return usecase.NewSearchBreaker(3, time.Minute)
+}
+
+// provideLogger builds the process logger from the logging config. Every
+// record carries an "env" attribute with the deployment environment, so logs
+// from local, development, staging, and production can be told apart once
+// aggregated. extra options are applied last; tests use them to capture
+// output.
+func provideLogger(cfg config.BaseConfig, extra ...logging.Option) (*logging.Logger, error) {
+	logCfg := cfg.Logging
 	var opts []logging.Option
 	switch logCfg.Level {
 	case "debug":
@@ -449,5 +532,9 @@ func provideLogger(logCfg config.LoggingConfig) (*logging.Logger, error) {
 	case "json":
 		opts = append(opts, logging.WithFormat(logging.FormatJSON))
 	}
-	return logging.New(opts...)
+	logger, err := logging.New(append(opts, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+	return logger.With(slog.String("env", cfg.Environment)), nil
 }
@@ -0,0 +1,98 @@
+package di
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/gcp/gemini"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvideLogger_TagsEnvironment(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	cfg := config.BaseConfig{
+		Environment: "staging",
+		Logging:     config.LoggingConfig{Level: "info", Format: "json"},
+	}
+
+	logger, err := provideLogger(cfg, logging.WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	logger.Info(ctx, "first record")
+	logger.With().Warn(ctx, "derived logger record")
+
+	sc := bufio.NewScanner(&buf)
+	var n int
+	for sc.Scan() {
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &rec))
+		assert.Equal(t, "staging", rec["env"], "record %q must carry env", rec["msg"])
+		n++
+	}
+	assert.Equal(t, 2, n)
+}
+
+// redirectTransport sends every request to the test server at URL.
+type redirectTransport struct {
+	URL string
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, err := url.Parse(t.URL)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewGeminiHTTPClient_LeavesRetriesToTheSearcher(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":{"code":503,"message":"Service unavailable","status":"UNAVAILABLE"}}`))
+	}))
+	defer ts.Close()
+
+	logger, err := logging.New()
+	require.NoError(t, err)
+	ctx := context.Background()
+	searcher, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+		APIKey:       "test",
+		ModelExtract: "gemini-pro",
+		ModelParse:   "gemini-pro",
+		Retry:        gemini.RetryPolicy{MaxRetries: 1, BaseBackoff: time.Millisecond},
+	}, newGeminiHTTPClient(&redirectTransport{URL: ts.URL}), logger)
+	require.NoError(t, err)
+
+	got, err := searcher.Search(ctx, &entity.Artist{Name: "Test Artist"}, nil, time.Now())
+
+	// The 503 must reach the searcher as a genai APIError: the searcher
+	// classifies it as transient and degrades to an empty result. Had the
+	// transport swallowed it, the searcher would see an unclassified error
+	// and fail the search instead.
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	// 3 Step 1 slices × 2 attempts each; the transport adds no attempts.
+	assert.Equal(t, int32(6), calls.Load())
+}
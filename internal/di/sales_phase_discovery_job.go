@@ -19,7 +19,6 @@ import (
 	"github.com/liverty-music/backend/pkg/shutdown"
 	"github.com/liverty-music/backend/pkg/telemetry"
 	"github.com/pannpers/go-logging/logging"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // SalesPhaseDiscoveryJobApp is the dependency bundle for the sales-phase
@@ -44,7 +43,7 @@ func InitializeSalesPhaseDiscoveryJobApp(ctx context.Context) (*SalesPhaseDiscov
 		return nil, err
 	}
 
-	logger, err := provideLogger(cfg.Logging)
+	logger, err := provideLogger(cfg.BaseConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +92,7 @@ func InitializeSalesPhaseDiscoveryJobApp(ctx context.Context) (*SalesPhaseDiscov
 	if cfg.GCP.GeminiSearchAPIKey == "" {
 		return nil, fmt.Errorf("sales-phase-discovery job requires GCP_GEMINI_SEARCH_API_KEY")
 	}
-	geminiHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	geminiHTTPClient := newGeminiHTTPClient(http.DefaultTransport)
 	searcher, err := gemini.NewSalesPhaseSearcher(ctx, gemini.SalesPhaseConfig{
 		APIKey:          cfg.GCP.GeminiSearchAPIKey,
 		ModelExtract:    cfg.GCP.SearchModelExtract(),
@@ -0,0 +1,101 @@
+package di
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	googlemaps "github.com/liverty-music/backend/internal/infrastructure/maps/google"
+	"github.com/liverty-music/backend/internal/infrastructure/music/musicbrainz"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/liverty-music/backend/pkg/httpx"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/liverty-music/backend/pkg/telemetry"
+	"github.com/pannpers/go-logging/logging"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/time/rate"
+)
+
+// VenueEnrichmentJobApp represents the venue enrichment CronJob application.
+// The job retries the place enrichment of venues whose earlier attempts
+// failed.
+type VenueEnrichmentJobApp struct {
+	RetryUC         usecase.VenueEnrichmentRetryUseCase
+	Logger          *logging.Logger
+	ShutdownTimeout time.Duration
+}
+
+// InitializeVenueEnrichmentJobApp wires the venue enrichment job.
+func InitializeVenueEnrichmentJobApp(ctx context.Context) (*VenueEnrichmentJobApp, error) {
+	cfg, err := config.Load[config.JobConfig]()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger, err := provideLogger(cfg.BaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := rdb.New(ctx, cfg.Database, cfg.IsLocal(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryCloser, err := telemetry.SetupTelemetry(ctx, cfg.Telemetry, cfg.Environment, cfg.ShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Repositories
+	venueRepo := rdb.NewVenueRepository(db)
+
+	// Infrastructure - Google Maps Places API. Uses OAuth via ADC (Workload
+	// Identity in GKE).
+	if cfg.GCP.ProjectID == "" {
+		return nil, fmt.Errorf("GCP project ID is required for Google Maps Places API")
+	}
+	gmTokenSource, err := google.DefaultTokenSource(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		return nil, fmt.Errorf("obtain google maps token source: %w", err)
+	}
+	gmHTTPClient := &http.Client{
+		Transport: otelhttp.NewTransport(httpx.NewRetryTransport(nil)),
+		Timeout:   10 * time.Second,
+	}
+	gmClient := googlemaps.NewClient(gmTokenSource, cfg.GCP.ProjectID, gmHTTPClient, logger)
+	placeSearcher := googlemaps.NewPlaceSearcher(gmClient)
+	placeLimiter := rate.NewLimiter(rate.Limit(cfg.VenueBackfillPlacesRPS), 1)
+
+	// Infrastructure - MusicBrainz Places API, for venue MBIDs.
+	musicHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	musicbrainzClient := musicbrainz.NewClient(musicHTTPClient, musicbrainz.NewRateLimiter(cfg.MusicBrainzRPS), logger)
+	mbidSearcher := musicbrainz.NewPlaceSearcher(musicbrainzClient)
+
+	// Use Cases
+	enrichmentUC := usecase.NewVenueEnrichmentUseCase(venueRepo, placeSearcher, mbidSearcher, logger)
+	retryUC := usecase.NewVenueEnrichmentRetryUseCase(
+		venueRepo, enrichmentUC, placeLimiter,
+		cfg.VenueEnrichmentRetryBaseBackoff, cfg.VenueEnrichmentMaxAttempts,
+		logger,
+	)
+
+	// Register shutdown phases.
+	shutdown.Init(logger)
+	shutdown.AddObservePhase(telemetryCloser)
+	shutdown.AddDatastorePhase(db)
+
+	return &VenueEnrichmentJobApp{
+		RetryUC:         retryUC,
+		Logger:          logger,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+	}, nil
+}
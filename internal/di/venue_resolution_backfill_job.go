@@ -0,0 +1,91 @@
+package di
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	googlemaps "github.com/liverty-music/backend/internal/infrastructure/maps/google"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/liverty-music/backend/pkg/httpx"
+	"github.com/liverty-music/backend/pkg/shutdown"
+	"github.com/liverty-music/backend/pkg/telemetry"
+	"github.com/pannpers/go-logging/logging"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/time/rate"
+)
+
+// VenueResolutionBackfillJobApp represents the venue resolution backfill
+// CronJob application. The job links concerts recorded before their venue was
+// resolved to a venue.
+type VenueResolutionBackfillJobApp struct {
+	BackfillUC      usecase.VenueResolutionBackfillUseCase
+	Logger          *logging.Logger
+	ShutdownTimeout time.Duration
+}
+
+// InitializeVenueResolutionBackfillJobApp wires the venue resolution backfill job.
+func InitializeVenueResolutionBackfillJobApp(ctx context.Context) (*VenueResolutionBackfillJobApp, error) {
+	cfg, err := config.Load[config.JobConfig]()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger, err := provideLogger(cfg.BaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := rdb.New(ctx, cfg.Database, cfg.IsLocal(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryCloser, err := telemetry.SetupTelemetry(ctx, cfg.Telemetry, cfg.Environment, cfg.ShutdownTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Repositories
+	concertRepo := rdb.NewConcertRepository(db)
+	venueRepo := rdb.NewVenueRepository(db)
+
+	// Infrastructure - Google Maps Places API. Uses OAuth via ADC (Workload
+	// Identity in GKE).
+	if cfg.GCP.ProjectID == "" {
+		return nil, fmt.Errorf("GCP project ID is required for Google Maps Places API")
+	}
+	gmTokenSource, err := google.DefaultTokenSource(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		return nil, fmt.Errorf("obtain google maps token source: %w", err)
+	}
+	gmHTTPClient := &http.Client{
+		Transport: otelhttp.NewTransport(httpx.NewRetryTransport(nil)),
+		Timeout:   10 * time.Second,
+	}
+	gmClient := googlemaps.NewClient(gmTokenSource, cfg.GCP.ProjectID, gmHTTPClient, logger)
+	placeSearcher := googlemaps.NewPlaceSearcher(gmClient)
+	placeLimiter := rate.NewLimiter(rate.Limit(cfg.VenueBackfillPlacesRPS), 1)
+
+	// Use Cases
+	backfillUC := usecase.NewVenueResolutionBackfillUseCase(concertRepo, venueRepo, placeSearcher, placeLimiter, logger)
+
+	// Register shutdown phases.
+	shutdown.Init(logger)
+	shutdown.AddObservePhase(telemetryCloser)
+	shutdown.AddDatastorePhase(db)
+
+	return &VenueResolutionBackfillJobApp{
+		BackfillUC:      backfillUC,
+		Logger:          logger,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+	}, nil
+}
@@ -97,6 +97,19 @@ type ArtistRepository interface {
 	//   - Internal: database query failure.
 	List(ctx context.Context) ([]*Artist, error)
 
+	// ListPage retrieves up to limit artists ordered by ID using keyset
+	// pagination. Pass an empty cursor for the first page and the returned
+	// nextCursor for each following page; an empty nextCursor marks the last
+	// page. Artist IDs are UUIDv7, so artists created while a caller is paging
+	// sort after every existing artist and never shift or duplicate rows on
+	// later pages.
+	//
+	// # Possible errors:
+	//
+	//   - InvalidArgument: limit is not positive or the cursor is malformed.
+	//   - Internal: database query failure.
+	ListPage(ctx context.Context, limit int, cursor string) (artists []*Artist, nextCursor string, err error)
+
 	// Get retrieves a specific artist by their internal UUID.
 	//
 	// # Possible errors:
@@ -137,11 +150,12 @@ type ArtistRepository interface {
 	// # Possible errors:
 	//
 	//   - InvalidArgument: the URL is malformed or empty.
-	//   - AlreadyExists: the artist already has an official site record.
+	//   - AlreadyExists: the artist already has an official site with this URL.
 	//   - Internal: database execution failure.
 	CreateOfficialSite(ctx context.Context, site *OfficialSite) error
 
-	// GetOfficialSite retrieves the verified website for a specific artist.
+	// GetOfficialSite retrieves the primary (earliest registered) website for
+	// a specific artist.
 	//
 	// # Possible errors:
 	//
@@ -149,6 +163,23 @@ type ArtistRepository interface {
 	//   - Internal: database query failure.
 	GetOfficialSite(ctx context.Context, artistID string) (*OfficialSite, error)
 
+	// ListOfficialSites retrieves every website registered for an artist,
+	// primary site first. An artist with no sites yields an empty slice.
+	//
+	// # Possible errors:
+	//
+	//   - Internal: database query failure.
+	ListOfficialSites(ctx context.Context, artistID string) ([]*OfficialSite, error)
+
+	// ListArtistsWithoutOfficialSite returns up to limit artists that have no
+	// official site registered, in random order so that artists whose site
+	// cannot be resolved do not starve the rest across runs.
+	//
+	// # Possible errors:
+	//
+	//   - Internal: database query failure.
+	ListArtistsWithoutOfficialSite(ctx context.Context, limit int) ([]*Artist, error)
+
 	// Fanart operations
 
 	// UpdateFanart replaces the cached fanart.tv data for an artist.
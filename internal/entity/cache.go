@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 // Cache provides key-value storage with automatic expiration.
 // Implementations handle TTL, thread safety, and cleanup.
 type Cache interface {
@@ -7,4 +9,9 @@ type Cache interface {
 	Get(key string) any
 	// Set stores a value with the implementation's configured TTL.
 	Set(key string, value any)
+	// SetWithTTL stores a value that expires after ttl instead of the
+	// implementation's configured TTL.
+	SetWithTTL(key string, value any, ttl time.Duration)
+	// Delete removes a value by key. Deleting a missing key is a no-op.
+	Delete(key string)
 }
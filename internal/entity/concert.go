@@ -1,7 +1,10 @@
 package entity
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/liverty-music/backend/pkg/geo"
@@ -86,6 +89,40 @@ type ScrapedConcert struct {
 	// cross-run series key — series identity is adopted from already-persisted
 	// member events. Zero for standalone concerts.
 	TourGroup int `json:"tour_group,omitempty"`
+	// IsFestival reports whether this concert is an appearance on a festival
+	// lineup (a Gemini <festival> block). Festival appearances are filed under
+	// a FESTIVAL series and keyed on the festival day alone, so every artist
+	// on the lineup shares one event; StartTime is then the artist's set time.
+	IsFestival bool `json:"is_festival,omitempty"`
+	// ReschedulesEventID is the ID of the published event this concert moves
+	// to a new date, set when discovery detects a reschedule (see
+	// ScrapedConcerts.Reschedules). The move is staged for review like any
+	// other discovery; approval applies it to that event instead of
+	// publishing a new one. Empty for a new concert.
+	ReschedulesEventID string `json:"reschedules_event_id,omitempty"`
+}
+
+// SeriesType returns the type of the Series the concert is filed under:
+// FESTIVAL for a festival appearance, TOUR for a tour date, SINGLE otherwise.
+func (sc *ScrapedConcert) SeriesType() SeriesType {
+	switch {
+	case sc.IsFestival:
+		return SeriesTypeFestival
+	case sc.IsTour:
+		return SeriesTypeTour
+	default:
+		return SeriesTypeSingle
+	}
+}
+
+// EventStartTime returns the start time that keys the concert's event: nil
+// for a festival appearance, whose event is shared by the whole day's
+// lineup, and the concert's own StartTime otherwise.
+func (sc *ScrapedConcert) EventStartTime() *time.Time {
+	if sc.IsFestival {
+		return nil
+	}
+	return NullableTime(sc.StartTime)
 }
 
 // ToConcert converts a ScrapedConcert into a fully-populated Concert entity.
@@ -128,6 +165,19 @@ func (sc *ScrapedConcert) ToConcert(artistID, seriesID, eventID, venueID string,
 	return c
 }
 
+// NormalizeSourceURL returns the comparison form of a concert source URL:
+// surrounding whitespace, the query string, the fragment, and any trailing
+// slashes are removed. Tracking parameters and a trailing slash vary between
+// scrapes of the same page, so two URLs that normalize equally are treated as
+// the same source; the date and venue ConcertRepository.ExistsBySource also
+// matches on tell apart the shows one page lists. ExistsBySource applies the
+// same rules to the stored URL.
+func NormalizeSourceURL(raw string) string {
+	u, _, _ := strings.Cut(strings.TrimSpace(raw), "#")
+	u, _, _ = strings.Cut(u, "?")
+	return strings.TrimRight(u, "/")
+}
+
 // NullableTime returns a pointer to t, or nil when t is the zero value (an
 // unknown time). It is the canonical "unknown time → SQL NULL" conversion used
 // across the event physical key, mirroring how nullable TIMESTAMPTZ columns
@@ -152,9 +202,95 @@ func StartKey(t *time.Time) string {
 	return t.UTC().Format(time.RFC3339)
 }
 
+// jst is Japan Standard Time. Japan observes no daylight saving time, so a
+// fixed offset is exact and needs no tzdata.
+var jst = time.FixedZone("JST", 9*60*60)
+
+// anywhereOnEarth is UTC-12, the last time zone to leave any calendar date.
+// A date has passed everywhere only once it has passed here.
+var anywhereOnEarth = time.FixedZone("AoE", -12*60*60)
+
+// EventLocation returns the time zone an event's LocalDate is expressed in,
+// derived from the venue's ISO 3166-2 admin area. Japanese venues ("JP-*")
+// use JST. Venues whose area is unknown or outside Japan use Anywhere on
+// Earth, so a show is never treated as past while its date may still be
+// current where it takes place.
+func EventLocation(adminArea *string) *time.Location {
+	if adminArea != nil && strings.HasPrefix(*adminArea, "JP-") {
+		return jst
+	}
+	return anywhereOnEarth
+}
+
+// EventTimeZoneID returns the IANA time zone ID of the EventLocation for the
+// admin area, or "" when the location is Anywhere on Earth, which is a bare
+// UTC offset with no IANA zone.
+func EventTimeZoneID(adminArea *string) string {
+	if EventLocation(adminArea) == jst {
+		return "Asia/Tokyo"
+	}
+	return ""
+}
+
+// IsPast reports whether the concert's local date is before the calendar date
+// of now in the venue's time zone (see EventLocation). A concert taking place
+// today is not past. Comparing against now's date in the server's zone (or
+// UTC) instead would drop or keep events around midnight depending on where
+// the server runs.
+func (s *ScrapedConcert) IsPast(now time.Time) bool {
+	y, m, d := now.In(EventLocation(s.AdminArea)).Date()
+	return s.LocalDate.Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
 // ScrapedConcerts is a slice of ScrapedConcert pointers with domain-level operations.
 type ScrapedConcerts []*ScrapedConcert
 
+// Upcoming returns the concerts that are not past as of now (see IsPast),
+// preserving order. Returns nil if none remain.
+func (ss ScrapedConcerts) Upcoming(now time.Time) ScrapedConcerts {
+	var result ScrapedConcerts
+	for _, s := range ss {
+		if !s.IsPast(now) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// Sort orders the concerts chronologically in place: by LocalDate, then
+// StartTime (unknown start last), then ListedVenueName, then Title. The sort
+// is stable, so fully tied entries keep their relative order and output for a
+// given input is deterministic — same-date shows would otherwise come out in
+// whatever order the model happened to list them.
+func (ss ScrapedConcerts) Sort() {
+	slices.SortStableFunc(ss, func(a, b *ScrapedConcert) int {
+		if c := a.LocalDate.Compare(b.LocalDate); c != 0 {
+			return c
+		}
+		if c := compareStart(a.StartTime, b.StartTime); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.ListedVenueName, b.ListedVenueName); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Title, b.Title)
+	})
+}
+
+// compareStart orders optional start times ascending with the zero value
+// (unknown) after every known time.
+func compareStart(a, b time.Time) int {
+	switch {
+	case a.IsZero() && b.IsZero():
+		return 0
+	case a.IsZero():
+		return 1
+	case b.IsZero():
+		return -1
+	}
+	return a.Compare(b)
+}
+
 // FilterNew returns scraped concerts that do not conflict with existing concerts,
 // a best-effort upstream filter aligned with the events physical natural key
 // `(venue_id, local_event_date, start_at)`. It handles both cross-batch
@@ -174,6 +310,11 @@ type ScrapedConcerts []*ScrapedConcert
 //     that (date, venue) → kept, so the creation path can fill the announced
 //     time onto the existing row instead of dropping it here.
 //
+// A festival appearance compares as unknown-start whatever its set time (see
+// [ScrapedConcert.EventStartTime]), so an appearance already published, or
+// repeated in the batch, is dropped rather than passed on as a fill of the
+// shared festival event.
+//
 // The creation path resolves event identity authoritatively (exact dedup, fill,
 // or insert); this filter only avoids redundant publish/UPSERT round-trips.
 //
@@ -221,7 +362,10 @@ func (ss ScrapedConcerts) FilterNew(existing []*Concert) ScrapedConcerts {
 			continue
 		}
 		k := vdKey{date: s.LocalDate.Format("2006-01-02"), venue: s.ListedVenueName}
-		start := StartKey(NullableTime(s.StartTime))
+		// A festival appearance keys on the day alone, like its event: any
+		// concert already recorded at the (date, venue) is the same appearance,
+		// whatever set time the scrape carries.
+		start := StartKey(s.EventStartTime())
 		var dup bool
 		if start == "" {
 			// Unknown start: redundant if anything is already known here.
@@ -241,6 +385,72 @@ func (ss ScrapedConcerts) FilterNew(existing []*Concert) ScrapedConcerts {
 	return result
 }
 
+// Reschedule pairs a published concert with the scraped concert that moves it
+// to a new date.
+type Reschedule struct {
+	// Existing is the published concert whose date changed.
+	Existing *Concert
+	// Scraped carries the new date and, if announced, the new times.
+	Scraped *ScrapedConcert
+}
+
+// Reschedules detects scraped concerts that are a published concert moved to a
+// new date rather than a new show. A scraped concert is a reschedule of an
+// existing one when they share the series title and listed venue, nothing is
+// published at the scraped (date, venue), and the existing concert's own
+// (date, venue) no longer appears anywhere in the receiver — the old date was
+// dropped from the listing and a new one took its place. The last condition
+// keeps a multi-night run (same title and venue on consecutive dates) from
+// being collapsed into one event.
+//
+// The receiver must be the complete scrape for one artist and existing that
+// artist's published concerts. A scraped concert matching more than one
+// candidate is ambiguous and not reported; each existing concert is paired at
+// most once.
+func (ss ScrapedConcerts) Reschedules(existing []*Concert) []Reschedule {
+	dateVenue := func(d time.Time, venue string) string {
+		return d.Format("2006-01-02") + "|" + venue
+	}
+	listed := make(map[string]bool, len(ss))
+	for _, s := range ss {
+		listed[dateVenue(s.LocalDate, s.ListedVenueName)] = true
+	}
+	published := make(map[string]bool, len(existing))
+	for _, ex := range existing {
+		if ex.ListedVenueName != nil {
+			published[dateVenue(ex.LocalDate, *ex.ListedVenueName)] = true
+		}
+	}
+
+	claimed := make(map[string]bool)
+	var result []Reschedule
+	for _, s := range ss {
+		if s.ListedVenueName == "" || published[dateVenue(s.LocalDate, s.ListedVenueName)] {
+			continue
+		}
+		var match *Concert
+		ambiguous := false
+		for _, ex := range existing {
+			if ex.Series == nil || ex.ListedVenueName == nil || claimed[ex.ID] ||
+				ex.Series.Title != s.Title || *ex.ListedVenueName != s.ListedVenueName ||
+				listed[dateVenue(ex.LocalDate, *ex.ListedVenueName)] {
+				continue
+			}
+			if match != nil {
+				ambiguous = true
+				break
+			}
+			match = ex
+		}
+		if match == nil || ambiguous {
+			continue
+		}
+		claimed[match.ID] = true
+		result = append(result, Reschedule{Existing: match, Scraped: s})
+	}
+	return result
+}
+
 // ProximityTo determines the geographic proximity of this concert's venue
 // relative to the given user home area.
 //
@@ -325,22 +535,81 @@ func GroupByDateAndProximity(concerts []*Concert, home *Home) []*ProximityGroup
 	return result
 }
 
+// UpcomingConcertFilter narrows ConcertRepository.ListUpcomingGlobal. The zero
+// value matches every upcoming concert.
+type UpcomingConcertFilter struct {
+	// AdminArea restricts results to venues in this ISO 3166-2 subdivision
+	// (e.g., "JP-13"). Empty means no restriction.
+	AdminArea string
+}
+
 // ConcertRepository defines the data access interface for Concerts.
 type ConcertRepository interface {
 	// ListByArtist retrieves all concerts where the given artist appears in
 	// event_performers. if upcomingOnly is true, it only returns concerts with
-	// LocalDate >= today.
+	// LocalDate >= today. Series, Venue (with coordinates), and Performers are
+	// hydrated in a fixed number of queries regardless of the result size.
+	// Soft-deleted concerts are excluded.
 	//
 	// # Possible errors
 	//
 	//  - InvalidArgument: If the artist ID is empty.
 	ListByArtist(ctx context.Context, artistID string, upcomingOnly bool) ([]*Concert, error)
-	// ListByFollower retrieves all concerts for artists followed by the given user,
-	// ordered by local_event_date ascending.
-	ListByFollower(ctx context.Context, userID string) ([]*Concert, error)
+	// ListByArtistAll is the admin variant of ListByArtist. It returns every
+	// concert of the artist regardless of date and, when includeDeleted is
+	// true, also the soft-deleted ones (with DeletedAt set) so an operator can
+	// review what was removed.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the artist ID is empty.
+	ListByArtistAll(ctx context.Context, artistID string, includeDeleted bool) ([]*Concert, error)
+	// ListByVenue retrieves the concerts held at the given venue, whichever
+	// artists perform. upcomingOnly filters as in ListByArtist, and Series,
+	// Venue and Performers (with artist names) are hydrated the same way.
+	// Soft-deleted concerts are excluded.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID is empty.
+	ListByVenue(ctx context.Context, venueID string, upcomingOnly bool) ([]*Concert, error)
+	// ListUpcomingGlobal retrieves upcoming concerts across all artists for the
+	// explore feed, ordered by LocalDate and then ID, using keyset pagination.
+	// Pass an empty cursor for the first page and the returned nextCursor for
+	// each following page; an empty nextCursor marks the last page.
+	// Soft-deleted concerts are excluded. Series, Venue (with coordinates),
+	// and Performers are hydrated as for ListByArtist.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive or the cursor is malformed.
+	ListUpcomingGlobal(ctx context.Context, filter UpcomingConcertFilter, limit int, cursor string) (concerts []*Concert, nextCursor string, err error)
+	// Search returns up to limit concerts whose event title, venue name (as
+	// resolved or as listed), or performing artist's name contains query,
+	// case-insensitively. Concerts whose title or venue name contains every
+	// word of query as a word prefix rank first, title matches above venue
+	// matches; ties and the remaining substring matches are ordered by
+	// LocalDate ascending. Only upcoming concerts, dated today or later, are
+	// searched; soft-deleted concerts are excluded.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If query is blank or limit is not positive.
+	Search(ctx context.Context, query string, limit int) ([]*Concert, error)
+	// ListByFollower retrieves one page of the concerts for artists followed by
+	// the given user, ordered by LocalDate and then ID, using keyset pagination
+	// as for ListUpcomingGlobal: pass an empty cursor for the first page and
+	// the returned nextCursor for each following page; an empty nextCursor
+	// marks the last page. Soft-deleted concerts are excluded.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive or the cursor is malformed.
+	ListByFollower(ctx context.Context, userID string, limit int, cursor string) (concerts []*Concert, nextCursor string, err error)
 	// ListByArtists retrieves concerts where any of the given artists appear in
 	// event_performers, in a single query. Venue coordinates are included for
 	// proximity classification. Results are ordered by local_event_date ascending.
+	// Soft-deleted concerts are excluded.
 	ListByArtists(ctx context.Context, artistIDs []string) ([]*Concert, error)
 	// Create persists one or more concerts using bulk insert with UPSERT semantics.
 	//
@@ -354,6 +623,16 @@ type ConcertRepository interface {
 	// (event_performers) are inserted for every concert in the batch and use
 	// ON CONFLICT DO NOTHING so re-runs are idempotent.
 	//
+	// An event's SearchSessionID is recorded on insert only; re-ingesting the
+	// same event, from the same session or a later one, keeps the original.
+	//
+	// A concert whose Series is a FESTIVAL is keyed on (venue, date) alone, so
+	// every artist on a festival day's lineup shares one event; the concert's
+	// StartTime is stored as that performer's set time rather than the event's.
+	//
+	// A concert with an empty VenueID is recorded pending venue resolution and
+	// is keyed by its ListedVenueName in place of the venue.
+	//
 	// Nil elements in the input slice are silently skipped.
 	//
 	// Returns the event IDs of concerts that were genuinely inserted (i.e.,
@@ -362,17 +641,61 @@ type ConcertRepository interface {
 	//
 	// # Possible errors
 	//
+	//  - InvalidArgument: If a concert carries neither a VenueID nor a ListedVenueName.
 	//  - FailedPrecondition: If a foreign key constraint is violated (e.g., invalid series, venue, or performer).
 	Create(ctx context.Context, concerts ...*Concert) ([]string, error)
 	// ListByIDs retrieves concerts by their event IDs. Venues, parent Series,
 	// and Performers are all populated so callers can render the response
-	// without follow-up queries. IDs that do not match any row are silently
-	// omitted from the result.
+	// without follow-up queries. IDs that do not match any row, and concerts
+	// that have been soft-deleted, are silently omitted from the result.
 	//
 	// # Possible errors
 	//
 	//  - InvalidArgument: If the ids slice is empty.
 	ListByIDs(ctx context.Context, ids []string) ([]*Concert, error)
+	// ListBySearchSession retrieves the concerts whose events were first
+	// created by the given discovery search session, for auditing what a run
+	// produced. Series, Venue, and Performers are hydrated. Soft-deleted
+	// concerts are omitted. Results are ordered by local_event_date
+	// ascending; an unknown session yields an empty slice.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the session ID is empty.
+	ListBySearchSession(ctx context.Context, sessionID string) ([]*Concert, error)
+	// ListPendingVenue retrieves up to limit concerts recorded before their
+	// venue was resolved: VenueID is empty, Venue is nil, and ListedVenueName
+	// names the venue as scraped. Series and Performers are hydrated.
+	// Concerts never attempted come first, then those attempted longest ago
+	// (see MarkVenueResolutionAttempted), each ordered by local_event_date
+	// ascending, so names that keep failing cannot starve the rest.
+	// Soft-deleted concerts are excluded.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive.
+	ListPendingVenue(ctx context.Context, limit int) ([]*Concert, error)
+	// MarkVenueResolutionAttempted records that resolving the venue of the
+	// given events pending venue resolution was attempted now, moving them to
+	// the back of ListPendingVenue. IDs of events that have a venue are
+	// ignored.
+	MarkVenueResolutionAttempted(ctx context.Context, eventIDs []string) error
+	// AssignVenue links an event pending venue resolution to venueID and
+	// returns the ID of the event that now holds the concert. When the venue
+	// already hosts an event on the same date and start time, the pending
+	// event is merged into it instead: its performers are linked to that
+	// event, it is soft-deleted, and the existing event's ID is returned. An
+	// event that already has a venue is left untouched, so repeating the call
+	// after a partial run is harmless.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If either ID is empty.
+	//  - NotFound: If no live event pending venue resolution has the ID.
+	//  - FailedPrecondition: If the venue does not exist, or the pending
+	//    event must be merged but has tickets or used nullifiers.
+	//  - AlreadyExists: If a colliding event was inserted concurrently.
+	AssignVenue(ctx context.Context, eventID, venueID string) (string, error)
 	// FindEventsByVenueAndDate returns existing events occurring at any of the
 	// given (venue_id, local_event_date) pairs. The two slices are zipped
 	// element-wise into pairs; an event matches when its (venue_id,
@@ -391,6 +714,32 @@ type ConcertRepository interface {
 	// The three slices are zipped element-wise; a nil time leaves the column
 	// unchanged. Idempotent: a no-op when eventIDs is empty.
 	FillEventStartTimes(ctx context.Context, eventIDs []string, startTimes, openTimes []*time.Time) error
+	// Reschedule moves an existing event to a new date, recording its current
+	// local_event_date as previous_local_event_date. start_at / open_at are
+	// replaced with the given times (nil clears the column), since absolute
+	// times announced for the old date no longer apply.
+	//
+	// # Possible errors
+	//
+	//  - NotFound: If no live event exists with the given ID.
+	//  - AlreadyExists: If another event already occupies the new (venue, date, start) natural key.
+	Reschedule(ctx context.Context, eventID string, date time.Time, startTime, openTime *time.Time) error
+	// Update corrects a live published concert in place, identified by its
+	// event ID. It overwrites the event's listed_venue_name, local_event_date,
+	// start_at, and open_at (a nil time clears the column), and the parent
+	// Series' title and source URL. Title and source URL live on the Series,
+	// which every event of a tour or festival shares: correcting them through
+	// one event changes them for all of its sibling events too. Performer links
+	// and the venue are never changed. A FESTIVAL concert's StartTime stays on
+	// its performer link, as on Create.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the event ID is empty, or Series is nil or has an empty title.
+	//  - NotFound: If no live event exists with the given ID; a soft-deleted
+	//    event is not updated.
+	//  - AlreadyExists: If another event already occupies the updated (venue, date, start) natural key.
+	Update(ctx context.Context, concert *Concert) error
 	// List retrieves every published concert with Series, Venue, and Performers
 	// hydrated, ordered by local_event_date ascending. Unlike ListByArtist /
 	// ListByFollower it applies no audience filter — it returns the whole
@@ -402,17 +751,41 @@ type ConcertRepository interface {
 	// parent series' sales_phases). It is idempotent: deleting an id that no
 	// longer exists is a no-op success.
 	Delete(ctx context.Context, eventID string) error
+	// ExistsBySource reports whether the given artist already performs in a
+	// live concert on localDate at the venue listed as listedVenueName (the
+	// event's listed name, or its venue's listed or canonical name) whose
+	// series source URL matches sourceURL after NormalizeSourceURL is applied
+	// to both sides. An empty sourceURL never matches.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the artist ID is empty.
+	ExistsBySource(ctx context.Context, artistID, sourceURL string, localDate time.Time, listedVenueName string) (bool, error)
+	// SoftDelete hides a published event from artist and follower listings by
+	// setting its deleted_at, keeping the row and everything referencing it for
+	// audit. Re-discovery of the same physical event resolves to the hidden row
+	// instead of recreating it. Soft-deleting an already-deleted event is a
+	// no-op success that keeps the original timestamp.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the event ID is empty.
+	//  - NotFound: If no event exists with the given ID.
+	SoftDelete(ctx context.Context, eventID string) error
 }
 
 // ConcertSearcher defines the interface for searching concerts from external sources.
 type ConcertSearcher interface {
 	// Search uses an external service (e.g., Gemini) to find concerts for an artist.
-	// It relies on the artist's name and official site URL for grounding.
+	// It relies on the artist's name and official site URLs for grounding; the
+	// first site is treated as the primary one, and officialSites may be empty.
 	//
 	// # Possible errors
 	//
-	//  - InvalidArgument: If the artist or official site is invalid.
+	//  - InvalidArgument: If the artist or an official site is invalid.
 	//  - Unavailable: If the external service is down.
+	//  - FailedPrecondition: If the response was rejected as implausible, e.g.
+	//    more events than the per-artist limit. Retrying will not help.
 	//  - Internal: unexpected failure during search processing.
-	Search(ctx context.Context, artist *Artist, officialSite *OfficialSite, from time.Time) ([]*ScrapedConcert, error)
+	Search(ctx context.Context, artist *Artist, officialSites []*OfficialSite, from time.Time) ([]*ScrapedConcert, error)
 }
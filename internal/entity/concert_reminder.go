@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// ConcertReminderCandidate is a (user, event) pair the concert-reminders job
+// may remind: the user holds a ticket journey for the event and has not been
+// reminded of it yet. It carries what the job needs to decide, in the user's
+// own time zone and lead time, whether the reminder is due, and to render it.
+type ConcertReminderCandidate struct {
+	// UserID is the user to remind.
+	UserID string
+	// EventID is the event the reminder is for.
+	EventID string
+	// Title is the title of the event's series.
+	Title string
+	// VenueName is the resolved venue name, or the listed venue name while
+	// the venue is pending resolution.
+	VenueName string
+	// LocalDate is the calendar date of the event in its local timezone.
+	LocalDate time.Time
+	// StartTime is when the event starts; nil when not yet published.
+	StartTime *time.Time
+	// ReminderDays is the user's reminder lead time in days.
+	ReminderDays int
+	// TimeZone is the user's IANA time zone; empty when not set.
+	TimeZone string
+	// PreferredLanguage is the user's ISO 639-1 language code; empty when not set.
+	PreferredLanguage string
+}
+
+// ConcertReminderRepository lists concerts to remind users of and persists
+// the sent-log that keeps each reminder to one delivery per (user, event).
+// The consumer that delivers the reminder is the sole writer of the sent-log.
+type ConcertReminderRepository interface {
+	// ListCandidates returns the unreminded (user, event) pairs whose event
+	// falls within the user's own reminder lead time of today, for users with
+	// a ticket journey other than Lost. Each user's window is widened by a day
+	// on both sides so that it covers today in every time zone; the caller
+	// narrows it to the user's local date. Deleted events are excluded.
+	//
+	// # Possible errors
+	//
+	//  - Internal: unexpected database failure.
+	ListCandidates(ctx context.Context, today time.Time) ([]*ConcertReminderCandidate, error)
+
+	// RecordSent records that the user was reminded of the event. The
+	// operation is idempotent due to the UNIQUE constraint on
+	// (user_id, event_id).
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If userID or eventID is empty.
+	//  - Internal: unexpected database failure.
+	RecordSent(ctx context.Context, userID, eventID string) error
+
+	// AlreadySent reports whether the user has been reminded of the event.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If userID or eventID is empty.
+	//  - Internal: unexpected database failure.
+	AlreadySent(ctx context.Context, userID, eventID string) (bool, error)
+}
@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"encoding/json"
+	"slices"
 	"testing"
 	"time"
 
@@ -526,6 +527,29 @@ func TestScrapedConcerts_FilterNew(t *testing.T) {
 			},
 			want: entity.ScrapedConcerts{sc1, sc2},
 		},
+		{
+			name: "published festival appearance is not passed on as a fill of its set time",
+			args: args{
+				scraped: entity.ScrapedConcerts{
+					{LocalDate: date1, ListedVenueName: "Zepp Tokyo", Title: "Fes", StartTime: date1.Add(15 * time.Hour), IsFestival: true},
+				},
+				existing: []*entity.Concert{existing1},
+			},
+			want: nil,
+		},
+		{
+			name: "festival appearance repeated in the batch is kept once",
+			args: args{
+				scraped: entity.ScrapedConcerts{
+					{LocalDate: date3, ListedVenueName: "Fes Park", Title: "Fes", StartTime: date3.Add(15 * time.Hour), IsFestival: true},
+					{LocalDate: date3, ListedVenueName: "Fes Park", Title: "Fes", StartTime: date3.Add(17 * time.Hour), IsFestival: true},
+				},
+				existing: nil,
+			},
+			want: entity.ScrapedConcerts{
+				{LocalDate: date3, ListedVenueName: "Fes Park", Title: "Fes", StartTime: date3.Add(15 * time.Hour), IsFestival: true},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -538,6 +562,277 @@ func TestScrapedConcerts_FilterNew(t *testing.T) {
 	}
 }
 
+func TestScrapedConcert_SeriesType(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 8, 8, 6, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		sc        *entity.ScrapedConcert
+		want      entity.SeriesType
+		wantStart *time.Time
+	}{
+		{name: "standalone", sc: &entity.ScrapedConcert{StartTime: start}, want: entity.SeriesTypeSingle, wantStart: &start},
+		{name: "tour date", sc: &entity.ScrapedConcert{StartTime: start, IsTour: true}, want: entity.SeriesTypeTour, wantStart: &start},
+		{name: "festival appearance keys on the day", sc: &entity.ScrapedConcert{StartTime: start, IsFestival: true}, want: entity.SeriesTypeFestival},
+		{name: "unknown start", sc: &entity.ScrapedConcert{}, want: entity.SeriesTypeSingle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.sc.SeriesType())
+			assert.Equal(t, tt.wantStart, tt.sc.EventStartTime())
+		})
+	}
+}
+
+func TestScrapedConcerts_Reschedules(t *testing.T) {
+	t.Parallel()
+
+	oldDate := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	newDate := time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC)
+	zepp := "Zepp Tokyo"
+	stored := func(id, title string, d time.Time) *entity.Concert {
+		return &entity.Concert{
+			Event:  entity.Event{ID: id, LocalDate: d, ListedVenueName: &zepp},
+			Series: &entity.Series{Title: title},
+		}
+	}
+	moved := &entity.ScrapedConcert{Title: "Live A", ListedVenueName: zepp, LocalDate: newDate}
+
+	tests := []struct {
+		name     string
+		scraped  entity.ScrapedConcerts
+		existing []*entity.Concert
+		want     []string // existing IDs paired, in scrape order
+	}{
+		{
+			name:     "old date dropped and new date listed",
+			scraped:  entity.ScrapedConcerts{moved},
+			existing: []*entity.Concert{stored("e1", "Live A", oldDate)},
+			want:     []string{"e1"},
+		},
+		{
+			name:     "old date still listed is a second night",
+			scraped:  entity.ScrapedConcerts{{Title: "Live A", ListedVenueName: zepp, LocalDate: oldDate}, moved},
+			existing: []*entity.Concert{stored("e1", "Live A", oldDate)},
+		},
+		{
+			name:     "different title is a different show",
+			scraped:  entity.ScrapedConcerts{moved},
+			existing: []*entity.Concert{stored("e1", "Live B", oldDate)},
+		},
+		{
+			name:     "new date already published",
+			scraped:  entity.ScrapedConcerts{moved},
+			existing: []*entity.Concert{stored("e1", "Live A", oldDate), stored("e2", "Live A", newDate)},
+		},
+		{
+			name:    "two candidates are ambiguous",
+			scraped: entity.ScrapedConcerts{moved},
+			existing: []*entity.Concert{
+				stored("e1", "Live A", oldDate),
+				stored("e2", "Live A", oldDate.AddDate(0, 0, 1)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, r := range tt.scraped.Reschedules(tt.existing) {
+				got = append(got, r.Existing.ID)
+				assert.Same(t, moved, r.Scraped)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScrapedConcert_IsPast(t *testing.T) {
+	t.Parallel()
+
+	tokyo := "JP-13"
+	nov1 := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		adminArea *string
+		localDate time.Time
+		now       time.Time
+		want      bool
+	}{
+		{
+			name:      "JP venue: today in JST while UTC is still yesterday",
+			adminArea: &tokyo,
+			localDate: nov1,
+			now:       time.Date(2026, 10, 31, 15, 30, 0, 0, time.UTC), // 00:30 JST Nov 1
+			want:      false,
+		},
+		{
+			name:      "JP venue: yesterday in JST while UTC is still on that date",
+			adminArea: &tokyo,
+			localDate: nov1,
+			now:       time.Date(2026, 11, 1, 15, 30, 0, 0, time.UTC), // 00:30 JST Nov 2
+			want:      true,
+		},
+		{
+			name:      "JP venue: last minute of the day in JST",
+			adminArea: &tokyo,
+			localDate: nov1,
+			now:       time.Date(2026, 11, 1, 14, 59, 0, 0, time.UTC), // 23:59 JST Nov 1
+			want:      false,
+		},
+		{
+			name:      "JP venue: future date",
+			adminArea: &tokyo,
+			localDate: nov1,
+			now:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			want:      false,
+		},
+		{
+			name:      "unknown area: kept while the date is current anywhere",
+			localDate: nov1,
+			now:       time.Date(2026, 11, 2, 11, 0, 0, 0, time.UTC), // 23:00 Nov 1 at UTC-12
+			want:      false,
+		},
+		{
+			name:      "unknown area: past once the date has ended everywhere",
+			localDate: nov1,
+			now:       time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC), // 00:00 Nov 2 at UTC-12
+			want:      true,
+		},
+		{
+			name:      "now in a non-UTC location is compared by instant",
+			adminArea: &tokyo,
+			localDate: nov1,
+			now:       time.Date(2026, 11, 1, 10, 30, 0, 0, time.FixedZone("PST", -8*60*60)), // 03:30 JST Nov 2
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sc := &entity.ScrapedConcert{AdminArea: tt.adminArea, LocalDate: tt.localDate}
+			assert.Equal(t, tt.want, sc.IsPast(tt.now))
+		})
+	}
+}
+
+func TestEvent_IsPast(t *testing.T) {
+	t.Parallel()
+
+	tokyo := "JP-13"
+	nov1 := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 11, 1, 15, 30, 0, 0, time.UTC) // 00:30 JST Nov 2, 03:30 Nov 1 at UTC-12
+
+	tests := []struct {
+		name  string
+		venue *entity.Venue
+		want  bool
+	}{
+		{name: "JP venue judged in JST", venue: &entity.Venue{AdminArea: &tokyo}, want: true},
+		{name: "venue with unknown area judged in Anywhere on Earth", venue: &entity.Venue{}, want: false},
+		{name: "pending venue judged in Anywhere on Earth", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e := &entity.Event{Venue: tt.venue, LocalDate: nov1}
+			assert.Equal(t, tt.want, e.IsPast(now))
+		})
+	}
+}
+
+func TestEventTimeZoneID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Asia/Tokyo", entity.EventTimeZoneID(new("JP-27")))
+	assert.Empty(t, entity.EventTimeZoneID(new("US-CA")))
+	assert.Empty(t, entity.EventTimeZoneID(nil))
+}
+
+func TestNormalizeSourceURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "already normalized", raw: "https://example.com/tour", want: "https://example.com/tour"},
+		{name: "trailing slash", raw: "https://example.com/tour/", want: "https://example.com/tour"},
+		{name: "query params", raw: "https://example.com/tour?utm_source=x&ref=y", want: "https://example.com/tour"},
+		{name: "query after trailing slash", raw: "https://example.com/tour/?utm_source=x", want: "https://example.com/tour"},
+		{name: "fragment", raw: "https://example.com/tour#tickets", want: "https://example.com/tour"},
+		{name: "fragment after query", raw: "https://example.com/tour?ref=y#map", want: "https://example.com/tour"},
+		{name: "surrounding whitespace", raw: "  https://example.com/tour/ ", want: "https://example.com/tour"},
+		{name: "path is preserved", raw: "https://example.com/tour/osaka", want: "https://example.com/tour/osaka"},
+		{name: "empty", raw: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, entity.NormalizeSourceURL(tt.raw))
+		})
+	}
+}
+
+func TestScrapedConcerts_Upcoming(t *testing.T) {
+	t.Parallel()
+
+	tokyo := "JP-13"
+	now := time.Date(2026, 11, 1, 15, 30, 0, 0, time.UTC) // 00:30 JST Nov 2
+	yesterday := &entity.ScrapedConcert{Title: "yesterday", AdminArea: &tokyo, LocalDate: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)}
+	today := &entity.ScrapedConcert{Title: "today", AdminArea: &tokyo, LocalDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)}
+	later := &entity.ScrapedConcert{Title: "later", AdminArea: &tokyo, LocalDate: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)}
+
+	got := entity.ScrapedConcerts{later, yesterday, today}.Upcoming(now)
+	assert.Equal(t, entity.ScrapedConcerts{later, today}, got)
+
+	assert.Nil(t, entity.ScrapedConcerts{yesterday}.Upcoming(now))
+}
+
+func TestScrapedConcerts_Sort(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	matinee := &entity.ScrapedConcert{Title: "B", ListedVenueName: "Zepp Haneda", LocalDate: day, StartTime: day.Add(4 * time.Hour)}
+	evening := &entity.ScrapedConcert{Title: "B", ListedVenueName: "Zepp Haneda", LocalDate: day, StartTime: day.Add(9 * time.Hour)}
+	unknownStartA := &entity.ScrapedConcert{Title: "A", ListedVenueName: "Billboard Live Tokyo", LocalDate: day}
+	unknownStartB := &entity.ScrapedConcert{Title: "A", ListedVenueName: "Zepp Haneda", LocalDate: day}
+	unknownStartC := &entity.ScrapedConcert{Title: "C", ListedVenueName: "Zepp Haneda", LocalDate: day}
+	nextDay := &entity.ScrapedConcert{Title: "A", ListedVenueName: "Zepp Haneda", LocalDate: day.AddDate(0, 0, 1), StartTime: day.Add(24*time.Hour + time.Hour)}
+	want := entity.ScrapedConcerts{matinee, evening, unknownStartA, unknownStartB, unknownStartC, nextDay}
+
+	// Every rotation of the input sorts to the same order.
+	for i := range want {
+		in := append(slices.Clone(want[i:]), want[:i]...)
+		slices.Reverse(in)
+		in.Sort()
+		assert.Equal(t, want, in, "rotation %d", i)
+	}
+}
+
+func TestScrapedConcerts_Sort_StableForTies(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	first := &entity.ScrapedConcert{Title: "Tour", ListedVenueName: "Zepp Haneda", LocalDate: day, SourceURL: "https://a.example"}
+	second := &entity.ScrapedConcert{Title: "Tour", ListedVenueName: "Zepp Haneda", LocalDate: day, SourceURL: "https://b.example"}
+
+	ss := entity.ScrapedConcerts{first, second}
+	ss.Sort()
+
+	assert.Same(t, first, ss[0])
+	assert.Same(t, second, ss[1])
+}
+
 func TestScrapedConcert_JSONSerialization(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,12 @@ type Nullifier struct {
 	UseTime time.Time
 }
 
+// DefaultMerkleTreeDepth is the Merkle tree depth of the circom TicketCheck
+// circuit. It is the depth of new events, and of events created before the
+// depth was recorded per event whose tree was never built, so their first
+// build yields proofs the circuit accepts.
+const DefaultMerkleTreeDepth = 20
+
 // MerkleNode represents a single node in the Merkle tree stored in the database.
 type MerkleNode struct {
 	// EventID is the event this node belongs to.
@@ -28,6 +34,85 @@ type MerkleNode struct {
 	Hash []byte
 }
 
+// MerkleRootVersion is one entry of an event's Merkle root history: the root a
+// tree build produced and when it took effect. Successive versions show when
+// and how the ticket-holder set changed, for investigating entry-verification
+// disputes after a rebuild.
+type MerkleRootVersion struct {
+	// EventID is the event whose tree was built.
+	EventID string
+	// Root is the Merkle root hash the build produced.
+	Root []byte
+	// LeafCount is the number of identity commitments in the tree, excluding
+	// the zero-hash leaves that pad it to full depth.
+	LeafCount int
+	// BuildTime is when the build was committed.
+	BuildTime time.Time
+}
+
+// EntryVerificationOutcome is the result of one entry verification attempt.
+type EntryVerificationOutcome string
+
+const (
+	// EntryVerificationVerified marks an attempt that admitted the holder.
+	EntryVerificationVerified EntryVerificationOutcome = "verified"
+	// EntryVerificationRejected marks an attempt turned away at the gate; the
+	// audit's Reason says why.
+	EntryVerificationRejected EntryVerificationOutcome = "rejected"
+)
+
+// EntryVerificationAudit is an append-only record of one entry verification
+// outcome, kept so gate activity can be reconstructed and disputes
+// investigated after the event.
+//
+// EventID intentionally carries no foreign key: rejected attempts are audited
+// before the event is looked up, and records must survive event deletion.
+type EntryVerificationAudit struct {
+	// ID is the primary key (UUIDv7, application-generated).
+	ID string
+	// EventID is the event the entry pass was presented for.
+	EventID string
+	// NullifierHash is the nullifier hash from the proof's public signals.
+	NullifierHash []byte
+	// Outcome is whether the holder was admitted.
+	Outcome EntryVerificationOutcome
+	// Reason is why the attempt was rejected; empty when verified.
+	Reason EntryRejectionReason
+	// VerifyTime is when the attempt was decided.
+	VerifyTime time.Time
+}
+
+// NewEntryVerificationAudit creates an audit record with an auto-generated
+// UUIDv7 ID. An empty reason records a verified attempt, any other a
+// rejected one.
+func NewEntryVerificationAudit(eventID string, nullifierHash []byte, reason EntryRejectionReason, verifyTime time.Time) *EntryVerificationAudit {
+	outcome := EntryVerificationVerified
+	if reason != "" {
+		outcome = EntryVerificationRejected
+	}
+	return &EntryVerificationAudit{
+		ID:            newID(),
+		EventID:       eventID,
+		NullifierHash: nullifierHash,
+		Outcome:       outcome,
+		Reason:        reason,
+		VerifyTime:    verifyTime,
+	}
+}
+
+// EntryVerificationAuditRepository defines the append-only data access
+// interface for the entry verification audit.
+type EntryVerificationAuditRepository interface {
+	// Append inserts a new audit record. It is append-only; no update or
+	// delete operations are defined on this table.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: the event ID or nullifier hash is empty.
+	//   - Internal: database execution failure.
+	Append(ctx context.Context, audit *EntryVerificationAudit) error
+}
+
 // NullifierRepository defines the interface for nullifier data access.
 type NullifierRepository interface {
 	// Insert atomically inserts a nullifier hash for an event.
@@ -46,6 +131,36 @@ type NullifierRepository interface {
 	//   - InvalidArgument: eventID or nullifierHash is empty.
 	//   - Internal: database query failure.
 	Exists(ctx context.Context, eventID string, nullifierHash []byte) (bool, error)
+
+	// CountByEvent returns the number of nullifiers recorded for an event,
+	// i.e. the number of attendees checked in so far. An event without
+	// check-ins yields 0.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: database query failure.
+	CountByEvent(ctx context.Context, eventID string) (int, error)
+
+	// ListByEvent returns every nullifier hash recorded for an event in
+	// check-in order, for post-event reconciliation of who entered. An event
+	// without check-ins yields an empty slice.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: database query failure.
+	ListByEvent(ctx context.Context, eventID string) ([][]byte, error)
+
+	// Delete removes a recorded nullifier hash, undoing the check-in it
+	// represents so the same entry pass verifies again.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID or nullifierHash is empty.
+	//   - NotFound: the nullifier was never recorded for this event.
+	//   - Internal: database execution failure.
+	Delete(ctx context.Context, eventID string, nullifierHash []byte) error
 }
 
 // MerkleTreeRepository defines the interface for Merkle tree data access.
@@ -59,15 +174,18 @@ type MerkleTreeRepository interface {
 	//   - Internal: database execution failure.
 	StoreBatch(ctx context.Context, eventID string, nodes []*MerkleNode) error
 
-	// StoreBatchWithRoot atomically stores all Merkle tree nodes and updates
-	// the event's Merkle root in a single transaction.
+	// StoreBatchWithRoot atomically stores all Merkle tree nodes, updates
+	// the event's Merkle root, and appends the root to the event's root
+	// history in a single transaction. It returns the event's new root
+	// version: versions start at 1 and increase by one with every root
+	// written for the event.
 	//
 	// # Possible errors
 	//
 	//   - InvalidArgument: eventID is empty.
 	//   - NotFound: event does not exist.
 	//   - Internal: database execution failure.
-	StoreBatchWithRoot(ctx context.Context, eventID string, nodes []*MerkleNode, root []byte) error
+	StoreBatchWithRoot(ctx context.Context, eventID string, nodes []*MerkleNode, root []byte) (int, error)
 
 	// GetPath retrieves the Merkle path (sibling hashes and indices) for a
 	// leaf at the given index for the specified event.
@@ -96,6 +214,27 @@ type MerkleTreeRepository interface {
 	//   - NotFound: no leaf exists at the specified index.
 	//   - Internal: database query failure.
 	GetLeaf(ctx context.Context, eventID string, leafIndex int) ([]byte, error)
+
+	// GetRootHistory returns every root StoreBatchWithRoot has recorded for
+	// the event, newest first. An event whose tree was never built has an
+	// empty history.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: database query failure.
+	GetRootHistory(ctx context.Context, eventID string) ([]*MerkleRootVersion, error)
+
+	// ListNodes returns every stored node of the event's Merkle tree, ordered
+	// by depth and then node index. An event whose tree was never built has
+	// no nodes. A full tree holds 2^(depth+1)-1 nodes, so this is meant for
+	// diagnostics rather than request paths.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: database query failure.
+	ListNodes(ctx context.Context, eventID string) ([]*MerkleNode, error)
 }
 
 // ZKPVerifier defines the interface for zero-knowledge proof verification.
@@ -113,7 +252,7 @@ type ZKPVerifier interface {
 }
 
 // EventRepository defines the interface for event-related data access
-// needed by the entry system.
+// needed by the entry system and for venue-level event listings.
 type EventRepository interface {
 	// GetMerkleRoot retrieves the Merkle root for an event.
 	//
@@ -124,22 +263,50 @@ type EventRepository interface {
 	//   - Internal: database query failure.
 	GetMerkleRoot(ctx context.Context, eventID string) ([]byte, error)
 
-	// UpdateMerkleRoot sets the Merkle root for an event.
+	// UpdateMerkleRoot sets the Merkle root for an event and returns the
+	// event's new root version. Versions start at 1 and increase by one with
+	// every root written for the event, including those written alongside a
+	// tree build.
 	//
 	// # Possible errors
 	//
 	//   - InvalidArgument: eventID is empty.
 	//   - NotFound: event does not exist.
 	//   - Internal: database execution failure.
-	UpdateMerkleRoot(ctx context.Context, eventID string, root []byte) error
+	UpdateMerkleRoot(ctx context.Context, eventID string, root []byte) (int, error)
 
 	// GetTicketLeafIndex returns the leaf index in the Merkle tree for a user's
-	// ticket at a given event. Returns -1 if the user has no ticket.
+	// ticket at a given event, as persisted by the tree build that first
+	// included the ticket.
 	//
 	// # Possible errors
 	//
 	//   - InvalidArgument: eventID or userID is empty.
 	//   - NotFound: user has no ticket for this event.
+	//   - FailedPrecondition: the tree has not been built since the ticket was minted.
 	//   - Internal: database query failure.
 	GetTicketLeafIndex(ctx context.Context, eventID, userID string) (int, error)
+
+	// GetTreeDepth returns the depth of the event's entry Merkle tree. Events
+	// created before the depth was recorded report the depth of their stored
+	// tree, or DefaultMerkleTreeDepth when no tree has been built.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - NotFound: event does not exist.
+	//   - Internal: database query failure.
+	GetTreeDepth(ctx context.Context, eventID string) (int, error)
+
+	// ListByVenueAndDateRange returns the events at a venue whose local date
+	// falls within [from, to] (both inclusive), independent of which artists
+	// perform. Each result carries its Venue, parent Series, and the full
+	// lineup. Results are ordered by local date, then start time (unknown
+	// last).
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: venueID is empty or to is before from.
+	//   - Internal: database query failure.
+	ListByVenueAndDateRange(ctx context.Context, venueID string, from, to time.Time) ([]*EventLineup, error)
 }
@@ -20,9 +20,12 @@ type Event struct {
 	ID string
 	// SeriesID is the foreign key reference to the parent [Series].
 	SeriesID string
-	// VenueID is the ID of the venue where the event takes place.
+	// VenueID is the ID of the venue where the event takes place. Empty while
+	// the venue is pending resolution, in which case ListedVenueName is set;
+	// listings that join the venue omit such events until it is resolved.
 	VenueID string
-	// Venue is the resolved venue entity. Populated by the server on read operations.
+	// Venue is the resolved venue entity. Populated by the server on read
+	// operations; nil while the venue is pending resolution.
 	Venue *Venue
 	// ListedVenueName is the raw venue name as listed in the source data.
 	// It preserves the original scraped text separately from the normalized Venue.Name.
@@ -40,4 +43,46 @@ type Event struct {
 	StartTime *time.Time
 	// OpenTime is the time when doors open (optional).
 	OpenTime *time.Time
+	// SearchSessionID identifies the discovery search session that first
+	// created this event. A later session re-discovering the same physical
+	// event does not replace it. Nil for events created outside discovery.
+	SearchSessionID *string
+	// DeletedAt is when an admin soft-deleted the event. Nil while the event
+	// is live.
+	DeletedAt *time.Time
+}
+
+// IsPast reports whether the event's local date is before the calendar date
+// of now in the venue's time zone (see EventLocation). An event taking place
+// today is not past. An event pending venue resolution is judged in Anywhere
+// on Earth.
+func (e *Event) IsPast(now time.Time) bool {
+	var adminArea *string
+	if e.Venue != nil {
+		adminArea = e.Venue.AdminArea
+	}
+	y, m, d := now.In(EventLocation(adminArea)).Date()
+	return e.LocalDate.Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// Performer is one artist on an event's lineup.
+type Performer struct {
+	// Artist is the performing artist.
+	Artist *Artist
+	// SetStartTime is the artist's own set time within the event, when it
+	// differs from the event's start (e.g. a festival slot). Nil when unknown
+	// or when the artist plays at the event's StartTime.
+	SetStartTime *time.Time
+}
+
+// EventLineup is the venue-level view of an event, independent of any one
+// artist: the event with its parent series and every artist performing at it.
+// Festival pages use it to render a day's full lineup.
+type EventLineup struct {
+	Event
+	// Series is the parent series (festival, tour, or single run).
+	Series *Series
+	// Performers lists every artist on the lineup, ordered by set time with
+	// unknown set times last.
+	Performers []*Performer
 }
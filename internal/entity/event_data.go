@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 // Event subject constants for domain events published via messaging.
 //
 // Subjects follow the UPPERCASE two-segment convention enforced by the
@@ -31,6 +33,15 @@ const (
 	// SubjectSalesPhaseReminderDue is published by the reminder scan for each
 	// (user, phase, stage) triple that became due and has not yet been sent.
 	SubjectSalesPhaseReminderDue = "SALES_PHASE.reminder.due"
+	// SubjectConcertReminderDue is published by the concert reminder scan for
+	// each (user, event) pair whose reminder became due on the user's lead
+	// time and has not yet been sent.
+	SubjectConcertReminderDue = "CONCERT.reminder_due"
+	// SubjectConcertRescheduled is published by the concert approval path
+	// once a reviewer approves a staged reschedule and the published event
+	// has moved to its new date. It drives the notice to the event's ticket
+	// holders.
+	SubjectConcertRescheduled = "CONCERT.rescheduled"
 	// SubjectTicketJourneyStatusChanged is published by SetStatus after a
 	// successful upsert when the new status differs from the prior one (or
 	// when no prior journey existed). It drives the
@@ -54,6 +65,12 @@ const (
 	// subject is never published on a silent token refresh — login-specific by
 	// construction. Uses a new ACCOUNT.* JetStream stream.
 	SubjectAccountLogin = "ACCOUNT.login"
+	// SubjectVenueEnrichmentRequested is published when a venues row is
+	// inserted for a venue the system has not stored before. A venue reused
+	// by place ID or listed name, including one created concurrently by
+	// another approval, must NOT publish this event. Matches the existing
+	// VENUE.* JetStream stream.
+	SubjectVenueEnrichmentRequested = "VENUE.enrichment_requested"
 )
 
 // AllSubjects is the canonical catalogue of every domain-event NATS subject
@@ -77,19 +94,23 @@ var AllSubjects = []string{
 	SubjectEntryZkProofRejected,
 	SubjectSalesPhaseDiscovered,
 	SubjectSalesPhaseReminderDue,
+	SubjectConcertReminderDue,
+	SubjectConcertRescheduled,
 	SubjectTicketJourneyStatusChanged,
 	SubjectTicketMintCompleted,
 	SubjectTicketEmailParsed,
 	SubjectAccountLogin,
+	SubjectVenueEnrichmentRequested,
 }
 
-// EntryRejectionReason enumerates the legitimate causes for a
-// zk-proof entry rejection. Carried on the entry.zk_proof.rejected
+// EntryRejectionReason enumerates the causes for a zk-proof entry
+// rejection. Legitimate reasons are carried on the entry.zk_proof.rejected
 // analytics event so operations dashboards can break down
 // check-in-failure rate by cause. Parse-error and event-id-mismatch
 // paths return errors instead of rejections and intentionally do NOT
 // fire the analytics event — those are attacks or upstream bugs, not
-// legitimate user attempts.
+// legitimate user attempts — but are still recorded in the entry
+// verification audit for dispute investigation.
 type EntryRejectionReason string
 
 // Legitimate entry.zk_proof.rejected reasons.
@@ -97,6 +118,15 @@ const (
 	EntryRejectionMerkleRootMismatch EntryRejectionReason = "merkle_root_mismatch"
 	EntryRejectionAlreadyCheckedIn   EntryRejectionReason = "already_checked_in"
 	EntryRejectionProofInvalid       EntryRejectionReason = "proof_invalid"
+	EntryRejectionPassExpired        EntryRejectionReason = "pass_expired"
+	EntryRejectionPassNotYetValid    EntryRejectionReason = "pass_not_yet_valid"
+)
+
+// Audit-only rejection reasons for malformed or forged requests.
+const (
+	EntryRejectionMalformedSignals EntryRejectionReason = "malformed_signals"
+	EntryRejectionMalformedProof   EntryRejectionReason = "malformed_proof"
+	EntryRejectionEventIDMismatch  EntryRejectionReason = "event_id_mismatch"
 )
 
 // ConcertDiscoveredData is the payload for concert.discovered.v1 events.
@@ -109,6 +139,22 @@ type ConcertDiscoveredData struct {
 	ArtistName string `json:"artist_name"`
 	// Concerts is the list of newly discovered, deduplicated scraped concerts.
 	Concerts ScrapedConcerts `json:"concerts"`
+	// SearchSessionID identifies the search run that produced this batch
+	// (UUIDv7). Redeliveries of the message carry the same ID, so every row
+	// staged or published from the batch is attributable to one run. Empty
+	// for payloads published before sessions were recorded.
+	SearchSessionID string `json:"search_session_id,omitempty"`
+}
+
+// VenueEnrichmentRequestedData is the payload for VENUE.enrichment_requested
+// events. Published by the concert approval path after it creates a venue, so
+// the venue consumer can canonicalize it off the approval request path.
+type VenueEnrichmentRequestedData struct {
+	// VenueID is the internal UUID of the newly created venue.
+	VenueID string `json:"venue_id"`
+	// ListedVenueName is the raw scraped venue name the venue was created
+	// from.
+	ListedVenueName string `json:"listed_venue_name"`
 }
 
 // UserCreatedData is the payload for user.created events.
@@ -278,6 +324,39 @@ type SalesPhaseReminderDueData struct {
 	Payload *NotificationPayload `json:"payload"`
 }
 
+// ConcertReminderDueData is the payload for CONCERT.reminder_due events.
+// Published by the concert reminder scan for each (user, event) pair that
+// became due.
+type ConcertReminderDueData struct {
+	// UserID is the recipient.
+	UserID string `json:"user_id"`
+	// EventID is the event the reminder is for.
+	EventID string `json:"event_id"`
+	// Payload is the pre-built notification payload for this recipient,
+	// rendered in the user's timezone and preferred_language.
+	Payload *NotificationPayload `json:"payload"`
+}
+
+// ConcertRescheduledData is the payload for CONCERT.rescheduled events.
+// Published by the concert approval path after it moves a published event to
+// the date of an approved reschedule.
+type ConcertRescheduledData struct {
+	// EventID is the event that moved.
+	EventID string `json:"event_id"`
+	// ArtistID is the performing artist the reschedule was discovered for.
+	ArtistID string `json:"artist_id"`
+	// Title is the concert title, for the notice copy.
+	Title string `json:"title"`
+	// ListedVenueName is the venue name as listed for the event, for the
+	// notice copy.
+	ListedVenueName string `json:"listed_venue_name"`
+	// PreviousLocalDate is the date the event was scheduled on before the
+	// move.
+	PreviousLocalDate time.Time `json:"previous_local_date"`
+	// LocalDate is the new date of the event.
+	LocalDate time.Time `json:"local_date"`
+}
+
 // TicketMintCompletedData is the payload for TICKET.mint_completed.
 // Mapped to the catalogue event ticket.mint.completed by the
 // analytics-consumer. Published by TicketUseCase.MintTicket after a ticket is
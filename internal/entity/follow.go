@@ -1,6 +1,9 @@
 package entity
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Hype represents the user's enthusiasm tier for a followed artist.
 // Values are ordered by ascending enthusiasm: Watch (lowest) to Away (highest).
@@ -116,6 +119,33 @@ type Follower struct {
 	Hype Hype
 }
 
+// FollowAction identifies the kind of change recorded in a user's follow history.
+type FollowAction string
+
+const (
+	// FollowActionFollow records that the user started following the artist.
+	FollowActionFollow FollowAction = "follow"
+	// FollowActionUnfollow records that the user stopped following the artist.
+	FollowActionUnfollow FollowAction = "unfollow"
+	// FollowActionHypeChange records that the user changed their hype tier.
+	FollowActionHypeChange FollowAction = "hype_change"
+)
+
+// FollowHistoryEntry is one append-only record of a follow mutation, kept so a
+// user's follow history can be reconstructed for auditing and debugging.
+type FollowHistoryEntry struct {
+	// UserID is the internal UUID of the user who performed the action.
+	UserID string
+	// ArtistID is the internal UUID of the artist the action applies to.
+	ArtistID string
+	// Action is the kind of change.
+	Action FollowAction
+	// Hype is the tier in effect after the action. Empty for unfollow.
+	Hype Hype
+	// OccurredAt is when the mutation was committed.
+	OccurredAt time.Time
+}
+
 // FollowRepository defines the persistence layer operations for follow relationships.
 type FollowRepository interface {
 	// Follow records a user's interest in an artist for notification purposes.
@@ -134,7 +164,8 @@ type FollowRepository interface {
 	//   - Internal: database execution failure.
 	Unfollow(ctx context.Context, userID, artistID string) error
 
-	// SetHype updates the enthusiasm tier for a followed artist.
+	// SetHype updates the enthusiasm tier for a followed artist. Setting the
+	// current hype again succeeds without recording history.
 	//
 	// # Possible errors:
 	//
@@ -157,6 +188,15 @@ type FollowRepository interface {
 	//   - Internal: database query failure.
 	ListAll(ctx context.Context) ([]*Artist, error)
 
+	// ListMostFollowed retrieves up to limit artists ordered by follower count,
+	// most-followed first. Ties are broken by artist ID for a stable order.
+	//
+	// # Possible errors:
+	//
+	//   - InvalidArgument: limit is not positive.
+	//   - Internal: database query failure.
+	ListMostFollowed(ctx context.Context, limit int) ([]*Artist, error)
+
 	// ListFollowers retrieves all users following the given artist along with
 	// their hype level. User entities are partially populated with ID, Home, and
 	// PreferredLanguage for notification filtering and copy localization. Returns
@@ -166,4 +206,23 @@ type FollowRepository interface {
 	//
 	//   - Internal: database query failure.
 	ListFollowers(ctx context.Context, artistID string) ([]*Follower, error)
+
+	// TopHype returns the highest hype any follower of the artist has chosen,
+	// in the order HypeWatch < HypeHome < HypeNearby < HypeAway. Returns an
+	// empty Hype when no users follow the artist.
+	//
+	// # Possible errors:
+	//
+	//   - Internal: database query failure.
+	TopHype(ctx context.Context, artistID string) (Hype, error)
+
+	// ListHistory retrieves every follow, unfollow, and hype change the user
+	// has made, oldest first. Follow, Unfollow, and SetHype append to this
+	// history only when they change state, so a repeated follow or an unfollow
+	// of an artist not followed leaves no entry.
+	//
+	// # Possible errors:
+	//
+	//   - Internal: database query failure.
+	ListHistory(ctx context.Context, userID string) ([]*FollowHistoryEntry, error)
 }
@@ -11,13 +11,14 @@ type MerkleTreeBuilder interface {
 	//   - Internal: hash computation failure.
 	IdentityCommitment(userID []byte) ([]byte, error)
 
-	// Build constructs a full Merkle tree from the given leaves.
+	// Build constructs a full Merkle tree of the given depth from the leaves.
 	// Empty positions are filled with a zero hash. Returns all nodes
 	// (including leaves) and the root hash.
 	//
 	// # Possible errors
 	//
-	//   - InvalidArgument: number of leaves exceeds tree capacity.
+	//   - InvalidArgument: depth is out of the supported range, or the number
+	//     of leaves exceeds tree capacity.
 	//   - Internal: hash computation failure.
-	Build(eventID string, leaves [][]byte) ([]*MerkleNode, []byte, error)
+	Build(eventID string, depth int, leaves [][]byte) ([]*MerkleNode, []byte, error)
 }
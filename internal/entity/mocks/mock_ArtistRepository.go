@@ -379,6 +379,65 @@ func (_c *MockArtistRepository_List_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
+// ListArtistsWithoutOfficialSite provides a mock function with given fields: ctx, limit
+func (_m *MockArtistRepository) ListArtistsWithoutOfficialSite(ctx context.Context, limit int) ([]*entity.Artist, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArtistsWithoutOfficialSite")
+	}
+
+	var r0 []*entity.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.Artist, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.Artist); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArtistRepository_ListArtistsWithoutOfficialSite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArtistsWithoutOfficialSite'
+type MockArtistRepository_ListArtistsWithoutOfficialSite_Call struct {
+	*mock.Call
+}
+
+// ListArtistsWithoutOfficialSite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockArtistRepository_Expecter) ListArtistsWithoutOfficialSite(ctx interface{}, limit interface{}) *MockArtistRepository_ListArtistsWithoutOfficialSite_Call {
+	return &MockArtistRepository_ListArtistsWithoutOfficialSite_Call{Call: _e.mock.On("ListArtistsWithoutOfficialSite", ctx, limit)}
+}
+
+func (_c *MockArtistRepository_ListArtistsWithoutOfficialSite_Call) Run(run func(ctx context.Context, limit int)) *MockArtistRepository_ListArtistsWithoutOfficialSite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockArtistRepository_ListArtistsWithoutOfficialSite_Call) Return(_a0 []*entity.Artist, _a1 error) *MockArtistRepository_ListArtistsWithoutOfficialSite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArtistRepository_ListArtistsWithoutOfficialSite_Call) RunAndReturn(run func(context.Context, int) ([]*entity.Artist, error)) *MockArtistRepository_ListArtistsWithoutOfficialSite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByMBIDs provides a mock function with given fields: ctx, mbids
 func (_m *MockArtistRepository) ListByMBIDs(ctx context.Context, mbids []string) ([]*entity.Artist, error) {
 	ret := _m.Called(ctx, mbids)
@@ -438,6 +497,132 @@ func (_c *MockArtistRepository_ListByMBIDs_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListOfficialSites provides a mock function with given fields: ctx, artistID
+func (_m *MockArtistRepository) ListOfficialSites(ctx context.Context, artistID string) ([]*entity.OfficialSite, error) {
+	ret := _m.Called(ctx, artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOfficialSites")
+	}
+
+	var r0 []*entity.OfficialSite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.OfficialSite, error)); ok {
+		return rf(ctx, artistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.OfficialSite); ok {
+		r0 = rf(ctx, artistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.OfficialSite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, artistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockArtistRepository_ListOfficialSites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOfficialSites'
+type MockArtistRepository_ListOfficialSites_Call struct {
+	*mock.Call
+}
+
+// ListOfficialSites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistID string
+func (_e *MockArtistRepository_Expecter) ListOfficialSites(ctx interface{}, artistID interface{}) *MockArtistRepository_ListOfficialSites_Call {
+	return &MockArtistRepository_ListOfficialSites_Call{Call: _e.mock.On("ListOfficialSites", ctx, artistID)}
+}
+
+func (_c *MockArtistRepository_ListOfficialSites_Call) Run(run func(ctx context.Context, artistID string)) *MockArtistRepository_ListOfficialSites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockArtistRepository_ListOfficialSites_Call) Return(_a0 []*entity.OfficialSite, _a1 error) *MockArtistRepository_ListOfficialSites_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockArtistRepository_ListOfficialSites_Call) RunAndReturn(run func(context.Context, string) ([]*entity.OfficialSite, error)) *MockArtistRepository_ListOfficialSites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPage provides a mock function with given fields: ctx, limit, cursor
+func (_m *MockArtistRepository) ListPage(ctx context.Context, limit int, cursor string) ([]*entity.Artist, string, error) {
+	ret := _m.Called(ctx, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPage")
+	}
+
+	var r0 []*entity.Artist
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]*entity.Artist, string, error)); ok {
+		return rf(ctx, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []*entity.Artist); ok {
+		r0 = rf(ctx, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) string); ok {
+		r1 = rf(ctx, limit, cursor)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, string) error); ok {
+		r2 = rf(ctx, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockArtistRepository_ListPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPage'
+type MockArtistRepository_ListPage_Call struct {
+	*mock.Call
+}
+
+// ListPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - cursor string
+func (_e *MockArtistRepository_Expecter) ListPage(ctx interface{}, limit interface{}, cursor interface{}) *MockArtistRepository_ListPage_Call {
+	return &MockArtistRepository_ListPage_Call{Call: _e.mock.On("ListPage", ctx, limit, cursor)}
+}
+
+func (_c *MockArtistRepository_ListPage_Call) Run(run func(ctx context.Context, limit int, cursor string)) *MockArtistRepository_ListPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockArtistRepository_ListPage_Call) Return(_a0 []*entity.Artist, _a1 string, _a2 error) *MockArtistRepository_ListPage_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockArtistRepository_ListPage_Call) RunAndReturn(run func(context.Context, int, string) ([]*entity.Artist, string, error)) *MockArtistRepository_ListPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListStaleOrMissingFanart provides a mock function with given fields: ctx, staleDuration, limit
 func (_m *MockArtistRepository) ListStaleOrMissingFanart(ctx context.Context, staleDuration time.Duration, limit int) ([]*entity.Artist, error) {
 	ret := _m.Called(ctx, staleDuration, limit)
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // MockCache is an autogenerated mock type for the Cache type
 type MockCache struct {
@@ -17,6 +21,39 @@ func (_m *MockCache) EXPECT() *MockCache_Expecter {
 	return &MockCache_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: key
+func (_m *MockCache) Delete(key string) {
+	_m.Called(key)
+}
+
+// MockCache_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCache_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - key string
+func (_e *MockCache_Expecter) Delete(key interface{}) *MockCache_Delete_Call {
+	return &MockCache_Delete_Call{Call: _e.mock.On("Delete", key)}
+}
+
+func (_c *MockCache_Delete_Call) Run(run func(key string)) *MockCache_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_Delete_Call) Return() *MockCache_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCache_Delete_Call) RunAndReturn(run func(string)) *MockCache_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: key
 func (_m *MockCache) Get(key string) interface{} {
 	ret := _m.Called(key)
@@ -99,6 +136,41 @@ func (_c *MockCache_Set_Call) RunAndReturn(run func(string, interface{})) *MockC
 	return _c
 }
 
+// SetWithTTL provides a mock function with given fields: key, value, ttl
+func (_m *MockCache) SetWithTTL(key string, value interface{}, ttl time.Duration) {
+	_m.Called(key, value, ttl)
+}
+
+// MockCache_SetWithTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWithTTL'
+type MockCache_SetWithTTL_Call struct {
+	*mock.Call
+}
+
+// SetWithTTL is a helper method to define mock.On call
+//   - key string
+//   - value interface{}
+//   - ttl time.Duration
+func (_e *MockCache_Expecter) SetWithTTL(key interface{}, value interface{}, ttl interface{}) *MockCache_SetWithTTL_Call {
+	return &MockCache_SetWithTTL_Call{Call: _e.mock.On("SetWithTTL", key, value, ttl)}
+}
+
+func (_c *MockCache_SetWithTTL_Call) Run(run func(key string, value interface{}, ttl time.Duration)) *MockCache_SetWithTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockCache_SetWithTTL_Call) Return() *MockCache_SetWithTTL_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCache_SetWithTTL_Call) RunAndReturn(run func(string, interface{}, time.Duration)) *MockCache_SetWithTTL_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockCache creates a new instance of MockCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCache(t interface {
@@ -24,6 +24,64 @@ func (_m *MockConcertRepository) EXPECT() *MockConcertRepository_Expecter {
 	return &MockConcertRepository_Expecter{mock: &_m.Mock}
 }
 
+// AssignVenue provides a mock function with given fields: ctx, eventID, venueID
+func (_m *MockConcertRepository) AssignVenue(ctx context.Context, eventID string, venueID string) (string, error) {
+	ret := _m.Called(ctx, eventID, venueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignVenue")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, eventID, venueID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, eventID, venueID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, eventID, venueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_AssignVenue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignVenue'
+type MockConcertRepository_AssignVenue_Call struct {
+	*mock.Call
+}
+
+// AssignVenue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - venueID string
+func (_e *MockConcertRepository_Expecter) AssignVenue(ctx interface{}, eventID interface{}, venueID interface{}) *MockConcertRepository_AssignVenue_Call {
+	return &MockConcertRepository_AssignVenue_Call{Call: _e.mock.On("AssignVenue", ctx, eventID, venueID)}
+}
+
+func (_c *MockConcertRepository_AssignVenue_Call) Run(run func(ctx context.Context, eventID string, venueID string)) *MockConcertRepository_AssignVenue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_AssignVenue_Call) Return(_a0 string, _a1 error) *MockConcertRepository_AssignVenue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_AssignVenue_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockConcertRepository_AssignVenue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, concerts
 func (_m *MockConcertRepository) Create(ctx context.Context, concerts ...*entity.Concert) ([]string, error) {
 	_va := make([]interface{}, len(concerts))
@@ -144,6 +202,66 @@ func (_c *MockConcertRepository_Delete_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ExistsBySource provides a mock function with given fields: ctx, artistID, sourceURL, localDate, listedVenueName
+func (_m *MockConcertRepository) ExistsBySource(ctx context.Context, artistID string, sourceURL string, localDate time.Time, listedVenueName string) (bool, error) {
+	ret := _m.Called(ctx, artistID, sourceURL, localDate, listedVenueName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsBySource")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) (bool, error)); ok {
+		return rf(ctx, artistID, sourceURL, localDate, listedVenueName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, string) bool); ok {
+		r0 = rf(ctx, artistID, sourceURL, localDate, listedVenueName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, string) error); ok {
+		r1 = rf(ctx, artistID, sourceURL, localDate, listedVenueName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_ExistsBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsBySource'
+type MockConcertRepository_ExistsBySource_Call struct {
+	*mock.Call
+}
+
+// ExistsBySource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistID string
+//   - sourceURL string
+//   - localDate time.Time
+//   - listedVenueName string
+func (_e *MockConcertRepository_Expecter) ExistsBySource(ctx interface{}, artistID interface{}, sourceURL interface{}, localDate interface{}, listedVenueName interface{}) *MockConcertRepository_ExistsBySource_Call {
+	return &MockConcertRepository_ExistsBySource_Call{Call: _e.mock.On("ExistsBySource", ctx, artistID, sourceURL, localDate, listedVenueName)}
+}
+
+func (_c *MockConcertRepository_ExistsBySource_Call) Run(run func(ctx context.Context, artistID string, sourceURL string, localDate time.Time, listedVenueName string)) *MockConcertRepository_ExistsBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_ExistsBySource_Call) Return(_a0 bool, _a1 error) *MockConcertRepository_ExistsBySource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_ExistsBySource_Call) RunAndReturn(run func(context.Context, string, string, time.Time, string) (bool, error)) *MockConcertRepository_ExistsBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FillEventStartTimes provides a mock function with given fields: ctx, eventIDs, startTimes, openTimes
 func (_m *MockConcertRepository) FillEventStartTimes(ctx context.Context, eventIDs []string, startTimes []*time.Time, openTimes []*time.Time) error {
 	ret := _m.Called(ctx, eventIDs, startTimes, openTimes)
@@ -371,6 +489,66 @@ func (_c *MockConcertRepository_ListByArtist_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ListByArtistAll provides a mock function with given fields: ctx, artistID, includeDeleted
+func (_m *MockConcertRepository) ListByArtistAll(ctx context.Context, artistID string, includeDeleted bool) ([]*entity.Concert, error) {
+	ret := _m.Called(ctx, artistID, includeDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByArtistAll")
+	}
+
+	var r0 []*entity.Concert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]*entity.Concert, error)); ok {
+		return rf(ctx, artistID, includeDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []*entity.Concert); ok {
+		r0 = rf(ctx, artistID, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, artistID, includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_ListByArtistAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByArtistAll'
+type MockConcertRepository_ListByArtistAll_Call struct {
+	*mock.Call
+}
+
+// ListByArtistAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistID string
+//   - includeDeleted bool
+func (_e *MockConcertRepository_Expecter) ListByArtistAll(ctx interface{}, artistID interface{}, includeDeleted interface{}) *MockConcertRepository_ListByArtistAll_Call {
+	return &MockConcertRepository_ListByArtistAll_Call{Call: _e.mock.On("ListByArtistAll", ctx, artistID, includeDeleted)}
+}
+
+func (_c *MockConcertRepository_ListByArtistAll_Call) Run(run func(ctx context.Context, artistID string, includeDeleted bool)) *MockConcertRepository_ListByArtistAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_ListByArtistAll_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertRepository_ListByArtistAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_ListByArtistAll_Call) RunAndReturn(run func(context.Context, string, bool) ([]*entity.Concert, error)) *MockConcertRepository_ListByArtistAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByArtists provides a mock function with given fields: ctx, artistIDs
 func (_m *MockConcertRepository) ListByArtists(ctx context.Context, artistIDs []string) ([]*entity.Concert, error) {
 	ret := _m.Called(ctx, artistIDs)
@@ -430,34 +608,41 @@ func (_c *MockConcertRepository_ListByArtists_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// ListByFollower provides a mock function with given fields: ctx, userID
-func (_m *MockConcertRepository) ListByFollower(ctx context.Context, userID string) ([]*entity.Concert, error) {
-	ret := _m.Called(ctx, userID)
+// ListByFollower provides a mock function with given fields: ctx, userID, limit, cursor
+func (_m *MockConcertRepository) ListByFollower(ctx context.Context, userID string, limit int, cursor string) ([]*entity.Concert, string, error) {
+	ret := _m.Called(ctx, userID, limit, cursor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListByFollower")
 	}
 
 	var r0 []*entity.Concert
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Concert, error)); ok {
-		return rf(ctx, userID)
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) ([]*entity.Concert, string, error)); ok {
+		return rf(ctx, userID, limit, cursor)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Concert); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) []*entity.Concert); ok {
+		r0 = rf(ctx, userID, limit, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.Concert)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) string); ok {
+		r1 = rf(ctx, userID, limit, cursor)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, string) error); ok {
+		r2 = rf(ctx, userID, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockConcertRepository_ListByFollower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByFollower'
@@ -468,23 +653,25 @@ type MockConcertRepository_ListByFollower_Call struct {
 // ListByFollower is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *MockConcertRepository_Expecter) ListByFollower(ctx interface{}, userID interface{}) *MockConcertRepository_ListByFollower_Call {
-	return &MockConcertRepository_ListByFollower_Call{Call: _e.mock.On("ListByFollower", ctx, userID)}
+//   - limit int
+//   - cursor string
+func (_e *MockConcertRepository_Expecter) ListByFollower(ctx interface{}, userID interface{}, limit interface{}, cursor interface{}) *MockConcertRepository_ListByFollower_Call {
+	return &MockConcertRepository_ListByFollower_Call{Call: _e.mock.On("ListByFollower", ctx, userID, limit, cursor)}
 }
 
-func (_c *MockConcertRepository_ListByFollower_Call) Run(run func(ctx context.Context, userID string)) *MockConcertRepository_ListByFollower_Call {
+func (_c *MockConcertRepository_ListByFollower_Call) Run(run func(ctx context.Context, userID string, limit int, cursor string)) *MockConcertRepository_ListByFollower_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockConcertRepository_ListByFollower_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertRepository_ListByFollower_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockConcertRepository_ListByFollower_Call) Return(_a0 []*entity.Concert, _a1 string, _a2 error) *MockConcertRepository_ListByFollower_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockConcertRepository_ListByFollower_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Concert, error)) *MockConcertRepository_ListByFollower_Call {
+func (_c *MockConcertRepository_ListByFollower_Call) RunAndReturn(run func(context.Context, string, int, string) ([]*entity.Concert, string, error)) *MockConcertRepository_ListByFollower_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -548,6 +735,503 @@ func (_c *MockConcertRepository_ListByIDs_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListBySearchSession provides a mock function with given fields: ctx, sessionID
+func (_m *MockConcertRepository) ListBySearchSession(ctx context.Context, sessionID string) ([]*entity.Concert, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySearchSession")
+	}
+
+	var r0 []*entity.Concert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Concert, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Concert); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_ListBySearchSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBySearchSession'
+type MockConcertRepository_ListBySearchSession_Call struct {
+	*mock.Call
+}
+
+// ListBySearchSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockConcertRepository_Expecter) ListBySearchSession(ctx interface{}, sessionID interface{}) *MockConcertRepository_ListBySearchSession_Call {
+	return &MockConcertRepository_ListBySearchSession_Call{Call: _e.mock.On("ListBySearchSession", ctx, sessionID)}
+}
+
+func (_c *MockConcertRepository_ListBySearchSession_Call) Run(run func(ctx context.Context, sessionID string)) *MockConcertRepository_ListBySearchSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_ListBySearchSession_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertRepository_ListBySearchSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_ListBySearchSession_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Concert, error)) *MockConcertRepository_ListBySearchSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByVenue provides a mock function with given fields: ctx, venueID, upcomingOnly
+func (_m *MockConcertRepository) ListByVenue(ctx context.Context, venueID string, upcomingOnly bool) ([]*entity.Concert, error) {
+	ret := _m.Called(ctx, venueID, upcomingOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByVenue")
+	}
+
+	var r0 []*entity.Concert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]*entity.Concert, error)); ok {
+		return rf(ctx, venueID, upcomingOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []*entity.Concert); ok {
+		r0 = rf(ctx, venueID, upcomingOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, venueID, upcomingOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_ListByVenue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByVenue'
+type MockConcertRepository_ListByVenue_Call struct {
+	*mock.Call
+}
+
+// ListByVenue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+//   - upcomingOnly bool
+func (_e *MockConcertRepository_Expecter) ListByVenue(ctx interface{}, venueID interface{}, upcomingOnly interface{}) *MockConcertRepository_ListByVenue_Call {
+	return &MockConcertRepository_ListByVenue_Call{Call: _e.mock.On("ListByVenue", ctx, venueID, upcomingOnly)}
+}
+
+func (_c *MockConcertRepository_ListByVenue_Call) Run(run func(ctx context.Context, venueID string, upcomingOnly bool)) *MockConcertRepository_ListByVenue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_ListByVenue_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertRepository_ListByVenue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_ListByVenue_Call) RunAndReturn(run func(context.Context, string, bool) ([]*entity.Concert, error)) *MockConcertRepository_ListByVenue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingVenue provides a mock function with given fields: ctx, limit
+func (_m *MockConcertRepository) ListPendingVenue(ctx context.Context, limit int) ([]*entity.Concert, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingVenue")
+	}
+
+	var r0 []*entity.Concert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.Concert, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.Concert); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_ListPendingVenue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingVenue'
+type MockConcertRepository_ListPendingVenue_Call struct {
+	*mock.Call
+}
+
+// ListPendingVenue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockConcertRepository_Expecter) ListPendingVenue(ctx interface{}, limit interface{}) *MockConcertRepository_ListPendingVenue_Call {
+	return &MockConcertRepository_ListPendingVenue_Call{Call: _e.mock.On("ListPendingVenue", ctx, limit)}
+}
+
+func (_c *MockConcertRepository_ListPendingVenue_Call) Run(run func(ctx context.Context, limit int)) *MockConcertRepository_ListPendingVenue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_ListPendingVenue_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertRepository_ListPendingVenue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_ListPendingVenue_Call) RunAndReturn(run func(context.Context, int) ([]*entity.Concert, error)) *MockConcertRepository_ListPendingVenue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUpcomingGlobal provides a mock function with given fields: ctx, filter, limit, cursor
+func (_m *MockConcertRepository) ListUpcomingGlobal(ctx context.Context, filter entity.UpcomingConcertFilter, limit int, cursor string) ([]*entity.Concert, string, error) {
+	ret := _m.Called(ctx, filter, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUpcomingGlobal")
+	}
+
+	var r0 []*entity.Concert
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UpcomingConcertFilter, int, string) ([]*entity.Concert, string, error)); ok {
+		return rf(ctx, filter, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.UpcomingConcertFilter, int, string) []*entity.Concert); ok {
+		r0 = rf(ctx, filter, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.UpcomingConcertFilter, int, string) string); ok {
+		r1 = rf(ctx, filter, limit, cursor)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, entity.UpcomingConcertFilter, int, string) error); ok {
+		r2 = rf(ctx, filter, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockConcertRepository_ListUpcomingGlobal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUpcomingGlobal'
+type MockConcertRepository_ListUpcomingGlobal_Call struct {
+	*mock.Call
+}
+
+// ListUpcomingGlobal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.UpcomingConcertFilter
+//   - limit int
+//   - cursor string
+func (_e *MockConcertRepository_Expecter) ListUpcomingGlobal(ctx interface{}, filter interface{}, limit interface{}, cursor interface{}) *MockConcertRepository_ListUpcomingGlobal_Call {
+	return &MockConcertRepository_ListUpcomingGlobal_Call{Call: _e.mock.On("ListUpcomingGlobal", ctx, filter, limit, cursor)}
+}
+
+func (_c *MockConcertRepository_ListUpcomingGlobal_Call) Run(run func(ctx context.Context, filter entity.UpcomingConcertFilter, limit int, cursor string)) *MockConcertRepository_ListUpcomingGlobal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.UpcomingConcertFilter), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_ListUpcomingGlobal_Call) Return(_a0 []*entity.Concert, _a1 string, _a2 error) *MockConcertRepository_ListUpcomingGlobal_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockConcertRepository_ListUpcomingGlobal_Call) RunAndReturn(run func(context.Context, entity.UpcomingConcertFilter, int, string) ([]*entity.Concert, string, error)) *MockConcertRepository_ListUpcomingGlobal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkVenueResolutionAttempted provides a mock function with given fields: ctx, eventIDs
+func (_m *MockConcertRepository) MarkVenueResolutionAttempted(ctx context.Context, eventIDs []string) error {
+	ret := _m.Called(ctx, eventIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkVenueResolutionAttempted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, eventIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConcertRepository_MarkVenueResolutionAttempted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkVenueResolutionAttempted'
+type MockConcertRepository_MarkVenueResolutionAttempted_Call struct {
+	*mock.Call
+}
+
+// MarkVenueResolutionAttempted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventIDs []string
+func (_e *MockConcertRepository_Expecter) MarkVenueResolutionAttempted(ctx interface{}, eventIDs interface{}) *MockConcertRepository_MarkVenueResolutionAttempted_Call {
+	return &MockConcertRepository_MarkVenueResolutionAttempted_Call{Call: _e.mock.On("MarkVenueResolutionAttempted", ctx, eventIDs)}
+}
+
+func (_c *MockConcertRepository_MarkVenueResolutionAttempted_Call) Run(run func(ctx context.Context, eventIDs []string)) *MockConcertRepository_MarkVenueResolutionAttempted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_MarkVenueResolutionAttempted_Call) Return(_a0 error) *MockConcertRepository_MarkVenueResolutionAttempted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConcertRepository_MarkVenueResolutionAttempted_Call) RunAndReturn(run func(context.Context, []string) error) *MockConcertRepository_MarkVenueResolutionAttempted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reschedule provides a mock function with given fields: ctx, eventID, date, startTime, openTime
+func (_m *MockConcertRepository) Reschedule(ctx context.Context, eventID string, date time.Time, startTime *time.Time, openTime *time.Time) error {
+	ret := _m.Called(ctx, eventID, date, startTime, openTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reschedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, *time.Time, *time.Time) error); ok {
+		r0 = rf(ctx, eventID, date, startTime, openTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConcertRepository_Reschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reschedule'
+type MockConcertRepository_Reschedule_Call struct {
+	*mock.Call
+}
+
+// Reschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - date time.Time
+//   - startTime *time.Time
+//   - openTime *time.Time
+func (_e *MockConcertRepository_Expecter) Reschedule(ctx interface{}, eventID interface{}, date interface{}, startTime interface{}, openTime interface{}) *MockConcertRepository_Reschedule_Call {
+	return &MockConcertRepository_Reschedule_Call{Call: _e.mock.On("Reschedule", ctx, eventID, date, startTime, openTime)}
+}
+
+func (_c *MockConcertRepository_Reschedule_Call) Run(run func(ctx context.Context, eventID string, date time.Time, startTime *time.Time, openTime *time.Time)) *MockConcertRepository_Reschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(*time.Time), args[4].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_Reschedule_Call) Return(_a0 error) *MockConcertRepository_Reschedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConcertRepository_Reschedule_Call) RunAndReturn(run func(context.Context, string, time.Time, *time.Time, *time.Time) error) *MockConcertRepository_Reschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, query, limit
+func (_m *MockConcertRepository) Search(ctx context.Context, query string, limit int) ([]*entity.Concert, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*entity.Concert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]*entity.Concert, error)); ok {
+		return rf(ctx, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*entity.Concert); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockConcertRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockConcertRepository_Expecter) Search(ctx interface{}, query interface{}, limit interface{}) *MockConcertRepository_Search_Call {
+	return &MockConcertRepository_Search_Call{Call: _e.mock.On("Search", ctx, query, limit)}
+}
+
+func (_c *MockConcertRepository_Search_Call) Run(run func(ctx context.Context, query string, limit int)) *MockConcertRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_Search_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertRepository_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertRepository_Search_Call) RunAndReturn(run func(context.Context, string, int) ([]*entity.Concert, error)) *MockConcertRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDelete provides a mock function with given fields: ctx, eventID
+func (_m *MockConcertRepository) SoftDelete(ctx context.Context, eventID string) error {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConcertRepository_SoftDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDelete'
+type MockConcertRepository_SoftDelete_Call struct {
+	*mock.Call
+}
+
+// SoftDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockConcertRepository_Expecter) SoftDelete(ctx interface{}, eventID interface{}) *MockConcertRepository_SoftDelete_Call {
+	return &MockConcertRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", ctx, eventID)}
+}
+
+func (_c *MockConcertRepository_SoftDelete_Call) Run(run func(ctx context.Context, eventID string)) *MockConcertRepository_SoftDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_SoftDelete_Call) Return(_a0 error) *MockConcertRepository_SoftDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConcertRepository_SoftDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockConcertRepository_SoftDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, concert
+func (_m *MockConcertRepository) Update(ctx context.Context, concert *entity.Concert) error {
+	ret := _m.Called(ctx, concert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Concert) error); ok {
+		r0 = rf(ctx, concert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConcertRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockConcertRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - concert *entity.Concert
+func (_e *MockConcertRepository_Expecter) Update(ctx interface{}, concert interface{}) *MockConcertRepository_Update_Call {
+	return &MockConcertRepository_Update_Call{Call: _e.mock.On("Update", ctx, concert)}
+}
+
+func (_c *MockConcertRepository_Update_Call) Run(run func(ctx context.Context, concert *entity.Concert)) *MockConcertRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Concert))
+	})
+	return _c
+}
+
+func (_c *MockConcertRepository_Update_Call) Return(_a0 error) *MockConcertRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConcertRepository_Update_Call) RunAndReturn(run func(context.Context, *entity.Concert) error) *MockConcertRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockConcertRepository creates a new instance of MockConcertRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockConcertRepository(t interface {
@@ -24,9 +24,9 @@ func (_m *MockConcertSearcher) EXPECT() *MockConcertSearcher_Expecter {
 	return &MockConcertSearcher_Expecter{mock: &_m.Mock}
 }
 
-// Search provides a mock function with given fields: ctx, artist, officialSite, from
-func (_m *MockConcertSearcher) Search(ctx context.Context, artist *entity.Artist, officialSite *entity.OfficialSite, from time.Time) ([]*entity.ScrapedConcert, error) {
-	ret := _m.Called(ctx, artist, officialSite, from)
+// Search provides a mock function with given fields: ctx, artist, officialSites, from
+func (_m *MockConcertSearcher) Search(ctx context.Context, artist *entity.Artist, officialSites []*entity.OfficialSite, from time.Time) ([]*entity.ScrapedConcert, error) {
+	ret := _m.Called(ctx, artist, officialSites, from)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Search")
@@ -34,19 +34,19 @@ func (_m *MockConcertSearcher) Search(ctx context.Context, artist *entity.Artist
 
 	var r0 []*entity.ScrapedConcert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Artist, *entity.OfficialSite, time.Time) ([]*entity.ScrapedConcert, error)); ok {
-		return rf(ctx, artist, officialSite, from)
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Artist, []*entity.OfficialSite, time.Time) ([]*entity.ScrapedConcert, error)); ok {
+		return rf(ctx, artist, officialSites, from)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.Artist, *entity.OfficialSite, time.Time) []*entity.ScrapedConcert); ok {
-		r0 = rf(ctx, artist, officialSite, from)
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Artist, []*entity.OfficialSite, time.Time) []*entity.ScrapedConcert); ok {
+		r0 = rf(ctx, artist, officialSites, from)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.ScrapedConcert)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *entity.Artist, *entity.OfficialSite, time.Time) error); ok {
-		r1 = rf(ctx, artist, officialSite, from)
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Artist, []*entity.OfficialSite, time.Time) error); ok {
+		r1 = rf(ctx, artist, officialSites, from)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,15 +62,15 @@ type MockConcertSearcher_Search_Call struct {
 // Search is a helper method to define mock.On call
 //   - ctx context.Context
 //   - artist *entity.Artist
-//   - officialSite *entity.OfficialSite
+//   - officialSites []*entity.OfficialSite
 //   - from time.Time
-func (_e *MockConcertSearcher_Expecter) Search(ctx interface{}, artist interface{}, officialSite interface{}, from interface{}) *MockConcertSearcher_Search_Call {
-	return &MockConcertSearcher_Search_Call{Call: _e.mock.On("Search", ctx, artist, officialSite, from)}
+func (_e *MockConcertSearcher_Expecter) Search(ctx interface{}, artist interface{}, officialSites interface{}, from interface{}) *MockConcertSearcher_Search_Call {
+	return &MockConcertSearcher_Search_Call{Call: _e.mock.On("Search", ctx, artist, officialSites, from)}
 }
 
-func (_c *MockConcertSearcher_Search_Call) Run(run func(ctx context.Context, artist *entity.Artist, officialSite *entity.OfficialSite, from time.Time)) *MockConcertSearcher_Search_Call {
+func (_c *MockConcertSearcher_Search_Call) Run(run func(ctx context.Context, artist *entity.Artist, officialSites []*entity.OfficialSite, from time.Time)) *MockConcertSearcher_Search_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entity.Artist), args[2].(*entity.OfficialSite), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(*entity.Artist), args[2].([]*entity.OfficialSite), args[3].(time.Time))
 	})
 	return _c
 }
@@ -80,7 +80,7 @@ func (_c *MockConcertSearcher_Search_Call) Return(_a0 []*entity.ScrapedConcert,
 	return _c
 }
 
-func (_c *MockConcertSearcher_Search_Call) RunAndReturn(run func(context.Context, *entity.Artist, *entity.OfficialSite, time.Time) ([]*entity.ScrapedConcert, error)) *MockConcertSearcher_Search_Call {
+func (_c *MockConcertSearcher_Search_Call) RunAndReturn(run func(context.Context, *entity.Artist, []*entity.OfficialSite, time.Time) ([]*entity.ScrapedConcert, error)) *MockConcertSearcher_Search_Call {
 	_c.Call.Return(run)
 	return _c
 }
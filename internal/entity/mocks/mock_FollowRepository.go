@@ -246,6 +246,124 @@ func (_c *MockFollowRepository_ListFollowers_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ListHistory provides a mock function with given fields: ctx, userID
+func (_m *MockFollowRepository) ListHistory(ctx context.Context, userID string) ([]*entity.FollowHistoryEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 []*entity.FollowHistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.FollowHistoryEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.FollowHistoryEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.FollowHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFollowRepository_ListHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHistory'
+type MockFollowRepository_ListHistory_Call struct {
+	*mock.Call
+}
+
+// ListHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockFollowRepository_Expecter) ListHistory(ctx interface{}, userID interface{}) *MockFollowRepository_ListHistory_Call {
+	return &MockFollowRepository_ListHistory_Call{Call: _e.mock.On("ListHistory", ctx, userID)}
+}
+
+func (_c *MockFollowRepository_ListHistory_Call) Run(run func(ctx context.Context, userID string)) *MockFollowRepository_ListHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFollowRepository_ListHistory_Call) Return(_a0 []*entity.FollowHistoryEntry, _a1 error) *MockFollowRepository_ListHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFollowRepository_ListHistory_Call) RunAndReturn(run func(context.Context, string) ([]*entity.FollowHistoryEntry, error)) *MockFollowRepository_ListHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMostFollowed provides a mock function with given fields: ctx, limit
+func (_m *MockFollowRepository) ListMostFollowed(ctx context.Context, limit int) ([]*entity.Artist, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMostFollowed")
+	}
+
+	var r0 []*entity.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.Artist, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.Artist); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFollowRepository_ListMostFollowed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMostFollowed'
+type MockFollowRepository_ListMostFollowed_Call struct {
+	*mock.Call
+}
+
+// ListMostFollowed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockFollowRepository_Expecter) ListMostFollowed(ctx interface{}, limit interface{}) *MockFollowRepository_ListMostFollowed_Call {
+	return &MockFollowRepository_ListMostFollowed_Call{Call: _e.mock.On("ListMostFollowed", ctx, limit)}
+}
+
+func (_c *MockFollowRepository_ListMostFollowed_Call) Run(run func(ctx context.Context, limit int)) *MockFollowRepository_ListMostFollowed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockFollowRepository_ListMostFollowed_Call) Return(_a0 []*entity.Artist, _a1 error) *MockFollowRepository_ListMostFollowed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFollowRepository_ListMostFollowed_Call) RunAndReturn(run func(context.Context, int) ([]*entity.Artist, error)) *MockFollowRepository_ListMostFollowed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetHype provides a mock function with given fields: ctx, userID, artistID, hype
 func (_m *MockFollowRepository) SetHype(ctx context.Context, userID string, artistID string, hype entity.Hype) error {
 	ret := _m.Called(ctx, userID, artistID, hype)
@@ -295,6 +413,63 @@ func (_c *MockFollowRepository_SetHype_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// TopHype provides a mock function with given fields: ctx, artistID
+func (_m *MockFollowRepository) TopHype(ctx context.Context, artistID string) (entity.Hype, error) {
+	ret := _m.Called(ctx, artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopHype")
+	}
+
+	var r0 entity.Hype
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Hype, error)); ok {
+		return rf(ctx, artistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Hype); ok {
+		r0 = rf(ctx, artistID)
+	} else {
+		r0 = ret.Get(0).(entity.Hype)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, artistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFollowRepository_TopHype_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopHype'
+type MockFollowRepository_TopHype_Call struct {
+	*mock.Call
+}
+
+// TopHype is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistID string
+func (_e *MockFollowRepository_Expecter) TopHype(ctx interface{}, artistID interface{}) *MockFollowRepository_TopHype_Call {
+	return &MockFollowRepository_TopHype_Call{Call: _e.mock.On("TopHype", ctx, artistID)}
+}
+
+func (_c *MockFollowRepository_TopHype_Call) Run(run func(ctx context.Context, artistID string)) *MockFollowRepository_TopHype_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFollowRepository_TopHype_Call) Return(_a0 entity.Hype, _a1 error) *MockFollowRepository_TopHype_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFollowRepository_TopHype_Call) RunAndReturn(run func(context.Context, string) (entity.Hype, error)) *MockFollowRepository_TopHype_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unfollow provides a mock function with given fields: ctx, userID, artistID
 func (_m *MockFollowRepository) Unfollow(ctx context.Context, userID string, artistID string) error {
 	ret := _m.Called(ctx, userID, artistID)
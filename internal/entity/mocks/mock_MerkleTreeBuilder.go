@@ -20,9 +20,9 @@ func (_m *MockMerkleTreeBuilder) EXPECT() *MockMerkleTreeBuilder_Expecter {
 	return &MockMerkleTreeBuilder_Expecter{mock: &_m.Mock}
 }
 
-// Build provides a mock function with given fields: eventID, leaves
-func (_m *MockMerkleTreeBuilder) Build(eventID string, leaves [][]byte) ([]*entity.MerkleNode, []byte, error) {
-	ret := _m.Called(eventID, leaves)
+// Build provides a mock function with given fields: eventID, depth, leaves
+func (_m *MockMerkleTreeBuilder) Build(eventID string, depth int, leaves [][]byte) ([]*entity.MerkleNode, []byte, error) {
+	ret := _m.Called(eventID, depth, leaves)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Build")
@@ -31,27 +31,27 @@ func (_m *MockMerkleTreeBuilder) Build(eventID string, leaves [][]byte) ([]*enti
 	var r0 []*entity.MerkleNode
 	var r1 []byte
 	var r2 error
-	if rf, ok := ret.Get(0).(func(string, [][]byte) ([]*entity.MerkleNode, []byte, error)); ok {
-		return rf(eventID, leaves)
+	if rf, ok := ret.Get(0).(func(string, int, [][]byte) ([]*entity.MerkleNode, []byte, error)); ok {
+		return rf(eventID, depth, leaves)
 	}
-	if rf, ok := ret.Get(0).(func(string, [][]byte) []*entity.MerkleNode); ok {
-		r0 = rf(eventID, leaves)
+	if rf, ok := ret.Get(0).(func(string, int, [][]byte) []*entity.MerkleNode); ok {
+		r0 = rf(eventID, depth, leaves)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.MerkleNode)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, [][]byte) []byte); ok {
-		r1 = rf(eventID, leaves)
+	if rf, ok := ret.Get(1).(func(string, int, [][]byte) []byte); ok {
+		r1 = rf(eventID, depth, leaves)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(string, [][]byte) error); ok {
-		r2 = rf(eventID, leaves)
+	if rf, ok := ret.Get(2).(func(string, int, [][]byte) error); ok {
+		r2 = rf(eventID, depth, leaves)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -66,14 +66,15 @@ type MockMerkleTreeBuilder_Build_Call struct {
 
 // Build is a helper method to define mock.On call
 //   - eventID string
+//   - depth int
 //   - leaves [][]byte
-func (_e *MockMerkleTreeBuilder_Expecter) Build(eventID interface{}, leaves interface{}) *MockMerkleTreeBuilder_Build_Call {
-	return &MockMerkleTreeBuilder_Build_Call{Call: _e.mock.On("Build", eventID, leaves)}
+func (_e *MockMerkleTreeBuilder_Expecter) Build(eventID interface{}, depth interface{}, leaves interface{}) *MockMerkleTreeBuilder_Build_Call {
+	return &MockMerkleTreeBuilder_Build_Call{Call: _e.mock.On("Build", eventID, depth, leaves)}
 }
 
-func (_c *MockMerkleTreeBuilder_Build_Call) Run(run func(eventID string, leaves [][]byte)) *MockMerkleTreeBuilder_Build_Call {
+func (_c *MockMerkleTreeBuilder_Build_Call) Run(run func(eventID string, depth int, leaves [][]byte)) *MockMerkleTreeBuilder_Build_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].([][]byte))
+		run(args[0].(string), args[1].(int), args[2].([][]byte))
 	})
 	return _c
 }
@@ -83,7 +84,7 @@ func (_c *MockMerkleTreeBuilder_Build_Call) Return(_a0 []*entity.MerkleNode, _a1
 	return _c
 }
 
-func (_c *MockMerkleTreeBuilder_Build_Call) RunAndReturn(run func(string, [][]byte) ([]*entity.MerkleNode, []byte, error)) *MockMerkleTreeBuilder_Build_Call {
+func (_c *MockMerkleTreeBuilder_Build_Call) RunAndReturn(run func(string, int, [][]byte) ([]*entity.MerkleNode, []byte, error)) *MockMerkleTreeBuilder_Build_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/liverty-music/backend/internal/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockSearchLogRepository is an autogenerated mock type for the SearchLogRepository type
@@ -128,6 +130,65 @@ func (_c *MockSearchLogRepository_GetByArtistID_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ListStale provides a mock function with given fields: ctx, olderThan
+func (_m *MockSearchLogRepository) ListStale(ctx context.Context, olderThan time.Duration) ([]string, error) {
+	ret := _m.Called(ctx, olderThan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStale")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) ([]string, error)); ok {
+		return rf(ctx, olderThan)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) []string); ok {
+		r0 = rf(ctx, olderThan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, olderThan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchLogRepository_ListStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStale'
+type MockSearchLogRepository_ListStale_Call struct {
+	*mock.Call
+}
+
+// ListStale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Duration
+func (_e *MockSearchLogRepository_Expecter) ListStale(ctx interface{}, olderThan interface{}) *MockSearchLogRepository_ListStale_Call {
+	return &MockSearchLogRepository_ListStale_Call{Call: _e.mock.On("ListStale", ctx, olderThan)}
+}
+
+func (_c *MockSearchLogRepository_ListStale_Call) Run(run func(ctx context.Context, olderThan time.Duration)) *MockSearchLogRepository_ListStale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockSearchLogRepository_ListStale_Call) Return(_a0 []string, _a1 error) *MockSearchLogRepository_ListStale_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchLogRepository_ListStale_Call) RunAndReturn(run func(context.Context, time.Duration) ([]string, error)) *MockSearchLogRepository_ListStale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarkFound provides a mock function with given fields: ctx, artistID
 func (_m *MockSearchLogRepository) MarkFound(ctx context.Context, artistID string) error {
 	ret := _m.Called(ctx, artistID)
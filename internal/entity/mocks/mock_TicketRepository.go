@@ -22,6 +22,120 @@ func (_m *MockTicketRepository) EXPECT() *MockTicketRepository_Expecter {
 	return &MockTicketRepository_Expecter{mock: &_m.Mock}
 }
 
+// AssignLeafIndexes provides a mock function with given fields: ctx, eventID
+func (_m *MockTicketRepository) AssignLeafIndexes(ctx context.Context, eventID string) (int, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignLeafIndexes")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTicketRepository_AssignLeafIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignLeafIndexes'
+type MockTicketRepository_AssignLeafIndexes_Call struct {
+	*mock.Call
+}
+
+// AssignLeafIndexes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockTicketRepository_Expecter) AssignLeafIndexes(ctx interface{}, eventID interface{}) *MockTicketRepository_AssignLeafIndexes_Call {
+	return &MockTicketRepository_AssignLeafIndexes_Call{Call: _e.mock.On("AssignLeafIndexes", ctx, eventID)}
+}
+
+func (_c *MockTicketRepository_AssignLeafIndexes_Call) Run(run func(ctx context.Context, eventID string)) *MockTicketRepository_AssignLeafIndexes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTicketRepository_AssignLeafIndexes_Call) Return(_a0 int, _a1 error) *MockTicketRepository_AssignLeafIndexes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTicketRepository_AssignLeafIndexes_Call) RunAndReturn(run func(context.Context, string) (int, error)) *MockTicketRepository_AssignLeafIndexes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByEvent provides a mock function with given fields: ctx, eventID
+func (_m *MockTicketRepository) CountByEvent(ctx context.Context, eventID string) (int, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByEvent")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTicketRepository_CountByEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByEvent'
+type MockTicketRepository_CountByEvent_Call struct {
+	*mock.Call
+}
+
+// CountByEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockTicketRepository_Expecter) CountByEvent(ctx interface{}, eventID interface{}) *MockTicketRepository_CountByEvent_Call {
+	return &MockTicketRepository_CountByEvent_Call{Call: _e.mock.On("CountByEvent", ctx, eventID)}
+}
+
+func (_c *MockTicketRepository_CountByEvent_Call) Run(run func(ctx context.Context, eventID string)) *MockTicketRepository_CountByEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTicketRepository_CountByEvent_Call) Return(_a0 int, _a1 error) *MockTicketRepository_CountByEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTicketRepository_CountByEvent_Call) RunAndReturn(run func(context.Context, string) (int, error)) *MockTicketRepository_CountByEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, params
 func (_m *MockTicketRepository) Create(ctx context.Context, params *entity.NewTicket) (*entity.Ticket, error) {
 	ret := _m.Called(ctx, params)
@@ -365,6 +365,73 @@ func (_c *MockUserRepository_List_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// ListPage provides a mock function with given fields: ctx, limit, cursor
+func (_m *MockUserRepository) ListPage(ctx context.Context, limit int, cursor string) ([]*entity.User, string, error) {
+	ret := _m.Called(ctx, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPage")
+	}
+
+	var r0 []*entity.User
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]*entity.User, string, error)); ok {
+		return rf(ctx, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []*entity.User); ok {
+		r0 = rf(ctx, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) string); ok {
+		r1 = rf(ctx, limit, cursor)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, string) error); ok {
+		r2 = rf(ctx, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserRepository_ListPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPage'
+type MockUserRepository_ListPage_Call struct {
+	*mock.Call
+}
+
+// ListPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - cursor string
+func (_e *MockUserRepository_Expecter) ListPage(ctx interface{}, limit interface{}, cursor interface{}) *MockUserRepository_ListPage_Call {
+	return &MockUserRepository_ListPage_Call{Call: _e.mock.On("ListPage", ctx, limit, cursor)}
+}
+
+func (_c *MockUserRepository_ListPage_Call) Run(run func(ctx context.Context, limit int, cursor string)) *MockUserRepository_ListPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ListPage_Call) Return(_a0 []*entity.User, _a1 string, _a2 error) *MockUserRepository_ListPage_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserRepository_ListPage_Call) RunAndReturn(run func(context.Context, int, string) ([]*entity.User, string, error)) *MockUserRepository_ListPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, params
 func (_m *MockUserRepository) Update(ctx context.Context, id string, params *entity.NewUser) (*entity.User, error) {
 	ret := _m.Called(ctx, id, params)
@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/liverty-music/backend/internal/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockVenueRepository is an autogenerated mock type for the VenueRepository type
@@ -22,6 +24,53 @@ func (_m *MockVenueRepository) EXPECT() *MockVenueRepository_Expecter {
 	return &MockVenueRepository_Expecter{mock: &_m.Mock}
 }
 
+// ApproveEnrichment provides a mock function with given fields: ctx, venueID
+func (_m *MockVenueRepository) ApproveEnrichment(ctx context.Context, venueID string) error {
+	ret := _m.Called(ctx, venueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveEnrichment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, venueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_ApproveEnrichment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveEnrichment'
+type MockVenueRepository_ApproveEnrichment_Call struct {
+	*mock.Call
+}
+
+// ApproveEnrichment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+func (_e *MockVenueRepository_Expecter) ApproveEnrichment(ctx interface{}, venueID interface{}) *MockVenueRepository_ApproveEnrichment_Call {
+	return &MockVenueRepository_ApproveEnrichment_Call{Call: _e.mock.On("ApproveEnrichment", ctx, venueID)}
+}
+
+func (_c *MockVenueRepository_ApproveEnrichment_Call) Run(run func(ctx context.Context, venueID string)) *MockVenueRepository_ApproveEnrichment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_ApproveEnrichment_Call) Return(_a0 error) *MockVenueRepository_ApproveEnrichment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_ApproveEnrichment_Call) RunAndReturn(run func(context.Context, string) error) *MockVenueRepository_ApproveEnrichment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, venue
 func (_m *MockVenueRepository) Create(ctx context.Context, venue *entity.Venue) error {
 	ret := _m.Called(ctx, venue)
@@ -188,6 +237,66 @@ func (_c *MockVenueRepository_GetByListedName_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetByNormalizedName provides a mock function with given fields: ctx, name, adminArea
+func (_m *MockVenueRepository) GetByNormalizedName(ctx context.Context, name string, adminArea *string) (*entity.Venue, error) {
+	ret := _m.Called(ctx, name, adminArea)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNormalizedName")
+	}
+
+	var r0 *entity.Venue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) (*entity.Venue, error)); ok {
+		return rf(ctx, name, adminArea)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) *entity.Venue); ok {
+		r0 = rf(ctx, name, adminArea)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Venue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string) error); ok {
+		r1 = rf(ctx, name, adminArea)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVenueRepository_GetByNormalizedName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNormalizedName'
+type MockVenueRepository_GetByNormalizedName_Call struct {
+	*mock.Call
+}
+
+// GetByNormalizedName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - adminArea *string
+func (_e *MockVenueRepository_Expecter) GetByNormalizedName(ctx interface{}, name interface{}, adminArea interface{}) *MockVenueRepository_GetByNormalizedName_Call {
+	return &MockVenueRepository_GetByNormalizedName_Call{Call: _e.mock.On("GetByNormalizedName", ctx, name, adminArea)}
+}
+
+func (_c *MockVenueRepository_GetByNormalizedName_Call) Run(run func(ctx context.Context, name string, adminArea *string)) *MockVenueRepository_GetByNormalizedName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_GetByNormalizedName_Call) Return(_a0 *entity.Venue, _a1 error) *MockVenueRepository_GetByNormalizedName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVenueRepository_GetByNormalizedName_Call) RunAndReturn(run func(context.Context, string, *string) (*entity.Venue, error)) *MockVenueRepository_GetByNormalizedName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByPlaceID provides a mock function with given fields: ctx, placeID
 func (_m *MockVenueRepository) GetByPlaceID(ctx context.Context, placeID string) (*entity.Venue, error) {
 	ret := _m.Called(ctx, placeID)
@@ -247,6 +356,643 @@ func (_c *MockVenueRepository_GetByPlaceID_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListByEnrichmentStatus provides a mock function with given fields: ctx, status, limit, offset
+func (_m *MockVenueRepository) ListByEnrichmentStatus(ctx context.Context, status entity.EnrichmentStatus, limit int, offset int) ([]*entity.Venue, error) {
+	ret := _m.Called(ctx, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEnrichmentStatus")
+	}
+
+	var r0 []*entity.Venue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.EnrichmentStatus, int, int) ([]*entity.Venue, error)); ok {
+		return rf(ctx, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.EnrichmentStatus, int, int) []*entity.Venue); ok {
+		r0 = rf(ctx, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Venue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.EnrichmentStatus, int, int) error); ok {
+		r1 = rf(ctx, status, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVenueRepository_ListByEnrichmentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEnrichmentStatus'
+type MockVenueRepository_ListByEnrichmentStatus_Call struct {
+	*mock.Call
+}
+
+// ListByEnrichmentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status entity.EnrichmentStatus
+//   - limit int
+//   - offset int
+func (_e *MockVenueRepository_Expecter) ListByEnrichmentStatus(ctx interface{}, status interface{}, limit interface{}, offset interface{}) *MockVenueRepository_ListByEnrichmentStatus_Call {
+	return &MockVenueRepository_ListByEnrichmentStatus_Call{Call: _e.mock.On("ListByEnrichmentStatus", ctx, status, limit, offset)}
+}
+
+func (_c *MockVenueRepository_ListByEnrichmentStatus_Call) Run(run func(ctx context.Context, status entity.EnrichmentStatus, limit int, offset int)) *MockVenueRepository_ListByEnrichmentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.EnrichmentStatus), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_ListByEnrichmentStatus_Call) Return(_a0 []*entity.Venue, _a1 error) *MockVenueRepository_ListByEnrichmentStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVenueRepository_ListByEnrichmentStatus_Call) RunAndReturn(run func(context.Context, entity.EnrichmentStatus, int, int) ([]*entity.Venue, error)) *MockVenueRepository_ListByEnrichmentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnrichmentRetryCandidates provides a mock function with given fields: ctx, now, baseBackoff, maxAttempts, limit
+func (_m *MockVenueRepository) ListEnrichmentRetryCandidates(ctx context.Context, now time.Time, baseBackoff time.Duration, maxAttempts int, limit int) ([]*entity.Venue, error) {
+	ret := _m.Called(ctx, now, baseBackoff, maxAttempts, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnrichmentRetryCandidates")
+	}
+
+	var r0 []*entity.Venue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int, int) ([]*entity.Venue, error)); ok {
+		return rf(ctx, now, baseBackoff, maxAttempts, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int, int) []*entity.Venue); ok {
+		r0 = rf(ctx, now, baseBackoff, maxAttempts, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Venue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Duration, int, int) error); ok {
+		r1 = rf(ctx, now, baseBackoff, maxAttempts, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVenueRepository_ListEnrichmentRetryCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnrichmentRetryCandidates'
+type MockVenueRepository_ListEnrichmentRetryCandidates_Call struct {
+	*mock.Call
+}
+
+// ListEnrichmentRetryCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - baseBackoff time.Duration
+//   - maxAttempts int
+//   - limit int
+func (_e *MockVenueRepository_Expecter) ListEnrichmentRetryCandidates(ctx interface{}, now interface{}, baseBackoff interface{}, maxAttempts interface{}, limit interface{}) *MockVenueRepository_ListEnrichmentRetryCandidates_Call {
+	return &MockVenueRepository_ListEnrichmentRetryCandidates_Call{Call: _e.mock.On("ListEnrichmentRetryCandidates", ctx, now, baseBackoff, maxAttempts, limit)}
+}
+
+func (_c *MockVenueRepository_ListEnrichmentRetryCandidates_Call) Run(run func(ctx context.Context, now time.Time, baseBackoff time.Duration, maxAttempts int, limit int)) *MockVenueRepository_ListEnrichmentRetryCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Duration), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_ListEnrichmentRetryCandidates_Call) Return(_a0 []*entity.Venue, _a1 error) *MockVenueRepository_ListEnrichmentRetryCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVenueRepository_ListEnrichmentRetryCandidates_Call) RunAndReturn(run func(context.Context, time.Time, time.Duration, int, int) ([]*entity.Venue, error)) *MockVenueRepository_ListEnrichmentRetryCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNeedsReview provides a mock function with given fields: ctx, limit, offset
+func (_m *MockVenueRepository) ListNeedsReview(ctx context.Context, limit int, offset int) ([]*entity.EnrichmentReview, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNeedsReview")
+	}
+
+	var r0 []*entity.EnrichmentReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*entity.EnrichmentReview, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*entity.EnrichmentReview); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.EnrichmentReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVenueRepository_ListNeedsReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNeedsReview'
+type MockVenueRepository_ListNeedsReview_Call struct {
+	*mock.Call
+}
+
+// ListNeedsReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *MockVenueRepository_Expecter) ListNeedsReview(ctx interface{}, limit interface{}, offset interface{}) *MockVenueRepository_ListNeedsReview_Call {
+	return &MockVenueRepository_ListNeedsReview_Call{Call: _e.mock.On("ListNeedsReview", ctx, limit, offset)}
+}
+
+func (_c *MockVenueRepository_ListNeedsReview_Call) Run(run func(ctx context.Context, limit int, offset int)) *MockVenueRepository_ListNeedsReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_ListNeedsReview_Call) Return(_a0 []*entity.EnrichmentReview, _a1 error) *MockVenueRepository_ListNeedsReview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVenueRepository_ListNeedsReview_Call) RunAndReturn(run func(context.Context, int, int) ([]*entity.EnrichmentReview, error)) *MockVenueRepository_ListNeedsReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDuplicate provides a mock function with given fields: ctx, venueID, canonicalID
+func (_m *MockVenueRepository) MarkDuplicate(ctx context.Context, venueID string, canonicalID string) error {
+	ret := _m.Called(ctx, venueID, canonicalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDuplicate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, venueID, canonicalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_MarkDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDuplicate'
+type MockVenueRepository_MarkDuplicate_Call struct {
+	*mock.Call
+}
+
+// MarkDuplicate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+//   - canonicalID string
+func (_e *MockVenueRepository_Expecter) MarkDuplicate(ctx interface{}, venueID interface{}, canonicalID interface{}) *MockVenueRepository_MarkDuplicate_Call {
+	return &MockVenueRepository_MarkDuplicate_Call{Call: _e.mock.On("MarkDuplicate", ctx, venueID, canonicalID)}
+}
+
+func (_c *MockVenueRepository_MarkDuplicate_Call) Run(run func(ctx context.Context, venueID string, canonicalID string)) *MockVenueRepository_MarkDuplicate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkDuplicate_Call) Return(_a0 error) *MockVenueRepository_MarkDuplicate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkDuplicate_Call) RunAndReturn(run func(context.Context, string, string) error) *MockVenueRepository_MarkDuplicate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function with given fields: ctx, venueID
+func (_m *MockVenueRepository) MarkFailed(ctx context.Context, venueID string) error {
+	ret := _m.Called(ctx, venueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, venueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type MockVenueRepository_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+func (_e *MockVenueRepository_Expecter) MarkFailed(ctx interface{}, venueID interface{}) *MockVenueRepository_MarkFailed_Call {
+	return &MockVenueRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, venueID)}
+}
+
+func (_c *MockVenueRepository_MarkFailed_Call) Run(run func(ctx context.Context, venueID string)) *MockVenueRepository_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkFailed_Call) Return(_a0 error) *MockVenueRepository_MarkFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkFailed_Call) RunAndReturn(run func(context.Context, string) error) *MockVenueRepository_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNeedsReview provides a mock function with given fields: ctx, venueID, place, confidence
+func (_m *MockVenueRepository) MarkNeedsReview(ctx context.Context, venueID string, place *entity.VenuePlace, confidence float64) error {
+	ret := _m.Called(ctx, venueID, place, confidence)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNeedsReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entity.VenuePlace, float64) error); ok {
+		r0 = rf(ctx, venueID, place, confidence)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_MarkNeedsReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNeedsReview'
+type MockVenueRepository_MarkNeedsReview_Call struct {
+	*mock.Call
+}
+
+// MarkNeedsReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+//   - place *entity.VenuePlace
+//   - confidence float64
+func (_e *MockVenueRepository_Expecter) MarkNeedsReview(ctx interface{}, venueID interface{}, place interface{}, confidence interface{}) *MockVenueRepository_MarkNeedsReview_Call {
+	return &MockVenueRepository_MarkNeedsReview_Call{Call: _e.mock.On("MarkNeedsReview", ctx, venueID, place, confidence)}
+}
+
+func (_c *MockVenueRepository_MarkNeedsReview_Call) Run(run func(ctx context.Context, venueID string, place *entity.VenuePlace, confidence float64)) *MockVenueRepository_MarkNeedsReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entity.VenuePlace), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkNeedsReview_Call) Return(_a0 error) *MockVenueRepository_MarkNeedsReview_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkNeedsReview_Call) RunAndReturn(run func(context.Context, string, *entity.VenuePlace, float64) error) *MockVenueRepository_MarkNeedsReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRetryable provides a mock function with given fields: ctx, venueID
+func (_m *MockVenueRepository) MarkRetryable(ctx context.Context, venueID string) error {
+	ret := _m.Called(ctx, venueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRetryable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, venueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_MarkRetryable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRetryable'
+type MockVenueRepository_MarkRetryable_Call struct {
+	*mock.Call
+}
+
+// MarkRetryable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+func (_e *MockVenueRepository_Expecter) MarkRetryable(ctx interface{}, venueID interface{}) *MockVenueRepository_MarkRetryable_Call {
+	return &MockVenueRepository_MarkRetryable_Call{Call: _e.mock.On("MarkRetryable", ctx, venueID)}
+}
+
+func (_c *MockVenueRepository_MarkRetryable_Call) Run(run func(ctx context.Context, venueID string)) *MockVenueRepository_MarkRetryable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkRetryable_Call) Return(_a0 error) *MockVenueRepository_MarkRetryable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_MarkRetryable_Call) RunAndReturn(run func(context.Context, string) error) *MockVenueRepository_MarkRetryable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeVenues provides a mock function with given fields: ctx, canonicalID, duplicateID, dryRun
+func (_m *MockVenueRepository) MergeVenues(ctx context.Context, canonicalID string, duplicateID string, dryRun bool) (*entity.MergeReport, error) {
+	ret := _m.Called(ctx, canonicalID, duplicateID, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeVenues")
+	}
+
+	var r0 *entity.MergeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (*entity.MergeReport, error)); ok {
+		return rf(ctx, canonicalID, duplicateID, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) *entity.MergeReport); ok {
+		r0 = rf(ctx, canonicalID, duplicateID, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.MergeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, canonicalID, duplicateID, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVenueRepository_MergeVenues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeVenues'
+type MockVenueRepository_MergeVenues_Call struct {
+	*mock.Call
+}
+
+// MergeVenues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - canonicalID string
+//   - duplicateID string
+//   - dryRun bool
+func (_e *MockVenueRepository_Expecter) MergeVenues(ctx interface{}, canonicalID interface{}, duplicateID interface{}, dryRun interface{}) *MockVenueRepository_MergeVenues_Call {
+	return &MockVenueRepository_MergeVenues_Call{Call: _e.mock.On("MergeVenues", ctx, canonicalID, duplicateID, dryRun)}
+}
+
+func (_c *MockVenueRepository_MergeVenues_Call) Run(run func(ctx context.Context, canonicalID string, duplicateID string, dryRun bool)) *MockVenueRepository_MergeVenues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_MergeVenues_Call) Return(_a0 *entity.MergeReport, _a1 error) *MockVenueRepository_MergeVenues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVenueRepository_MergeVenues_Call) RunAndReturn(run func(context.Context, string, string, bool) (*entity.MergeReport, error)) *MockVenueRepository_MergeVenues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectEnrichment provides a mock function with given fields: ctx, venueID
+func (_m *MockVenueRepository) RejectEnrichment(ctx context.Context, venueID string) error {
+	ret := _m.Called(ctx, venueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectEnrichment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, venueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_RejectEnrichment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectEnrichment'
+type MockVenueRepository_RejectEnrichment_Call struct {
+	*mock.Call
+}
+
+// RejectEnrichment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+func (_e *MockVenueRepository_Expecter) RejectEnrichment(ctx interface{}, venueID interface{}) *MockVenueRepository_RejectEnrichment_Call {
+	return &MockVenueRepository_RejectEnrichment_Call{Call: _e.mock.On("RejectEnrichment", ctx, venueID)}
+}
+
+func (_c *MockVenueRepository_RejectEnrichment_Call) Run(run func(ctx context.Context, venueID string)) *MockVenueRepository_RejectEnrichment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_RejectEnrichment_Call) Return(_a0 error) *MockVenueRepository_RejectEnrichment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_RejectEnrichment_Call) RunAndReturn(run func(context.Context, string) error) *MockVenueRepository_RejectEnrichment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnriched provides a mock function with given fields: ctx, venueID, place
+func (_m *MockVenueRepository) UpdateEnriched(ctx context.Context, venueID string, place *entity.VenuePlace) error {
+	ret := _m.Called(ctx, venueID, place)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEnriched")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entity.VenuePlace) error); ok {
+		r0 = rf(ctx, venueID, place)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_UpdateEnriched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnriched'
+type MockVenueRepository_UpdateEnriched_Call struct {
+	*mock.Call
+}
+
+// UpdateEnriched is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+//   - place *entity.VenuePlace
+func (_e *MockVenueRepository_Expecter) UpdateEnriched(ctx interface{}, venueID interface{}, place interface{}) *MockVenueRepository_UpdateEnriched_Call {
+	return &MockVenueRepository_UpdateEnriched_Call{Call: _e.mock.On("UpdateEnriched", ctx, venueID, place)}
+}
+
+func (_c *MockVenueRepository_UpdateEnriched_Call) Run(run func(ctx context.Context, venueID string, place *entity.VenuePlace)) *MockVenueRepository_UpdateEnriched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entity.VenuePlace))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_UpdateEnriched_Call) Return(_a0 error) *MockVenueRepository_UpdateEnriched_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_UpdateEnriched_Call) RunAndReturn(run func(context.Context, string, *entity.VenuePlace) error) *MockVenueRepository_UpdateEnriched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMBID provides a mock function with given fields: ctx, venueID, mbid
+func (_m *MockVenueRepository) UpdateMBID(ctx context.Context, venueID string, mbid string) error {
+	ret := _m.Called(ctx, venueID, mbid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMBID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, venueID, mbid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVenueRepository_UpdateMBID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMBID'
+type MockVenueRepository_UpdateMBID_Call struct {
+	*mock.Call
+}
+
+// UpdateMBID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venueID string
+//   - mbid string
+func (_e *MockVenueRepository_Expecter) UpdateMBID(ctx interface{}, venueID interface{}, mbid interface{}) *MockVenueRepository_UpdateMBID_Call {
+	return &MockVenueRepository_UpdateMBID_Call{Call: _e.mock.On("UpdateMBID", ctx, venueID, mbid)}
+}
+
+func (_c *MockVenueRepository_UpdateMBID_Call) Run(run func(ctx context.Context, venueID string, mbid string)) *MockVenueRepository_UpdateMBID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_UpdateMBID_Call) Return(_a0 error) *MockVenueRepository_UpdateMBID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVenueRepository_UpdateMBID_Call) RunAndReturn(run func(context.Context, string, string) error) *MockVenueRepository_UpdateMBID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertByNames provides a mock function with given fields: ctx, venues
+func (_m *MockVenueRepository) UpsertByNames(ctx context.Context, venues []*entity.Venue) (map[string]*entity.Venue, error) {
+	ret := _m.Called(ctx, venues)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertByNames")
+	}
+
+	var r0 map[string]*entity.Venue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entity.Venue) (map[string]*entity.Venue, error)); ok {
+		return rf(ctx, venues)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*entity.Venue) map[string]*entity.Venue); ok {
+		r0 = rf(ctx, venues)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*entity.Venue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*entity.Venue) error); ok {
+		r1 = rf(ctx, venues)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVenueRepository_UpsertByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertByNames'
+type MockVenueRepository_UpsertByNames_Call struct {
+	*mock.Call
+}
+
+// UpsertByNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - venues []*entity.Venue
+func (_e *MockVenueRepository_Expecter) UpsertByNames(ctx interface{}, venues interface{}) *MockVenueRepository_UpsertByNames_Call {
+	return &MockVenueRepository_UpsertByNames_Call{Call: _e.mock.On("UpsertByNames", ctx, venues)}
+}
+
+func (_c *MockVenueRepository_UpsertByNames_Call) Run(run func(ctx context.Context, venues []*entity.Venue)) *MockVenueRepository_UpsertByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entity.Venue))
+	})
+	return _c
+}
+
+func (_c *MockVenueRepository_UpsertByNames_Call) Return(_a0 map[string]*entity.Venue, _a1 error) *MockVenueRepository_UpsertByNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVenueRepository_UpsertByNames_Call) RunAndReturn(run func(context.Context, []*entity.Venue) (map[string]*entity.Venue, error)) *MockVenueRepository_UpsertByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockVenueRepository creates a new instance of MockVenueRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockVenueRepository(t interface {
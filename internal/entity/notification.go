@@ -16,6 +16,10 @@ const (
 	NotificationTypeSalesReminder NotificationType = "sales_reminder"
 	// NotificationTypeSalesPhaseAnnouncement announces a newly discovered sales phase.
 	NotificationTypeSalesPhaseAnnouncement NotificationType = "sales_phase_announcement"
+	// NotificationTypeConcertReminder reminds a user of an upcoming concert they track.
+	NotificationTypeConcertReminder NotificationType = "concert_reminder"
+	// NotificationTypeConcertRescheduled tells a ticket holder their concert moved to a new date.
+	NotificationTypeConcertRescheduled NotificationType = "concert_rescheduled"
 )
 
 // NotificationDeliveryStatus is the per-channel delivery state of a notification.
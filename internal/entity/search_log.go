@@ -86,6 +86,17 @@ type SearchLogRepository interface {
 	//  - Internal: If the update fails.
 	MarkFound(ctx context.Context, artistID string) error
 
+	// ListStale returns the IDs of followed artists due for a new search: those
+	// last searched more than olderThan ago, whatever the outcome, and those
+	// never searched at all. Never-searched artists come first, then the
+	// longest-unsearched.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If olderThan is negative.
+	//  - Internal: If the query fails.
+	ListStale(ctx context.Context, olderThan time.Duration) ([]string, error)
+
 	// Delete removes the search log for a specific artist.
 	//
 	// # Possible errors
@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// SearchTaskStatus represents the lifecycle state of a queued concert search task.
+type SearchTaskStatus string
+
+const (
+	// SearchTaskStatusQueued indicates the task is waiting to be claimed by a worker.
+	SearchTaskStatusQueued SearchTaskStatus = "queued"
+	// SearchTaskStatusRunning indicates a worker has claimed the task and the
+	// search is in flight. A running task whose lease has expired is treated as
+	// abandoned (crashed worker) and becomes claimable again.
+	SearchTaskStatusRunning SearchTaskStatus = "running"
+)
+
+// SearchTask is a durable request to run concert discovery for one artist in
+// the background. Onboarding enqueues a task per followed artist instead of
+// blocking on the external search; a worker drains the queue and the results
+// reach the user through the regular concert.discovered → concert.created →
+// notification pipeline.
+//
+// A row lives in the queue only until the search succeeds — completion deletes
+// it — so at most one outstanding task exists per artist.
+type SearchTask struct {
+	// ID is the unique task identifier (UUIDv7, application-generated).
+	ID string
+	// ArtistID is the artist whose concerts should be searched.
+	ArtistID string
+	// Status is the current lifecycle state of the task.
+	Status SearchTaskStatus
+	// Attempts is the number of times a worker has claimed the task,
+	// including the current attempt when Status is Running.
+	Attempts int
+	// LastError is the error message recorded by the most recent failed
+	// attempt. Empty when no attempt has failed.
+	LastError string
+	// EnqueueTime is when the task was first enqueued. Used to drain the
+	// queue in FIFO order.
+	EnqueueTime time.Time
+	// ClaimTime is when a worker last claimed the task. Nil while the task
+	// has never been claimed.
+	ClaimTime *time.Time
+	// NextAttemptTime is the earliest time the task may be claimed. It equals
+	// EnqueueTime for a fresh task and moves forward with backoff after each
+	// failed attempt.
+	NextAttemptTime time.Time
+}
+
+// SearchTaskRepository defines the data access interface for the background
+// concert search queue.
+type SearchTaskRepository interface {
+	// Enqueue adds a search task for the artist. It is idempotent: when a task
+	// for the artist is already queued or running, the call is a no-op.
+	//
+	// # Possible errors
+	//
+	//  - FailedPrecondition: If the artist does not exist.
+	//  - Internal: If the insert fails.
+	Enqueue(ctx context.Context, artistID string) error
+
+	// Claim atomically picks the oldest claimable task, marks it running, and
+	// increments its attempt counter. A task is claimable when it is queued
+	// and its NextAttemptTime has passed, or when it is running but was
+	// claimed more than lease ago (the previous worker is presumed dead).
+	// Concurrent workers never claim the same task.
+	//
+	// # Possible errors
+	//
+	//  - NotFound: If no task is claimable.
+	//  - Internal: If the query fails.
+	Claim(ctx context.Context, lease time.Duration) (*SearchTask, error)
+
+	// Complete removes a finished task from the queue.
+	//
+	// # Possible errors
+	//
+	//  - Internal: If the delete fails.
+	Complete(ctx context.Context, id string) error
+
+	// Release returns a claimed task to the queued state after a failed
+	// attempt, recording lastError so the failure stays visible. The task
+	// becomes claimable again once backoff has elapsed.
+	//
+	// # Possible errors
+	//
+	//  - Internal: If the update fails.
+	Release(ctx context.Context, id, lastError string, backoff time.Duration) error
+
+	// DeadLetter moves a task that has exhausted its retries out of the queue
+	// and into the dead-letter table, recording lastError as the final
+	// failure. The artist is no longer retried until it is enqueued again.
+	//
+	// # Possible errors
+	//
+	//  - NotFound: If the task does not exist.
+	//  - Internal: If the move fails.
+	DeadLetter(ctx context.Context, id, lastError string) error
+}
@@ -58,6 +58,18 @@ type StagedConcert struct {
 	// DiscoveredTime is the timestamp when the discovery pipeline staged this
 	// concert. Used to order the review queue.
 	DiscoveredTime time.Time
+	// SearchSessionID identifies the discovery search session that first
+	// staged this concert. Carried onto the event on approval. Nil when the
+	// concert was staged without a session.
+	SearchSessionID *string
+	// IsFestival reports whether the concert is an appearance on a festival
+	// lineup. Carried back onto the ScrapedConcert on approval so the event
+	// is shared with the rest of the day's lineup.
+	IsFestival bool
+	// ReschedulesEventID is the published event this concert moves to
+	// LocalDate. Approval reschedules that event and notifies its ticket
+	// holders instead of publishing a new event. Nil for a new concert.
+	ReschedulesEventID *string
 }
 
 // StagedConcertDedupKey is the pre-resolution dedup key used during discovery
@@ -79,7 +91,9 @@ type StagedConcertRepository interface {
 	// (artist_id, local_date, resolved_place_id) index; when nil it falls back
 	// to (artist_id, local_date, listed_venue_name). On conflict the mutable
 	// payload (title, start/open times, admin_area, source_url, resolved_*) is
-	// updated but the original discovered_at is kept so queue order is stable.
+	// updated but the original discovered_at and search_session_id are kept so
+	// queue order is stable and the row stays attributed to the session that
+	// first staged it.
 	//
 	// # Possible errors
 	//
@@ -37,6 +37,10 @@ type Ticket struct {
 	TxHash string
 	// MintTime is the timestamp at which this ticket was minted on the blockchain.
 	MintTime time.Time
+	// LeafIndex is the position of the holder's identity commitment among the
+	// leaves of the event's Merkle tree. It is assigned by the first tree build
+	// after mint and never changes; nil until then.
+	LeafIndex *int
 }
 
 // NewTicket represents data required to create a ticket record.
@@ -82,6 +86,7 @@ type TicketMinter interface {
 	//
 	// # Possible errors
 	//
+	//   - AlreadyExists: the token ID is already minted on-chain.
 	//   - Internal: RPC failure, transaction submission or on-chain revert.
 	Mint(ctx context.Context, recipient string, tokenID uint64) (txHash string, err error)
 
@@ -141,6 +146,28 @@ type TicketRepository interface {
 	//  - Internal: Database query or scan failure.
 	ListByEvent(ctx context.Context, eventID string) ([]*Ticket, error)
 
+	// CountByEvent returns the number of tickets issued for an event. An event
+	// without tickets yields 0.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If eventID is empty.
+	//  - Internal: Database query failure.
+	CountByEvent(ctx context.Context, eventID string) (int, error)
+
+	// AssignLeafIndexes gives every ticket of the event that has no leaf index
+	// yet the next free one, in mint order, after the highest index already in
+	// use. Assigned indices are never changed or reused, so a deleted ticket
+	// leaves a gap rather than shifting later holders. It returns how many
+	// tickets were assigned; concurrent calls for one event are serialized.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If eventID is empty.
+	//  - NotFound: If the event does not exist.
+	//  - Internal: Database execution failure.
+	AssignLeafIndexes(ctx context.Context, eventID string) (int, error)
+
 	// EventExists returns true if an event with the given ID exists in the database.
 	// Used to validate the event before triggering an irreversible on-chain mint.
 	//
@@ -0,0 +1,30 @@
+package entity
+
+// TokenUsage is the LLM token consumption of one ConcertSearcher.Search call,
+// summed over every model call the search made. It mirrors the usageMetadata
+// counters Gemini reports, so per-run cost can be aggregated without parsing
+// logs.
+type TokenUsage struct {
+	// PromptTokens is the input token count, including grounding context.
+	PromptTokens int
+	// CandidatesTokens is the generated output token count.
+	CandidatesTokens int
+	// ThinkingTokens is the count of reasoning tokens the model spent.
+	ThinkingTokens int
+	// ToolUseTokens is the input token count of tool results (search and
+	// URL context) fed back to the model.
+	ToolUseTokens int
+	// TotalTokens is the billed total as reported by the model.
+	TotalTokens int
+}
+
+// Add returns the element-wise sum of u and o.
+func (u TokenUsage) Add(o TokenUsage) TokenUsage {
+	return TokenUsage{
+		PromptTokens:     u.PromptTokens + o.PromptTokens,
+		CandidatesTokens: u.CandidatesTokens + o.CandidatesTokens,
+		ThinkingTokens:   u.ThinkingTokens + o.ThinkingTokens,
+		ToolUseTokens:    u.ToolUseTokens + o.ToolUseTokens,
+		TotalTokens:      u.TotalTokens + o.TotalTokens,
+	}
+}
@@ -2,7 +2,6 @@ package entity
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 )
 
@@ -28,24 +27,31 @@ func IsValidLanguageCode(s string) bool {
 	return languageCodeRe.MatchString(s)
 }
 
+// DefaultConcertReminderDays is the reminder lead time of a user who has
+// not chosen one.
+const DefaultConcertReminderDays = 1
+
 // Validate checks that the Home has a valid CountryCode, Level1, and optional Level2.
-// It returns a non-nil error describing the first validation failure.
+// It returns a *ValidationError listing every invalid field, keyed by the
+// proto field name (country_code, level_1, level_2), or nil when the Home is valid.
 // The entity layer returns stdlib errors; callers in the usecase layer are responsible
 // for wrapping them with the appropriate apperr code.
 func (h *Home) Validate() error {
-	if !countryCodeRe.MatchString(h.CountryCode) {
-		return fmt.Errorf("country_code must be a valid ISO 3166-1 alpha-2 code (e.g., JP), got %q", h.CountryCode)
-	}
-	if !iso31662Re.MatchString(h.Level1) {
-		return fmt.Errorf("level_1 must be a valid ISO 3166-2 code (e.g., JP-13), got %q", h.Level1)
+	var verr ValidationError
+	countryOK := countryCodeRe.MatchString(h.CountryCode)
+	if !countryOK {
+		verr.Add("country_code", "must be a valid ISO 3166-1 alpha-2 code (e.g., JP), got %q", h.CountryCode)
 	}
-	if h.Level1[:2] != h.CountryCode {
-		return fmt.Errorf("level_1 prefix %q does not match country_code %q", h.Level1[:2], h.CountryCode)
+	switch {
+	case !iso31662Re.MatchString(h.Level1):
+		verr.Add("level_1", "must be a valid ISO 3166-2 code (e.g., JP-13), got %q", h.Level1)
+	case countryOK && h.Level1[:2] != h.CountryCode:
+		verr.Add("level_1", "prefix %q does not match country_code %q", h.Level1[:2], h.CountryCode)
 	}
 	if h.Level2 != nil && (len(*h.Level2) == 0 || len(*h.Level2) > 20) {
-		return fmt.Errorf("level_2 must be between 1 and 20 characters when provided, got length %d", len(*h.Level2))
+		verr.Add("level_2", "must be between 1 and 20 characters when provided, got length %d", len(*h.Level2))
 	}
-	return nil
+	return verr.Err()
 }
 
 // Home represents the user's home area as a structured geographic location.
@@ -105,6 +111,11 @@ type User struct {
 	// Home is the user's home area. Nil when not set.
 	// Determines proximity classification (home/nearby/away).
 	Home *Home
+	// ConcertReminderDays is how many days before a concert the user is
+	// reminded of it; 0 reminds on the day itself. New users start at
+	// DefaultConcertReminderDays.
+	// TODO: let users change it once UserService carries the field.
+	ConcertReminderDays int
 }
 
 // NewUser represents data for creating a new user.
@@ -130,14 +141,15 @@ type NewUser struct {
 // CreateUser creates a new User with an auto-generated UUIDv7 ID from the given parameters.
 func CreateUser(params *NewUser) *User {
 	return &User{
-		ID:                newID(),
-		ExternalID:        params.ExternalID,
-		Email:             params.Email,
-		Name:              params.Name,
-		PreferredLanguage: params.PreferredLanguage,
-		Country:           params.Country,
-		TimeZone:          params.TimeZone,
-		IsActive:          true,
+		ID:                  newID(),
+		ExternalID:          params.ExternalID,
+		Email:               params.Email,
+		Name:                params.Name,
+		PreferredLanguage:   params.PreferredLanguage,
+		Country:             params.Country,
+		TimeZone:            params.TimeZone,
+		IsActive:            true,
+		ConcertReminderDays: DefaultConcertReminderDays,
 	}
 }
 
@@ -224,4 +236,16 @@ type UserRepository interface {
 
 	// List retrieves users with pagination.
 	List(ctx context.Context, limit, offset int) ([]*User, error)
+
+	// ListPage retrieves up to limit users ordered by ID using keyset
+	// pagination. Pass an empty cursor for the first page and the returned
+	// nextCursor for each following page; an empty nextCursor marks the last
+	// page. User IDs are UUIDv7, so users created while a caller is paging
+	// sort after every existing user and never shift or duplicate rows on
+	// later pages.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive or the cursor is malformed.
+	ListPage(ctx context.Context, limit int, cursor string) (users []*User, nextCursor string, err error)
 }
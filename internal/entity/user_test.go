@@ -1,10 +1,12 @@
 package entity_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateUser(t *testing.T) {
@@ -192,3 +194,20 @@ func TestHome_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestHome_Validate_AggregatesViolations(t *testing.T) {
+	t.Parallel()
+
+	level2Empty := ""
+	home := &entity.Home{CountryCode: "jp", Level1: "13", Level2: &level2Empty}
+
+	err := home.Validate()
+
+	verr, ok := errors.AsType[*entity.ValidationError](err)
+	require.True(t, ok, "expected *entity.ValidationError, got %T", err)
+	fields := make([]string, len(verr.Violations))
+	for i, v := range verr.Violations {
+		fields[i] = v.Field
+	}
+	assert.Equal(t, []string{"country_code", "level_1", "level_2"}, fields)
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FieldViolation describes a single rejected input field.
+type FieldViolation struct {
+	// Field is the snake_case path of the rejected field, with nested fields
+	// joined by dots (e.g., "home.level_1"). It matches the proto field path
+	// so clients can attach the message to the corresponding form input.
+	Field string
+	// Description explains why the value was rejected.
+	Description string
+}
+
+// ValidationError aggregates every FieldViolation found while validating one
+// input, so a caller learns about all invalid fields in a single round trip
+// instead of fixing them one at a time.
+//
+// Like other entity-layer errors it is a plain Go error; the usecase layer
+// wraps it with codes.InvalidArgument. The wrapped error still satisfies
+// errors.AsType[*ValidationError], which the RPC layer uses to attach the
+// violations to the response as structured details.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Add records a violation for field. The description is formatted with
+// fmt.Sprintf semantics.
+func (e *ValidationError) Add(field, format string, args ...any) {
+	e.Violations = append(e.Violations, FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Merge folds the violations of err into e, prefixing each field path with
+// prefix (e.g., "home"). A nil err is a no-op. An error that is not a
+// *ValidationError is recorded as a single violation on prefix itself.
+func (e *ValidationError) Merge(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	nested, ok := errors.AsType[*ValidationError](err)
+	if !ok {
+		e.Add(prefix, "%s", err.Error())
+		return
+	}
+	for _, v := range nested.Violations {
+		field := v.Field
+		if prefix != "" {
+			field = prefix + "." + field
+		}
+		e.Violations = append(e.Violations, FieldViolation{Field: field, Description: v.Description})
+	}
+}
+
+// Err returns e as an error when at least one violation was recorded, and
+// nil otherwise. Returning through Err avoids the typed-nil pitfall of
+// returning an empty *ValidationError as a non-nil error interface.
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error joins every violation as "field: description", separated by "; ".
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return strings.Join(parts, "; ")
+}
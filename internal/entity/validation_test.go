@@ -0,0 +1,48 @@
+package entity_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Err returns nil when no violation was recorded", func(t *testing.T) {
+		t.Parallel()
+
+		var verr entity.ValidationError
+		verr.Merge("home", nil)
+
+		assert.NoError(t, verr.Err())
+	})
+
+	t.Run("Merge prefixes nested field paths", func(t *testing.T) {
+		t.Parallel()
+
+		var nested entity.ValidationError
+		nested.Add("level_1", "is required")
+
+		var verr entity.ValidationError
+		verr.Add("name", "must not be empty")
+		verr.Merge("home", nested.Err())
+
+		assert.Equal(t, []entity.FieldViolation{
+			{Field: "name", Description: "must not be empty"},
+			{Field: "home.level_1", Description: "is required"},
+		}, verr.Violations)
+		assert.EqualError(t, verr.Err(), "name: must not be empty; home.level_1: is required")
+	})
+
+	t.Run("Merge records a plain error on the prefix field", func(t *testing.T) {
+		t.Parallel()
+
+		var verr entity.ValidationError
+		verr.Merge("home", errors.New("malformed"))
+
+		assert.Equal(t, []entity.FieldViolation{{Field: "home", Description: "malformed"}}, verr.Violations)
+	})
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"context"
+	"time"
 )
 
 // Venue represents a physical location where events are hosted.
@@ -25,9 +26,44 @@ type Venue struct {
 	ListedVenueName *string
 }
 
+// EnrichmentStatus is where a venue stands in place enrichment.
+type EnrichmentStatus string
+
+const (
+	// EnrichmentStatusPending marks a venue created from a raw listed name that
+	// has not been resolved to a place yet.
+	EnrichmentStatusPending EnrichmentStatus = "pending"
+	// EnrichmentStatusEnriched marks a venue resolved to its canonical place.
+	EnrichmentStatusEnriched EnrichmentStatus = "enriched"
+	// EnrichmentStatusFailed marks a venue whose name the place search could
+	// not resolve unambiguously.
+	EnrichmentStatusFailed EnrichmentStatus = "failed"
+	// EnrichmentStatusDuplicate marks a venue whose place another venue
+	// already holds. It awaits an administrator merging it into that venue.
+	EnrichmentStatusDuplicate EnrichmentStatus = "duplicate"
+	// EnrichmentStatusNeedsReview marks a venue whose place match was too
+	// uncertain to apply. It awaits an administrator approving or rejecting
+	// the proposed place.
+	EnrichmentStatusNeedsReview EnrichmentStatus = "needs_review"
+	// EnrichmentStatusRejected marks a venue whose proposed place an
+	// administrator rejected. It is not retried.
+	EnrichmentStatusRejected EnrichmentStatus = "rejected"
+)
+
+// IsValid reports whether s is a known enrichment status.
+func (s EnrichmentStatus) IsValid() bool {
+	switch s {
+	case EnrichmentStatusPending, EnrichmentStatusEnriched, EnrichmentStatusFailed, EnrichmentStatusDuplicate,
+		EnrichmentStatusNeedsReview, EnrichmentStatusRejected:
+		return true
+	}
+	return false
+}
+
 // VenuePlace represents a resolved canonical venue from an external place search service.
 type VenuePlace struct {
-	// ExternalID is the Google Place ID.
+	// ExternalID is the searching service's place identifier: a Google Place ID
+	// or a MusicBrainz Place ID (MBID).
 	ExternalID string
 	// Name is the canonical name returned by the external service.
 	Name string
@@ -36,6 +72,47 @@ type VenuePlace struct {
 	Coordinates *Coordinates
 }
 
+// EnrichmentReview is a venue whose place match enrichment held back as too
+// uncertain to apply, with the place it proposes.
+type EnrichmentReview struct {
+	// Venue is the venue as stored, still without a place.
+	Venue *Venue
+	// ProposedPlace is the place the search matched the venue to.
+	ProposedPlace *VenuePlace
+	// Confidence is how well ProposedPlace matches the venue's listed name,
+	// from 0 (unrelated) to 1 (the same name).
+	Confidence float64
+}
+
+// MergeReport summarizes the effect of merging a duplicate venue into its
+// canonical venue. A dry run reports what an apply would do without changing
+// anything.
+type MergeReport struct {
+	// CanonicalID is the venue that survives the merge.
+	CanonicalID string
+	// DuplicateID is the venue folded into CanonicalID. It is removed unless
+	// RetainedConcerts is positive.
+	DuplicateID string
+	// RepointedConcerts is the number of the duplicate's concerts moved to the
+	// canonical venue.
+	RepointedConcerts int
+	// DeletedConcerts is the number of the duplicate's concerts soft-deleted
+	// because the canonical venue already hosts a live event on the same date
+	// and start time. Their performers are carried over to that surviving
+	// event.
+	DeletedConcerts int
+	// DeletedConcertIDs lists the concerts counted in DeletedConcerts.
+	DeletedConcertIDs []string
+	// RetainedConcerts is the number of the duplicate's concerts left on it
+	// because the canonical venue already holds their date and start time:
+	// the soft-deleted ones, and live ones whose canonical counterpart is
+	// soft-deleted. The duplicate venue is kept, flagged as a duplicate of
+	// CanonicalID, while it still has concerts.
+	RetainedConcerts int
+	// DryRun reports whether the merge was only previewed.
+	DryRun bool
+}
+
 // VenuePlaceSearcher defines the interface for external place search services used in venue resolution.
 type VenuePlaceSearcher interface {
 	// SearchPlace looks up a venue by name and optional administrative area.
@@ -43,6 +120,8 @@ type VenuePlaceSearcher interface {
 	// # Possible errors
 	//
 	//  - NotFound: If no matching place is found.
+	//  - FailedPrecondition: If several places match and none can be preferred;
+	//    the venue needs manual review.
 	//  - Unavailable: If the external service is unreachable.
 	SearchPlace(ctx context.Context, name, adminArea string) (*VenuePlace, error)
 }
@@ -78,4 +157,165 @@ type VenueRepository interface {
 	//
 	//  - NotFound: If no venue with that listed name and admin area combination exists.
 	GetByListedName(ctx context.Context, listedVenueName string, adminArea *string) (*Venue, error)
+
+	// GetByNormalizedName retrieves a venue whose listed venue name or canonical
+	// name matches name after NFKC normalization, whitespace trimming, and case
+	// folding, so that "ZEPP NAGOYA", "Zepp Nagoya " and "Ｚｅｐｐ　Ｎａｇｏｙａ"
+	// all resolve to the same venue. The admin area must match exactly, as in
+	// GetByListedName. An exact listed-name match is preferred over a
+	// normalized one, and a listed-name match over a canonical-name match.
+	//
+	// # Possible errors
+	//
+	//  - NotFound: If no venue matches the normalized name and admin area.
+	GetByNormalizedName(ctx context.Context, name string, adminArea *string) (*Venue, error)
+
+	// UpdateEnriched records the canonical place resolved for a venue: its
+	// name, place ID, and coordinates are replaced by the place's and it is
+	// marked enriched. The listed venue name and admin area are kept.
+	//
+	// # Possible errors
+	//
+	//  - NotFound: If the venue does not exist.
+	//  - AlreadyExists: If another venue already holds the place ID.
+	UpdateEnriched(ctx context.Context, venueID string, place *VenuePlace) error
+
+	// UpdateMBID records the MusicBrainz Place ID (MBID) resolved for a venue.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID or MBID is empty.
+	//  - NotFound: If the venue does not exist.
+	UpdateMBID(ctx context.Context, venueID, mbid string) error
+
+	// MarkFailed records a failed enrichment attempt for the venue: it is
+	// marked failed, its attempt count is incremented, and the attempt time
+	// is recorded, so ListEnrichmentRetryCandidates can schedule a retry.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID is empty.
+	//  - NotFound: If the venue does not exist.
+	MarkFailed(ctx context.Context, venueID string) error
+
+	// MarkRetryable records an enrichment attempt that failed for a transient
+	// reason, such as the place search being unreachable: the venue is marked
+	// failed and the attempt time is recorded, so
+	// ListEnrichmentRetryCandidates schedules a retry, but the attempt count
+	// is left unchanged and an outage cannot exhaust the venue's retries.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID is empty.
+	//  - NotFound: If the venue does not exist.
+	MarkRetryable(ctx context.Context, venueID string) error
+
+	// MarkDuplicate flags the venue as a duplicate of canonicalID, the venue
+	// already holding its place, for an administrator to merge with
+	// MergeVenues. Nothing else about either venue changes.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If either ID is empty or both IDs are the same venue.
+	//  - NotFound: If the venue does not exist.
+	MarkDuplicate(ctx context.Context, venueID, canonicalID string) error
+
+	// MarkNeedsReview holds an uncertain place match for review: the venue is
+	// marked needs_review and place is stored as its proposed place together
+	// with confidence, without touching the venue's own name, place ID, or
+	// coordinates.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID is empty, the place has no ID or
+	//    name, or confidence is outside [0, 1].
+	//  - NotFound: If the venue does not exist.
+	MarkNeedsReview(ctx context.Context, venueID string, place *VenuePlace, confidence float64) error
+
+	// ListNeedsReview returns one page of the venues awaiting an enrichment
+	// review with their proposed places, oldest first.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive or offset is negative.
+	ListNeedsReview(ctx context.Context, limit, offset int) ([]*EnrichmentReview, error)
+
+	// ApproveEnrichment applies a venue's proposed place as UpdateEnriched
+	// would and marks it enriched. The match confidence is kept.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID is empty.
+	//  - NotFound: If the venue does not exist.
+	//  - FailedPrecondition: If the venue is not awaiting review.
+	//  - AlreadyExists: If another venue already holds the proposed place; it
+	//    should be merged rather than approved.
+	ApproveEnrichment(ctx context.Context, venueID string) error
+
+	// RejectEnrichment discards a venue's proposed place and marks it
+	// rejected, so the retry job does not propose it again. The match
+	// confidence is kept.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the venue ID is empty.
+	//  - NotFound: If the venue does not exist.
+	//  - FailedPrecondition: If the venue is not awaiting review.
+	RejectEnrichment(ctx context.Context, venueID string) error
+
+	// ListByEnrichmentStatus returns one page of the venues in the given
+	// enrichment status, oldest first. Venues are ordered by their time-ordered
+	// UUIDv7 IDs, so pages stay stable while newer venues are created.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If the status is unknown, limit is not positive, or
+	//    offset is negative.
+	ListByEnrichmentStatus(ctx context.Context, status EnrichmentStatus, limit, offset int) ([]*Venue, error)
+
+	// ListEnrichmentRetryCandidates returns up to limit failed venues due for
+	// another enrichment attempt at now, oldest first. A venue is due when it
+	// has fewer than maxAttempts attempts and its last attempt is at least
+	// baseBackoff × 2^(attempts−1) before now; one without a recorded attempt
+	// time is due immediately.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If baseBackoff is negative, or maxAttempts or limit
+	//    is not positive.
+	ListEnrichmentRetryCandidates(ctx context.Context, now time.Time, baseBackoff time.Duration, maxAttempts, limit int) ([]*Venue, error)
+
+	// UpsertByNames inserts the venues whose normalized name (NFKC, case- and
+	// surrounding-whitespace-insensitive) is not yet stored in the same admin
+	// area, and returns all requested venues keyed by their requested Name in
+	// one round-trip. Existing venues are returned as stored, and a venue whose
+	// GooglePlaceID already belongs to another venue resolves to that venue;
+	// callers must supply IDs for venues that may be inserted.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If any venue name is empty, or one name is requested
+	//    for more than one admin area.
+	UpsertByNames(ctx context.Context, venues []*Venue) (map[string]*Venue, error)
+
+	// MergeVenues folds duplicateID into canonicalID: the duplicate's concerts
+	// are re-pointed to the canonical venue and the duplicate venue is
+	// removed. A live duplicate concert whose (date, start time) the canonical
+	// venue already hosts live would violate the event natural key, so it is
+	// soft-deleted instead after its performers are linked to the surviving
+	// event. Concerts that cannot move are retained on the duplicate venue,
+	// which is then kept and flagged as a duplicate of canonicalID.
+	//
+	// With dryRun the report is computed without mutating anything; otherwise
+	// the merge runs in a single transaction. Both modes take the same counts
+	// under the same locks, so a dry run predicts the apply exactly when
+	// nothing else writes in between.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If either ID is empty or both IDs are the same venue.
+	//  - NotFound: If either venue does not exist.
+	//  - FailedPrecondition: If a concert that would be soft-deleted has
+	//    tickets or used nullifiers.
+	MergeVenues(ctx context.Context, canonicalID, duplicateID string, dryRun bool) (*MergeReport, error)
 }
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// groth16Protocol is the protocol snarkjs writes into a Groth16 proof.
+const groth16Protocol = "groth16"
+
+// groth16ProofJSON is the snarkjs Groth16 proof format. Each point is in
+// projective coordinates: pi_a and pi_c are G1 points [x, y, z], and pi_b is a
+// G2 point whose coordinates are pairs of field elements.
+type groth16ProofJSON struct {
+	PiA      []string   `json:"pi_a"`
+	PiB      [][]string `json:"pi_b"`
+	PiC      []string   `json:"pi_c"`
+	Protocol *string    `json:"protocol"`
+}
+
+// ValidateGroth16ProofJSON checks that proofJSON has the shape of a snarkjs
+// Groth16 proof: pi_a, pi_b, and pi_c of the right dimensions with decimal
+// coordinates, and protocol "groth16". It does not verify the proof; it lets a
+// malformed one be rejected before the costly pairing check.
+func ValidateGroth16ProofJSON(proofJSON string) error {
+	var proof groth16ProofJSON
+	if err := json.Unmarshal([]byte(proofJSON), &proof); err != nil {
+		return fmt.Errorf("unmarshal proof: %w", err)
+	}
+
+	if proof.Protocol == nil {
+		return errors.New("proof is missing protocol")
+	}
+	if *proof.Protocol != groth16Protocol {
+		return fmt.Errorf("unsupported proof protocol %q, want %q", *proof.Protocol, groth16Protocol)
+	}
+	if err := validateG1Point(proof.PiA, "pi_a"); err != nil {
+		return err
+	}
+	if err := validateG2Point(proof.PiB, "pi_b"); err != nil {
+		return err
+	}
+	return validateG1Point(proof.PiC, "pi_c")
+}
+
+func validateG1Point(coords []string, label string) error {
+	if coords == nil {
+		return fmt.Errorf("proof is missing %s", label)
+	}
+	if len(coords) != 3 {
+		return fmt.Errorf("proof %s must have 3 coordinates, got %d", label, len(coords))
+	}
+	for i, c := range coords {
+		if err := validateDecimal(c, fmt.Sprintf("%s[%d]", label, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateG2Point(coords [][]string, label string) error {
+	if coords == nil {
+		return fmt.Errorf("proof is missing %s", label)
+	}
+	if len(coords) != 3 {
+		return fmt.Errorf("proof %s must have 3 coordinates, got %d", label, len(coords))
+	}
+	for i, pair := range coords {
+		if len(pair) != 2 {
+			return fmt.Errorf("proof %s[%d] must have 2 elements, got %d", label, i, len(pair))
+		}
+		for j, c := range pair {
+			if err := validateDecimal(c, fmt.Sprintf("%s[%d][%d]", label, i, j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateDecimal(s, label string) error {
+	if _, ok := new(big.Int).SetString(s, 10); !ok {
+		return fmt.Errorf("proof %s is not a decimal integer: %q", label, s)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+// validProofJSON has the shape of a snarkjs Groth16 proof.
+const validProofJSON = `{
+	"pi_a": ["1", "2", "1"],
+	"pi_b": [["3", "4"], ["5", "6"], ["1", "0"]],
+	"pi_c": ["7", "8", "1"],
+	"protocol": "groth16",
+	"curve": "bn128"
+}`
+
+func TestValidateGroth16ProofJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		proofJSON       string
+		wantErrContains string // non-empty means an error is expected
+	}{
+		{
+			name:      "valid-shaped proof passes",
+			proofJSON: validProofJSON,
+		},
+		{
+			name:            "invalid JSON",
+			proofJSON:       "not-json",
+			wantErrContains: "unmarshal proof",
+		},
+		{
+			name:            "empty object is missing protocol",
+			proofJSON:       `{}`,
+			wantErrContains: "proof is missing protocol",
+		},
+		{
+			name:            "other protocol",
+			proofJSON:       `{"pi_a": ["1", "2", "1"], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "pi_c": ["7", "8", "1"], "protocol": "plonk"}`,
+			wantErrContains: `unsupported proof protocol "plonk"`,
+		},
+		{
+			name:            "missing pi_a",
+			proofJSON:       `{"pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "pi_c": ["7", "8", "1"], "protocol": "groth16"}`,
+			wantErrContains: "proof is missing pi_a",
+		},
+		{
+			name:            "missing pi_b",
+			proofJSON:       `{"pi_a": ["1", "2", "1"], "pi_c": ["7", "8", "1"], "protocol": "groth16"}`,
+			wantErrContains: "proof is missing pi_b",
+		},
+		{
+			name:            "missing pi_c",
+			proofJSON:       `{"pi_a": ["1", "2", "1"], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "protocol": "groth16"}`,
+			wantErrContains: "proof is missing pi_c",
+		},
+		{
+			name:            "pi_a with too few coordinates",
+			proofJSON:       `{"pi_a": ["1", "2"], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "pi_c": ["7", "8", "1"], "protocol": "groth16"}`,
+			wantErrContains: "proof pi_a must have 3 coordinates, got 2",
+		},
+		{
+			name:            "pi_b coordinate that is not a pair",
+			proofJSON:       `{"pi_a": ["1", "2", "1"], "pi_b": [["3", "4"], ["5"], ["1", "0"]], "pi_c": ["7", "8", "1"], "protocol": "groth16"}`,
+			wantErrContains: "proof pi_b[1] must have 2 elements, got 1",
+		},
+		{
+			name:            "non-decimal coordinate",
+			proofJSON:       `{"pi_a": ["1", "2", "1"], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "pi_c": ["0x7", "8", "1"], "protocol": "groth16"}`,
+			wantErrContains: `proof pi_c[0] is not a decimal integer: "0x7"`,
+		},
+		{
+			name:            "coordinates of the wrong JSON type",
+			proofJSON:       `{"pi_a": [1, 2, 1], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "pi_c": ["7", "8", "1"], "protocol": "groth16"}`,
+			wantErrContains: "unmarshal proof",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := entity.ValidateGroth16ProofJSON(tt.proofJSON)
+			if tt.wantErrContains != "" {
+				assert.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -8,18 +8,133 @@ import (
 	"strings"
 )
 
+// bn254ScalarFieldModulus is the order r of the BN254 scalar field, in which
+// every circom circuit signal lives.
+const bn254ScalarFieldModulus = "21888242871839275222246405745257275088548364400416034343698204186575808495617"
+
+// BN254ScalarField is the BN254 scalar field modulus r. A canonical field
+// element v satisfies 0 <= v < r.
+var BN254ScalarField, _ = new(big.Int).SetString(bn254ScalarFieldModulus, 10)
+
+// ZKPSignalsOption configures ParseZKPPublicSignals.
+type ZKPSignalsOption func(*zkpSignalsConfig)
+
+type zkpSignalsConfig struct {
+	layout      ZKPSignalLayout
+	strictField bool
+}
+
+// WithSignalLayout makes ParseZKPPublicSignals read the signals at the
+// positions of layout instead of ZKPSignalLayoutV1.
+func WithSignalLayout(layout ZKPSignalLayout) ZKPSignalsOption {
+	return func(c *zkpSignalsConfig) { c.layout = layout }
+}
+
+// WithStrictFieldValidation makes ParseZKPPublicSignals reject any signal
+// that is not a canonical BN254 field element, as the circuit requires. A
+// value at or above the modulus still fits in 32 bytes but aliases a smaller
+// element, so without it such a signal passes parsing and fails only at
+// proof verification, or is recorded in a non-canonical form.
+func WithStrictFieldValidation() ZKPSignalsOption {
+	return func(c *zkpSignalsConfig) { c.strictField = true }
+}
+
+// ValidateBN254FieldElement reports an error when n is not a canonical BN254
+// field element: negative, or not less than BN254ScalarField.
+func ValidateBN254FieldElement(n *big.Int, label string) error {
+	if n.Sign() < 0 {
+		return fmt.Errorf("%s is not a BN254 field element: negative value %s", label, n.String())
+	}
+	if n.Cmp(BN254ScalarField) >= 0 {
+		return fmt.Errorf("%s is not a BN254 field element: %s is not less than the field modulus", label, n.String())
+	}
+	return nil
+}
+
+// Names of the public signals the backend reads, as declared in the circuit.
+const (
+	ZKPSignalMerkleRoot    = "merkleRoot"
+	ZKPSignalEventID       = "eventId"
+	ZKPSignalNullifierHash = "nullifierHash"
+)
+
+// zkpRequiredSignals are the signals every ZKPSignalLayout must place.
+var zkpRequiredSignals = []string{ZKPSignalMerkleRoot, ZKPSignalEventID, ZKPSignalNullifierHash}
+
+// ZKPSignalLayout describes the public signals array a circuit version
+// emits. snarkjs orders public signals by the circuit's declarations, so a
+// circuit upgrade that adds or reorders signals ships with a new layout
+// rather than being read at stale positions.
+type ZKPSignalLayout struct {
+	// Version identifies the circuit version, e.g. "v1".
+	Version string
+	// Count is the exact number of public signals the circuit emits.
+	Count int
+	// Indices maps each signal name to its position in the array. Signals
+	// the backend does not read may be left out.
+	Indices map[string]int
+}
+
+// ZKPSignalLayoutV1 is the layout of the original entry circuit:
+// [merkleRoot, eventId, nullifierHash].
+var ZKPSignalLayoutV1 = ZKPSignalLayout{
+	Version: "v1",
+	Count:   3,
+	Indices: map[string]int{
+		ZKPSignalMerkleRoot:    0,
+		ZKPSignalEventID:       1,
+		ZKPSignalNullifierHash: 2,
+	},
+}
+
+// zkpSignalLayouts are the circuit versions the backend can read, by version.
+var zkpSignalLayouts = map[string]ZKPSignalLayout{
+	ZKPSignalLayoutV1.Version: ZKPSignalLayoutV1,
+}
+
+// LookupZKPSignalLayout returns the public signal layout of the given
+// circuit version.
+func LookupZKPSignalLayout(version string) (ZKPSignalLayout, error) {
+	layout, ok := zkpSignalLayouts[version]
+	if !ok {
+		return ZKPSignalLayout{}, fmt.Errorf("unknown ZKP circuit version %q", version)
+	}
+	return layout, nil
+}
+
+// Validate reports an error when the layout does not place every signal the
+// backend reads at a distinct index within Count.
+func (l ZKPSignalLayout) Validate() error {
+	seen := make(map[int]string, len(l.Indices))
+	for name, idx := range l.Indices {
+		if idx < 0 || idx >= l.Count {
+			return fmt.Errorf("signal layout %s: %s index %d out of range for %d signals", l.Version, name, idx, l.Count)
+		}
+		if other, dup := seen[idx]; dup {
+			return fmt.Errorf("signal layout %s: %s and %s share index %d", l.Version, other, name, idx)
+		}
+		seen[idx] = name
+	}
+	for _, name := range zkpRequiredSignals {
+		if _, ok := l.Indices[name]; !ok {
+			return fmt.Errorf("signal layout %s: missing %s", l.Version, name)
+		}
+	}
+	return nil
+}
+
 // ZKPPublicSignals holds the parsed public signals from a ZK proof.
 // All values are BN254 field elements encoded as decimal strings.
 //
-// Expected JSON input format (index order matters):
+// Expected JSON input format for ZKPSignalLayoutV1 (index order matters):
 //
 //	["<merkleRoot>", "<eventId>", "<nullifierHash>"]
 type ZKPPublicSignals struct {
-	// MerkleRoot is the Merkle root of the event membership set (index 0).
+	// MerkleRoot is the Merkle root of the event membership set.
 	MerkleRoot []byte
-	// EventID is the event UUID encoded as BigInt(hex(uuid_without_hyphens)) (index 1).
+	// EventID is the event UUID encoded as BigInt(hex(uuid_without_hyphens)).
 	EventID *big.Int
-	// NullifierHash prevents double-entry for a given (identity, event) pair (index 2).
+	// NullifierHash prevents double-entry for a given (identity, event) pair.
 	NullifierHash []byte
 }
 
@@ -37,38 +152,61 @@ func (s *ZKPPublicSignals) VerifyEventID(expectedUUID string) error {
 	return nil
 }
 
-// ParseZKPPublicSignals parses the public signals JSON array and extracts all fields:
-// merkleRoot (index 0), eventId (index 1), nullifierHash (index 2).
-func ParseZKPPublicSignals(publicSignalsJSON string) (*ZKPPublicSignals, error) {
+// ParseZKPPublicSignals parses the public signals JSON array and extracts
+// merkleRoot, eventId and nullifierHash at the positions of the signal layout,
+// ZKPSignalLayoutV1 unless WithSignalLayout is given. The array must hold
+// exactly the layout's Count signals, so proofs from a different circuit
+// version fail here instead of being read at the wrong positions. With
+// WithStrictFieldValidation each must also be a canonical BN254 field element.
+func ParseZKPPublicSignals(publicSignalsJSON string, opts ...ZKPSignalsOption) (*ZKPPublicSignals, error) {
+	cfg := zkpSignalsConfig{layout: ZKPSignalLayoutV1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	layout := cfg.layout
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+
 	var raw []string
 	if err := json.Unmarshal([]byte(publicSignalsJSON), &raw); err != nil {
 		return nil, fmt.Errorf("unmarshal public signals: %w", err)
 	}
 
-	if len(raw) < 3 {
-		return nil, fmt.Errorf("expected at least 3 public signals, got %d", len(raw))
+	if len(raw) != layout.Count {
+		return nil, fmt.Errorf("expected %d public signals for circuit %s, got %d", layout.Count, layout.Version, len(raw))
 	}
 
-	// Index 0: merkleRoot
-	rootInt := new(big.Int)
-	if _, ok := rootInt.SetString(raw[0], 10); !ok {
-		return nil, fmt.Errorf("invalid merkle root: %s", raw[0])
+	parse := func(s, label string) (*big.Int, error) {
+		n := new(big.Int)
+		if _, ok := n.SetString(s, 10); !ok {
+			return nil, fmt.Errorf("invalid %s: %s", label, s)
+		}
+		if cfg.strictField {
+			if err := ValidateBN254FieldElement(n, label); err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	}
+
+	rootInt, err := parse(raw[layout.Indices[ZKPSignalMerkleRoot]], "merkle root")
+	if err != nil {
+		return nil, err
 	}
 	merkleRoot, err := BigIntToBytes32(rootInt, "merkle root")
 	if err != nil {
 		return nil, err
 	}
 
-	// Index 1: eventId
-	eventID := new(big.Int)
-	if _, ok := eventID.SetString(raw[1], 10); !ok {
-		return nil, fmt.Errorf("invalid event ID: %s", raw[1])
+	eventID, err := parse(raw[layout.Indices[ZKPSignalEventID]], "event ID")
+	if err != nil {
+		return nil, err
 	}
 
-	// Index 2: nullifierHash
-	nullInt := new(big.Int)
-	if _, ok := nullInt.SetString(raw[2], 10); !ok {
-		return nil, fmt.Errorf("invalid nullifier hash: %s", raw[2])
+	nullInt, err := parse(raw[layout.Indices[ZKPSignalNullifierHash]], "nullifier hash")
+	if err != nil {
+		return nil, err
 	}
 	nullifierHash, err := BigIntToBytes32(nullInt, "nullifier hash")
 	if err != nil {
@@ -84,6 +222,8 @@ func ParseZKPPublicSignals(publicSignalsJSON string) (*ZKPPublicSignals, error)
 
 // BigIntToBytes32 converts a big.Int to a 32-byte big-endian slice.
 // Returns an error if the value exceeds 32 bytes (> 2^256-1, outside BN254 field).
+// It does not check the value against the field modulus; see
+// ValidateBN254FieldElement.
 func BigIntToBytes32(n *big.Int, label string) ([]byte, error) {
 	b := n.Bytes()
 	if len(b) > 32 {
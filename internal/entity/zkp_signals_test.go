@@ -52,7 +52,12 @@ func TestParseZKPPublicSignals(t *testing.T) {
 		{
 			name:            "fewer than 3 signals returns error",
 			args:            args{publicSignalsJSON: `["123", "456"]`},
-			wantErrContains: "expected at least 3 public signals",
+			wantErrContains: "expected 3 public signals for circuit v1, got 2",
+		},
+		{
+			name:            "more than 3 signals returns error",
+			args:            args{publicSignalsJSON: `["123", "456", "789", "0"]`},
+			wantErrContains: "expected 3 public signals for circuit v1, got 4",
 		},
 		{
 			name:            "invalid merkle root decimal returns error",
@@ -72,7 +77,7 @@ func TestParseZKPPublicSignals(t *testing.T) {
 		{
 			name:            "empty JSON array returns error",
 			args:            args{publicSignalsJSON: `[]`},
-			wantErrContains: "expected at least 3 public signals",
+			wantErrContains: "expected 3 public signals for circuit v1, got 0",
 		},
 	}
 
@@ -97,6 +102,145 @@ func TestParseZKPPublicSignals(t *testing.T) {
 	}
 }
 
+func TestParseZKPPublicSignals_SignalLayouts(t *testing.T) {
+	t.Parallel()
+
+	merkleRoot := big.NewInt(123456789)
+	eventID := big.NewInt(987654321)
+	nullifier := big.NewInt(111111111)
+
+	// v2 adds an expiry signal and moves the nullifier hash first.
+	v2 := entity.ZKPSignalLayout{
+		Version: "v2",
+		Count:   4,
+		Indices: map[string]int{
+			entity.ZKPSignalNullifierHash: 0,
+			entity.ZKPSignalMerkleRoot:    1,
+			"expiresAt":                   2,
+			entity.ZKPSignalEventID:       3,
+		},
+	}
+	require.NoError(t, v2.Validate())
+
+	v1JSON := validSignalsJSON(t, merkleRoot, eventID, nullifier)
+	data, err := json.Marshal([]string{nullifier.String(), merkleRoot.String(), "1767225600", eventID.String()})
+	require.NoError(t, err)
+	v2JSON := string(data)
+
+	tests := []struct {
+		name            string
+		layout          entity.ZKPSignalLayout
+		signalsJSON     string
+		wantErrContains string
+	}{
+		{
+			name:        "v1 signals with the v1 layout",
+			layout:      entity.ZKPSignalLayoutV1,
+			signalsJSON: v1JSON,
+		},
+		{
+			name:        "v2 signals with the v2 layout",
+			layout:      v2,
+			signalsJSON: v2JSON,
+		},
+		{
+			name:            "v2 signals with the v1 layout fail on the count",
+			layout:          entity.ZKPSignalLayoutV1,
+			signalsJSON:     v2JSON,
+			wantErrContains: "expected 3 public signals for circuit v1, got 4",
+		},
+		{
+			name:            "v1 signals with the v2 layout fail on the count",
+			layout:          v2,
+			signalsJSON:     v1JSON,
+			wantErrContains: "expected 4 public signals for circuit v2, got 3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := entity.ParseZKPPublicSignals(tt.signalsJSON, entity.WithSignalLayout(tt.layout))
+			if tt.wantErrContains != "" {
+				assert.ErrorContains(t, err, tt.wantErrContains)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 0, got.EventID.Cmp(eventID))
+			assert.Equal(t, 0, new(big.Int).SetBytes(got.MerkleRoot).Cmp(merkleRoot))
+			assert.Equal(t, 0, new(big.Int).SetBytes(got.NullifierHash).Cmp(nullifier))
+		})
+	}
+}
+
+func TestZKPSignalLayout_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		layout          entity.ZKPSignalLayout
+		wantErrContains string
+	}{
+		{
+			name:   "v1 is valid",
+			layout: entity.ZKPSignalLayoutV1,
+		},
+		{
+			name: "missing signal",
+			layout: entity.ZKPSignalLayout{Version: "vx", Count: 2, Indices: map[string]int{
+				entity.ZKPSignalMerkleRoot: 0,
+				entity.ZKPSignalEventID:    1,
+			}},
+			wantErrContains: "missing nullifierHash",
+		},
+		{
+			name: "index out of range",
+			layout: entity.ZKPSignalLayout{Version: "vx", Count: 3, Indices: map[string]int{
+				entity.ZKPSignalMerkleRoot:    0,
+				entity.ZKPSignalEventID:       1,
+				entity.ZKPSignalNullifierHash: 3,
+			}},
+			wantErrContains: "nullifierHash index 3 out of range for 3 signals",
+		},
+		{
+			name: "shared index",
+			layout: entity.ZKPSignalLayout{Version: "vx", Count: 3, Indices: map[string]int{
+				entity.ZKPSignalMerkleRoot:    0,
+				entity.ZKPSignalEventID:       1,
+				entity.ZKPSignalNullifierHash: 1,
+			}},
+			wantErrContains: "share index 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.layout.Validate()
+			if tt.wantErrContains != "" {
+				assert.ErrorContains(t, err, tt.wantErrContains)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLookupZKPSignalLayout(t *testing.T) {
+	t.Parallel()
+
+	layout, err := entity.LookupZKPSignalLayout("v1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ZKPSignalLayoutV1, layout)
+
+	_, err = entity.LookupZKPSignalLayout("v9")
+	assert.ErrorContains(t, err, `unknown ZKP circuit version "v9"`)
+}
+
 func TestParseZKPPublicSignals_RoundTrip(t *testing.T) {
 	t.Parallel()
 
@@ -119,6 +263,71 @@ func TestParseZKPPublicSignals_RoundTrip(t *testing.T) {
 	assert.Equal(t, nullifier, recoveredNull)
 }
 
+func TestParseZKPPublicSignals_StrictFieldValidation(t *testing.T) {
+	t.Parallel()
+
+	r := entity.BN254ScalarField
+	maxElement := new(big.Int).Sub(r, big.NewInt(1))
+	small := big.NewInt(7)
+
+	tests := []struct {
+		name            string
+		signals         []*big.Int
+		wantErrContains string
+	}{
+		{
+			name:    "largest field element is accepted",
+			signals: []*big.Int{maxElement, small, maxElement},
+		},
+		{
+			name:    "zero is accepted",
+			signals: []*big.Int{big.NewInt(0), small, big.NewInt(0)},
+		},
+		{
+			name:            "merkle root equal to the modulus is rejected",
+			signals:         []*big.Int{r, small, small},
+			wantErrContains: "merkle root is not a BN254 field element",
+		},
+		{
+			name:            "event ID above the modulus is rejected",
+			signals:         []*big.Int{small, new(big.Int).Add(r, big.NewInt(1)), small},
+			wantErrContains: "event ID is not a BN254 field element",
+		},
+		{
+			name:            "nullifier hash equal to the modulus is rejected",
+			signals:         []*big.Int{small, small, r},
+			wantErrContains: "nullifier hash is not a BN254 field element",
+		},
+		{
+			name:            "negative value is rejected",
+			signals:         []*big.Int{small, small, big.NewInt(-1)},
+			wantErrContains: "negative value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			signalsJSON := validSignalsJSON(t, tt.signals[0], tt.signals[1], tt.signals[2])
+
+			got, err := entity.ParseZKPPublicSignals(signalsJSON, entity.WithStrictFieldValidation())
+			if tt.wantErrContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContains)
+				assert.Nil(t, got)
+
+				// The lenient default still accepts any value that fits in
+				// 32 bytes.
+				_, err := entity.ParseZKPPublicSignals(signalsJSON)
+				assert.NoError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 0, new(big.Int).SetBytes(got.MerkleRoot).Cmp(tt.signals[0]))
+		})
+	}
+}
+
 func TestZKPPublicSignals_VerifyEventID(t *testing.T) {
 	t.Parallel()
 
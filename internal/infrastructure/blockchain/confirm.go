@@ -0,0 +1,106 @@
+// Package blockchain provides helpers shared by the EVM contract clients.
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// DefaultConfirmTimeout bounds how long ConfirmTx waits for a receipt when no
+// timeout is configured. Base produces a block every two seconds, so a
+// transaction still unmined after this long is stuck rather than slow.
+const DefaultConfirmTimeout = 2 * time.Minute
+
+// errConfirmTimeout is the cancellation cause set when ConfirmTx's own
+// timeout, rather than the caller's context, ends the wait.
+var errConfirmTimeout = errors.New("confirmation timeout")
+
+// RevertedError reports a transaction that was mined but reverted. It wraps
+// an Internal apperr, so errors.Is(err, apperr.ErrInternal) holds.
+type RevertedError struct {
+	// TxHash is the hash of the reverted transaction.
+	TxHash common.Hash
+	// Receipt is the receipt of the reverted transaction.
+	Receipt *types.Receipt
+
+	err error
+}
+
+func newRevertedError(receipt *types.Receipt) *RevertedError {
+	return &RevertedError{
+		TxHash:  receipt.TxHash,
+		Receipt: receipt,
+		err: apperr.New(codes.Internal, fmt.Sprintf("blockchain: transaction reverted on-chain (tx=%s)", receipt.TxHash.Hex()),
+			slog.Uint64("block_number", receipt.BlockNumber.Uint64()),
+		),
+	}
+}
+
+// Error implements error.
+func (e *RevertedError) Error() string { return e.err.Error() }
+
+// Unwrap returns the underlying Internal apperr.
+func (e *RevertedError) Unwrap() error { return e.err }
+
+// confirmConfig holds the settings applied by ConfirmOption.
+type confirmConfig struct {
+	timeout time.Duration
+}
+
+// ConfirmOption configures ConfirmTx.
+type ConfirmOption func(*confirmConfig)
+
+// WithConfirmTimeout overrides DefaultConfirmTimeout. A non-positive value
+// keeps the default.
+func WithConfirmTimeout(d time.Duration) ConfirmOption {
+	return func(c *confirmConfig) {
+		if d > 0 {
+			c.timeout = d
+		}
+	}
+}
+
+// ConfirmTx waits for tx to be mined and verifies that it succeeded. Every
+// write to a contract must go through ConfirmTx: a mined transaction is not
+// necessarily a successful one, and only the receipt status tells them apart.
+//
+// # Possible errors:
+//
+//   - Internal: the transaction was mined but reverted (*RevertedError).
+//   - DeadlineExceeded: no receipt arrived within the confirmation timeout.
+//     The transaction may still be mined later.
+//   - context.Canceled / context.DeadlineExceeded: ctx ended first; returned
+//     as is.
+func ConfirmTx(ctx context.Context, backend bind.DeployBackend, tx *types.Transaction, opts ...ConfirmOption) (*types.Receipt, error) {
+	cfg := confirmConfig{timeout: DefaultConfirmTimeout}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	waitCtx, cancel := context.WithTimeoutCause(ctx, cfg.timeout, errConfirmTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(waitCtx, backend, tx)
+	if err != nil {
+		if ctx.Err() == nil && errors.Is(context.Cause(waitCtx), errConfirmTimeout) {
+			return nil, apperr.Wrap(err, codes.DeadlineExceeded, "blockchain: timed out waiting for transaction receipt",
+				slog.String("tx_hash", tx.Hash().Hex()),
+				slog.Duration("timeout", cfg.timeout),
+			)
+		}
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, newRevertedError(receipt)
+	}
+	return receipt, nil
+}
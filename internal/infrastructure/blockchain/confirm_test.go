@@ -0,0 +1,140 @@
+package blockchain_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain/ticketsbt"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPrivateKey is a throwaway private key for unit testing (never used on-chain).
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// simulatedChainID is the chain ID the simulated backend always uses.
+const simulatedChainID = 1337
+
+// stubMintCode is the runtime bytecode of a minimal stand-in for TicketSBT
+// whose mint(address,uint256) reverts for an already minted token ID:
+//
+//	PUSH1 0x24 CALLDATALOAD DUP1 SLOAD PUSH1 0x0d JUMPI
+//	PUSH1 0x01 SWAP1 SSTORE STOP
+//	JUMPDEST PUSH1 0x00 DUP1 REVERT
+var stubMintCode = common.FromHex("6024358054600d5760019055005b600080fd")
+
+var (
+	contractAddr = common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	recipient    = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e20d17dc79C")
+)
+
+// preMintedToken is already minted at genesis, so minting it again reverts.
+const preMintedToken = 7
+
+type confirmTestDeps struct {
+	backend  *simulated.Backend
+	contract *ticketsbt.TicketSBT
+	signer   *bind.TransactOpts
+}
+
+func newConfirmTestDeps(t *testing.T) *confirmTestDeps {
+	t.Helper()
+
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.Ether)},
+		contractAddr: {
+			Code:    stubMintCode,
+			Storage: map[common.Hash]common.Hash{common.BigToHash(big.NewInt(preMintedToken)): common.BigToHash(big.NewInt(1))},
+		},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	contract, err := ticketsbt.NewTicketSBT(contractAddr, backend.Client())
+	require.NoError(t, err)
+	signer, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulatedChainID))
+	require.NoError(t, err)
+
+	return &confirmTestDeps{backend: backend, contract: contract, signer: signer}
+}
+
+// mint submits a mint transaction. A fixed gas limit skips gas estimation so
+// that a reverting call still reaches the chain.
+func (d *confirmTestDeps) mint(t *testing.T, tokenID int64) *types.Transaction {
+	t.Helper()
+	opts := *d.signer
+	opts.GasLimit = 100_000
+	tx, err := d.contract.Mint(&opts, recipient, big.NewInt(tokenID))
+	require.NoError(t, err)
+	return tx
+}
+
+func TestConfirmTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the receipt of a successful mint", func(t *testing.T) {
+		t.Parallel()
+		d := newConfirmTestDeps(t)
+		tx := d.mint(t, 1)
+		d.backend.Commit()
+
+		receipt, err := blockchain.ConfirmTx(context.Background(), d.backend.Client(), tx)
+
+		require.NoError(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		assert.Equal(t, tx.Hash(), receipt.TxHash)
+	})
+
+	t.Run("returns a typed Internal error for a reverted call", func(t *testing.T) {
+		t.Parallel()
+		d := newConfirmTestDeps(t)
+		tx := d.mint(t, preMintedToken)
+		d.backend.Commit()
+
+		receipt, err := blockchain.ConfirmTx(context.Background(), d.backend.Client(), tx)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+		reverted, ok := errors.AsType[*blockchain.RevertedError](err)
+		require.True(t, ok)
+		assert.Equal(t, tx.Hash(), reverted.TxHash)
+		require.NotNil(t, receipt)
+		assert.Equal(t, types.ReceiptStatusFailed, receipt.Status)
+	})
+
+	t.Run("gives up after the confirmation timeout", func(t *testing.T) {
+		t.Parallel()
+		d := newConfirmTestDeps(t)
+		tx := d.mint(t, 2) // never committed
+
+		_, err := blockchain.ConfirmTx(context.Background(), d.backend.Client(), tx,
+			blockchain.WithConfirmTimeout(50*time.Millisecond))
+
+		assert.ErrorIs(t, err, apperr.ErrDeadlineExceeded)
+	})
+
+	t.Run("returns the caller's context error on cancellation", func(t *testing.T) {
+		t.Parallel()
+		d := newConfirmTestDeps(t)
+		tx := d.mint(t, 3) // never committed
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := blockchain.ConfirmTx(ctx, d.backend.Client(), tx)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,147 @@
+package blockchain
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// NonceSource reports the next nonce an account may use, counting its
+// transactions still in the mempool. *ethclient.Client satisfies it.
+type NonceSource interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hands out the nonces of a single sending account from memory,
+// so concurrent writers neither query the node per transaction nor collide on
+// the same nonce. A nonce whose transaction never reached the mempool is
+// returned with Release(false) and handed out again before any higher one,
+// which closes the gap it would otherwise leave: the node does not mine a
+// transaction while a lower nonce of the same account is missing.
+//
+// The in-memory view is reconciled with the node's pending nonce on first use
+// and on Reset. NonceManager is safe for concurrent use.
+type NonceManager struct {
+	mu      sync.Mutex
+	source  NonceSource
+	account common.Address
+	synced  bool
+	// epoch counts resynchronisations; a Nonce from an earlier epoch no
+	// longer describes the current view and its Release is ignored.
+	epoch uint64
+	next  uint64
+	// released holds returned nonces below next, in ascending order.
+	released []uint64
+}
+
+// Nonce is a nonce leased from a NonceManager. Every Nonce must be released
+// exactly once, after its transaction has been submitted or has failed to be.
+type Nonce struct {
+	// Value is the nonce to sign the transaction with.
+	Value uint64
+
+	m     *NonceManager
+	epoch uint64
+	done  bool
+}
+
+// NewNonceManager creates a NonceManager for account. The nonce is fetched
+// from source on the first Acquire unless Reset is called earlier.
+func NewNonceManager(source NonceSource, account common.Address) *NonceManager {
+	return &NonceManager{source: source, account: account}
+}
+
+// Reset discards the in-memory view and re-reads the pending nonce from the
+// node. Call it on startup and after a stuck transaction has been replaced or
+// dropped. Nonces leased before Reset are invalidated: releasing them is a
+// no-op.
+//
+// # Possible errors:
+//
+//   - Internal: the pending nonce could not be fetched. The manager retries
+//     on the next Acquire.
+func (m *NonceManager) Reset(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.synced = false
+	return m.sync(ctx)
+}
+
+// Acquire leases the lowest free nonce: a previously released one if any,
+// otherwise the next unused one.
+//
+// # Possible errors:
+//
+//   - Internal: the manager is not synchronised and the pending nonce could
+//     not be fetched.
+func (m *NonceManager) Acquire(ctx context.Context) (*Nonce, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.sync(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var value uint64
+	if len(m.released) > 0 {
+		value = m.released[0]
+		m.released = m.released[1:]
+	} else {
+		value = m.next
+		m.next++
+	}
+	return &Nonce{Value: value, m: m, epoch: m.epoch}, nil
+}
+
+// sync replaces the in-memory view with the node's pending nonce. The caller
+// must hold mu.
+func (m *NonceManager) sync(ctx context.Context) error {
+	next, err := m.source.PendingNonceAt(ctx, m.account)
+	if err != nil {
+		return apperr.Wrap(err, codes.Internal, "blockchain: failed to fetch pending nonce")
+	}
+	m.epoch++
+	m.next = next
+	m.released = nil
+	m.synced = true
+	return nil
+}
+
+// Release ends the lease. Pass success=true once the transaction is in the
+// mempool; the nonce is then spent. Pass false when submission failed without
+// the transaction reaching the node, so the nonce is handed out again.
+// Releasing twice is a no-op.
+func (n *Nonce) Release(success bool) {
+	m := n.m
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if n.done {
+		return
+	}
+	n.done = true
+	if success || n.epoch != m.epoch || n.Value >= m.next {
+		return
+	}
+
+	if n.Value == m.next-1 {
+		// The highest nonce is free again; shrink instead of recording a gap,
+		// along with any released nonces now at the top.
+		m.next--
+		for len(m.released) > 0 && m.released[len(m.released)-1] == m.next-1 {
+			m.released = m.released[:len(m.released)-1]
+			m.next--
+		}
+		return
+	}
+	if i, found := slices.BinarySearch(m.released, n.Value); !found {
+		m.released = slices.Insert(m.released, i, n.Value)
+	}
+}
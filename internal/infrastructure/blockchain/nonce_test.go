@@ -0,0 +1,182 @@
+package blockchain_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNonceSource reports a fixed pending nonce, or err when set.
+type fakeNonceSource struct {
+	mu      sync.Mutex
+	pending uint64
+	err     error
+	calls   int
+}
+
+func (s *fakeNonceSource) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.pending, s.err
+}
+
+func (s *fakeNonceSource) set(pending uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending, s.err = pending, err
+}
+
+func acquire(t *testing.T, m *blockchain.NonceManager) *blockchain.Nonce {
+	t.Helper()
+	n, err := m.Acquire(context.Background())
+	require.NoError(t, err)
+	return n
+}
+
+func TestNonceManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	account := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	t.Run("concurrent acquisitions get distinct consecutive nonces", func(t *testing.T) {
+		t.Parallel()
+		source := &fakeNonceSource{pending: 10}
+		m := blockchain.NewNonceManager(source, account)
+		require.NoError(t, m.Reset(ctx))
+
+		const workers = 50
+		var (
+			mu  sync.Mutex
+			got []uint64
+			wg  sync.WaitGroup
+		)
+		for range workers {
+			wg.Go(func() {
+				n, err := m.Acquire(ctx)
+				if !assert.NoError(t, err) {
+					return
+				}
+				n.Release(true)
+				mu.Lock()
+				got = append(got, n.Value)
+				mu.Unlock()
+			})
+		}
+		wg.Wait()
+
+		slices.Sort(got)
+		want := make([]uint64, workers)
+		for i := range want {
+			want[i] = uint64(10 + i)
+		}
+		assert.Equal(t, want, got)
+		assert.Equal(t, 1, source.calls, "nonces must come from memory after the initial sync")
+	})
+
+	t.Run("failed transaction returns its nonce to the pool", func(t *testing.T) {
+		t.Parallel()
+		m := blockchain.NewNonceManager(&fakeNonceSource{pending: 5}, account)
+
+		n := acquire(t, m)
+		n.Release(false)
+
+		assert.Equal(t, uint64(5), acquire(t, m).Value)
+	})
+
+	t.Run("released gap is filled before new nonces", func(t *testing.T) {
+		t.Parallel()
+		m := blockchain.NewNonceManager(&fakeNonceSource{pending: 0}, account)
+
+		n0, n1, n2 := acquire(t, m), acquire(t, m), acquire(t, m)
+		n0.Release(true)
+		n2.Release(true)
+		n1.Release(false)
+
+		assert.Equal(t, uint64(1), acquire(t, m).Value)
+		assert.Equal(t, uint64(3), acquire(t, m).Value)
+	})
+
+	t.Run("releasing the top nonces shrinks the sequence", func(t *testing.T) {
+		t.Parallel()
+		m := blockchain.NewNonceManager(&fakeNonceSource{pending: 0}, account)
+
+		n0, n1, n2 := acquire(t, m), acquire(t, m), acquire(t, m)
+		n0.Release(true)
+		n1.Release(false)
+		n2.Release(false)
+
+		assert.Equal(t, uint64(1), acquire(t, m).Value)
+		assert.Equal(t, uint64(2), acquire(t, m).Value)
+		assert.Equal(t, uint64(3), acquire(t, m).Value)
+	})
+
+	t.Run("releasing twice does not hand the nonce out twice", func(t *testing.T) {
+		t.Parallel()
+		m := blockchain.NewNonceManager(&fakeNonceSource{pending: 0}, account)
+
+		n0, n1 := acquire(t, m), acquire(t, m)
+		n0.Release(false)
+		n0.Release(false)
+		n1.Release(true)
+
+		assert.Equal(t, uint64(0), acquire(t, m).Value)
+		assert.Equal(t, uint64(2), acquire(t, m).Value)
+	})
+
+	t.Run("reset resyncs and ignores nonces leased before it", func(t *testing.T) {
+		t.Parallel()
+		source := &fakeNonceSource{pending: 0}
+		m := blockchain.NewNonceManager(source, account)
+
+		stale := acquire(t, m)
+		acquire(t, m).Release(true)
+
+		// A stuck transaction was replaced; the node now expects nonce 7.
+		source.set(7, nil)
+		require.NoError(t, m.Reset(ctx))
+		stale.Release(false)
+
+		assert.Equal(t, uint64(7), acquire(t, m).Value)
+		assert.Equal(t, uint64(8), acquire(t, m).Value)
+	})
+
+	t.Run("reset after a nonce conflict skips nonces used elsewhere", func(t *testing.T) {
+		t.Parallel()
+		source := &fakeNonceSource{pending: 0}
+		m := blockchain.NewNonceManager(source, account)
+		acquire(t, m).Release(true)
+
+		// Another sender used nonces 1 and 2; the node rejects nonce 1 as too
+		// low. Releasing it unused would hand it out again.
+		source.set(3, nil)
+		stale := acquire(t, m)
+		require.Equal(t, uint64(1), stale.Value)
+		stale.Release(true)
+		require.NoError(t, m.Reset(ctx))
+
+		assert.Equal(t, uint64(3), acquire(t, m).Value)
+	})
+
+	t.Run("failed sync is retried on the next acquire", func(t *testing.T) {
+		t.Parallel()
+		source := &fakeNonceSource{err: errors.New("connection refused")}
+		m := blockchain.NewNonceManager(source, account)
+
+		assert.ErrorIs(t, m.Reset(ctx), apperr.ErrInternal)
+		_, err := m.Acquire(ctx)
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+
+		source.set(3, nil)
+		assert.Equal(t, uint64(3), acquire(t, m).Value)
+	})
+}
@@ -0,0 +1,91 @@
+package blockchain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// DecodeRevert translates the revert data carried by an RPC error into a
+// readable form such as "ERC721NonexistentToken(tokenId=42)". The 4-byte
+// selector is matched against the custom errors declared in contractABI;
+// Solidity's built-in Error(string) and Panic(uint256) are decoded as well.
+//
+// # Possible errors:
+//
+//   - InvalidArgument: the error data is missing, not hex, or too short to
+//     hold a selector.
+//   - NotFound: the selector matches no known error.
+//   - Internal: the arguments do not match the error's declared inputs.
+func DecodeRevert(contractABI *abi.ABI, dataErr rpc.DataError) (string, error) {
+	data, err := revertData(dataErr.ErrorData())
+	if err != nil {
+		return "", err
+	}
+	if len(data) < 4 {
+		return "", apperr.New(codes.InvalidArgument, fmt.Sprintf("blockchain: revert data too short for a selector (%d bytes)", len(data)))
+	}
+
+	for _, e := range contractABI.Errors {
+		if !bytes.Equal(e.ID[:4], data[:4]) {
+			continue
+		}
+		args, err := e.Inputs.Unpack(data[4:])
+		if err != nil {
+			return "", apperr.Wrap(err, codes.Internal, fmt.Sprintf("blockchain: failed to decode %s arguments", e.Name))
+		}
+		return formatRevert(e, args), nil
+	}
+
+	// Not a custom error of this contract; try require() messages and panics.
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, nil
+	}
+	return "", apperr.New(codes.NotFound, "blockchain: unknown revert selector 0x"+hex.EncodeToString(data[:4]))
+}
+
+// revertData normalizes ErrorData, which JSON-RPC transports deliver as a
+// 0x-prefixed hex string and in-process backends may deliver as raw bytes.
+func revertData(v any) ([]byte, error) {
+	switch d := v.(type) {
+	case []byte:
+		return d, nil
+	case string:
+		b, err := hexutil.Decode(d)
+		if err != nil {
+			return nil, apperr.Wrap(err, codes.InvalidArgument, "blockchain: revert data is not hex")
+		}
+		return b, nil
+	default:
+		return nil, apperr.New(codes.InvalidArgument, fmt.Sprintf("blockchain: unsupported revert data type %T", v))
+	}
+}
+
+// formatRevert renders a decoded custom error as Name(arg=value, ...).
+func formatRevert(e abi.Error, args []any) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		name := e.Inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		parts[i] = name + "=" + formatArg(arg)
+	}
+	return e.Name + "(" + strings.Join(parts, ", ") + ")"
+}
+
+// formatArg renders fixed-size byte arrays (e.g. role hashes) as hex instead
+// of Go's default array notation.
+func formatArg(v any) string {
+	if b, ok := v.([32]byte); ok {
+		return hexutil.Encode(b[:])
+	}
+	return fmt.Sprint(v)
+}
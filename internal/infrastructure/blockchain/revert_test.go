@@ -0,0 +1,113 @@
+package blockchain_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain/ticketsbt"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dataError is an rpc.DataError carrying the given revert data.
+type dataError struct{ data any }
+
+func (e dataError) Error() string  { return "execution reverted" }
+func (e dataError) ErrorData() any { return e.data }
+
+// encodeError ABI-encodes the named custom error of the TicketSBT contract.
+func encodeError(t *testing.T, parsed *abi.ABI, name string, args ...any) []byte {
+	t.Helper()
+	e, ok := parsed.Errors[name]
+	require.True(t, ok, "unknown error %s", name)
+	packed, err := e.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(e.ID.Bytes()[:4], packed...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := ticketsbt.TicketSBTMetaData.GetAbi()
+	require.NoError(t, err)
+
+	minterRole := crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+	account := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e20d17dc79C")
+
+	// Error(string), as produced by require(cond, "sold out").
+	stringRevert := append(crypto.Keccak256([]byte("Error(string)"))[:4], func() []byte {
+		strType, _ := abi.NewType("string", "", nil)
+		packed, err := abi.Arguments{{Type: strType}}.Pack("sold out")
+		require.NoError(t, err)
+		return packed
+	}()...)
+
+	tests := []struct {
+		name    string
+		data    any
+		want    string
+		wantErr error
+	}{
+		{
+			name: "ERC721NonexistentToken from hex string",
+			data: hexutil.Encode(encodeError(t, parsed, "ERC721NonexistentToken", big.NewInt(42))),
+			want: "ERC721NonexistentToken(tokenId=42)",
+		},
+		{
+			name: "AccessControlUnauthorizedAccount from raw bytes",
+			data: encodeError(t, parsed, "AccessControlUnauthorizedAccount", account, [32]byte(minterRole)),
+			want: "AccessControlUnauthorizedAccount(account=" + account.Hex() + ", neededRole=" + minterRole.Hex() + ")",
+		},
+		{
+			name: "ERC721InvalidSender for an already minted token",
+			data: hexutil.Encode(encodeError(t, parsed, "ERC721InvalidSender", common.Address{})),
+			want: "ERC721InvalidSender(sender=0x0000000000000000000000000000000000000000)",
+		},
+		{
+			name: "built-in Error(string)",
+			data: hexutil.Encode(stringRevert),
+			want: "sold out",
+		},
+		{
+			name:    "unknown selector",
+			data:    "0xdeadbeef",
+			wantErr: apperr.ErrNotFound,
+		},
+		{
+			name:    "data shorter than a selector",
+			data:    "0x7e27",
+			wantErr: apperr.ErrInvalidArgument,
+		},
+		{
+			name:    "non-hex data",
+			data:    "not hex",
+			wantErr: apperr.ErrInvalidArgument,
+		},
+		{
+			name:    "missing data",
+			data:    nil,
+			wantErr: apperr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := blockchain.DecodeRevert(parsed, dataError{data: tt.data})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
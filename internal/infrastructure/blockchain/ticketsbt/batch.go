@@ -0,0 +1,140 @@
+package ticketsbt
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// MintRequest identifies a single token to mint within a batch.
+type MintRequest struct {
+	// RecipientAddr is the hex-encoded Ethereum address that will receive the token.
+	RecipientAddr string
+	// TokenID is the ERC-721 token ID to mint (must be > 0 and unique).
+	TokenID uint64
+}
+
+// MintResult reports the outcome of one MintRequest within a batch.
+type MintResult struct {
+	// TokenID is the token ID of the corresponding MintRequest.
+	TokenID uint64
+	// TxHash is the hash of the submitted transaction. It is empty when the
+	// transaction was never submitted.
+	TxHash string
+	// Err is nil when the token was minted on-chain.
+	Err error
+}
+
+// pendingMint tracks a submitted transaction until its receipt is checked.
+type pendingMint struct {
+	index int
+	tx    *types.Transaction
+}
+
+// BatchMint mints many tokens with sequential transactions sharing a single
+// nonce sequence, then waits for every receipt. Compared to calling Mint in a
+// loop, all transactions are in the mempool at once, so a batch of N tokens
+// takes roughly one block instead of N.
+//
+// The returned slice has one MintResult per request, in request order; a
+// failed mint is reported in its MintResult and does not abort the rest of the
+// batch. Unlike Mint, individual submissions are not retried — callers should
+// resubmit the failed requests. The error is non-nil only when the batch
+// could not be started at all.
+func (c *Client) BatchMint(ctx context.Context, reqs []MintRequest) ([]MintResult, error) {
+	results := make([]MintResult, len(reqs))
+	for i, r := range reqs {
+		results[i].TokenID = r.TokenID
+	}
+	if len(reqs) == 0 {
+		return results, nil
+	}
+
+	pending, err := c.submitBatch(ctx, reqs, results)
+	if err != nil {
+		return nil, err
+	}
+
+	var timedOut bool
+	for _, p := range pending {
+		if _, err := blockchain.ConfirmTx(ctx, c.ethClient, p.tx, blockchain.WithConfirmTimeout(c.confirmTimeout)); err != nil {
+			results[p.index].Err = err
+			timedOut = timedOut || errors.Is(err, apperr.ErrDeadlineExceeded)
+		}
+	}
+	// A transaction that was never mined may have been dropped or replaced,
+	// leaving the in-memory nonces ahead of or behind the node.
+	if timedOut {
+		c.mu.Lock()
+		c.resyncNonces(ctx)
+		c.mu.Unlock()
+	}
+
+	var failed int
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			c.logger.Warn(ctx, "batch mint item failed",
+				slog.Uint64("tokenID", r.TokenID),
+				slog.String("txHash", r.TxHash),
+				slog.String("error", r.Err.Error()),
+			)
+		}
+	}
+	c.logger.Info(ctx, "batch mint completed",
+		slog.Int("requested", len(reqs)),
+		slog.Int("minted", len(reqs)-failed),
+		slog.Int("failed", failed),
+	)
+
+	return results, nil
+}
+
+// submitBatch sends one mint transaction per request and records submission
+// failures in results. The mutex is held only while transactions are
+// submitted, so nonces are leased in request order; concurrent mints submit
+// after the batch and wait for their receipts independently.
+func (c *Client) submitBatch(ctx context.Context, reqs []MintRequest, results []MintResult) ([]pendingMint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Copy the signer to set per-call context for cancellation/timeout propagation.
+	opts := *c.signer
+	opts.Context = ctx
+
+	pending := make([]pendingMint, 0, len(reqs))
+	for i, r := range reqs {
+		nonce, err := c.nonces.Acquire(ctx)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			for j := i; j < len(reqs); j++ {
+				results[j].Err = err
+			}
+			break
+		}
+
+		opts.Nonce = new(big.Int).SetUint64(nonce.Value)
+		tx, err := c.contract.Mint(&opts, common.HexToAddress(r.RecipientAddr), new(big.Int).SetUint64(r.TokenID))
+		if err == nil {
+			nonce.Release(true)
+			results[i].TxHash = tx.Hash().Hex()
+			pending = append(pending, pendingMint{index: i, tx: tx})
+			continue
+		}
+		c.releaseFailedNonce(ctx, nonce, err)
+		results[i].Err = mintRevertError(err, r.TokenID)
+		if results[i].Err == nil {
+			results[i].Err = apperr.Wrap(err, codes.Internal, "ticketsbt: failed to submit mint transaction")
+		}
+	}
+	return pending, nil
+}
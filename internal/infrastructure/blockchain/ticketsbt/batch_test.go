@@ -0,0 +1,323 @@
+package ticketsbt_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain/ticketsbt"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// simulatedChainID is the chain ID the simulated backend always uses.
+const simulatedChainID int64 = 1337
+
+// stubMintCode is the runtime bytecode of a minimal stand-in for TicketSBT.
+// Like the real contract, mint(address,uint256) reverts with
+// ERC721InvalidSender(address(0)) when the token ID is already minted and
+// otherwise records it:
+//
+//	PUSH1 0x24 CALLDATALOAD  // tokenId
+//	DUP1 SLOAD               // minted[tokenId]
+//	PUSH1 0x0d JUMPI         // already minted -> revert
+//	PUSH1 0x01 SWAP1 SSTORE  // minted[tokenId] = 1
+//	STOP
+//	JUMPDEST
+//	PUSH4 0x73c6ac6e PUSH1 0xe0 SHL PUSH1 0x00 MSTORE  // ERC721InvalidSender selector
+//	PUSH1 0x24 PUSH1 0x00 REVERT                       // selector ++ address(0)
+var stubMintCode = common.FromHex("6024358054600d5760019055005b6373c6ac6e60e01b60005260246000fd")
+
+// simBackend adapts the simulated client to ticketsbt.EthBackend. Closing is
+// owned by the simulated.Backend itself.
+type simBackend struct {
+	simulated.Client
+}
+
+func (simBackend) Close() {}
+
+// countingBackend counts the pending-nonce lookups, each of which is a
+// NonceManager sync.
+type countingBackend struct {
+	simBackend
+	nonceSyncs atomic.Int32
+}
+
+func (b *countingBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	b.nonceSyncs.Add(1)
+	return b.simBackend.PendingNonceAt(ctx, account)
+}
+
+// newSimulatedClient starts a simulated chain with the stub contract deployed
+// and the given token IDs already minted.
+func newSimulatedClient(t *testing.T, preMinted ...uint64) (*ticketsbt.Client, *simulated.Backend) {
+	t.Helper()
+
+	backend := newSimulatedChain(t, preMinted...)
+	client, err := ticketsbt.NewClientWithBackend(simBackend{backend.Client()}, mustParseKey(t), common.HexToAddress(testContractAddr), simulatedChainID, testLogger())
+	require.NoError(t, err)
+	return client, backend
+}
+
+// newSimulatedChain starts a simulated chain with the stub contract deployed
+// and the given token IDs already minted.
+func newSimulatedChain(t *testing.T, preMinted ...uint64) *simulated.Backend {
+	t.Helper()
+
+	key := mustParseKey(t)
+
+	storage := make(map[common.Hash]common.Hash, len(preMinted))
+	for _, id := range preMinted {
+		storage[common.BigToHash(new(big.Int).SetUint64(id))] = common.BigToHash(big.NewInt(1))
+	}
+	contractAddr := common.HexToAddress(testContractAddr)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.Ether)},
+		contractAddr:                          {Code: stubMintCode, Storage: storage},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	return backend
+}
+
+// sendOutOfBand mines a plain transfer from the minter account at nonce,
+// standing in for a transaction sent by another process with the same key.
+func sendOutOfBand(t *testing.T, backend *simulated.Backend, nonce uint64) {
+	t.Helper()
+
+	ctx := context.Background()
+	key := mustParseKey(t)
+	head, err := backend.Client().HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	to := crypto.PubkeyToAddress(key.PublicKey)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(simulatedChainID)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(simulatedChainID),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), big.NewInt(params.GWei)),
+		Gas:       params.TxGas,
+		To:        &to,
+	})
+	require.NoError(t, err)
+	require.NoError(t, backend.Client().SendTransaction(ctx, tx))
+	backend.Commit()
+}
+
+// whileMining runs fn while sealing blocks until it returns, since the
+// simulated chain only mines on Commit.
+func whileMining[T any](t *testing.T, backend *simulated.Backend, fn func(ctx context.Context) (T, error)) (T, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	type outcome struct {
+		value T
+		err   error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		value, err := fn(ctx)
+		done <- outcome{value, err}
+	}()
+
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case o := <-done:
+			return o.value, o.err
+		case <-ticker.C:
+			backend.Commit()
+		}
+	}
+}
+
+// batchMintMining runs BatchMint while sealing blocks until it returns.
+func batchMintMining(t *testing.T, client *ticketsbt.Client, backend *simulated.Backend, reqs []ticketsbt.MintRequest) ([]ticketsbt.MintResult, error) {
+	t.Helper()
+	return whileMining(t, backend, func(ctx context.Context) ([]ticketsbt.MintResult, error) {
+		return client.BatchMint(ctx, reqs)
+	})
+}
+
+// mintMining runs Mint while sealing blocks until it returns.
+func mintMining(t *testing.T, client *ticketsbt.Client, backend *simulated.Backend, tokenID uint64) (string, error) {
+	t.Helper()
+	return whileMining(t, backend, func(ctx context.Context) (string, error) {
+		return client.Mint(ctx, "0x70997970C51812dc3A010C7d01b50e20d17dc79C", tokenID)
+	})
+}
+
+func TestBatchMint_AllSucceed(t *testing.T) {
+	t.Parallel()
+
+	client, backend := newSimulatedClient(t)
+	recipient := "0x70997970C51812dc3A010C7d01b50e20d17dc79C"
+
+	results, err := batchMintMining(t, client, backend, []ticketsbt.MintRequest{
+		{RecipientAddr: recipient, TokenID: 1},
+		{RecipientAddr: recipient, TokenID: 2},
+		{RecipientAddr: recipient, TokenID: 3},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	seen := make(map[string]bool)
+	for i, r := range results {
+		assert.Equal(t, uint64(i+1), r.TokenID)
+		assert.NoError(t, r.Err)
+		assert.NotEmpty(t, r.TxHash)
+		assert.False(t, seen[r.TxHash], "each mint must be its own transaction")
+		seen[r.TxHash] = true
+	}
+}
+
+func TestBatchMint_PartialFailure(t *testing.T) {
+	t.Parallel()
+
+	recipient := "0x70997970C51812dc3A010C7d01b50e20d17dc79C"
+
+	t.Run("reports a mint rejected before submission", func(t *testing.T) {
+		t.Parallel()
+		client, backend := newSimulatedClient(t, 2)
+
+		results, err := batchMintMining(t, client, backend, []ticketsbt.MintRequest{
+			{RecipientAddr: recipient, TokenID: 1},
+			{RecipientAddr: recipient, TokenID: 2},
+			{RecipientAddr: recipient, TokenID: 3},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.NoError(t, results[0].Err)
+		assert.ErrorIs(t, results[1].Err, apperr.ErrAlreadyExists)
+		assert.Empty(t, results[1].TxHash, "rejected mint must not be submitted")
+		// The nonce skipped by the rejected mint is reused, so the following
+		// mint is not stuck behind a gap.
+		assert.NoError(t, results[2].Err)
+	})
+
+	t.Run("reports a mint reverted on-chain", func(t *testing.T) {
+		t.Parallel()
+		client, backend := newSimulatedClient(t)
+
+		// Both transactions pass gas estimation against the latest block, but
+		// the second one reverts once the first has been mined.
+		results, err := batchMintMining(t, client, backend, []ticketsbt.MintRequest{
+			{RecipientAddr: recipient, TokenID: 5},
+			{RecipientAddr: recipient, TokenID: 5},
+			{RecipientAddr: recipient, TokenID: 6},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.NoError(t, results[0].Err)
+		assert.ErrorContains(t, results[1].Err, "reverted on-chain")
+		assert.NotEmpty(t, results[1].TxHash)
+		assert.NoError(t, results[2].Err)
+	})
+}
+
+func TestBatchMint_NonceConflict(t *testing.T) {
+	t.Parallel()
+
+	client, backend := newSimulatedClient(t)
+	recipient := "0x70997970C51812dc3A010C7d01b50e20d17dc79C"
+	_, err := mintMining(t, client, backend, 1)
+	require.NoError(t, err)
+	// Another sender takes nonce 1 behind the client's back.
+	sendOutOfBand(t, backend, 1)
+
+	results, err := batchMintMining(t, client, backend, []ticketsbt.MintRequest{
+		{RecipientAddr: recipient, TokenID: 2},
+		{RecipientAddr: recipient, TokenID: 3},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.ErrorContains(t, results[0].Err, "nonce too low")
+	// The stale nonce is not handed to the next request: the nonces are
+	// resynced from the node.
+	assert.NoError(t, results[1].Err)
+}
+
+func TestBatchMint_ConfirmTimeoutResyncsNonces(t *testing.T) {
+	t.Parallel()
+
+	backend := &countingBackend{simBackend: simBackend{newSimulatedChain(t).Client()}}
+	client, err := ticketsbt.NewClientWithBackend(backend, mustParseKey(t), common.HexToAddress(testContractAddr),
+		simulatedChainID, testLogger(), ticketsbt.WithConfirmTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+
+	// Nothing is mined, so no receipt arrives.
+	results, err := client.BatchMint(context.Background(), []ticketsbt.MintRequest{
+		{RecipientAddr: "0x70997970C51812dc3A010C7d01b50e20d17dc79C", TokenID: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	assert.ErrorIs(t, results[0].Err, apperr.ErrDeadlineExceeded)
+	assert.Equal(t, int32(2), backend.nonceSyncs.Load(), "the first lease syncs, the timeout resyncs")
+}
+
+func TestBatchMint_Empty(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newSimulatedClient(t)
+
+	results, err := client.BatchMint(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestMint_DuplicateTokenID(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newSimulatedClient(t, 9)
+
+	_, err := client.Mint(context.Background(), "0x70997970C51812dc3A010C7d01b50e20d17dc79C", 9)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, apperr.ErrAlreadyExists)
+	assert.ErrorContains(t, err, "ERC721InvalidSender(sender=0x0000000000000000000000000000000000000000)")
+}
+
+func TestMint_NonceConflict(t *testing.T) {
+	t.Parallel()
+
+	client, backend := newSimulatedClient(t)
+	_, err := mintMining(t, client, backend, 1)
+	require.NoError(t, err)
+	// Another sender takes nonce 1 behind the client's back.
+	sendOutOfBand(t, backend, 1)
+
+	_, err = mintMining(t, client, backend, 2)
+	require.ErrorContains(t, err, "nonce too low")
+
+	// The next mint signs with the node's nonce instead of the stale one.
+	_, err = mintMining(t, client, backend, 2)
+	assert.NoError(t, err)
+}
+
+func TestMint_ConfirmTimeoutResyncsNonces(t *testing.T) {
+	t.Parallel()
+
+	backend := &countingBackend{simBackend: simBackend{newSimulatedChain(t).Client()}}
+	client, err := ticketsbt.NewClientWithBackend(backend, mustParseKey(t), common.HexToAddress(testContractAddr),
+		simulatedChainID, testLogger(), ticketsbt.WithConfirmTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+
+	// Nothing is mined, so no receipt arrives.
+	_, err = client.Mint(context.Background(), "0x70997970C51812dc3A010C7d01b50e20d17dc79C", 1)
+
+	assert.ErrorIs(t, err, apperr.ErrDeadlineExceeded)
+	assert.Equal(t, int32(2), backend.nonceSyncs.Load(), "the first lease syncs, the timeout resyncs")
+}
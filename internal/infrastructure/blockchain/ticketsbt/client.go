@@ -21,6 +21,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/pannpers/go-apperr/apperr/codes"
 	"github.com/pannpers/go-logging/logging"
@@ -31,6 +32,9 @@ import (
 // Used to distinguish "token does not exist" reverts from other RPC failures.
 const erc721NonexistentTokenSelector = "7e273289"
 
+// ticketSBTABI parses the TicketSBT ABI once, for decoding revert reasons.
+var ticketSBTABI = sync.OnceValues(TicketSBTMetaData.GetAbi)
+
 // Compile-time check that Client implements entity.TicketMinter.
 var _ entity.TicketMinter = (*Client)(nil)
 
@@ -41,15 +45,40 @@ const (
 	retryBaseDelay = 500 * time.Millisecond
 )
 
+// ethBackend is the subset of the JSON-RPC client used by Client. It is
+// satisfied by *ethclient.Client and, in tests, by a simulated backend.
+type ethBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	Close()
+}
+
 // Client wraps the TicketSBT contract caller and transactor.
 type Client struct {
+	// mu serialises transaction submission so that a nonce resync never
+	// races a leased but not yet submitted nonce. It is not held while
+	// waiting for receipts.
 	mu          sync.Mutex
-	ethClient   *ethclient.Client
+	ethClient   ethBackend
+	nonces      *blockchain.NonceManager
 	contract    *TicketSBT
 	signer      *bind.TransactOpts
 	privateKey  *ecdsa.PrivateKey
 	fromAddress common.Address
-	logger      *logging.Logger
+	// confirmTimeout bounds the wait for each transaction receipt.
+	confirmTimeout time.Duration
+	logger         *logging.Logger
+}
+
+// ClientOption configures optional Client settings.
+type ClientOption func(*Client)
+
+// WithConfirmTimeout sets how long write calls wait for their transaction
+// receipt. Defaults to blockchain.DefaultConfirmTimeout.
+func WithConfirmTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.confirmTimeout = d
+	}
 }
 
 // NewClient creates a new TicketSBT contract client.
@@ -58,7 +87,7 @@ type Client struct {
 // privateKeyHex is the hex-encoded EOA private key that holds MINTER_ROLE.
 // contractAddr is the deployed TicketSBT contract address.
 // chainID is the EIP-155 chain ID used for transaction signing (e.g., 84532 for Base Sepolia).
-func NewClient(ctx context.Context, rpcURL, privateKeyHex, contractAddr string, chainID int64, logger *logging.Logger) (*Client, error) {
+func NewClient(ctx context.Context, rpcURL, privateKeyHex, contractAddr string, chainID int64, logger *logging.Logger, opts ...ClientOption) (*Client, error) {
 	if rpcURL == "" || privateKeyHex == "" || contractAddr == "" {
 		return nil, apperr.New(codes.InvalidArgument, "ticketsbt: rpcURL, privateKeyHex, and contractAddr are required")
 	}
@@ -95,14 +124,28 @@ func NewClient(ctx context.Context, rpcURL, privateKeyHex, contractAddr string,
 		slog.Int64("chainID", chainID),
 	)
 
-	return &Client{
-		ethClient:   ethClient,
-		contract:    contract,
-		signer:      signer,
-		privateKey:  privateKey,
-		fromAddress: fromAddress,
-		logger:      l,
-	}, nil
+	c := &Client{
+		ethClient:      ethClient,
+		nonces:         blockchain.NewNonceManager(ethClient, fromAddress),
+		contract:       contract,
+		signer:         signer,
+		privateKey:     privateKey,
+		fromAddress:    fromAddress,
+		confirmTimeout: blockchain.DefaultConfirmTimeout,
+		logger:         l,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	// Reconcile with the node's pending nonce up front. A failure is not
+	// fatal: the first mint retries the sync.
+	if err := c.nonces.Reset(ctx); err != nil {
+		l.Warn(ctx, "failed to fetch initial nonce, deferring to first mint",
+			slog.String("error", err.Error()),
+		)
+	}
+	return c, nil
 }
 
 // Close releases the underlying RPC connection. Implements io.Closer.
@@ -133,24 +176,38 @@ func isTransientError(err error) bool {
 	return true
 }
 
+// isNonceConflict reports whether err shows the node already holds a
+// transaction at the submitted nonce: the nonce is spent or taken, so handing
+// it out again would fail the same way.
+func isNonceConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, s := range []string{
+		"nonce too low",
+		"already known",
+		"known transaction",
+		"replacement transaction underpriced",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
 // Mint submits a mint transaction to the TicketSBT contract and waits for on-chain
-// confirmation. It retries up to maxRetries times with exponential backoff on transient
-// RPC errors. Permanent errors (execution reverts, insufficient funds, etc.) are
-// returned immediately without retrying.
+// confirmation via blockchain.ConfirmTx. Submission is retried up to maxRetries times
+// with exponential backoff on transient RPC errors. Permanent errors (execution reverts,
+// insufficient funds, etc.) and confirmation failures are returned without retrying.
 //
 // recipientAddr is the hex-encoded Ethereum address that will receive the soulbound token.
 // tokenID is the ERC-721 token ID to mint (must be > 0 and unique).
 func (c *Client) Mint(ctx context.Context, recipientAddr string, tokenID uint64) (string, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
 	recipient := common.HexToAddress(recipientAddr)
 	tokenIDBig := new(big.Int).SetUint64(tokenID)
 
-	// Copy the signer to set per-call context for cancellation/timeout propagation.
-	opts := *c.signer
-	opts.Context = ctx
-
 	var lastErr error
 	for attempt := range maxRetries {
 		if attempt > 0 {
@@ -168,19 +225,11 @@ func (c *Client) Mint(ctx context.Context, recipientAddr string, tokenID uint64)
 			}
 		}
 
-		// Fetch a fresh nonce on each attempt to avoid stale nonce after retries.
-		nonce, err := c.ethClient.PendingNonceAt(ctx, c.fromAddress)
+		tx, err := c.submitMint(ctx, recipient, tokenIDBig)
 		if err != nil {
-			if !isTransientError(err) {
-				return "", apperr.Wrap(err, codes.Internal, "ticketsbt: permanent error fetching nonce")
+			if revertErr := mintRevertError(err, tokenID); revertErr != nil {
+				return "", revertErr
 			}
-			lastErr = apperr.Wrap(err, codes.Internal, "ticketsbt: failed to fetch pending nonce")
-			continue
-		}
-		opts.Nonce = new(big.Int).SetUint64(nonce)
-
-		tx, err := c.contract.Mint(&opts, recipient, tokenIDBig)
-		if err != nil {
 			if !isTransientError(err) {
 				return "", apperr.Wrap(err, codes.Internal, "ticketsbt: permanent mint error")
 			}
@@ -189,16 +238,15 @@ func (c *Client) Mint(ctx context.Context, recipientAddr string, tokenID uint64)
 		}
 
 		// Wait for the transaction to be mined and verify on-chain success.
-		receipt, err := bind.WaitMined(ctx, c.ethClient, tx)
-		if err != nil {
-			if !isTransientError(err) {
-				return "", apperr.Wrap(err, codes.Internal, "ticketsbt: permanent error waiting for receipt")
+		// Not retried: the transaction is already in the mempool, so a
+		// resubmission would only race it for the same token ID.
+		if _, err := blockchain.ConfirmTx(ctx, c.ethClient, tx, blockchain.WithConfirmTimeout(c.confirmTimeout)); err != nil {
+			if errors.Is(err, apperr.ErrDeadlineExceeded) {
+				c.mu.Lock()
+				c.resyncNonces(ctx)
+				c.mu.Unlock()
 			}
-			lastErr = err
-			continue
-		}
-		if receipt.Status != types.ReceiptStatusSuccessful {
-			return "", apperr.New(codes.Internal, fmt.Sprintf("ticketsbt: mint transaction reverted on-chain (tx=%s)", tx.Hash().Hex()))
+			return "", err
 		}
 
 		txHash := tx.Hash().Hex()
@@ -218,6 +266,58 @@ func (c *Client) Mint(ctx context.Context, recipientAddr string, tokenID uint64)
 	return "", apperr.Wrap(lastErr, codes.Internal, fmt.Sprintf("ticketsbt: mint failed after %d attempts", maxRetries))
 }
 
+// submitMint sends one mint transaction signed with a nonce leased from
+// c.nonces. A failed submission gives the nonce back through
+// releaseFailedNonce.
+func (c *Client) submitMint(ctx context.Context, recipient common.Address, tokenID *big.Int) (*types.Transaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nonce, err := c.nonces.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the signer to set per-call context for cancellation/timeout propagation.
+	opts := *c.signer
+	opts.Context = ctx
+	opts.Nonce = new(big.Int).SetUint64(nonce.Value)
+
+	tx, err := c.contract.Mint(&opts, recipient, tokenID)
+	if err != nil {
+		c.releaseFailedNonce(ctx, nonce, err)
+		return nil, err
+	}
+	nonce.Release(true)
+	return tx, nil
+}
+
+// releaseFailedNonce ends the lease of a nonce whose transaction failed to
+// submit. A revert during gas estimation leaves the nonce unused, so it is
+// handed out again. A nonce conflict means the node has moved past the
+// in-memory view, and a transient RPC error may hide a transaction that did
+// reach the mempool; either way the nonces are resynced from the node rather
+// than guessed. The caller must hold mu.
+func (c *Client) releaseFailedNonce(ctx context.Context, nonce *blockchain.Nonce, err error) {
+	if !isNonceConflict(err) && !isTransientError(err) {
+		nonce.Release(false)
+		return
+	}
+	// The resync supersedes the lease, so the stale nonce is never handed
+	// out again.
+	nonce.Release(true)
+	c.resyncNonces(ctx)
+}
+
+// resyncNonces re-reads the pending nonce after a submission whose outcome is
+// unknown or whose nonce the node rejected. A failure is only logged: the next Acquire retries the sync. The
+// caller must hold mu.
+func (c *Client) resyncNonces(ctx context.Context) {
+	if err := c.nonces.Reset(ctx); err != nil {
+		c.logger.Warn(ctx, "failed to resync nonce", slog.String("error", err.Error()))
+	}
+}
+
 // OwnerOf returns the owner address of the given tokenID as a lowercase hex string.
 // Returns an error if the token does not exist or the RPC call fails.
 func (c *Client) OwnerOf(ctx context.Context, tokenID uint64) (string, error) {
@@ -280,6 +380,32 @@ func (c *Client) IsTokenMinted(ctx context.Context, tokenID uint64) (bool, error
 	return true, nil
 }
 
+// mintRevertError translates a reverted mint into an apperr naming the contract
+// error, e.g. "ERC721InvalidSender(sender=0x0000…)". It returns nil when err
+// carries no decodable revert data.
+func mintRevertError(err error, tokenID uint64) error {
+	dataErr, ok := errors.AsType[rpc.DataError](err)
+	if !ok {
+		return nil
+	}
+	parsed, abiErr := ticketSBTABI()
+	if abiErr != nil {
+		return nil
+	}
+	reason, decodeErr := blockchain.DecodeRevert(parsed, dataErr)
+	if decodeErr != nil {
+		return nil
+	}
+
+	code := codes.Internal
+	if name, _, _ := strings.Cut(reason, "("); name == "ERC721InvalidSender" {
+		// OpenZeppelin's _mint reverts with ERC721InvalidSender(address(0))
+		// when the token ID is already taken.
+		code = codes.AlreadyExists
+	}
+	return apperr.Wrap(err, code, fmt.Sprintf("ticketsbt: mint of token %d reverted: %s", tokenID, reason))
+}
+
 // isERC721NonexistentTokenError checks whether err is an ERC721NonexistentToken revert.
 // It first attempts to extract the 4-byte ABI error selector from the RPC error data
 // (via rpc.DataError interface), falling back to string matching as a last resort.
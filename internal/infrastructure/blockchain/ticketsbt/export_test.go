@@ -0,0 +1,43 @@
+package ticketsbt
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/liverty-music/backend/internal/infrastructure/blockchain"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// EthBackend exposes ethBackend so tests can supply a simulated chain.
+type EthBackend = ethBackend
+
+// NewClientWithBackend builds a Client on top of an already connected backend,
+// bypassing the RPC dial performed by NewClient.
+func NewClientWithBackend(backend EthBackend, privateKey *ecdsa.PrivateKey, contractAddr common.Address, chainID int64, logger *logging.Logger, opts ...ClientOption) (*Client, error) {
+	contract, err := NewTicketSBT(contractAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(chainID))
+	if err != nil {
+		return nil, err
+	}
+	fromAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+	c := &Client{
+		ethClient:      backend,
+		nonces:         blockchain.NewNonceManager(backend, fromAddress),
+		contract:       contract,
+		signer:         signer,
+		privateKey:     privateKey,
+		fromAddress:    fromAddress,
+		confirmTimeout: blockchain.DefaultConfirmTimeout,
+		logger:         logger,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
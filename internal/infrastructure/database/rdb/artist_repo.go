@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"slices"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/pannpers/go-apperr/apperr/codes"
@@ -35,6 +37,13 @@ const (
 		SELECT id, name, mbid, fanart, fanart_synced_at
 		FROM artists
 	`
+	listArtistsPageQuery = `
+		SELECT id, name, mbid, fanart, fanart_synced_at
+		FROM artists
+		WHERE $2::uuid IS NULL OR id > $2::uuid
+		ORDER BY id
+		LIMIT $1
+	`
 	getArtistQuery = `
 		SELECT id, name, mbid, fanart, fanart_synced_at
 		FROM artists
@@ -59,6 +68,23 @@ const (
 		SELECT id, artist_id, url
 		FROM artist_official_site
 		WHERE artist_id = $1
+		ORDER BY id
+		LIMIT 1
+	`
+	listOfficialSitesQuery = `
+		SELECT id, artist_id, url
+		FROM artist_official_site
+		WHERE artist_id = $1
+		ORDER BY id
+	`
+	listArtistsWithoutOfficialSiteQuery = `
+		SELECT a.id, a.name, a.mbid, a.fanart, a.fanart_synced_at
+		FROM artists a
+		WHERE NOT EXISTS (
+			SELECT 1 FROM artist_official_site s WHERE s.artist_id = a.id
+		)
+		ORDER BY random()
+		LIMIT $1
 	`
 	insertOfficialSiteQuery = `
 		INSERT INTO artist_official_site (id, artist_id, url)
@@ -98,67 +124,45 @@ func scanArtist(scan func(dest ...any) error) (*entity.Artist, error) {
 
 // Create persists one or more artists using unnest bulk upsert.
 // All artists must have a non-empty MBID; artists with matching MBIDs are
-// deduplicated. Returns all artists with valid database IDs.
+// deduplicated. Large batches are written in chunks of createChunkSize within
+// one transaction. Returns all artists with valid database IDs, in input order.
 func (r *ArtistRepository) Create(ctx context.Context, artists ...*entity.Artist) ([]*entity.Artist, error) {
 	if len(artists) == 0 {
 		return []*entity.Artist{}, nil
 	}
 
-	var ids, names, mbids []string
-	// inputIdx[i] = original index of the i-th artist in the input slice.
-	var inputIdx []int
-
-	for origIdx, a := range artists {
-		if a == nil {
-			continue
-		}
+	rows := compactRows(artists)
+	for _, a := range rows {
 		if a.MBID == "" {
 			return nil, apperr.New(codes.InvalidArgument, "all artists must have a non-empty MBID")
 		}
 		if a.ID == "" {
 			a.ID = entity.NewArtist(a.Name, a.MBID).ID
 		}
-		ids = append(ids, a.ID)
-		names = append(names, a.Name)
-		mbids = append(mbids, a.MBID)
-		inputIdx = append(inputIdx, origIdx)
 	}
-
-	if len(ids) > 0 {
-		if _, err := r.db.Pool.Exec(ctx, insertArtistsWithMBIDUnnestQuery, ids, names, mbids); err != nil {
-			return nil, toAppErr(err, "failed to bulk insert artists with MBID", slog.Int("count", len(ids)))
-		}
+	if len(rows) == 0 {
+		return []*entity.Artist{}, nil
 	}
 
-	// Fetch back all persisted artists (both new and pre-existing) by MBID,
-	// preserving the input order via WITH ORDINALITY.
-	resultByOrigIdx := make(map[int]*entity.Artist)
-
-	rows, err := r.db.Pool.Query(ctx, selectArtistsByMBIDsQuery, mbids)
+	tx, err := r.db.Pool.Begin(ctx)
 	if err != nil {
-		return nil, toAppErr(err, "failed to select artists by mbids")
+		return nil, toAppErr(err, "failed to begin transaction")
 	}
-	defer rows.Close()
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	i := 0
-	for rows.Next() {
-		a, err := scanArtist(rows.Scan)
+	// Each chunk is inserted and then read back before the next one, so
+	// appending the per-chunk results keeps the overall input order.
+	result := make([]*entity.Artist, 0, len(rows))
+	for chunk := range slices.Chunk(rows, createChunkSize) {
+		artists, err := insertArtistChunk(ctx, tx, chunk)
 		if err != nil {
-			return nil, toAppErr(err, "failed to scan artist")
+			return nil, err
 		}
-		resultByOrigIdx[inputIdx[i]] = a
-		i++
-	}
-	if err := rows.Err(); err != nil {
-		return nil, toAppErr(err, "error iterating artist rows by mbids")
+		result = append(result, artists...)
 	}
 
-	// Reassemble in original input order, skipping nil entries.
-	result := make([]*entity.Artist, 0, len(resultByOrigIdx))
-	for origIdx := range artists {
-		if a, ok := resultByOrigIdx[origIdx]; ok {
-			result = append(result, a)
-		}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, toAppErr(err, "failed to commit transaction")
 	}
 
 	r.db.logger.Info(ctx, "artists created",
@@ -169,6 +173,39 @@ func (r *ArtistRepository) Create(ctx context.Context, artists ...*entity.Artist
 	return result, nil
 }
 
+// insertArtistChunk inserts one chunk of Create's artists and fetches back
+// the persisted row for every MBID in the chunk, new or pre-existing.
+// selectArtistsByMBIDsQuery preserves the input order via WITH ORDINALITY,
+// so the result lines up with the chunk.
+func insertArtistChunk(ctx context.Context, tx pgx.Tx, chunk []*entity.Artist) ([]*entity.Artist, error) {
+	ids := unnestColumn(chunk, func(a *entity.Artist) string { return a.ID })
+	names := unnestColumn(chunk, func(a *entity.Artist) string { return a.Name })
+	mbids := unnestColumn(chunk, func(a *entity.Artist) string { return a.MBID })
+
+	if _, err := tx.Exec(ctx, insertArtistsWithMBIDUnnestQuery, ids, names, mbids); err != nil {
+		return nil, toAppErr(err, "failed to bulk insert artists with MBID", slog.Int("count", len(ids)))
+	}
+
+	dbRows, err := tx.Query(ctx, selectArtistsByMBIDsQuery, mbids)
+	if err != nil {
+		return nil, toAppErr(err, "failed to select artists by mbids")
+	}
+	defer dbRows.Close()
+
+	artists := make([]*entity.Artist, 0, len(chunk))
+	for dbRows.Next() {
+		a, err := scanArtist(dbRows.Scan)
+		if err != nil {
+			return nil, toAppErr(err, "failed to scan artist")
+		}
+		artists = append(artists, a)
+	}
+	if err := dbRows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating artist rows by mbids")
+	}
+	return artists, nil
+}
+
 // ListByMBIDs retrieves artists matching the provided MusicBrainz IDs.
 // The result order matches the input mbids order. Unknown MBIDs are silently skipped.
 func (r *ArtistRepository) ListByMBIDs(ctx context.Context, mbids []string) ([]*entity.Artist, error) {
@@ -218,6 +255,39 @@ func (r *ArtistRepository) List(ctx context.Context) ([]*entity.Artist, error) {
 	return artists, nil
 }
 
+// ListPage retrieves one keyset-paginated page of artists ordered by ID.
+func (r *ArtistRepository) ListPage(ctx context.Context, limit int, cursor string) ([]*entity.Artist, string, error) {
+	if limit <= 0 {
+		return nil, "", apperr.New(codes.InvalidArgument, "page limit must be positive")
+	}
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	rows, err := r.db.Pool.Query(ctx, listArtistsPageQuery, limit+1, after)
+	if err != nil {
+		return nil, "", toAppErr(err, "failed to list artists page")
+	}
+	defer rows.Close()
+
+	var artists []*entity.Artist
+	for rows.Next() {
+		a, err := scanArtist(rows.Scan)
+		if err != nil {
+			return nil, "", toAppErr(err, "failed to scan artist")
+		}
+		artists = append(artists, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", toAppErr(err, "error iterating artist rows")
+	}
+
+	artists, next := trimPage(artists, limit, func(a *entity.Artist) string { return a.ID })
+	return artists, next, nil
+}
+
 // Get retrieves an artist by ID.
 func (r *ArtistRepository) Get(ctx context.Context, id string) (*entity.Artist, error) {
 	row := r.db.Pool.QueryRow(ctx, getArtistQuery, id)
@@ -270,7 +340,8 @@ func (r *ArtistRepository) CreateOfficialSite(ctx context.Context, site *entity.
 	return nil
 }
 
-// GetOfficialSite retrieves the official site for an artist.
+// GetOfficialSite retrieves the primary (earliest registered) official site
+// for an artist.
 func (r *ArtistRepository) GetOfficialSite(ctx context.Context, artistID string) (*entity.OfficialSite, error) {
 	var s entity.OfficialSite
 	err := r.db.Pool.QueryRow(ctx, getOfficialSiteQuery, artistID).Scan(
@@ -282,6 +353,52 @@ func (r *ArtistRepository) GetOfficialSite(ctx context.Context, artistID string)
 	return &s, nil
 }
 
+// ListOfficialSites retrieves every official site for an artist in
+// registration order, primary site first.
+func (r *ArtistRepository) ListOfficialSites(ctx context.Context, artistID string) ([]*entity.OfficialSite, error) {
+	rows, err := r.db.Pool.Query(ctx, listOfficialSitesQuery, artistID)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list official sites", slog.String("artist_id", artistID))
+	}
+	defer rows.Close()
+
+	var sites []*entity.OfficialSite
+	for rows.Next() {
+		var s entity.OfficialSite
+		if err := rows.Scan(&s.ID, &s.ArtistID, &s.URL); err != nil {
+			return nil, toAppErr(err, "failed to scan official site")
+		}
+		sites = append(sites, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating official site rows")
+	}
+	return sites, nil
+}
+
+// ListArtistsWithoutOfficialSite returns up to limit artists with no
+// official site row, in random order.
+func (r *ArtistRepository) ListArtistsWithoutOfficialSite(ctx context.Context, limit int) ([]*entity.Artist, error) {
+	rows, err := r.db.Pool.Query(ctx, listArtistsWithoutOfficialSiteQuery, limit)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list artists without official site")
+	}
+	defer rows.Close()
+
+	var artists []*entity.Artist
+	for rows.Next() {
+		a, err := scanArtist(rows.Scan)
+		if err != nil {
+			return nil, toAppErr(err, "failed to scan artist")
+		}
+		artists = append(artists, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating artists without official site")
+	}
+	return artists, nil
+}
+
 // UpdateFanart replaces the cached fanart.tv data for an artist.
 func (r *ArtistRepository) UpdateFanart(ctx context.Context, id string, fanart *entity.Fanart, syncTime time.Time) error {
 	var fanartJSON []byte
@@ -2,6 +2,7 @@ package rdb_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -104,6 +105,43 @@ func TestArtistRepository_Create(t *testing.T) {
 	}
 }
 
+func TestArtistRepository_Create_Chunked(t *testing.T) {
+	repo := rdb.NewArtistRepository(testDB)
+	ctx := context.Background()
+
+	cleanDatabase(t)
+
+	existing, err := repo.Create(ctx, entity.NewArtist("Original Name", newTestID(t)))
+	require.NoError(t, err)
+
+	// The last two rows land in the second chunk: one repeats the MBID of a
+	// pre-existing artist, the other repeats the MBID of the first row of
+	// the first chunk.
+	size := rdb.CreateChunkSize + 2
+	batch := make([]*entity.Artist, size)
+	for i := range size - 2 {
+		batch[i] = entity.NewArtist(fmt.Sprintf("Artist %d", i), newTestID(t))
+	}
+	batch[size-2] = entity.NewArtist("Renamed", existing[0].MBID)
+	batch[size-1] = entity.NewArtist("Duplicate", batch[0].MBID)
+
+	got, err := repo.Create(ctx, batch...)
+
+	require.NoError(t, err)
+	require.Len(t, got, size)
+	for i, a := range batch {
+		assert.Equal(t, a.MBID, got[i].MBID, "row %d out of input order", i)
+	}
+	assert.Equal(t, existing[0].ID, got[size-2].ID)
+	assert.Equal(t, "Original Name", got[size-2].Name)
+	assert.Equal(t, got[0].ID, got[size-1].ID)
+	assert.Equal(t, "Artist 0", got[size-1].Name)
+
+	var n int
+	require.NoError(t, testDB.Pool.QueryRow(ctx, `SELECT count(*) FROM artists`).Scan(&n))
+	assert.Equal(t, size-1, n, "deduplicated rows must not be inserted twice")
+}
+
 func TestArtistRepository_ListByMBIDs(t *testing.T) {
 	repo := rdb.NewArtistRepository(testDB)
 	ctx := context.Background()
@@ -541,6 +579,52 @@ func TestArtistRepository_List(t *testing.T) {
 	}
 }
 
+func TestArtistRepository_ListPage(t *testing.T) {
+	repo := rdb.NewArtistRepository(testDB)
+	ctx := context.Background()
+
+	createArtist := func(t *testing.T, name string) string {
+		t.Helper()
+		created, err := repo.Create(ctx, entity.NewArtist(name, newTestID(t)))
+		require.NoError(t, err)
+		require.Len(t, created, 1)
+		return created[0].ID
+	}
+
+	t.Run("walks every page via cursor while artists are inserted concurrently", func(t *testing.T) {
+		cleanDatabase(t)
+		want := []string{
+			createArtist(t, "Page Artist A"),
+			createArtist(t, "Page Artist B"),
+			createArtist(t, "Page Artist C"),
+		}
+
+		var got []string
+		cursor := ""
+		for page := 0; ; page++ {
+			artists, next, err := repo.ListPage(ctx, 2, cursor)
+			require.NoError(t, err)
+			for _, a := range artists {
+				got = append(got, a.ID)
+			}
+			if page == 0 {
+				want = append(want, createArtist(t, "Page Artist D"))
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		assert.Equal(t, want, got, "every artist exactly once, in ID order")
+	})
+
+	t.Run("rejects a malformed cursor", func(t *testing.T) {
+		_, _, err := repo.ListPage(ctx, 10, "%%%")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
 func TestArtistRepository_CreateOfficialSite(t *testing.T) {
 	repo := rdb.NewArtistRepository(testDB)
 	ctx := context.Background()
@@ -566,16 +650,29 @@ func TestArtistRepository_CreateOfficialSite(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "returns AlreadyExists when creating second site for same artist",
+			name: "creates second site with a different URL for same artist",
 			setup: func() string {
 				cleanDatabase(t)
-				created, err := repo.Create(ctx, entity.NewArtist("Site Artist Dup", "dd000000-0000-0000-0000-00000site002"))
+				created, err := repo.Create(ctx, entity.NewArtist("Site Artist Multi", "dd000000-0000-0000-0000-00000site002"))
 				require.NoError(t, err)
 				artistID := created[0].ID
 				err = repo.CreateOfficialSite(ctx, entity.NewOfficialSite(artistID, "https://first.example.com"))
 				require.NoError(t, err)
 				return artistID
 			},
+			wantErr: nil,
+		},
+		{
+			name: "returns AlreadyExists when creating same URL twice for same artist",
+			setup: func() string {
+				cleanDatabase(t)
+				created, err := repo.Create(ctx, entity.NewArtist("Site Artist Dup", "dd000000-0000-0000-0000-00000site003"))
+				require.NoError(t, err)
+				artistID := created[0].ID
+				err = repo.CreateOfficialSite(ctx, entity.NewOfficialSite(artistID, "https://example.com"))
+				require.NoError(t, err)
+				return artistID
+			},
 			wantErr: apperr.ErrAlreadyExists,
 		},
 	}
@@ -626,6 +723,22 @@ func TestArtistRepository_GetOfficialSite(t *testing.T) {
 			wantURL: "https://getsite.example.com",
 			wantErr: nil,
 		},
+		{
+			name: "returns the earliest site when several exist",
+			setup: func() string {
+				cleanDatabase(t)
+				created, err := repo.Create(ctx, entity.NewArtist("Multi Site Artist", "ee000000-0000-0000-0000-0000getsite3"))
+				require.NoError(t, err)
+				artistID := created[0].ID
+				err = repo.CreateOfficialSite(ctx, entity.NewOfficialSite(artistID, "https://primary.example.com"))
+				require.NoError(t, err)
+				err = repo.CreateOfficialSite(ctx, entity.NewOfficialSite(artistID, "https://tour.example.com"))
+				require.NoError(t, err)
+				return artistID
+			},
+			wantURL: "https://primary.example.com",
+			wantErr: nil,
+		},
 		{
 			name: "returns NotFound when artist has no official site",
 			setup: func() string {
@@ -657,3 +770,94 @@ func TestArtistRepository_GetOfficialSite(t *testing.T) {
 		})
 	}
 }
+
+func TestArtistRepository_ListOfficialSites(t *testing.T) {
+	repo := rdb.NewArtistRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		urls     []string // created in order
+		wantURLs []string
+	}{
+		{
+			name:     "returns empty when artist has no official site",
+			urls:     nil,
+			wantURLs: nil,
+		},
+		{
+			name:     "returns the only site",
+			urls:     []string{"https://example.com"},
+			wantURLs: []string{"https://example.com"},
+		},
+		{
+			name:     "returns every site in registration order",
+			urls:     []string{"https://example.com", "https://tour.example.com", "https://label.example.jp/artist"},
+			wantURLs: []string{"https://example.com", "https://tour.example.com", "https://label.example.jp/artist"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanDatabase(t)
+			created, err := repo.Create(ctx, entity.NewArtist("List Site Artist", fmt.Sprintf("ff000000-0000-0000-0000-0000listsit%d", i)))
+			require.NoError(t, err)
+			artistID := created[0].ID
+			for _, u := range tt.urls {
+				require.NoError(t, repo.CreateOfficialSite(ctx, entity.NewOfficialSite(artistID, u)))
+			}
+
+			got, err := repo.ListOfficialSites(ctx, artistID)
+
+			require.NoError(t, err)
+			var gotURLs []string
+			for _, site := range got {
+				assert.Equal(t, artistID, site.ArtistID)
+				assert.NotEmpty(t, site.ID)
+				gotURLs = append(gotURLs, site.URL)
+			}
+			assert.Equal(t, tt.wantURLs, gotURLs)
+		})
+	}
+}
+
+func TestArtistRepository_ListArtistsWithoutOfficialSite(t *testing.T) {
+	repo := rdb.NewArtistRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("returns only artists with no official site", func(t *testing.T) {
+		cleanDatabase(t)
+
+		created, err := repo.Create(ctx,
+			entity.NewArtist("Has Site", "60000000-0000-0000-0000-00000000cc01"),
+			entity.NewArtist("No Site A", "60000000-0000-0000-0000-00000000cc02"),
+			entity.NewArtist("No Site B", "60000000-0000-0000-0000-00000000cc03"),
+		)
+		require.NoError(t, err)
+		require.NoError(t, repo.CreateOfficialSite(ctx, entity.NewOfficialSite(created[0].ID, "https://example.com")))
+
+		got, err := repo.ListArtistsWithoutOfficialSite(ctx, 10)
+		require.NoError(t, err)
+
+		var names []string
+		for _, a := range got {
+			names = append(names, a.Name)
+		}
+		assert.ElementsMatch(t, []string{"No Site A", "No Site B"}, names)
+	})
+
+	t.Run("respects limit", func(t *testing.T) {
+		cleanDatabase(t)
+
+		_, err := repo.Create(ctx,
+			entity.NewArtist("A", "61000000-0000-0000-0000-00000000dd01"),
+			entity.NewArtist("B", "61000000-0000-0000-0000-00000000dd02"),
+			entity.NewArtist("C", "61000000-0000-0000-0000-00000000dd03"),
+		)
+		require.NoError(t, err)
+
+		got, err := repo.ListArtistsWithoutOfficialSite(ctx, 2)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+	})
+}
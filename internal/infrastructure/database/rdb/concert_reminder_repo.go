@@ -0,0 +1,140 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// ConcertReminderRepository implements [entity.ConcertReminderRepository]
+// for PostgreSQL.
+type ConcertReminderRepository struct {
+	db *Database
+}
+
+// Compile-time interface compliance check.
+var _ entity.ConcertReminderRepository = (*ConcertReminderRepository)(nil)
+
+// NewConcertReminderRepository creates a new ConcertReminderRepository.
+func NewConcertReminderRepository(db *Database) *ConcertReminderRepository {
+	return &ConcertReminderRepository{db: db}
+}
+
+const (
+	// listConcertReminderCandidatesQuery bounds every user's window by their
+	// own concert_reminder_days, so users with different lead times for the
+	// same event are selected on different days. The window runs from the day
+	// before $1 to a day past the lead time, covering "today" in every time
+	// zone; the use case narrows it to the user's local date.
+	listConcertReminderCandidatesQuery = `
+		SELECT tj.user_id, e.id, s.title, COALESCE(v.name, e.listed_venue_name, ''),
+		       e.local_event_date, e.start_at,
+		       u.concert_reminder_days, u.time_zone, u.preferred_language
+		FROM ticket_journeys tj
+		JOIN users u ON u.id = tj.user_id
+		JOIN events e ON e.id = tj.event_id
+		JOIN series s ON s.id = e.series_id
+		LEFT JOIN venues v ON v.id = e.venue_id
+		WHERE tj.status <> $2
+		  AND u.is_active
+		  AND e.deleted_at IS NULL
+		  AND e.local_event_date BETWEEN $1::date - 1 AND $1::date + u.concert_reminder_days::int + 1
+		  AND NOT EXISTS (
+			SELECT 1 FROM concert_reminders cr
+			WHERE cr.user_id = tj.user_id AND cr.event_id = e.id
+		  )
+		ORDER BY e.local_event_date, tj.user_id, e.id
+	`
+
+	// recordConcertReminderSentQuery inserts a sent-log row. ON CONFLICT DO
+	// NOTHING makes a duplicate (user_id, event_id) pair a no-op.
+	recordConcertReminderSentQuery = `
+		INSERT INTO concert_reminders (id, user_id, event_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT ON CONSTRAINT uq_concert_reminders DO NOTHING
+	`
+
+	concertReminderAlreadySentQuery = `
+		SELECT EXISTS (
+			SELECT 1 FROM concert_reminders WHERE user_id = $1 AND event_id = $2
+		)
+	`
+)
+
+// ListCandidates implements [entity.ConcertReminderRepository].
+func (r *ConcertReminderRepository) ListCandidates(ctx context.Context, today time.Time) ([]*entity.ConcertReminderCandidate, error) {
+	rows, err := r.db.Pool.Query(ctx, listConcertReminderCandidatesQuery, today, int16(entity.TicketJourneyStatusLost))
+	if err != nil {
+		return nil, toAppErr(err, "failed to list concert reminder candidates",
+			slog.String("today", today.Format(time.DateOnly)),
+		)
+	}
+	defer rows.Close()
+
+	var candidates []*entity.ConcertReminderCandidate
+	for rows.Next() {
+		c := &entity.ConcertReminderCandidate{}
+		var reminderDays int16
+		var timeZone, preferredLanguage sql.NullString
+		if err := rows.Scan(
+			&c.UserID, &c.EventID, &c.Title, &c.VenueName,
+			&c.LocalDate, &c.StartTime,
+			&reminderDays, &timeZone, &preferredLanguage,
+		); err != nil {
+			return nil, toAppErr(err, "failed to scan concert reminder candidate")
+		}
+		c.ReminderDays = int(reminderDays)
+		c.TimeZone = timeZone.String
+		c.PreferredLanguage = preferredLanguage.String
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "list concert reminder candidates iteration error")
+	}
+	return candidates, nil
+}
+
+// RecordSent implements [entity.ConcertReminderRepository].
+func (r *ConcertReminderRepository) RecordSent(ctx context.Context, userID, eventID string) error {
+	if userID == "" {
+		return apperr.New(codes.InvalidArgument, "userID must not be empty")
+	}
+	if eventID == "" {
+		return apperr.New(codes.InvalidArgument, "eventID must not be empty")
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return toAppErr(err, "failed to generate UUIDv7 for concert reminder")
+	}
+	if _, err := r.db.Pool.Exec(ctx, recordConcertReminderSentQuery, id.String(), userID, eventID); err != nil {
+		return toAppErr(err, "failed to record concert reminder sent",
+			slog.String("user_id", userID),
+			slog.String("event_id", eventID),
+		)
+	}
+	return nil
+}
+
+// AlreadySent implements [entity.ConcertReminderRepository].
+func (r *ConcertReminderRepository) AlreadySent(ctx context.Context, userID, eventID string) (bool, error) {
+	if userID == "" {
+		return false, apperr.New(codes.InvalidArgument, "userID must not be empty")
+	}
+	if eventID == "" {
+		return false, apperr.New(codes.InvalidArgument, "eventID must not be empty")
+	}
+	var sent bool
+	if err := r.db.Pool.QueryRow(ctx, concertReminderAlreadySentQuery, userID, eventID).Scan(&sent); err != nil {
+		return false, toAppErr(err, "failed to check concert reminder sent status",
+			slog.String("user_id", userID),
+			slog.String("event_id", eventID),
+		)
+	}
+	return sent, nil
+}
@@ -0,0 +1,122 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcertReminderRepository(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewConcertReminderRepository(testDB)
+	ctx := context.Background()
+
+	today := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	// seedReminderUser stores a user with the given reminder lead time.
+	seedReminderUser := func(name string, reminderDays int) string {
+		t.Helper()
+		id := seedUser(t, name, name+"@example.com", "ext-"+name)
+		_, err := testDB.Pool.Exec(ctx,
+			`UPDATE users SET concert_reminder_days = $2, time_zone = 'Asia/Tokyo' WHERE id = $1`,
+			id, int16(reminderDays))
+		require.NoError(t, err)
+		return id
+	}
+	track := func(userID, eventID string, status entity.TicketJourneyStatus) {
+		t.Helper()
+		_, err := testDB.Pool.Exec(ctx,
+			`INSERT INTO ticket_journeys (user_id, event_id, status) VALUES ($1, $2, $3)`,
+			userID, eventID, int16(status))
+		require.NoError(t, err)
+	}
+
+	venueID := seedVenue(t, "Zepp Haneda")
+	artistID := seedArtist(t, "Reminder Artist", "")
+	weekAway := seedEvent(t, venueID, artistID, "Autumn Tour", "2026-10-24")
+	tomorrow := seedEvent(t, venueID, artistID, "Encore Night", "2026-10-19")
+
+	weekAhead := seedReminderUser("week-ahead", 7)
+	dayBefore := seedReminderUser("day-before", 1)
+	for _, userID := range []string{weekAhead, dayBefore} {
+		track(userID, weekAway, entity.TicketJourneyStatusTracking)
+		track(userID, tomorrow, entity.TicketJourneyStatusPaid)
+	}
+	lost := seedReminderUser("lost", 7)
+	track(lost, weekAway, entity.TicketJourneyStatusLost)
+
+	pairs := func(candidates []*entity.ConcertReminderCandidate) [][2]string {
+		out := make([][2]string, 0, len(candidates))
+		for _, c := range candidates {
+			out = append(out, [2]string{c.UserID, c.EventID})
+		}
+		return out
+	}
+
+	t.Run("windows follow each user's own lead time", func(t *testing.T) {
+		got, err := repo.ListCandidates(ctx, today)
+		require.NoError(t, err)
+		// The concert six days away is inside the week-ahead user's window
+		// only; the one tomorrow is inside both.
+		assert.ElementsMatch(t, [][2]string{
+			{weekAhead, tomorrow},
+			{dayBefore, tomorrow},
+			{weekAhead, weekAway},
+		}, pairs(got))
+
+		for _, c := range got {
+			if c.UserID == weekAhead && c.EventID == weekAway {
+				assert.Equal(t, "Autumn Tour", c.Title)
+				assert.Equal(t, "Zepp Haneda", c.VenueName)
+				assert.Equal(t, 7, c.ReminderDays)
+				assert.Equal(t, "Asia/Tokyo", c.TimeZone)
+				assert.Equal(t, "2026-10-24", c.LocalDate.Format(time.DateOnly))
+			}
+		}
+	})
+
+	t.Run("the same concert enters the shorter window later", func(t *testing.T) {
+		got, err := repo.ListCandidates(ctx, time.Date(2026, 10, 22, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Contains(t, pairs(got), [2]string{dayBefore, weekAway})
+	})
+
+	t.Run("reminded pairs are excluded", func(t *testing.T) {
+		require.NoError(t, repo.RecordSent(ctx, weekAhead, tomorrow))
+		require.NoError(t, repo.RecordSent(ctx, weekAhead, tomorrow), "recording twice is a no-op")
+
+		sent, err := repo.AlreadySent(ctx, weekAhead, tomorrow)
+		require.NoError(t, err)
+		assert.True(t, sent)
+		sent, err = repo.AlreadySent(ctx, dayBefore, tomorrow)
+		require.NoError(t, err)
+		assert.False(t, sent)
+
+		got, err := repo.ListCandidates(ctx, today)
+		require.NoError(t, err)
+		assert.NotContains(t, pairs(got), [2]string{weekAhead, tomorrow})
+		assert.Contains(t, pairs(got), [2]string{dayBefore, tomorrow})
+	})
+
+	t.Run("deleted events are excluded", func(t *testing.T) {
+		_, err := testDB.Pool.Exec(ctx, `UPDATE events SET deleted_at = now() WHERE id = $1`, weekAway)
+		require.NoError(t, err)
+
+		got, err := repo.ListCandidates(ctx, today)
+		require.NoError(t, err)
+		assert.NotContains(t, pairs(got), [2]string{weekAhead, weekAway})
+	})
+
+	t.Run("empty IDs are rejected", func(t *testing.T) {
+		assert.ErrorIs(t, repo.RecordSent(ctx, "", tomorrow), apperr.ErrInvalidArgument)
+		assert.ErrorIs(t, repo.RecordSent(ctx, weekAhead, ""), apperr.ErrInvalidArgument)
+		_, err := repo.AlreadySent(ctx, "", tomorrow)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
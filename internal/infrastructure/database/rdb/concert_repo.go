@@ -2,14 +2,25 @@ package rdb
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/pannpers/go-apperr/apperr/codes"
 )
 
+// createChunkSize bounds the rows one bulk Create statement carries, so a
+// very large batch is written as several statements in one transaction
+// rather than one statement with arbitrarily large array parameters. It is
+// shared by the concert and artist repositories.
+const createChunkSize = 1000
+
 // ConcertRepository implements entity.ConcertRepository interface for PostgreSQL.
 type ConcertRepository struct {
 	db *Database
@@ -20,9 +31,11 @@ const (
 	// natural key. On natural-key conflict the existing row is preserved and only NULL
 	// open_at / start_at are filled in via COALESCE. The input id is discarded in that case;
 	// callers detect this by re-querying with WHERE EXISTS on the input UUID.
+	// search_session_id is written on insert only, so a re-ingested event stays
+	// attributed to the session that first created it.
 	upsertEventsQuery = `
-		INSERT INTO events (id, series_id, venue_id, listed_venue_name, local_event_date, start_at, open_at)
-		SELECT * FROM unnest($1::uuid[], $2::uuid[], $3::uuid[], $4::text[], $5::date[], $6::timestamptz[], $7::timestamptz[])
+		INSERT INTO events (id, series_id, venue_id, listed_venue_name, local_event_date, start_at, open_at, search_session_id)
+		SELECT * FROM unnest($1::uuid[], $2::uuid[], $3::uuid[], $4::text[], $5::date[], $6::timestamptz[], $7::timestamptz[], $8::uuid[])
 		ON CONFLICT ON CONSTRAINT uq_events_natural_key DO UPDATE SET
 			start_at = COALESCE(events.start_at, EXCLUDED.start_at),
 			open_at  = COALESCE(events.open_at, EXCLUDED.open_at)
@@ -49,6 +62,14 @@ const (
 	// to an already-known event still attaches the new performer to that
 	// event's actual id. Idempotent via ON CONFLICT DO NOTHING.
 	//
+	// set_start_at carries the performer's own slot when it differs from the
+	// event's start — a festival appearance, whose event is keyed on the day
+	// alone (see eventStart) — and is NULL otherwise.
+	//
+	// venue_id and pending_venue_name are matched with IS NOT DISTINCT FROM so
+	// a link also finds an event pending venue resolution, which is keyed by
+	// its listed name with a NULL venue_id (see eventVenueKey).
+	//
 	// RETURNING event_id surfaces ONLY the genuinely new performer links
 	// (re-deliveries hit ON CONFLICT and are not returned). Callers use this
 	// to drive notification: an artist's followers must be notified whenever
@@ -58,13 +79,14 @@ const (
 	// pre-existing-event path silently skipped notifications because
 	// insertConcertsQuery only RETURNs UUIDs that won the UPSERT race.
 	insertEventPerformersQuery = `
-		INSERT INTO event_performers (event_id, artist_id)
-		SELECT e.id, perf.artist_id
-		FROM unnest($1::uuid[], $2::date[], $3::timestamptz[], $4::uuid[])
-			AS perf(venue_id, local_event_date, start_at, artist_id)
+		INSERT INTO event_performers (event_id, artist_id, set_start_at)
+		SELECT e.id, perf.artist_id, perf.set_start_at
+		FROM unnest($1::uuid[], $2::text[], $3::date[], $4::timestamptz[], $5::uuid[], $6::timestamptz[])
+			AS perf(venue_id, pending_venue_name, local_event_date, start_at, artist_id, set_start_at)
 		JOIN events e
-			ON e.venue_id = perf.venue_id
-			AND e.local_event_date = perf.local_event_date
+			ON e.local_event_date = perf.local_event_date
+			AND e.venue_id IS NOT DISTINCT FROM perf.venue_id
+			AND e.pending_venue_name IS NOT DISTINCT FROM perf.pending_venue_name
 			AND e.start_at IS NOT DISTINCT FROM perf.start_at
 		ON CONFLICT DO NOTHING
 		RETURNING event_id
@@ -93,26 +115,135 @@ const (
 		WHERE e.id = u.id
 	`
 
+	// rescheduleEventQuery moves a live event to a new date, keeping the date
+	// it replaces in previous_local_event_date. start_at / open_at are
+	// overwritten (not COALESCEd): times announced for the old date do not
+	// carry over.
+	rescheduleEventQuery = `
+		UPDATE events
+		SET previous_local_event_date = local_event_date,
+		    local_event_date = $2,
+		    start_at = $3,
+		    open_at  = $4
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	// updateEventQuery overwrites a live event's correctable fields by id and
+	// returns its parent series so the series-level fields can follow in the
+	// same transaction. Performer links and venue_id are left untouched.
+	updateEventQuery = `
+		UPDATE events
+		SET listed_venue_name = $2,
+		    local_event_date = $3,
+		    start_at = $4,
+		    open_at  = $5
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING series_id
+	`
+
+	// updateSeriesTitleQuery overwrites the series-level fields a concert
+	// correction may carry. They are shared by every event of the series.
+	updateSeriesTitleQuery = `
+		UPDATE series
+		SET title = $2,
+		    source_url = $3
+		WHERE id = $1
+	`
+
 	// listConcertsByArtistQuery returns concerts where the given artist appears
-	// in event_performers. The Series parent and the venue are joined; performer
-	// hydration happens in a follow-up query (listPerformersByEventIDsQuery).
+	// in event_performers. The Series parent and the venue (including lat/lng,
+	// as for ListByFollower) are joined in the same statement; performer
+	// hydration happens in one follow-up query (listPerformersByEventIDsQuery),
+	// so the round-trip count does not grow with the number of concerts.
+	// Soft-deleted events are excluded, as in every audience-facing listing.
 	listConcertsByArtistQuery = `
-		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at,
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
 		       s.title, s.type, s.source_url, s.merch_url,
-		       v.id, v.name, v.admin_area
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
 		FROM events e
 		JOIN series s ON e.series_id = s.id
 		JOIN venues v ON e.venue_id = v.id
 		WHERE EXISTS (
 			SELECT 1 FROM event_performers ep WHERE ep.event_id = e.id AND ep.artist_id = $1
 		)
+		AND e.deleted_at IS NULL
 		ORDER BY e.local_event_date ASC
 	`
 
+	// listConcertsByArtistAllQuery is the admin variant of
+	// listConcertsByArtistQuery: $2 = true also returns soft-deleted events.
+	listConcertsByArtistAllQuery = `
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM events e
+		JOIN series s ON e.series_id = s.id
+		JOIN venues v ON e.venue_id = v.id
+		WHERE EXISTS (
+			SELECT 1 FROM event_performers ep WHERE ep.event_id = e.id AND ep.artist_id = $1
+		)
+		AND ($2::boolean OR e.deleted_at IS NULL)
+		ORDER BY e.local_event_date ASC
+	`
+
+	// lockPendingEventQuery locks the live event pending venue resolution $1
+	// and returns the event of venue $2 holding its natural key (date,
+	// start_at; NULL start times compare equal, as in uq_events_natural_key),
+	// if any, and whether the pending event has tickets or used nullifiers
+	// that merging it would strand.
+	lockPendingEventQuery = `
+		SELECT (
+				SELECT c.id FROM events c
+				WHERE c.venue_id = $2
+				  AND c.local_event_date = p.local_event_date
+				  AND c.start_at IS NOT DISTINCT FROM p.start_at
+		       ),
+		       EXISTS (SELECT 1 FROM tickets t WHERE t.event_id = p.id)
+		         OR EXISTS (SELECT 1 FROM nullifiers n WHERE n.event_id = p.id)
+		FROM events p
+		WHERE p.id = $1 AND p.venue_id IS NULL AND p.deleted_at IS NULL
+		FOR UPDATE OF p
+	`
+
+	// assignEventVenueQuery links an event pending venue resolution to a
+	// venue. Resolved events do not match, which makes the update idempotent.
+	assignEventVenueQuery = `
+		UPDATE events
+		SET venue_id = $2
+		WHERE id = $1 AND venue_id IS NULL
+	`
+
+	// mergePendingEventPerformersQuery links the performers of the pending
+	// event $1 to the event $2 it is merged into.
+	mergePendingEventPerformersQuery = `
+		INSERT INTO event_performers (event_id, artist_id, set_start_at)
+		SELECT $2, artist_id, set_start_at
+		FROM event_performers
+		WHERE event_id = $1
+		ON CONFLICT (event_id, artist_id) DO NOTHING
+	`
+
+	// listConcertsByVenueQuery returns the concerts held at venue $1; $2 =
+	// true keeps only those dated today or later, as
+	// listUpcomingConcertsByArtistQuery does for an artist. Performers are
+	// hydrated separately, as for listConcertsByArtistQuery.
+	listConcertsByVenueQuery = `
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM events e
+		JOIN series s ON e.series_id = s.id
+		JOIN venues v ON e.venue_id = v.id
+		WHERE e.venue_id = $1
+		AND (NOT $2::boolean OR e.local_event_date >= CURRENT_DATE)
+		AND e.deleted_at IS NULL
+		ORDER BY e.local_event_date ASC, e.start_at ASC NULLS LAST, e.id ASC
+	`
+
 	listUpcomingConcertsByArtistQuery = `
-		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at,
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
 		       s.title, s.type, s.source_url, s.merch_url,
-		       v.id, v.name, v.admin_area
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
 		FROM events e
 		JOIN series s ON e.series_id = s.id
 		JOIN venues v ON e.venue_id = v.id
@@ -120,12 +251,83 @@ const (
 			SELECT 1 FROM event_performers ep WHERE ep.event_id = e.id AND ep.artist_id = $1
 		)
 		AND e.local_event_date >= CURRENT_DATE
+		AND e.deleted_at IS NULL
 		ORDER BY e.local_event_date ASC
 	`
 
+	// listUpcomingGlobalPageQuery is the keyset page of the explore feed:
+	// $2 is the optional admin_area filter, and ($3, $4) the optional
+	// (local_event_date, id) position of the previous page's last row.
+	listUpcomingGlobalPageQuery = `
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM events e
+		JOIN series s ON e.series_id = s.id
+		JOIN venues v ON e.venue_id = v.id
+		WHERE e.local_event_date >= CURRENT_DATE
+		AND e.deleted_at IS NULL
+		AND ($2::text IS NULL OR v.admin_area = $2::text)
+		AND ($3::date IS NULL OR (e.local_event_date, e.id) > ($3::date, $4::uuid))
+		ORDER BY e.local_event_date ASC, e.id ASC
+		LIMIT $1
+	`
+
+	// searchConcertsQuery matches the prefix tsquery $3 against the indexed
+	// search vectors of the series title and the venue names, and the ILIKE
+	// pattern $1 against the same text and every performer's name. The
+	// substring match catches what the word tokenizer cannot, such as part of
+	// an unspaced Japanese name; it ranks below any keyword match. A NULL $3
+	// (a query with no word characters) leaves only the substring match.
+	//
+	// Each branch of the matched UNION filters a single table, so its
+	// keyword and substring conditions are served by that table's search
+	// vector and trigram GIN indexes (combined in a BitmapOr); one OR across
+	// the joined tables would leave the planner nothing but a sequential
+	// scan of every event.
+	searchConcertsQuery = `
+		WITH tsq AS (SELECT to_tsquery('simple', $3) AS q),
+		matched AS (
+			SELECT e.id
+			FROM series s
+			JOIN events e ON e.series_id = s.id
+			CROSS JOIN tsq
+			WHERE s.search_vector @@ tsq.q OR s.title ILIKE $1
+			UNION
+			SELECT e.id
+			FROM venues v
+			JOIN events e ON e.venue_id = v.id
+			CROSS JOIN tsq
+			WHERE v.search_vector @@ tsq.q OR v.name ILIKE $1
+			UNION
+			SELECT e.id
+			FROM events e
+			CROSS JOIN tsq
+			WHERE e.search_vector @@ tsq.q OR e.listed_venue_name ILIKE $1
+			UNION
+			SELECT ep.event_id
+			FROM artists a
+			JOIN event_performers ep ON ep.artist_id = a.id
+			WHERE a.name ILIKE $1
+		)
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM matched m
+		JOIN events e ON e.id = m.id
+		JOIN series s ON e.series_id = s.id
+		JOIN venues v ON e.venue_id = v.id
+		CROSS JOIN tsq
+		WHERE e.deleted_at IS NULL
+		AND e.local_event_date >= CURRENT_DATE
+		ORDER BY COALESCE(ts_rank(s.search_vector || v.search_vector || e.search_vector, tsq.q), 0) DESC,
+		         e.local_event_date ASC, e.id ASC
+		LIMIT $2
+	`
+
 	// listConcertsByArtistsQuery includes venue lat/lng for proximity classification.
 	listConcertsByArtistsQuery = `
-		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at,
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
 		       s.title, s.type, s.source_url, s.merch_url,
 		       v.id, v.name, v.admin_area, v.latitude, v.longitude
 		FROM events e
@@ -134,14 +336,16 @@ const (
 		WHERE EXISTS (
 			SELECT 1 FROM event_performers ep WHERE ep.event_id = e.id AND ep.artist_id = ANY($1)
 		)
+		AND e.deleted_at IS NULL
 		ORDER BY e.local_event_date ASC
 	`
 
 	// listAllConcertsQuery returns every published concert with no audience
 	// filter, for the admin console's catalog management. Venue lat/lng are
 	// included (withCoords) so the shared scanConcertRow path is reused.
+	// Soft-deleted events are included; DeletedAt tells them apart.
 	listAllConcertsQuery = `
-		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at,
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
 		       s.title, s.type, s.source_url, s.merch_url,
 		       v.id, v.name, v.admin_area, v.latitude, v.longitude
 		FROM events e
@@ -154,27 +358,96 @@ const (
 	// event are removed by the schema's ON DELETE CASCADE foreign keys.
 	deleteEventQuery = `DELETE FROM events WHERE id = $1`
 
+	// softDeleteEventQuery keeps the first deleted_at so a repeated soft-delete
+	// does not rewrite when the event was hidden.
+	softDeleteEventQuery = `
+		UPDATE events SET deleted_at = COALESCE(deleted_at, now())
+		WHERE id = $1
+	`
+
+	// existsConcertBySourceQuery normalizes the stored series.source_url
+	// exactly as entity.NormalizeSourceURL does ($2 arrives normalized): trim
+	// whitespace, drop the fragment and the query string, then strip trailing
+	// slashes.
+	existsConcertBySourceQuery = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM event_performers ep
+			JOIN events e ON e.id = ep.event_id
+			JOIN series s ON s.id = e.series_id
+			LEFT JOIN venues v ON v.id = e.venue_id
+			CROSS JOIN LATERAL (SELECT split_part(btrim(s.source_url), '#', 1) AS url) src
+			WHERE ep.artist_id = $1
+			  AND e.deleted_at IS NULL
+			  AND e.local_event_date = $3
+			  AND $4 IN (e.listed_venue_name, v.listed_venue_name, v.name)
+			  AND rtrim(split_part(src.url, '?', 1), '/') = $2
+		)
+	`
+
 	// listConcertsByIDsQuery includes venue lat/lng because NotifyNewConcerts
 	// feeds the result into HypeNearby.ShouldNotify, which calls ProximityTo
 	// on Venue.Coordinates. Without the coordinates, ProximityTo returns
 	// ProximityAway for every concert and HypeNearby followers are silently
 	// excluded from every new-concert push notification.
 	listConcertsByIDsQuery = `
-		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at,
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
 		       s.title, s.type, s.source_url, s.merch_url,
 		       v.id, v.name, v.admin_area, v.latitude, v.longitude
 		FROM events e
 		JOIN series s ON e.series_id = s.id
 		JOIN venues v ON e.venue_id = v.id
 		WHERE e.id = ANY($1)
+		  AND e.deleted_at IS NULL
 		ORDER BY e.local_event_date ASC
 	`
 
+	// listConcertsBySearchSessionQuery returns the concerts whose events were
+	// first created by a discovery search session. Backed by the partial index
+	// idx_events_search_session_id.
+	listConcertsBySearchSessionQuery = `
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM events e
+		JOIN series s ON e.series_id = s.id
+		JOIN venues v ON e.venue_id = v.id
+		WHERE e.search_session_id = $1
+		  AND e.deleted_at IS NULL
+		ORDER BY e.local_event_date ASC
+	`
+
+	// listConcertsPendingVenueQuery returns the concerts recorded before their
+	// venue was resolved. The venue LEFT JOIN matches nothing for these rows;
+	// it keeps the column layout of the other listings so scanConcertRow is
+	// shared. Backed by the partial index idx_events_pending_venue.
+	listConcertsPendingVenueQuery = `
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM events e
+		JOIN series s ON e.series_id = s.id
+		LEFT JOIN venues v ON e.venue_id = v.id
+		WHERE e.venue_id IS NULL
+		AND e.deleted_at IS NULL
+		ORDER BY e.venue_resolution_attempted_at ASC NULLS FIRST, e.local_event_date ASC, e.id ASC
+		LIMIT $1
+	`
+
+	markVenueResolutionAttemptedQuery = `
+		UPDATE events
+		SET venue_resolution_attempted_at = now()
+		WHERE id = ANY($1::uuid[]) AND venue_id IS NULL
+	`
+
 	// listConcertsByFollowerQuery joins followed_artists via event_performers.
 	// Distinct is required because an event could have multiple performers that
-	// are all followed by the same user; we want one row per event.
+	// are all followed by the same user; we want one row per event. It is a
+	// keyset page like listUpcomingGlobalPageQuery: $2 is the page size and
+	// ($3, $4) the optional (local_event_date, id) position of the previous
+	// page's last row.
 	listConcertsByFollowerQuery = `
-		SELECT DISTINCT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at,
+		SELECT DISTINCT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
 		       s.title, s.type, s.source_url, s.merch_url,
 		       v.id, v.name, v.admin_area, v.latitude, v.longitude
 		FROM events e
@@ -183,7 +456,10 @@ const (
 		JOIN event_performers ep ON ep.event_id = e.id
 		JOIN followed_artists fa ON fa.artist_id = ep.artist_id
 		WHERE fa.user_id = $1
-		ORDER BY e.local_event_date ASC
+		AND e.deleted_at IS NULL
+		AND ($3::date IS NULL OR (e.local_event_date, e.id) > ($3::date, $4::uuid))
+		ORDER BY e.local_event_date ASC, e.id ASC
+		LIMIT $2
 	`
 
 	// listPerformersByEventIDsQuery hydrates the Performers slice on each Concert.
@@ -207,23 +483,61 @@ func NewConcertRepository(db *Database) *ConcertRepository {
 	return &ConcertRepository{db: db}
 }
 
+// performerLink is one event_performers row of a Create batch, addressed by the
+// event's physical natural key rather than its id (see insertEventPerformersQuery).
+type performerLink struct {
+	venueID          *string
+	pendingVenueName *string
+	date             time.Time
+	startAt          *time.Time
+	artistID         string
+	setStart         *time.Time
+}
+
+// eventStart returns the start_at that keys c's event row. A festival
+// appearance keys on the festival day alone, so every artist on the lineup
+// resolves to one shared event; its own StartTime is that artist's set time
+// and is stored on the performer link instead.
+func eventStart(c *entity.Concert) *time.Time {
+	if c.Series != nil && c.Series.Type == entity.SeriesTypeFestival {
+		return nil
+	}
+	return c.StartTime
+}
+
+// eventVenueKey returns the venue columns that key c's event row. A concert
+// whose venue is resolved keys on its VenueID; one pending venue resolution
+// has no venue_id and keys on its listed venue name instead, mirroring the
+// generated events.pending_venue_name column.
+func eventVenueKey(c *entity.Concert) (venueID, pendingVenueName *string) {
+	if c.VenueID != "" {
+		return &c.VenueID, nil
+	}
+	return nil, c.ListedVenueName
+}
+
 // scanConcertRow scans a row from the standard JOIN (events + series + venue)
 // into a Concert without populating Performers. Pass withCoords=true when the
-// query selects venue lat/lng (used by ListByArtists / ListByFollower).
+// query selects venue lat/lng (used by ListByArtists / ListByFollower). A row
+// pending venue resolution (NULL venue_id, venue columns NULL from a LEFT
+// JOIN) yields an empty VenueID and a nil Venue.
 func scanConcertRow(rowScan func(dest ...any) error, withCoords bool) (*entity.Concert, error) {
 	var (
-		c         entity.Concert
-		series    entity.Series
-		venue     entity.Venue
-		seriesT   string
-		sourceURL *string
-		merchURL  *string
-		lat, lng  *float64
+		c          entity.Concert
+		series     entity.Series
+		venue      entity.Venue
+		seriesT    string
+		sourceURL  *string
+		merchURL   *string
+		eventVenue *string
+		venueID    *string
+		venueName  *string
+		lat, lng   *float64
 	)
 	dests := []any{
-		&c.ID, &c.SeriesID, &c.VenueID, &c.ListedVenueName, &c.LocalDate, &c.StartTime, &c.OpenTime,
+		&c.ID, &c.SeriesID, &eventVenue, &c.ListedVenueName, &c.LocalDate, &c.StartTime, &c.OpenTime, &c.SearchSessionID, &c.DeletedAt,
 		&series.Title, &seriesT, &sourceURL, &merchURL,
-		&venue.ID, &venue.Name, &venue.AdminArea,
+		&venueID, &venueName, &venue.AdminArea,
 	}
 	if withCoords {
 		dests = append(dests, &lat, &lng)
@@ -256,10 +570,20 @@ func scanConcertRow(rowScan func(dest ...any) error, withCoords bool) (*entity.C
 	if merchURL != nil {
 		series.MerchURL = *merchURL
 	}
+	c.Series = &series
+	if eventVenue != nil {
+		c.VenueID = *eventVenue
+	}
+	if venueID == nil {
+		return &c, nil
+	}
+	venue.ID = *venueID
+	if venueName != nil {
+		venue.Name = *venueName
+	}
 	if lat != nil && lng != nil {
 		venue.Coordinates = &entity.Coordinates{Latitude: *lat, Longitude: *lng}
 	}
-	c.Series = &series
 	c.Venue = &venue
 	return &c, nil
 }
@@ -322,7 +646,7 @@ func (r *ConcertRepository) ListByArtist(ctx context.Context, artistID string, u
 
 	var concerts []*entity.Concert
 	for rows.Next() {
-		c, err := scanConcertRow(rows.Scan, false)
+		c, err := scanConcertRow(rows.Scan, true)
 		if err != nil {
 			// scanConcertRow already returns a classified error
 			// (toAppErr-wrapped pgx errors or apperr.New for the
@@ -344,6 +668,67 @@ func (r *ConcertRepository) ListByArtist(ctx context.Context, artistID string, u
 	return concerts, nil
 }
 
+// ListByVenue retrieves the concerts held at the given venue.
+func (r *ConcertRepository) ListByVenue(ctx context.Context, venueID string, upcomingOnly bool) ([]*entity.Concert, error) {
+	if venueID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "venue ID must not be empty")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listConcertsByVenueQuery, venueID, upcomingOnly)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list concerts by venue", slog.String("venue_id", venueID))
+	}
+	defer rows.Close()
+
+	var concerts []*entity.Concert
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, err
+		}
+		concerts = append(concerts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "concert row iteration ended with error")
+	}
+
+	if err := r.hydratePerformers(ctx, concerts); err != nil {
+		return nil, err
+	}
+	return concerts, nil
+}
+
+// ListByArtistAll retrieves every concert of the artist for admin review,
+// including soft-deleted ones when includeDeleted is true.
+func (r *ConcertRepository) ListByArtistAll(ctx context.Context, artistID string, includeDeleted bool) ([]*entity.Concert, error) {
+	if artistID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "artist ID must not be empty")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listConcertsByArtistAllQuery, artistID, includeDeleted)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list all concerts by artist", slog.String("artist_id", artistID))
+	}
+	defer rows.Close()
+
+	var concerts []*entity.Concert
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, err
+		}
+		concerts = append(concerts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "concert row iteration ended with error")
+	}
+
+	if err := r.hydratePerformers(ctx, concerts); err != nil {
+		return nil, err
+	}
+	return concerts, nil
+}
+
 // ListByIDs retrieves concerts by their event IDs. Series, Venue, and Performers
 // are all populated.
 func (r *ConcertRepository) ListByIDs(ctx context.Context, ids []string) ([]*entity.Concert, error) {
@@ -378,12 +763,16 @@ func (r *ConcertRepository) ListByIDs(ctx context.Context, ids []string) ([]*ent
 	return concerts, nil
 }
 
-// ListByFollower retrieves all concerts featuring artists the user follows.
-// Venue lat/lng are included for proximity classification.
-func (r *ConcertRepository) ListByFollower(ctx context.Context, userID string) ([]*entity.Concert, error) {
-	rows, err := r.db.Pool.Query(ctx, listConcertsByFollowerQuery, userID)
+// ListBySearchSession retrieves the concerts whose events were first created by
+// the given discovery search session.
+func (r *ConcertRepository) ListBySearchSession(ctx context.Context, sessionID string) ([]*entity.Concert, error) {
+	if sessionID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "search session ID must not be empty")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listConcertsBySearchSessionQuery, sessionID)
 	if err != nil {
-		return nil, toAppErr(err, "failed to list concerts by follower", slog.String("user_id", userID))
+		return nil, toAppErr(err, "failed to list concerts by search session", slog.String("search_session_id", sessionID))
 	}
 	defer rows.Close()
 
@@ -405,6 +794,140 @@ func (r *ConcertRepository) ListByFollower(ctx context.Context, userID string) (
 	return concerts, nil
 }
 
+// ListPendingVenue retrieves up to limit concerts whose venue is pending
+// resolution, least recently attempted first.
+func (r *ConcertRepository) ListPendingVenue(ctx context.Context, limit int) ([]*entity.Concert, error) {
+	if limit <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "limit must be positive")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listConcertsPendingVenueQuery, limit)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list concerts pending venue resolution")
+	}
+	defer rows.Close()
+
+	var concerts []*entity.Concert
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, err
+		}
+		concerts = append(concerts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "concert row iteration ended with error")
+	}
+
+	if err := r.hydratePerformers(ctx, concerts); err != nil {
+		return nil, err
+	}
+	return concerts, nil
+}
+
+// MarkVenueResolutionAttempted stamps the venue-resolution attempt time of
+// the given events pending venue resolution.
+func (r *ConcertRepository) MarkVenueResolutionAttempted(ctx context.Context, eventIDs []string) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+	if _, err := r.db.Pool.Exec(ctx, markVenueResolutionAttemptedQuery, eventIDs); err != nil {
+		return toAppErr(err, "failed to mark venue resolution attempted", slog.Int("count", len(eventIDs)))
+	}
+	return nil
+}
+
+// AssignVenue links an event pending venue resolution to a venue, merging it
+// into the venue's event on the same date and start time when there is one.
+func (r *ConcertRepository) AssignVenue(ctx context.Context, eventID, venueID string) (string, error) {
+	if eventID == "" || venueID == "" {
+		return "", apperr.New(codes.InvalidArgument, "event ID and venue ID must not be empty")
+	}
+	attrs := []slog.Attr{slog.String("event_id", eventID), slog.String("venue_id", venueID)}
+
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return "", toAppErr(err, "failed to begin transaction", attrs...)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var (
+		collidingID *string
+		hasEntry    bool
+	)
+	if err := tx.QueryRow(ctx, lockPendingEventQuery, eventID, venueID).Scan(&collidingID, &hasEntry); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", apperr.New(codes.NotFound, "no event pending venue resolution with this ID", attrs...)
+		}
+		return "", toAppErr(err, "failed to lock event pending venue resolution", attrs...)
+	}
+
+	heldBy := eventID
+	if collidingID == nil {
+		if _, err := tx.Exec(ctx, assignEventVenueQuery, eventID, venueID); err != nil {
+			return "", toAppErr(err, "failed to assign venue to event", attrs...)
+		}
+	} else {
+		// Repointing would violate uq_events_natural_key: the venue already
+		// holds this show, so fold the pending event into it.
+		if hasEntry {
+			return "", apperr.New(codes.FailedPrecondition, "event to merge has tickets or used nullifiers",
+				append(attrs, slog.String("colliding_event_id", *collidingID))...)
+		}
+		if _, err := tx.Exec(ctx, mergePendingEventPerformersQuery, eventID, *collidingID); err != nil {
+			return "", toAppErr(err, "failed to merge performers of pending event", attrs...)
+		}
+		if _, err := tx.Exec(ctx, softDeleteEventQuery, eventID); err != nil {
+			return "", toAppErr(err, "failed to soft-delete merged pending event", attrs...)
+		}
+		heldBy = *collidingID
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", toAppErr(err, "failed to commit venue assignment", attrs...)
+	}
+	return heldBy, nil
+}
+
+// ListByFollower retrieves one page of the concerts featuring artists the
+// user follows. Venue lat/lng are included for proximity classification.
+func (r *ConcertRepository) ListByFollower(ctx context.Context, userID string, limit int, cursor string) ([]*entity.Concert, string, error) {
+	if limit <= 0 {
+		return nil, "", apperr.New(codes.InvalidArgument, "page limit must be positive")
+	}
+	afterDate, afterID, err := decodeDateCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	rows, err := r.db.Pool.Query(ctx, listConcertsByFollowerQuery, userID, limit+1, afterDate, afterID)
+	if err != nil {
+		return nil, "", toAppErr(err, "failed to list concerts by follower", slog.String("user_id", userID))
+	}
+	defer rows.Close()
+
+	var concerts []*entity.Concert
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, "", err
+		}
+		concerts = append(concerts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", toAppErr(err, "concert row iteration ended with error")
+	}
+
+	concerts, next := trimPage(concerts, limit, func(c *entity.Concert) string {
+		return dateCursorKey(c.LocalDate, c.ID)
+	})
+	if err := r.hydratePerformers(ctx, concerts); err != nil {
+		return nil, "", err
+	}
+	return concerts, next, nil
+}
+
 // ListByArtists retrieves concerts where any of the given artists is a performer.
 // Venue lat/lng are included for proximity classification.
 func (r *ConcertRepository) ListByArtists(ctx context.Context, artistIDs []string) ([]*entity.Concert, error) {
@@ -469,6 +992,151 @@ func (r *ConcertRepository) Delete(ctx context.Context, eventID string) error {
 	return nil
 }
 
+// SoftDelete hides a published event from audience-facing listings by setting
+// deleted_at. Repeating it on an already-deleted event is a no-op success.
+func (r *ConcertRepository) SoftDelete(ctx context.Context, eventID string) error {
+	if eventID == "" {
+		return apperr.New(codes.InvalidArgument, "event ID must not be empty")
+	}
+
+	result, err := r.db.Pool.Exec(ctx, softDeleteEventQuery, eventID)
+	if err != nil {
+		return toAppErr(err, "failed to soft-delete event", slog.String("event_id", eventID))
+	}
+	if result.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "event not found", slog.String("event_id", eventID))
+	}
+	return nil
+}
+
+// ListUpcomingGlobal returns one keyset page of upcoming concerts across all
+// artists, ordered by (local_event_date, id).
+func (r *ConcertRepository) ListUpcomingGlobal(ctx context.Context, filter entity.UpcomingConcertFilter, limit int, cursor string) ([]*entity.Concert, string, error) {
+	if limit <= 0 {
+		return nil, "", apperr.New(codes.InvalidArgument, "page limit must be positive")
+	}
+	afterDate, afterID, err := decodeDateCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	var adminArea *string
+	if filter.AdminArea != "" {
+		adminArea = &filter.AdminArea
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	rows, err := r.db.Pool.Query(ctx, listUpcomingGlobalPageQuery, limit+1, adminArea, afterDate, afterID)
+	if err != nil {
+		return nil, "", toAppErr(err, "failed to list upcoming concerts page",
+			slog.String("admin_area", filter.AdminArea),
+		)
+	}
+	defer rows.Close()
+
+	var concerts []*entity.Concert
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, "", err
+		}
+		concerts = append(concerts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", toAppErr(err, "concert row iteration ended with error")
+	}
+
+	concerts, next := trimPage(concerts, limit, func(c *entity.Concert) string {
+		return dateCursorKey(c.LocalDate, c.ID)
+	})
+	if err := r.hydratePerformers(ctx, concerts); err != nil {
+		return nil, "", err
+	}
+	return concerts, next, nil
+}
+
+// Search returns concerts whose title, venue, or performer name contains query.
+func (r *ConcertRepository) Search(ctx context.Context, query string, limit int) ([]*entity.Concert, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, apperr.New(codes.InvalidArgument, "search query must not be empty")
+	}
+	if limit <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "search limit must be positive")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, searchConcertsQuery, containsPattern(query), limit, prefixTSQuery(query))
+	if err != nil {
+		return nil, toAppErr(err, "failed to search concerts", slog.String("query", query))
+	}
+	defer rows.Close()
+
+	var concerts []*entity.Concert
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, err
+		}
+		concerts = append(concerts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "concert row iteration ended with error")
+	}
+
+	if err := r.hydratePerformers(ctx, concerts); err != nil {
+		return nil, err
+	}
+	return concerts, nil
+}
+
+// containsPattern turns user input into an ILIKE substring pattern, escaping
+// the LIKE wildcards so "%" and "_" in the input match themselves.
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// prefixTSQuery builds a tsquery that requires every word of s as a lexeme
+// prefix, so "zepp hane" matches "Zepp Haneda". Words are split on anything
+// but letters, digits, and combining marks, which also strips tsquery operators. It returns nil
+// when s has no words, so the query binds SQL NULL.
+func prefixTSQuery(s string) *string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.IsMark(r)
+	})
+	if len(words) == 0 {
+		return nil
+	}
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+	q := strings.Join(words, " & ")
+	return &q
+}
+
+// ExistsBySource reports whether the artist performs in a live concert on
+// localDate at listedVenueName whose normalized series source URL equals the
+// normalized sourceURL.
+func (r *ConcertRepository) ExistsBySource(ctx context.Context, artistID, sourceURL string, localDate time.Time, listedVenueName string) (bool, error) {
+	if artistID == "" {
+		return false, apperr.New(codes.InvalidArgument, "artist ID must not be empty")
+	}
+	normalized := entity.NormalizeSourceURL(sourceURL)
+	if normalized == "" {
+		return false, nil
+	}
+
+	var exists bool
+	if err := r.db.Pool.QueryRow(ctx, existsConcertBySourceQuery, artistID, normalized, localDate, listedVenueName).Scan(&exists); err != nil {
+		return false, toAppErr(err, "failed to check concert source",
+			slog.String("artist_id", artistID),
+			slog.String("source_url", normalized),
+			slog.String("local_date", localDate.Format(time.DateOnly)),
+		)
+	}
+	return exists, nil
+}
+
 // Create persists one or more concerts using bulk insert with UPSERT semantics.
 //
 // Caller MUST have already created the parent Series rows via
@@ -477,7 +1145,9 @@ func (r *ConcertRepository) Delete(ctx context.Context, eventID string) error {
 // those Series rows (FK enforced).
 //
 // Events use UPSERT on (series_id, local_event_date, venue_id). On conflict the
-// pre-existing event keeps its id and only NULL start/open times are filled.
+// pre-existing event keeps its id and search_session_id, and only NULL
+// start/open times are filled. A concert without a VenueID is recorded
+// pending venue resolution, keyed by its ListedVenueName (see eventVenueKey).
 // The placeholder concerts row and the event_performers links are only inserted
 // for events whose input UUID survived the UPSERT.
 //
@@ -500,45 +1170,14 @@ func (r *ConcertRepository) Create(ctx context.Context, concerts ...*entity.Conc
 	// so both survive, GetByListedName/Places resolves them to the same
 	// venue_id, and with an identical date+start they collapse to one key →
 	// batch UPSERT crash, the tx rolls back, and the Pub/Sub consumer retries
-	// the message indefinitely. Today's only caller
-	// (concert_creation_uc.CreateFromDiscovered) emits one batch per Pub/Sub
-	// message and one message per artist, so every concert in a single Create
-	// call shares the same artist set — dropping duplicates by natural key
-	// cannot lose performer information. If a future caller batches concerts
-	// from multiple artists, it must merge duplicates' Performers into the kept
-	// entry before invoking Create; the Warn log surfaces if that happens.
+	// the message indefinitely. It also fires for two artists' appearances at
+	// one festival day, which share an event by design (see eventStart).
+	//
+	// Only the event row of a duplicate is dropped: its performers are still
+	// flattened below, and the natural-key JOIN in insertEventPerformersQuery
+	// attaches them to the kept row, so no lineup information is lost.
 	var valid []*entity.Concert
 	seenKey := make(map[string]struct{}, len(concerts))
-	for _, c := range concerts {
-		if c == nil {
-			continue
-		}
-		start := entity.StartKey(c.StartTime)
-		key := c.VenueID + "|" + c.LocalDate.Format("2006-01-02") + "|" + start
-		if _, dup := seenKey[key]; dup {
-			r.db.logger.Warn(ctx, "Create: dropping duplicate concert with identical natural key from same batch",
-				slog.String("concert_id", c.ID),
-				slog.String("venue_id", c.VenueID),
-				slog.String("local_date", c.LocalDate.Format("2006-01-02")),
-				slog.String("start_at", start),
-			)
-			continue
-		}
-		seenKey[key] = struct{}{}
-		valid = append(valid, c)
-	}
-	if len(valid) == 0 {
-		return nil, nil
-	}
-
-	n := len(valid)
-	eventIDs := make([]string, n)
-	seriesIDs := make([]string, n)
-	venueIDs := make([]string, n)
-	listedVenueNames := make([]*string, n)
-	eventDates := make([]time.Time, n)
-	startTimes := make([]*time.Time, n)
-	openTimes := make([]*time.Time, n)
 
 	// Flatten (venue_id, local_event_date, start_at, artist_id) tuples from each
 	// concert's Performers slice. The physical-key triple lets the
@@ -547,19 +1186,14 @@ func (r *ConcertRepository) Create(ctx context.Context, concerts ...*entity.Conc
 	// what makes re-scrape lineup updates correctly attach to the existing
 	// event id instead of being silently dropped. start_at is matched with
 	// IS NOT DISTINCT FROM so it both disambiguates 昼夜2公演 and matches NULLs.
-	var (
-		performerVenueIDs   []string
-		performerEventDates []time.Time
-		performerStartAts   []*time.Time
-		performerArtistIDs  []string
-	)
+	var links []*performerLink
 
-	for i, c := range valid {
+	for _, c := range compactRows(concerts) {
 		if c.ID == "" {
 			return nil, apperr.New(codes.InvalidArgument, "concert must carry an ID (event UUID) before insert")
 		}
-		if c.VenueID == "" {
-			return nil, apperr.New(codes.InvalidArgument, "concert must carry a VenueID before insert")
+		if c.VenueID == "" && (c.ListedVenueName == nil || *c.ListedVenueName == "") {
+			return nil, apperr.New(codes.InvalidArgument, "concert must carry a VenueID or a ListedVenueName before insert")
 		}
 		if c.SeriesID == "" {
 			return nil, apperr.New(codes.InvalidArgument, "concert must carry a SeriesID before insert")
@@ -567,62 +1201,64 @@ func (r *ConcertRepository) Create(ctx context.Context, concerts ...*entity.Conc
 		if len(c.Performers) == 0 {
 			return nil, apperr.New(codes.InvalidArgument, "concert must have at least one performer before insert")
 		}
-		eventIDs[i] = c.ID
-		seriesIDs[i] = c.SeriesID
-		venueIDs[i] = c.VenueID
-		listedVenueNames[i] = c.ListedVenueName
-		eventDates[i] = c.LocalDate
-		startTimes[i] = c.StartTime
-		openTimes[i] = c.OpenTime
+
+		start := eventStart(c)
+		venueID, pendingVenueName := eventVenueKey(c)
+		var setStart *time.Time
+		if start != c.StartTime {
+			setStart = c.StartTime
+		}
 		for _, p := range c.Performers {
 			if p == nil || p.ID == "" {
 				return nil, apperr.New(codes.InvalidArgument, "performer ID must not be empty")
 			}
-			performerVenueIDs = append(performerVenueIDs, c.VenueID)
-			performerEventDates = append(performerEventDates, c.LocalDate)
-			performerStartAts = append(performerStartAts, c.StartTime)
-			performerArtistIDs = append(performerArtistIDs, p.ID)
+			links = append(links, &performerLink{
+				venueID:          venueID,
+				pendingVenueName: pendingVenueName,
+				date:             c.LocalDate,
+				startAt:          start,
+				artistID:         p.ID,
+				setStart:         setStart,
+			})
+		}
+
+		key := c.VenueID + "|" + c.LocalDate.Format("2006-01-02") + "|" + entity.StartKey(start)
+		if pendingVenueName != nil {
+			key += "|" + *pendingVenueName
+		}
+		if _, dup := seenKey[key]; dup {
+			r.db.logger.Warn(ctx, "Create: merging concert with identical natural key into earlier batch entry",
+				slog.String("concert_id", c.ID),
+				slog.String("venue_id", c.VenueID),
+				slog.String("local_date", c.LocalDate.Format("2006-01-02")),
+				slog.String("start_at", entity.StartKey(start)),
+			)
+			continue
 		}
+		seenKey[key] = struct{}{}
+		valid = append(valid, c)
+	}
+	if len(valid) == 0 {
+		return nil, nil
 	}
 
+	n := len(valid)
 	tx, err := r.db.Pool.Begin(ctx)
 	if err != nil {
 		return nil, toAppErr(err, "failed to begin transaction")
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	if _, err := tx.Exec(ctx, upsertEventsQuery,
-		eventIDs, seriesIDs, venueIDs, listedVenueNames, eventDates, startTimes, openTimes,
-	); err != nil {
-		return nil, toAppErr(err, "failed to upsert events", slog.Int("count", n))
-	}
-
-	rows, err := tx.Query(ctx, insertConcertsQuery, eventIDs)
-	if err != nil {
-		return nil, toAppErr(err, "failed to insert concerts", slog.Int("count", n))
-	}
-	defer rows.Close()
+	// Every chunk runs in the one transaction, so a failure in any chunk
+	// rolls back the whole batch.
 	insertedIDs := make([]string, 0, n)
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			return nil, toAppErr(err, "failed to scan inserted concert id")
+	for chunk := range slices.Chunk(valid, createChunkSize) {
+		ids, err := insertEventChunk(ctx, tx, chunk)
+		if err != nil {
+			return nil, err
 		}
-		insertedIDs = append(insertedIDs, id)
+		insertedIDs = append(insertedIDs, ids...)
 	}
-	if err := rows.Err(); err != nil {
-		return nil, toAppErr(err, "concert insert RETURNING iteration ended with error",
-			slog.Int("count", n),
-		)
-	}
-	// Load-bearing explicit close: pgx v5 forbids issuing a new query on
-	// the same transaction while a previous Rows is still open, and the
-	// next tx.Query for insertEventPerformersQuery happens immediately
-	// below. The `defer rows.Close()` at line 520 only fires when Create
-	// returns; while `rows.Next()` returning false also auto-closes today,
-	// any future intermediate break/return between the loop and the next
-	// tx.Query would leak the cursor and block the follow-up query.
-	rows.Close()
 
 	// linkedEventIDs collects the event IDs returned by
 	// insertEventPerformersQuery — these are the events where one of THIS
@@ -633,31 +1269,17 @@ func (r *ConcertRepository) Create(ctx context.Context, concerts ...*entity.Conc
 	// keeps the existing row, insertConcertsQuery returns nothing — but
 	// the event_performers RETURNING surfaces the new (event, B) link so
 	// B's followers get notified.
+	//
+	// Links are inserted only after every event chunk, because a link
+	// finds its event by natural key and that event may sit in a later
+	// chunk than the concert the link came from.
 	var linkedEventIDs []string
-	if len(performerArtistIDs) > 0 {
-		linkRows, err := tx.Query(ctx, insertEventPerformersQuery,
-			performerVenueIDs, performerEventDates, performerStartAts, performerArtistIDs,
-		)
+	for chunk := range slices.Chunk(links, createChunkSize) {
+		ids, err := insertPerformerLinkChunk(ctx, tx, chunk)
 		if err != nil {
-			return nil, toAppErr(err, "failed to insert event_performers",
-				slog.Int("event_count", n),
-				slog.Int("link_count", len(performerArtistIDs)),
-			)
-		}
-		defer linkRows.Close()
-		for linkRows.Next() {
-			var id string
-			if err := linkRows.Scan(&id); err != nil {
-				return nil, toAppErr(err, "failed to scan linked event id")
-			}
-			linkedEventIDs = append(linkedEventIDs, id)
-		}
-		if err := linkRows.Err(); err != nil {
-			return nil, toAppErr(err, "event_performers insert RETURNING iteration ended with error",
-				slog.Int("link_count", len(performerArtistIDs)),
-			)
+			return nil, err
 		}
-		linkRows.Close()
+		linkedEventIDs = append(linkedEventIDs, ids...)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -696,6 +1318,84 @@ func (r *ConcertRepository) Create(ctx context.Context, concerts ...*entity.Conc
 	return notifiableIDs, nil
 }
 
+// insertEventChunk upserts one chunk of Create's events and inserts the
+// placeholder concerts rows, returning the IDs of events that were newly
+// inserted.
+func insertEventChunk(ctx context.Context, tx pgx.Tx, chunk []*entity.Concert) ([]string, error) {
+	n := len(chunk)
+	eventIDs := unnestColumn(chunk, func(c *entity.Concert) string { return c.ID })
+	if _, err := tx.Exec(ctx, upsertEventsQuery,
+		eventIDs,
+		unnestColumn(chunk, func(c *entity.Concert) string { return c.SeriesID }),
+		unnestColumn(chunk, func(c *entity.Concert) *string {
+			venueID, _ := eventVenueKey(c)
+			return venueID
+		}),
+		unnestColumn(chunk, func(c *entity.Concert) *string { return c.ListedVenueName }),
+		unnestColumn(chunk, func(c *entity.Concert) time.Time { return c.LocalDate }),
+		unnestColumn(chunk, eventStart),
+		unnestColumn(chunk, func(c *entity.Concert) *time.Time { return c.OpenTime }),
+		unnestColumn(chunk, func(c *entity.Concert) *string { return c.SearchSessionID }),
+	); err != nil {
+		return nil, toAppErr(err, "failed to upsert events", slog.Int("count", n))
+	}
+
+	rows, err := tx.Query(ctx, insertConcertsQuery, eventIDs)
+	if err != nil {
+		return nil, toAppErr(err, "failed to insert concerts", slog.Int("count", n))
+	}
+	// pgx v5 forbids a new query on the transaction while these rows are
+	// open; the deferred close fires before the caller issues the next one.
+	defer rows.Close()
+	insertedIDs := make([]string, 0, n)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, toAppErr(err, "failed to scan inserted concert id")
+		}
+		insertedIDs = append(insertedIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "concert insert RETURNING iteration ended with error",
+			slog.Int("count", n),
+		)
+	}
+	return insertedIDs, nil
+}
+
+// insertPerformerLinkChunk inserts one chunk of Create's event_performers
+// links, returning the IDs of events that gained a new performer.
+func insertPerformerLinkChunk(ctx context.Context, tx pgx.Tx, chunk []*performerLink) ([]string, error) {
+	rows, err := tx.Query(ctx, insertEventPerformersQuery,
+		unnestColumn(chunk, func(l *performerLink) *string { return l.venueID }),
+		unnestColumn(chunk, func(l *performerLink) *string { return l.pendingVenueName }),
+		unnestColumn(chunk, func(l *performerLink) time.Time { return l.date }),
+		unnestColumn(chunk, func(l *performerLink) *time.Time { return l.startAt }),
+		unnestColumn(chunk, func(l *performerLink) string { return l.artistID }),
+		unnestColumn(chunk, func(l *performerLink) *time.Time { return l.setStart }),
+	)
+	if err != nil {
+		return nil, toAppErr(err, "failed to insert event_performers",
+			slog.Int("link_count", len(chunk)),
+		)
+	}
+	defer rows.Close()
+	var linkedEventIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, toAppErr(err, "failed to scan linked event id")
+		}
+		linkedEventIDs = append(linkedEventIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "event_performers insert RETURNING iteration ended with error",
+			slog.Int("link_count", len(chunk)),
+		)
+	}
+	return linkedEventIDs, nil
+}
+
 // FindEventsByVenueAndDate implements entity.ConcertRepository. It returns
 // existing events at any of the supplied (venue_id, local_event_date) pairs,
 // projected to the fields discovery-time resolution needs.
@@ -740,3 +1440,57 @@ func (r *ConcertRepository) FillEventStartTimes(ctx context.Context, eventIDs []
 	}
 	return nil
 }
+
+// Update implements entity.ConcertRepository. It overwrites the event row and
+// its parent series in one transaction.
+func (r *ConcertRepository) Update(ctx context.Context, concert *entity.Concert) error {
+	if concert == nil || concert.ID == "" {
+		return apperr.New(codes.InvalidArgument, "concert must carry an ID (event UUID) to update")
+	}
+	if concert.Series == nil || concert.Series.Title == "" {
+		return apperr.New(codes.InvalidArgument, "concert must carry a Series with a non-empty title to update")
+	}
+
+	var sourceURL *string
+	if concert.Series.SourceURL != "" {
+		sourceURL = &concert.Series.SourceURL
+	}
+
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return toAppErr(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var seriesID string
+	if err := tx.QueryRow(ctx, updateEventQuery,
+		concert.ID, concert.ListedVenueName, concert.LocalDate, eventStart(concert), concert.OpenTime,
+	).Scan(&seriesID); err != nil {
+		return toAppErr(err, "failed to update event", slog.String("event_id", concert.ID))
+	}
+
+	if _, err := tx.Exec(ctx, updateSeriesTitleQuery, seriesID, concert.Series.Title, sourceURL); err != nil {
+		return toAppErr(err, "failed to update series",
+			slog.String("event_id", concert.ID),
+			slog.String("series_id", seriesID),
+		)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return toAppErr(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// Reschedule implements entity.ConcertRepository. It moves the event to date,
+// recording the replaced date in previous_local_event_date.
+func (r *ConcertRepository) Reschedule(ctx context.Context, eventID string, date time.Time, startTime, openTime *time.Time) error {
+	tag, err := r.db.Pool.Exec(ctx, rescheduleEventQuery, eventID, date, startTime, openTime)
+	if err != nil {
+		return toAppErr(err, "failed to reschedule event", slog.String("event_id", eventID))
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "event not found")
+	}
+	return nil
+}
@@ -2,6 +2,9 @@ package rdb_test
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,6 +14,9 @@ import (
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // requireCreate wraps ConcertRepository.Create for integration tests that only
@@ -606,6 +612,103 @@ func TestConcertRepository_CoHeadliners(t *testing.T) {
 	}
 }
 
+// TestConcertRepository_FestivalSharedEvent verifies that two artists'
+// appearances at one festival day resolve to a single shared event even though
+// each carries its own set time: the event is keyed on (venue, date) and each
+// artist's slot is kept on its event_performers row. Covers both a later
+// discovery joining an existing festival event and two appearances arriving
+// in one batch.
+func TestConcertRepository_FestivalSharedEvent(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	festivalDate, _ := time.Parse("2006-01-02", "2026-08-22")
+	slotA := time.Date(2026, 8, 22, 3, 0, 0, 0, time.UTC)
+	slotB := time.Date(2026, 8, 22, 6, 30, 0, 0, time.UTC)
+
+	seed := func(t *testing.T) (artistA, artistB, venueID string, festival *entity.Series) {
+		t.Helper()
+		cleanDatabase(t)
+		artistA, artistB = newTestID(t), newTestID(t)
+		_, err := artistRepo.Create(ctx,
+			&entity.Artist{ID: artistA, Name: "Festival Band A", MBID: newTestID(t)},
+			&entity.Artist{ID: artistB, Name: "Festival Band B", MBID: newTestID(t)},
+		)
+		require.NoError(t, err)
+		venueID = newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Festival Grounds"}))
+		festival = &entity.Series{ID: newTestID(t), Title: "Summer Fes 2026", Type: entity.SeriesTypeFestival}
+		_, err = seriesRepo.Create(ctx, festival)
+		require.NoError(t, err)
+		return artistA, artistB, venueID, festival
+	}
+	appearance := func(t *testing.T, artistID, venueID string, festival *entity.Series, slot time.Time) *entity.Concert {
+		t.Helper()
+		return &entity.Concert{
+			Event:      entity.Event{ID: newTestID(t), SeriesID: festival.ID, VenueID: venueID, LocalDate: festivalDate, StartTime: &slot},
+			Series:     festival,
+			Performers: []*entity.Artist{{ID: artistID}},
+		}
+	}
+	assertShared := func(t *testing.T, artistA, artistB string) {
+		t.Helper()
+		gotA, err := concertRepo.ListByArtist(ctx, artistA, false)
+		require.NoError(t, err)
+		require.Len(t, gotA, 1)
+		gotB, err := concertRepo.ListByArtist(ctx, artistB, false)
+		require.NoError(t, err)
+		require.Len(t, gotB, 1)
+		assert.Equal(t, gotA[0].ID, gotB[0].ID, "both artists reference the same festival event")
+		assert.Nil(t, gotA[0].StartTime, "a festival event carries no start time of its own")
+		assert.ElementsMatch(t, []string{artistA, artistB}, gotA[0].PerformerIDs())
+
+		sets := map[string]time.Time{}
+		rows, err := testDB.Pool.Query(ctx,
+			"SELECT artist_id, set_start_at FROM event_performers WHERE event_id = $1", gotA[0].ID)
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var (
+				artistID string
+				setStart time.Time
+			)
+			require.NoError(t, rows.Scan(&artistID, &setStart))
+			sets[artistID] = setStart
+		}
+		require.NoError(t, rows.Err())
+		assert.True(t, slotA.Equal(sets[artistA]), "artist A keeps its own set time")
+		assert.True(t, slotB.Equal(sets[artistB]), "artist B keeps its own set time")
+	}
+
+	t.Run("second artist's discovery joins the existing festival event", func(t *testing.T) {
+		artistA, artistB, venueID, festival := seed(t)
+
+		ids, err := concertRepo.Create(ctx, appearance(t, artistA, venueID, festival, slotA))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		ids, err = concertRepo.Create(ctx, appearance(t, artistB, venueID, festival, slotB))
+		require.NoError(t, err)
+		require.Len(t, ids, 1, "the new performer link is notifiable")
+
+		assertShared(t, artistA, artistB)
+	})
+
+	t.Run("two appearances in one batch share one event", func(t *testing.T) {
+		artistA, artistB, venueID, festival := seed(t)
+
+		requireCreate(t, ctx, concertRepo,
+			appearance(t, artistA, venueID, festival, slotA),
+			appearance(t, artistB, venueID, festival, slotB),
+		)
+
+		assertShared(t, artistA, artistB)
+	})
+}
+
 // TestConcertRepository_PhysicalNaturalKey verifies the physical-identity
 // natural key (venue_id, local_event_date, start_at): the same physical show
 // collapses to one row regardless of series (so a tour-stop and a co-bill
@@ -777,6 +880,99 @@ func TestConcertRepository_ListedVenueName(t *testing.T) {
 	}
 }
 
+// countQueries returns a context whose database statements are recorded, and
+// a func reporting how many statements ran under it so far. TracedPool opens
+// one span per statement; spans are matched by trace ID so statements issued
+// by parallel tests are not counted.
+func countQueries(t *testing.T, ctx context.Context) (context.Context, func() int) {
+	t.Helper()
+	queryRecorderOnce.Do(func() {
+		queryRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(queryRecorder)))
+	})
+
+	ctx, root := otel.Tracer("rdb_test").Start(ctx, t.Name())
+	t.Cleanup(func() { root.End() })
+	traceID := root.SpanContext().TraceID()
+
+	return ctx, func() int {
+		n := 0
+		for _, s := range queryRecorder.Ended() {
+			if s.SpanContext().TraceID() == traceID && s.Name() != t.Name() {
+				n++
+			}
+		}
+		return n
+	}
+}
+
+var (
+	queryRecorderOnce sync.Once
+	queryRecorder     *tracetest.SpanRecorder
+)
+
+func TestConcertRepository_ListByArtist_SingleVenueJoin(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	artistID := newTestID(t)
+	_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Venue Join Band", MBID: newTestID(t)})
+	require.NoError(t, err)
+
+	// Five concerts at five distinct venues: a per-row venue lookup would show
+	// up as one extra statement per concert.
+	const n = 5
+	date := time.Now().UTC().AddDate(0, 0, 10)
+	venues := make(map[string]*entity.Venue, n)
+	for i := range n {
+		v := &entity.Venue{
+			ID:          newTestID(t),
+			Name:        fmt.Sprintf("Join Hall %d", i),
+			AdminArea:   new("JP-13"),
+			Coordinates: &entity.Coordinates{Latitude: 35.6 + float64(i)/100, Longitude: 139.7},
+		}
+		require.NoError(t, venueRepo.Create(ctx, v))
+		venues[v.ID] = v
+
+		seriesID := seedSeries(t, ctx, seriesRepo, fmt.Sprintf("Join Show %d", i))
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: newTestID(t), SeriesID: seriesID, VenueID: v.ID, LocalDate: date.AddDate(0, 0, i)},
+			Series:     &entity.Series{ID: seriesID, Title: fmt.Sprintf("Join Show %d", i), Type: entity.SeriesTypeSingle},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+	}
+
+	for _, upcomingOnly := range []bool{false, true} {
+		t.Run(fmt.Sprintf("upcomingOnly=%v", upcomingOnly), func(t *testing.T) {
+			qctx, queries := countQueries(t, ctx)
+
+			got, err := concertRepo.ListByArtist(qctx, artistID, upcomingOnly)
+			require.NoError(t, err)
+			require.Len(t, got, n)
+
+			// One statement for events+series+venues, one for performers.
+			assert.Equal(t, 2, queries(), "statement count must not grow with the number of concerts")
+
+			for _, c := range got {
+				want := venues[c.VenueID]
+				require.NotNil(t, c.Venue, "concert %s", c.ID)
+				assert.Equal(t, want.ID, c.Venue.ID)
+				assert.Equal(t, want.Name, c.Venue.Name)
+				assert.Equal(t, want.AdminArea, c.Venue.AdminArea)
+				require.NotNil(t, c.Venue.Coordinates)
+				assert.InDelta(t, want.Coordinates.Latitude, c.Venue.Coordinates.Latitude, 1e-9)
+				assert.InDelta(t, want.Coordinates.Longitude, c.Venue.Coordinates.Longitude, 1e-9)
+				assert.Equal(t, []string{artistID}, c.PerformerIDs())
+			}
+		})
+	}
+}
+
 func TestConcertRepository_ListByArtist(t *testing.T) {
 	ctx := context.Background()
 	concertRepo := rdb.NewConcertRepository(testDB)
@@ -1215,7 +1411,7 @@ func TestConcertRepository_ListByFollower(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		got, err := concertRepo.ListByFollower(ctx, userID)
+		got, _, err := concertRepo.ListByFollower(ctx, userID, 100, "")
 		assert.NoError(t, err)
 		require.Len(t, got, 1, "should only return concerts for followed artists")
 		require.NotNil(t, got[0].Series)
@@ -1263,7 +1459,7 @@ func TestConcertRepository_ListByFollower(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		got, err := concertRepo.ListByFollower(ctx, userID)
+		got, _, err := concertRepo.ListByFollower(ctx, userID, 100, "")
 		require.NoError(t, err)
 		require.Len(t, got, 1)
 		require.NotNil(t, got[0].Venue)
@@ -1282,12 +1478,199 @@ func TestConcertRepository_ListByFollower(t *testing.T) {
 		)
 		require.NoError(t, err)
 
-		got, err := concertRepo.ListByFollower(ctx, userID)
+		got, _, err := concertRepo.ListByFollower(ctx, userID, 100, "")
 		assert.NoError(t, err)
 		assert.Empty(t, got)
 	})
 }
 
+func TestConcertRepository_ListByFollower_Pagination(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	userID := newTestID(t)
+	_, err := testDB.Pool.Exec(ctx,
+		"INSERT INTO users (id, name, email, external_id) VALUES ($1, $2, $3, $4)",
+		userID, "Paging User", "paging@test.com", "ext-user-paging",
+	)
+	require.NoError(t, err)
+
+	artistA, artistB := newTestID(t), newTestID(t)
+	for _, id := range []string{artistA, artistB} {
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: id, Name: "Paging Band " + id, MBID: newTestID(t)})
+		require.NoError(t, err)
+		_, err = testDB.Pool.Exec(ctx,
+			"INSERT INTO followed_artists (user_id, artist_id) VALUES ($1, $2)",
+			userID, id,
+		)
+		require.NoError(t, err)
+	}
+
+	hallA, hallB := newTestID(t), newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: hallA, Name: "Paging Hall A"}))
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: hallB, Name: "Paging Hall B"}))
+
+	base, _ := time.Parse("2006-01-02", "2026-10-01")
+	create := func(venueID string, date time.Time, performers ...string) string {
+		seriesID := seedSeries(t, ctx, seriesRepo, "Paging Tour")
+		id := newTestID(t)
+		artists := make([]*entity.Artist, len(performers))
+		for i, p := range performers {
+			artists[i] = &entity.Artist{ID: p}
+		}
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: id, VenueID: venueID, SeriesID: seriesID, LocalDate: date},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: artists,
+		})
+		return id
+	}
+
+	// Two shows share a date so page boundaries must break the tie on ID, and
+	// one co-headlined show features both followed artists yet is one row.
+	sameDay := []string{create(hallA, base, artistA), create(hallB, base, artistB)}
+	slices.Sort(sameDay)
+	want := slices.Concat(sameDay, []string{
+		create(hallA, base.AddDate(0, 0, 1), artistA, artistB),
+		create(hallA, base.AddDate(0, 0, 2), artistB),
+		create(hallB, base.AddDate(0, 0, 3), artistA),
+	})
+
+	ids := func(concerts []*entity.Concert) []string {
+		out := make([]string, len(concerts))
+		for i, c := range concerts {
+			out[i] = c.ID
+		}
+		return out
+	}
+
+	for _, limit := range []int{1, 2, 3, 5} {
+		t.Run(fmt.Sprintf("pages of %d cover the feed without duplicates or gaps", limit), func(t *testing.T) {
+			var got []string
+			cursor := ""
+			pages := 0
+			for {
+				page, next, err := concertRepo.ListByFollower(ctx, userID, limit, cursor)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page), limit)
+				got = append(got, ids(page)...)
+				pages++
+				if next == "" {
+					break
+				}
+				require.Len(t, page, limit, "only the last page may be short")
+				cursor = next
+			}
+			assert.Equal(t, want, got)
+			assert.Equal(t, (len(want)+limit-1)/limit, pages)
+		})
+	}
+
+	t.Run("rejects a non-positive limit", func(t *testing.T) {
+		_, _, err := concertRepo.ListByFollower(ctx, userID, 0, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("rejects a malformed cursor", func(t *testing.T) {
+		_, _, err := concertRepo.ListByFollower(ctx, userID, 10, "not-a-cursor")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestConcertRepository_ListByVenue(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	aurora, nebula := newTestID(t), newTestID(t)
+	_, err := artistRepo.Create(ctx,
+		&entity.Artist{ID: aurora, Name: "Aurora Lights", MBID: newTestID(t)},
+		&entity.Artist{ID: nebula, Name: "Nebula Band", MBID: newTestID(t)},
+	)
+	require.NoError(t, err)
+
+	hall, elsewhere := newTestID(t), newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: hall, Name: "Venue Hall"}))
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: elsewhere, Name: "Elsewhere Hall"}))
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	create := func(venueID string, date time.Time, performers ...string) string {
+		seriesID := seedSeries(t, ctx, seriesRepo, "Venue Tour")
+		id := newTestID(t)
+		artists := make([]*entity.Artist, len(performers))
+		for i, p := range performers {
+			artists[i] = &entity.Artist{ID: p}
+		}
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: id, VenueID: venueID, SeriesID: seriesID, LocalDate: date},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: artists,
+		})
+		return id
+	}
+
+	past := create(hall, today.AddDate(0, -1, 0), aurora)
+	auroraShow := create(hall, today.AddDate(0, 0, 10), aurora)
+	sharedBill := create(hall, today.AddDate(0, 0, 20), aurora, nebula)
+	nebulaShow := create(hall, today.AddDate(0, 0, 30), nebula)
+	_ = create(elsewhere, today.AddDate(0, 0, 15), aurora)
+	require.NoError(t, concertRepo.SoftDelete(ctx, create(hall, today.AddDate(0, 0, 25), nebula)))
+
+	ids := func(concerts []*entity.Concert) []string {
+		out := make([]string, len(concerts))
+		for i, c := range concerts {
+			out[i] = c.ID
+		}
+		return out
+	}
+
+	t.Run("lists every artist's concerts at the venue with performer names", func(t *testing.T) {
+		got, err := concertRepo.ListByVenue(ctx, hall, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{past, auroraShow, sharedBill, nebulaShow}, ids(got))
+
+		names := make(map[string][]string, len(got))
+		for _, c := range got {
+			require.NotNil(t, c.Venue)
+			assert.Equal(t, hall, c.Venue.ID)
+			for _, p := range c.Performers {
+				names[c.ID] = append(names[c.ID], p.Name)
+			}
+		}
+		assert.Equal(t, []string{"Aurora Lights"}, names[auroraShow])
+		assert.ElementsMatch(t, []string{"Aurora Lights", "Nebula Band"}, names[sharedBill])
+		assert.Equal(t, []string{"Nebula Band"}, names[nebulaShow])
+	})
+
+	t.Run("upcomingOnly hides past concerts", func(t *testing.T) {
+		got, err := concertRepo.ListByVenue(ctx, hall, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{auroraShow, sharedBill, nebulaShow}, ids(got))
+	})
+
+	t.Run("venue without concerts returns empty", func(t *testing.T) {
+		empty := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: empty, Name: "Empty Hall"}))
+		got, err := concertRepo.ListByVenue(ctx, empty, false)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("rejects an empty venue ID", func(t *testing.T) {
+		_, err := concertRepo.ListByVenue(ctx, "", false)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
 func TestConcertRepository_List(t *testing.T) {
 	ctx := context.Background()
 	concertRepo := rdb.NewConcertRepository(testDB)
@@ -1401,18 +1784,1058 @@ func TestConcertRepository_Delete(t *testing.T) {
 	})
 }
 
-// countRows returns the number of rows in the given table referencing eventID
-// via its event_id / id column. Used to assert ON DELETE CASCADE behaviour.
-func countRows(t *testing.T, ctx context.Context, table, eventID string) int {
-	t.Helper()
-	col := "event_id"
-	if table == "events" {
-		col = "id"
+func TestConcertRepository_Reschedule(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	oldDate, _ := time.Parse("2006-01-02", "2026-10-10")
+	newDate, _ := time.Parse("2006-01-02", "2026-12-05")
+
+	t.Run("moves the event and records the previous date", func(t *testing.T) {
+		cleanDatabase(t)
+
+		artistID := newTestID(t)
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Reschedule Test Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Reschedule Test Hall"}))
+		seriesID := seedSeries(t, ctx, seriesRepo, "Reschedule Test Concert")
+
+		oldStart := time.Date(2026, 10, 10, 9, 0, 0, 0, time.UTC)
+		eventID := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: eventID, VenueID: venueID, SeriesID: seriesID, LocalDate: oldDate, StartTime: &oldStart},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+
+		require.NoError(t, concertRepo.Reschedule(ctx, eventID, newDate, nil, nil))
+
+		var (
+			gotDate, gotPrev time.Time
+			gotStart         *time.Time
+		)
+		err = testDB.Pool.QueryRow(ctx,
+			"SELECT local_event_date, previous_local_event_date, start_at FROM events WHERE id = $1", eventID,
+		).Scan(&gotDate, &gotPrev, &gotStart)
+		require.NoError(t, err)
+		assert.True(t, newDate.Equal(gotDate), "event moved to the new date")
+		assert.True(t, oldDate.Equal(gotPrev), "old date recorded")
+		assert.Nil(t, gotStart, "start time for the old date cleared")
+	})
+
+	t.Run("returns NotFound for a missing id", func(t *testing.T) {
+		cleanDatabase(t)
+		err := concertRepo.Reschedule(ctx, newTestID(t), newDate, nil, nil)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("returns NotFound for a soft-deleted event", func(t *testing.T) {
+		cleanDatabase(t)
+
+		artistID := newTestID(t)
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Reschedule Deleted Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Reschedule Deleted Hall"}))
+		seriesID := seedSeries(t, ctx, seriesRepo, "Reschedule Deleted Concert")
+
+		eventID := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: eventID, VenueID: venueID, SeriesID: seriesID, LocalDate: oldDate},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+		require.NoError(t, concertRepo.SoftDelete(ctx, eventID))
+
+		err = concertRepo.Reschedule(ctx, eventID, newDate, nil, nil)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+}
+
+func TestConcertRepository_Update(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	oldDate, _ := time.Parse("2006-01-02", "2026-10-10")
+	newDate, _ := time.Parse("2006-01-02", "2026-10-11")
+
+	// seed creates one concert and returns it as read back from the repository.
+	seed := func(t *testing.T, start *time.Time) *entity.Concert {
+		t.Helper()
+		cleanDatabase(t)
+		artistID := newTestID(t)
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Update Test Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Update Test Hall"}))
+		seriesID := seedSeries(t, ctx, seriesRepo, "Update Test Concert")
+		listed := "Update Test Hall"
+
+		eventID := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event: entity.Event{
+				ID: eventID, VenueID: venueID, SeriesID: seriesID, LocalDate: oldDate,
+				ListedVenueName: &listed, StartTime: start,
+			},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+		got, err := concertRepo.ListByIDs(ctx, []string{eventID})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		return got[0]
 	}
-	var n int
-	err := testDB.Pool.QueryRow(ctx,
-		"SELECT COUNT(*) FROM "+table+" WHERE "+col+" = $1", eventID,
-	).Scan(&n)
-	require.NoError(t, err)
-	return n
+
+	t.Run("overwrites event and series fields but keeps the lineup", func(t *testing.T) {
+		c := seed(t, nil)
+		performers := c.PerformerIDs()
+
+		start := time.Date(2026, 10, 11, 9, 0, 0, 0, time.UTC)
+		open := time.Date(2026, 10, 11, 8, 0, 0, 0, time.UTC)
+		listed := "Update Test Hall (Main Stage)"
+		c.Series.Title = "Corrected Title"
+		c.Series.SourceURL = "https://example.com/corrected"
+		c.LocalDate = newDate
+		c.StartTime = &start
+		c.OpenTime = &open
+		c.ListedVenueName = &listed
+		c.Performers = []*entity.Artist{{ID: newTestID(t)}}
+
+		require.NoError(t, concertRepo.Update(ctx, c))
+
+		got, err := concertRepo.ListByIDs(ctx, []string{c.ID})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "Corrected Title", got[0].Series.Title)
+		assert.Equal(t, "https://example.com/corrected", got[0].Series.SourceURL)
+		assert.True(t, newDate.Equal(got[0].LocalDate))
+		require.NotNil(t, got[0].StartTime)
+		assert.True(t, start.Equal(*got[0].StartTime))
+		require.NotNil(t, got[0].OpenTime)
+		assert.True(t, open.Equal(*got[0].OpenTime))
+		require.NotNil(t, got[0].ListedVenueName)
+		assert.Equal(t, listed, *got[0].ListedVenueName)
+		assert.Equal(t, performers, got[0].PerformerIDs(), "artist linkage is never changed")
+	})
+
+	t.Run("clears a known start time to NULL", func(t *testing.T) {
+		start := time.Date(2026, 10, 10, 9, 0, 0, 0, time.UTC)
+		c := seed(t, &start)
+		require.NotNil(t, c.StartTime)
+
+		c.StartTime = nil
+		require.NoError(t, concertRepo.Update(ctx, c))
+
+		var gotStart *time.Time
+		err := testDB.Pool.QueryRow(ctx, "SELECT start_at FROM events WHERE id = $1", c.ID).Scan(&gotStart)
+		require.NoError(t, err)
+		assert.Nil(t, gotStart)
+	})
+
+	t.Run("returns NotFound for a missing id", func(t *testing.T) {
+		cleanDatabase(t)
+		err := concertRepo.Update(ctx, &entity.Concert{
+			Event:  entity.Event{ID: newTestID(t), LocalDate: newDate},
+			Series: &entity.Series{Title: "Missing"},
+		})
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("returns NotFound for a soft-deleted event and leaves it unchanged", func(t *testing.T) {
+		c := seed(t, nil)
+		require.NoError(t, concertRepo.SoftDelete(ctx, c.ID))
+
+		c.Series.Title = "Corrected Title"
+		c.LocalDate = newDate
+		err := concertRepo.Update(ctx, c)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+
+		var gotDate time.Time
+		var gotTitle string
+		err = testDB.Pool.QueryRow(ctx,
+			"SELECT e.local_event_date, s.title FROM events e JOIN series s ON s.id = e.series_id WHERE e.id = $1", c.ID,
+		).Scan(&gotDate, &gotTitle)
+		require.NoError(t, err)
+		assert.True(t, oldDate.Equal(gotDate))
+		assert.Equal(t, "Update Test Concert", gotTitle)
+	})
+
+	t.Run("series fields change for every sibling event", func(t *testing.T) {
+		c := seed(t, nil)
+		siblingID := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: siblingID, VenueID: c.VenueID, SeriesID: c.SeriesID, LocalDate: newDate.AddDate(0, 0, 1)},
+			Series:     &entity.Series{ID: c.SeriesID},
+			Performers: []*entity.Artist{{ID: c.PerformerIDs()[0]}},
+		})
+
+		c.Series.Title = "Corrected Tour Title"
+		c.Series.SourceURL = "https://example.com/corrected-tour"
+		require.NoError(t, concertRepo.Update(ctx, c))
+
+		got, err := concertRepo.ListByIDs(ctx, []string{siblingID})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "Corrected Tour Title", got[0].Series.Title, "the sibling shares the series title")
+		assert.Equal(t, "https://example.com/corrected-tour", got[0].Series.SourceURL)
+		assert.True(t, newDate.AddDate(0, 0, 1).Equal(got[0].LocalDate), "only the updated event's own fields stay per event")
+	})
+}
+
+func TestConcertRepository_SoftDelete(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	// seed publishes two concerts by one followed artist and returns the
+	// artist, the follower, and the event IDs (kept, to-be-deleted).
+	seed := func(t *testing.T) (artistID, userID, keptID, deletedID string) {
+		t.Helper()
+		cleanDatabase(t)
+
+		userID = newTestID(t)
+		_, err := testDB.Pool.Exec(ctx,
+			"INSERT INTO users (id, name, email, external_id) VALUES ($1, $2, $3, $4)",
+			userID, "Soft Delete User", "soft-delete@test.com", "ext-soft-delete",
+		)
+		require.NoError(t, err)
+
+		artistID = newTestID(t)
+		_, err = artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Soft Delete Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		_, err = testDB.Pool.Exec(ctx,
+			"INSERT INTO followed_artists (user_id, artist_id) VALUES ($1, $2)", userID, artistID)
+		require.NoError(t, err)
+
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Soft Delete Hall"}))
+
+		date := time.Now().UTC().AddDate(0, 1, 0).Truncate(24 * time.Hour)
+		keptSeries := seedSeries(t, ctx, seriesRepo, "Real Tour")
+		fakeSeries := seedSeries(t, ctx, seriesRepo, "Hallucinated Tour")
+		keptID, deletedID = newTestID(t), newTestID(t)
+		requireCreate(t, ctx, concertRepo,
+			&entity.Concert{
+				Event:      entity.Event{ID: keptID, VenueID: venueID, SeriesID: keptSeries, LocalDate: date},
+				Series:     &entity.Series{ID: keptSeries},
+				Performers: []*entity.Artist{{ID: artistID}},
+			},
+			&entity.Concert{
+				Event:      entity.Event{ID: deletedID, VenueID: venueID, SeriesID: fakeSeries, LocalDate: date.AddDate(0, 0, 1)},
+				Series:     &entity.Series{ID: fakeSeries},
+				Performers: []*entity.Artist{{ID: artistID}},
+			},
+		)
+		return artistID, userID, keptID, deletedID
+	}
+
+	ids := func(concerts []*entity.Concert) []string {
+		out := make([]string, len(concerts))
+		for i, c := range concerts {
+			out[i] = c.ID
+		}
+		return out
+	}
+
+	t.Run("hides the concert from follower and artist feeds but keeps it in the admin listing", func(t *testing.T) {
+		artistID, userID, keptID, deletedID := seed(t)
+
+		require.NoError(t, concertRepo.SoftDelete(ctx, deletedID))
+
+		feed, _, err := concertRepo.ListByFollower(ctx, userID, 100, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{keptID}, ids(feed))
+
+		byArtist, err := concertRepo.ListByArtist(ctx, artistID, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{keptID}, ids(byArtist))
+
+		upcoming, err := concertRepo.ListByArtist(ctx, artistID, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{keptID}, ids(upcoming))
+
+		byArtists, err := concertRepo.ListByArtists(ctx, []string{artistID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{keptID}, ids(byArtists))
+
+		live, err := concertRepo.ListByArtistAll(ctx, artistID, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{keptID}, ids(live))
+
+		byIDs, err := concertRepo.ListByIDs(ctx, []string{keptID, deletedID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{keptID}, ids(byIDs))
+
+		all, err := concertRepo.ListByArtistAll(ctx, artistID, true)
+		require.NoError(t, err)
+		require.Equal(t, []string{keptID, deletedID}, ids(all))
+		assert.Nil(t, all[0].DeletedAt)
+		assert.NotNil(t, all[1].DeletedAt)
+	})
+
+	t.Run("is idempotent and keeps the first deletion time", func(t *testing.T) {
+		artistID, _, _, deletedID := seed(t)
+
+		require.NoError(t, concertRepo.SoftDelete(ctx, deletedID))
+		var first time.Time
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			"SELECT deleted_at FROM events WHERE id = $1", deletedID).Scan(&first))
+
+		require.NoError(t, concertRepo.SoftDelete(ctx, deletedID))
+
+		all, err := concertRepo.ListByArtistAll(ctx, artistID, true)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		require.NotNil(t, all[1].DeletedAt)
+		assert.True(t, first.Equal(*all[1].DeletedAt))
+	})
+
+	t.Run("returns NotFound for a missing id", func(t *testing.T) {
+		cleanDatabase(t)
+		err := concertRepo.SoftDelete(ctx, newTestID(t))
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("rejects an empty id", func(t *testing.T) {
+		err := concertRepo.SoftDelete(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestConcertRepository_ListBySearchSession(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	concertDate, _ := time.Parse("2006-01-02", "2026-11-20")
+
+	setup := func(t *testing.T) (artistID, venueID, seriesID string) {
+		t.Helper()
+		cleanDatabase(t)
+		artistID = newTestID(t)
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Session Test Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		venueID = newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Session Test Hall"}))
+		seriesID = seedSeries(t, ctx, seriesRepo, "Session Test Concert")
+		return artistID, venueID, seriesID
+	}
+	newConcert := func(t *testing.T, artistID, venueID, seriesID, sessionID string) *entity.Concert {
+		t.Helper()
+		return &entity.Concert{
+			Event: entity.Event{
+				ID: newTestID(t), VenueID: venueID, SeriesID: seriesID, LocalDate: concertDate,
+				SearchSessionID: &sessionID,
+			},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		}
+	}
+
+	t.Run("re-ingesting a session does not double-create", func(t *testing.T) {
+		artistID, venueID, seriesID := setup(t)
+		sessionID := newTestID(t)
+
+		first, err := concertRepo.Create(ctx, newConcert(t, artistID, venueID, seriesID, sessionID))
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+
+		// A redelivered batch mints fresh event UUIDs for the same physical show.
+		again, err := concertRepo.Create(ctx, newConcert(t, artistID, venueID, seriesID, sessionID))
+		require.NoError(t, err)
+		assert.Empty(t, again, "re-ingestion inserts nothing new")
+
+		got, err := concertRepo.ListBySearchSession(ctx, sessionID)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, first[0], got[0].ID)
+		require.NotNil(t, got[0].SearchSessionID)
+		assert.Equal(t, sessionID, *got[0].SearchSessionID)
+		assert.Equal(t, []string{artistID}, got[0].PerformerIDs())
+	})
+
+	t.Run("a later session keeps the original attribution", func(t *testing.T) {
+		artistID, venueID, seriesID := setup(t)
+		firstSession, laterSession := newTestID(t), newTestID(t)
+
+		requireCreate(t, ctx, concertRepo, newConcert(t, artistID, venueID, seriesID, firstSession))
+		requireCreate(t, ctx, concertRepo, newConcert(t, artistID, venueID, seriesID, laterSession))
+
+		got, err := concertRepo.ListBySearchSession(ctx, firstSession)
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+
+		got, err = concertRepo.ListBySearchSession(ctx, laterSession)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("omits soft-deleted concerts", func(t *testing.T) {
+		artistID, venueID, seriesID := setup(t)
+		sessionID := newTestID(t)
+
+		created, err := concertRepo.Create(ctx, newConcert(t, artistID, venueID, seriesID, sessionID))
+		require.NoError(t, err)
+		require.Len(t, created, 1)
+		require.NoError(t, concertRepo.SoftDelete(ctx, created[0]))
+
+		got, err := concertRepo.ListBySearchSession(ctx, sessionID)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("returns InvalidArgument for an empty session ID", func(t *testing.T) {
+		_, err := concertRepo.ListBySearchSession(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestConcertRepository_PendingVenue(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	concertDate, _ := time.Parse("2006-01-02", "2026-12-05")
+
+	setup := func(t *testing.T) (artistID, seriesID string) {
+		t.Helper()
+		cleanDatabase(t)
+		artistID = newTestID(t)
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Pending Venue Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		seriesID = seedSeries(t, ctx, seriesRepo, "Pending Venue Concert")
+		return artistID, seriesID
+	}
+	newConcert := func(t *testing.T, artistID, seriesID, venueID, listedName string) *entity.Concert {
+		t.Helper()
+		return &entity.Concert{
+			Event: entity.Event{
+				ID: newTestID(t), VenueID: venueID, SeriesID: seriesID, LocalDate: concertDate,
+				ListedVenueName: &listedName,
+			},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		}
+	}
+
+	t.Run("creates and lists a concert without a venue", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+
+		ids, err := concertRepo.Create(ctx, newConcert(t, artistID, seriesID, "", "Unresolved Hall"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		got, err := concertRepo.ListPendingVenue(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, ids[0], got[0].ID)
+		assert.Empty(t, got[0].VenueID)
+		assert.Nil(t, got[0].Venue)
+		require.NotNil(t, got[0].ListedVenueName)
+		assert.Equal(t, "Unresolved Hall", *got[0].ListedVenueName)
+		assert.Equal(t, []string{artistID}, got[0].PerformerIDs())
+
+		// Audience listings join the venue and omit the concert until it is resolved.
+		byArtist, err := concertRepo.ListByArtist(ctx, artistID, false)
+		require.NoError(t, err)
+		assert.Empty(t, byArtist)
+	})
+
+	t.Run("venueless concerts are keyed by listed venue name", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+
+		requireCreate(t, ctx, concertRepo,
+			newConcert(t, artistID, seriesID, "", "Hall A"),
+			newConcert(t, artistID, seriesID, "", "Hall B"),
+		)
+		// A redelivery of the same listed venue, date, and start is deduplicated.
+		again, err := concertRepo.Create(ctx, newConcert(t, artistID, seriesID, "", "Hall A"))
+		require.NoError(t, err)
+		assert.Empty(t, again)
+
+		got, err := concertRepo.ListPendingVenue(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, got, 2, "two listed venues on one date are two events")
+	})
+
+	t.Run("concerts with a resolved venue are not pending", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Resolved Hall"}))
+
+		requireCreate(t, ctx, concertRepo, newConcert(t, artistID, seriesID, venueID, "Resolved Hall"))
+
+		got, err := concertRepo.ListPendingVenue(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("assigning a venue links a pending concert once", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Unresolved Hall"}))
+
+		ids, err := concertRepo.Create(ctx, newConcert(t, artistID, seriesID, "", "Unresolved Hall"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		heldBy, err := concertRepo.AssignVenue(ctx, ids[0], venueID)
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], heldBy)
+
+		pending, err := concertRepo.ListPendingVenue(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+		byArtist, err := concertRepo.ListByArtist(ctx, artistID, false)
+		require.NoError(t, err)
+		require.Len(t, byArtist, 1)
+		assert.Equal(t, venueID, byArtist[0].VenueID)
+		require.NotNil(t, byArtist[0].Venue)
+		assert.Equal(t, "Unresolved Hall", byArtist[0].Venue.Name)
+
+		// A repeated run finds nothing left to link.
+		_, err = concertRepo.AssignVenue(ctx, ids[0], venueID)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	// seedCollision seeds an event at a resolved venue and a pending event of
+	// another artist for the same show under a different listed name.
+	seedCollision := func(t *testing.T) (venueID, existingID, pendingID, otherArtistID string) {
+		t.Helper()
+		artistID, seriesID := setup(t)
+		otherArtistID = newTestID(t)
+		_, err := artistRepo.Create(ctx, &entity.Artist{ID: otherArtistID, Name: "Guest Band", MBID: newTestID(t)})
+		require.NoError(t, err)
+		venueID = newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Resolved Hall"}))
+
+		existing := newConcert(t, artistID, seriesID, venueID, "Resolved Hall")
+		requireCreate(t, ctx, concertRepo, existing)
+		ids, err := concertRepo.Create(ctx, newConcert(t, otherArtistID, seriesID, "", "Resolved Hall (Tokyo)"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+		return venueID, existing.ID, ids[0], otherArtistID
+	}
+
+	t.Run("assigning a venue that already hosts the show merges into it", func(t *testing.T) {
+		venueID, existingID, pendingID, otherArtistID := seedCollision(t)
+
+		heldBy, err := concertRepo.AssignVenue(ctx, pendingID, venueID)
+		require.NoError(t, err)
+		assert.Equal(t, existingID, heldBy)
+
+		pending, err := concertRepo.ListPendingVenue(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pending, "the merged concert is no longer pending")
+		var deleted bool
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT deleted_at IS NOT NULL FROM events WHERE id = $1`, pendingID).Scan(&deleted))
+		assert.True(t, deleted, "the pending event is soft-deleted")
+		got, err := concertRepo.ListByIDs(ctx, []string{existingID})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Contains(t, got[0].PerformerIDs(), otherArtistID, "the pending event's performers move to the existing event")
+	})
+
+	t.Run("refuses to merge a pending event with used nullifiers", func(t *testing.T) {
+		venueID, _, pendingID, _ := seedCollision(t)
+		_, err := testDB.Pool.Exec(ctx,
+			`INSERT INTO nullifiers (event_id, nullifier_hash) VALUES ($1, $2)`, pendingID, make([]byte, 32))
+		require.NoError(t, err)
+
+		_, err = concertRepo.AssignVenue(ctx, pendingID, venueID)
+		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+
+		pending, err := concertRepo.ListPendingVenue(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, pending, 1, "the refused concert stays pending")
+	})
+
+	t.Run("assigning an unknown venue fails", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+
+		ids, err := concertRepo.Create(ctx, newConcert(t, artistID, seriesID, "", "Unresolved Hall"))
+		require.NoError(t, err)
+		require.Len(t, ids, 1)
+
+		_, err = concertRepo.AssignVenue(ctx, ids[0], newTestID(t))
+		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+	})
+
+	t.Run("lists up to limit, least recently attempted first", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+
+		a := newConcert(t, artistID, seriesID, "", "Hall A")
+		b := newConcert(t, artistID, seriesID, "", "Hall B")
+		c := newConcert(t, artistID, seriesID, "", "Hall C")
+		requireCreate(t, ctx, concertRepo, a, b, c)
+		require.NoError(t, concertRepo.MarkVenueResolutionAttempted(ctx, []string{a.ID}))
+		require.NoError(t, concertRepo.MarkVenueResolutionAttempted(ctx, []string{b.ID}))
+
+		got, err := concertRepo.ListPendingVenue(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, c.ID, got[0].ID, "a never-attempted concert comes first")
+		assert.Equal(t, a.ID, got[1].ID)
+
+		_, err = concertRepo.ListPendingVenue(ctx, 0)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("rejects a concert with neither venue nor listed venue name", func(t *testing.T) {
+		artistID, seriesID := setup(t)
+
+		_, err := concertRepo.Create(ctx, newConcert(t, artistID, seriesID, "", ""))
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+// countRows returns the number of rows in the given table referencing eventID
+// via its event_id / id column. Used to assert ON DELETE CASCADE behaviour.
+func countRows(t *testing.T, ctx context.Context, table, eventID string) int {
+	t.Helper()
+	col := "event_id"
+	if table == "events" {
+		col = "id"
+	}
+	var n int
+	err := testDB.Pool.QueryRow(ctx,
+		"SELECT COUNT(*) FROM "+table+" WHERE "+col+" = $1", eventID,
+	).Scan(&n)
+	require.NoError(t, err)
+	return n
+}
+
+func TestConcertRepository_ExistsBySource(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	artistID := newTestID(t)
+	_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Source URL Band", MBID: newTestID(t)})
+	require.NoError(t, err)
+	otherArtistID := newTestID(t)
+	_, err = artistRepo.Create(ctx, &entity.Artist{ID: otherArtistID, Name: "Other Band", MBID: newTestID(t)})
+	require.NoError(t, err)
+
+	venueID := newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Source URL Hall", ListedVenueName: new("SOURCE URL HALL")}))
+
+	seriesID := newTestID(t)
+	_, err = seriesRepo.Create(ctx, &entity.Series{
+		ID:        seriesID,
+		Title:     "Source URL Tour",
+		Type:      entity.SeriesTypeTour,
+		SourceURL: "https://example.com/tour/?utm_source=x#tickets",
+	})
+	require.NoError(t, err)
+	date := time.Now().UTC().AddDate(0, 1, 0).Truncate(24 * time.Hour)
+	deletedDate := date.AddDate(0, 0, 1)
+	deletedID := newTestID(t)
+	requireCreate(t, ctx, concertRepo,
+		&entity.Concert{
+			Event:      entity.Event{ID: newTestID(t), VenueID: venueID, SeriesID: seriesID, LocalDate: date, ListedVenueName: new("Source URL Hall Tokyo")},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		},
+		&entity.Concert{
+			Event:      entity.Event{ID: deletedID, VenueID: venueID, SeriesID: seriesID, LocalDate: deletedDate},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		},
+	)
+	require.NoError(t, concertRepo.SoftDelete(ctx, deletedID))
+
+	const url = "https://example.com/tour"
+	tests := []struct {
+		name      string
+		artistID  string
+		sourceURL string
+		date      time.Time
+		venue     string
+		want      bool
+		wantErr   error
+	}{
+		{name: "match on the event's listed venue name", artistID: artistID, sourceURL: url, date: date, venue: "Source URL Hall Tokyo", want: true},
+		{name: "match on the venue's canonical name", artistID: artistID, sourceURL: url, date: date, venue: "Source URL Hall", want: true},
+		{name: "match on the venue's listed name", artistID: artistID, sourceURL: url, date: date, venue: "SOURCE URL HALL", want: true},
+		{name: "match after normalizing", artistID: artistID, sourceURL: "https://example.com/tour/", date: date, venue: "Source URL Hall", want: true},
+		{name: "different path", artistID: artistID, sourceURL: "https://example.com/tour/osaka", date: date, venue: "Source URL Hall", want: false},
+		{name: "different date", artistID: artistID, sourceURL: url, date: date.AddDate(0, 0, 7), venue: "Source URL Hall", want: false},
+		{name: "different venue", artistID: artistID, sourceURL: url, date: date, venue: "Other Hall", want: false},
+		{name: "soft-deleted concert", artistID: artistID, sourceURL: url, date: deletedDate, venue: "Source URL Hall", want: false},
+		{name: "other artist", artistID: otherArtistID, sourceURL: url, date: date, venue: "Source URL Hall", want: false},
+		{name: "empty URL never matches", artistID: artistID, sourceURL: "", date: date, venue: "Source URL Hall", want: false},
+		{name: "empty artist ID", artistID: "", sourceURL: url, date: date, venue: "Source URL Hall", wantErr: apperr.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := concertRepo.ExistsBySource(ctx, tt.artistID, tt.sourceURL, tt.date, tt.venue)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConcertRepository_ListUpcomingGlobal(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	artistID := newTestID(t)
+	_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Explore Band", MBID: newTestID(t)})
+	require.NoError(t, err)
+
+	tokyo, osaka := "JP-13", "JP-27"
+	tokyoVenue, osakaVenue := newTestID(t), newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: tokyoVenue, Name: "Tokyo Hall", AdminArea: &tokyo}))
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: osakaVenue, Name: "Osaka Hall", AdminArea: &osaka}))
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	create := func(venueID string, date time.Time, start *time.Time) string {
+		seriesID := seedSeries(t, ctx, seriesRepo, "Explore Tour")
+		id := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: id, VenueID: venueID, SeriesID: seriesID, LocalDate: date, StartTime: start},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+		return id
+	}
+
+	// Two Tokyo shows share a date so the page boundary has to break the tie
+	// on ID; they are a matinee and an evening show, as the natural key
+	// would otherwise collapse them into one event. The past and
+	// soft-deleted shows never appear.
+	sharedDate := today.AddDate(0, 0, 10)
+	matinee, evening := sharedDate.Add(13*time.Hour), sharedDate.Add(18*time.Hour)
+	tokyo1 := create(tokyoVenue, sharedDate, &matinee)
+	tokyo2 := create(tokyoVenue, sharedDate, &evening)
+	osaka1 := create(osakaVenue, today.AddDate(0, 0, 20), nil)
+	tokyo3 := create(tokyoVenue, today.AddDate(0, 0, 30), nil)
+	_ = create(tokyoVenue, today.AddDate(0, 0, -5), nil)
+	require.NoError(t, concertRepo.SoftDelete(ctx, create(osakaVenue, today.AddDate(0, 0, 15), nil)))
+
+	sameDay := []string{tokyo1, tokyo2}
+	slices.Sort(sameDay)
+	allUpcoming := slices.Concat(sameDay, []string{osaka1, tokyo3})
+
+	ids := func(concerts []*entity.Concert) []string {
+		out := make([]string, len(concerts))
+		for i, c := range concerts {
+			out[i] = c.ID
+		}
+		return out
+	}
+
+	t.Run("pages through every upcoming concert in date order", func(t *testing.T) {
+		var got []string
+		cursor := ""
+		pages := 0
+		for {
+			page, next, err := concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{}, 3, cursor)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page), 3)
+			for _, c := range page {
+				require.Len(t, c.Performers, 1)
+				assert.Equal(t, artistID, c.Performers[0].ID)
+			}
+			got = append(got, ids(page)...)
+			pages++
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		assert.Equal(t, allUpcoming, got)
+		assert.Equal(t, 2, pages)
+	})
+
+	t.Run("page boundary inside a shared date", func(t *testing.T) {
+		first, next, err := concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{}, 1, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, next)
+		second, _, err := concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{}, 1, next)
+		require.NoError(t, err)
+		assert.Equal(t, sameDay, append(ids(first), ids(second)...))
+	})
+
+	t.Run("filters by admin area", func(t *testing.T) {
+		page, next, err := concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{AdminArea: tokyo}, 10, "")
+		require.NoError(t, err)
+		assert.Empty(t, next)
+		assert.Equal(t, slices.Concat(sameDay, []string{tokyo3}), ids(page))
+
+		page, _, err = concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{AdminArea: osaka}, 10, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{osaka1}, ids(page))
+	})
+
+	t.Run("rejects a non-positive limit", func(t *testing.T) {
+		_, _, err := concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{}, 0, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("rejects a malformed cursor", func(t *testing.T) {
+		_, _, err := concertRepo.ListUpcomingGlobal(ctx, entity.UpcomingConcertFilter{}, 10, "not-a-cursor")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestConcertRepository_Search(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	aurora, nebula := newTestID(t), newTestID(t)
+	_, err := artistRepo.Create(ctx,
+		&entity.Artist{ID: aurora, Name: "Aurora Lights", MBID: newTestID(t)},
+		&entity.Artist{ID: nebula, Name: "Nebula_Band", MBID: newTestID(t)},
+	)
+	require.NoError(t, err)
+
+	budokan, zepp := newTestID(t), newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: budokan, Name: "Nippon Budokan"}))
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: zepp, Name: "Zepp Haneda"}))
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	create := func(title, venueID, artistID string, date time.Time) string {
+		seriesID := seedSeries(t, ctx, seriesRepo, title)
+		id := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: id, VenueID: venueID, SeriesID: seriesID, LocalDate: date},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+		return id
+	}
+
+	winterTour := create("Winter Arena Tour", zepp, aurora, today.AddDate(0, 0, 20))
+	budokanShow := create("One Night Only", budokan, nebula, today.AddDate(0, 0, 10))
+	auroraBudokan := create("Spring Live", budokan, aurora, today.AddDate(0, 0, 5))
+	require.NoError(t, concertRepo.SoftDelete(ctx, create("Winter Arena Tour", zepp, nebula, today.AddDate(0, 0, 1))))
+	// A past concert is not searched.
+	create("Winter Arena Tour", budokan, aurora, today.AddDate(0, 0, -30))
+
+	ids := func(concerts []*entity.Concert) []string {
+		out := make([]string, len(concerts))
+		for i, c := range concerts {
+			out[i] = c.ID
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{name: "matches the event title", query: "arena", limit: 10, want: []string{winterTour}},
+		{name: "matches the venue name", query: "budokan", limit: 10, want: []string{auroraBudokan, budokanShow}},
+		{name: "matches a performer name", query: "AURORA", limit: 10, want: []string{auroraBudokan, winterTour}},
+		{name: "trims surrounding whitespace", query: "  haneda ", limit: 10, want: []string{winterTour}},
+		{name: "treats LIKE wildcards literally", query: "_", limit: 10, want: []string{budokanShow}},
+		{name: "percent sign matches nothing", query: "%", limit: 10, want: []string{}},
+		{name: "limit caps results in date order", query: "budokan", limit: 1, want: []string{auroraBudokan}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := concertRepo.Search(ctx, tt.query, tt.limit)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(got))
+		})
+	}
+
+	t.Run("hydrates performers", func(t *testing.T) {
+		got, err := concertRepo.Search(ctx, "spring live", 10)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Len(t, got[0].Performers, 1)
+		assert.Equal(t, aurora, got[0].Performers[0].ID)
+		assert.Equal(t, "Nippon Budokan", got[0].Venue.Name)
+	})
+
+	t.Run("rejects a blank query", func(t *testing.T) {
+		_, err := concertRepo.Search(ctx, "   ", 10)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("rejects a non-positive limit", func(t *testing.T) {
+		_, err := concertRepo.Search(ctx, "aurora", 0)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestConcertRepository_Search_Ranking(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	artistID := newTestID(t)
+	_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Starlight Parade", MBID: newTestID(t)})
+	require.NoError(t, err)
+
+	starlightHall, budokan, plainHall := newTestID(t), newTestID(t), newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: starlightHall, Name: "Starlight Hall"}))
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: budokan, Name: "日本武道館"}))
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: plainHall, Name: "Plain Hall"}))
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	create := func(title, venueID string, date time.Time) string {
+		seriesID := seedSeries(t, ctx, seriesRepo, title)
+		id := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: id, VenueID: venueID, SeriesID: seriesID, LocalDate: date},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+		return id
+	}
+
+	// Dates run against relevance, so the order below can only come from
+	// ranking: a title match, then a venue match, then the performer-only
+	// substring matches in date order.
+	performerOnly := create("Autumn Live", plainHall, today.AddDate(0, 0, 1))
+	venueMatch := create("Winter Live", starlightHall, today.AddDate(0, 0, 2))
+	titleMatch := create("Starlight Arena Tour", plainHall, today.AddDate(0, 0, 3))
+	budokanShow := create("ワンマンライブ", budokan, today.AddDate(0, 0, 4))
+
+	ids := func(concerts []*entity.Concert) []string {
+		out := make([]string, len(concerts))
+		for i, c := range concerts {
+			out[i] = c.ID
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "ranks title above venue above substring matches", query: "starlight", want: []string{titleMatch, venueMatch, performerOnly, budokanShow}},
+		{name: "matches an exact title", query: "Starlight Arena Tour", want: []string{titleMatch}},
+		{name: "matches word prefixes of a venue name", query: "starl hal", want: []string{venueMatch}},
+		{name: "matches part of an unspaced Japanese venue name", query: "武道館", want: []string{budokanShow}},
+		{name: "matches part of a word", query: "ight ha", want: []string{venueMatch}},
+		{name: "strips tsquery operators", query: "arena & !tour", want: []string{titleMatch}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := concertRepo.Search(ctx, tt.query, 10)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(got))
+		})
+	}
+
+	t.Run("limit keeps the best ranked", func(t *testing.T) {
+		got, err := concertRepo.Search(ctx, "starlight", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{titleMatch}, ids(got))
+	})
+}
+
+func TestConcertRepository_Create_Chunked(t *testing.T) {
+	ctx := context.Background()
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	cleanDatabase(t)
+
+	artistID := newTestID(t)
+	_, err := artistRepo.Create(ctx, &entity.Artist{ID: artistID, Name: "Chunk Band", MBID: newTestID(t)})
+	require.NoError(t, err)
+	venueID := newTestID(t)
+	require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Chunk Hall"}))
+
+	// batch returns concerts on consecutive dates so every row has its own
+	// natural key and none is merged before insert.
+	batch := func(seriesID string, n int, from time.Time) []*entity.Concert {
+		concerts := make([]*entity.Concert, n)
+		for i := range concerts {
+			concerts[i] = &entity.Concert{
+				Event:      entity.Event{ID: newTestID(t), VenueID: venueID, SeriesID: seriesID, LocalDate: from.AddDate(0, 0, i)},
+				Series:     &entity.Series{ID: seriesID},
+				Performers: []*entity.Artist{{ID: artistID}},
+			}
+		}
+		return concerts
+	}
+	countRows := func(t *testing.T, query, seriesID string) int {
+		t.Helper()
+		var n int
+		require.NoError(t, testDB.Pool.QueryRow(ctx, query, seriesID).Scan(&n))
+		return n
+	}
+	const (
+		countEvents     = `SELECT count(*) FROM events WHERE series_id = $1`
+		countConcerts   = `SELECT count(*) FROM concerts c JOIN events e ON e.id = c.event_id WHERE e.series_id = $1`
+		countPerformers = `SELECT count(*) FROM event_performers ep JOIN events e ON e.id = ep.event_id WHERE e.series_id = $1`
+	)
+	size := rdb.CreateChunkSize + 1
+
+	t.Run("persists every row of a batch larger than one chunk", func(t *testing.T) {
+		seriesID := seedSeries(t, ctx, seriesRepo, "Chunked Tour")
+		concerts := batch(seriesID, size, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		ids, err := concertRepo.Create(ctx, concerts...)
+		require.NoError(t, err)
+
+		assert.Len(t, ids, size)
+		assert.Equal(t, size, countRows(t, countEvents, seriesID))
+		assert.Equal(t, size, countRows(t, countConcerts, seriesID))
+		assert.Equal(t, size, countRows(t, countPerformers, seriesID))
+	})
+
+	t.Run("a failure in a later chunk rolls back earlier chunks", func(t *testing.T) {
+		seriesID := seedSeries(t, ctx, seriesRepo, "Doomed Tour")
+		concerts := batch(seriesID, size, time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC))
+		// The last row lands in the second chunk and violates the series FK.
+		missingSeries := newTestID(t)
+		concerts[size-1].SeriesID = missingSeries
+		concerts[size-1].Series = &entity.Series{ID: missingSeries}
+
+		_, err := concertRepo.Create(ctx, concerts...)
+		require.Error(t, err)
+
+		assert.Zero(t, countRows(t, countEvents, seriesID), "first chunk must not persist")
+	})
 }
@@ -0,0 +1,42 @@
+package rdb
+
+import (
+	"context"
+)
+
+// txContextKey is the context key of the transaction bound by RunInTx.
+type txContextKey struct{}
+
+// contextTx returns the transaction bound to ctx by RunInTx, if any. It is
+// always a TracedTx, so statements routed to it keep the pool's per-query
+// timeout, slow-query log, and spans.
+func contextTx(ctx context.Context) (*TracedTx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*TracedTx)
+	return tx, ok
+}
+
+// RunInTx runs fn in a transaction bound to the context passed to fn.
+// Statements issued through db.Pool with that context, including those of
+// repositories sharing db, run in the transaction, and transactions they begin
+// are savepoints within it. The transaction commits when fn returns nil and
+// rolls back when fn returns an error or panics. Called with a context that
+// already carries a transaction, RunInTx nests a savepoint.
+//
+// A transaction has a single connection: fn must not use the context from
+// concurrent goroutines.
+func (d *Database) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := d.Pool.beginTx(ctx)
+	if err != nil {
+		return toAppErr(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return toAppErr(err, "failed to commit transaction")
+	}
+	return nil
+}
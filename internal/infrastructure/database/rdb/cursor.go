@@ -0,0 +1,71 @@
+package rdb
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// encodeCursor turns the ID of the last row on a page into the opaque token
+// a caller passes back to fetch the next page. Keyset pagination resumes
+// strictly after that ID, so the token stays valid across concurrent inserts.
+func encodeCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+// decodeCursor reverses encodeCursor. An empty cursor means "first page" and
+// decodes to nil, which the keyset queries treat as "no lower bound".
+func decodeCursor(cursor string) (*string, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || uuid.Validate(string(raw)) != nil {
+		return nil, apperr.New(codes.InvalidArgument, "malformed page cursor")
+	}
+	id := string(raw)
+	return &id, nil
+}
+
+// trimPage drops the look-ahead row a keyset query fetches with
+// LIMIT limit+1 and returns the cursor for the next page. The cursor is
+// empty when rows held no more than limit entries, i.e. this is the last page.
+func trimPage[T any](rows []*T, limit int, id func(*T) string) ([]*T, string) {
+	if len(rows) <= limit {
+		return rows, ""
+	}
+	rows = rows[:limit]
+	return rows, encodeCursor(id(rows[limit-1]))
+}
+
+// dateCursorKey is the keyset position of a row ordered by (date, id). It is
+// passed to trimPage as the row key, so encodeCursor wraps it like a plain ID.
+func dateCursorKey(date time.Time, id string) string {
+	return date.Format(time.DateOnly) + "|" + id
+}
+
+// decodeDateCursor reverses encodeCursor over a dateCursorKey. An empty
+// cursor decodes to nil bounds, meaning "first page".
+func decodeDateCursor(cursor string) (*time.Time, *string, error) {
+	if cursor == "" {
+		return nil, nil, nil
+	}
+	malformed := apperr.New(codes.InvalidArgument, "malformed page cursor")
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, malformed
+	}
+	datePart, id, ok := strings.Cut(string(raw), "|")
+	if !ok || uuid.Validate(id) != nil {
+		return nil, nil, malformed
+	}
+	date, err := time.Parse(time.DateOnly, datePart)
+	if err != nil {
+		return nil, nil, malformed
+	}
+	return &date, &id, nil
+}
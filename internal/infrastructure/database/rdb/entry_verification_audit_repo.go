@@ -0,0 +1,61 @@
+package rdb
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// EntryVerificationAuditRepository implements
+// entity.EntryVerificationAuditRepository for PostgreSQL.
+type EntryVerificationAuditRepository struct {
+	db *Database
+}
+
+// Compile-time interface compliance check.
+var _ entity.EntryVerificationAuditRepository = (*EntryVerificationAuditRepository)(nil)
+
+const insertEntryVerificationAuditQuery = `
+	INSERT INTO entry_verification_audit (id, event_id, nullifier_hash, outcome, reason, verified_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+`
+
+// NewEntryVerificationAuditRepository creates a new
+// EntryVerificationAuditRepository instance.
+func NewEntryVerificationAuditRepository(db *Database) *EntryVerificationAuditRepository {
+	return &EntryVerificationAuditRepository{db: db}
+}
+
+// Append inserts a new audit record.
+func (r *EntryVerificationAuditRepository) Append(ctx context.Context, audit *entity.EntryVerificationAudit) error {
+	if audit.EventID == "" {
+		return apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+	// A rejected attempt whose signals could not be parsed has no nullifier
+	// hash; it is stored as NULL.
+	var nullifierHash []byte
+	if len(audit.NullifierHash) > 0 {
+		nullifierHash = audit.NullifierHash
+	} else if audit.Outcome != entity.EntryVerificationRejected {
+		return apperr.New(codes.InvalidArgument, "nullifier hash cannot be empty for a verified entry")
+	}
+
+	_, err := r.db.Pool.Exec(ctx, insertEntryVerificationAuditQuery,
+		audit.ID,
+		audit.EventID,
+		nullifierHash,
+		string(audit.Outcome),
+		string(audit.Reason),
+		audit.VerifyTime,
+	)
+	if err != nil {
+		return toAppErr(err, "failed to append entry verification audit",
+			slog.String("audit_id", audit.ID),
+			slog.String("event_id", audit.EventID),
+		)
+	}
+	return nil
+}
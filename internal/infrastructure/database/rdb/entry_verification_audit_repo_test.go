@@ -0,0 +1,94 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryVerificationAuditRepository_Append(t *testing.T) {
+	repo := rdb.NewEntryVerificationAuditRepository(testDB)
+	ctx := context.Background()
+	// entry_verification_audit.event_id has no FK, so no event seed needed.
+	eventID := uuid.Must(uuid.NewV7()).String()
+	nullifier := make([]byte, 32)
+	nullifier[31] = 1
+	at := time.Date(2026, 6, 15, 18, 30, 0, 0, time.UTC)
+
+	type row struct {
+		outcome string
+		reason  *string
+	}
+	readRow := func(t *testing.T, id string) row {
+		t.Helper()
+		var r row
+		err := testDB.Pool.QueryRow(ctx,
+			`SELECT outcome, reason FROM entry_verification_audit WHERE id = $1`, id,
+		).Scan(&r.outcome, &r.reason)
+		require.NoError(t, err)
+		return r
+	}
+
+	t.Run("verified attempt is stored without a reason", func(t *testing.T) {
+		cleanDatabase(t)
+		audit := entity.NewEntryVerificationAudit(eventID, nullifier, "", at)
+
+		require.NoError(t, repo.Append(ctx, audit))
+
+		got := readRow(t, audit.ID)
+		assert.Equal(t, "verified", got.outcome)
+		assert.Nil(t, got.reason)
+	})
+
+	t.Run("rejected attempt is stored with its reason", func(t *testing.T) {
+		cleanDatabase(t)
+		audit := entity.NewEntryVerificationAudit(eventID, nullifier, entity.EntryRejectionAlreadyCheckedIn, at)
+
+		require.NoError(t, repo.Append(ctx, audit))
+
+		got := readRow(t, audit.ID)
+		assert.Equal(t, "rejected", got.outcome)
+		require.NotNil(t, got.reason)
+		assert.Equal(t, "already_checked_in", *got.reason)
+	})
+
+	t.Run("repeated attempts for one pass are each kept", func(t *testing.T) {
+		cleanDatabase(t)
+		for _, reason := range []entity.EntryRejectionReason{"", entity.EntryRejectionAlreadyCheckedIn} {
+			require.NoError(t, repo.Append(ctx, entity.NewEntryVerificationAudit(eventID, nullifier, reason, at)))
+		}
+
+		var count int
+		err := testDB.Pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM entry_verification_audit WHERE event_id = $1`, eventID,
+		).Scan(&count)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("rejected attempt without a nullifier is stored with a NULL hash", func(t *testing.T) {
+		cleanDatabase(t)
+		audit := entity.NewEntryVerificationAudit(eventID, nil, entity.EntryRejectionMalformedSignals, at)
+
+		require.NoError(t, repo.Append(ctx, audit))
+
+		var hash []byte
+		err := testDB.Pool.QueryRow(ctx,
+			`SELECT nullifier_hash FROM entry_verification_audit WHERE id = $1`, audit.ID,
+		).Scan(&hash)
+		require.NoError(t, err)
+		assert.Nil(t, hash)
+	})
+
+	t.Run("verified attempt without a nullifier returns error", func(t *testing.T) {
+		err := repo.Append(ctx, entity.NewEntryVerificationAudit(eventID, nil, "", at))
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
@@ -3,6 +3,7 @@ package rdb
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
@@ -11,7 +12,8 @@ import (
 
 // EventEntryRepository implements entity.EventRepository for the entry system.
 // This is separate from the concert-related event repository and focuses on
-// entry-specific operations (merkle root, ticket leaf index).
+// entry-specific operations (merkle root, ticket leaf index) and venue-level
+// event listings that are not scoped to an artist.
 type EventEntryRepository struct {
 	db *Database
 }
@@ -29,22 +31,61 @@ const (
 		SELECT merkle_root FROM events WHERE id = $1
 	`
 
+	// updateMerkleRootQuery bumps the root version with every write, so each
+	// root an event is given has its own number.
 	updateMerkleRootQuery = `
-		UPDATE events SET merkle_root = $2 WHERE id = $1
+		UPDATE events SET merkle_root = $2, merkle_root_version = merkle_root_version + 1
+		WHERE id = $1
+		RETURNING merkle_root_version
 	`
 
-	// getTicketLeafIndexQuery returns the 0-based position of a user's ticket
-	// within the ordered set of tickets for an event. This position serves as
-	// the leaf index in the Merkle tree.
+	// getTreeDepthQuery falls back to the height of the stored tree (its root
+	// sits at the maximum depth) for events that predate the
+	// merkle_tree_depth column, and to $2, the circuit depth, when no tree was
+	// built either, so the first build matches the circuit.
+	getTreeDepthQuery = `
+		SELECT COALESCE(
+			e.merkle_tree_depth,
+			(SELECT MAX(mt.depth) FROM merkle_tree mt WHERE mt.event_id = e.id),
+			$2
+		)
+		FROM events e
+		WHERE e.id = $1
+	`
+
+	// getTicketLeafIndexQuery returns the leaf index persisted on a user's
+	// ticket, NULL until a tree build has assigned one.
 	getTicketLeafIndexQuery = `
-		SELECT idx FROM (
-			SELECT id, user_id,
-				ROW_NUMBER() OVER (ORDER BY minted_at ASC, id ASC) - 1 AS idx
-			FROM tickets
-			WHERE event_id = $1
-		) t
-		WHERE t.user_id = $2
-		LIMIT 1
+		SELECT leaf_index
+		FROM tickets
+		WHERE event_id = $1 AND user_id = $2
+	`
+
+	// listEventsByVenueDateRangeQuery selects the same columns as the concert
+	// listing queries so rows scan through scanConcertRow. Soft-deleted events
+	// are excluded.
+	listEventsByVenueDateRangeQuery = `
+		SELECT e.id, e.series_id, e.venue_id, e.listed_venue_name, e.local_event_date, e.start_at, e.open_at, e.search_session_id, e.deleted_at,
+		       s.title, s.type, s.source_url, s.merch_url,
+		       v.id, v.name, v.admin_area, v.latitude, v.longitude
+		FROM events e
+		JOIN series s ON e.series_id = s.id
+		JOIN venues v ON e.venue_id = v.id
+		WHERE e.venue_id = $1
+		AND e.local_event_date BETWEEN $2 AND $3
+		AND e.deleted_at IS NULL
+		ORDER BY e.local_event_date ASC, e.start_at ASC NULLS LAST, e.id ASC
+	`
+
+	// listLineupsByEventIDsQuery returns each event's performers with their
+	// set times, ordered by set time (unknown last) and then artist id for a
+	// stable lineup order.
+	listLineupsByEventIDsQuery = `
+		SELECT ep.event_id, a.id, a.name, a.mbid, ep.set_start_at
+		FROM event_performers ep
+		JOIN artists a ON a.id = ep.artist_id
+		WHERE ep.event_id = ANY($1)
+		ORDER BY ep.event_id, ep.set_start_at ASC NULLS LAST, a.id
 	`
 )
 
@@ -69,28 +110,42 @@ func (r *EventEntryRepository) GetMerkleRoot(ctx context.Context, eventID string
 	return *root, nil
 }
 
-// UpdateMerkleRoot sets the Merkle root for an event.
-func (r *EventEntryRepository) UpdateMerkleRoot(ctx context.Context, eventID string, root []byte) error {
+// UpdateMerkleRoot sets the Merkle root for an event and returns its new
+// root version.
+func (r *EventEntryRepository) UpdateMerkleRoot(ctx context.Context, eventID string, root []byte) (int, error) {
 	if eventID == "" {
-		return apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+		return 0, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
 	}
 
-	tag, err := r.db.Pool.Exec(ctx, updateMerkleRootQuery, eventID, root)
+	var version int
+	err := r.db.Pool.QueryRow(ctx, updateMerkleRootQuery, eventID, root).Scan(&version)
 	if err != nil {
-		return toAppErr(err, "failed to update merkle root",
+		return 0, toAppErr(err, "failed to update merkle root",
 			slog.String("event_id", eventID),
 		)
 	}
 
-	if tag.RowsAffected() == 0 {
-		return apperr.New(codes.NotFound, "event not found")
+	return version, nil
+}
+
+// GetTreeDepth returns the depth of the event's entry Merkle tree.
+func (r *EventEntryRepository) GetTreeDepth(ctx context.Context, eventID string) (int, error) {
+	if eventID == "" {
+		return 0, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
 	}
 
-	return nil
+	var depth int
+	err := r.db.Pool.QueryRow(ctx, getTreeDepthQuery, eventID, entity.DefaultMerkleTreeDepth).Scan(&depth)
+	if err != nil {
+		return 0, toAppErr(err, "failed to get merkle tree depth",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	return depth, nil
 }
 
-// GetTicketLeafIndex returns the leaf index in the Merkle tree for a user's ticket.
-// Returns -1 if the user has no ticket for the event.
+// GetTicketLeafIndex returns the persisted leaf index of a user's ticket.
 func (r *EventEntryRepository) GetTicketLeafIndex(ctx context.Context, eventID, userID string) (int, error) {
 	if eventID == "" {
 		return -1, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
@@ -100,7 +155,7 @@ func (r *EventEntryRepository) GetTicketLeafIndex(ctx context.Context, eventID,
 		return -1, apperr.New(codes.InvalidArgument, "user ID cannot be empty")
 	}
 
-	var idx int
+	var idx *int
 	err := r.db.Pool.QueryRow(ctx, getTicketLeafIndexQuery, eventID, userID).Scan(&idx)
 	if err != nil {
 		return -1, toAppErr(err, "failed to get ticket leaf index",
@@ -108,6 +163,86 @@ func (r *EventEntryRepository) GetTicketLeafIndex(ctx context.Context, eventID,
 			slog.String("user_id", userID),
 		)
 	}
+	if idx == nil {
+		return -1, apperr.New(codes.FailedPrecondition, "ticket has no leaf index until the merkle tree is rebuilt",
+			slog.String("event_id", eventID),
+			slog.String("user_id", userID),
+		)
+	}
+
+	return *idx, nil
+}
+
+// ListByVenueAndDateRange returns the events at a venue within [from, to],
+// each with its series, venue, and full lineup.
+func (r *EventEntryRepository) ListByVenueAndDateRange(ctx context.Context, venueID string, from, to time.Time) ([]*entity.EventLineup, error) {
+	if venueID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+	if to.Before(from) {
+		return nil, apperr.New(codes.InvalidArgument, "date range end is before its start",
+			slog.String("from", from.Format("2006-01-02")),
+			slog.String("to", to.Format("2006-01-02")),
+		)
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listEventsByVenueDateRangeQuery, venueID, from, to)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list events by venue and date range",
+			slog.String("venue_id", venueID),
+		)
+	}
+	defer rows.Close()
+
+	var lineups []*entity.EventLineup
+	byID := make(map[string]*entity.EventLineup)
+	for rows.Next() {
+		c, err := scanConcertRow(rows.Scan, true)
+		if err != nil {
+			return nil, err
+		}
+		l := &entity.EventLineup{Event: c.Event, Series: c.Series}
+		lineups = append(lineups, l)
+		byID[l.ID] = l
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "events by venue/date range iteration ended with error")
+	}
+	rows.Close()
+
+	if len(lineups) == 0 {
+		return lineups, nil
+	}
+
+	ids := make([]string, len(lineups))
+	for i, l := range lineups {
+		ids[i] = l.ID
+	}
+	prows, err := r.db.Pool.Query(ctx, listLineupsByEventIDsQuery, ids)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list lineups for events", slog.Int("count", len(ids)))
+	}
+	defer prows.Close()
+
+	for prows.Next() {
+		var (
+			eventID string
+			artist  entity.Artist
+			p       entity.Performer
+		)
+		if err := prows.Scan(&eventID, &artist.ID, &artist.Name, &artist.MBID, &p.SetStartTime); err != nil {
+			return nil, toAppErr(err, "failed to scan lineup performer")
+		}
+		l, ok := byID[eventID]
+		if !ok {
+			continue
+		}
+		p.Artist = &artist
+		l.Performers = append(l.Performers, &p)
+	}
+	if err := prows.Err(); err != nil {
+		return nil, toAppErr(err, "lineup iteration ended with error")
+	}
 
-	return idx, nil
+	return lineups, nil
 }
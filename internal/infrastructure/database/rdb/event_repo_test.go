@@ -2,20 +2,25 @@ package rdb_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb/rdbtest"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestEventEntryRepository_GetMerkleRoot(t *testing.T) {
-	cleanDatabase(t)
-	repo := rdb.NewEventEntryRepository(testDB)
+	db := beginTestTx(t, testDB)
+	repo := rdb.NewEventEntryRepository(db)
 	ctx := context.Background()
-	eventID := seedMerkleTestData(t)
+	f := rdbtest.New(t, db)
+	eventID := f.Concert().ID
+	unknownID := f.ID()
 
 	t.Run("returns NotFound when merkle root is NULL", func(t *testing.T) {
 		// Event was just created with NULL merkle_root.
@@ -26,7 +31,7 @@ func TestEventEntryRepository_GetMerkleRoot(t *testing.T) {
 	t.Run("returns root after update", func(t *testing.T) {
 		root := []byte("merkle-root-32-bytes-of-data!!")
 
-		err := repo.UpdateMerkleRoot(ctx, eventID, root)
+		_, err := repo.UpdateMerkleRoot(ctx, eventID, root)
 		require.NoError(t, err)
 
 		got, err := repo.GetMerkleRoot(ctx, eventID)
@@ -35,7 +40,7 @@ func TestEventEntryRepository_GetMerkleRoot(t *testing.T) {
 	})
 
 	t.Run("non-existent event returns NotFound", func(t *testing.T) {
-		_, err := repo.GetMerkleRoot(ctx, "018b2f19-e591-7d12-bf9e-000000000000")
+		_, err := repo.GetMerkleRoot(ctx, unknownID)
 		assert.ErrorIs(t, err, apperr.ErrNotFound)
 	})
 
@@ -46,15 +51,18 @@ func TestEventEntryRepository_GetMerkleRoot(t *testing.T) {
 }
 
 func TestEventEntryRepository_UpdateMerkleRoot(t *testing.T) {
-	cleanDatabase(t)
-	repo := rdb.NewEventEntryRepository(testDB)
+	db := beginTestTx(t, testDB)
+	repo := rdb.NewEventEntryRepository(db)
 	ctx := context.Background()
-	eventID := seedMerkleTestData(t)
+	f := rdbtest.New(t, db)
+	eventID := f.Concert().ID
+	unknownID := f.ID()
 
 	t.Run("update merkle root successfully", func(t *testing.T) {
 		root := []byte("new-merkle-root-value")
-		err := repo.UpdateMerkleRoot(ctx, eventID, root)
+		version, err := repo.UpdateMerkleRoot(ctx, eventID, root)
 		require.NoError(t, err)
+		assert.Equal(t, 1, version, "first root is version 1")
 
 		got, err := repo.GetMerkleRoot(ctx, eventID)
 		require.NoError(t, err)
@@ -63,25 +71,41 @@ func TestEventEntryRepository_UpdateMerkleRoot(t *testing.T) {
 
 	t.Run("update replaces existing root", func(t *testing.T) {
 		first := []byte("first-root")
-		err := repo.UpdateMerkleRoot(ctx, eventID, first)
+		firstVersion, err := repo.UpdateMerkleRoot(ctx, eventID, first)
 		require.NoError(t, err)
 
 		second := []byte("second-root")
-		err = repo.UpdateMerkleRoot(ctx, eventID, second)
+		secondVersion, err := repo.UpdateMerkleRoot(ctx, eventID, second)
 		require.NoError(t, err)
+		assert.Equal(t, firstVersion+1, secondVersion)
 
 		got, err := repo.GetMerkleRoot(ctx, eventID)
 		require.NoError(t, err)
 		assert.Equal(t, second, got)
 	})
 
+	t.Run("tree build with root advances the version", func(t *testing.T) {
+		before, err := repo.UpdateMerkleRoot(ctx, eventID, []byte("before-build"))
+		require.NoError(t, err)
+
+		root := testHash32("built-root")
+		nodes := []*entity.MerkleNode{{EventID: eventID, Depth: 0, NodeIndex: 0, Hash: root}}
+		built, err := rdb.NewMerkleTreeRepository(db).StoreBatchWithRoot(ctx, eventID, nodes, root)
+		require.NoError(t, err)
+		assert.Equal(t, before+1, built)
+
+		after, err := repo.UpdateMerkleRoot(ctx, eventID, root)
+		require.NoError(t, err)
+		assert.Equal(t, before+2, after)
+	})
+
 	t.Run("non-existent event returns NotFound", func(t *testing.T) {
-		err := repo.UpdateMerkleRoot(ctx, "018b2f19-e591-7d12-bf9e-000000000000", []byte("root"))
+		_, err := repo.UpdateMerkleRoot(ctx, unknownID, []byte("root"))
 		assert.ErrorIs(t, err, apperr.ErrNotFound)
 	})
 
 	t.Run("empty event ID returns error", func(t *testing.T) {
-		err := repo.UpdateMerkleRoot(ctx, "", []byte("root"))
+		_, err := repo.UpdateMerkleRoot(ctx, "", []byte("root"))
 		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
 	})
 }
@@ -91,13 +115,9 @@ func TestEventEntryRepository_GetTicketLeafIndex(t *testing.T) {
 	repo := rdb.NewEventEntryRepository(testDB)
 	ticketRepo := rdb.NewTicketRepository(testDB)
 	ctx := context.Background()
-	eventID, userID := seedTicketTestData(t)
-
-	// Create a second user.
-	userID2 := seedUser(t, "leaf-index-user2", "leaf-index2@example.com", "018b2f19-e591-7d12-bf9e-f0e74f1b4901")
-
-	// Create a third user.
-	userID3 := seedUser(t, "leaf-index-user3", "leaf-index3@example.com", "018b2f19-e591-7d12-bf9e-f0e74f1b4902")
+	f := rdbtest.New(t, testDB)
+	eventID := f.Concert().ID
+	userID, userID2, userID3 := f.User().ID, f.User().ID, f.User().ID
 
 	// Mint tickets in a specific order: user1 first, then user2, then user3.
 	_, err := ticketRepo.Create(ctx, &entity.NewTicket{EventID: eventID, UserID: userID, TokenID: 1, TxHash: "0x1"})
@@ -107,7 +127,15 @@ func TestEventEntryRepository_GetTicketLeafIndex(t *testing.T) {
 	_, err = ticketRepo.Create(ctx, &entity.NewTicket{EventID: eventID, UserID: userID3, TokenID: 3, TxHash: "0x3"})
 	require.NoError(t, err)
 
-	t.Run("returns correct 0-based index ordered by minted_at", func(t *testing.T) {
+	t.Run("ticket without an assigned index returns FailedPrecondition", func(t *testing.T) {
+		_, err := repo.GetTicketLeafIndex(ctx, eventID, userID)
+		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+	})
+
+	t.Run("returns the persisted index assigned in mint order", func(t *testing.T) {
+		_, err := ticketRepo.AssignLeafIndexes(ctx, eventID)
+		require.NoError(t, err)
+
 		idx, err := repo.GetTicketLeafIndex(ctx, eventID, userID)
 		require.NoError(t, err)
 		assert.Equal(t, 0, idx) // first minted
@@ -121,8 +149,19 @@ func TestEventEntryRepository_GetTicketLeafIndex(t *testing.T) {
 		assert.Equal(t, 2, idx) // third minted
 	})
 
+	t.Run("index is stable after an earlier ticket is deleted", func(t *testing.T) {
+		_, err := testDB.Pool.Exec(ctx, `DELETE FROM tickets WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+		require.NoError(t, err)
+		_, err = ticketRepo.AssignLeafIndexes(ctx, eventID)
+		require.NoError(t, err)
+
+		idx, err := repo.GetTicketLeafIndex(ctx, eventID, userID3)
+		require.NoError(t, err)
+		assert.Equal(t, 2, idx)
+	})
+
 	t.Run("user with no ticket returns NotFound", func(t *testing.T) {
-		_, err := repo.GetTicketLeafIndex(ctx, eventID, "018b2f19-e591-7d12-bf9e-000000000000")
+		_, err := repo.GetTicketLeafIndex(ctx, eventID, f.ID())
 		assert.ErrorIs(t, err, apperr.ErrNotFound)
 	})
 
@@ -136,3 +175,161 @@ func TestEventEntryRepository_GetTicketLeafIndex(t *testing.T) {
 		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
 	})
 }
+
+func TestEventEntryRepository_GetTreeDepth(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewEventEntryRepository(testDB)
+	merkleRepo := rdb.NewMerkleTreeRepository(testDB)
+	ctx := context.Background()
+	f := rdbtest.New(t, testDB)
+
+	artistID := f.Artist().ID
+	venueID := f.Venue().ID
+	newEvent := func(date string) string {
+		d, err := time.Parse("2006-01-02", date)
+		require.NoError(t, err)
+		return f.Concert(rdbtest.AtVenue(venueID), rdbtest.PerformedBy(artistID), rdbtest.On(d)).ID
+	}
+
+	// storeLeafZeroPath stores just the nodes leaf 0's path reads at every
+	// level below depth, plus the root, so a full 2^depth tree is not needed.
+	storeLeafZeroPath := func(t *testing.T, eventID string, depth int) {
+		t.Helper()
+		nodes := []*entity.MerkleNode{{EventID: eventID, Depth: depth, NodeIndex: 0, Hash: testHash32("root")}}
+		for d := range depth {
+			nodes = append(nodes, &entity.MerkleNode{EventID: eventID, Depth: d, NodeIndex: 1, Hash: testHash32(fmt.Sprintf("sib-%d", d))})
+		}
+		require.NoError(t, merkleRepo.StoreBatch(ctx, eventID, nodes))
+	}
+
+	makeLegacy := func(t *testing.T, eventID string) {
+		t.Helper()
+		_, err := testDB.Pool.Exec(ctx, `UPDATE events SET merkle_tree_depth = NULL WHERE id = $1`, eventID)
+		require.NoError(t, err)
+	}
+
+	t.Run("new event defaults to the circuit depth and yields depth-20 paths", func(t *testing.T) {
+		eventID := newEvent("2026-05-01")
+		storeLeafZeroPath(t, eventID, 20)
+
+		depth, err := repo.GetTreeDepth(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 20, depth)
+
+		pathElements, pathIndices, err := merkleRepo.GetPath(ctx, eventID, 0, depth)
+		require.NoError(t, err)
+		assert.Len(t, pathElements, 20)
+		assert.Len(t, pathIndices, 20)
+	})
+
+	t.Run("legacy event without a tree reads as the circuit depth", func(t *testing.T) {
+		eventID := newEvent("2026-05-02")
+		makeLegacy(t, eventID)
+
+		depth, err := repo.GetTreeDepth(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.DefaultMerkleTreeDepth, depth)
+	})
+
+	t.Run("legacy event with a depth-10 tree still yields depth-10 paths", func(t *testing.T) {
+		eventID := newEvent("2026-05-03")
+		makeLegacy(t, eventID)
+		storeLeafZeroPath(t, eventID, 10)
+
+		depth, err := repo.GetTreeDepth(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 10, depth)
+
+		pathElements, _, err := merkleRepo.GetPath(ctx, eventID, 0, depth)
+		require.NoError(t, err)
+		assert.Len(t, pathElements, 10)
+	})
+
+	t.Run("legacy event reads the height of its stored tree", func(t *testing.T) {
+		eventID := newEvent("2026-05-04")
+		makeLegacy(t, eventID)
+		storeLeafZeroPath(t, eventID, 20)
+
+		depth, err := repo.GetTreeDepth(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 20, depth)
+	})
+
+	t.Run("non-existent event returns NotFound", func(t *testing.T) {
+		_, err := repo.GetTreeDepth(ctx, f.ID())
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.GetTreeDepth(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestEventEntryRepository_ListByVenueAndDateRange(t *testing.T) {
+	ctx := context.Background()
+	repo := rdb.NewEventEntryRepository(testDB)
+
+	day1, _ := time.Parse("2006-01-02", "2026-08-22")
+	day2, _ := time.Parse("2006-01-02", "2026-08-23")
+	outside, _ := time.Parse("2006-01-02", "2026-09-30")
+	slotA := time.Date(2026, 8, 22, 6, 30, 0, 0, time.UTC)
+	slotB := time.Date(2026, 8, 22, 3, 0, 0, 0, time.UTC)
+
+	cleanDatabase(t)
+	f := rdbtest.New(t, testDB)
+	named := func(name string) func(*entity.Artist) { return func(a *entity.Artist) { a.Name = name } }
+	artistA := f.Artist(named("Lineup Band A")).ID
+	artistB := f.Artist(named("Lineup Band B")).ID
+	artistC := f.Artist(named("Lineup Band C")).ID
+	grounds := f.Venue(func(v *entity.Venue) { v.Name = "Lineup Festival Grounds" }).ID
+	elsewhere := f.Venue().ID
+
+	festival := f.Series(func(s *entity.Series) { s.Title, s.Type = "Lineup Fes 2026", entity.SeriesTypeFestival })
+	single := f.Series()
+
+	f.Concert(rdbtest.PerformedBy(artistA), rdbtest.AtVenue(grounds), rdbtest.InSeries(festival), rdbtest.On(day1), rdbtest.StartingAt(slotA))
+	f.Concert(rdbtest.PerformedBy(artistB), rdbtest.AtVenue(grounds), rdbtest.InSeries(festival), rdbtest.On(day1), rdbtest.StartingAt(slotB))
+	f.Concert(rdbtest.PerformedBy(artistC), rdbtest.AtVenue(grounds), rdbtest.InSeries(single), rdbtest.On(day2))
+	f.Concert(rdbtest.PerformedBy(artistC), rdbtest.AtVenue(grounds), rdbtest.InSeries(single), rdbtest.On(outside))
+	f.Concert(rdbtest.PerformedBy(artistA), rdbtest.AtVenue(elsewhere), rdbtest.InSeries(single), rdbtest.On(day1))
+
+	t.Run("lists the festival day with its full lineup", func(t *testing.T) {
+		got, err := repo.ListByVenueAndDateRange(ctx, grounds, day1, day2)
+		require.NoError(t, err)
+		require.Len(t, got, 2, "events outside the range or at other venues are excluded")
+
+		fes := got[0]
+		assert.True(t, day1.Equal(fes.LocalDate))
+		require.NotNil(t, fes.Series)
+		assert.Equal(t, "Lineup Fes 2026", fes.Series.Title)
+		require.NotNil(t, fes.Venue)
+		assert.Equal(t, "Lineup Festival Grounds", fes.Venue.Name)
+		require.Len(t, fes.Performers, 2)
+		assert.Equal(t, artistB, fes.Performers[0].Artist.ID, "lineup ordered by set time")
+		assert.Equal(t, "Lineup Band B", fes.Performers[0].Artist.Name)
+		require.NotNil(t, fes.Performers[0].SetStartTime)
+		assert.True(t, slotB.Equal(*fes.Performers[0].SetStartTime))
+		assert.Equal(t, artistA, fes.Performers[1].Artist.ID)
+
+		assert.True(t, day2.Equal(got[1].LocalDate))
+		require.Len(t, got[1].Performers, 1)
+		assert.Nil(t, got[1].Performers[0].SetStartTime)
+	})
+
+	t.Run("empty range returns no events", func(t *testing.T) {
+		got, err := repo.ListByVenueAndDateRange(ctx, elsewhere, day2, day2)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("inverted range returns InvalidArgument", func(t *testing.T) {
+		_, err := repo.ListByVenueAndDateRange(ctx, grounds, day2, day1)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("empty venue ID returns InvalidArgument", func(t *testing.T) {
+		_, err := repo.ListByVenueAndDateRange(ctx, "", day1, day2)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,78 @@
+package rdb_test
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/stretchr/testify/require"
+)
+
+// explainAdvisoryEnv opts into the EXPLAIN index advisory. The advisory is
+// off by default because it asserts on planner output, which is sensitive to
+// the Postgres version and to the statistics of the local test database.
+const explainAdvisoryEnv = "RDB_EXPLAIN_ADVISORY"
+
+// largeTables are the tables expected to grow without bound in production.
+// A hot query that can only reach them through a sequential scan is missing
+// an index.
+var largeTables = []string{"artists", "concerts", "events", "event_performers", "followed_artists"}
+
+var seqScanPattern = regexp.MustCompile(`Seq Scan on (\w+)`)
+
+// requireNoSeqScan plans query with EXPLAIN (GENERIC_PLAN) and fails the test
+// if the plan sequentially scans any of largeTables.
+//
+// The test database holds a handful of rows, where a sequential scan is
+// always the cheapest plan, so the advisory disables enable_seqscan for the
+// planning transaction. The planner then picks an index whenever a usable one
+// exists and falls back to a sequential scan only when none does, which is
+// exactly the missing-index signal this helper looks for.
+func requireNoSeqScan(t *testing.T, query string) {
+	t.Helper()
+	if os.Getenv(explainAdvisoryEnv) == "" {
+		t.Skipf("set %s=1 to run the EXPLAIN index advisory", explainAdvisoryEnv)
+	}
+	if testDB == nil {
+		t.Skip("no local database available")
+	}
+
+	ctx := context.Background()
+	tx, err := testDB.Pool.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, "SET LOCAL enable_seqscan = off")
+	require.NoError(t, err)
+
+	rows, err := tx.Query(ctx, "EXPLAIN (GENERIC_PLAN) "+query)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		var line string
+		require.NoError(t, rows.Scan(&line))
+		plan = append(plan, line)
+	}
+	require.NoError(t, rows.Err())
+
+	for _, line := range plan {
+		m := seqScanPattern.FindStringSubmatch(line)
+		if m != nil && slices.Contains(largeTables, m[1]) {
+			t.Fatalf("sequential scan on %s; the query is likely missing an index:\n%s", m[1], strings.Join(plan, "\n"))
+		}
+	}
+}
+
+func TestExplainAdvisory_ListConcertsByArtist(t *testing.T) {
+	requireNoSeqScan(t, rdb.ListConcertsByArtistQuery)
+}
+
+func TestExplainAdvisory_ListFollowers(t *testing.T) {
+	requireNoSeqScan(t, rdb.FollowListFollowersQuery)
+}
@@ -0,0 +1,41 @@
+package rdb
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+)
+
+// Query constants exposed to the external rdb_test package so the EXPLAIN
+// index advisory can plan the exact statements the repositories issue.
+const (
+	ListConcertsByArtistQuery = listConcertsByArtistQuery
+	FollowListFollowersQuery  = followListFollowersQuery
+)
+
+// CreateChunkSize exposes the per-statement row bound of the bulk Create
+// methods so tests can build a batch that spans chunks.
+const CreateChunkSize = createChunkSize
+
+// NewTxDatabase returns a Database that logs like parent but runs all its
+// statements inside tx, so a test can discard everything it wrote by rolling
+// tx back. Transactions begun on the returned Database, by a repository or a
+// nested test, are savepoints within tx. It shares the single connection of
+// tx and must not be used concurrently.
+func NewTxDatabase(parent *Database, tx pgx.Tx) *Database {
+	return &Database{
+		Pool:   &TracedPool{inner: txPool{tx}, tracer: otel.Tracer(tracerName)},
+		logger: parent.logger,
+	}
+}
+
+// txPool adapts a pgx.Tx to pgxPool. Begin on a pgx.Tx opens a savepoint.
+type txPool struct {
+	pgx.Tx
+}
+
+func (p txPool) Ping(ctx context.Context) error { return p.Conn().Ping(ctx) }
+
+// Close is a no-op; the transaction's owner rolls it back.
+func (txPool) Close() {}
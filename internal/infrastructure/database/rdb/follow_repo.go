@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"log/slog"
 
+	"github.com/google/uuid"
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/pannpers/go-apperr/apperr/codes"
@@ -20,19 +21,45 @@ const (
 	// constant entity.DefaultHype is the source-of-truth on the write path
 	// rather than the DB column DEFAULT. The DB DEFAULT remains as a safety
 	// net for any future code path that omits the column.
+	//
+	// The follow_history row ($4 is its ID) is appended in the same statement
+	// and only when the insert took effect.
 	followInsertQuery = `
-		INSERT INTO followed_artists (user_id, artist_id, hype)
-		VALUES ($1, $2, $3)
-		ON CONFLICT DO NOTHING
+		WITH ins AS (
+			INSERT INTO followed_artists (user_id, artist_id, hype)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+			RETURNING user_id, artist_id, hype
+		)
+		INSERT INTO follow_history (id, user_id, artist_id, action, hype)
+		SELECT $4, user_id, artist_id, 'follow', hype FROM ins
 	`
 	followDeleteQuery = `
-		DELETE FROM followed_artists
-		WHERE user_id = $1 AND artist_id = $2
+		WITH del AS (
+			DELETE FROM followed_artists
+			WHERE user_id = $1 AND artist_id = $2
+			RETURNING user_id, artist_id
+		)
+		INSERT INTO follow_history (id, user_id, artist_id, action)
+		SELECT $3, user_id, artist_id, 'unfollow' FROM del
 	`
+	// followSetHypeQuery updates the follow and appends its history row
+	// ($4 is its ID) only when the hype changes. It reports whether the
+	// follow exists and whether it changed; the final SELECT sees the
+	// statement's snapshot, so the follow is found whether or not it changed.
 	followSetHypeQuery = `
-		UPDATE followed_artists
-		SET hype = $3
-		WHERE user_id = $1 AND artist_id = $2
+		WITH upd AS (
+			UPDATE followed_artists
+			SET hype = $3
+			WHERE user_id = $1 AND artist_id = $2 AND hype <> $3
+			RETURNING user_id, artist_id, hype
+		), hist AS (
+			INSERT INTO follow_history (id, user_id, artist_id, action, hype)
+			SELECT $4, user_id, artist_id, 'hype_change', hype FROM upd
+		)
+		SELECT
+			EXISTS (SELECT 1 FROM followed_artists WHERE user_id = $1 AND artist_id = $2),
+			EXISTS (SELECT 1 FROM upd)
 	`
 	followListByUserQuery = `
 		SELECT a.id, a.name, COALESCE(a.mbid, ''), a.fanart, fa.hype
@@ -45,6 +72,14 @@ const (
 		FROM artists a
 		JOIN followed_artists fa ON a.id = fa.artist_id
 	`
+	followListMostFollowedQuery = `
+		SELECT a.id, a.name, COALESCE(a.mbid, '')
+		FROM artists a
+		JOIN followed_artists fa ON a.id = fa.artist_id
+		GROUP BY a.id
+		ORDER BY COUNT(*) DESC, a.id
+		LIMIT $1
+	`
 	followListFollowersQuery = `
 		SELECT fa.user_id, fa.hype, COALESCE(h.level_1, ''), COALESCE(u.preferred_language, '')
 		FROM followed_artists fa
@@ -52,6 +87,24 @@ const (
 		LEFT JOIN homes h ON h.id = u.home_id
 		WHERE fa.artist_id = $1
 	`
+	// followTopHypeQuery ranks hype in entity.Hype's ascending order of
+	// enthusiasm; the subquery yields no row, and so the empty string, when
+	// nobody follows the artist.
+	followTopHypeQuery = `
+		SELECT COALESCE((
+			SELECT hype
+			FROM followed_artists
+			WHERE artist_id = $1
+			ORDER BY CASE hype WHEN 'away' THEN 4 WHEN 'nearby' THEN 3 WHEN 'home' THEN 2 ELSE 1 END DESC
+			LIMIT 1
+		), '')
+	`
+	followListHistoryQuery = `
+		SELECT artist_id, action, COALESCE(hype, ''), occurred_at
+		FROM follow_history
+		WHERE user_id = $1
+		ORDER BY occurred_at, id
+	`
 )
 
 // NewFollowRepository creates a new follow repository instance.
@@ -63,7 +116,11 @@ func NewFollowRepository(db *Database) *FollowRepository {
 // The new row's hype is set to entity.DefaultHype — the canonical domain-layer
 // default. The DB column DEFAULT mirrors this value but is not relied upon here.
 func (r *FollowRepository) Follow(ctx context.Context, userID, artistID string) error {
-	_, err := r.db.Pool.Exec(ctx, followInsertQuery, userID, artistID, string(entity.DefaultHype))
+	historyID, err := uuid.NewV7()
+	if err != nil {
+		return toAppErr(err, "failed to generate UUIDv7 for follow history")
+	}
+	_, err = r.db.Pool.Exec(ctx, followInsertQuery, userID, artistID, string(entity.DefaultHype), historyID.String())
 	if err != nil {
 		return toAppErr(err, "failed to follow artist", slog.String("user_id", userID), slog.String("artist_id", artistID))
 	}
@@ -78,7 +135,11 @@ func (r *FollowRepository) Follow(ctx context.Context, userID, artistID string)
 
 // Unfollow removes a follow relationship.
 func (r *FollowRepository) Unfollow(ctx context.Context, userID, artistID string) error {
-	_, err := r.db.Pool.Exec(ctx, followDeleteQuery, userID, artistID)
+	historyID, err := uuid.NewV7()
+	if err != nil {
+		return toAppErr(err, "failed to generate UUIDv7 for follow history")
+	}
+	_, err = r.db.Pool.Exec(ctx, followDeleteQuery, userID, artistID, historyID.String())
 	if err != nil {
 		return toAppErr(err, "failed to unfollow artist", slog.String("user_id", userID), slog.String("artist_id", artistID))
 	}
@@ -91,15 +152,24 @@ func (r *FollowRepository) Unfollow(ctx context.Context, userID, artistID string
 	return nil
 }
 
-// SetHype updates the enthusiasm tier for a followed artist.
+// SetHype updates the enthusiasm tier for a followed artist. Setting the
+// current hype again is a no-op that records no history.
 func (r *FollowRepository) SetHype(ctx context.Context, userID, artistID string, hype entity.Hype) error {
-	tag, err := r.db.Pool.Exec(ctx, followSetHypeQuery, userID, artistID, string(hype))
+	historyID, err := uuid.NewV7()
+	if err != nil {
+		return toAppErr(err, "failed to generate UUIDv7 for follow history")
+	}
+	var found, changed bool
+	err = r.db.Pool.QueryRow(ctx, followSetHypeQuery, userID, artistID, string(hype), historyID.String()).Scan(&found, &changed)
 	if err != nil {
 		return toAppErr(err, "failed to set hype", slog.String("user_id", userID), slog.String("artist_id", artistID))
 	}
-	if tag.RowsAffected() == 0 {
+	if !found {
 		return apperr.New(codes.NotFound, "follow relationship not found")
 	}
+	if !changed {
+		return nil
+	}
 
 	r.db.logger.Info(ctx, "hype updated",
 		slog.String("entityType", "followed_artists"),
@@ -167,6 +237,32 @@ func (r *FollowRepository) ListAll(ctx context.Context) ([]*entity.Artist, error
 	return artists, nil
 }
 
+// ListMostFollowed retrieves the limit artists with the most followers.
+func (r *FollowRepository) ListMostFollowed(ctx context.Context, limit int) ([]*entity.Artist, error) {
+	if limit <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "limit must be positive")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, followListMostFollowedQuery, limit)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list most followed artists", slog.Int("limit", limit))
+	}
+	defer rows.Close()
+
+	var artists []*entity.Artist
+	for rows.Next() {
+		var a entity.Artist
+		if err := rows.Scan(&a.ID, &a.Name, &a.MBID); err != nil {
+			return nil, toAppErr(err, "failed to scan most followed artist")
+		}
+		artists = append(artists, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating most followed artist rows")
+	}
+	return artists, nil
+}
+
 // ListFollowers retrieves all followers of an artist with their hype level and home area.
 // User entities are partially populated with ID, Home, and PreferredLanguage for
 // notification filtering and copy localization.
@@ -198,3 +294,37 @@ func (r *FollowRepository) ListFollowers(ctx context.Context, artistID string) (
 	}
 	return followers, nil
 }
+
+// TopHype returns the highest hype among the artist's followers.
+func (r *FollowRepository) TopHype(ctx context.Context, artistID string) (entity.Hype, error) {
+	var hype string
+	if err := r.db.Pool.QueryRow(ctx, followTopHypeQuery, artistID).Scan(&hype); err != nil {
+		return "", toAppErr(err, "failed to get top follower hype", slog.String("artist_id", artistID))
+	}
+	return entity.Hype(hype), nil
+}
+
+// ListHistory retrieves the user's follow mutations in the order they were committed.
+func (r *FollowRepository) ListHistory(ctx context.Context, userID string) ([]*entity.FollowHistoryEntry, error) {
+	rows, err := r.db.Pool.Query(ctx, followListHistoryQuery, userID)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list follow history", slog.String("user_id", userID))
+	}
+	defer rows.Close()
+
+	var history []*entity.FollowHistoryEntry
+	for rows.Next() {
+		e := &entity.FollowHistoryEntry{UserID: userID}
+		var action, hype string
+		if err := rows.Scan(&e.ArtistID, &action, &hype, &e.OccurredAt); err != nil {
+			return nil, toAppErr(err, "failed to scan follow history row")
+		}
+		e.Action = entity.FollowAction(action)
+		e.Hype = entity.Hype(hype)
+		history = append(history, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating follow history rows")
+	}
+	return history, nil
+}
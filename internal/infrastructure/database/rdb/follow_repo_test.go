@@ -301,6 +301,17 @@ func TestFollowRepository_SetHype(t *testing.T) {
 			},
 			hype: entity.HypeAway,
 		},
+		{
+			name: "set the current hype again succeeds",
+			setup: func() (string, string) {
+				cleanDatabase(t)
+				userID := seedUser(t, "Same Hype User", "same-hype@test.com", "ext-same-hype-01")
+				artistID := seedArtist(t, "Same Hype Artist", "a3000000-0000-0000-0000-000000000003")
+				require.NoError(t, followRepo.Follow(ctx, userID, artistID))
+				return userID, artistID
+			},
+			hype: entity.DefaultHype,
+		},
 		{
 			name: "set hype on non-existent follow returns NotFound",
 			setup: func() (string, string) {
@@ -394,6 +405,67 @@ func TestFollowRepository_ListAll(t *testing.T) {
 	}
 }
 
+func TestFollowRepository_ListMostFollowed(t *testing.T) {
+	followRepo := rdb.NewFollowRepository(testDB)
+	ctx := context.Background()
+
+	cleanDatabase(t)
+	popular := seedArtist(t, "Popular Artist", "a5000000-0000-0000-0000-000000000001")
+	middle := seedArtist(t, "Middle Artist", "a5000000-0000-0000-0000-000000000002")
+	niche := seedArtist(t, "Niche Artist", "a5000000-0000-0000-0000-000000000003")
+	user1ID := seedUser(t, "MostFollowed User 1", "mostfollowed-user1@test.com", "ext-mostfollowed-01")
+	user2ID := seedUser(t, "MostFollowed User 2", "mostfollowed-user2@test.com", "ext-mostfollowed-02")
+	user3ID := seedUser(t, "MostFollowed User 3", "mostfollowed-user3@test.com", "ext-mostfollowed-03")
+
+	for _, f := range []struct{ userID, artistID string }{
+		{user1ID, popular}, {user2ID, popular}, {user3ID, popular},
+		{user1ID, middle}, {user2ID, middle},
+		{user1ID, niche},
+	} {
+		require.NoError(t, followRepo.Follow(ctx, f.userID, f.artistID))
+	}
+
+	tests := []struct {
+		name    string
+		limit   int
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "orders by follower count",
+			limit: 10,
+			want:  []string{popular, middle, niche},
+		},
+		{
+			name:  "truncates to limit",
+			limit: 2,
+			want:  []string{popular, middle},
+		},
+		{
+			name:    "rejects non-positive limit",
+			limit:   0,
+			wantErr: apperr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := followRepo.ListMostFollowed(ctx, tt.limit)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			ids := make([]string, len(got))
+			for i, a := range got {
+				ids[i] = a.ID
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
 func TestFollowRepository_ListFollowers(t *testing.T) {
 	followRepo := rdb.NewFollowRepository(testDB)
 	ctx := context.Background()
@@ -526,3 +598,102 @@ func TestFollowRepository_ListFollowers(t *testing.T) {
 		})
 	}
 }
+
+func TestFollowRepository_TopHype(t *testing.T) {
+	followRepo := rdb.NewFollowRepository(testDB)
+	ctx := context.Background()
+
+	cleanDatabase(t)
+	artistID := seedArtist(t, "Top Hype Artist", "a5000000-0000-0000-0000-0000000000f1")
+
+	t.Run("empty when no followers", func(t *testing.T) {
+		got, err := followRepo.TopHype(ctx, artistID)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	follow := func(name string, hype entity.Hype) {
+		t.Helper()
+		userID := seedUser(t, name, name+"@test.com", "ext-"+name)
+		require.NoError(t, followRepo.Follow(ctx, userID, artistID))
+		require.NoError(t, followRepo.SetHype(ctx, userID, artistID, hype))
+	}
+
+	t.Run("returns the highest hype among followers", func(t *testing.T) {
+		follow("tophype-watch", entity.HypeWatch)
+		got, err := followRepo.TopHype(ctx, artistID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.HypeWatch, got)
+
+		follow("tophype-nearby", entity.HypeNearby)
+		follow("tophype-home", entity.HypeHome)
+		got, err = followRepo.TopHype(ctx, artistID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.HypeNearby, got)
+
+		follow("tophype-away", entity.HypeAway)
+		got, err = followRepo.TopHype(ctx, artistID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.HypeAway, got)
+	})
+}
+
+func TestFollowRepository_ListHistory(t *testing.T) {
+	followRepo := rdb.NewFollowRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	ctx := context.Background()
+
+	cleanDatabase(t)
+	created, err := artistRepo.Create(ctx,
+		entity.NewArtist("History Artist A", "f4000000-0000-0000-0000-00000000fa01"),
+		entity.NewArtist("History Artist B", "f4000000-0000-0000-0000-00000000fa02"),
+	)
+	require.NoError(t, err)
+	artistA, artistB := created[0].ID, created[1].ID
+	userID := seedUser(t, "History User", "history@example.com", "ext-history-01")
+	otherUserID := seedUser(t, "Other User", "history-other@example.com", "ext-history-02")
+
+	before := time.Now()
+	require.NoError(t, followRepo.Follow(ctx, userID, artistA))
+	require.NoError(t, followRepo.Follow(ctx, userID, artistA), "repeat follow is a no-op")
+	require.NoError(t, followRepo.SetHype(ctx, userID, artistA, entity.HypeAway))
+	require.NoError(t, followRepo.SetHype(ctx, userID, artistA, entity.HypeAway), "unchanged hype is a no-op")
+	require.NoError(t, followRepo.Follow(ctx, userID, artistB))
+	require.NoError(t, followRepo.Unfollow(ctx, userID, artistA))
+	require.NoError(t, followRepo.Unfollow(ctx, userID, artistA), "repeat unfollow is a no-op")
+	require.ErrorIs(t, followRepo.SetHype(ctx, userID, artistA, entity.HypeHome), apperr.ErrNotFound)
+	require.NoError(t, followRepo.Follow(ctx, otherUserID, artistA))
+	after := time.Now()
+
+	got, err := followRepo.ListHistory(ctx, userID)
+	require.NoError(t, err)
+
+	want := []struct {
+		artistID string
+		action   entity.FollowAction
+		hype     entity.Hype
+	}{
+		{artistA, entity.FollowActionFollow, entity.DefaultHype},
+		{artistA, entity.FollowActionHypeChange, entity.HypeAway},
+		{artistB, entity.FollowActionFollow, entity.DefaultHype},
+		{artistA, entity.FollowActionUnfollow, ""},
+	}
+	require.Len(t, got, len(want))
+	for i, w := range want {
+		assert.Equal(t, userID, got[i].UserID)
+		assert.Equal(t, w.artistID, got[i].ArtistID, "entry %d", i)
+		assert.Equal(t, w.action, got[i].Action, "entry %d", i)
+		assert.Equal(t, w.hype, got[i].Hype, "entry %d", i)
+		assert.WithinRange(t, got[i].OccurredAt, before.Add(-time.Second), after.Add(time.Second), "entry %d", i)
+		if i > 0 {
+			assert.False(t, got[i].OccurredAt.Before(got[i-1].OccurredAt), "entry %d is out of order", i)
+		}
+	}
+
+	t.Run("user without history returns empty slice", func(t *testing.T) {
+		lonely := seedUser(t, "Lonely History User", "history-lonely@example.com", "ext-history-03")
+		got, err := followRepo.ListHistory(ctx, lonely)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
@@ -2,8 +2,11 @@ package rdb
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
@@ -53,6 +56,27 @@ const (
 		SELECT hash FROM merkle_tree
 		WHERE event_id = $1 AND depth = 0 AND node_index = $2
 	`
+
+	listMerkleNodesQuery = `
+		SELECT event_id, depth, node_index, hash
+		FROM merkle_tree
+		WHERE event_id = $1
+		ORDER BY depth, node_index
+	`
+
+	insertMerkleRootHistoryQuery = `
+		INSERT INTO merkle_root_history (id, event_id, root, leaf_count)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	// listMerkleRootHistoryQuery orders by id after built_at: ids are
+	// UUIDv7, so builds committed in the same instant keep insertion order.
+	listMerkleRootHistoryQuery = `
+		SELECT event_id, root, leaf_count, built_at
+		FROM merkle_root_history
+		WHERE event_id = $1
+		ORDER BY built_at DESC, id DESC
+	`
 )
 
 // StoreBatch replaces all nodes for an event's Merkle tree within a transaction.
@@ -90,48 +114,74 @@ func (r *MerkleTreeRepository) StoreBatch(ctx context.Context, eventID string, n
 }
 
 // StoreBatchWithRoot atomically stores all Merkle tree nodes and updates
-// the event's Merkle root in a single database transaction.
-func (r *MerkleTreeRepository) StoreBatchWithRoot(ctx context.Context, eventID string, nodes []*entity.MerkleNode, root []byte) error {
+// the event's Merkle root in a single database transaction, returning the
+// new root version.
+func (r *MerkleTreeRepository) StoreBatchWithRoot(ctx context.Context, eventID string, nodes []*entity.MerkleNode, root []byte) (int, error) {
 	if eventID == "" {
-		return apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+		return 0, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
 	}
 
 	tx, err := r.db.Pool.Begin(ctx)
 	if err != nil {
-		return toAppErr(err, "failed to begin transaction for merkle tree store with root")
+		return 0, toAppErr(err, "failed to begin transaction for merkle tree store with root")
 	}
 	defer tx.Rollback(ctx) //nolint:errcheck
 
 	// Delete existing nodes for this event.
 	if _, err := tx.Exec(ctx, deleteMerkleNodesQuery, eventID); err != nil {
-		return toAppErr(err, "failed to delete existing merkle nodes",
+		return 0, toAppErr(err, "failed to delete existing merkle nodes",
 			slog.String("event_id", eventID),
 		)
 	}
 
 	// Pipeline all inserts in a single batch round trip.
 	if err := r.batchInsertNodes(ctx, tx, eventID, nodes); err != nil {
-		return err
+		return 0, err
 	}
 
 	// Update the event's Merkle root within the same transaction.
-	tag, err := tx.Exec(ctx, updateMerkleRootQuery, eventID, root)
-	if err != nil {
-		return toAppErr(err, "failed to update merkle root",
+	var version int
+	if err := tx.QueryRow(ctx, updateMerkleRootQuery, eventID, root).Scan(&version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, apperr.New(codes.NotFound, "event not found")
+		}
+		return 0, toAppErr(err, "failed to update merkle root",
 			slog.String("event_id", eventID),
 		)
 	}
-	if tag.RowsAffected() == 0 {
-		return apperr.New(codes.NotFound, "event not found")
+
+	// Record the new root so a later rebuild does not erase when it was in
+	// force.
+	historyID, err := uuid.NewV7()
+	if err != nil {
+		return 0, apperr.Wrap(err, codes.Internal, "failed to generate merkle root history ID")
+	}
+	if _, err := tx.Exec(ctx, insertMerkleRootHistoryQuery, historyID.String(), eventID, root, countLeaves(nodes)); err != nil {
+		return 0, toAppErr(err, "failed to append merkle root history",
+			slog.String("event_id", eventID),
+		)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return toAppErr(err, "failed to commit merkle tree store with root",
+		return 0, toAppErr(err, "failed to commit merkle tree store with root",
 			slog.String("event_id", eventID),
 		)
 	}
 
-	return nil
+	return version, nil
+}
+
+// countLeaves returns the number of non-padding leaves among nodes. The tree
+// builder pads the leaf level to full depth with all-zero hashes, which are
+// not ticket holders.
+func countLeaves(nodes []*entity.MerkleNode) int {
+	n := 0
+	for _, node := range nodes {
+		if node.Depth == 0 && slices.ContainsFunc(node.Hash, func(b byte) bool { return b != 0 }) {
+			n++
+		}
+	}
+	return n
 }
 
 // batchInsertNodes pipelines all node inserts via pgx.SendBatch for a single
@@ -261,3 +311,69 @@ func (r *MerkleTreeRepository) GetLeaf(ctx context.Context, eventID string, leaf
 
 	return leaf, nil
 }
+
+// GetRootHistory returns the event's recorded Merkle roots, newest first.
+func (r *MerkleTreeRepository) GetRootHistory(ctx context.Context, eventID string) ([]*entity.MerkleRootVersion, error) {
+	if eventID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listMerkleRootHistoryQuery, eventID)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list merkle root history",
+			slog.String("event_id", eventID),
+		)
+	}
+	defer rows.Close()
+
+	var history []*entity.MerkleRootVersion
+	for rows.Next() {
+		v := &entity.MerkleRootVersion{}
+		if err := rows.Scan(&v.EventID, &v.Root, &v.LeafCount, &v.BuildTime); err != nil {
+			return nil, toAppErr(err, "failed to scan merkle root history",
+				slog.String("event_id", eventID),
+			)
+		}
+		history = append(history, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "failed to iterate merkle root history",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	return history, nil
+}
+
+// ListNodes returns all stored nodes of an event's Merkle tree, leaves first.
+func (r *MerkleTreeRepository) ListNodes(ctx context.Context, eventID string) ([]*entity.MerkleNode, error) {
+	if eventID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listMerkleNodesQuery, eventID)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list merkle nodes",
+			slog.String("event_id", eventID),
+		)
+	}
+	defer rows.Close()
+
+	var nodes []*entity.MerkleNode
+	for rows.Next() {
+		n := &entity.MerkleNode{}
+		if err := rows.Scan(&n.EventID, &n.Depth, &n.NodeIndex, &n.Hash); err != nil {
+			return nil, toAppErr(err, "failed to scan merkle node",
+				slog.String("event_id", eventID),
+			)
+		}
+		nodes = append(nodes, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "failed to iterate merkle nodes",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	return nodes, nil
+}
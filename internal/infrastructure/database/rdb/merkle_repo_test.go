@@ -96,8 +96,9 @@ func TestMerkleTreeRepository_StoreBatchWithRoot(t *testing.T) {
 			{EventID: eventID, Depth: 1, NodeIndex: 0, Hash: rootHash},
 		}
 
-		err := repo.StoreBatchWithRoot(ctx, eventID, nodes, rootHash)
+		version, err := repo.StoreBatchWithRoot(ctx, eventID, nodes, rootHash)
 		require.NoError(t, err)
+		assert.Equal(t, 1, version, "first root is version 1")
 
 		// Verify the merkle root was updated on the event.
 		gotRoot, err := eventRepo.GetMerkleRoot(ctx, eventID)
@@ -110,16 +111,119 @@ func TestMerkleTreeRepository_StoreBatchWithRoot(t *testing.T) {
 		assert.Equal(t, rootHash, treeRoot)
 	})
 
+	t.Run("rebuild advances the root version", func(t *testing.T) {
+		rootHash := testHash32("rebuilt-root")
+		nodes := []*entity.MerkleNode{{EventID: eventID, Depth: 0, NodeIndex: 0, Hash: rootHash}}
+
+		before, err := repo.StoreBatchWithRoot(ctx, eventID, nodes, rootHash)
+		require.NoError(t, err)
+		after, err := repo.StoreBatchWithRoot(ctx, eventID, nodes, rootHash)
+		require.NoError(t, err)
+		assert.Equal(t, before+1, after)
+
+		gotRoot, err := eventRepo.GetMerkleRoot(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, rootHash, gotRoot)
+	})
+
 	t.Run("non-existent event returns FailedPrecondition", func(t *testing.T) {
 		nodes := []*entity.MerkleNode{
 			{EventID: "018b2f19-e591-7d12-bf9e-000000000000", Depth: 0, NodeIndex: 0, Hash: testHash32("leaf")},
 		}
-		err := repo.StoreBatchWithRoot(ctx, "018b2f19-e591-7d12-bf9e-000000000000", nodes, testHash32("root"))
+		_, err := repo.StoreBatchWithRoot(ctx, "018b2f19-e591-7d12-bf9e-000000000000", nodes, testHash32("root"))
 		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
 	})
 
 	t.Run("empty event ID returns error", func(t *testing.T) {
-		err := repo.StoreBatchWithRoot(ctx, "", nil, nil)
+		_, err := repo.StoreBatchWithRoot(ctx, "", nil, nil)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestMerkleTreeRepository_GetRootHistory(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewMerkleTreeRepository(testDB)
+	ctx := context.Background()
+	eventID := seedMerkleTestData(t)
+
+	t.Run("successive builds yield distinct entries newest first", func(t *testing.T) {
+		// First build: one ticket holder, padded with a zero leaf.
+		first := []*entity.MerkleNode{
+			{EventID: eventID, Depth: 0, NodeIndex: 0, Hash: testHash32("leaf0")},
+			{EventID: eventID, Depth: 0, NodeIndex: 1, Hash: make([]byte, 32)},
+			{EventID: eventID, Depth: 1, NodeIndex: 0, Hash: testHash32("root-v1")},
+		}
+		_, err := repo.StoreBatchWithRoot(ctx, eventID, first, testHash32("root-v1"))
+		require.NoError(t, err)
+
+		// Second build: a new ticket fills the padded slot.
+		second := []*entity.MerkleNode{
+			{EventID: eventID, Depth: 0, NodeIndex: 0, Hash: testHash32("leaf0")},
+			{EventID: eventID, Depth: 0, NodeIndex: 1, Hash: testHash32("leaf1")},
+			{EventID: eventID, Depth: 1, NodeIndex: 0, Hash: testHash32("root-v2")},
+		}
+		_, err = repo.StoreBatchWithRoot(ctx, eventID, second, testHash32("root-v2"))
+		require.NoError(t, err)
+
+		history, err := repo.GetRootHistory(ctx, eventID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		assert.Equal(t, eventID, history[0].EventID)
+		assert.Equal(t, testHash32("root-v2"), history[0].Root)
+		assert.Equal(t, 2, history[0].LeafCount)
+		assert.Equal(t, testHash32("root-v1"), history[1].Root)
+		assert.Equal(t, 1, history[1].LeafCount, "zero-hash padding is not counted")
+		assert.False(t, history[0].BuildTime.Before(history[1].BuildTime))
+	})
+
+	t.Run("failed store leaves no history", func(t *testing.T) {
+		missing := "018b2f19-e591-7d12-bf9e-000000000001"
+		_, err := repo.StoreBatchWithRoot(ctx, missing, nil, testHash32("root"))
+		require.ErrorIs(t, err, apperr.ErrNotFound)
+
+		history, err := repo.GetRootHistory(ctx, missing)
+		require.NoError(t, err)
+		assert.Empty(t, history)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.GetRootHistory(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestMerkleTreeRepository_ListNodes(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewMerkleTreeRepository(testDB)
+	ctx := context.Background()
+	eventID := seedMerkleTestData(t)
+
+	t.Run("nodes are ordered by depth then index", func(t *testing.T) {
+		// Stored out of order to show the listing sorts them.
+		nodes := []*entity.MerkleNode{
+			{EventID: eventID, Depth: 1, NodeIndex: 0, Hash: testHash32("root")},
+			{EventID: eventID, Depth: 0, NodeIndex: 1, Hash: testHash32("leaf1")},
+			{EventID: eventID, Depth: 0, NodeIndex: 0, Hash: testHash32("leaf0")},
+		}
+		require.NoError(t, repo.StoreBatch(ctx, eventID, nodes))
+
+		got, err := repo.ListNodes(ctx, eventID)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, &entity.MerkleNode{EventID: eventID, Depth: 0, NodeIndex: 0, Hash: testHash32("leaf0")}, got[0])
+		assert.Equal(t, &entity.MerkleNode{EventID: eventID, Depth: 0, NodeIndex: 1, Hash: testHash32("leaf1")}, got[1])
+		assert.Equal(t, &entity.MerkleNode{EventID: eventID, Depth: 1, NodeIndex: 0, Hash: testHash32("root")}, got[2])
+	})
+
+	t.Run("event without a tree has no nodes", func(t *testing.T) {
+		got, err := repo.ListNodes(ctx, "018b2f19-e591-7d12-bf9e-000000000001")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.ListNodes(ctx, "")
 		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
 	})
 }
@@ -34,6 +34,22 @@ const (
 			WHERE event_id = $1 AND nullifier_hash = $2
 		)
 	`
+
+	countNullifiersByEventQuery = `
+		SELECT COUNT(*) FROM nullifiers
+		WHERE event_id = $1
+	`
+
+	listNullifiersByEventQuery = `
+		SELECT nullifier_hash FROM nullifiers
+		WHERE event_id = $1
+		ORDER BY used_at, nullifier_hash
+	`
+
+	deleteNullifierQuery = `
+		DELETE FROM nullifiers
+		WHERE event_id = $1 AND nullifier_hash = $2
+	`
 )
 
 // Insert atomically inserts a nullifier hash for an event.
@@ -77,3 +93,80 @@ func (r *NullifierRepository) Exists(ctx context.Context, eventID string, nullif
 
 	return exists, nil
 }
+
+// CountByEvent returns the number of nullifiers recorded for an event.
+func (r *NullifierRepository) CountByEvent(ctx context.Context, eventID string) (int, error) {
+	if eventID == "" {
+		return 0, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	var count int
+	err := r.db.Pool.QueryRow(ctx, countNullifiersByEventQuery, eventID).Scan(&count)
+	if err != nil {
+		return 0, toAppErr(err, "failed to count nullifiers",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	return count, nil
+}
+
+// ListByEvent returns the nullifier hashes recorded for an event, oldest
+// check-in first.
+func (r *NullifierRepository) ListByEvent(ctx context.Context, eventID string) ([][]byte, error) {
+	if eventID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listNullifiersByEventQuery, eventID)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list nullifiers",
+			slog.String("event_id", eventID),
+		)
+	}
+	defer rows.Close()
+
+	hashes := [][]byte{}
+	for rows.Next() {
+		var hash []byte
+		if err := rows.Scan(&hash); err != nil {
+			return nil, toAppErr(err, "failed to scan nullifier",
+				slog.String("event_id", eventID),
+			)
+		}
+		hashes = append(hashes, hash)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "failed to iterate nullifiers",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	return hashes, nil
+}
+
+// Delete removes a recorded nullifier hash for an event.
+// Returns NotFound if the nullifier was never recorded.
+func (r *NullifierRepository) Delete(ctx context.Context, eventID string, nullifierHash []byte) error {
+	if eventID == "" {
+		return apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	if len(nullifierHash) == 0 {
+		return apperr.New(codes.InvalidArgument, "nullifier hash cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, deleteNullifierQuery, eventID, nullifierHash)
+	if err != nil {
+		return toAppErr(err, "failed to delete nullifier",
+			slog.String("event_id", eventID),
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "nullifier not found",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package rdb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
 	"github.com/pannpers/go-apperr/apperr"
@@ -123,3 +124,120 @@ func TestNullifierRepository_Exists(t *testing.T) {
 		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
 	})
 }
+
+func TestNullifierRepository_CountByEvent(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewNullifierRepository(testDB)
+	ctx := context.Background()
+	eventID := seedMerkleTestData(t)
+
+	t.Run("returns zero for an event without check-ins", func(t *testing.T) {
+		count, err := repo.CountByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("counts the nullifiers recorded for the event", func(t *testing.T) {
+		for _, seed := range []string{"attendee-1", "attendee-2", "attendee-3"} {
+			require.NoError(t, repo.Insert(ctx, eventID, testHash32(seed)))
+		}
+
+		count, err := repo.CountByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		// Another event's count is unaffected.
+		count, err = repo.CountByEvent(ctx, "018b2f19-e591-7d12-bf9e-000000000000")
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.CountByEvent(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestNullifierRepository_ListByEvent(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewNullifierRepository(testDB)
+	ctx := context.Background()
+	eventID := seedMerkleTestData(t)
+
+	t.Run("returns empty for an event without check-ins", func(t *testing.T) {
+		hashes, err := repo.ListByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Empty(t, hashes)
+	})
+
+	t.Run("lists the nullifiers recorded for the event in check-in order", func(t *testing.T) {
+		seeds := []string{"attendee-b", "attendee-a", "attendee-c"}
+		for _, seed := range seeds {
+			require.NoError(t, repo.Insert(ctx, eventID, testHash32(seed)))
+			// used_at has microsecond precision; keep check-ins apart.
+			time.Sleep(time.Millisecond)
+		}
+
+		hashes, err := repo.ListByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{testHash32("attendee-b"), testHash32("attendee-a"), testHash32("attendee-c")}, hashes)
+
+		count, err := repo.CountByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Len(t, hashes, count)
+
+		// Another event's nullifiers are not included.
+		hashes, err = repo.ListByEvent(ctx, "018b2f19-e591-7d12-bf9e-000000000000")
+		require.NoError(t, err)
+		assert.Empty(t, hashes)
+	})
+
+	t.Run("revoked check-in is no longer listed", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, eventID, testHash32("attendee-a")))
+
+		hashes, err := repo.ListByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{testHash32("attendee-b"), testHash32("attendee-c")}, hashes)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.ListByEvent(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestNullifierRepository_Delete(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewNullifierRepository(testDB)
+	ctx := context.Background()
+	eventID := seedMerkleTestData(t)
+
+	t.Run("removes a recorded nullifier", func(t *testing.T) {
+		hash := testHash32("revoked-null")
+		require.NoError(t, repo.Insert(ctx, eventID, hash))
+
+		require.NoError(t, repo.Delete(ctx, eventID, hash))
+
+		exists, err := repo.Exists(ctx, eventID, hash)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// The nullifier can be recorded again after the delete.
+		require.NoError(t, repo.Insert(ctx, eventID, hash))
+	})
+
+	t.Run("nullifier never recorded returns NotFound", func(t *testing.T) {
+		err := repo.Delete(ctx, eventID, testHash32("never-recorded"))
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		err := repo.Delete(ctx, "", []byte("hash"))
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("empty nullifier hash returns error", func(t *testing.T) {
+		err := repo.Delete(ctx, eventID, []byte{})
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,89 @@
+package rdb
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// PoolStats is a snapshot of the connection pool's usage.
+type PoolStats struct {
+	// Acquired is the number of connections currently in use.
+	Acquired int32 `json:"acquired"`
+	// Idle is the number of open connections ready to be acquired.
+	Idle int32 `json:"idle"`
+	// Max is the pool's connection limit, DATABASE_MAX_OPEN_CONNS.
+	Max int32 `json:"max"`
+	// Waited counts the acquires since the pool started that found no idle
+	// connection and had to wait for one. pgxpool does not report how many
+	// acquires are waiting right now; a rising count is the signal.
+	Waited int64 `json:"waited"`
+}
+
+func newPoolStats(s *pgxpool.Stat) PoolStats {
+	return PoolStats{
+		Acquired: s.AcquiredConns(),
+		Idle:     s.IdleConns(),
+		Max:      s.MaxConns(),
+		Waited:   s.EmptyAcquireCount(),
+	}
+}
+
+// SaturationPercent returns the share of the connection limit in use, in
+// percent. A pool without a limit reports 0.
+func (s PoolStats) SaturationPercent() int {
+	if s.Max <= 0 {
+		return 0
+	}
+	return int(int64(s.Acquired) * 100 / int64(s.Max))
+}
+
+// PoolStats returns a snapshot of the connection pool's usage. A Database not
+// backed by its own pool reports zero stats.
+func (d *Database) PoolStats() PoolStats {
+	if d.stat == nil {
+		return PoolStats{}
+	}
+	return d.stat()
+}
+
+// CheckPoolSaturation returns a snapshot of the connection pool's usage and
+// logs a WARNING when the acquired connections reach the configured share of
+// the connection limit, giving early warning of connection exhaustion.
+func (d *Database) CheckPoolSaturation(ctx context.Context) PoolStats {
+	stats := d.PoolStats()
+	if d.saturationWarnPercent > 0 && stats.Max > 0 && stats.SaturationPercent() >= d.saturationWarnPercent {
+		d.logger.Warn(ctx, "database connection pool near saturation",
+			slog.Int("acquired_conns", int(stats.Acquired)),
+			slog.Int("idle_conns", int(stats.Idle)),
+			slog.Int("max_conns", int(stats.Max)),
+			slog.Int64("waited_acquires", stats.Waited),
+			slog.Int("saturation_percent", stats.SaturationPercent()),
+			slog.Int("warn_percent", d.saturationWarnPercent),
+		)
+	}
+	return stats
+}
+
+// registerPoolSaturationMetrics registers the pool's connection limit and
+// waited acquires alongside the TracedPool's active and idle connection
+// gauges. Each collection also runs CheckPoolSaturation, so the saturation
+// warning is logged on the metrics export interval.
+func (d *Database) registerPoolSaturationMetrics() {
+	meter := otel.Meter(tracerName)
+	maxConns, _ := meter.Int64ObservableGauge("db.pool.max_connections",
+		metric.WithDescription("Maximum number of open database connections (DATABASE_MAX_OPEN_CONNS)"),
+	)
+	waited, _ := meter.Int64ObservableCounter("db.pool.waited_acquires",
+		metric.WithDescription("Connection acquires that had to wait because no idle connection was available"),
+	)
+	_, _ = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		stats := d.CheckPoolSaturation(ctx)
+		o.ObserveInt64(maxConns, int64(stats.Max))
+		o.ObserveInt64(waited, stats.Waited)
+		return nil
+	}, maxConns, waited)
+}
@@ -0,0 +1,61 @@
+package rdb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_CheckPoolSaturation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		acquired    int32
+		warnPercent int
+		wantWarn    bool
+	}{
+		{name: "below the threshold", acquired: 7, warnPercent: 80},
+		{name: "at the threshold", acquired: 8, warnPercent: 80, wantWarn: true},
+		{name: "exhausted", acquired: 10, warnPercent: 80, wantWarn: true},
+		{name: "disabled", acquired: 10, warnPercent: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			logger, err := logging.New(logging.WithWriter(buf))
+			require.NoError(t, err)
+			stats := PoolStats{Acquired: tc.acquired, Idle: 10 - tc.acquired, Max: 10, Waited: 3}
+			d := &Database{
+				logger:                logger,
+				stat:                  func() PoolStats { return stats },
+				saturationWarnPercent: tc.warnPercent,
+			}
+
+			got := d.CheckPoolSaturation(context.Background())
+
+			assert.Equal(t, stats, got)
+			if tc.wantWarn {
+				assert.Contains(t, buf.String(), "database connection pool near saturation")
+				assert.Contains(t, buf.String(), "max_conns=10")
+			} else {
+				assert.Empty(t, buf.String())
+			}
+		})
+	}
+}
+
+func TestDatabase_PoolStatsWithoutPool(t *testing.T) {
+	t.Parallel()
+
+	d := &Database{saturationWarnPercent: 80}
+
+	assert.Equal(t, PoolStats{}, d.PoolStats())
+	assert.Zero(t, d.PoolStats().SaturationPercent())
+}
@@ -0,0 +1,55 @@
+package rdb_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_CheckPoolSaturation_AcquiredConnections(t *testing.T) {
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	logger, err := logging.New(logging.WithWriter(buf))
+	require.NoError(t, err)
+
+	db, err := rdb.New(ctx, config.DatabaseConfig{
+		Host:                      "localhost",
+		Port:                      15432,
+		Name:                      "test-db",
+		User:                      "test-user",
+		SSLMode:                   "disable",
+		MaxOpenConns:              4,
+		HealthCheckPeriod:         60,
+		PoolSaturationWarnPercent: 75,
+	}, true, logger)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	// Each open transaction holds one connection until it ends.
+	acquire := func(n int) {
+		for range n {
+			tx, err := db.Pool.Begin(ctx)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = tx.Rollback(ctx) })
+		}
+	}
+
+	acquire(2)
+	buf.Reset()
+	stats := db.CheckPoolSaturation(ctx)
+	assert.Equal(t, int32(2), stats.Acquired)
+	assert.Equal(t, int32(4), stats.Max)
+	assert.NotContains(t, buf.String(), "near saturation", "half the pool in use is below the threshold")
+
+	acquire(1)
+	stats = db.CheckPoolSaturation(ctx)
+	assert.Equal(t, int32(3), stats.Acquired)
+	assert.Equal(t, 75, stats.SaturationPercent())
+	assert.Contains(t, buf.String(), "database connection pool near saturation")
+}
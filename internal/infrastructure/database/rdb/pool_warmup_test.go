@@ -0,0 +1,72 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/pkg/config"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabase_Warmup(t *testing.T) {
+	newDB := func(t *testing.T) *rdb.Database {
+		t.Helper()
+		logger, err := logging.New()
+		require.NoError(t, err)
+		db, err := rdb.New(context.Background(), config.DatabaseConfig{
+			Host:              "localhost",
+			Port:              15432,
+			Name:              "test-db",
+			User:              "test-user",
+			SSLMode:           "disable",
+			MaxOpenConns:      4,
+			HealthCheckPeriod: 60,
+		}, true, logger)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		return db
+	}
+
+	t.Run("opens the requested connections and releases them", func(t *testing.T) {
+		db := newDB(t)
+
+		warmed, err := db.Warmup(context.Background(), 3)
+		require.NoError(t, err)
+		assert.Equal(t, 3, warmed)
+
+		stats := db.PoolStats()
+		assert.Equal(t, int32(3), stats.Idle)
+		assert.Zero(t, stats.Acquired)
+	})
+
+	t.Run("caps the count at the pool limit", func(t *testing.T) {
+		db := newDB(t)
+
+		warmed, err := db.Warmup(context.Background(), 10)
+		require.NoError(t, err)
+		assert.Equal(t, 4, warmed)
+		assert.Equal(t, int32(4), db.PoolStats().Idle)
+	})
+
+	t.Run("zero is a no-op", func(t *testing.T) {
+		db := newDB(t)
+
+		warmed, err := db.Warmup(context.Background(), 0)
+		require.NoError(t, err)
+		assert.Zero(t, warmed)
+	})
+
+	t.Run("stops on a cancelled context", func(t *testing.T) {
+		db := newDB(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		warmed, err := db.Warmup(ctx, 3)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, warmed)
+		assert.Zero(t, db.PoolStats().Acquired)
+	})
+}
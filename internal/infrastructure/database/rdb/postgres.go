@@ -18,6 +18,15 @@ type Database struct {
 	Pool   *TracedPool
 	logger *logging.Logger
 	dialer *cloudsqlconn.Dialer
+	// pool is the underlying pgxpool; nil for a Database not backed by its own
+	// pool.
+	pool *pgxpool.Pool
+	// stat snapshots the pool's usage; nil for a Database not backed by its
+	// own pool.
+	stat func() PoolStats
+	// saturationWarnPercent is the share of the connection limit in use, in
+	// percent, at or above which CheckPoolSaturation warns; 0 disables it.
+	saturationWarnPercent int
 }
 
 // New creates a new database instance with connection and ping verification.
@@ -83,15 +92,22 @@ func New(ctx context.Context, dbCfg config.DatabaseConfig, isLocal bool, logger
 	}
 
 	database := &Database{
-		Pool:   NewTracedPool(pool, dbCfg.Name, dbCfg.Host),
-		logger: logger,
-		dialer: dialer,
+		Pool: NewTracedPool(pool, dbCfg.Name, dbCfg.Host,
+			WithQueryTimeout(time.Duration(dbCfg.QueryTimeout)*time.Second),
+			WithSlowQueryLog(time.Duration(dbCfg.SlowQueryThresholdMs)*time.Millisecond, logger),
+		),
+		logger:                logger,
+		dialer:                dialer,
+		pool:                  pool,
+		stat:                  func() PoolStats { return newPoolStats(pool.Stat()) },
+		saturationWarnPercent: dbCfg.PoolSaturationWarnPercent,
 	}
 
 	if err := database.Ping(ctx); err != nil {
 		_ = database.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
+	database.registerPoolSaturationMetrics()
 
 	logger.Info(ctx, "Database connection established successfully",
 		slog.String("host", dbCfg.Host),
@@ -102,6 +118,9 @@ func New(ctx context.Context, dbCfg config.DatabaseConfig, isLocal bool, logger
 		slog.Int("conn_max_lifetime_s", dbCfg.ConnMaxLifetime),
 		slog.Int("max_conn_idle_time_s", dbCfg.MaxConnIdleTime),
 		slog.Int("health_check_period_s", dbCfg.HealthCheckPeriod),
+		slog.Int("query_timeout_s", dbCfg.QueryTimeout),
+		slog.Int("slow_query_threshold_ms", dbCfg.SlowQueryThresholdMs),
+		slog.Int("pool_saturation_warn_percent", dbCfg.PoolSaturationWarnPercent),
 	)
 
 	return database, nil
@@ -121,6 +140,61 @@ func (d *Database) Ping(ctx context.Context) error {
 	return nil
 }
 
+// Warmup opens up to n connections ahead of the first request by acquiring
+// them all at once and releasing them back to the pool, so traffic arriving
+// right after startup does not pay the connection setup cost (TLS and, outside
+// local, the Cloud SQL IAM handshake). n is capped at the pool's connection
+// limit. Connections beyond DATABASE_MAX_IDLE_CONNS are closed again after
+// DATABASE_MAX_CONN_IDLE_TIME if they stay unused.
+//
+// Warmup stops early when ctx is cancelled or a connection cannot be opened,
+// and returns the number of connections warmed along with the error.
+func (d *Database) Warmup(ctx context.Context, n int) (int, error) {
+	if d.pool == nil || n <= 0 {
+		return 0, nil
+	}
+	limit := int(d.pool.Config().MaxConns)
+	n = min(n, limit)
+
+	conns := make([]*pgxpool.Conn, 0, n)
+	defer func() {
+		for _, c := range conns {
+			c.Release()
+		}
+	}()
+
+	var err error
+	for len(conns) < n {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		var c *pgxpool.Conn
+		c, err = d.pool.Acquire(ctx)
+		if err != nil {
+			break
+		}
+		conns = append(conns, c)
+	}
+
+	warmed := len(conns)
+	if err != nil {
+		d.logger.Warn(ctx, "database pool warmup stopped early",
+			slog.Int("requested", n),
+			slog.Int("warmed", warmed),
+			slog.Int("max_conns", limit),
+			slog.String("error", err.Error()),
+		)
+		return warmed, fmt.Errorf("failed to warm up database pool: %w", err)
+	}
+
+	d.logger.Info(ctx, "database pool warmed",
+		slog.Int("requested", n),
+		slog.Int("warmed", warmed),
+		slog.Int("max_conns", limit),
+	)
+	return warmed, nil
+}
+
 // Close closes the database connection.
 func (d *Database) Close() error {
 	d.logger.Info(context.Background(), "Closing database connection")
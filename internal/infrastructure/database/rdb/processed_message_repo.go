@@ -0,0 +1,101 @@
+package rdb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+)
+
+// ProcessedMessageRepository records the event messages consumer handlers
+// have processed, so a redelivered message can be skipped. It satisfies
+// messaging.IdempotencyStore.
+type ProcessedMessageRepository struct {
+	db *Database
+}
+
+// NewProcessedMessageRepository creates a new processed message repository instance.
+func NewProcessedMessageRepository(db *Database) *ProcessedMessageRepository {
+	return &ProcessedMessageRepository{db: db}
+}
+
+const (
+	isMessageProcessedQuery = `
+		SELECT EXISTS (
+			SELECT 1 FROM processed_messages
+			WHERE handler_name = $1 AND dedup_key = $2
+			  AND processed_at >= NOW() - $3 * INTERVAL '1 second'
+		)
+	`
+
+	// markMessageProcessedQuery inserts the record, or refreshes one that has
+	// aged out of the window. A record inside the window is left untouched, so
+	// no row is affected for a duplicate.
+	markMessageProcessedQuery = `
+		INSERT INTO processed_messages (handler_name, dedup_key)
+		VALUES ($1, $2)
+		ON CONFLICT (handler_name, dedup_key) DO UPDATE
+		SET processed_at = NOW()
+		WHERE processed_messages.processed_at < NOW() - $3 * INTERVAL '1 second'
+	`
+
+	pruneProcessedMessagesQuery = `
+		DELETE FROM processed_messages
+		WHERE processed_at < NOW() - $1 * INTERVAL '1 second'
+	`
+)
+
+// IsProcessed reports whether handlerName recorded the message identified by
+// dedupKey within window.
+func (r *ProcessedMessageRepository) IsProcessed(ctx context.Context, handlerName, dedupKey string, window time.Duration) (bool, error) {
+	if handlerName == "" {
+		return false, apperr.New(codes.InvalidArgument, "handler name cannot be empty")
+	}
+	if dedupKey == "" {
+		return false, apperr.New(codes.InvalidArgument, "dedup key cannot be empty")
+	}
+
+	var processed bool
+	if err := r.db.Pool.QueryRow(ctx, isMessageProcessedQuery, handlerName, dedupKey, window.Seconds()).Scan(&processed); err != nil {
+		return false, toAppErr(err, "failed to check message processed",
+			slog.String("handler_name", handlerName),
+			slog.String("dedup_key", dedupKey),
+		)
+	}
+	return processed, nil
+}
+
+// MarkProcessed records that handlerName processed the message identified by
+// dedupKey. It reports false, recording nothing, when the message was already
+// recorded within window.
+func (r *ProcessedMessageRepository) MarkProcessed(ctx context.Context, handlerName, dedupKey string, window time.Duration) (bool, error) {
+	if handlerName == "" {
+		return false, apperr.New(codes.InvalidArgument, "handler name cannot be empty")
+	}
+	if dedupKey == "" {
+		return false, apperr.New(codes.InvalidArgument, "dedup key cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, markMessageProcessedQuery, handlerName, dedupKey, window.Seconds())
+	if err != nil {
+		return false, toAppErr(err, "failed to mark message processed",
+			slog.String("handler_name", handlerName),
+			slog.String("dedup_key", dedupKey),
+		)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// PruneProcessed deletes the records older than window and returns how many
+// were deleted.
+func (r *ProcessedMessageRepository) PruneProcessed(ctx context.Context, window time.Duration) (int64, error) {
+	tag, err := r.db.Pool.Exec(ctx, pruneProcessedMessagesQuery, window.Seconds())
+	if err != nil {
+		return 0, toAppErr(err, "failed to prune processed messages")
+	}
+
+	return tag.RowsAffected(), nil
+}
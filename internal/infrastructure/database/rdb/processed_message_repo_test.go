@@ -0,0 +1,88 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessedMessageRepository_MarkProcessed(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewProcessedMessageRepository(testDB)
+	ctx := context.Background()
+	const window = time.Hour
+
+	t.Run("second delivery within the window is a duplicate", func(t *testing.T) {
+		fresh, err := repo.MarkProcessed(ctx, "create-concerts", "msg-1", window)
+		require.NoError(t, err)
+		assert.True(t, fresh)
+
+		fresh, err = repo.MarkProcessed(ctx, "create-concerts", "msg-1", window)
+		require.NoError(t, err)
+		assert.False(t, fresh)
+	})
+
+	t.Run("handlers deduplicate independently", func(t *testing.T) {
+		fresh, err := repo.MarkProcessed(ctx, "forward-to-analytics", "msg-1", window)
+		require.NoError(t, err)
+		assert.True(t, fresh)
+	})
+
+	t.Run("record older than the window no longer suppresses", func(t *testing.T) {
+		fresh, err := repo.MarkProcessed(ctx, "create-concerts", "msg-old", window)
+		require.NoError(t, err)
+		require.True(t, fresh)
+		_, err = testDB.Pool.Exec(ctx,
+			`UPDATE processed_messages SET processed_at = NOW() - INTERVAL '2 hours' WHERE dedup_key = 'msg-old'`)
+		require.NoError(t, err)
+
+		fresh, err = repo.MarkProcessed(ctx, "create-concerts", "msg-old", window)
+		require.NoError(t, err)
+		assert.True(t, fresh)
+
+		fresh, err = repo.MarkProcessed(ctx, "create-concerts", "msg-old", window)
+		require.NoError(t, err)
+		assert.False(t, fresh, "the refreshed record suppresses again")
+	})
+
+	t.Run("empty arguments return error", func(t *testing.T) {
+		_, err := repo.MarkProcessed(ctx, "", "msg-1", window)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		_, err = repo.MarkProcessed(ctx, "create-concerts", "", window)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestProcessedMessageRepository_IsProcessed(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewProcessedMessageRepository(testDB)
+	ctx := context.Background()
+	const window = time.Hour
+
+	processed, err := repo.IsProcessed(ctx, "create-concerts", "msg-1", window)
+	require.NoError(t, err)
+	assert.False(t, processed, "an unrecorded message is not processed")
+
+	_, err = repo.MarkProcessed(ctx, "create-concerts", "msg-1", window)
+	require.NoError(t, err)
+
+	processed, err = repo.IsProcessed(ctx, "create-concerts", "msg-1", window)
+	require.NoError(t, err)
+	assert.True(t, processed)
+
+	processed, err = repo.IsProcessed(ctx, "notify-followers", "msg-1", window)
+	require.NoError(t, err)
+	assert.False(t, processed, "records are scoped to their handler")
+
+	processed, err = repo.IsProcessed(ctx, "create-concerts", "msg-1", 0)
+	require.NoError(t, err)
+	assert.False(t, processed, "a record outside the window does not count")
+
+	_, err = repo.IsProcessed(ctx, "create-concerts", "", window)
+	assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+}
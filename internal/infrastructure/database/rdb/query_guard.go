@@ -0,0 +1,69 @@
+package rdb
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pannpers/go-logging/logging"
+)
+
+// slowQueryTextLimit caps how much of the SQL text a slow-query warning
+// carries; the operation and table identify the statement, the prefix
+// disambiguates between queries on the same table.
+const slowQueryTextLimit = 200
+
+// queryGuard bounds and times every statement issued through TracedPool and
+// TracedTx, the single choke point all repositories query through.
+//
+// A zero timeout or slowThreshold disables the respective behavior, so the
+// zero value is a no-op guard.
+type queryGuard struct {
+	// timeout caps each statement's duration unless the caller's context
+	// already carries an earlier deadline.
+	timeout time.Duration
+	// slowThreshold is the duration at or above which a statement is logged
+	// as slow.
+	slowThreshold time.Duration
+	logger        *logging.Logger
+}
+
+// start derives the context a statement runs under and returns a done func
+// that must be called exactly once when the statement finishes (for Query,
+// when its rows are closed). done releases the timeout and emits a WARNING
+// when the statement was slow.
+func (g queryGuard) start(ctx context.Context, sql string) (context.Context, func()) {
+	logCtx := ctx
+	cancel := context.CancelFunc(func() {})
+	if g.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, g.timeout)
+	}
+	began := time.Now()
+
+	return ctx, func() {
+		cancel()
+		elapsed := time.Since(began)
+		if g.slowThreshold <= 0 || g.logger == nil || elapsed < g.slowThreshold {
+			return
+		}
+		meta := ExtractQueryMeta(sql)
+		g.logger.Warn(logCtx, "slow query",
+			slog.String("db.operation.name", meta.Operation),
+			slog.String("db.collection.name", meta.Table),
+			slog.String("db.query.text", truncateQueryText(sql)),
+			slog.Duration("duration", elapsed),
+			slog.Duration("threshold", g.slowThreshold),
+		)
+	}
+}
+
+// truncateQueryText collapses the whitespace of a multi-line query constant
+// and cuts it to slowQueryTextLimit bytes for logging.
+func truncateQueryText(sql string) string {
+	s := strings.Join(strings.Fields(sql), " ")
+	if len(s) > slowQueryTextLimit {
+		s = s[:slowQueryTextLimit] + "…"
+	}
+	return s
+}
@@ -0,0 +1,129 @@
+package rdb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+// slowPool is a fake pgxPool whose Exec takes delay to complete, or until the
+// statement's context is done, whichever comes first.
+type slowPool struct {
+	delay time.Duration
+}
+
+func (p *slowPool) Exec(ctx context.Context, _ string, _ ...any) (pgconn.CommandTag, error) {
+	select {
+	case <-time.After(p.delay):
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	case <-ctx.Done():
+		return pgconn.CommandTag{}, ctx.Err()
+	}
+}
+
+func (p *slowPool) Query(context.Context, string, ...any) (pgx.Rows, error) { panic("unused") }
+func (p *slowPool) QueryRow(context.Context, string, ...any) pgx.Row        { panic("unused") }
+func (p *slowPool) Begin(context.Context) (pgx.Tx, error)                   { return &slowTx{delay: p.delay}, nil }
+func (p *slowPool) Ping(context.Context) error                              { return nil }
+func (p *slowPool) Close()                                                  {}
+
+// slowTx is a fake pgx.Tx whose Exec behaves like slowPool's.
+type slowTx struct {
+	pgx.Tx
+	delay time.Duration
+}
+
+func (tx *slowTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return (&slowPool{delay: tx.delay}).Exec(ctx, sql, args...)
+}
+
+func (tx *slowTx) Commit(context.Context) error   { return nil }
+func (tx *slowTx) Rollback(context.Context) error { return nil }
+
+func newGuardedPool(t *testing.T, delay time.Duration, opts ...TracedPoolOption) (*TracedPool, *bytes.Buffer) {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	logger, err := logging.New(logging.WithWriter(buf))
+	require.NoError(t, err)
+
+	tp := &TracedPool{inner: &slowPool{delay: delay}, tracer: otel.Tracer(tracerName)}
+	for _, opt := range append([]TracedPoolOption{WithSlowQueryLog(100*time.Millisecond, logger)}, opts...) {
+		opt(tp)
+	}
+	return tp, buf
+}
+
+const guardTestQuery = `
+		UPDATE venues
+		SET name = $2
+		WHERE id = $1
+	`
+
+func TestTracedPool_SlowQueryLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("logs a warning when a query exceeds the threshold", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			tp, buf := newGuardedPool(t, 250*time.Millisecond)
+
+			_, err := tp.Exec(context.Background(), guardTestQuery, "id", "name")
+
+			require.NoError(t, err)
+			out := buf.String()
+			assert.Contains(t, out, "slow query")
+			assert.Contains(t, out, "WARN")
+			assert.Contains(t, out, "venues")
+			assert.Contains(t, out, "UPDATE venues SET name = $2 WHERE id = $1")
+		})
+	})
+
+	t.Run("stays silent for a fast query", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			tp, buf := newGuardedPool(t, 10*time.Millisecond)
+
+			_, err := tp.Exec(context.Background(), guardTestQuery, "id", "name")
+
+			require.NoError(t, err)
+			assert.NotContains(t, buf.String(), "slow query")
+		})
+	})
+
+	t.Run("cancels a query that exceeds the query timeout", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			tp, buf := newGuardedPool(t, time.Hour, WithQueryTimeout(time.Second))
+
+			_, err := tp.Exec(context.Background(), guardTestQuery, "id", "name")
+
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Contains(t, buf.String(), "slow query", "a timed-out query is also reported as slow")
+		})
+	})
+
+	t.Run("guards statements inside RunInTx", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			tp, buf := newGuardedPool(t, time.Hour, WithQueryTimeout(time.Second))
+			db := &Database{Pool: tp}
+
+			err := db.RunInTx(context.Background(), func(ctx context.Context) error {
+				_, err := tp.Exec(ctx, guardTestQuery, "id", "name")
+				return err
+			})
+
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Contains(t, buf.String(), "slow query")
+		})
+	})
+}
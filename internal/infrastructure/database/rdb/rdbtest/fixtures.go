@@ -0,0 +1,198 @@
+// Package rdbtest provides fixture builders for the rdb integration tests.
+//
+// Each builder inserts one row with unique defaults and returns the stored
+// entity; option functions adjust fields before the insert. Builders fail the
+// test on error, so a setup step stays on one line:
+//
+//	f := rdbtest.New(t, testDB)
+//	artist := f.Artist(func(a *entity.Artist) { a.Name = "Aurora" })
+//	concert := f.Concert(rdbtest.PerformedBy(artist.ID), rdbtest.On(date))
+//
+// Rows a fixture depends on but the caller did not supply, such as the venue
+// and performer of a concert, are created with defaults as well.
+package rdbtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/stretchr/testify/require"
+)
+
+// Fixtures inserts test rows into one database. It is not safe for concurrent
+// use; create one per test.
+type Fixtures struct {
+	t   testing.TB
+	db  *rdb.Database
+	seq int
+
+	artistRepo  *rdb.ArtistRepository
+	venueRepo   *rdb.VenueRepository
+	seriesRepo  *rdb.SeriesRepository
+	concertRepo *rdb.ConcertRepository
+}
+
+// New returns Fixtures that insert into db and fail t on error.
+func New(t testing.TB, db *rdb.Database) *Fixtures {
+	return &Fixtures{
+		t:           t,
+		db:          db,
+		artistRepo:  rdb.NewArtistRepository(db),
+		venueRepo:   rdb.NewVenueRepository(db),
+		seriesRepo:  rdb.NewSeriesRepository(db),
+		concertRepo: rdb.NewConcertRepository(db),
+	}
+}
+
+// ID returns a fresh UUIDv7 string, for rows whose ID only has to be unique.
+func (f *Fixtures) ID() string {
+	f.t.Helper()
+	id, err := uuid.NewV7()
+	require.NoError(f.t, err)
+	return id.String()
+}
+
+// next returns a number unique to f, used to keep default names apart.
+func (f *Fixtures) next() int {
+	f.seq++
+	return f.seq
+}
+
+// User inserts a user with a unique name, email, and external ID.
+func (f *Fixtures) User(opts ...func(*entity.User)) *entity.User {
+	f.t.Helper()
+	id := f.ID()
+	u := &entity.User{
+		ID:         id,
+		ExternalID: "ext-" + id,
+		Email:      "user-" + id + "@example.com",
+		Name:       fmt.Sprintf("Fixture User %d", f.next()),
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	_, err := f.db.Pool.Exec(context.Background(),
+		`INSERT INTO users (id, name, email, external_id) VALUES ($1, $2, $3, $4)`,
+		u.ID, u.Name, u.Email, u.ExternalID,
+	)
+	require.NoError(f.t, err)
+	return u
+}
+
+// Artist inserts an artist with a unique name and MBID.
+func (f *Fixtures) Artist(opts ...func(*entity.Artist)) *entity.Artist {
+	f.t.Helper()
+	a := &entity.Artist{
+		ID:   f.ID(),
+		Name: fmt.Sprintf("Fixture Artist %d", f.next()),
+		MBID: f.ID(),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	created, err := f.artistRepo.Create(context.Background(), a)
+	require.NoError(f.t, err)
+	require.Len(f.t, created, 1)
+	return created[0]
+}
+
+// Venue inserts a venue with a unique name.
+func (f *Fixtures) Venue(opts ...func(*entity.Venue)) *entity.Venue {
+	f.t.Helper()
+	v := &entity.Venue{
+		ID:   f.ID(),
+		Name: fmt.Sprintf("Fixture Venue %d", f.next()),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	require.NoError(f.t, f.venueRepo.Create(context.Background(), v))
+	return v
+}
+
+// Series inserts a SINGLE series with a unique title.
+func (f *Fixtures) Series(opts ...func(*entity.Series)) *entity.Series {
+	f.t.Helper()
+	s := &entity.Series{
+		ID:    f.ID(),
+		Title: fmt.Sprintf("Fixture Series %d", f.next()),
+		Type:  entity.SeriesTypeSingle,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	_, err := f.seriesRepo.Create(context.Background(), s)
+	require.NoError(f.t, err)
+	return s
+}
+
+// Concert inserts a concert through ConcertRepository.Create. By default it
+// is dated one month from today in a series, at a venue, and by an artist of
+// its own; options replace any of them. A concert that shares its natural key
+// with an existing event is merged into that event, as Create does.
+func (f *Fixtures) Concert(opts ...func(*entity.Concert)) *entity.Concert {
+	f.t.Helper()
+	c := &entity.Concert{
+		Event: entity.Event{
+			ID:        f.ID(),
+			LocalDate: time.Now().UTC().AddDate(0, 1, 0).Truncate(24 * time.Hour),
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.Series == nil {
+		c.Series = f.Series()
+	}
+	c.SeriesID = c.Series.ID
+	if c.VenueID == "" && c.ListedVenueName == nil {
+		c.VenueID = f.Venue().ID
+	}
+	if len(c.Performers) == 0 {
+		c.Performers = []*entity.Artist{f.Artist()}
+	}
+
+	_, err := f.concertRepo.Create(context.Background(), c)
+	require.NoError(f.t, err)
+	return c
+}
+
+// AtVenue places a concert at the venue.
+func AtVenue(venueID string) func(*entity.Concert) {
+	return func(c *entity.Concert) { c.VenueID = venueID }
+}
+
+// On dates a concert.
+func On(date time.Time) func(*entity.Concert) {
+	return func(c *entity.Concert) { c.LocalDate = date }
+}
+
+// StartingAt sets a concert's start time.
+func StartingAt(start time.Time) func(*entity.Concert) {
+	return func(c *entity.Concert) { c.StartTime = &start }
+}
+
+// PerformedBy sets a concert's performers.
+func PerformedBy(artistIDs ...string) func(*entity.Concert) {
+	return func(c *entity.Concert) {
+		c.Performers = make([]*entity.Artist, len(artistIDs))
+		for i, id := range artistIDs {
+			c.Performers[i] = &entity.Artist{ID: id}
+		}
+	}
+}
+
+// InSeries places a concert in an existing series.
+func InSeries(s *entity.Series) func(*entity.Concert) {
+	return func(c *entity.Concert) { c.Series = s }
+}
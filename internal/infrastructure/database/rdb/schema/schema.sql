@@ -5,6 +5,9 @@
 CREATE SCHEMA IF NOT EXISTS app;
 SET search_path TO app, public;
 
+-- Trigram operator classes for the substring indexes of concert search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 -- Users table
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY,
@@ -17,9 +20,11 @@ CREATE TABLE IF NOT EXISTS users (
     is_active BOOLEAN NOT NULL DEFAULT TRUE,
     safe_address TEXT,
     home_id UUID,
+    concert_reminder_days SMALLINT NOT NULL DEFAULT 1,
     CONSTRAINT users_safe_address_unique UNIQUE (safe_address),
     CONSTRAINT chk_safe_address_format CHECK (safe_address IS NULL OR safe_address ~ '^0x[0-9a-fA-F]{40}$'),
-    CONSTRAINT chk_users_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
+    CONSTRAINT chk_users_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_users_concert_reminder_days CHECK (concert_reminder_days BETWEEN 0 AND 30)
 );
 
 COMMENT ON TABLE users IS 'User profiles and authentication data';
@@ -33,6 +38,7 @@ COMMENT ON COLUMN users.time_zone IS 'User time zone (IANA time zone database)';
 COMMENT ON COLUMN users.is_active IS 'Whether the user account is active';
 COMMENT ON COLUMN users.safe_address IS 'Predicted Safe (ERC-4337) address derived deterministically from users.id via CREATE2';
 COMMENT ON COLUMN users.home_id IS 'Reference to the user home area in the homes table. NULL when home is not set.';
+COMMENT ON COLUMN users.concert_reminder_days IS 'How many days before a concert the user is reminded of it (0 = on the day itself)';
 
 -- Homes table
 CREATE TABLE IF NOT EXISTS homes (
@@ -81,14 +87,15 @@ COMMENT ON COLUMN artists.fanart_synced_at IS 'Timestamp of the last successful
 -- Artist official site
 CREATE TABLE IF NOT EXISTS artist_official_site (
     id UUID PRIMARY KEY,
-    artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE UNIQUE,
+    artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
     url TEXT NOT NULL,
+    CONSTRAINT uq_artist_official_site_artist_url UNIQUE (artist_id, url),
     CONSTRAINT chk_artist_official_site_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
 );
 
-COMMENT ON TABLE artist_official_site IS 'Stores the official website URL for each artist, used for concert search grounding.';
+COMMENT ON TABLE artist_official_site IS 'Stores the official website URLs for each artist, used for concert search grounding.';
 COMMENT ON COLUMN artist_official_site.id IS 'Unique identifier (UUIDv7, application-generated)';
-COMMENT ON COLUMN artist_official_site.artist_id IS 'Reference to the artist (1:1 relationship)';
+COMMENT ON COLUMN artist_official_site.artist_id IS 'Reference to the artist (1:N relationship; the earliest row is the primary site)';
 COMMENT ON COLUMN artist_official_site.url IS 'Official artist website URL';
 
 -- Venues table
@@ -100,7 +107,21 @@ CREATE TABLE IF NOT EXISTS venues (
     latitude DOUBLE PRECISION,
     longitude DOUBLE PRECISION,
     listed_venue_name TEXT,
+    search_vector TSVECTOR NOT NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple', name || ' ' || COALESCE(listed_venue_name, '')), 'B')) STORED,
+    enrichment_status TEXT NOT NULL DEFAULT 'pending',
+    enrichment_attempts INTEGER NOT NULL DEFAULT 0,
+    last_enrichment_attempt_at TIMESTAMPTZ,
+    duplicate_of_venue_id UUID REFERENCES venues(id) ON DELETE SET NULL,
+    mbid TEXT,
+    enrichment_confidence DOUBLE PRECISION,
+    proposed_place_id TEXT,
+    proposed_name TEXT,
+    proposed_latitude DOUBLE PRECISION,
+    proposed_longitude DOUBLE PRECISION,
     CONSTRAINT chk_venues_name_not_empty CHECK (name <> ''),
+    CONSTRAINT chk_venues_enrichment_status CHECK (enrichment_status IN ('pending', 'enriched', 'failed', 'duplicate', 'needs_review', 'rejected')),
+    CONSTRAINT chk_venues_enrichment_attempts CHECK (enrichment_attempts >= 0),
+    CONSTRAINT chk_venues_enrichment_confidence CHECK (enrichment_confidence >= 0 AND enrichment_confidence <= 1),
     CONSTRAINT chk_venues_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
 );
 
@@ -112,6 +133,17 @@ COMMENT ON COLUMN venues.google_place_id IS 'Google Maps Place ID for the canoni
 COMMENT ON COLUMN venues.latitude IS 'WGS 84 latitude of the venue from Google Places API';
 COMMENT ON COLUMN venues.longitude IS 'WGS 84 longitude of the venue from Google Places API';
 COMMENT ON COLUMN venues.listed_venue_name IS 'Raw scraped venue name as returned by Gemini; used for DB-first lookup to avoid redundant Places API calls';
+COMMENT ON COLUMN venues.search_vector IS 'Generated keyword-search lexemes of the canonical and listed venue names (simple configuration, weight B)';
+COMMENT ON COLUMN venues.enrichment_status IS 'Place enrichment state: pending (default, awaiting enrichment), enriched (resolved to a place), failed (no unambiguous place match), duplicate (its place is held by duplicate_of_venue_id, awaiting an admin merge), needs_review (an uncertain match awaits an admin decision on the proposed_* place), or rejected (an admin rejected the proposed place; not retried)';
+COMMENT ON COLUMN venues.enrichment_attempts IS 'Number of failed place enrichment attempts; incremented each time the venue is marked failed, but not for a transient place search outage';
+COMMENT ON COLUMN venues.last_enrichment_attempt_at IS 'When place enrichment last failed, including transient place search outages; NULL when it never failed, or failed before attempts were timed';
+COMMENT ON COLUMN venues.duplicate_of_venue_id IS 'Venue already holding this venue''s place, set when enrichment flags it as a duplicate; NULL otherwise';
+COMMENT ON COLUMN venues.mbid IS 'MusicBrainz Place ID (MBID) resolved during enrichment; NULL when MusicBrainz has no unambiguous place in the venue''s admin area';
+COMMENT ON COLUMN venues.enrichment_confidence IS 'Confidence (0 to 1) that the place held for review matches the listed venue name; kept after the review is decided; NULL for matches applied without review';
+COMMENT ON COLUMN venues.proposed_place_id IS 'Google Maps Place ID of the uncertain match awaiting review; NULL unless enrichment_status is needs_review';
+COMMENT ON COLUMN venues.proposed_name IS 'Canonical name of the uncertain match awaiting review; NULL unless enrichment_status is needs_review';
+COMMENT ON COLUMN venues.proposed_latitude IS 'WGS 84 latitude of the uncertain match awaiting review; NULL unless enrichment_status is needs_review or the place has no coordinates';
+COMMENT ON COLUMN venues.proposed_longitude IS 'WGS 84 longitude of the uncertain match awaiting review; NULL unless enrichment_status is needs_review or the place has no coordinates';
 
 -- Series type enum
 CREATE TYPE series_type AS ENUM ('TOUR', 'SINGLE', 'FESTIVAL');
@@ -125,6 +157,7 @@ CREATE TABLE IF NOT EXISTS series (
     type series_type NOT NULL,
     source_url TEXT,
     merch_url TEXT,
+    search_vector TSVECTOR NOT NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple', title), 'A')) STORED,
     CONSTRAINT chk_series_title_not_empty CHECK (title <> ''),
     CONSTRAINT chk_series_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
 );
@@ -135,31 +168,51 @@ COMMENT ON COLUMN series.title IS 'Series title shared across all member events
 COMMENT ON COLUMN series.type IS 'Classification of the series; drives presentation and notification grouping';
 COMMENT ON COLUMN series.source_url IS 'Optional series-level official URL (tour page, festival page); per-event URLs are not stored';
 COMMENT ON COLUMN series.merch_url IS 'Optional official merchandise information page (official site page or official social media post) shared across the series; populated asynchronously by the merch-url discovery job. Stores only the link — no sale timing, channel, price, or item data.';
+COMMENT ON COLUMN series.search_vector IS 'Generated keyword-search lexemes of the title (simple configuration, weight A)';
 
 -- Events table
 CREATE TABLE IF NOT EXISTS events (
     id UUID PRIMARY KEY,
     series_id UUID NOT NULL REFERENCES series(id) ON DELETE CASCADE,
-    venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    venue_id UUID REFERENCES venues(id) ON DELETE CASCADE,
     listed_venue_name TEXT,
     local_event_date DATE NOT NULL,
+    previous_local_event_date DATE,
     start_at TIMESTAMPTZ,
     open_at TIMESTAMPTZ,
     merkle_root BYTEA,
-    CONSTRAINT uq_events_natural_key UNIQUE NULLS NOT DISTINCT (venue_id, local_event_date, start_at),
-    CONSTRAINT chk_events_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
+    search_session_id UUID,
+    deleted_at TIMESTAMPTZ,
+    merkle_tree_depth INT DEFAULT 20,
+    merkle_root_version INT NOT NULL DEFAULT 0,
+    venue_resolution_attempted_at TIMESTAMPTZ,
+    pending_venue_name TEXT GENERATED ALWAYS AS (CASE WHEN venue_id IS NULL THEN listed_venue_name END) STORED,
+    search_vector TSVECTOR NOT NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple', COALESCE(listed_venue_name, '')), 'B')) STORED,
+    CONSTRAINT uq_events_natural_key UNIQUE NULLS NOT DISTINCT (venue_id, pending_venue_name, local_event_date, start_at),
+    CONSTRAINT chk_events_venue_or_listed_name CHECK (venue_id IS NOT NULL OR listed_venue_name IS NOT NULL),
+    CONSTRAINT chk_events_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_events_merkle_tree_depth CHECK (merkle_tree_depth BETWEEN 1 AND 20),
+    CONSTRAINT chk_events_merkle_root_version CHECK (merkle_root_version >= 0)
 );
 
 COMMENT ON TABLE events IS 'A single performance occurring on a specific date at a specific venue. Belongs to exactly one parent series.';
-COMMENT ON CONSTRAINT uq_events_natural_key ON events IS 'Physical identity of a performance: one row per (venue, local date, start time), independent of series or performing artist. start_at is part of the key so two shows at one venue on one date with different start times (matinee/evening) are distinct; NULLS NOT DISTINCT collapses two shows whose start time is not yet published. The same physical show discovered via different artists/series resolves to one row.';
+COMMENT ON CONSTRAINT uq_events_natural_key ON events IS 'Physical identity of a performance: one row per (venue, local date, start time), independent of series or performing artist. start_at is part of the key so two shows at one venue on one date with different start times (matinee/evening) are distinct; NULLS NOT DISTINCT collapses two shows whose start time is not yet published. The same physical show discovered via different artists/series resolves to one row. An event pending venue resolution is keyed by its listed venue name (pending_venue_name) instead.';
 COMMENT ON COLUMN events.id IS 'Unique event identifier (UUIDv7, application-generated)';
 COMMENT ON COLUMN events.series_id IS 'Reference to the parent series that aggregates this event with any sibling events. Not part of the natural key — series is a grouping parent, not a component of event identity.';
-COMMENT ON COLUMN events.venue_id IS 'Reference to the venue hosting the event';
+COMMENT ON COLUMN events.venue_id IS 'Reference to the venue hosting the event; NULL while the venue is pending resolution, in which case listed_venue_name is set';
 COMMENT ON COLUMN events.listed_venue_name IS 'Raw venue name as scraped from the source, preserved separately from the normalized venue record';
 COMMENT ON COLUMN events.local_event_date IS 'Date of the event';
+COMMENT ON COLUMN events.previous_local_event_date IS 'Date the event was scheduled for before its most recent reschedule; NULL when the event has never been rescheduled';
 COMMENT ON COLUMN events.start_at IS 'Event start time (absolute)';
 COMMENT ON COLUMN events.open_at IS 'Doors open time (absolute), if available';
 COMMENT ON COLUMN events.merkle_root IS 'Merkle tree root hash for ZKP identity set; NULL for non-ticket events';
+COMMENT ON COLUMN events.search_session_id IS 'Discovery search session that first created this event. Kept when a later session re-discovers the same physical event; NULL for events created outside discovery or before sessions were recorded';
+COMMENT ON COLUMN events.deleted_at IS 'When an admin soft-deleted this event (e.g. a hallucinated discovery). Soft-deleted events are hidden from artist and follower listings but kept for audit; NULL while the event is live';
+COMMENT ON COLUMN events.merkle_tree_depth IS 'Depth of the event''s ZKP entry Merkle tree; must match the depth of the circuit that proves membership. NULL for events created before the depth was recorded, which are read as the depth of their stored tree, or 20 (the circuit depth) when none was built';
+COMMENT ON COLUMN events.merkle_root_version IS 'Number of times merkle_root has been written; 0 while no root has been set. Incremented by every root update';
+COMMENT ON COLUMN events.venue_resolution_attempted_at IS 'When the venue-resolution backfill last tried and failed to link this event pending venue resolution; NULL if it never has. Orders the backfill so names that keep failing do not starve the rest';
+COMMENT ON COLUMN events.pending_venue_name IS 'listed_venue_name while venue_id is NULL, otherwise NULL. Generated; keys a venueless event in uq_events_natural_key';
+COMMENT ON COLUMN events.search_vector IS 'Generated keyword-search lexemes of the listed venue name (simple configuration, weight B)';
 
 -- Concerts table
 CREATE TABLE IF NOT EXISTS concerts (
@@ -173,12 +226,14 @@ COMMENT ON COLUMN concerts.event_id IS 'Reference to the generic event (PK/FK)';
 CREATE TABLE IF NOT EXISTS event_performers (
     event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
     artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    set_start_at TIMESTAMPTZ,
     PRIMARY KEY (event_id, artist_id)
 );
 
 COMMENT ON TABLE event_performers IS 'M:N relation between events and performing artists. Supports festival lineups, co-headliners, and support acts.';
 COMMENT ON COLUMN event_performers.event_id IS 'Reference to the event';
 COMMENT ON COLUMN event_performers.artist_id IS 'Reference to the performing artist';
+COMMENT ON COLUMN event_performers.set_start_at IS 'The performer''s own set time within the event. A festival day shared by several artists is one event with no start time of its own, so each artist''s slot is kept here; NULL when unknown or when the performer plays at the event''s start time';
 
 -- User artist follows
 CREATE TABLE IF NOT EXISTS followed_artists (
@@ -194,6 +249,27 @@ COMMENT ON COLUMN followed_artists.user_id IS 'Reference to the user who is foll
 COMMENT ON COLUMN followed_artists.artist_id IS 'Reference to the artist being followed';
 COMMENT ON COLUMN followed_artists.hype IS 'User enthusiasm tier: watch (no notifications), home (home area only), nearby (within ~200km of home, default), or away (all concerts)';
 
+-- Follow history table (audit of follow mutations)
+CREATE TABLE IF NOT EXISTS follow_history (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    action TEXT NOT NULL,
+    hype TEXT,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_follow_history_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_follow_history_action CHECK (action IN ('follow', 'unfollow', 'hype_change')),
+    CONSTRAINT chk_follow_history_hype CHECK (hype IS NULL OR hype IN ('watch', 'home', 'nearby', 'away'))
+);
+
+COMMENT ON TABLE follow_history IS 'Append-only log of follow, unfollow, and hype changes per user. Written in the same statement as the followed_artists mutation so a user''s follow history can be reconstructed when debugging onboarding.';
+COMMENT ON COLUMN follow_history.id IS 'Unique history entry identifier (UUIDv7, application-generated); breaks ties between actions in the same instant';
+COMMENT ON COLUMN follow_history.user_id IS 'The user who performed the action';
+COMMENT ON COLUMN follow_history.artist_id IS 'The artist the action applies to';
+COMMENT ON COLUMN follow_history.action IS 'What changed: follow, unfollow, or hype_change';
+COMMENT ON COLUMN follow_history.hype IS 'Hype tier in effect after the action; NULL for unfollow';
+COMMENT ON COLUMN follow_history.occurred_at IS 'Timestamp when the action was committed';
+
 -- Latest search logs table
 CREATE TABLE IF NOT EXISTS latest_search_logs (
     artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
@@ -209,6 +285,51 @@ COMMENT ON COLUMN latest_search_logs.searched_at IS 'Timestamp of the most recen
 COMMENT ON COLUMN latest_search_logs.status IS 'Search job status: pending, completed, or failed';
 COMMENT ON COLUMN latest_search_logs.last_found_at IS 'Timestamp of the most recent search that discovered at least one new concert; NULL if none ever found';
 
+-- Concert search tasks table (durable background search queue)
+CREATE TABLE IF NOT EXISTS concert_search_tasks (
+    id UUID PRIMARY KEY,
+    artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'queued',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    enqueued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    claimed_at TIMESTAMPTZ,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_concert_search_tasks_artist_id UNIQUE (artist_id),
+    CONSTRAINT chk_concert_search_tasks_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_concert_search_tasks_status CHECK (status IN ('queued', 'running')),
+    CONSTRAINT chk_concert_search_tasks_attempts CHECK (attempts >= 0)
+);
+
+COMMENT ON TABLE concert_search_tasks IS 'Durable queue of background concert searches enqueued during onboarding. Holds only outstanding tasks; a successful search deletes its row, so there is at most one task per artist.';
+COMMENT ON COLUMN concert_search_tasks.id IS 'Unique task identifier (UUIDv7, application-generated)';
+COMMENT ON COLUMN concert_search_tasks.artist_id IS 'The artist whose concerts should be searched. Unique so repeated enqueues collapse onto one task.';
+COMMENT ON COLUMN concert_search_tasks.status IS 'Task state: queued (waiting for a worker) or running (claimed by a worker)';
+COMMENT ON COLUMN concert_search_tasks.attempts IS 'Number of times a worker has claimed the task, including the in-flight attempt';
+COMMENT ON COLUMN concert_search_tasks.last_error IS 'Error message from the most recent failed attempt; NULL if no attempt has failed';
+COMMENT ON COLUMN concert_search_tasks.enqueued_at IS 'Timestamp when the task was enqueued. The queue drains in FIFO order on this column.';
+COMMENT ON COLUMN concert_search_tasks.claimed_at IS 'Timestamp when a worker last claimed the task; a running task with an expired claim is reclaimable. NULL until first claimed.';
+COMMENT ON COLUMN concert_search_tasks.next_attempt_at IS 'Earliest time the task may be claimed. Pushed into the future with exponential backoff after each failed attempt.';
+
+-- Concert search dead letters table
+CREATE TABLE IF NOT EXISTS concert_search_dead_letters (
+    id UUID PRIMARY KEY,
+    artist_id UUID NOT NULL REFERENCES artists(id) ON DELETE CASCADE,
+    attempts INTEGER NOT NULL,
+    last_error TEXT,
+    enqueued_at TIMESTAMPTZ NOT NULL,
+    dead_lettered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_concert_search_dead_letters_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
+);
+
+COMMENT ON TABLE concert_search_dead_letters IS 'Background concert search tasks that exhausted their retries. Moved out of concert_search_tasks so a permanently failing artist no longer occupies the worker; kept for operator inspection.';
+COMMENT ON COLUMN concert_search_dead_letters.id IS 'Identifier of the original task (UUIDv7), preserved from concert_search_tasks';
+COMMENT ON COLUMN concert_search_dead_letters.artist_id IS 'The artist whose concert search kept failing';
+COMMENT ON COLUMN concert_search_dead_letters.attempts IS 'Number of attempts made before the task was dead-lettered';
+COMMENT ON COLUMN concert_search_dead_letters.last_error IS 'Error message from the final failed attempt; NULL when the task was abandoned by crashed workers without a recorded error';
+COMMENT ON COLUMN concert_search_dead_letters.enqueued_at IS 'Timestamp when the original task was enqueued';
+COMMENT ON COLUMN concert_search_dead_letters.dead_lettered_at IS 'Timestamp when the task was moved to the dead-letter table';
+
 -- Tickets table (Soulbound Ticket ERC-5192)
 CREATE TABLE IF NOT EXISTS tickets (
     id UUID PRIMARY KEY,
@@ -217,7 +338,9 @@ CREATE TABLE IF NOT EXISTS tickets (
     token_id NUMERIC(78, 0) NOT NULL,
     tx_hash TEXT NOT NULL,
     minted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    CONSTRAINT chk_tickets_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
+    leaf_index INT,
+    CONSTRAINT chk_tickets_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_tickets_leaf_index CHECK (leaf_index >= 0)
 );
 
 COMMENT ON TABLE tickets IS 'Soulbound Ticket (ERC-5192) ownership records linking users to event tokens on-chain';
@@ -227,6 +350,7 @@ COMMENT ON COLUMN tickets.user_id IS 'Reference to the ticket holder';
 COMMENT ON COLUMN tickets.token_id IS 'On-chain ERC-721 token ID minted on Base Sepolia';
 COMMENT ON COLUMN tickets.tx_hash IS 'Blockchain transaction hash of the mint operation';
 COMMENT ON COLUMN tickets.minted_at IS 'Timestamp when the ticket was minted on-chain';
+COMMENT ON COLUMN tickets.leaf_index IS 'Position of the holder''s identity commitment among the event''s Merkle tree leaves; NULL until the first tree build after mint, then never changed';
 
 -- Merkle tree nodes table for ZKP identity set per event
 CREATE TABLE IF NOT EXISTS merkle_tree (
@@ -246,6 +370,25 @@ COMMENT ON COLUMN merkle_tree.depth IS 'Tree depth level (0 = leaves, max = root
 COMMENT ON COLUMN merkle_tree.node_index IS 'Node position at the given depth level';
 COMMENT ON COLUMN merkle_tree.hash IS 'Poseidon hash value of the node';
 
+-- Merkle root history table (audit of tree rebuilds)
+CREATE TABLE IF NOT EXISTS merkle_root_history (
+    id UUID PRIMARY KEY,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    root BYTEA NOT NULL,
+    leaf_count INT NOT NULL,
+    built_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_merkle_root_history_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_merkle_root_history_leaf_count CHECK (leaf_count >= 0),
+    CONSTRAINT chk_merkle_root_history_root_size CHECK (octet_length(root) = 32)
+);
+
+COMMENT ON TABLE merkle_root_history IS 'Append-only log of every Merkle root an event has had. One row per tree build, written in the same transaction that replaces the tree, so entry-verification disputes can be traced to the root in force at the time.';
+COMMENT ON COLUMN merkle_root_history.id IS 'Unique history entry identifier (UUIDv7, application-generated); breaks ties between builds in the same instant';
+COMMENT ON COLUMN merkle_root_history.event_id IS 'The event whose Merkle tree was built';
+COMMENT ON COLUMN merkle_root_history.root IS 'Merkle root hash produced by the build';
+COMMENT ON COLUMN merkle_root_history.leaf_count IS 'Number of identity commitments in the tree, excluding zero-hash padding leaves';
+COMMENT ON COLUMN merkle_root_history.built_at IS 'Timestamp when the build was committed';
+
 -- Nullifiers table for double-entry prevention
 CREATE TABLE IF NOT EXISTS nullifiers (
     event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
@@ -260,6 +403,43 @@ COMMENT ON COLUMN nullifiers.event_id IS 'Reference to the event this nullifier
 COMMENT ON COLUMN nullifiers.nullifier_hash IS 'The nullifier hash from the ZK proof; unique per event to prevent reuse';
 COMMENT ON COLUMN nullifiers.used_at IS 'Timestamp when the nullifier was consumed for event entry';
 
+-- Entry verification audit table (append-only). event_id has no foreign key:
+-- rejected attempts are audited before the event is looked up, and the audit
+-- survives event deletion.
+CREATE TABLE IF NOT EXISTS entry_verification_audit (
+    id UUID PRIMARY KEY,
+    event_id UUID NOT NULL,
+    nullifier_hash BYTEA,
+    outcome TEXT NOT NULL,
+    reason TEXT,
+    verified_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_entry_verification_audit_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7'),
+    CONSTRAINT chk_entry_verification_audit_outcome CHECK (outcome IN ('verified', 'rejected')),
+    CONSTRAINT chk_entry_verification_audit_reason CHECK ((outcome = 'rejected') = (reason IS NOT NULL)),
+    CONSTRAINT chk_entry_verification_audit_nullifier CHECK (outcome = 'rejected' OR nullifier_hash IS NOT NULL)
+);
+
+COMMENT ON TABLE entry_verification_audit IS 'Append-only audit of every entry verification outcome, for reconstructing gate activity and investigating entry disputes.';
+COMMENT ON COLUMN entry_verification_audit.id IS 'Unique audit record identifier (UUIDv7, application-generated).';
+COMMENT ON COLUMN entry_verification_audit.event_id IS 'The event the entry pass was presented for. Intentionally not a foreign key so the audit survives event deletion.';
+COMMENT ON COLUMN entry_verification_audit.nullifier_hash IS 'The nullifier hash from the proof''s public signals, identifying the entry pass without revealing its holder; NULL when a rejected attempt''s signals could not be parsed';
+COMMENT ON COLUMN entry_verification_audit.outcome IS 'Verification outcome: verified (holder admitted) or rejected';
+COMMENT ON COLUMN entry_verification_audit.reason IS 'Why a rejected attempt was turned away, e.g. already_checked_in, proof_invalid or malformed_signals. NULL when verified.';
+COMMENT ON COLUMN entry_verification_audit.verified_at IS 'Timestamp when the verification outcome was decided';
+
+-- Processed messages table (consumer idempotency)
+CREATE TABLE IF NOT EXISTS processed_messages (
+    handler_name TEXT NOT NULL,
+    dedup_key TEXT NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (handler_name, dedup_key)
+);
+
+COMMENT ON TABLE processed_messages IS 'Event messages already handled by an idempotent consumer handler. A row commits together with the handler''s writes, so a redelivered message is skipped; rows older than the dedup window are pruned.';
+COMMENT ON COLUMN processed_messages.handler_name IS 'Name of the consumer handler that processed the message; handlers subscribed to the same subject deduplicate independently';
+COMMENT ON COLUMN processed_messages.dedup_key IS 'Message dedup key: the CloudEvents id (ce_id) header, or the message UUID when absent';
+COMMENT ON COLUMN processed_messages.processed_at IS 'Timestamp when the message was processed; a record older than the dedup window no longer suppresses redelivery';
+
 -- Ticket journeys table (user-managed ticket acquisition tracking)
 CREATE TABLE IF NOT EXISTS ticket_journeys (
     user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
@@ -348,7 +528,7 @@ CREATE TABLE IF NOT EXISTS notifications (
 COMMENT ON TABLE notifications IS 'Notification log: one durable record per user-facing notification, with per-channel delivery state (queued/delivered/failed) and per-user read/dismiss state. Source of truth for delivery auditing and the in-app inbox.';
 COMMENT ON COLUMN notifications.id IS 'Unique notification identifier (UUIDv7, application-generated). Propagated into the push payload data.notification_id as the end-to-end correlation key.';
 COMMENT ON COLUMN notifications.user_id IS 'Reference to the recipient user';
-COMMENT ON COLUMN notifications.type IS 'Notification type: new_concerts, sales_reminder, sales_phase_announcement';
+COMMENT ON COLUMN notifications.type IS 'Notification type: new_concerts, sales_reminder, sales_phase_announcement, concert_reminder, concert_rescheduled';
 COMMENT ON COLUMN notifications.payload IS 'Rendered notification payload (title, body, url, tag) as delivered to the channel';
 COMMENT ON COLUMN notifications.delivery_status IS 'Web-push channel delivery state: queued (on creation), delivered (push service accepted the send), or failed';
 COMMENT ON COLUMN notifications.failure_reason IS 'Human-readable reason set when delivery_status is failed; NULL otherwise';
@@ -418,6 +598,24 @@ COMMENT ON COLUMN sales_phase_reminders.sales_phase_id IS 'Reference to the sale
 COMMENT ON COLUMN sales_phase_reminders.stage IS 'Reminder stage: 1=APPLY_OPEN (at apply_start_time), 2=APPLY_CLOSE_24H (24h before apply_end_time), 3=APPLY_CLOSE_1H (1h before apply_end_time), 4=RESULT_DAY (09:00 on lottery_result_time day). Payment-deadline stage deferred.';
 COMMENT ON COLUMN sales_phase_reminders.sent_at IS 'Timestamp when the reminder was dispatched';
 
+-- Concert reminders sent-log
+-- Tracks which concerts each user has already been reminded of, so the
+-- concert-reminders job delivers at most one reminder per (user, event).
+CREATE TABLE IF NOT EXISTS concert_reminders (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_concert_reminders UNIQUE (user_id, event_id),
+    CONSTRAINT chk_concert_reminders_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
+);
+
+COMMENT ON TABLE concert_reminders IS 'Sent-log for concert reminder notifications. UNIQUE (user_id, event_id) prevents duplicate dispatches.';
+COMMENT ON COLUMN concert_reminders.id IS 'Unique reminder record identifier (UUIDv7, application-generated)';
+COMMENT ON COLUMN concert_reminders.user_id IS 'Reference to the user who received the reminder';
+COMMENT ON COLUMN concert_reminders.event_id IS 'Reference to the event the reminder was for';
+COMMENT ON COLUMN concert_reminders.sent_at IS 'Timestamp when the reminder was dispatched';
+
 -- Staged concerts (approval queue)
 -- Concerts discovered by the Gemini search pipeline are held here in a pending
 -- state until a developer approves them in the admin console. Venue resolution
@@ -441,6 +639,9 @@ CREATE TABLE IF NOT EXISTS staged_concerts (
     resolved_latitude DOUBLE PRECISION,
     resolved_longitude DOUBLE PRECISION,
     discovered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    search_session_id UUID,
+    is_festival BOOLEAN NOT NULL DEFAULT false,
+    reschedules_event_id UUID REFERENCES events(id) ON DELETE SET NULL,
     CONSTRAINT chk_staged_concerts_id_uuidv7 CHECK (substring(id::text, 15, 1) = '7')
 );
 
@@ -460,6 +661,9 @@ COMMENT ON COLUMN staged_concerts.resolved_admin_area IS 'ISO 3166-2 admin area
 COMMENT ON COLUMN staged_concerts.resolved_latitude IS 'WGS 84 latitude of the resolved venue. NULL when unresolved.';
 COMMENT ON COLUMN staged_concerts.resolved_longitude IS 'WGS 84 longitude of the resolved venue. NULL when unresolved.';
 COMMENT ON COLUMN staged_concerts.discovered_at IS 'Timestamp when the discovery pipeline staged this concert. Used to order the review queue.';
+COMMENT ON COLUMN staged_concerts.search_session_id IS 'Discovery search session that first staged this concert; copied onto the event on approval. NULL for rows staged before sessions were recorded';
+COMMENT ON COLUMN staged_concerts.is_festival IS 'Whether the concert is an appearance on a festival lineup. Approval files it under a FESTIVAL series so every artist on the day shares one event.';
+COMMENT ON COLUMN staged_concerts.reschedules_event_id IS 'Published event this concert reschedules. Approval moves that event to local_date and notifies its ticket holders instead of publishing a new event. NULL for a new concert';
 
 -- Rejected concerts log (append-only)
 -- Every rejection is recorded here for search-quality analysis. It is NEVER read
@@ -518,14 +722,39 @@ COMMENT ON INDEX idx_users_email IS 'Speeds up user lookup by email during authe
 CREATE INDEX IF NOT EXISTS idx_artists_name ON artists(name);
 COMMENT ON INDEX idx_artists_name IS 'Speeds up artist search by name';
 
+CREATE INDEX IF NOT EXISTS idx_artists_name_trgm ON artists USING gin (name gin_trgm_ops);
+COMMENT ON INDEX idx_artists_name_trgm IS 'Supports case-insensitive substring concert search over performer names';
+
 -- Artist official site indexes
 CREATE INDEX IF NOT EXISTS idx_artist_official_site_artist_id ON artist_official_site(artist_id);
-COMMENT ON INDEX idx_artist_official_site_artist_id IS 'Optimizes retrieval of official site for an artist';
+COMMENT ON INDEX idx_artist_official_site_artist_id IS 'Optimizes retrieval of official sites for an artist';
 
 -- Venues indexes
 CREATE UNIQUE INDEX IF NOT EXISTS idx_venues_google_place_id ON venues (google_place_id) WHERE google_place_id IS NOT NULL;
 COMMENT ON INDEX idx_venues_google_place_id IS 'Ensures uniqueness of Google Maps Place ID across venue records';
 
+CREATE INDEX IF NOT EXISTS idx_venues_search_vector ON venues USING gin (search_vector);
+COMMENT ON INDEX idx_venues_search_vector IS 'Supports keyword search over venue names';
+
+CREATE INDEX IF NOT EXISTS idx_venues_name_trgm ON venues USING gin (name gin_trgm_ops);
+COMMENT ON INDEX idx_venues_name_trgm IS 'Supports case-insensitive substring concert search over canonical venue names';
+
+CREATE INDEX IF NOT EXISTS idx_venues_enrichment_status ON venues (enrichment_status, id);
+COMMENT ON INDEX idx_venues_enrichment_status IS 'Supports paging through venues by enrichment status in creation order';
+
+CREATE INDEX IF NOT EXISTS idx_venues_normalized_listed_venue_name ON venues ((lower(btrim(normalize(listed_venue_name, NFKC)))));
+COMMENT ON INDEX idx_venues_normalized_listed_venue_name IS 'Supports fuzzy venue lookup by NFKC-normalized, trimmed, case-folded listed venue name';
+
+CREATE INDEX IF NOT EXISTS idx_venues_normalized_name ON venues ((lower(btrim(normalize(name, NFKC)))));
+COMMENT ON INDEX idx_venues_normalized_name IS 'Supports fuzzy venue lookup by NFKC-normalized, trimmed, case-folded venue name';
+
+-- Series indexes
+CREATE INDEX IF NOT EXISTS idx_series_search_vector ON series USING gin (search_vector);
+COMMENT ON INDEX idx_series_search_vector IS 'Supports keyword search over series titles';
+
+CREATE INDEX IF NOT EXISTS idx_series_title_trgm ON series USING gin (title gin_trgm_ops);
+COMMENT ON INDEX idx_series_title_trgm IS 'Supports case-insensitive substring concert search over series titles';
+
 -- Events indexes
 CREATE INDEX IF NOT EXISTS idx_events_local_event_date ON events(local_event_date);
 COMMENT ON INDEX idx_events_local_event_date IS 'Speeds up date-based event searches and calendar views';
@@ -533,9 +762,21 @@ COMMENT ON INDEX idx_events_local_event_date IS 'Speeds up date-based event sear
 CREATE INDEX IF NOT EXISTS idx_events_venue_id ON events(venue_id);
 COMMENT ON INDEX idx_events_venue_id IS 'Optimizes listing events by venue';
 
+CREATE INDEX IF NOT EXISTS idx_events_pending_venue ON events(venue_resolution_attempted_at NULLS FIRST, local_event_date, id) WHERE venue_id IS NULL;
+COMMENT ON INDEX idx_events_pending_venue IS 'Optimizes listing events whose venue is pending resolution';
+
 CREATE INDEX IF NOT EXISTS idx_events_series_id ON events(series_id);
 COMMENT ON INDEX idx_events_series_id IS 'Optimizes listing all events belonging to a series';
 
+CREATE INDEX IF NOT EXISTS idx_events_search_session_id ON events(search_session_id) WHERE search_session_id IS NOT NULL;
+COMMENT ON INDEX idx_events_search_session_id IS 'Optimizes auditing the events a discovery search session produced';
+
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING gin (search_vector);
+COMMENT ON INDEX idx_events_search_vector IS 'Supports keyword search over listed venue names';
+
+CREATE INDEX IF NOT EXISTS idx_events_listed_venue_name_trgm ON events USING gin (listed_venue_name gin_trgm_ops);
+COMMENT ON INDEX idx_events_listed_venue_name_trgm IS 'Supports case-insensitive substring concert search over listed venue names';
+
 -- Event performers indexes
 CREATE INDEX IF NOT EXISTS idx_event_performers_artist_id ON event_performers(artist_id);
 COMMENT ON INDEX idx_event_performers_artist_id IS 'Optimizes lookup of all events for a given artist (reverse direction of the composite PK)';
@@ -551,6 +792,7 @@ COMMENT ON INDEX idx_followed_artists_artist_id IS 'Optimizes finding all follow
 CREATE UNIQUE INDEX IF NOT EXISTS idx_tickets_event_user ON tickets(event_id, user_id);
 CREATE INDEX IF NOT EXISTS idx_tickets_user_id ON tickets(user_id);
 CREATE UNIQUE INDEX IF NOT EXISTS idx_tickets_token_id ON tickets(token_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tickets_event_leaf_index ON tickets(event_id, leaf_index);
 
 -- Ticket journeys indexes
 CREATE INDEX IF NOT EXISTS idx_ticket_journeys_event_id ON ticket_journeys(event_id);
@@ -580,6 +822,10 @@ COMMENT ON INDEX idx_sales_phase_reminders_user_id IS 'Optimizes lookup of all r
 CREATE INDEX IF NOT EXISTS idx_sales_phase_reminders_sales_phase_id ON sales_phase_reminders(sales_phase_id);
 COMMENT ON INDEX idx_sales_phase_reminders_sales_phase_id IS 'Optimizes lookup of all reminder records for a sales phase';
 
+-- Concert reminders indexes
+CREATE INDEX IF NOT EXISTS idx_concert_reminders_event_id ON concert_reminders(event_id);
+COMMENT ON INDEX idx_concert_reminders_event_id IS 'Optimizes cascading deletes of reminder records for an event';
+
 -- Staged concerts indexes
 -- Two partial unique indexes form the NULL-safe natural key: when the venue
 -- resolved, dedup on the canonical place id; otherwise fall back to the raw
@@ -600,3 +846,27 @@ COMMENT ON INDEX idx_rejected_concerts_log_artist_id IS 'Supports per-artist ana
 
 CREATE INDEX IF NOT EXISTS idx_rejected_concerts_log_rejected_at ON rejected_concerts_log(rejected_at);
 COMMENT ON INDEX idx_rejected_concerts_log_rejected_at IS 'Supports time-windowed analysis of rejections';
+
+-- Concert search tasks indexes
+CREATE INDEX IF NOT EXISTS idx_concert_search_tasks_status_next_attempt ON concert_search_tasks(status, next_attempt_at);
+COMMENT ON INDEX idx_concert_search_tasks_status_next_attempt IS 'Supports the worker claim query: queued tasks whose backoff has elapsed';
+
+-- Concert search dead letters indexes
+CREATE INDEX IF NOT EXISTS idx_concert_search_dead_letters_artist_id ON concert_search_dead_letters(artist_id);
+COMMENT ON INDEX idx_concert_search_dead_letters_artist_id IS 'Supports looking up dead-lettered searches for an artist';
+
+-- Merkle root history indexes
+CREATE INDEX IF NOT EXISTS idx_merkle_root_history_event_built ON merkle_root_history(event_id, built_at DESC);
+COMMENT ON INDEX idx_merkle_root_history_event_built IS 'Supports listing an event''s root history newest first';
+
+-- Entry verification audit indexes
+CREATE INDEX IF NOT EXISTS idx_entry_verification_audit_event_verified_at ON entry_verification_audit(event_id, verified_at);
+COMMENT ON INDEX idx_entry_verification_audit_event_verified_at IS 'Supports replaying an event''s gate activity in time order';
+
+-- Follow history indexes
+CREATE INDEX IF NOT EXISTS idx_follow_history_user_occurred ON follow_history(user_id, occurred_at, id);
+COMMENT ON INDEX idx_follow_history_user_occurred IS 'Supports listing a user''s follow history in chronological order';
+
+-- Processed messages indexes
+CREATE INDEX IF NOT EXISTS idx_processed_messages_processed_at ON processed_messages(processed_at);
+COMMENT ON INDEX idx_processed_messages_processed_at IS 'Supports pruning records older than the dedup window';
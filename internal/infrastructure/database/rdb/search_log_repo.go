@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 )
 
 // SearchLogRepository implements entity.SearchLogRepository interface for PostgreSQL.
@@ -34,6 +36,17 @@ const (
 		SET last_found_at = NOW()
 		WHERE artist_id = $1
 	`
+	// listStaleSearchLogsQuery left-joins the logs so followed artists that
+	// were never searched (no row) are returned alongside those whose last
+	// search is older than $1 seconds.
+	listStaleSearchLogsQuery = `
+		SELECT a.id
+		FROM artists a
+		LEFT JOIN latest_search_logs sl ON sl.artist_id = a.id
+		WHERE EXISTS (SELECT 1 FROM followed_artists fa WHERE fa.artist_id = a.id)
+		  AND (sl.artist_id IS NULL OR sl.searched_at < NOW() - make_interval(secs => $1))
+		ORDER BY sl.searched_at NULLS FIRST, a.id
+	`
 	deleteSearchLogQuery = `
 		DELETE FROM latest_search_logs
 		WHERE artist_id = $1
@@ -91,6 +104,32 @@ func (r *SearchLogRepository) MarkFound(ctx context.Context, artistID string) er
 	return nil
 }
 
+// ListStale returns the IDs of followed artists due for a new search.
+func (r *SearchLogRepository) ListStale(ctx context.Context, olderThan time.Duration) ([]string, error) {
+	if olderThan < 0 {
+		return nil, apperr.New(codes.InvalidArgument, "olderThan must not be negative")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listStaleSearchLogsQuery, olderThan.Seconds())
+	if err != nil {
+		return nil, toAppErr(err, "failed to list stale search logs", slog.Duration("older_than", olderThan))
+	}
+	defer rows.Close()
+
+	var artistIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, toAppErr(err, "failed to scan stale search log")
+		}
+		artistIDs = append(artistIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating stale search log rows")
+	}
+	return artistIDs, nil
+}
+
 // Delete removes the search log for a specific artist.
 func (r *SearchLogRepository) Delete(ctx context.Context, artistID string) error {
 	_, err := r.db.Pool.Exec(ctx, deleteSearchLogQuery, artistID)
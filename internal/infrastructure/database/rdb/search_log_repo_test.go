@@ -7,6 +7,7 @@ import (
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb/rdbtest"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -256,3 +257,69 @@ func TestSearchLogRepository_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchLogRepository_ListStale(t *testing.T) {
+	repo := rdb.NewSearchLogRepository(testDB)
+	ctx := context.Background()
+
+	cleanDatabase(t)
+	f := rdbtest.New(t, testDB)
+	user := f.User()
+	follow := func(artistID string) {
+		t.Helper()
+		_, err := testDB.Pool.Exec(ctx,
+			"INSERT INTO followed_artists (user_id, artist_id) VALUES ($1, $2)", user.ID, artistID)
+		require.NoError(t, err)
+	}
+	searched := func(artistID string, ago time.Duration, status entity.SearchLogStatus) {
+		t.Helper()
+		require.NoError(t, repo.Upsert(ctx, artistID, status))
+		_, err := testDB.Pool.Exec(ctx,
+			"UPDATE latest_search_logs SET searched_at = NOW() - make_interval(secs => $2) WHERE artist_id = $1",
+			artistID, ago.Seconds())
+		require.NoError(t, err)
+	}
+
+	fresh := f.Artist().ID
+	follow(fresh)
+	searched(fresh, time.Hour, entity.SearchLogStatusCompleted)
+
+	stale := f.Artist().ID
+	follow(stale)
+	searched(stale, 48*time.Hour, entity.SearchLogStatusCompleted)
+
+	staleFailed := f.Artist().ID
+	follow(staleFailed)
+	searched(staleFailed, 30*time.Hour, entity.SearchLogStatusFailed)
+
+	never := f.Artist().ID
+	follow(never)
+
+	// Stale but followed by no one, so the discovery job has no reason to search it.
+	unfollowed := f.Artist().ID
+	searched(unfollowed, 48*time.Hour, entity.SearchLogStatusCompleted)
+	f.Artist() // never searched and unfollowed
+
+	t.Run("returns never-searched then longest-unsearched followed artists", func(t *testing.T) {
+		got, err := repo.ListStale(ctx, 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{never, stale, staleFailed}, got)
+	})
+
+	t.Run("shorter cutoff includes recently searched artists", func(t *testing.T) {
+		got, err := repo.ListStale(ctx, 30*time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, []string{never, stale, staleFailed, fresh}, got)
+	})
+
+	t.Run("longer cutoff leaves only never-searched artists", func(t *testing.T) {
+		got, err := repo.ListStale(ctx, 72*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{never}, got)
+	})
+
+	t.Run("negative cutoff returns InvalidArgument", func(t *testing.T) {
+		_, err := repo.ListStale(ctx, -time.Hour)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
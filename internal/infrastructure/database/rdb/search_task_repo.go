@@ -0,0 +1,128 @@
+package rdb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liverty-music/backend/internal/entity"
+)
+
+// SearchTaskRepository implements entity.SearchTaskRepository interface for PostgreSQL.
+type SearchTaskRepository struct {
+	db *Database
+}
+
+const (
+	enqueueSearchTaskQuery = `
+		INSERT INTO concert_search_tasks (id, artist_id)
+		VALUES ($1, $2)
+		ON CONFLICT (artist_id) DO NOTHING
+	`
+	// claimSearchTaskQuery picks the oldest claimable task and marks it running
+	// in a single statement. FOR UPDATE SKIP LOCKED lets concurrent workers
+	// each grab a different row instead of blocking on the same one. Queued
+	// tasks still backing off are skipped. A running task whose claim is older
+	// than the lease ($1 seconds) is reclaimed so a crashed worker cannot
+	// strand it.
+	claimSearchTaskQuery = `
+		UPDATE concert_search_tasks
+		SET status = 'running', attempts = attempts + 1, claimed_at = NOW()
+		WHERE id = (
+			SELECT id FROM concert_search_tasks
+			WHERE (status = 'queued' AND next_attempt_at <= NOW())
+			   OR (status = 'running' AND claimed_at < NOW() - make_interval(secs => $1))
+			ORDER BY next_attempt_at
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING id, artist_id, status, attempts, last_error, enqueued_at, claimed_at, next_attempt_at
+	`
+	completeSearchTaskQuery = `
+		DELETE FROM concert_search_tasks
+		WHERE id = $1
+	`
+	releaseSearchTaskQuery = `
+		UPDATE concert_search_tasks
+		SET status = 'queued', last_error = $2, next_attempt_at = NOW() + make_interval(secs => $3)
+		WHERE id = $1
+	`
+	// deadLetterSearchTaskQuery moves the task row into the dead-letter table
+	// in one statement so it can never exist in both, or neither.
+	deadLetterSearchTaskQuery = `
+		WITH moved AS (
+			DELETE FROM concert_search_tasks
+			WHERE id = $1
+			RETURNING id, artist_id, attempts, enqueued_at
+		)
+		INSERT INTO concert_search_dead_letters (id, artist_id, attempts, last_error, enqueued_at)
+		SELECT id, artist_id, attempts, NULLIF($2, ''), enqueued_at FROM moved
+		RETURNING id
+	`
+)
+
+// NewSearchTaskRepository creates a new search task repository instance.
+func NewSearchTaskRepository(db *Database) *SearchTaskRepository {
+	return &SearchTaskRepository{db: db}
+}
+
+// Enqueue adds a search task for the artist unless one is already outstanding.
+func (r *SearchTaskRepository) Enqueue(ctx context.Context, artistID string) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return toAppErr(err, "failed to generate UUIDv7 for search task")
+	}
+	_, err = r.db.Pool.Exec(ctx, enqueueSearchTaskQuery, id.String(), artistID)
+	if err != nil {
+		return toAppErr(err, "failed to enqueue search task", slog.String("artist_id", artistID))
+	}
+	return nil
+}
+
+// Claim marks the oldest claimable task as running and returns it.
+func (r *SearchTaskRepository) Claim(ctx context.Context, lease time.Duration) (*entity.SearchTask, error) {
+	var (
+		task      entity.SearchTask
+		status    string
+		lastError *string
+	)
+	err := r.db.Pool.QueryRow(ctx, claimSearchTaskQuery, lease.Seconds()).
+		Scan(&task.ID, &task.ArtistID, &status, &task.Attempts, &lastError, &task.EnqueueTime, &task.ClaimTime, &task.NextAttemptTime)
+	if err != nil {
+		return nil, toAppErr(err, "failed to claim search task")
+	}
+	task.Status = entity.SearchTaskStatus(status)
+	if lastError != nil {
+		task.LastError = *lastError
+	}
+	return &task, nil
+}
+
+// Complete deletes a finished task.
+func (r *SearchTaskRepository) Complete(ctx context.Context, id string) error {
+	_, err := r.db.Pool.Exec(ctx, completeSearchTaskQuery, id)
+	if err != nil {
+		return toAppErr(err, "failed to complete search task", slog.String("task_id", id))
+	}
+	return nil
+}
+
+// Release returns a claimed task to the queue after a failed attempt.
+func (r *SearchTaskRepository) Release(ctx context.Context, id, lastError string, backoff time.Duration) error {
+	_, err := r.db.Pool.Exec(ctx, releaseSearchTaskQuery, id, lastError, backoff.Seconds())
+	if err != nil {
+		return toAppErr(err, "failed to release search task", slog.String("task_id", id))
+	}
+	return nil
+}
+
+// DeadLetter moves a task that exhausted its retries into the dead-letter table.
+func (r *SearchTaskRepository) DeadLetter(ctx context.Context, id, lastError string) error {
+	var movedID string
+	err := r.db.Pool.QueryRow(ctx, deadLetterSearchTaskQuery, id, lastError).Scan(&movedID)
+	if err != nil {
+		return toAppErr(err, "failed to dead-letter search task", slog.String("task_id", id))
+	}
+	return nil
+}
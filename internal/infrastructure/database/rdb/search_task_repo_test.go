@@ -0,0 +1,264 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTaskRepository_Enqueue(t *testing.T) {
+	repo := rdb.NewSearchTaskRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "enqueued task is claimable",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				task, err := repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+				assert.Equal(t, artistID, task.ArtistID)
+				assert.Equal(t, entity.SearchTaskStatusRunning, task.Status)
+				assert.Equal(t, 1, task.Attempts)
+				assert.Empty(t, task.LastError)
+				require.NotNil(t, task.ClaimTime)
+				assert.WithinDuration(t, time.Now(), *task.ClaimTime, 5*time.Second)
+			},
+		},
+		{
+			name: "enqueue is idempotent per artist",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				_, err := repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+
+				_, err = repo.Claim(ctx, time.Minute)
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+			},
+		},
+		{
+			name: "unknown artist returns FailedPrecondition",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+
+				err := repo.Enqueue(ctx, "018b2f19-e591-7d12-bf9e-f0e74f1b49e5")
+				assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.run)
+	}
+}
+
+func TestSearchTaskRepository_Claim(t *testing.T) {
+	repo := rdb.NewSearchTaskRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "empty queue returns NotFound",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+
+				_, err := repo.Claim(ctx, time.Minute)
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+			},
+		},
+		{
+			name: "claims in FIFO order",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				first := seedArtist(t, "First Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				second := seedArtist(t, "Second Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c02")
+
+				require.NoError(t, repo.Enqueue(ctx, first))
+				require.NoError(t, repo.Enqueue(ctx, second))
+
+				task, err := repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+				assert.Equal(t, first, task.ArtistID)
+
+				task, err = repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+				assert.Equal(t, second, task.ArtistID)
+			},
+		},
+		{
+			name: "running task with expired lease is reclaimed",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				_, err := repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+
+				// Simulate a worker that died an hour ago mid-search.
+				_, err = testDB.Pool.Exec(ctx,
+					`UPDATE concert_search_tasks SET claimed_at = NOW() - INTERVAL '1 hour' WHERE artist_id = $1`,
+					artistID,
+				)
+				require.NoError(t, err)
+
+				task, err := repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+				assert.Equal(t, artistID, task.ArtistID)
+				assert.Equal(t, 2, task.Attempts)
+			},
+		},
+		{
+			name: "running task within lease is not reclaimed",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				_, err := repo.Claim(ctx, time.Hour)
+				require.NoError(t, err)
+
+				_, err = repo.Claim(ctx, time.Hour)
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.run)
+	}
+}
+
+func TestSearchTaskRepository_Finish(t *testing.T) {
+	repo := rdb.NewSearchTaskRepository(testDB)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "complete removes the task",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				task, err := repo.Claim(ctx, time.Minute)
+				require.NoError(t, err)
+				require.NoError(t, repo.Complete(ctx, task.ID))
+
+				_, err = repo.Claim(ctx, 0)
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+			},
+		},
+		{
+			name: "release requeues the task and records the error",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				task, err := repo.Claim(ctx, time.Hour)
+				require.NoError(t, err)
+				require.NoError(t, repo.Release(ctx, task.ID, "gemini: deadline exceeded", 0))
+
+				retried, err := repo.Claim(ctx, time.Hour)
+				require.NoError(t, err)
+				assert.Equal(t, task.ID, retried.ID)
+				assert.Equal(t, 2, retried.Attempts)
+				assert.Equal(t, "gemini: deadline exceeded", retried.LastError)
+			},
+		},
+		{
+			name: "released task is not claimable until backoff elapses",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				task, err := repo.Claim(ctx, time.Hour)
+				require.NoError(t, err)
+				require.NoError(t, repo.Release(ctx, task.ID, "gemini: unavailable", time.Hour))
+
+				_, err = repo.Claim(ctx, time.Hour)
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+			},
+		},
+		{
+			name: "dead letter moves the task out of the queue",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+				artistID := seedArtist(t, "Search Task Artist", "aaaaaaaa-aaaa-aaaa-aaaa-5ea4c47a5c01")
+				require.NoError(t, repo.Enqueue(ctx, artistID))
+
+				task, err := repo.Claim(ctx, time.Hour)
+				require.NoError(t, err)
+				require.NoError(t, repo.DeadLetter(ctx, task.ID, "gemini: permanent failure"))
+
+				_, err = repo.Claim(ctx, 0)
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+
+				var (
+					gotArtistID string
+					attempts    int
+					lastError   string
+				)
+				err = testDB.Pool.QueryRow(ctx,
+					`SELECT artist_id, attempts, last_error FROM concert_search_dead_letters WHERE id = $1`,
+					task.ID,
+				).Scan(&gotArtistID, &attempts, &lastError)
+				require.NoError(t, err)
+				assert.Equal(t, artistID, gotArtistID)
+				assert.Equal(t, 1, attempts)
+				assert.Equal(t, "gemini: permanent failure", lastError)
+			},
+		},
+		{
+			name: "dead letter of unknown task returns NotFound",
+			run: func(t *testing.T) {
+				t.Helper()
+				cleanDatabase(t)
+
+				err := repo.DeadLetter(ctx, "018b2f19-e591-7d12-bf9e-f0e74f1b49e5", "boom")
+				assert.ErrorIs(t, err, apperr.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, tt.run)
+	}
+}
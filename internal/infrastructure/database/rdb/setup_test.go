@@ -71,6 +71,28 @@ func cleanDatabase(t *testing.T) {
 	cleanTables(testDB)
 }
 
+// beginTestTx returns a Database whose statements run inside a transaction
+// on db that is rolled back when t finishes, isolating the test without
+// truncating tables. Called on a Database it returned, it nests a savepoint,
+// so a subtest can discard its writes while keeping its parent's.
+//
+// It suits tests whose assertions are scoped to rows they created: rows left
+// by earlier tests that used cleanDatabase remain visible. NOW() is fixed for
+// the whole transaction, and statements share one connection, so tests that
+// depend on elapsed time or concurrent sessions should keep cleanDatabase. A
+// statement that fails in the database, such as a constraint violation,
+// aborts the transaction; run it under a nested beginTestTx so only that
+// savepoint is lost.
+func beginTestTx(t testing.TB, db *rdb.Database) *rdb.Database {
+	t.Helper()
+	tx, err := db.Pool.Begin(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = tx.Rollback(context.Background())
+	})
+	return rdb.NewTxDatabase(db, tx)
+}
+
 // seedUser inserts a minimal user record and returns its ID.
 func seedUser(t *testing.T, name, email, externalID string) string {
 	t.Helper()
@@ -155,15 +177,22 @@ func cleanTables(db *rdb.Database) {
 	ctx := context.Background()
 	tables := []string{
 		"nullifiers",
+		"entry_verification_audit",
+		"processed_messages",
 		"merkle_tree",
+		"merkle_root_history",
 		"tickets",
 		"ticket_emails",
 		"ticket_journeys",
 		"push_subscriptions",
+		"concert_search_dead_letters",
+		"concert_search_tasks",
 		"latest_search_logs",
+		"follow_history",
 		"followed_artists",
 		"artist_official_site",
 		"sales_phase_reminders",
+		"concert_reminders",
 		"sales_phases",
 		"event_performers",
 		"concerts",
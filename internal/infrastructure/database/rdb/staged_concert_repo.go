@@ -20,62 +20,72 @@ const (
 	// (artist_id, local_date, resolved_place_id). The ON CONFLICT target
 	// mirrors the partial index uq_staged_concerts_by_place. On conflict the
 	// mutable payload is refreshed but discovered_at is kept so queue order is
-	// stable.
+	// stable, and search_session_id keeps the session that first staged the row.
 	upsertStagedConcertByPlaceQuery = `
 		INSERT INTO staged_concerts (
 			id, artist_id, title, local_date, start_at, open_at,
 			listed_venue_name, admin_area, source_url,
 			resolved_place_id, resolved_venue_name, resolved_admin_area,
-			resolved_latitude, resolved_longitude
+			resolved_latitude, resolved_longitude, search_session_id, is_festival,
+			reschedules_event_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		ON CONFLICT (artist_id, local_date, resolved_place_id)
 		WHERE resolved_place_id IS NOT NULL
 		DO UPDATE SET
-			title               = EXCLUDED.title,
-			start_at            = EXCLUDED.start_at,
-			open_at             = EXCLUDED.open_at,
-			admin_area          = EXCLUDED.admin_area,
-			source_url          = EXCLUDED.source_url,
-			resolved_venue_name = EXCLUDED.resolved_venue_name,
-			resolved_admin_area = EXCLUDED.resolved_admin_area,
-			resolved_latitude   = EXCLUDED.resolved_latitude,
-			resolved_longitude  = EXCLUDED.resolved_longitude
+			title                = EXCLUDED.title,
+			start_at             = EXCLUDED.start_at,
+			open_at              = EXCLUDED.open_at,
+			admin_area           = EXCLUDED.admin_area,
+			source_url           = EXCLUDED.source_url,
+			resolved_venue_name  = EXCLUDED.resolved_venue_name,
+			resolved_admin_area  = EXCLUDED.resolved_admin_area,
+			resolved_latitude    = EXCLUDED.resolved_latitude,
+			resolved_longitude   = EXCLUDED.resolved_longitude,
+			is_festival          = EXCLUDED.is_festival,
+			reschedules_event_id = EXCLUDED.reschedules_event_id,
+			search_session_id    = COALESCE(staged_concerts.search_session_id, EXCLUDED.search_session_id)
 	`
 
 	// upsertStagedConcertByListedNameQuery handles the unresolved-venue path:
 	// when ResolvedPlaceID is nil the natural key is
 	// (artist_id, local_date, listed_venue_name). The ON CONFLICT target
 	// mirrors the partial index uq_staged_concerts_by_listed_name. On conflict
-	// the mutable payload is refreshed but discovered_at is kept.
+	// the mutable payload is refreshed but discovered_at and search_session_id
+	// are kept.
 	upsertStagedConcertByListedNameQuery = `
 		INSERT INTO staged_concerts (
 			id, artist_id, title, local_date, start_at, open_at,
 			listed_venue_name, admin_area, source_url,
 			resolved_place_id, resolved_venue_name, resolved_admin_area,
-			resolved_latitude, resolved_longitude
+			resolved_latitude, resolved_longitude, search_session_id, is_festival,
+			reschedules_event_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		ON CONFLICT (artist_id, local_date, listed_venue_name)
 		WHERE resolved_place_id IS NULL
 		DO UPDATE SET
-			title               = EXCLUDED.title,
-			start_at            = EXCLUDED.start_at,
-			open_at             = EXCLUDED.open_at,
-			admin_area          = EXCLUDED.admin_area,
-			source_url          = EXCLUDED.source_url,
-			resolved_place_id   = EXCLUDED.resolved_place_id,
-			resolved_venue_name = EXCLUDED.resolved_venue_name,
-			resolved_admin_area = EXCLUDED.resolved_admin_area,
-			resolved_latitude   = EXCLUDED.resolved_latitude,
-			resolved_longitude  = EXCLUDED.resolved_longitude
+			title                = EXCLUDED.title,
+			start_at             = EXCLUDED.start_at,
+			open_at              = EXCLUDED.open_at,
+			admin_area           = EXCLUDED.admin_area,
+			source_url           = EXCLUDED.source_url,
+			resolved_place_id    = EXCLUDED.resolved_place_id,
+			resolved_venue_name  = EXCLUDED.resolved_venue_name,
+			resolved_admin_area  = EXCLUDED.resolved_admin_area,
+			resolved_latitude    = EXCLUDED.resolved_latitude,
+			resolved_longitude   = EXCLUDED.resolved_longitude,
+			is_festival          = EXCLUDED.is_festival,
+			reschedules_event_id = EXCLUDED.reschedules_event_id,
+			search_session_id    = COALESCE(staged_concerts.search_session_id, EXCLUDED.search_session_id)
 	`
 
 	listPendingStagedConcertsQuery = `
 		SELECT id, artist_id, title, local_date, start_at, open_at,
 		       listed_venue_name, admin_area, source_url,
 		       resolved_place_id, resolved_venue_name, resolved_admin_area,
-		       resolved_latitude, resolved_longitude, discovered_at
+		       resolved_latitude, resolved_longitude, discovered_at, search_session_id,
+		       is_festival, reschedules_event_id
 		FROM staged_concerts
 		ORDER BY discovered_at ASC
 	`
@@ -84,7 +94,8 @@ const (
 		SELECT id, artist_id, title, local_date, start_at, open_at,
 		       listed_venue_name, admin_area, source_url,
 		       resolved_place_id, resolved_venue_name, resolved_admin_area,
-		       resolved_latitude, resolved_longitude, discovered_at
+		       resolved_latitude, resolved_longitude, discovered_at, search_session_id,
+		       is_festival, reschedules_event_id
 		FROM staged_concerts
 		WHERE id = $1
 	`
@@ -132,6 +143,9 @@ func (r *StagedConcertRepository) Upsert(ctx context.Context, sc *entity.StagedC
 		sc.ResolvedAdminArea,
 		sc.ResolvedLatitude,
 		sc.ResolvedLongitude,
+		sc.SearchSessionID,
+		sc.IsFestival,
+		sc.ReschedulesEventID,
 	)
 	if err != nil {
 		return toAppErr(err, "failed to upsert staged concert",
@@ -238,6 +252,9 @@ func scanStagedConcertRow(scan func(dest ...any) error) (*entity.StagedConcert,
 		&sc.ResolvedLatitude,
 		&sc.ResolvedLongitude,
 		&sc.DiscoveredTime,
+		&sc.SearchSessionID,
+		&sc.IsFestival,
+		&sc.ReschedulesEventID,
 	)
 	if err != nil {
 		return nil, err
@@ -57,9 +57,65 @@ func TestStagedConcertRepository_Upsert_Insert(t *testing.T) {
 	assert.Equal(t, sc.ArtistID, got.ArtistID)
 	assert.Equal(t, sc.Title, got.Title)
 	assert.Equal(t, sc.ListedVenueName, got.ListedVenueName)
+	assert.False(t, got.IsFestival)
+	assert.Nil(t, got.ReschedulesEventID)
 	assert.WithinDuration(t, time.Now(), got.DiscoveredTime, 5*time.Second)
 }
 
+func TestStagedConcertRepository_Upsert_IsFestival(t *testing.T) {
+	repo := rdb.NewStagedConcertRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("round-trips the festival flag", func(t *testing.T) {
+		cleanDatabase(t)
+		artistID := seedArtist(t, "Festival Artist", "aaaaaaaa-aaaa-aaaa-aaaa-100000000099")
+
+		sc := buildStagedConcert(t, artistID)
+		sc.IsFestival = true
+		require.NoError(t, repo.Upsert(ctx, sc))
+
+		got, err := repo.GetByID(ctx, sc.ID)
+		require.NoError(t, err)
+		assert.True(t, got.IsFestival)
+
+		pending, err := repo.ListPending(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.True(t, pending[0].IsFestival)
+	})
+}
+
+func TestStagedConcertRepository_Upsert_ReschedulesEventID(t *testing.T) {
+	repo := rdb.NewStagedConcertRepository(testDB)
+	concertRepo := rdb.NewConcertRepository(testDB)
+	venueRepo := rdb.NewVenueRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("round-trips the rescheduled event", func(t *testing.T) {
+		cleanDatabase(t)
+		artistID := seedArtist(t, "Reschedule Stage Artist", "aaaaaaaa-aaaa-aaaa-aaaa-100000000098")
+		venueID := newTestID(t)
+		require.NoError(t, venueRepo.Create(ctx, &entity.Venue{ID: venueID, Name: "Reschedule Stage Hall"}))
+		seriesID := seedSeries(t, ctx, seriesRepo, "Reschedule Stage Concert")
+		eventID := newTestID(t)
+		requireCreate(t, ctx, concertRepo, &entity.Concert{
+			Event:      entity.Event{ID: eventID, VenueID: venueID, SeriesID: seriesID, LocalDate: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+			Series:     &entity.Series{ID: seriesID},
+			Performers: []*entity.Artist{{ID: artistID}},
+		})
+
+		sc := buildStagedConcert(t, artistID)
+		sc.ReschedulesEventID = &eventID
+		require.NoError(t, repo.Upsert(ctx, sc))
+
+		got, err := repo.GetByID(ctx, sc.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.ReschedulesEventID)
+		assert.Equal(t, eventID, *got.ReschedulesEventID)
+	})
+}
+
 func TestStagedConcertRepository_Upsert_RefreshOnConflict_ByListedName(t *testing.T) {
 	repo := rdb.NewStagedConcertRepository(testDB)
 	ctx := context.Background()
@@ -126,6 +182,32 @@ func TestStagedConcertRepository_Upsert_RefreshOnConflict_ByPlaceID(t *testing.T
 	})
 }
 
+func TestStagedConcertRepository_Upsert_SearchSession(t *testing.T) {
+	repo := rdb.NewStagedConcertRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("keeps the session that first staged the row", func(t *testing.T) {
+		cleanDatabase(t)
+		artistID := seedArtist(t, "Session Stage Artist", "aaaaaaaa-aaaa-aaaa-aaaa-100000000009")
+		firstSession := uuid.Must(uuid.NewV7()).String()
+		laterSession := uuid.Must(uuid.NewV7()).String()
+
+		first := buildStagedConcert(t, artistID)
+		first.SearchSessionID = &firstSession
+		require.NoError(t, repo.Upsert(ctx, first))
+
+		second := buildStagedConcert(t, artistID)
+		second.SearchSessionID = &laterSession
+		require.NoError(t, repo.Upsert(ctx, second))
+
+		pending, err := repo.ListPending(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.NotNil(t, pending[0].SearchSessionID)
+		assert.Equal(t, firstSession, *pending[0].SearchSessionID)
+	})
+}
+
 func TestStagedConcertRepository_Upsert_BothNaturalKeyPaths(t *testing.T) {
 	repo := rdb.NewStagedConcertRepository(testDB)
 	ctx := context.Background()
@@ -0,0 +1,91 @@
+package rdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb/rdbtest"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeginTestTx(t *testing.T) {
+	ctx := context.Background()
+	cleanDatabase(t)
+	outside := rdb.NewArtistRepository(testDB)
+
+	var kept, discarded *entity.Artist
+	t.Run("rows are visible only inside the transaction", func(t *testing.T) {
+		db := beginTestTx(t, testDB)
+		f := rdbtest.New(t, db)
+		kept = f.Artist()
+
+		got, err := rdb.NewArtistRepository(db).Get(ctx, kept.ID)
+		require.NoError(t, err)
+		assert.Equal(t, kept.Name, got.Name)
+
+		_, err = outside.Get(ctx, kept.ID)
+		assert.ErrorIs(t, err, apperr.ErrNotFound, "uncommitted row leaked to another session")
+
+		t.Run("nested call rolls back to a savepoint", func(t *testing.T) {
+			nested := beginTestTx(t, db)
+			discarded = rdbtest.New(t, nested).Artist()
+		})
+
+		_, err = rdb.NewArtistRepository(db).Get(ctx, discarded.ID)
+		assert.ErrorIs(t, err, apperr.ErrNotFound, "savepoint writes survived the nested rollback")
+		_, err = rdb.NewArtistRepository(db).Get(ctx, kept.ID)
+		assert.NoError(t, err, "nested rollback discarded the parent's writes")
+
+		t.Run("failed statement is confined to its savepoint", func(t *testing.T) {
+			nested := beginTestTx(t, db)
+			err := rdb.NewVenueRepository(nested).Create(ctx, &entity.Venue{ID: kept.ID, Name: "Dup"})
+			require.NoError(t, err)
+			err = rdb.NewVenueRepository(nested).Create(ctx, &entity.Venue{ID: kept.ID, Name: "Dup"})
+			assert.ErrorIs(t, err, apperr.ErrAlreadyExists)
+		})
+
+		_, err = rdb.NewArtistRepository(db).Get(ctx, kept.ID)
+		assert.NoError(t, err, "parent transaction aborted by the nested failure")
+
+		t.Run("repository transactions commit into the test transaction", func(t *testing.T) {
+			// ConcertRepository.Create runs in its own transaction, which
+			// becomes a savepoint here.
+			concert := f.Concert()
+			got, err := rdb.NewConcertRepository(db).ListByVenue(ctx, concert.VenueID, false)
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, concert.ID, got[0].ID)
+		})
+	})
+
+	_, err := outside.Get(ctx, kept.ID)
+	assert.ErrorIs(t, err, apperr.ErrNotFound, "rows survived the rollback")
+}
+
+// BenchmarkTestIsolation compares isolating a test by truncating every table
+// with isolating it in a rolled-back transaction. Each iteration isolates,
+// then inserts a concert with its series, venue, and artist.
+func BenchmarkTestIsolation(b *testing.B) {
+	b.Run("truncate", func(b *testing.B) {
+		for b.Loop() {
+			cleanTables(testDB)
+			rdbtest.New(b, testDB).Concert()
+		}
+	})
+
+	b.Run("transaction", func(b *testing.B) {
+		ctx := context.Background()
+		for b.Loop() {
+			// Roll back in the iteration: beginTestTx's cleanup would only
+			// run once the whole benchmark finishes.
+			tx, err := testDB.Pool.Begin(ctx)
+			require.NoError(b, err)
+			rdbtest.New(b, rdb.NewTxDatabase(testDB, tx)).Concert()
+			require.NoError(b, tx.Rollback(ctx))
+		}
+	})
+}
@@ -27,32 +27,56 @@ const (
 	`
 
 	getTicketQuery = `
-		SELECT id, event_id, user_id, token_id, tx_hash, minted_at
+		SELECT id, event_id, user_id, token_id, tx_hash, minted_at, leaf_index
 		FROM tickets
 		WHERE id = $1
 	`
 
 	getTicketByEventAndUserQuery = `
-		SELECT id, event_id, user_id, token_id, tx_hash, minted_at
+		SELECT id, event_id, user_id, token_id, tx_hash, minted_at, leaf_index
 		FROM tickets
 		WHERE event_id = $1 AND user_id = $2
 	`
 
 	listTicketsByUserQuery = `
-		SELECT id, event_id, user_id, token_id, tx_hash, minted_at
+		SELECT id, event_id, user_id, token_id, tx_hash, minted_at, leaf_index
 		FROM tickets
 		WHERE user_id = $1
 		ORDER BY minted_at DESC
 	`
 
 	listTicketsByEventQuery = `
-		SELECT id, event_id, user_id, token_id, tx_hash, minted_at
+		SELECT id, event_id, user_id, token_id, tx_hash, minted_at, leaf_index
 		FROM tickets
 		WHERE event_id = $1
 		ORDER BY minted_at ASC, id ASC
 	`
 
+	countTicketsByEventQuery = `SELECT COUNT(*) FROM tickets WHERE event_id = $1`
+
 	eventExistsQuery = `SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)`
+
+	// lockEventForLeafAssignmentQuery serializes leaf assignment per event so
+	// two builds cannot hand out the same index.
+	lockEventForLeafAssignmentQuery = `SELECT id FROM events WHERE id = $1 FOR UPDATE`
+
+	// assignTicketLeafIndexesQuery numbers the event's unassigned tickets in
+	// mint order, starting after the highest index in use.
+	assignTicketLeafIndexesQuery = `
+		WITH next AS (
+			SELECT COALESCE(MAX(leaf_index) + 1, 0) AS base
+			FROM tickets
+			WHERE event_id = $1
+		), unassigned AS (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY minted_at ASC, id ASC) - 1 AS n
+			FROM tickets
+			WHERE event_id = $1 AND leaf_index IS NULL
+		)
+		UPDATE tickets t
+		SET leaf_index = next.base + unassigned.n
+		FROM next, unassigned
+		WHERE t.id = unassigned.id
+	`
 )
 
 // Create persists a newly minted ticket record.
@@ -98,7 +122,7 @@ func (r *TicketRepository) Get(ctx context.Context, id string) (*entity.Ticket,
 
 	ticket := &entity.Ticket{}
 	err := r.db.Pool.QueryRow(ctx, getTicketQuery, id).Scan(
-		&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime,
+		&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime, &ticket.LeafIndex,
 	)
 	if err != nil {
 		return nil, toAppErr(err, "failed to get ticket", slog.String("ticket_id", id))
@@ -119,7 +143,7 @@ func (r *TicketRepository) GetByEventAndUser(ctx context.Context, eventID, userI
 
 	ticket := &entity.Ticket{}
 	err := r.db.Pool.QueryRow(ctx, getTicketByEventAndUserQuery, eventID, userID).Scan(
-		&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime,
+		&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime, &ticket.LeafIndex,
 	)
 	if err != nil {
 		return nil, toAppErr(err, "failed to get ticket by event and user",
@@ -147,7 +171,7 @@ func (r *TicketRepository) ListByUser(ctx context.Context, userID string) ([]*en
 	for rows.Next() {
 		ticket := &entity.Ticket{}
 		if err := rows.Scan(
-			&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime,
+			&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime, &ticket.LeafIndex,
 		); err != nil {
 			return nil, toAppErr(err, "failed to scan ticket row", slog.String("user_id", userID))
 		}
@@ -177,7 +201,7 @@ func (r *TicketRepository) ListByEvent(ctx context.Context, eventID string) ([]*
 	for rows.Next() {
 		ticket := &entity.Ticket{}
 		if err := rows.Scan(
-			&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime,
+			&ticket.ID, &ticket.EventID, &ticket.UserID, &ticket.TokenID, &ticket.TxHash, &ticket.MintTime, &ticket.LeafIndex,
 		); err != nil {
 			return nil, toAppErr(err, "failed to scan ticket row", slog.String("event_id", eventID))
 		}
@@ -191,6 +215,49 @@ func (r *TicketRepository) ListByEvent(ctx context.Context, eventID string) ([]*
 	return tickets, nil
 }
 
+// CountByEvent returns the number of tickets issued for an event.
+func (r *TicketRepository) CountByEvent(ctx context.Context, eventID string) (int, error) {
+	if eventID == "" {
+		return 0, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	var count int
+	if err := r.db.Pool.QueryRow(ctx, countTicketsByEventQuery, eventID).Scan(&count); err != nil {
+		return 0, toAppErr(err, "failed to count tickets for event", slog.String("event_id", eventID))
+	}
+
+	return count, nil
+}
+
+// AssignLeafIndexes assigns leaf indices to the event's tickets that have none.
+func (r *TicketRepository) AssignLeafIndexes(ctx context.Context, eventID string) (int, error) {
+	if eventID == "" {
+		return 0, apperr.New(codes.InvalidArgument, "event ID cannot be empty")
+	}
+
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return 0, toAppErr(err, "failed to begin transaction for leaf index assignment")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var lockedID string
+	if err := tx.QueryRow(ctx, lockEventForLeafAssignmentQuery, eventID).Scan(&lockedID); err != nil {
+		return 0, toAppErr(err, "failed to lock event for leaf index assignment", slog.String("event_id", eventID))
+	}
+
+	tag, err := tx.Exec(ctx, assignTicketLeafIndexesQuery, eventID)
+	if err != nil {
+		return 0, toAppErr(err, "failed to assign ticket leaf indexes", slog.String("event_id", eventID))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, toAppErr(err, "failed to commit leaf index assignment", slog.String("event_id", eventID))
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
 // EventExists returns true if an event with the given ID exists in the database.
 func (r *TicketRepository) EventExists(ctx context.Context, eventID string) (bool, error) {
 	if eventID == "" {
@@ -2,11 +2,14 @@ package rdb_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb/rdbtest"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -300,6 +303,138 @@ func TestTicketRepository_ListByEvent(t *testing.T) {
 	})
 }
 
+func TestTicketRepository_CountByEvent(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewTicketRepository(testDB)
+	ctx := context.Background()
+	eventID, userID := seedTicketTestData(t)
+
+	t.Run("event without tickets yields zero", func(t *testing.T) {
+		count, err := repo.CountByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("counts the tickets issued for the event", func(t *testing.T) {
+		userID2 := seedUser(t, "count-by-event-user2", "count-by-event2@example.com", "ext-count-by-event-02")
+		_, err := repo.Create(ctx, &entity.NewTicket{EventID: eventID, UserID: userID, TokenID: 30, TxHash: "0xc"})
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, &entity.NewTicket{EventID: eventID, UserID: userID2, TokenID: 40, TxHash: "0xd"})
+		require.NoError(t, err)
+
+		count, err := repo.CountByEvent(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		count, err = repo.CountByEvent(ctx, "018b2f19-e591-7d12-bf9e-000000000000")
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.CountByEvent(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestTicketRepository_AssignLeafIndexes(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewTicketRepository(testDB)
+	ctx := context.Background()
+	f := rdbtest.New(t, testDB)
+	eventID := f.Concert().ID
+
+	var tokenID uint64
+	mint := func(t *testing.T) *entity.Ticket {
+		t.Helper()
+		tokenID++
+		ticket, err := repo.Create(ctx, &entity.NewTicket{EventID: eventID, UserID: f.User().ID, TokenID: tokenID, TxHash: fmt.Sprintf("0x%d", tokenID)})
+		require.NoError(t, err)
+		return ticket
+	}
+	// leafIndexes returns each ticket's persisted leaf index by user ID.
+	leafIndexes := func(t *testing.T) map[string]int {
+		t.Helper()
+		tickets, err := repo.ListByEvent(ctx, eventID)
+		require.NoError(t, err)
+		got := make(map[string]int, len(tickets))
+		for _, ticket := range tickets {
+			require.NotNil(t, ticket.LeafIndex, "ticket of %s has no leaf index", ticket.UserID)
+			got[ticket.UserID] = *ticket.LeafIndex
+		}
+		return got
+	}
+
+	first, second, third := mint(t), mint(t), mint(t)
+
+	t.Run("a fresh ticket has no leaf index", func(t *testing.T) {
+		ticket, err := repo.Get(ctx, first.ID)
+		require.NoError(t, err)
+		assert.Nil(t, ticket.LeafIndex)
+	})
+
+	t.Run("first build assigns indices in mint order", func(t *testing.T) {
+		n, err := repo.AssignLeafIndexes(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, map[string]int{first.UserID: 0, second.UserID: 1, third.UserID: 2}, leafIndexes(t))
+	})
+
+	t.Run("rebuild without new tickets changes nothing", func(t *testing.T) {
+		n, err := repo.AssignLeafIndexes(ctx, eventID)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Equal(t, map[string]int{first.UserID: 0, second.UserID: 1, third.UserID: 2}, leafIndexes(t))
+	})
+
+	t.Run("rebuild after a deletion keeps indices and appends new tickets", func(t *testing.T) {
+		_, err := testDB.Pool.Exec(ctx, `DELETE FROM tickets WHERE id = $1`, second.ID)
+		require.NoError(t, err)
+		fourth, fifth := mint(t), mint(t)
+
+		n, err := repo.AssignLeafIndexes(ctx, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		// Index 1 stays vacant; it is never handed to another holder.
+		assert.Equal(t, map[string]int{first.UserID: 0, third.UserID: 2, fourth.UserID: 3, fifth.UserID: 4}, leafIndexes(t))
+	})
+
+	t.Run("concurrent builds never share an index", func(t *testing.T) {
+		for range 5 {
+			mint(t)
+		}
+
+		var wg sync.WaitGroup
+		errs := make([]error, 4)
+		for i := range errs {
+			wg.Go(func() {
+				_, errs[i] = repo.AssignLeafIndexes(ctx, eventID)
+			})
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		seen := make(map[int]bool)
+		for _, idx := range leafIndexes(t) {
+			assert.False(t, seen[idx], "leaf index %d assigned twice", idx)
+			seen[idx] = true
+		}
+		assert.Len(t, seen, 9)
+	})
+
+	t.Run("unknown event returns NotFound", func(t *testing.T) {
+		_, err := repo.AssignLeafIndexes(ctx, f.ID())
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty event ID returns error", func(t *testing.T) {
+		_, err := repo.AssignLeafIndexes(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
 func TestTicketRepository_EventExists(t *testing.T) {
 	cleanDatabase(t)
 	repo := rdb.NewTicketRepository(testDB)
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pannpers/go-logging/logging"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -21,24 +23,61 @@ import (
 
 const tracerName = "github.com/liverty-music/backend/internal/infrastructure/database/rdb"
 
+// pgxPool is the subset of *pgxpool.Pool that TracedPool wraps, so tests can
+// substitute a fake pool.
+type pgxPool interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Ping(ctx context.Context) error
+	Close()
+}
+
 // TracedPool wraps *pgxpool.Pool to transparently create OTel spans and inject
-// sqlcommenter traceparent comments into every SQL query.
+// sqlcommenter traceparent comments into every SQL query. It also applies the
+// per-query timeout and slow-query logging configured via TracedPoolOption,
+// both inherited by the transactions it begins.
 type TracedPool struct {
-	inner         *pgxpool.Pool
+	inner         pgxPool
 	tracer        trace.Tracer
 	dbNamespace   string
 	serverAddress string
+	guard         queryGuard
+}
+
+// TracedPoolOption configures optional TracedPool behavior.
+type TracedPoolOption func(*TracedPool)
+
+// WithQueryTimeout caps every statement at d unless the caller's context
+// already has an earlier deadline. A non-positive d disables the cap.
+func WithQueryTimeout(d time.Duration) TracedPoolOption {
+	return func(tp *TracedPool) { tp.guard.timeout = d }
+}
+
+// WithSlowQueryLog logs a WARNING with the statement's operation, table,
+// and duration whenever a statement takes at least threshold. For Query the
+// duration runs until the rows are closed. A non-positive threshold disables
+// the log.
+func WithSlowQueryLog(threshold time.Duration, logger *logging.Logger) TracedPoolOption {
+	return func(tp *TracedPool) {
+		tp.guard.slowThreshold = threshold
+		tp.guard.logger = logger
+	}
 }
 
 // NewTracedPool creates a TracedPool wrapping the given pool.
 // dbNamespace is the database name and serverAddress is the database host.
-func NewTracedPool(pool *pgxpool.Pool, dbNamespace, serverAddress string) *TracedPool {
+func NewTracedPool(pool *pgxpool.Pool, dbNamespace, serverAddress string, opts ...TracedPoolOption) *TracedPool {
 	tp := &TracedPool{
 		inner:         pool,
 		tracer:        otel.Tracer(tracerName),
 		dbNamespace:   dbNamespace,
 		serverAddress: serverAddress,
 	}
+	for _, opt := range opts {
+		opt(tp)
+	}
 	tp.registerPoolMetrics(pool)
 	return tp
 }
@@ -63,31 +102,51 @@ func (tp *TracedPool) registerPoolMetrics(pool *pgxpool.Pool) {
 
 // Query executes a query that returns rows, with tracing and traceparent injection.
 // The span is ended when the returned Rows is closed, covering the full row iteration.
+// Within RunInTx it runs in the context's transaction, under the same
+// timeout, slow-query log, and span.
 func (tp *TracedPool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if tx, ok := contextTx(ctx); ok {
+		return tx.Query(ctx, sql, args...)
+	}
+	ctx, done := tp.guard.start(ctx, sql)
 	ctx, span := tp.startSpan(ctx, sql)
 
 	rows, err := tp.inner.Query(ctx, InjectTraceparent(ctx, sql), args...)
 	if err != nil {
 		recordError(span, err)
 		span.End()
+		done()
 		return nil, err
 	}
-	return &tracedRows{Rows: rows, span: span}, nil
+	return &tracedRows{Rows: rows, span: span, done: done}, nil
 }
 
 // QueryRow executes a query that returns at most one row, with tracing and traceparent injection.
 // A runtime finalizer ensures the span is eventually ended even if Scan is never called.
+// Within RunInTx it runs in the context's transaction, under the same
+// timeout, slow-query log, and span.
 func (tp *TracedPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if tx, ok := contextTx(ctx); ok {
+		return tx.QueryRow(ctx, sql, args...)
+	}
+	ctx, done := tp.guard.start(ctx, sql)
 	ctx, span := tp.startSpan(ctx, sql)
 
 	row := tp.inner.QueryRow(ctx, InjectTraceparent(ctx, sql), args...)
-	r := &tracedRow{Row: row, span: span}
-	runtime.SetFinalizer(r, func(r *tracedRow) { r.span.End() })
+	r := &tracedRow{Row: row, span: span, done: done}
+	runtime.SetFinalizer(r, func(r *tracedRow) { r.finish() })
 	return r
 }
 
 // Exec executes a query that doesn't return rows, with tracing and traceparent injection.
+// Within RunInTx it runs in the context's transaction, under the same
+// timeout, slow-query log, and span.
 func (tp *TracedPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if tx, ok := contextTx(ctx); ok {
+		return tx.Exec(ctx, sql, args...)
+	}
+	ctx, done := tp.guard.start(ctx, sql)
+	defer done()
 	ctx, span := tp.startSpan(ctx, sql)
 	defer span.End()
 
@@ -99,7 +158,21 @@ func (tp *TracedPool) Exec(ctx context.Context, sql string, args ...any) (pgconn
 }
 
 // Begin starts a transaction and returns a TracedTx that applies tracing to queries within it.
+// Within RunInTx it starts a savepoint in the context's transaction.
 func (tp *TracedPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	tx, err := tp.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// beginTx is Begin with the concrete TracedTx type, which RunInTx binds to
+// its context.
+func (tp *TracedPool) beginTx(ctx context.Context) (*TracedTx, error) {
+	if tx, ok := contextTx(ctx); ok {
+		return tx.beginTx(ctx)
+	}
 	ctx, span := tp.tracer.Start(ctx, "BEGIN",
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(semconv.DBSystemPostgreSQL),
@@ -111,7 +184,7 @@ func (tp *TracedPool) Begin(ctx context.Context) (pgx.Tx, error) {
 		recordError(span, err)
 		return nil, err
 	}
-	return &TracedTx{inner: tx, tracer: tp.tracer, dbNamespace: tp.dbNamespace, serverAddress: tp.serverAddress}, nil
+	return &TracedTx{inner: tx, tracer: tp.tracer, dbNamespace: tp.dbNamespace, serverAddress: tp.serverAddress, guard: tp.guard}, nil
 }
 
 // Ping delegates to the inner pool.
@@ -150,6 +223,7 @@ func (tp *TracedPool) startSpan(ctx context.Context, sql string) (context.Contex
 type tracedRow struct {
 	pgx.Row
 	span trace.Span
+	done func()
 }
 
 // Scan delegates to the inner Row and ends the span.
@@ -159,21 +233,35 @@ func (r *tracedRow) Scan(dest ...any) error {
 		recordError(r.span, err)
 	}
 	runtime.SetFinalizer(r, nil)
-	r.span.End()
+	r.finish()
 	return err
 }
 
+// finish ends the span and releases the query guard.
+func (r *tracedRow) finish() {
+	r.span.End()
+	r.done()
+}
+
 // tracedRows wraps pgx.Rows to end the span when the rows are closed,
 // ensuring the span covers the full row iteration lifecycle.
 type tracedRows struct {
 	pgx.Rows
-	span trace.Span
+	span   trace.Span
+	done   func()
+	closed bool
 }
 
-// Close closes the underlying Rows and ends the span.
+// Close closes the underlying Rows and ends the span. pgx allows Close to be
+// called more than once; the span and guard are finished only on the first.
 func (r *tracedRows) Close() {
 	r.Rows.Close()
+	if r.closed {
+		return
+	}
+	r.closed = true
 	r.span.End()
+	r.done()
 }
 
 func recordError(span trace.Span, err error) {
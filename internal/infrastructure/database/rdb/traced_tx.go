@@ -21,35 +21,41 @@ type TracedTx struct {
 	tracer        trace.Tracer
 	dbNamespace   string
 	serverAddress string
+	guard         queryGuard
 }
 
 // Query executes a query within the transaction, with tracing and traceparent injection.
 // The span is ended when the returned Rows is closed, covering the full row iteration.
 func (t *TracedTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	ctx, done := t.guard.start(ctx, sql)
 	ctx, span := t.startSpan(ctx, sql)
 
 	rows, err := t.inner.Query(ctx, InjectTraceparent(ctx, sql), args...)
 	if err != nil {
 		recordError(span, err)
 		span.End()
+		done()
 		return nil, err
 	}
-	return &tracedRows{Rows: rows, span: span}, nil
+	return &tracedRows{Rows: rows, span: span, done: done}, nil
 }
 
 // QueryRow executes a query that returns at most one row within the transaction.
 // A runtime finalizer ensures the span is eventually ended even if Scan is never called.
 func (t *TracedTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	ctx, done := t.guard.start(ctx, sql)
 	ctx, span := t.startSpan(ctx, sql)
 
 	row := t.inner.QueryRow(ctx, InjectTraceparent(ctx, sql), args...)
-	r := &tracedRow{Row: row, span: span}
-	runtime.SetFinalizer(r, func(r *tracedRow) { r.span.End() })
+	r := &tracedRow{Row: row, span: span, done: done}
+	runtime.SetFinalizer(r, func(r *tracedRow) { r.finish() })
 	return r
 }
 
 // Exec executes a query that doesn't return rows within the transaction.
 func (t *TracedTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	ctx, done := t.guard.start(ctx, sql)
+	defer done()
 	ctx, span := t.startSpan(ctx, sql)
 	defer span.End()
 
@@ -92,11 +98,20 @@ func (t *TracedTx) Rollback(ctx context.Context) error {
 
 // Begin starts a pseudo nested transaction (savepoint).
 func (t *TracedTx) Begin(ctx context.Context) (pgx.Tx, error) {
+	tx, err := t.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// beginTx is Begin with the concrete TracedTx type.
+func (t *TracedTx) beginTx(ctx context.Context) (*TracedTx, error) {
 	tx, err := t.inner.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &TracedTx{inner: tx, tracer: t.tracer, dbNamespace: t.dbNamespace, serverAddress: t.serverAddress}, nil
+	return &TracedTx{inner: tx, tracer: t.tracer, dbNamespace: t.dbNamespace, serverAddress: t.serverAddress, guard: t.guard}, nil
 }
 
 // Conn returns the underlying *pgx.Conn.
@@ -0,0 +1,33 @@
+package rdb
+
+// Helpers for building the parallel array parameters of
+// `INSERT ... SELECT * FROM unnest($1::uuid[], $2::text[], ...)` bulk
+// statements.
+//
+// Hand-sizing one array per column with make([]T, len(input)) and then
+// skipping nil inputs leaves zero values in the skipped slots, which reach
+// PostgreSQL as empty UUIDs / strings. Deriving every column from the same
+// compacted row slice rules that out: all arrays have exactly one element per
+// real row, in the same order.
+
+// compactRows returns the non-nil elements of items in order. The result
+// never aliases items.
+func compactRows[T any](items []*T) []*T {
+	rows := make([]*T, 0, len(items))
+	for _, it := range items {
+		if it != nil {
+			rows = append(rows, it)
+		}
+	}
+	return rows
+}
+
+// unnestColumn extracts one typed column array from rows, which must not
+// contain nils (see compactRows). The result has exactly len(rows) elements.
+func unnestColumn[T, V any](rows []*T, field func(*T) V) []V {
+	col := make([]V, len(rows))
+	for i, r := range rows {
+		col[i] = field(r)
+	}
+	return col
+}
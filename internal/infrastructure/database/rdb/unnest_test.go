@@ -0,0 +1,68 @@
+package rdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type unnestTestRow struct {
+	id   string
+	name *string
+}
+
+func TestCompactRows(t *testing.T) {
+	t.Parallel()
+
+	a, b := &unnestTestRow{id: "a"}, &unnestTestRow{id: "b"}
+
+	tests := []struct {
+		name  string
+		items []*unnestTestRow
+		want  []*unnestTestRow
+	}{
+		{name: "nil input", items: nil, want: []*unnestTestRow{}},
+		{name: "all nil", items: []*unnestTestRow{nil, nil}, want: []*unnestTestRow{}},
+		{name: "no nils", items: []*unnestTestRow{a, b}, want: []*unnestTestRow{a, b}},
+		{name: "interleaved nils keep order", items: []*unnestTestRow{nil, a, nil, b, nil}, want: []*unnestTestRow{a, b}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, compactRows(tt.items))
+		})
+	}
+}
+
+func TestCompactRows_DoesNotAlias(t *testing.T) {
+	t.Parallel()
+
+	a, b := &unnestTestRow{id: "a"}, &unnestTestRow{id: "b"}
+	items := []*unnestTestRow{a, nil, b}
+
+	rows := compactRows(items)
+	rows[0] = b
+
+	assert.Same(t, a, items[0], "compacting must not write through to the input")
+}
+
+func TestUnnestColumn(t *testing.T) {
+	t.Parallel()
+
+	name := "Name B"
+	rows := compactRows([]*unnestTestRow{nil, {id: "a"}, nil, {id: "b", name: &name}})
+
+	ids := unnestColumn(rows, func(r *unnestTestRow) string { return r.id })
+	names := unnestColumn(rows, func(r *unnestTestRow) *string { return r.name })
+
+	// A nil input element must leave no zero-valued slot behind: an empty id
+	// here would reach PostgreSQL as an empty UUID.
+	assert.Equal(t, []string{"a", "b"}, ids)
+	assert.NotContains(t, ids, "")
+	// Nullable columns keep nil as the column value for real rows.
+	assert.Equal(t, []*string{nil, &name}, names)
+	assert.Len(t, names, len(ids), "every column has one element per row")
+
+	assert.Empty(t, unnestColumn(compactRows([]*unnestTestRow{nil}), func(r *unnestTestRow) string { return r.id }))
+}
@@ -20,7 +20,7 @@ type UserRepository struct {
 }
 
 const (
-	userColumns = `u.id, u.external_id, u.email, u.name, u.preferred_language, u.country, u.time_zone, COALESCE(u.safe_address, ''), u.is_active`
+	userColumns = `u.id, u.external_id, u.email, u.name, u.preferred_language, u.country, u.time_zone, COALESCE(u.safe_address, ''), u.is_active, u.concert_reminder_days`
 
 	homeColumns = `h.id, h.country_code, h.level_1, h.level_2, h.centroid_latitude, h.centroid_longitude`
 
@@ -70,6 +70,15 @@ const (
 		LIMIT $1 OFFSET $2
 	`
 
+	listUsersPageQuery = `
+		SELECT ` + userColumns + `, ` + homeColumns + `
+		FROM users u
+		LEFT JOIN homes h ON u.home_id = h.id
+		WHERE $2::uuid IS NULL OR u.id > $2::uuid
+		ORDER BY u.id
+		LIMIT $1
+	`
+
 	updateSafeAddressQuery = `
 		UPDATE users SET safe_address = $2 WHERE id = $1
 	`
@@ -155,16 +164,18 @@ func scanUser(scanner interface{ Scan(dest ...any) error }) (*entity.User, error
 	var preferredLanguage, country, timeZone sql.NullString
 	var homeID, countryCode, level1, level2 sql.NullString
 	var centroidLat, centroidLng sql.NullFloat64
+	var concertReminderDays int16
 
 	err := scanner.Scan(
 		&user.ID, &user.ExternalID, &user.Email, &user.Name,
 		&preferredLanguage, &country, &timeZone,
-		&user.SafeAddress, &user.IsActive,
+		&user.SafeAddress, &user.IsActive, &concertReminderDays,
 		&homeID, &countryCode, &level1, &level2, &centroidLat, &centroidLng,
 	)
 	if err != nil {
 		return nil, err
 	}
+	user.ConcertReminderDays = int(concertReminderDays)
 	if preferredLanguage.Valid {
 		user.PreferredLanguage = preferredLanguage.String
 	}
@@ -393,6 +404,40 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*entity
 	return users, nil
 }
 
+// ListPage retrieves one keyset-paginated page of users ordered by ID.
+func (r *UserRepository) ListPage(ctx context.Context, limit int, cursor string) ([]*entity.User, string, error) {
+	if limit <= 0 {
+		return nil, "", apperr.New(codes.InvalidArgument, "page limit must be positive")
+	}
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	rows, err := r.db.Pool.Query(ctx, listUsersPageQuery, limit+1, after)
+	if err != nil {
+		return nil, "", toAppErr(err, "failed to list users page")
+	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, "", toAppErr(err, "failed to scan user row")
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", toAppErr(err, "failed to iterate user rows")
+	}
+
+	users, next := trimPage(users, limit, func(u *entity.User) string { return u.ID })
+	return users, next, nil
+}
+
 // UpdateSafeAddress sets the predicted Safe address for a user.
 func (r *UserRepository) UpdateSafeAddress(ctx context.Context, id, safeAddress string) error {
 	if id == "" {
@@ -3,6 +3,7 @@ package rdb_test
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"testing"
 
 	"github.com/liverty-music/backend/internal/entity"
@@ -481,6 +482,83 @@ func TestUserRepository_List(t *testing.T) {
 	}
 }
 
+func TestUserRepository_ListPage(t *testing.T) {
+	repo := rdb.NewUserRepository(testDB)
+	ctx := context.Background()
+
+	createUser := func(t *testing.T, n int) string {
+		t.Helper()
+		u, err := repo.Create(ctx, newTestUser(
+			fmt.Sprintf("ext-page-%d", n), fmt.Sprintf("page%d@example.com", n), fmt.Sprintf("Page%d", n)))
+		require.NoError(t, err)
+		return u.ID
+	}
+
+	t.Run("walks every page via cursor while users are inserted concurrently", func(t *testing.T) {
+		cleanDatabase(t)
+		var want []string
+		for i := range 5 {
+			want = append(want, createUser(t, i))
+		}
+
+		var got []string
+		var pageSizes []int
+		cursor := ""
+		for page := 0; ; page++ {
+			users, next, err := repo.ListPage(ctx, 2, cursor)
+			require.NoError(t, err)
+			pageSizes = append(pageSizes, len(users))
+			for _, u := range users {
+				got = append(got, u.ID)
+			}
+			if page == 0 {
+				// Inserts between page fetches land after every existing
+				// row and must not shift or repeat anything already served.
+				want = append(want, createUser(t, 5), createUser(t, 6))
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		assert.Equal(t, want, got, "every user exactly once, in ID order")
+		assert.Equal(t, []int{2, 2, 2, 1}, pageSizes)
+	})
+
+	t.Run("empty table returns no next cursor", func(t *testing.T) {
+		cleanDatabase(t)
+
+		users, next, err := repo.ListPage(ctx, 10, "")
+
+		require.NoError(t, err)
+		assert.Empty(t, users)
+		assert.Empty(t, next)
+	})
+
+	t.Run("exact final page returns no next cursor", func(t *testing.T) {
+		cleanDatabase(t)
+		createUser(t, 0)
+		createUser(t, 1)
+
+		users, next, err := repo.ListPage(ctx, 2, "")
+
+		require.NoError(t, err)
+		assert.Len(t, users, 2)
+		assert.Empty(t, next)
+	})
+
+	t.Run("rejects a malformed cursor", func(t *testing.T) {
+		_, _, err := repo.ListPage(ctx, 10, "not-a-cursor")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("rejects a non-positive limit", func(t *testing.T) {
+		_, _, err := repo.ListPage(ctx, 0, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
 func TestUserRepository_Delete(t *testing.T) {
 	repo := rdb.NewUserRepository(testDB)
 	ctx := context.Background()
@@ -838,6 +916,22 @@ func TestUserRepository_PreferredLanguage(t *testing.T) {
 	})
 }
 
+func TestUserRepository_ConcertReminderDays(t *testing.T) {
+	repo := rdb.NewUserRepository(testDB)
+	ctx := context.Background()
+
+	t.Run("new users start at the default lead time", func(t *testing.T) {
+		cleanDatabase(t)
+		user, err := repo.Create(ctx, newTestUser("ext-remind-1", "remind1@example.com", "Remind1"))
+		require.NoError(t, err)
+		assert.Equal(t, entity.DefaultConcertReminderDays, user.ConcertReminderDays)
+
+		got, err := repo.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.DefaultConcertReminderDays, got.ConcertReminderDays)
+	})
+}
+
 // TestUserRepository_ScanNULLColumns is the regression gate for the
 // 2026-05-23 incident: scanUser scanned the nullable preferred_language,
 // country, and time_zone columns directly into Go *string fields. With
@@ -3,8 +3,13 @@ package rdb
 import (
 	"context"
 	"log/slog"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 )
 
 // VenueRepository implements entity.VenueRepository for PostgreSQL.
@@ -13,9 +18,11 @@ type VenueRepository struct {
 }
 
 const (
+	// A venue created with a place is enriched already; one created from a
+	// listed name alone awaits enrichment.
 	insertVenueQuery = `
-		INSERT INTO venues (id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO venues (id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name, enrichment_status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, CASE WHEN $4::text IS NULL THEN 'pending' ELSE 'enriched' END)
 	`
 	getVenueQuery = `
 		SELECT id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name
@@ -34,6 +41,228 @@ const (
 		  AND (admin_area = $2 OR (admin_area IS NULL AND $2 IS NULL))
 		LIMIT 1
 	`
+	// getVenueByNormalizedNameQuery matches on the same expressions as
+	// idx_venues_normalized_listed_venue_name and idx_venues_normalized_name.
+	// Exact listed-name matches sort first, then normalized listed-name
+	// matches, then canonical-name matches.
+	getVenueByNormalizedNameQuery = `
+		SELECT id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name
+		FROM venues
+		WHERE (lower(btrim(normalize(listed_venue_name, NFKC))) = lower(btrim(normalize($1, NFKC)))
+		    OR lower(btrim(normalize(name, NFKC))) = lower(btrim(normalize($1, NFKC))))
+		  AND (admin_area = $2 OR (admin_area IS NULL AND $2 IS NULL))
+		ORDER BY (listed_venue_name = $1) DESC NULLS LAST,
+		         (lower(btrim(normalize(listed_venue_name, NFKC))) = lower(btrim(normalize($1, NFKC)))) DESC NULLS LAST,
+		         id
+		LIMIT 1
+	`
+	updateVenueEnrichedQuery = `
+		UPDATE venues
+		SET name = $2, google_place_id = $3, latitude = $4, longitude = $5, enrichment_status = 'enriched'
+		WHERE id = $1
+	`
+	updateVenueMBIDQuery = `
+		UPDATE venues SET mbid = $2 WHERE id = $1
+	`
+	markVenueEnrichmentFailedQuery = `
+		UPDATE venues
+		SET enrichment_status = 'failed',
+		    enrichment_attempts = enrichment_attempts + 1,
+		    last_enrichment_attempt_at = now()
+		WHERE id = $1
+	`
+	markVenueEnrichmentRetryableQuery = `
+		UPDATE venues
+		SET enrichment_status = 'failed',
+		    last_enrichment_attempt_at = now()
+		WHERE id = $1
+	`
+	markVenueDuplicateQuery = `
+		UPDATE venues
+		SET enrichment_status = 'duplicate', duplicate_of_venue_id = $2
+		WHERE id = $1
+	`
+	markVenueNeedsReviewQuery = `
+		UPDATE venues
+		SET enrichment_status = 'needs_review',
+		    proposed_place_id = $2, proposed_name = $3,
+		    proposed_latitude = $4, proposed_longitude = $5,
+		    enrichment_confidence = $6
+		WHERE id = $1
+	`
+	listVenuesNeedingReviewQuery = `
+		SELECT id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name,
+		       proposed_place_id, proposed_name, proposed_latitude, proposed_longitude, enrichment_confidence
+		FROM venues
+		WHERE enrichment_status = 'needs_review'
+		ORDER BY id
+		LIMIT $1 OFFSET $2
+	`
+	approveVenueEnrichmentQuery = `
+		UPDATE venues
+		SET name = proposed_name, google_place_id = proposed_place_id,
+		    latitude = proposed_latitude, longitude = proposed_longitude,
+		    enrichment_status = 'enriched',
+		    proposed_place_id = NULL, proposed_name = NULL,
+		    proposed_latitude = NULL, proposed_longitude = NULL
+		WHERE id = $1 AND enrichment_status = 'needs_review'
+	`
+	rejectVenueEnrichmentQuery = `
+		UPDATE venues
+		SET enrichment_status = 'rejected',
+		    proposed_place_id = NULL, proposed_name = NULL,
+		    proposed_latitude = NULL, proposed_longitude = NULL
+		WHERE id = $1 AND enrichment_status = 'needs_review'
+	`
+	getVenueEnrichmentStatusQuery = `
+		SELECT enrichment_status FROM venues WHERE id = $1
+	`
+	// listVenueEnrichmentRetryCandidatesQuery selects failed venues whose
+	// backoff, $2 seconds doubled for every attempt after the first, has
+	// elapsed at $1. The exponent is capped so the interval cannot overflow.
+	listVenueEnrichmentRetryCandidatesQuery = `
+		SELECT id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name
+		FROM venues
+		WHERE enrichment_status = 'failed'
+		  AND enrichment_attempts < $3
+		  AND (
+			last_enrichment_attempt_at IS NULL
+			OR last_enrichment_attempt_at
+				+ make_interval(secs => $2::float8 * power(2, GREATEST(LEAST(enrichment_attempts, 30) - 1, 0)))
+				<= $1
+		  )
+		ORDER BY id
+		LIMIT $4
+	`
+	listVenuesByEnrichmentStatusQuery = `
+		SELECT id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name
+		FROM venues
+		WHERE enrichment_status = $1
+		ORDER BY id
+		LIMIT $2 OFFSET $3
+	`
+	// upsertVenuesByNameQuery inserts the requested venues whose normalized
+	// name (NFKC, lower-cased, trimmed, as in getVenueByNormalizedNameQuery)
+	// matches no existing row in the same admin area, and returns every
+	// requested name alongside its stored venue, all in one statement.
+	// Duplicates within the input are inserted once, and rows are inserted in
+	// input order, so the first occurrence wins. Names are not unique by
+	// constraint, so the name conflict is detected with NOT EXISTS; ON CONFLICT
+	// skips rows that collide on google_place_id, and those resolve to the
+	// venue already holding the place ID. The outer SELECT reads the snapshot
+	// from before the insert, so new rows come from inserted.
+	upsertVenuesByNameQuery = `
+		WITH requested AS (
+			SELECT t.*, lower(btrim(normalize(t.name, NFKC))) AS norm_name
+			FROM unnest($1::uuid[], $2::text[], $3::text[], $4::text[], $5::float8[], $6::float8[], $7::text[])
+				WITH ORDINALITY AS t(id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name, ord)
+		),
+		inserted AS (
+			INSERT INTO venues (id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name, enrichment_status)
+			SELECT d.id, d.name, d.admin_area, d.google_place_id, d.latitude, d.longitude, d.listed_venue_name,
+				CASE WHEN d.google_place_id IS NULL THEN 'pending' ELSE 'enriched' END
+			FROM (
+				SELECT DISTINCT ON (r.norm_name, r.admin_area) r.*
+				FROM requested r
+				WHERE NOT EXISTS (
+					SELECT 1 FROM venues v
+					WHERE lower(btrim(normalize(v.name, NFKC))) = r.norm_name
+					  AND v.admin_area IS NOT DISTINCT FROM r.admin_area
+				)
+				ORDER BY r.norm_name, r.admin_area, r.ord
+			) d
+			ORDER BY d.ord
+			ON CONFLICT DO NOTHING
+			RETURNING id, name, admin_area, google_place_id, latitude, longitude, listed_venue_name
+		)
+		SELECT r.name, v.id, v.name, v.admin_area, v.google_place_id, v.latitude, v.longitude, v.listed_venue_name
+		FROM requested r
+		CROSS JOIN LATERAL (
+			SELECT c.id, c.name, c.admin_area, c.google_place_id, c.latitude, c.longitude, c.listed_venue_name
+			FROM (
+				SELECT 0 AS pref, e.id, e.name, e.admin_area, e.google_place_id, e.latitude, e.longitude, e.listed_venue_name
+				FROM venues e
+				WHERE lower(btrim(normalize(e.name, NFKC))) = r.norm_name
+				  AND e.admin_area IS NOT DISTINCT FROM r.admin_area
+				UNION ALL
+				SELECT 1, i.id, i.name, i.admin_area, i.google_place_id, i.latitude, i.longitude, i.listed_venue_name
+				FROM inserted i
+				WHERE lower(btrim(normalize(i.name, NFKC))) = r.norm_name
+				  AND i.admin_area IS NOT DISTINCT FROM r.admin_area
+				UNION ALL
+				SELECT 2, e.id, e.name, e.admin_area, e.google_place_id, e.latitude, e.longitude, e.listed_venue_name
+				FROM venues e
+				WHERE e.google_place_id = r.google_place_id
+				UNION ALL
+				SELECT 3, i.id, i.name, i.admin_area, i.google_place_id, i.latitude, i.longitude, i.listed_venue_name
+				FROM inserted i
+				WHERE i.google_place_id = r.google_place_id
+			) c
+			ORDER BY c.pref, c.id
+			LIMIT 1
+		) v
+		ORDER BY r.ord
+	`
+
+	// lockMergeVenuesQuery locks both merge participants so concurrent merges
+	// or venue writes cannot interleave with the count and the mutations.
+	lockMergeVenuesQuery = `
+		SELECT count(*) FROM (
+			SELECT id FROM venues WHERE id = ANY($1::uuid[]) FOR UPDATE
+		) locked
+	`
+
+	// classifyMergeEventsQuery classifies each event of the duplicate venue
+	// ($2) against the canonical venue ($1) on the natural key (date,
+	// start_at; NULL start times compare equal, as in uq_events_natural_key):
+	// whether the canonical venue holds the key at all, whether it holds it
+	// with a live event, and whether the duplicate event has tickets or used
+	// nullifiers that a soft delete would strand.
+	classifyMergeEventsQuery = `
+		SELECT d.id,
+		       d.deleted_at IS NULL,
+		       EXISTS (
+				SELECT 1 FROM events c
+				WHERE c.venue_id = $1
+				  AND c.local_event_date = d.local_event_date
+				  AND c.start_at IS NOT DISTINCT FROM d.start_at
+		       ),
+		       EXISTS (
+				SELECT 1 FROM events c
+				WHERE c.venue_id = $1
+				  AND c.local_event_date = d.local_event_date
+				  AND c.start_at IS NOT DISTINCT FROM d.start_at
+				  AND c.deleted_at IS NULL
+		       ),
+		       EXISTS (SELECT 1 FROM tickets t WHERE t.event_id = d.id)
+		         OR EXISTS (SELECT 1 FROM nullifiers n WHERE n.event_id = d.id)
+		FROM events d
+		WHERE d.venue_id = $2
+		ORDER BY d.id
+	`
+
+	// mergeCollidingPerformersQuery links the performers of each duplicate
+	// event being soft-deleted ($2) to the live canonical event it collides
+	// with, so the merge loses no lineup entry.
+	mergeCollidingPerformersQuery = `
+		INSERT INTO event_performers (event_id, artist_id, set_start_at)
+		SELECT c.id, ep.artist_id, ep.set_start_at
+		FROM events d
+		JOIN events c
+		  ON c.venue_id = $1
+		 AND c.local_event_date = d.local_event_date
+		 AND c.start_at IS NOT DISTINCT FROM d.start_at
+		 AND c.deleted_at IS NULL
+		JOIN event_performers ep ON ep.event_id = d.id
+		WHERE d.id = ANY($2::uuid[])
+		ON CONFLICT (event_id, artist_id) DO NOTHING
+	`
+
+	softDeleteEventsQuery = `UPDATE events SET deleted_at = now() WHERE id = ANY($1::uuid[])`
+
+	repointEventsQuery = `UPDATE events SET venue_id = $1 WHERE id = ANY($2::uuid[])`
+
+	deleteVenueQuery = `DELETE FROM venues WHERE id = $1`
 )
 
 // NewVenueRepository creates a new venue repository instance.
@@ -119,3 +348,507 @@ func (r *VenueRepository) GetByListedName(ctx context.Context, listedVenueName s
 	}
 	return &v, nil
 }
+
+// GetByNormalizedName retrieves a venue by its NFKC-normalized, trimmed,
+// case-folded listed or canonical name and optional admin area.
+func (r *VenueRepository) GetByNormalizedName(ctx context.Context, name string, adminArea *string) (*entity.Venue, error) {
+	var v entity.Venue
+	var lat, lng *float64
+	err := r.db.Pool.QueryRow(ctx, getVenueByNormalizedNameQuery, name, adminArea).Scan(
+		&v.ID, &v.Name, &v.AdminArea, &v.GooglePlaceID,
+		&lat, &lng, &v.ListedVenueName,
+	)
+	if err != nil {
+		return nil, toAppErr(err, "failed to get venue by normalized name", slog.String("name", name))
+	}
+	if lat != nil && lng != nil {
+		v.Coordinates = &entity.Coordinates{Latitude: *lat, Longitude: *lng}
+	}
+	return &v, nil
+}
+
+// UpdateEnriched replaces a venue's name, place ID, and coordinates with those
+// of its resolved place and marks it enriched.
+func (r *VenueRepository) UpdateEnriched(ctx context.Context, venueID string, place *entity.VenuePlace) error {
+	if venueID == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+	if place == nil || place.ExternalID == "" || place.Name == "" {
+		return apperr.New(codes.InvalidArgument, "place must have an ID and a name", slog.String("venue_id", venueID))
+	}
+
+	var lat, lng *float64
+	if place.Coordinates != nil {
+		lat = &place.Coordinates.Latitude
+		lng = &place.Coordinates.Longitude
+	}
+	tag, err := r.db.Pool.Exec(ctx, updateVenueEnrichedQuery, venueID, place.Name, place.ExternalID, lat, lng)
+	if err != nil {
+		return toAppErr(err, "failed to update enriched venue",
+			slog.String("venue_id", venueID),
+			slog.String("place_id", place.ExternalID),
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "venue not found", slog.String("venue_id", venueID))
+	}
+	return nil
+}
+
+// UpdateMBID records the MusicBrainz Place ID resolved for a venue.
+func (r *VenueRepository) UpdateMBID(ctx context.Context, venueID, mbid string) error {
+	if venueID == "" || mbid == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID and MBID cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, updateVenueMBIDQuery, venueID, mbid)
+	if err != nil {
+		return toAppErr(err, "failed to update venue MBID",
+			slog.String("venue_id", venueID),
+			slog.String("mbid", mbid),
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "venue not found", slog.String("venue_id", venueID))
+	}
+	return nil
+}
+
+// MarkFailed marks a venue's place enrichment as failed.
+func (r *VenueRepository) MarkFailed(ctx context.Context, venueID string) error {
+	if venueID == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, markVenueEnrichmentFailedQuery, venueID)
+	if err != nil {
+		return toAppErr(err, "failed to mark venue enrichment failed", slog.String("venue_id", venueID))
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "venue not found", slog.String("venue_id", venueID))
+	}
+	return nil
+}
+
+// MarkRetryable marks a venue's place enrichment as failed for a transient
+// reason, without counting the attempt.
+func (r *VenueRepository) MarkRetryable(ctx context.Context, venueID string) error {
+	if venueID == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, markVenueEnrichmentRetryableQuery, venueID)
+	if err != nil {
+		return toAppErr(err, "failed to mark venue enrichment retryable", slog.String("venue_id", venueID))
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "venue not found", slog.String("venue_id", venueID))
+	}
+	return nil
+}
+
+// MarkDuplicate flags a venue as a duplicate of the venue holding its place.
+func (r *VenueRepository) MarkDuplicate(ctx context.Context, venueID, canonicalID string) error {
+	if venueID == "" || canonicalID == "" {
+		return apperr.New(codes.InvalidArgument, "venue IDs cannot be empty")
+	}
+	if venueID == canonicalID {
+		return apperr.New(codes.InvalidArgument, "venue cannot duplicate itself", slog.String("venue_id", venueID))
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, markVenueDuplicateQuery, venueID, canonicalID)
+	if err != nil {
+		return toAppErr(err, "failed to mark venue duplicate",
+			slog.String("venue_id", venueID),
+			slog.String("canonical_venue_id", canonicalID),
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "venue not found", slog.String("venue_id", venueID))
+	}
+	return nil
+}
+
+// MarkNeedsReview holds a venue's uncertain place match for review.
+func (r *VenueRepository) MarkNeedsReview(ctx context.Context, venueID string, place *entity.VenuePlace, confidence float64) error {
+	if venueID == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+	if place == nil || place.ExternalID == "" || place.Name == "" {
+		return apperr.New(codes.InvalidArgument, "place must have an ID and a name", slog.String("venue_id", venueID))
+	}
+	if confidence < 0 || confidence > 1 {
+		return apperr.New(codes.InvalidArgument, "confidence must be between 0 and 1",
+			slog.String("venue_id", venueID),
+			slog.Float64("confidence", confidence),
+		)
+	}
+
+	var lat, lng *float64
+	if place.Coordinates != nil {
+		lat = &place.Coordinates.Latitude
+		lng = &place.Coordinates.Longitude
+	}
+	tag, err := r.db.Pool.Exec(ctx, markVenueNeedsReviewQuery, venueID, place.ExternalID, place.Name, lat, lng, confidence)
+	if err != nil {
+		return toAppErr(err, "failed to mark venue for enrichment review",
+			slog.String("venue_id", venueID),
+			slog.String("place_id", place.ExternalID),
+		)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.New(codes.NotFound, "venue not found", slog.String("venue_id", venueID))
+	}
+	return nil
+}
+
+// ListNeedsReview returns one page of the venues awaiting an enrichment
+// review, ordered by ID (creation order).
+func (r *VenueRepository) ListNeedsReview(ctx context.Context, limit, offset int) ([]*entity.EnrichmentReview, error) {
+	if limit <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "page limit must be positive")
+	}
+	if offset < 0 {
+		return nil, apperr.New(codes.InvalidArgument, "page offset must not be negative")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listVenuesNeedingReviewQuery, limit, offset)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list venues needing enrichment review")
+	}
+	defer rows.Close()
+
+	var reviews []*entity.EnrichmentReview
+	for rows.Next() {
+		var v entity.Venue
+		var p entity.VenuePlace
+		var lat, lng, proposedLat, proposedLng *float64
+		var confidence float64
+		if err := rows.Scan(
+			&v.ID, &v.Name, &v.AdminArea, &v.GooglePlaceID, &lat, &lng, &v.ListedVenueName,
+			&p.ExternalID, &p.Name, &proposedLat, &proposedLng, &confidence,
+		); err != nil {
+			return nil, toAppErr(err, "failed to scan venue needing enrichment review")
+		}
+		if lat != nil && lng != nil {
+			v.Coordinates = &entity.Coordinates{Latitude: *lat, Longitude: *lng}
+		}
+		if proposedLat != nil && proposedLng != nil {
+			p.Coordinates = &entity.Coordinates{Latitude: *proposedLat, Longitude: *proposedLng}
+		}
+		reviews = append(reviews, &entity.EnrichmentReview{Venue: &v, ProposedPlace: &p, Confidence: confidence})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "venue review row iteration ended with error")
+	}
+	return reviews, nil
+}
+
+// ApproveEnrichment applies a venue's proposed place and marks it enriched.
+func (r *VenueRepository) ApproveEnrichment(ctx context.Context, venueID string) error {
+	if venueID == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, approveVenueEnrichmentQuery, venueID)
+	if err != nil {
+		return toAppErr(err, "failed to approve venue enrichment", slog.String("venue_id", venueID))
+	}
+	if tag.RowsAffected() == 0 {
+		return r.reviewTransitionError(ctx, venueID)
+	}
+
+	r.db.logger.Info(ctx, "venue enrichment approved",
+		slog.String("entityType", "venue"),
+		slog.String("venueID", venueID),
+	)
+	return nil
+}
+
+// RejectEnrichment discards a venue's proposed place and marks it rejected.
+func (r *VenueRepository) RejectEnrichment(ctx context.Context, venueID string) error {
+	if venueID == "" {
+		return apperr.New(codes.InvalidArgument, "venue ID cannot be empty")
+	}
+
+	tag, err := r.db.Pool.Exec(ctx, rejectVenueEnrichmentQuery, venueID)
+	if err != nil {
+		return toAppErr(err, "failed to reject venue enrichment", slog.String("venue_id", venueID))
+	}
+	if tag.RowsAffected() == 0 {
+		return r.reviewTransitionError(ctx, venueID)
+	}
+
+	r.db.logger.Info(ctx, "venue enrichment rejected",
+		slog.String("entityType", "venue"),
+		slog.String("venueID", venueID),
+	)
+	return nil
+}
+
+// reviewTransitionError explains why a review decision changed no row: the
+// venue does not exist, or it is not awaiting review.
+func (r *VenueRepository) reviewTransitionError(ctx context.Context, venueID string) error {
+	var status string
+	if err := r.db.Pool.QueryRow(ctx, getVenueEnrichmentStatusQuery, venueID).Scan(&status); err != nil {
+		return toAppErr(err, "failed to get venue enrichment status", slog.String("venue_id", venueID))
+	}
+	return apperr.New(codes.FailedPrecondition, "venue is not awaiting enrichment review",
+		slog.String("venue_id", venueID),
+		slog.String("status", status),
+	)
+}
+
+// ListByEnrichmentStatus returns one page of the venues in the given
+// enrichment status, ordered by ID (creation order).
+func (r *VenueRepository) ListByEnrichmentStatus(ctx context.Context, status entity.EnrichmentStatus, limit, offset int) ([]*entity.Venue, error) {
+	if !status.IsValid() {
+		return nil, apperr.New(codes.InvalidArgument, "unknown enrichment status", slog.String("status", string(status)))
+	}
+	if limit <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "page limit must be positive")
+	}
+	if offset < 0 {
+		return nil, apperr.New(codes.InvalidArgument, "page offset must not be negative")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listVenuesByEnrichmentStatusQuery, string(status), limit, offset)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list venues by enrichment status", slog.String("status", string(status)))
+	}
+	return collectVenues(rows)
+}
+
+// ListEnrichmentRetryCandidates returns the failed venues whose enrichment
+// retry backoff has elapsed at now, ordered by ID (creation order).
+func (r *VenueRepository) ListEnrichmentRetryCandidates(ctx context.Context, now time.Time, baseBackoff time.Duration, maxAttempts, limit int) ([]*entity.Venue, error) {
+	if baseBackoff < 0 {
+		return nil, apperr.New(codes.InvalidArgument, "base backoff must not be negative")
+	}
+	if maxAttempts <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "max attempts must be positive")
+	}
+	if limit <= 0 {
+		return nil, apperr.New(codes.InvalidArgument, "limit must be positive")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, listVenueEnrichmentRetryCandidatesQuery, now, baseBackoff.Seconds(), maxAttempts, limit)
+	if err != nil {
+		return nil, toAppErr(err, "failed to list venue enrichment retry candidates")
+	}
+	return collectVenues(rows)
+}
+
+// collectVenues scans and closes rows of venue columns.
+func collectVenues(rows pgx.Rows) ([]*entity.Venue, error) {
+	defer rows.Close()
+
+	var venues []*entity.Venue
+	for rows.Next() {
+		var v entity.Venue
+		var lat, lng *float64
+		if err := rows.Scan(&v.ID, &v.Name, &v.AdminArea, &v.GooglePlaceID, &lat, &lng, &v.ListedVenueName); err != nil {
+			return nil, toAppErr(err, "failed to scan venue")
+		}
+		if lat != nil && lng != nil {
+			v.Coordinates = &entity.Coordinates{Latitude: *lat, Longitude: *lng}
+		}
+		venues = append(venues, &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "venue row iteration ended with error")
+	}
+	return venues, nil
+}
+
+// UpsertByNames inserts the venues whose normalized name does not exist yet in
+// their admin area and returns every requested venue keyed by its requested
+// Name, in a single round-trip. Existing rows are returned unchanged; nil
+// entries are skipped.
+func (r *VenueRepository) UpsertByNames(ctx context.Context, venues []*entity.Venue) (map[string]*entity.Venue, error) {
+	rows := compactRows(venues)
+	result := make(map[string]*entity.Venue, len(rows))
+	if len(rows) == 0 {
+		return result, nil
+	}
+
+	adminAreaByName := make(map[string]string, len(rows))
+	for _, v := range rows {
+		if strings.TrimSpace(v.Name) == "" {
+			return nil, apperr.New(codes.InvalidArgument, "all venues must have a non-empty name")
+		}
+		// The result is keyed by name, so one name cannot stand for venues in
+		// two admin areas. A nil admin area counts as an area of its own.
+		area := "nil"
+		if v.AdminArea != nil {
+			area = "=" + *v.AdminArea
+		}
+		if prev, ok := adminAreaByName[v.Name]; ok && prev != area {
+			return nil, apperr.New(codes.InvalidArgument, "venue name is requested for more than one admin area",
+				slog.String("name", v.Name),
+			)
+		}
+		adminAreaByName[v.Name] = area
+	}
+	ids := unnestColumn(rows, func(v *entity.Venue) string { return v.ID })
+	names := unnestColumn(rows, func(v *entity.Venue) string { return v.Name })
+	adminAreas := unnestColumn(rows, func(v *entity.Venue) *string { return v.AdminArea })
+	placeIDs := unnestColumn(rows, func(v *entity.Venue) *string { return v.GooglePlaceID })
+	lats := unnestColumn(rows, func(v *entity.Venue) *float64 {
+		if v.Coordinates == nil {
+			return nil
+		}
+		return &v.Coordinates.Latitude
+	})
+	lngs := unnestColumn(rows, func(v *entity.Venue) *float64 {
+		if v.Coordinates == nil {
+			return nil
+		}
+		return &v.Coordinates.Longitude
+	})
+	listedNames := unnestColumn(rows, func(v *entity.Venue) *string { return v.ListedVenueName })
+
+	dbRows, err := r.db.Pool.Query(ctx, upsertVenuesByNameQuery, ids, names, adminAreas, placeIDs, lats, lngs, listedNames)
+	if err != nil {
+		return nil, toAppErr(err, "failed to upsert venues by name", slog.Int("count", len(rows)))
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var requestedName string
+		var v entity.Venue
+		var lat, lng *float64
+		if err := dbRows.Scan(
+			&requestedName,
+			&v.ID, &v.Name, &v.AdminArea, &v.GooglePlaceID,
+			&lat, &lng, &v.ListedVenueName,
+		); err != nil {
+			return nil, toAppErr(err, "failed to scan upserted venue")
+		}
+		if lat != nil && lng != nil {
+			v.Coordinates = &entity.Coordinates{Latitude: *lat, Longitude: *lng}
+		}
+		result[requestedName] = &v
+	}
+	if err := dbRows.Err(); err != nil {
+		return nil, toAppErr(err, "error iterating upserted venue rows")
+	}
+
+	r.db.logger.Info(ctx, "venues upserted by name",
+		slog.String("entityType", "venue"),
+		slog.Int("requested", len(rows)),
+		slog.Int("resolved", len(result)),
+	)
+	return result, nil
+}
+
+// MergeVenues folds the duplicate venue into the canonical one, or only
+// reports what that would do when dryRun is set.
+func (r *VenueRepository) MergeVenues(ctx context.Context, canonicalID, duplicateID string, dryRun bool) (*entity.MergeReport, error) {
+	if canonicalID == "" || duplicateID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "canonical and duplicate venue IDs must not be empty")
+	}
+	if canonicalID == duplicateID {
+		return nil, apperr.New(codes.InvalidArgument, "cannot merge a venue into itself",
+			slog.String("venue_id", canonicalID))
+	}
+	attrs := []slog.Attr{
+		slog.String("canonical_venue_id", canonicalID),
+		slog.String("duplicate_venue_id", duplicateID),
+	}
+
+	// The dry run shares the transaction and locks with the apply path and is
+	// simply rolled back, so its counts are taken exactly as an apply would.
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, toAppErr(err, "failed to begin venue merge transaction", attrs...)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var locked int
+	if err := tx.QueryRow(ctx, lockMergeVenuesQuery, []string{canonicalID, duplicateID}).Scan(&locked); err != nil {
+		return nil, toAppErr(err, "failed to lock venues for merge", attrs...)
+	}
+	if locked != 2 {
+		return nil, apperr.New(codes.NotFound, "venue to merge not found", attrs...)
+	}
+
+	report := &entity.MergeReport{CanonicalID: canonicalID, DuplicateID: duplicateID, DryRun: dryRun}
+	rows, err := tx.Query(ctx, classifyMergeEventsQuery, canonicalID, duplicateID)
+	if err != nil {
+		return nil, toAppErr(err, "failed to classify events to merge", attrs...)
+	}
+	var repointIDs, blockedIDs []string
+	for rows.Next() {
+		var (
+			id                                 string
+			live, keyTaken, collides, hasEntry bool
+		)
+		if err := rows.Scan(&id, &live, &keyTaken, &collides, &hasEntry); err != nil {
+			rows.Close()
+			return nil, toAppErr(err, "failed to scan event to merge", attrs...)
+		}
+		switch {
+		case !keyTaken:
+			repointIDs = append(repointIDs, id)
+		case live && collides:
+			if hasEntry {
+				blockedIDs = append(blockedIDs, id)
+			}
+			report.DeletedConcertIDs = append(report.DeletedConcertIDs, id)
+		default:
+			report.RetainedConcerts++
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, toAppErr(err, "failed to classify events to merge", attrs...)
+	}
+	if len(blockedIDs) > 0 {
+		return nil, apperr.New(codes.FailedPrecondition, "colliding events have tickets or used nullifiers",
+			append(attrs, slog.Any("event_ids", blockedIDs))...)
+	}
+	report.RepointedConcerts = len(repointIDs)
+	report.DeletedConcerts = len(report.DeletedConcertIDs)
+	// Soft-deleted events keep the natural key, so they stay on the
+	// duplicate venue alongside any event whose key a soft-deleted
+	// canonical event holds.
+	report.RetainedConcerts += report.DeletedConcerts
+	if dryRun {
+		return report, nil
+	}
+
+	if len(report.DeletedConcertIDs) > 0 {
+		if _, err := tx.Exec(ctx, mergeCollidingPerformersQuery, canonicalID, report.DeletedConcertIDs); err != nil {
+			return nil, toAppErr(err, "failed to merge performers of colliding events", attrs...)
+		}
+		if _, err := tx.Exec(ctx, softDeleteEventsQuery, report.DeletedConcertIDs); err != nil {
+			return nil, toAppErr(err, "failed to soft-delete colliding events", attrs...)
+		}
+	}
+	if len(repointIDs) > 0 {
+		if _, err := tx.Exec(ctx, repointEventsQuery, canonicalID, repointIDs); err != nil {
+			return nil, toAppErr(err, "failed to re-point events to canonical venue", attrs...)
+		}
+	}
+	// Deleting the venue would cascade to the events still on it, so a
+	// venue that keeps events is flagged instead.
+	if report.RetainedConcerts > 0 {
+		if _, err := tx.Exec(ctx, markVenueDuplicateQuery, duplicateID, canonicalID); err != nil {
+			return nil, toAppErr(err, "failed to flag duplicate venue", attrs...)
+		}
+	} else if _, err := tx.Exec(ctx, deleteVenueQuery, duplicateID); err != nil {
+		return nil, toAppErr(err, "failed to delete duplicate venue", attrs...)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, toAppErr(err, "failed to commit venue merge", attrs...)
+	}
+
+	r.db.logger.Info(ctx, "venues merged", append(attrs,
+		slog.Int("repointed_concerts", report.RepointedConcerts),
+		slog.Int("deleted_concerts", report.DeletedConcerts),
+		slog.Any("deleted_concert_ids", report.DeletedConcertIDs),
+		slog.Int("retained_concerts", report.RetainedConcerts),
+	)...)
+	return report, nil
+}
@@ -3,6 +3,7 @@ package rdb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
@@ -242,6 +243,108 @@ func TestVenueRepository_GetByListedName(t *testing.T) {
 	}
 }
 
+func TestVenueRepository_GetByNormalizedName(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	adminArea := "JP-23"
+	listedName := "Zepp Nagoya"
+	seededWithArea := &entity.Venue{
+		ID:              "018b2f19-e591-7d12-bf9e-f0e74f1b50b1",
+		Name:            "Zepp Nagoya",
+		AdminArea:       &adminArea,
+		ListedVenueName: &listedName,
+	}
+	require.NoError(t, repo.Create(ctx, seededWithArea))
+
+	// Seed: venue whose canonical name differs from its listed name.
+	listedNameNoArea := "武道館"
+	seededNoArea := &entity.Venue{
+		ID:              "018b2f19-e591-7d12-bf9e-f0e74f1b50b2",
+		Name:            "Nippon Budokan",
+		GooglePlaceID:   new("ChIJbudokan002"),
+		ListedVenueName: &listedNameNoArea,
+	}
+	require.NoError(t, repo.Create(ctx, seededNoArea))
+
+	type args struct {
+		name      string
+		adminArea *string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantID  string
+		wantErr error
+	}{
+		{
+			name:   "exact listed name",
+			args:   args{name: "Zepp Nagoya", adminArea: &adminArea},
+			wantID: seededWithArea.ID,
+		},
+		{
+			name:   "case-insensitive",
+			args:   args{name: "ZEPP NAGOYA", adminArea: &adminArea},
+			wantID: seededWithArea.ID,
+		},
+		{
+			name:   "trailing and leading spaces",
+			args:   args{name: " Zepp Nagoya ", adminArea: &adminArea},
+			wantID: seededWithArea.ID,
+		},
+		{
+			name:   "full-width letters",
+			args:   args{name: "Ｚｅｐｐ Ｎａｇｏｙａ", adminArea: &adminArea},
+			wantID: seededWithArea.ID,
+		},
+		{
+			name:   "full-width upper case with ideographic space",
+			args:   args{name: "ＺＥＰＰ　ＮＡＧＯＹＡ", adminArea: &adminArea},
+			wantID: seededWithArea.ID,
+		},
+		{
+			name:   "canonical name with NULL admin area",
+			args:   args{name: "nippon budokan", adminArea: nil},
+			wantID: seededNoArea.ID,
+		},
+		{
+			name:   "listed name with NULL admin area",
+			args:   args{name: "武道館 ", adminArea: nil},
+			wantID: seededNoArea.ID,
+		},
+		{
+			name:    "not found: wrong admin area",
+			args:    args{name: "zepp nagoya", adminArea: new("JP-27")},
+			wantErr: apperr.ErrNotFound,
+		},
+		{
+			name:    "not found: different name",
+			args:    args{name: "Zepp Nagoya Hall", adminArea: &adminArea},
+			wantErr: apperr.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.GetByNormalizedName(ctx, tt.args.name, tt.args.adminArea)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantID, got.ID)
+		})
+	}
+
+	t.Run("GetByListedName stays exact", func(t *testing.T) {
+		_, err := repo.GetByListedName(ctx, "ZEPP NAGOYA", &adminArea)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+}
+
 func TestVenueRepository_GetByPlaceID(t *testing.T) {
 	cleanDatabase(t)
 	repo := rdb.NewVenueRepository(testDB)
@@ -295,3 +398,825 @@ func TestVenueRepository_GetByPlaceID(t *testing.T) {
 		})
 	}
 }
+
+func TestVenueRepository_UpdateEnriched(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	listed := "zepp haneda"
+	venue := &entity.Venue{ID: newTestID(t), Name: listed, AdminArea: new("JP-13"), ListedVenueName: &listed}
+	require.NoError(t, repo.Create(ctx, venue))
+	other := &entity.Venue{ID: newTestID(t), Name: "Budokan", GooglePlaceID: new("ChIJbudokan")}
+	require.NoError(t, repo.Create(ctx, other))
+
+	t.Run("records the place and keeps the listed name and admin area", func(t *testing.T) {
+		place := &entity.VenuePlace{
+			ExternalID:  "ChIJzepp",
+			Name:        "Zepp Haneda (TOKYO)",
+			Coordinates: &entity.Coordinates{Latitude: 35.54, Longitude: 139.75},
+		}
+		require.NoError(t, repo.UpdateEnriched(ctx, venue.ID, place))
+
+		got, err := repo.Get(ctx, venue.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &entity.Venue{
+			ID:              venue.ID,
+			Name:            "Zepp Haneda (TOKYO)",
+			AdminArea:       new("JP-13"),
+			GooglePlaceID:   new("ChIJzepp"),
+			Coordinates:     &entity.Coordinates{Latitude: 35.54, Longitude: 139.75},
+			ListedVenueName: &listed,
+		}, got)
+	})
+
+	t.Run("place without coordinates stores NULL coordinates", func(t *testing.T) {
+		unmapped := &entity.Venue{
+			ID:          newTestID(t),
+			Name:        "Live House",
+			Coordinates: &entity.Coordinates{Latitude: 1, Longitude: 2},
+		}
+		require.NoError(t, repo.Create(ctx, unmapped))
+
+		require.NoError(t, repo.UpdateEnriched(ctx, unmapped.ID, &entity.VenuePlace{ExternalID: "ChIJlivehouse", Name: "Live House"}))
+
+		got, err := repo.Get(ctx, unmapped.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.Coordinates)
+		var lat, lng *float64
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT latitude, longitude FROM venues WHERE id = $1`, unmapped.ID).Scan(&lat, &lng))
+		assert.Nil(t, lat)
+		assert.Nil(t, lng)
+	})
+
+	t.Run("coordinates round-trip at full precision", func(t *testing.T) {
+		precise := &entity.Venue{ID: newTestID(t), Name: "Hollywood Bowl"}
+		require.NoError(t, repo.Create(ctx, precise))
+
+		coords := &entity.Coordinates{Latitude: 34.112222, Longitude: -118.339167}
+		require.NoError(t, repo.UpdateEnriched(ctx, precise.ID,
+			&entity.VenuePlace{ExternalID: "ChIJbowl", Name: "Hollywood Bowl", Coordinates: coords}))
+
+		got, err := repo.Get(ctx, precise.ID)
+		require.NoError(t, err)
+		assert.Equal(t, coords, got.Coordinates)
+	})
+
+	t.Run("place held by another venue returns AlreadyExists", func(t *testing.T) {
+		err := repo.UpdateEnriched(ctx, venue.ID, &entity.VenuePlace{ExternalID: "ChIJbudokan", Name: "Nippon Budokan"})
+		assert.ErrorIs(t, err, apperr.ErrAlreadyExists)
+	})
+
+	t.Run("unknown venue returns NotFound", func(t *testing.T) {
+		err := repo.UpdateEnriched(ctx, newTestID(t), &entity.VenuePlace{ExternalID: "ChIJnew", Name: "New Hall"})
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("place without an ID returns InvalidArgument", func(t *testing.T) {
+		err := repo.UpdateEnriched(ctx, venue.ID, &entity.VenuePlace{Name: "New Hall"})
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_UpdateMBID(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	venue := &entity.Venue{ID: newTestID(t), Name: "Zepp Haneda", GooglePlaceID: new("ChIJzepp")}
+	require.NoError(t, repo.Create(ctx, venue))
+
+	t.Run("records the MBID", func(t *testing.T) {
+		require.NoError(t, repo.UpdateMBID(ctx, venue.ID, "mbid-zepp"))
+
+		var mbid *string
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT mbid FROM venues WHERE id = $1`, venue.ID).Scan(&mbid))
+		require.NotNil(t, mbid)
+		assert.Equal(t, "mbid-zepp", *mbid)
+	})
+
+	t.Run("unknown venue returns NotFound", func(t *testing.T) {
+		err := repo.UpdateMBID(ctx, newTestID(t), "mbid-new")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty MBID returns InvalidArgument", func(t *testing.T) {
+		err := repo.UpdateMBID(ctx, venue.ID, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_MarkFailed(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	venue := &entity.Venue{ID: newTestID(t), Name: "Secret Venue", ListedVenueName: new("Secret Venue")}
+	require.NoError(t, repo.Create(ctx, venue))
+
+	attempts := func(t *testing.T) (int, *time.Time) {
+		t.Helper()
+		var n int
+		var last *time.Time
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT enrichment_attempts, last_enrichment_attempt_at FROM venues WHERE id = $1`, venue.ID,
+		).Scan(&n, &last))
+		return n, last
+	}
+
+	t.Run("moves the venue to the failed status", func(t *testing.T) {
+		require.NoError(t, repo.MarkFailed(ctx, venue.ID))
+		n, last := attempts(t)
+		assert.Equal(t, 1, n)
+		assert.NotNil(t, last)
+
+		failed, err := repo.ListByEnrichmentStatus(ctx, entity.EnrichmentStatusFailed, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		assert.Equal(t, venue.ID, failed[0].ID)
+
+		pending, err := repo.ListByEnrichmentStatus(ctx, entity.EnrichmentStatusPending, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("every failure counts another attempt", func(t *testing.T) {
+		require.NoError(t, repo.MarkFailed(ctx, venue.ID))
+		n, _ := attempts(t)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("unknown venue returns NotFound", func(t *testing.T) {
+		err := repo.MarkFailed(ctx, newTestID(t))
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty ID returns InvalidArgument", func(t *testing.T) {
+		err := repo.MarkFailed(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_MarkRetryable(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	venue := &entity.Venue{ID: newTestID(t), Name: "Budokan", ListedVenueName: new("Budokan")}
+	require.NoError(t, repo.Create(ctx, venue))
+
+	t.Run("schedules a retry without counting the attempt", func(t *testing.T) {
+		require.NoError(t, repo.MarkRetryable(ctx, venue.ID))
+
+		var n int
+		var last *time.Time
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT enrichment_attempts, last_enrichment_attempt_at FROM venues WHERE id = $1`, venue.ID,
+		).Scan(&n, &last))
+		assert.Zero(t, n)
+		assert.NotNil(t, last)
+
+		failed, err := repo.ListByEnrichmentStatus(ctx, entity.EnrichmentStatusFailed, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		assert.Equal(t, venue.ID, failed[0].ID)
+	})
+
+	t.Run("unknown venue returns NotFound", func(t *testing.T) {
+		err := repo.MarkRetryable(ctx, newTestID(t))
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty ID returns InvalidArgument", func(t *testing.T) {
+		err := repo.MarkRetryable(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_MarkDuplicate(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	canonical := &entity.Venue{ID: newTestID(t), Name: "Nippon Budokan", GooglePlaceID: new("place-budokan")}
+	require.NoError(t, repo.Create(ctx, canonical))
+	venue := &entity.Venue{ID: newTestID(t), Name: "Budokan", ListedVenueName: new("Budokan")}
+	require.NoError(t, repo.Create(ctx, venue))
+
+	t.Run("flags the venue for an admin merge", func(t *testing.T) {
+		require.NoError(t, repo.MarkDuplicate(ctx, venue.ID, canonical.ID))
+
+		var duplicateOf string
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT duplicate_of_venue_id FROM venues WHERE id = $1`, venue.ID,
+		).Scan(&duplicateOf))
+		assert.Equal(t, canonical.ID, duplicateOf)
+
+		duplicates, err := repo.ListByEnrichmentStatus(ctx, entity.EnrichmentStatusDuplicate, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, duplicates, 1)
+		assert.Equal(t, venue.ID, duplicates[0].ID)
+	})
+
+	t.Run("unknown venue returns NotFound", func(t *testing.T) {
+		err := repo.MarkDuplicate(ctx, newTestID(t), canonical.ID)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("same venue returns InvalidArgument", func(t *testing.T) {
+		err := repo.MarkDuplicate(ctx, venue.ID, venue.ID)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_EnrichmentReview(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	newUnresolved := func(name string) *entity.Venue {
+		v := &entity.Venue{ID: newTestID(t), Name: name, AdminArea: new("JP-13"), ListedVenueName: new(name)}
+		require.NoError(t, repo.Create(ctx, v))
+		return v
+	}
+	statusOf := func(t *testing.T, venueID string) entity.EnrichmentStatus {
+		t.Helper()
+		var status string
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT enrichment_status FROM venues WHERE id = $1`, venueID,
+		).Scan(&status))
+		return entity.EnrichmentStatus(status)
+	}
+	proposal := &entity.VenuePlace{
+		ExternalID:  "ChIJdome",
+		Name:        "Tokyo Dome",
+		Coordinates: &entity.Coordinates{Latitude: 35.7056, Longitude: 139.7519},
+	}
+
+	t.Run("held match is listed with its proposal and leaves the venue unresolved", func(t *testing.T) {
+		venue := newUnresolved("Secret Live House")
+		require.NoError(t, repo.MarkNeedsReview(ctx, venue.ID, proposal, 0.2))
+
+		assert.Equal(t, entity.EnrichmentStatusNeedsReview, statusOf(t, venue.ID))
+		reviews, err := repo.ListNeedsReview(ctx, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, reviews, 1)
+		assert.Equal(t, venue, reviews[0].Venue)
+		assert.Equal(t, proposal, reviews[0].ProposedPlace)
+		assert.InDelta(t, 0.2, reviews[0].Confidence, 1e-9)
+
+		got, err := repo.Get(ctx, venue.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.GooglePlaceID)
+		assert.Equal(t, "Secret Live House", got.Name)
+	})
+
+	t.Run("approval applies the proposed place", func(t *testing.T) {
+		venue := newUnresolved("Dome Hall")
+		require.NoError(t, repo.MarkNeedsReview(ctx, venue.ID, &entity.VenuePlace{ExternalID: "ChIJhall", Name: "Dome City Hall"}, 0.4))
+
+		require.NoError(t, repo.ApproveEnrichment(ctx, venue.ID))
+
+		assert.Equal(t, entity.EnrichmentStatusEnriched, statusOf(t, venue.ID))
+		got, err := repo.Get(ctx, venue.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Dome City Hall", got.Name)
+		require.NotNil(t, got.GooglePlaceID)
+		assert.Equal(t, "ChIJhall", *got.GooglePlaceID)
+		assert.Nil(t, got.Coordinates)
+
+		var proposedPlaceID *string
+		var confidence *float64
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			`SELECT proposed_place_id, enrichment_confidence FROM venues WHERE id = $1`, venue.ID,
+		).Scan(&proposedPlaceID, &confidence))
+		assert.Nil(t, proposedPlaceID)
+		require.NotNil(t, confidence)
+		assert.InDelta(t, 0.4, *confidence, 1e-9)
+	})
+
+	t.Run("rejection discards the proposal and is not retried", func(t *testing.T) {
+		venue := newUnresolved("Tiny Bar")
+		require.NoError(t, repo.MarkNeedsReview(ctx, venue.ID, &entity.VenuePlace{ExternalID: "ChIJbar", Name: "Tiny Bar Osaka"}, 0.45))
+
+		require.NoError(t, repo.RejectEnrichment(ctx, venue.ID))
+
+		assert.Equal(t, entity.EnrichmentStatusRejected, statusOf(t, venue.ID))
+		got, err := repo.Get(ctx, venue.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.GooglePlaceID)
+		candidates, err := repo.ListEnrichmentRetryCandidates(ctx, time.Now(), time.Second, 5, 10)
+		require.NoError(t, err)
+		assert.Empty(t, candidates)
+	})
+
+	t.Run("approving a place another venue holds returns AlreadyExists", func(t *testing.T) {
+		holder := &entity.Venue{ID: newTestID(t), Name: "Nippon Budokan", GooglePlaceID: new("ChIJbudokan")}
+		require.NoError(t, repo.Create(ctx, holder))
+		venue := newUnresolved("Budo")
+		require.NoError(t, repo.MarkNeedsReview(ctx, venue.ID, &entity.VenuePlace{ExternalID: "ChIJbudokan", Name: "Nippon Budokan"}, 0.3))
+
+		err := repo.ApproveEnrichment(ctx, venue.ID)
+		assert.ErrorIs(t, err, apperr.ErrAlreadyExists)
+		assert.Equal(t, entity.EnrichmentStatusNeedsReview, statusOf(t, venue.ID))
+	})
+
+	t.Run("deciding a venue not awaiting review returns FailedPrecondition", func(t *testing.T) {
+		venue := newUnresolved("Pending Hall")
+
+		assert.ErrorIs(t, repo.ApproveEnrichment(ctx, venue.ID), apperr.ErrFailedPrecondition)
+		assert.ErrorIs(t, repo.RejectEnrichment(ctx, venue.ID), apperr.ErrFailedPrecondition)
+		assert.Equal(t, entity.EnrichmentStatusPending, statusOf(t, venue.ID))
+	})
+
+	t.Run("unknown venue returns NotFound", func(t *testing.T) {
+		assert.ErrorIs(t, repo.MarkNeedsReview(ctx, newTestID(t), proposal, 0.2), apperr.ErrNotFound)
+		assert.ErrorIs(t, repo.ApproveEnrichment(ctx, newTestID(t)), apperr.ErrNotFound)
+		assert.ErrorIs(t, repo.RejectEnrichment(ctx, newTestID(t)), apperr.ErrNotFound)
+	})
+
+	t.Run("invalid arguments are rejected", func(t *testing.T) {
+		venue := newUnresolved("Arg Hall")
+
+		assert.ErrorIs(t, repo.MarkNeedsReview(ctx, venue.ID, proposal, 1.5), apperr.ErrInvalidArgument)
+		assert.ErrorIs(t, repo.MarkNeedsReview(ctx, venue.ID, &entity.VenuePlace{Name: "No ID"}, 0.2), apperr.ErrInvalidArgument)
+		_, err := repo.ListNeedsReview(ctx, 0, 0)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_ListByEnrichmentStatus(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	// UUIDv7 IDs generated in sequence, so creation order is ID order.
+	newVenue := func(name string, placeID *string) *entity.Venue {
+		v := &entity.Venue{ID: newTestID(t), Name: name, GooglePlaceID: placeID, ListedVenueName: new(name)}
+		require.NoError(t, repo.Create(ctx, v))
+		return v
+	}
+	pending1 := newVenue("Pending One", nil)
+	enriched := newVenue("Budokan", new("ChIJbudokan"))
+	pending2 := newVenue("Pending Two", nil)
+	failed := newVenue("Failed One", nil)
+	require.NoError(t, repo.MarkFailed(ctx, failed.ID))
+	pending3 := newVenue("Pending Three", nil)
+	enrichedLater := newVenue("zepp haneda", nil)
+	require.NoError(t, repo.UpdateEnriched(ctx, enrichedLater.ID, &entity.VenuePlace{ExternalID: "ChIJzepp", Name: "Zepp Haneda"}))
+
+	ids := func(venues []*entity.Venue) []string {
+		out := make([]string, 0, len(venues))
+		for _, v := range venues {
+			out = append(out, v.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		status  entity.EnrichmentStatus
+		limit   int
+		offset  int
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "pending venues in creation order",
+			status: entity.EnrichmentStatusPending,
+			limit:  10,
+			want:   []string{pending1.ID, pending2.ID, pending3.ID},
+		},
+		{
+			name:   "venues created with or resolved to a place are enriched",
+			status: entity.EnrichmentStatusEnriched,
+			limit:  10,
+			want:   []string{enriched.ID, enrichedLater.ID},
+		},
+		{
+			name:   "failed venues",
+			status: entity.EnrichmentStatusFailed,
+			limit:  10,
+			want:   []string{failed.ID},
+		},
+		{
+			name:   "first page",
+			status: entity.EnrichmentStatusPending,
+			limit:  2,
+			want:   []string{pending1.ID, pending2.ID},
+		},
+		{
+			name:   "second page",
+			status: entity.EnrichmentStatusPending,
+			limit:  2,
+			offset: 2,
+			want:   []string{pending3.ID},
+		},
+		{
+			name:   "offset past the end returns no venues",
+			status: entity.EnrichmentStatusPending,
+			limit:  2,
+			offset: 3,
+			want:   []string{},
+		},
+		{
+			name:    "unknown status returns InvalidArgument",
+			status:  "archived",
+			limit:   10,
+			wantErr: apperr.ErrInvalidArgument,
+		},
+		{
+			name:    "non-positive limit returns InvalidArgument",
+			status:  entity.EnrichmentStatusPending,
+			wantErr: apperr.ErrInvalidArgument,
+		},
+		{
+			name:    "negative offset returns InvalidArgument",
+			status:  entity.EnrichmentStatusPending,
+			limit:   10,
+			offset:  -1,
+			wantErr: apperr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.ListByEnrichmentStatus(ctx, tt.status, tt.limit, tt.offset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(got))
+		})
+	}
+
+	t.Run("returns the stored venue fields", func(t *testing.T) {
+		got, err := repo.ListByEnrichmentStatus(ctx, entity.EnrichmentStatusEnriched, 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []*entity.Venue{enriched}, got)
+	})
+}
+
+func TestVenueRepository_ListEnrichmentRetryCandidates(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	now := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	const (
+		baseBackoff = time.Hour
+		maxAttempts = 5
+	)
+
+	// failedVenue stores a failed venue with the given attempt count and last
+	// attempt time; a zero ago leaves the attempt time NULL.
+	failedVenue := func(name string, attempts int, ago time.Duration) *entity.Venue {
+		t.Helper()
+		v := &entity.Venue{ID: newTestID(t), Name: name, ListedVenueName: new(name)}
+		require.NoError(t, repo.Create(ctx, v))
+		var last *time.Time
+		if ago != 0 {
+			last = new(now.Add(-ago))
+		}
+		_, err := testDB.Pool.Exec(ctx,
+			`UPDATE venues SET enrichment_status = 'failed', enrichment_attempts = $2, last_enrichment_attempt_at = $3 WHERE id = $1`,
+			v.ID, attempts, last)
+		require.NoError(t, err)
+		return v
+	}
+
+	// The backoff is 1h after the first attempt, 2h after the second, 4h
+	// after the third, and so on.
+	firstDue := failedVenue("first attempt, backoff elapsed", 1, 2*time.Hour)
+	failedVenue("first attempt, backing off", 1, 30*time.Minute)
+	secondAtBoundary := failedVenue("second attempt, backoff just elapsed", 2, 2*time.Hour)
+	thirdDue := failedVenue("third attempt, backoff elapsed", 3, 5*time.Hour)
+	failedVenue("third attempt, backing off", 3, 3*time.Hour)
+	failedVenue("attempts exhausted", maxAttempts, 1000*time.Hour)
+	untimed := failedVenue("failed before attempts were timed", 1, 0)
+	pending := &entity.Venue{ID: newTestID(t), Name: "pending", ListedVenueName: new("pending")}
+	require.NoError(t, repo.Create(ctx, pending))
+
+	ids := func(venues []*entity.Venue) []string {
+		out := make([]string, 0, len(venues))
+		for _, v := range venues {
+			out = append(out, v.ID)
+		}
+		return out
+	}
+
+	t.Run("returns the due failed venues in creation order", func(t *testing.T) {
+		got, err := repo.ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{firstDue.ID, secondAtBoundary.ID, thirdDue.ID, untimed.ID}, ids(got))
+	})
+
+	t.Run("limit bounds the batch", func(t *testing.T) {
+		got, err := repo.ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{firstDue.ID, secondAtBoundary.ID}, ids(got))
+	})
+
+	t.Run("a lower attempt cap drops venues that reached it", func(t *testing.T) {
+		got, err := repo.ListEnrichmentRetryCandidates(ctx, now, baseBackoff, 2, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{firstDue.ID, untimed.ID}, ids(got))
+	})
+
+	t.Run("zero backoff makes every failed venue under the cap due", func(t *testing.T) {
+		got, err := repo.ListEnrichmentRetryCandidates(ctx, now, 0, maxAttempts, 10)
+		require.NoError(t, err)
+		assert.Len(t, got, 6)
+	})
+
+	t.Run("invalid arguments return InvalidArgument", func(t *testing.T) {
+		_, err := repo.ListEnrichmentRetryCandidates(ctx, now, -time.Second, maxAttempts, 10)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		_, err = repo.ListEnrichmentRetryCandidates(ctx, now, baseBackoff, 0, 10)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		_, err = repo.ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 0)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestVenueRepository_UpsertByNames(t *testing.T) {
+	cleanDatabase(t)
+	repo := rdb.NewVenueRepository(testDB)
+	ctx := context.Background()
+
+	existing := &entity.Venue{
+		ID:        "018b2f19-e591-7d12-bf9e-f0e74f1b49f1",
+		Name:      "Zepp Haneda",
+		AdminArea: new("JP-13"),
+	}
+	require.NoError(t, repo.Create(ctx, existing))
+	placed := &entity.Venue{
+		ID:            "018b2f19-e591-7d12-bf9e-f0e74f1b49e1",
+		Name:          "Nippon Budokan",
+		AdminArea:     new("JP-13"),
+		GooglePlaceID: new("place-budokan"),
+	}
+	require.NoError(t, repo.Create(ctx, placed))
+
+	t.Run("inserts new venues and returns existing ones keyed by requested name", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, []*entity.Venue{
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f2", Name: " zepp haneda ", AdminArea: new("JP-13")},
+			{
+				ID:          "018b2f19-e591-7d12-bf9e-f0e74f1b49f3",
+				Name:        "Billboard Live Osaka",
+				AdminArea:   new("JP-27"),
+				Coordinates: &entity.Coordinates{Latitude: 34.7009, Longitude: 135.4955},
+			},
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f4", Name: "billboard live osaka", AdminArea: new("JP-27")},
+			nil,
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+
+		// Normalized match on an existing row returns the stored venue unchanged.
+		assert.Equal(t, existing.ID, got[" zepp haneda "].ID)
+		assert.Equal(t, "Zepp Haneda", got[" zepp haneda "].Name)
+
+		// Duplicate names in one call insert only the first occurrence.
+		inserted := got["Billboard Live Osaka"]
+		require.NotNil(t, inserted)
+		assert.Equal(t, "018b2f19-e591-7d12-bf9e-f0e74f1b49f3", inserted.ID)
+		require.NotNil(t, inserted.Coordinates)
+		assert.InDelta(t, 34.7009, inserted.Coordinates.Latitude, 1e-9)
+		assert.Equal(t, inserted.ID, got["billboard live osaka"].ID)
+
+		_, err = repo.Get(ctx, "018b2f19-e591-7d12-bf9e-f0e74f1b49f2")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		_, err = repo.Get(ctx, "018b2f19-e591-7d12-bf9e-f0e74f1b49f4")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("is idempotent on repeat calls", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, []*entity.Venue{
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f5", Name: "Billboard Live Osaka", AdminArea: new("JP-27")},
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "018b2f19-e591-7d12-bf9e-f0e74f1b49f3", got["Billboard Live Osaka"].ID)
+	})
+
+	t.Run("same name in another admin area is a new venue", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, []*entity.Venue{
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f7", Name: "Zepp Haneda", AdminArea: new("JP-27")},
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "018b2f19-e591-7d12-bf9e-f0e74f1b49f7", got["Zepp Haneda"].ID)
+		assert.Equal(t, new("JP-27"), got["Zepp Haneda"].AdminArea)
+
+		stored, err := repo.Get(ctx, existing.ID)
+		require.NoError(t, err)
+		assert.Equal(t, new("JP-13"), stored.AdminArea, "the existing venue is untouched")
+	})
+
+	t.Run("place ID conflict resolves to the venue holding the place ID", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, []*entity.Venue{
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f8", Name: "Budokan", AdminArea: new("JP-13"), GooglePlaceID: new("place-budokan")},
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f9", Name: "Tokyo Garden Theater", AdminArea: new("JP-13"), GooglePlaceID: new("place-garden")},
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49fa", Name: "Garden Theater", AdminArea: new("JP-13"), GooglePlaceID: new("place-garden")},
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+
+		// An existing venue already holds the place ID.
+		assert.Equal(t, placed.ID, got["Budokan"].ID)
+		// Another venue in the same call took the place ID first.
+		assert.Equal(t, "018b2f19-e591-7d12-bf9e-f0e74f1b49f9", got["Tokyo Garden Theater"].ID)
+		assert.Equal(t, "018b2f19-e591-7d12-bf9e-f0e74f1b49f9", got["Garden Theater"].ID)
+
+		_, err = repo.Get(ctx, "018b2f19-e591-7d12-bf9e-f0e74f1b49f8")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		_, err = repo.Get(ctx, "018b2f19-e591-7d12-bf9e-f0e74f1b49fa")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty input returns an empty map", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("empty name is rejected", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, []*entity.Venue{
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49f6", Name: "  "},
+		})
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Nil(t, got)
+	})
+
+	t.Run("one name in two admin areas is rejected", func(t *testing.T) {
+		got, err := repo.UpsertByNames(ctx, []*entity.Venue{
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49fb", Name: "Zepp Namba", AdminArea: new("JP-27")},
+			{ID: "018b2f19-e591-7d12-bf9e-f0e74f1b49fc", Name: "Zepp Namba"},
+		})
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Nil(t, got)
+	})
+}
+
+func TestVenueRepository_MergeVenues(t *testing.T) {
+	ctx := context.Background()
+	repo := rdb.NewVenueRepository(testDB)
+	concertRepo := rdb.NewConcertRepository(testDB)
+	artistRepo := rdb.NewArtistRepository(testDB)
+	seriesRepo := rdb.NewSeriesRepository(testDB)
+
+	type fixture struct {
+		canonicalID, duplicateID     string
+		keptID, collidingID, movedID string
+		canonicalArtist, dupArtist   string
+	}
+
+	// seed gives the canonical venue one event and the duplicate venue two:
+	// one on the same (date, start) as the canonical event (a collision) and
+	// one on another date (re-pointed).
+	seed := func(t *testing.T) fixture {
+		t.Helper()
+		cleanDatabase(t)
+
+		f := fixture{
+			canonicalID: newTestID(t), duplicateID: newTestID(t),
+			keptID: newTestID(t), collidingID: newTestID(t), movedID: newTestID(t),
+			canonicalArtist: newTestID(t), dupArtist: newTestID(t),
+		}
+		require.NoError(t, repo.Create(ctx, &entity.Venue{ID: f.canonicalID, Name: "Zepp DiverCity"}))
+		require.NoError(t, repo.Create(ctx, &entity.Venue{ID: f.duplicateID, Name: "Zepp DiverCity (TOKYO)"}))
+		_, err := artistRepo.Create(ctx,
+			&entity.Artist{ID: f.canonicalArtist, Name: "Merge Band A", MBID: newTestID(t)},
+			&entity.Artist{ID: f.dupArtist, Name: "Merge Band B", MBID: newTestID(t)},
+		)
+		require.NoError(t, err)
+
+		date, _ := time.Parse("2006-01-02", "2026-11-01")
+		seriesID := seedSeries(t, ctx, seriesRepo, "Merge Tour")
+		concert := func(id, venueID, artistID string, d time.Time) *entity.Concert {
+			return &entity.Concert{
+				Event:      entity.Event{ID: id, VenueID: venueID, SeriesID: seriesID, LocalDate: d},
+				Series:     &entity.Series{ID: seriesID},
+				Performers: []*entity.Artist{{ID: artistID}},
+			}
+		}
+		requireCreate(t, ctx, concertRepo,
+			concert(f.keptID, f.canonicalID, f.canonicalArtist, date),
+			concert(f.collidingID, f.duplicateID, f.dupArtist, date),
+			concert(f.movedID, f.duplicateID, f.dupArtist, date.AddDate(0, 0, 1)),
+		)
+		return f
+	}
+
+	deletedAt := func(t *testing.T, eventID string) *time.Time {
+		t.Helper()
+		var at *time.Time
+		require.NoError(t, testDB.Pool.QueryRow(ctx, `SELECT deleted_at FROM events WHERE id = $1`, eventID).Scan(&at))
+		return at
+	}
+
+	t.Run("dry run reports the counts an apply then produces", func(t *testing.T) {
+		f := seed(t)
+
+		preview, err := repo.MergeVenues(ctx, f.canonicalID, f.duplicateID, true)
+		require.NoError(t, err)
+		assert.Equal(t, &entity.MergeReport{
+			CanonicalID: f.canonicalID, DuplicateID: f.duplicateID,
+			RepointedConcerts: 1, DeletedConcerts: 1, DeletedConcertIDs: []string{f.collidingID},
+			RetainedConcerts: 1, DryRun: true,
+		}, preview)
+
+		// Nothing changed.
+		_, err = repo.Get(ctx, f.duplicateID)
+		require.NoError(t, err)
+		assert.Nil(t, deletedAt(t, f.collidingID))
+		got, err := concertRepo.ListByIDs(ctx, []string{f.keptID, f.collidingID, f.movedID})
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		for _, c := range got {
+			if c.ID != f.keptID {
+				assert.Equal(t, f.duplicateID, c.VenueID)
+			}
+		}
+
+		applied, err := repo.MergeVenues(ctx, f.canonicalID, f.duplicateID, false)
+		require.NoError(t, err)
+		assert.False(t, applied.DryRun)
+		assert.Equal(t, preview.RepointedConcerts, applied.RepointedConcerts)
+		assert.Equal(t, preview.DeletedConcertIDs, applied.DeletedConcertIDs)
+		assert.Equal(t, preview.RetainedConcerts, applied.RetainedConcerts)
+
+		assert.NotNil(t, deletedAt(t, f.collidingID), "the colliding duplicate event is soft-deleted")
+		duplicates, err := repo.ListByEnrichmentStatus(ctx, entity.EnrichmentStatusDuplicate, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, duplicates, 1, "the duplicate venue keeps its soft-deleted event and is flagged")
+		assert.Equal(t, f.duplicateID, duplicates[0].ID)
+
+		got, err = concertRepo.ListByIDs(ctx, []string{f.keptID, f.movedID})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		byID := make(map[string]*entity.Concert, len(got))
+		for _, c := range got {
+			byID[c.ID] = c
+		}
+		require.Contains(t, byID, f.movedID)
+		assert.Equal(t, f.canonicalID, byID[f.movedID].VenueID)
+		require.Contains(t, byID, f.keptID)
+		assert.ElementsMatch(t, []string{f.canonicalArtist, f.dupArtist}, byID[f.keptID].PerformerIDs(),
+			"the soft-deleted event's performers move to the surviving event")
+	})
+
+	t.Run("removes the duplicate venue when every event moves", func(t *testing.T) {
+		f := seed(t)
+		_, err := testDB.Pool.Exec(ctx, `DELETE FROM events WHERE id = $1`, f.collidingID)
+		require.NoError(t, err)
+
+		report, err := repo.MergeVenues(ctx, f.canonicalID, f.duplicateID, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.RepointedConcerts)
+		assert.Zero(t, report.DeletedConcerts)
+		assert.Zero(t, report.RetainedConcerts)
+
+		_, err = repo.Get(ctx, f.duplicateID)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		assert.Nil(t, deletedAt(t, f.movedID))
+	})
+
+	t.Run("refuses when a colliding event has used nullifiers", func(t *testing.T) {
+		f := seed(t)
+		_, err := testDB.Pool.Exec(ctx,
+			`INSERT INTO nullifiers (event_id, nullifier_hash) VALUES ($1, $2)`, f.collidingID, make([]byte, 32))
+		require.NoError(t, err)
+
+		_, err = repo.MergeVenues(ctx, f.canonicalID, f.duplicateID, false)
+
+		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+		assert.Nil(t, deletedAt(t, f.collidingID))
+		got, err := concertRepo.ListByIDs(ctx, []string{f.movedID})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, f.duplicateID, got[0].VenueID, "nothing is re-pointed")
+	})
+
+	t.Run("rejects merging a venue into itself", func(t *testing.T) {
+		f := seed(t)
+
+		_, err := repo.MergeVenues(ctx, f.canonicalID, f.canonicalID, false)
+
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		_, err = repo.Get(ctx, f.canonicalID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns NotFound when a venue does not exist", func(t *testing.T) {
+		f := seed(t)
+
+		_, err := repo.MergeVenues(ctx, f.canonicalID, newTestID(t), true)
+
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+}
@@ -14,6 +14,12 @@ import (
 
 // toAppErr maps various infrastructure-level errors to domain-specific apperr.Error.
 // It handles Context cancellations, Gemini API specifics, and JSON unmarshaling failures.
+//
+// A timeout is classified by the side that gave up. Our own deadline (the
+// per-call budget or the caller's) expiring is DeadlineExceeded: the request
+// was still running and the remedy is a longer budget or a smaller request.
+// A 504 from Gemini is Unavailable: the service failed to answer in its own
+// time, and the remedy is to back off and retry later.
 func toAppErr(err error, msg string, attrs ...slog.Attr) error {
 	if err == nil {
 		return nil
@@ -39,10 +45,8 @@ func toAppErr(err error, msg string, attrs ...slog.Attr) error {
 			code = codes.NotFound
 		case http.StatusTooManyRequests:
 			code = codes.ResourceExhausted
-		case http.StatusServiceUnavailable:
+		case http.StatusServiceUnavailable, http.StatusGatewayTimeout:
 			code = codes.Unavailable
-		case http.StatusGatewayTimeout:
-			code = codes.DeadlineExceeded
 		case 499: // Client Closed Request (Nginx-origin; Gemini uses it for server-side cancellation)
 			code = codes.Canceled
 		default:
@@ -74,11 +78,14 @@ func toAppErr(err error, msg string, attrs ...slog.Attr) error {
 //   - 500 (Internal Server Error): Transient server-side failure.
 //   - 502 (Bad Gateway): Upstream proxy failure.
 //   - 503 (Service Unavailable): Server temporarily overloaded.
+//   - 499 (Client Cancelled): Gemini cancelled the operation server-side,
+//     typically while shedding load; the same request succeeds on retry.
 //   - 504 (Gateway Timeout): Deadline exceeded. Retryable because each attempt
 //     uses an independent context with a fresh 120s timeout.
 //
 // NOT retryable:
-//   - 499 (Client Cancelled): Gemini cancelled the operation server-side.
+//   - 400 (Bad Request) / 404 (Not Found): the request itself is wrong;
+//     repeating it only wastes attempts.
 //   - Context errors (DeadlineExceeded, Canceled): caller's own deadline expired.
 func isRetryable(err error) bool {
 	apiErr, ok := errors.AsType[genai.APIError](err)
@@ -92,7 +99,8 @@ func isRetryable(err error) bool {
 		http.StatusInternalServerError,
 		http.StatusBadGateway,
 		http.StatusServiceUnavailable,
-		http.StatusGatewayTimeout:
+		http.StatusGatewayTimeout,
+		499: // Client Closed Request (Gemini server-side cancellation)
 		return true
 	default:
 		return false
@@ -3,6 +3,8 @@ package gemini
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/pannpers/go-apperr/apperr"
@@ -31,3 +33,48 @@ func TestToAppErr_ContextCanceled(t *testing.T) {
 	require.True(t, ok, "expected AppErr, got %T", err)
 	assert.Equal(t, codes.Canceled, appErr.Code)
 }
+
+func TestToAppErr_TimeoutSide(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "our own deadline is DeadlineExceeded",
+			err:      fmt.Errorf("models.generateContent: %w", context.DeadlineExceeded),
+			wantCode: codes.DeadlineExceeded,
+		},
+		{
+			name:     "a Gemini 504 is Unavailable",
+			err:      genai.APIError{Code: http.StatusGatewayTimeout, Message: "Deadline expired before operation could complete."},
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := toAppErr(tt.err, "failed to call Gemini API")
+
+			appErr, ok := errors.AsType[*apperr.AppErr](err)
+			require.True(t, ok, "expected AppErr, got %T", err)
+			assert.Equal(t, tt.wantCode, appErr.Code)
+		})
+	}
+
+	t.Run("the two timeouts classify differently", func(t *testing.T) {
+		t.Parallel()
+
+		client := toAppErr(context.DeadlineExceeded, "failed to call Gemini API")
+		server := toAppErr(genai.APIError{Code: http.StatusGatewayTimeout}, "failed to call Gemini API")
+
+		assert.ErrorIs(t, client, apperr.ErrDeadlineExceeded)
+		assert.NotErrorIs(t, client, apperr.ErrUnavailable)
+		assert.ErrorIs(t, server, apperr.ErrUnavailable)
+		assert.NotErrorIs(t, server, apperr.ErrDeadlineExceeded)
+	})
+}
@@ -53,15 +53,20 @@ func TestIsRetryable(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "499 Client Cancelled is not retryable (Gemini server-side cancel)",
+			name: "499 Client Cancelled is retryable (Gemini server-side cancel)",
 			err:  genai.APIError{Code: 499, Message: "cancelled"},
-			want: false,
+			want: true,
 		},
 		{
 			name: "400 Bad Request is not retryable",
 			err:  genai.APIError{Code: http.StatusBadRequest, Message: "bad request"},
 			want: false,
 		},
+		{
+			name: "404 Not Found is not retryable",
+			err:  genai.APIError{Code: http.StatusNotFound, Message: "not found"},
+			want: false,
+		},
 		{
 			name: "context.DeadlineExceeded is not retryable",
 			err:  context.DeadlineExceeded,
@@ -1,10 +1,31 @@
 package gemini
 
+import (
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+)
+
 // IsRetryable exports isRetryable for testing.
 var IsRetryable = isRetryable
 
 // ErrInvalidJSON exports errInvalidJSON for testing.
 var ErrInvalidJSON = errInvalidJSON
 
+// ErrTooManyEvents exports errTooManyEvents for testing.
+var ErrTooManyEvents = errTooManyEvents
+
 // ParseStep1Envelope exports parseStep1Envelope for testing.
 var ParseStep1Envelope = parseStep1Envelope
+
+// Step1TourPrompt renders the Step 1 tour-slice prompt the searcher sends
+// for the given artist and official sites.
+func Step1TourPrompt(from, to, artistName string, sites []*entity.OfficialSite) string {
+	return step1Prompt(promptTemplateStep1Tour, from, to, artistName, officialSiteURLs(sites))
+}
+
+// SetBackoffObserver registers fn to receive every wait computed between
+// Gemini call attempts.
+func SetBackoffObserver(s *ConcertSearcher, fn func(time.Duration)) {
+	s.onBackoff = fn
+}
@@ -0,0 +1,199 @@
+package gemini
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+const (
+	// defaultMaxRetries keeps the historical budget of 3 attempts per call.
+	defaultMaxRetries = 2
+	// defaultBaseBackoff is the ceiling of the first wait between attempts.
+	defaultBaseBackoff = 1 * time.Second
+	// defaultJitterFactor applies full jitter: every wait is drawn
+	// uniformly from [0, ceiling).
+	defaultJitterFactor = 1.0
+	// maxBackoff caps the exponential ceiling regardless of BaseBackoff.
+	maxBackoff = 60 * time.Second
+	// maxRetryAfter bounds a server-requested wait, so a bogus Retry-After
+	// cannot park a search longer than the backoff schedule itself would.
+	maxRetryAfter = maxBackoff
+)
+
+// RetryPolicy configures how executePass retries a single Gemini call.
+// Zero values fall back to the package defaults, so an empty RetryPolicy
+// reproduces the historical 3-attempt budget with 1s base backoff and
+// full jitter.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// BaseBackoff is the ceiling of the first wait. The ceiling doubles
+	// on every retry and is capped at 60s.
+	BaseBackoff time.Duration
+	// JitterFactor is the randomized fraction of each wait, in (0, 1].
+	// 1.0 is full jitter (wait ∈ [0, ceiling)); 0.5 draws from
+	// [ceiling/2, ceiling). Values outside (0, 1] fall back to 1.0.
+	JitterFactor float64
+}
+
+func (p RetryPolicy) maxAttempts() uint {
+	if p.MaxRetries <= 0 {
+		return defaultMaxRetries + 1
+	}
+	return uint(p.MaxRetries) + 1
+}
+
+func (p RetryPolicy) baseBackoff() time.Duration {
+	if p.BaseBackoff <= 0 {
+		return defaultBaseBackoff
+	}
+	return p.BaseBackoff
+}
+
+func (p RetryPolicy) jitterFactor() float64 {
+	if p.JitterFactor <= 0 || p.JitterFactor > 1 {
+		return defaultJitterFactor
+	}
+	return p.JitterFactor
+}
+
+// jitterBackOff is an exponential backoff with jitter. Concurrent Step 1
+// slices fail together when Gemini is overloaded; randomizing the wait
+// spreads their retries instead of firing them in lockstep.
+type jitterBackOff struct {
+	base    time.Duration
+	jitter  float64
+	attempt int
+	// randFloat returns a value in [0, 1). Swapped in tests.
+	randFloat func() float64
+	// observe, when non-nil, receives every computed wait. Test hook.
+	observe func(time.Duration)
+	// retryAfter, when positive, replaces the next computed wait.
+	retryAfter time.Duration
+}
+
+var _ backoff.BackOff = (*jitterBackOff)(nil)
+
+func newJitterBackOff(p RetryPolicy, observe func(time.Duration)) *jitterBackOff {
+	return &jitterBackOff{
+		base:      p.baseBackoff(),
+		jitter:    p.jitterFactor(),
+		randFloat: rand.Float64,
+		observe:   observe,
+	}
+}
+
+// NextBackOff returns the wait before the next attempt. The retry budget
+// is enforced by backoff.WithMaxTries, so this never returns backoff.Stop.
+func (b *jitterBackOff) NextBackOff() time.Duration {
+	ceiling := b.base
+	for i := 0; i < b.attempt && ceiling < maxBackoff; i++ {
+		ceiling *= 2
+	}
+	ceiling = min(ceiling, maxBackoff)
+	b.attempt++
+
+	fixed := time.Duration(float64(ceiling) * (1 - b.jitter))
+	wait := fixed + time.Duration(b.randFloat()*float64(ceiling-fixed))
+	if b.retryAfter > 0 {
+		wait, b.retryAfter = b.retryAfter, 0
+	}
+	if b.observe != nil {
+		b.observe(wait)
+	}
+	return wait
+}
+
+// Reset restarts the exponential sequence.
+func (b *jitterBackOff) Reset() {
+	b.attempt = 0
+}
+
+// useRetryAfter makes the next wait d instead of the jittered schedule. The
+// exponential sequence still advances, so attempts without a hint keep
+// backing off from where they were.
+func (b *jitterBackOff) useRetryAfter(d time.Duration) {
+	b.retryAfter = d
+}
+
+// retryAfterHint carries the Retry-After of one Gemini call from the HTTP
+// transport back to executePass. genai.APIError drops the response headers,
+// so the transport records the hint into a slot carried by the request
+// context instead.
+type retryAfterHint struct {
+	wait time.Duration
+}
+
+type retryAfterHintKey struct{}
+
+// withRetryAfterHint returns a child context carrying a fresh hint slot.
+func withRetryAfterHint(ctx context.Context) (context.Context, *retryAfterHint) {
+	h := &retryAfterHint{}
+	return context.WithValue(ctx, retryAfterHintKey{}, h), h
+}
+
+// retryAfterTransport records the Retry-After header of 429 and 503
+// responses into the request's retryAfterHint, when there is one.
+type retryAfterTransport struct {
+	next http.RoundTripper
+}
+
+var _ http.RoundTripper = (*retryAfterTransport)(nil)
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if h, ok := req.Context().Value(retryAfterHintKey{}).(*retryAfterHint); ok {
+			h.wait = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
+	}
+	return resp, nil
+}
+
+// withRetryAfterTransport returns a shallow copy of c whose transport records
+// Retry-After hints. A nil c yields a client over http.DefaultTransport.
+func withRetryAfterTransport(c *http.Client) *http.Client {
+	wrapped := &http.Client{}
+	if c != nil {
+		*wrapped = *c
+	}
+	wrapped.Transport = &retryAfterTransport{next: wrapped.Transport}
+	return wrapped
+}
+
+// parseRetryAfter converts a Retry-After value, either delay-seconds or an
+// HTTP-date, into a wait capped at maxRetryAfter. Missing, malformed, or
+// elapsed values yield 0, leaving the jittered schedule in charge.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		if secs >= int64(maxRetryAfter/time.Second) {
+			return maxRetryAfter
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return min(max(at.Sub(now), 0), maxRetryAfter)
+	}
+	return 0
+}
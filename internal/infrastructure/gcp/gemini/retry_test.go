@@ -3,11 +3,15 @@ package gemini_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"testing/synctest"
 	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
@@ -26,7 +30,7 @@ func TestSearch_RetryOnTransientError(t *testing.T) {
 	ctx := context.Background()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	successBody := `{
 		"tours": [],
@@ -75,7 +79,7 @@ func TestSearch_RetryOnTransientError(t *testing.T) {
 	}, httpClient, logger)
 	require.NoError(t, err)
 
-	got, err := s.Search(ctx, artist, officialSite, from)
+	got, err := s.Search(ctx, artist, officialSites, from)
 
 	assert.NoError(t, err)
 	require.Len(t, got, 1)
@@ -94,7 +98,7 @@ func TestSearch_AllRetriesExhausted(t *testing.T) {
 	ctx := context.Background()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	var callCount atomic.Int32
 
@@ -113,7 +117,7 @@ func TestSearch_AllRetriesExhausted(t *testing.T) {
 	}, httpClient, logger)
 	require.NoError(t, err)
 
-	got, err := s.Search(ctx, artist, officialSite, from)
+	got, err := s.Search(ctx, artist, officialSites, from)
 
 	// Graceful-degradation semantics (post-review-2): transient exhaustion
 	// on every Step 1 slice surfaces as an empty result, NOT an error.
@@ -138,7 +142,7 @@ func TestSearch_NonRetryableErrorStopsImmediately(t *testing.T) {
 	ctx := context.Background()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	var callCount atomic.Int32
 
@@ -157,7 +161,7 @@ func TestSearch_NonRetryableErrorStopsImmediately(t *testing.T) {
 	}, httpClient, logger)
 	require.NoError(t, err)
 
-	got, err := s.Search(ctx, artist, officialSite, from)
+	got, err := s.Search(ctx, artist, officialSites, from)
 
 	assert.Nil(t, got)
 	assert.Error(t, err)
@@ -174,7 +178,7 @@ func TestSearch_ContextCancellationStopsRetry(t *testing.T) {
 	logger, _ := logging.New()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	var callCount atomic.Int32
 
@@ -197,7 +201,7 @@ func TestSearch_ContextCancellationStopsRetry(t *testing.T) {
 	}, httpClient, logger)
 	require.NoError(t, err)
 
-	got, err := s.Search(ctx, artist, officialSite, from)
+	got, err := s.Search(ctx, artist, officialSites, from)
 
 	assert.Nil(t, got)
 	assert.Error(t, err)
@@ -205,3 +209,139 @@ func TestSearch_ContextCancellationStopsRetry(t *testing.T) {
 	// Context cancellation during backoff stops some retries.
 	assert.Less(t, callCount.Load(), int32(9), "should not exhaust all retries when context is cancelled")
 }
+
+// roundTripFunc adapts a function to http.RoundTripper so tests inside a
+// synctest bubble can answer Gemini calls without a real listener.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestConcertSearcher_Search_RetryAfter checks that a Retry-After on a 429 or
+// 503 replaces the jittered wait before the next attempt, bounded at 60s, and
+// that responses without one keep the configured schedule. Each Step 1 slice
+// fails once and then succeeds.
+func TestConcertSearcher_Search_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	const baseBackoff = time.Millisecond
+
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter func(now time.Time) string
+		wantWait   time.Duration // 0 means the jittered schedule applies
+	}{
+		{
+			name:       "429 with delay-seconds waits the indicated duration",
+			status:     http.StatusTooManyRequests,
+			retryAfter: func(time.Time) string { return "7" },
+			wantWait:   7 * time.Second,
+		},
+		{
+			name:       "503 with an HTTP-date waits until that time",
+			status:     http.StatusServiceUnavailable,
+			retryAfter: func(now time.Time) string { return now.Add(30 * time.Second).UTC().Format(http.TimeFormat) },
+			wantWait:   30 * time.Second,
+		},
+		{
+			name:       "Retry-After beyond the cap is bounded",
+			status:     http.StatusTooManyRequests,
+			retryAfter: func(time.Time) string { return "3600" },
+			wantWait:   60 * time.Second,
+		},
+		{
+			name:       "missing Retry-After keeps the jittered schedule",
+			status:     http.StatusTooManyRequests,
+			retryAfter: func(time.Time) string { return "" },
+		},
+		{
+			name:       "malformed Retry-After keeps the jittered schedule",
+			status:     http.StatusServiceUnavailable,
+			retryAfter: func(time.Time) string { return "soon" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			synctest.Test(t, func(t *testing.T) {
+				logger, _ := logging.New()
+				ctx := context.Background()
+				from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+				artist := &entity.Artist{Name: "Test Artist"}
+				officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
+
+				var (
+					mu       sync.Mutex
+					attempts = map[string][]time.Time{}
+					waits    []time.Duration
+				)
+				transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					body, _ := io.ReadAll(req.Body)
+					now := time.Now()
+					mu.Lock()
+					attempts[string(body)] = append(attempts[string(body)], now)
+					n := len(attempts[string(body)])
+					mu.Unlock()
+
+					resp := &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Content-Type": []string{"application/json"}},
+						Request:    req,
+					}
+					if n == 1 {
+						resp.StatusCode = tt.status
+						if v := tt.retryAfter(now); v != "" {
+							resp.Header.Set("Retry-After", v)
+						}
+						resp.Body = io.NopCloser(strings.NewReader(fmt.Sprintf(`{"error":{"code":%d,"message":"injected failure"}}`, tt.status)))
+						return resp, nil
+					}
+					// An envelope without events ends Search after Step 1.
+					resp.Body = io.NopCloser(strings.NewReader(geminiResponse("<extracted></extracted>", "STOP")))
+					return resp, nil
+				})
+
+				s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+					APIKey:       "test",
+					ModelExtract: "gemini-pro",
+					ModelParse:   "gemini-pro",
+					Retry: gemini.RetryPolicy{
+						MaxRetries:   2,
+						BaseBackoff:  baseBackoff,
+						JitterFactor: 1.0,
+					},
+				}, &http.Client{Transport: transport}, logger)
+				require.NoError(t, err)
+				gemini.SetBackoffObserver(s, func(d time.Duration) {
+					mu.Lock()
+					waits = append(waits, d)
+					mu.Unlock()
+				})
+
+				_, err = s.Search(ctx, artist, officialSites, from)
+				require.NoError(t, err)
+
+				mu.Lock()
+				defer mu.Unlock()
+				require.Len(t, attempts, 3, "one distinct request body per slice")
+				require.Len(t, waits, 3, "each slice waits once")
+				for _, d := range waits {
+					if tt.wantWait > 0 {
+						assert.Equal(t, tt.wantWait, d)
+					} else {
+						assert.Less(t, d, baseBackoff, "full jitter stays below the first ceiling")
+					}
+				}
+				for body, at := range attempts {
+					require.Len(t, at, 2, "attempts for %q", body)
+					if tt.wantWait > 0 {
+						assert.Equal(t, tt.wantWait, at[1].Sub(at[0]), "gap between attempts")
+					}
+				}
+			})
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/infrastructure/geo"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 	"github.com/pannpers/go-logging/logging"
 	"google.golang.org/genai"
 )
@@ -29,6 +32,12 @@ import (
 // same prompt is unlikely to fix it.
 var errInvalidJSON = errors.New("gemini returned invalid JSON")
 
+// errTooManyEvents is returned by SearchExt when Step 1 extracted more events
+// than Config.MaxEventsPerArtist allows. It is wrapped as FailedPrecondition:
+// the rejection is permanent for the response, so retrying the search only
+// burns quota.
+var errTooManyEvents = errors.New("gemini returned more events than the per-artist limit")
+
 // Config holds the configuration for Gemini searcher.
 //
 // The searcher exclusively targets the Gemini API direct backend
@@ -64,11 +73,46 @@ type Config struct {
 	//   - Parse:   "low" (mechanical transformation; schema bounds output)
 	ThinkingExtract string
 	ThinkingParse   string
+
+	// Retry is applied to every Gemini call (each Step 1 slice and the
+	// Step 2 parse). The zero value keeps 3 attempts with a 1s base and
+	// full jitter.
+	Retry RetryPolicy
+
+	// MaxEventsPerArtist caps how many events one Search may extract for an
+	// artist. A response over the cap is rejected as a likely hallucination
+	// before Step 2 runs. Zero falls back to defaultMaxEventsPerArtist.
+	MaxEventsPerArtist int
+
+	// DiscoverFestivals adds a fourth grounded Step 1 slice that searches
+	// for the artist's festival appearances. Off by default: it is one more
+	// grounded call per Search, roughly a third more Step 1 cost.
+	DiscoverFestivals bool
+
+	// OnTokenUsage, when non-nil, is called once per Search with the token
+	// usage summed over every Step 1 slice and the Step 2 parse. It also
+	// fires when Search fails, since the calls made before the failure are
+	// billed all the same. Each call contributes the usage of its final
+	// attempt. It runs on the Search goroutine and must be safe for
+	// concurrent use when Search is.
+	OnTokenUsage func(ctx context.Context, artistID string, usage entity.TokenUsage)
 }
 
 func (c *Config) modelExtract() string { return c.ModelExtract }
 func (c *Config) modelParse() string   { return c.ModelParse }
 
+// defaultMaxEventsPerArtist sits well above the busiest real schedules (a
+// long arena tour plus festivals in a year is well under 100 dates), so only
+// a runaway response reaches it.
+const defaultMaxEventsPerArtist = 150
+
+func (c *Config) maxEventsPerArtist() int {
+	if c.MaxEventsPerArtist > 0 {
+		return c.MaxEventsPerArtist
+	}
+	return defaultMaxEventsPerArtist
+}
+
 // thinkingExtract / thinkingParse resolve the per-step thinking level
 // with fallback to the legacy ThinkingLevel field.
 func (c *Config) thinkingExtract() string {
@@ -181,6 +225,46 @@ const (
 
 4. 指定期間中の全ての単発公演がMECEで抽出できていることをチェック。
 
+5. 余計なテキストは含めず、XMLのみをレスポンスに含める。
+`
+
+	// systemInstructionStep1Festival is the Step 1 system instruction used
+	// by the festival slice. Same shape as the standalone instruction: one
+	// <event> per <festival>, for each day the artist appears on a lineup.
+	systemInstructionStep1Festival = `あなたはライブ音楽情報システム向けのデータ抽出エージェントです。下記の手順に従って、音楽ファンに提供するための、正確な公式情報を抽出することがゴールです。
+
+1. 対象アーティストの公式サイトから、指定の期間内に開催される音楽フェスへの出演告知ページを探索。複数ある場合も漏れが無いように。
+
+2. 対象アーティストが出演する全ての日程の正確な情報を読み込み、下記の出力フォーマットで指定されたフィールドに値をセット。複数日開催のフェスは、対象アーティストの出演日ごとに1つの <festival> を出力。
+
+<extracted>
+  <festival>
+    <title>ROCK IN JAPAN FESTIVAL 2026</title>
+    <source_url>https://www.uverworld.jp/news/detail/rijf2026</source_url>
+    <event>
+      <venue>千葉市蘇我スポーツ公園</venue>
+      <country>JP</country>
+      <local_date>2026年8月8日(土)</local_date>
+      <open_time></open_time>
+      <start_time>15:30</start_time>
+    </event>
+  </festival>
+  <festival>...</festival>
+</extracted>
+
+抽出ルール:
+- title: フェスの名称。
+- source_url: その出演告知ページ、もしくは最も詳細な情報を記載しているページのURL。
+- country: コンサート開催予定の国コード (ISO 3166-1 alpha-2)。
+- start_time: 対象アーティストの出演時刻 (タイムテーブル)。未発表の場合は空のままにすること。
+- country 以外は必ず、verbatim (一字一句そのまま) でコピーすること。
+- ページに該当する情報が記載されていない場合は、タグを空のままにすること。
+- local_date に年表記が無い場合 (例: "01.16. sat" や "8月7日" のように MM.DD のみ) は、ページ context (フェス名の年表記、ページ見出しの開催年度など) から年を推定し、verbatim な日付の先頭に年を付加して emit する。
+
+3. venue, local_date の2つのフィールドが同じ出演は重複と判定し、除外する。
+
+4. 指定期間中の全てのフェス出演日がMECEで抽出できていることをチェック。
+
 5. 余計なテキストは含めず、XMLのみをレスポンスに含める。
 `
 
@@ -197,7 +281,8 @@ You receive a JSON array of input events with raw venue, country, and date/time
 
 	// promptTemplateStep1Tour carries the per-call variables for a Step 1
 	// tour slice. Placeholders (4): from_date (YYYY-MM-DD), to_date
-	// (YYYY-MM-DD), artist name, official site host.
+	// (YYYY-MM-DD), artist name, primary official site host. step1Prompt
+	// appends the full URL list for artists with several sites.
 	promptTemplateStep1Tour = `開催日が %s から %s に含まれる %s のツアーを全て抽出して。音楽フェスと単発公演は除外して。
 
 公式サイト host: %s
@@ -207,6 +292,13 @@ You receive a JSON array of input events with raw venue, country, and date/time
 	// Step 1 standalone slice. Same 4 placeholders as the tour template.
 	promptTemplateStep1Standalone = `開催日が %s から %s に含まれる %s の単発公演 (ソロ単独ライブ、ファンクラブ限定ライブ、2-4組の named co-headliner との対バン) を全て抽出して。音楽フェスとツアーは除外して。
 
+公式サイト host: %s
+`
+
+	// promptTemplateStep1Festival carries the per-call variables for the
+	// Step 1 festival slice. Same 4 placeholders as the tour template.
+	promptTemplateStep1Festival = `開催日が %s から %s に含まれる、%s が出演する音楽フェス (複数のアーティストが出演するラインナップ形式のイベント) の出演日を全て抽出して。ツアーと単発公演は除外して。
+
 公式サイト host: %s
 `
 
@@ -302,6 +394,9 @@ type EventDraft struct {
 	// identity is adopted from already-persisted member events downstream).
 	// Tour blocks are numbered from 1; standalone drafts carry 0.
 	TourGroup int
+	// IsFestival reports whether this draft originated from a <festival>
+	// block. It selects the FESTIVAL SeriesType downstream.
+	IsFestival bool
 }
 
 // step2InputEvent is the per-event payload sent to Step 2. It is a
@@ -339,6 +434,8 @@ type step1Envelope struct {
 	XMLName     xml.Name          `xml:"extracted"`
 	Tours       []step1Tour       `xml:"tour"`
 	Standalones []step1Standalone `xml:"standalone"`
+	// Festivals share the standalone shape: one <event> per festival day.
+	Festivals []step1Standalone `xml:"festival"`
 }
 
 type step1Tour struct {
@@ -365,7 +462,8 @@ type step1Event struct {
 // envelope into a flat list of EventDraft. <tour> blocks contribute one
 // draft per child <event>, with Title and SourceURL taken from the tour's
 // own <title> / <source_url> children. <standalone> blocks contribute
-// exactly one draft each (a standalone has a single <event> child).
+// exactly one draft each (a standalone has a single <event> child), and
+// <festival> blocks likewise, one per festival day the artist plays.
 //
 // Returns an empty slice (no error) on unparseable input — Step 1 may
 // emit non-XML fallback text (e.g. when the model misbehaves), in which
@@ -413,6 +511,18 @@ func parseStep1Envelope(envelope string) []EventDraft {
 			TourGroup: 0,
 		})
 	}
+	for _, fe := range env.Festivals {
+		drafts = append(drafts, EventDraft{
+			Title:      strings.TrimSpace(fe.Title),
+			SourceURL:  strings.TrimSpace(fe.SourceURL),
+			Venue:      strings.TrimSpace(fe.Event.Venue),
+			Country:    strings.TrimSpace(fe.Event.Country),
+			LocalDate:  strings.TrimSpace(fe.Event.LocalDate),
+			StartTime:  strings.TrimSpace(fe.Event.StartTime),
+			OpenTime:   strings.TrimSpace(fe.Event.OpenTime),
+			IsFestival: true,
+		})
+	}
 	return drafts
 }
 
@@ -421,6 +531,10 @@ type ConcertSearcher struct {
 	client *genai.Client
 	config Config
 	logger *logging.Logger
+
+	// onBackoff, when non-nil, observes every wait between attempts.
+	// Set only from tests via export_test.go.
+	onBackoff func(time.Duration)
 }
 
 // PassMetadata captures observation data for a single Gemini call.
@@ -454,6 +568,15 @@ type PassMetadata struct {
 	// from "infra failed, output was forced to empty" (this flag is true).
 	// Aggregated by OR in aggregatePassMetadata.
 	ExhaustedTransient bool
+
+	// NoCandidates is true when Gemini answered 200 with zero candidates,
+	// which is how a refused or blocked prompt surfaces. The pass yields
+	// empty text like a model that found nothing; this flag tells the two
+	// apart. Aggregated by OR in aggregatePassMetadata.
+	NoCandidates bool
+	// BlockReason is the prompt feedback's block reason accompanying an
+	// empty-candidates response, when Gemini gives one.
+	BlockReason string
 }
 
 // SearchMetadata captures per-call observation data used by the A/B
@@ -465,7 +588,7 @@ type SearchMetadata struct {
 	// calculator and dashboards that expect a single "Step 1 total".
 	Step1Grounded *PassMetadata
 	// Step1Slices — per-slice metadata in slice-definition order (see
-	// defaultStep1Slices). Surfaced for diagnostics so a failing slice
+	// Config.step1Slices). Surfaced for diagnostics so a failing slice
 	// can be identified without re-running.
 	Step1Slices []*PassMetadata
 	// Step2Parse — JSON parse, schema enforced, no tools. Nil when every
@@ -536,7 +659,7 @@ func NewConcertSearcher(ctx context.Context, cfg Config, httpClient *http.Client
 	}
 
 	cc := &genai.ClientConfig{
-		HTTPClient: httpClient,
+		HTTPClient: withRetryAfterTransport(httpClient),
 		Backend:    genai.BackendGeminiAPI,
 		APIKey:     cfg.APIKey,
 	}
@@ -558,10 +681,10 @@ func NewConcertSearcher(ctx context.Context, cfg Config, httpClient *http.Client
 func (s *ConcertSearcher) Search(
 	ctx context.Context,
 	artist *entity.Artist,
-	officialSite *entity.OfficialSite,
+	officialSites []*entity.OfficialSite,
 	from time.Time,
 ) ([]*entity.ScrapedConcert, error) {
-	results, _, err := s.SearchExt(ctx, artist, officialSite, from)
+	results, _, err := s.SearchExt(ctx, artist, officialSites, from)
 	return results, err
 }
 
@@ -580,12 +703,13 @@ func (s *ConcertSearcher) Search(
 func (s *ConcertSearcher) SearchExt(
 	ctx context.Context,
 	artist *entity.Artist,
-	officialSite *entity.OfficialSite,
+	officialSites []*entity.OfficialSite,
 	from time.Time,
 ) ([]*entity.ScrapedConcert, *SearchMetadata, error) {
+	siteURLs := officialSiteURLs(officialSites)
 	var officialSiteURL string
-	if officialSite != nil {
-		officialSiteURL = officialSite.URL
+	if len(siteURLs) > 0 {
+		officialSiteURL = siteURLs[0]
 	}
 
 	attrs := []slog.Attr{
@@ -594,14 +718,20 @@ func (s *ConcertSearcher) SearchExt(
 		slog.String("model_parse", s.config.modelParse()),
 		slog.String("artist", artist.Name),
 		slog.String("official_site", officialSiteURL),
+		slog.Int("official_site_count", len(siteURLs)),
 		slog.String("from", from.Format("2006-01-02")),
 	}
 	s.logger.Info(ctx, "start calling Gemini API to search concerts", attrs...)
 
 	md := &SearchMetadata{}
+	if s.config.OnTokenUsage != nil {
+		defer func() {
+			s.config.OnTokenUsage(ctx, artist.ID, tokenUsageOf(md.Step1Grounded, md.Step2Parse))
+		}()
+	}
 
 	// ===== Step 1: Grounded search + verbatim extract (parallel slices) =====
-	envelope, step1, step1Slices, err := s.runStep1Grounded(ctx, artist, officialSiteURL, attrs)
+	envelope, step1, step1Slices, err := s.runStep1Grounded(ctx, artist, siteURLs, attrs)
 	md.Step1Grounded = step1
 	md.Step1Slices = step1Slices
 	if err != nil {
@@ -619,7 +749,8 @@ func (s *ConcertSearcher) SearchExt(
 		md.DiscoveredURLCount = len(urls)
 	}
 	if envelope == "" {
-		s.logger.Warn(ctx, "step 1 produced empty envelope, returning empty results", attrs...)
+		s.logger.Warn(ctx, "step 1 produced empty envelope, returning empty results",
+			append(attrs, slog.Bool("no_candidates", step1 != nil && step1.NoCandidates))...)
 		return nil, md, nil
 	}
 
@@ -633,6 +764,14 @@ func (s *ConcertSearcher) SearchExt(
 		s.logger.Warn(ctx, "step 1 envelope produced 0 parseable events, returning empty results", attrs...)
 		return nil, md, nil
 	}
+	if limit := s.config.maxEventsPerArtist(); len(drafts) > limit {
+		guardAttrs := append(attrs,
+			slog.Int("draft_count", len(drafts)),
+			slog.Int("max_events_per_artist", limit),
+		)
+		s.logger.Warn(ctx, "step 1 extracted more events than the per-artist limit, rejecting as likely hallucination", guardAttrs...)
+		return nil, md, apperr.Wrap(errTooManyEvents, codes.FailedPrecondition, "gemini search result exceeds per-artist event limit", guardAttrs...)
+	}
 
 	// ===== Step 2: Structured parse (no tools, schema enforced) =====
 	results, step2, err := s.runStep2Parse(ctx, drafts, from, md, attrs)
@@ -644,6 +783,25 @@ func (s *ConcertSearcher) SearchExt(
 	return results, md, nil
 }
 
+// tokenUsageOf sums the token counters of the given passes. Nil passes (a
+// step that never ran) contribute nothing.
+func tokenUsageOf(passes ...*PassMetadata) entity.TokenUsage {
+	var u entity.TokenUsage
+	for _, pm := range passes {
+		if pm == nil {
+			continue
+		}
+		u = u.Add(entity.TokenUsage{
+			PromptTokens:     int(pm.PromptTokens),
+			CandidatesTokens: int(pm.CandidatesTokens),
+			ThinkingTokens:   int(pm.ThinkingTokens),
+			ToolUseTokens:    int(pm.ToolUseTokens),
+			TotalTokens:      int(pm.TotalTokens),
+		})
+	}
+	return u
+}
+
 // mirrorStep2 copies Step 2 values into top-level SearchMetadata fields.
 // Existing log consumers expect a single token snapshot per Search.
 func mirrorStep2(md *SearchMetadata, pm *PassMetadata) {
@@ -676,11 +834,11 @@ func mirrorStep2(md *SearchMetadata, pm *PassMetadata) {
 type Step1Slice struct {
 	// Name is a stable identifier used in logs and per-slice metadata.
 	Name string
-	// SystemInstruction selects the tour-only or standalone-only Step 1
-	// system instruction.
+	// SystemInstruction selects the tour-only, standalone-only or
+	// festival-only Step 1 system instruction.
 	SystemInstruction string
-	// PromptTemplate is the per-slice prompt template (tour or standalone
-	// variant). It carries 4 %s placeholders in order: from_date, to_date,
+	// PromptTemplate is the per-slice prompt template (tour, standalone or
+	// festival variant). It carries 4 %s placeholders in order: from_date, to_date,
 	// artist name, official site host.
 	PromptTemplate string
 	// FromMonthsOffset is the offset in calendar months added to the
@@ -725,7 +883,26 @@ var defaultStep1Slices = []Step1Slice{
 	},
 }
 
-// runStep1Grounded executes Step 1 as a fan-out across defaultStep1Slices.
+// festivalStep1Slice searches for festival appearances in the next 24
+// months. It runs after defaultStep1Slices only when Config.DiscoverFestivals
+// is set.
+var festivalStep1Slice = Step1Slice{
+	Name:              "festivals",
+	SystemInstruction: systemInstructionStep1Festival,
+	PromptTemplate:    promptTemplateStep1Festival,
+	FromMonthsOffset:  0,
+	ToMonthsOffset:    24,
+}
+
+// step1Slices returns the Step 1 slices one Search fans out over.
+func (c *Config) step1Slices() []Step1Slice {
+	if !c.DiscoverFestivals {
+		return defaultStep1Slices
+	}
+	return append(slices.Clone(defaultStep1Slices), festivalStep1Slice)
+}
+
+// runStep1Grounded executes Step 1 as a fan-out across the configured slices.
 // Each slice fires its own Gemini call in parallel. Successful envelopes
 // are merged with <source url> dedup before being returned.
 //
@@ -736,10 +913,9 @@ var defaultStep1Slices = []Step1Slice{
 func (s *ConcertSearcher) runStep1Grounded(
 	ctx context.Context,
 	artist *entity.Artist,
-	officialSiteURL string,
+	siteURLs []string,
 	attrs []slog.Attr,
 ) (string, *PassMetadata, []*PassMetadata, error) {
-	host := hostOf(officialSiteURL)
 	baseDate := time.Now().UTC()
 
 	type sliceResult struct {
@@ -747,13 +923,14 @@ func (s *ConcertSearcher) runStep1Grounded(
 		pm       *PassMetadata
 		err      error
 	}
-	results := make([]sliceResult, len(defaultStep1Slices))
+	step1Slices := s.config.step1Slices()
+	results := make([]sliceResult, len(step1Slices))
 	var wg sync.WaitGroup
-	for i, sl := range defaultStep1Slices {
+	for i, sl := range step1Slices {
 		wg.Add(1)
 		go func(idx int, slice Step1Slice) {
 			defer wg.Done()
-			env, pm, err := s.runStep1Slice(ctx, slice, artist.Name, host, baseDate, attrs)
+			env, pm, err := s.runStep1Slice(ctx, slice, artist.Name, siteURLs, baseDate, attrs)
 			results[idx] = sliceResult{envelope: env, pm: pm, err: err}
 		}(i, sl)
 	}
@@ -800,13 +977,14 @@ func (s *ConcertSearcher) runStep1Grounded(
 func (s *ConcertSearcher) runStep1Slice(
 	ctx context.Context,
 	slice Step1Slice,
-	artistName, officialSiteHost string,
+	artistName string,
+	siteURLs []string,
 	baseDate time.Time,
 	attrs []slog.Attr,
 ) (string, *PassMetadata, error) {
 	from := baseDate.AddDate(0, slice.FromMonthsOffset, 0).Format("2006-01-02")
 	to := baseDate.AddDate(0, slice.ToMonthsOffset, 0).Format("2006-01-02")
-	prompt := fmt.Sprintf(slice.PromptTemplate, from, to, artistName, officialSiteHost)
+	prompt := step1Prompt(slice.PromptTemplate, from, to, artistName, siteURLs)
 
 	now := time.Now().UTC().Truncate(time.Second)
 	searchTool := &genai.Tool{
@@ -886,6 +1064,10 @@ func aggregatePassMetadata(slices []*PassMetadata) *PassMetadata {
 		// ExhaustedTransient is OR-ed across slices: a single slice
 		// exhausting retries is enough to flag the aggregated metadata.
 		agg.ExhaustedTransient = agg.ExhaustedTransient || s.ExhaustedTransient
+		agg.NoCandidates = agg.NoCandidates || s.NoCandidates
+		if agg.BlockReason == "" {
+			agg.BlockReason = s.BlockReason
+		}
 		// FinishReason aggregation: the goal is "show the worst reason
 		// seen". Slot empty → use whatever the slice reported (including
 		// STOP). Slot already STOP → overwrite only with a non-STOP /
@@ -925,7 +1107,7 @@ var extractedInnerRe = regexp.MustCompile(`(?s)<extracted>(.*?)</extracted>`)
 
 // mergeAndDedupEnvelopes merges several Step 1 slice envelopes into one
 // <extracted>...</extracted> wrapper. The inner content of each slice's
-// envelope (its <tour> and <standalone> children) is concatenated into
+// envelope (its <tour>, <standalone> and <festival> children) is concatenated into
 // the merged wrapper. Cross-slice event-level dedup is NOT performed here;
 // it is handled in parseStep2Response by the (local_date, venue,
 // start_time) triple.
@@ -1053,8 +1235,10 @@ func (s *ConcertSearcher) runStep2Parse(
 	return parsed, pm, nil
 }
 
-// executePass runs one Gemini call wrapped in exponential backoff
-// (3 attempts, 1s/2s/4s, 60s max). It captures all observable metadata
+// executePass runs one Gemini call wrapped in exponential backoff with
+// jitter as configured by Config.Retry (default 3 attempts, 1s base,
+// 60s max, full jitter). A Retry-After on a 429/503 response replaces
+// the next wait, capped at 60s. It captures all observable metadata
 // into a fresh PassMetadata. Returns:
 //   - (pm, rawText, false, nil) on success
 //   - (pm, "", true, nil) when retries are exhausted with transient errors
@@ -1069,11 +1253,7 @@ func (s *ConcertSearcher) executePass(
 	attrs []slog.Attr,
 ) (*PassMetadata, string, bool, error) {
 	pm := &PassMetadata{}
-	bo := &backoff.ExponentialBackOff{
-		InitialInterval: 1 * time.Second,
-		Multiplier:      2.0,
-		MaxInterval:     60 * time.Second,
-	}
+	bo := newJitterBackOff(s.config.Retry, s.onBackoff)
 
 	var (
 		lastWasFinish bool
@@ -1093,16 +1273,25 @@ func (s *ConcertSearcher) executePass(
 		// stuck request × 120 s on top of the parent's deadline.
 		reqCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), geminiCallTimeout)
 		defer cancel()
+		reqCtx, hint := withRetryAfterHint(reqCtx)
 
 		resp, err := s.client.Models.GenerateContent(reqCtx, modelName, genai.Text(prompt), cfg)
 		if err != nil {
 			lastWasFinish = false
 			s.logger.Warn(ctx, "gemini model call failed",
-				append(attrs, slog.String("error", err.Error()))...)
+				append(attrs,
+					slog.String("error", err.Error()),
+					slog.Duration("retry_after", hint.wait),
+				)...)
 			if !isRetryable(err) {
 				sawPermanent = true
 				return "", backoff.Permanent(err)
 			}
+			// A 429/503 that says when to come back overrides the jittered
+			// schedule for this one wait.
+			if hint.wait > 0 {
+				bo.useRetryAfter(hint.wait)
+			}
 			return "", err
 		}
 
@@ -1117,6 +1306,8 @@ func (s *ConcertSearcher) executePass(
 		pm.WebSearchQueriesList = nil
 		pm.WebSearchQueries = 0
 		pm.RenderedParts = 0
+		pm.NoCandidates = false
+		pm.BlockReason = ""
 
 		if u := resp.UsageMetadata; u != nil {
 			pm.PromptTokens = u.PromptTokenCount
@@ -1138,7 +1329,13 @@ func (s *ConcertSearcher) executePass(
 		}
 
 		if len(resp.Candidates) == 0 {
-			s.logger.Info(ctx, "Gemini returned no candidates", append(attrs, respAttrs...)...)
+			// Not retried: a refusal is deterministic for the same prompt.
+			pm.NoCandidates = true
+			if resp.PromptFeedback != nil {
+				pm.BlockReason = string(resp.PromptFeedback.BlockReason)
+			}
+			s.logger.Warn(ctx, "Gemini returned no candidates, possible refusal",
+				append(attrs, append(respAttrs, slog.String("block_reason", pm.BlockReason))...)...)
 			return "", nil
 		}
 
@@ -1237,7 +1434,7 @@ func (s *ConcertSearcher) executePass(
 
 		lastWasFinish = false
 		return joined, nil
-	}, backoff.WithBackOff(bo), backoff.WithMaxTries(3))
+	}, backoff.WithBackOff(bo), backoff.WithMaxTries(s.config.Retry.maxAttempts()))
 
 	if err != nil {
 		if sawPermanent {
@@ -1256,7 +1453,7 @@ func (s *ConcertSearcher) executePass(
 			return pm, "", true, nil
 		}
 		// Transient network exhaustion (HTTP 503, rate-limit, etc.): the
-		// per-attempt RPC failed three times without ever reaching a
+		// per-attempt RPC failed on every attempt without ever reaching a
 		// permanent / non-STOP-FinishReason path. The function docstring
 		// promises `(pm, "", true, nil)` for exhausted transient errors;
 		// surfacing this as a hard error would propagate as a permanent
@@ -1320,6 +1517,44 @@ func assertStepInvariants(step string, cfg *genai.GenerateContentConfig) error {
 	return nil
 }
 
+// step1Prompt renders a Step 1 slice template. The template's host line
+// names the primary (first) site; when the artist has more than one site,
+// every URL is listed after it as an additional grounding hint so tour,
+// label, and ticketing domains are all searched. With zero or one site the
+// prompt is exactly the single-site prompt.
+func step1Prompt(template, from, to, artistName string, siteURLs []string) string {
+	var primaryHost string
+	if len(siteURLs) > 0 {
+		primaryHost = hostOf(siteURLs[0])
+	}
+	prompt := fmt.Sprintf(template, from, to, artistName, primaryHost)
+	if len(siteURLs) < 2 {
+		return prompt
+	}
+
+	var b strings.Builder
+	b.WriteString(prompt)
+	b.WriteString("公式サイト URL 一覧:\n")
+	for _, u := range siteURLs {
+		b.WriteString("- ")
+		b.WriteString(u)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// officialSiteURLs returns the non-empty URLs of sites in order, skipping nil
+// entries.
+func officialSiteURLs(sites []*entity.OfficialSite) []string {
+	urls := make([]string, 0, len(sites))
+	for _, site := range sites {
+		if site != nil && site.URL != "" {
+			urls = append(urls, site.URL)
+		}
+	}
+	return urls
+}
+
 // hostOf parses u and returns its host (lowercased, without scheme/port/path).
 func hostOf(u string) string {
 	if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
@@ -1515,7 +1750,8 @@ func (s *ConcertSearcher) parseStep2Response(
 // SourceURL pass-through) with the Step 2 coerced output
 // (AdminArea / LocalDate / StartTime / OpenTime in ISO form) into an
 // entity.ScrapedConcert. Returns nil if the event must be skipped
-// (unparseable date, or local_date is before `from`).
+// (unparseable date, or local_date is before `from`'s date in the venue's
+// time zone).
 func (s *ConcertSearcher) toScrapedConcert(
 	ctx context.Context,
 	draft EventDraft,
@@ -1530,13 +1766,6 @@ func (s *ConcertSearcher) toScrapedConcert(
 		return nil
 	}
 
-	if date.Before(from.Truncate(24 * time.Hour)) {
-		s.logger.Debug(ctx, "filtered past event",
-			append(attrs, slog.String("title", draft.Title), slog.String("date", coerced.LocalDate))...,
-		)
-		return nil
-	}
-
 	var startTime time.Time
 	if coerced.StartTime != "" && coerced.StartTime != "null" {
 		if st, err := time.Parse(time.RFC3339, coerced.StartTime); err != nil {
@@ -1564,7 +1793,7 @@ func (s *ConcertSearcher) toScrapedConcert(
 		adminArea = geo.NormalizeAdminArea(coerced.AdminArea)
 	}
 
-	return &entity.ScrapedConcert{
+	sc := &entity.ScrapedConcert{
 		Title:           draft.Title,
 		ListedVenueName: draft.Venue,
 		AdminArea:       adminArea,
@@ -1574,5 +1803,16 @@ func (s *ConcertSearcher) toScrapedConcert(
 		SourceURL:       draft.SourceURL,
 		IsTour:          draft.IsTour,
 		TourGroup:       draft.TourGroup,
+		IsFestival:      draft.IsFestival,
+	}
+
+	// The date is local to the venue, so "past" is judged against from's
+	// date in the venue's zone, not in UTC.
+	if sc.IsPast(from) {
+		s.logger.Debug(ctx, "filtered past event",
+			append(attrs, slog.String("title", draft.Title), slog.String("date", coerced.LocalDate))...,
+		)
+		return nil
 	}
+	return sc
 }
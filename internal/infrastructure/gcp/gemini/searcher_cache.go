@@ -0,0 +1,93 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// CachedConcertSearcher wraps a ConcertSearcher with a short-lived response
+// cache keyed on (artist, official sites, search horizon). Two onboarding users
+// who follow the same artist within minutes would otherwise each pay for a
+// full two-step Gemini call; with the cache the second Search reuses the
+// first's result.
+//
+// Only successful responses are cached, so a failed call is retried on the
+// next Search. The cache itself must be safe for concurrent use (e.g.
+// cache.MemoryCache); every hit returns a fresh copy of the cached slice so
+// callers cannot mutate each other's results.
+type CachedConcertSearcher struct {
+	next   entity.ConcertSearcher
+	cache  entity.Cache
+	logger *logging.Logger
+}
+
+// Compile-time interface compliance check.
+var _ entity.ConcertSearcher = (*CachedConcertSearcher)(nil)
+
+// NewCachedConcertSearcher creates a ConcertSearcher that serves repeated
+// identical searches from cache. The TTL is owned by the cache.
+func NewCachedConcertSearcher(next entity.ConcertSearcher, cache entity.Cache, logger *logging.Logger) *CachedConcertSearcher {
+	return &CachedConcertSearcher{
+		next:   next,
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+// Search returns the cached result for an identical recent search, or
+// delegates to the wrapped searcher and caches its result.
+func (s *CachedConcertSearcher) Search(
+	ctx context.Context,
+	artist *entity.Artist,
+	officialSites []*entity.OfficialSite,
+	from time.Time,
+) ([]*entity.ScrapedConcert, error) {
+	key := searchCacheKey(artist, officialSites, from)
+
+	if cached, ok := s.cache.Get(key).([]*entity.ScrapedConcert); ok {
+		s.logger.Debug(ctx, "concert search served from cache",
+			slog.String("artistID", artist.ID),
+			slog.Int("count", len(cached)),
+		)
+		return cloneScrapedConcerts(cached), nil
+	}
+
+	results, err := s.next.Search(ctx, artist, officialSites, from)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(key, cloneScrapedConcerts(results))
+	return results, nil
+}
+
+// searchCacheKey identifies a search by artist, grounding sites, and the
+// calendar day the horizon starts on. Day granularity lets searches issued
+// minutes apart share an entry while a search on a later day misses.
+func searchCacheKey(artist *entity.Artist, officialSites []*entity.OfficialSite, from time.Time) string {
+	artistKey := artist.ID
+	if artistKey == "" {
+		// Ad-hoc callers (CLI, evaluation harness) search by name only.
+		artistKey = "name:" + artist.Name
+	}
+	siteKey := strings.Join(officialSiteURLs(officialSites), " ")
+	return fmt.Sprintf("concerts:%s:%s:%s", artistKey, siteKey, from.UTC().Format(time.DateOnly))
+}
+
+// cloneScrapedConcerts returns a copy of the slice with each element copied,
+// so a cached result is never shared with a caller that might modify it. A nil
+// input yields an empty slice so "no concerts found" is cacheable too.
+func cloneScrapedConcerts(src []*entity.ScrapedConcert) []*entity.ScrapedConcert {
+	dst := make([]*entity.ScrapedConcert, len(src))
+	for i, c := range src {
+		cp := *c
+		dst[i] = &cp
+	}
+	return dst
+}
@@ -0,0 +1,121 @@
+package gemini_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/infrastructure/gcp/gemini"
+	"github.com/liverty-music/backend/pkg/cache"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newCachedSearcher(t *testing.T, next entity.ConcertSearcher) *gemini.CachedConcertSearcher {
+	t.Helper()
+	logger, err := logging.New()
+	require.NoError(t, err)
+	c := cache.NewMemoryCache(time.Minute)
+	t.Cleanup(func() { _ = c.Close() })
+	return gemini.NewCachedConcertSearcher(next, c, logger)
+}
+
+func TestCachedConcertSearcher_Search(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	artist := &entity.Artist{ID: "artist-1", Name: "Test Artist"}
+	sites := []*entity.OfficialSite{{URL: "https://test-artist.example"}}
+	from := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	scraped := []*entity.ScrapedConcert{{Title: "Tour 2026", ListedVenueName: "Test Hall"}}
+
+	t.Run("second identical search within TTL does not hit the backend", func(t *testing.T) {
+		t.Parallel()
+		backend := mocks.NewMockConcertSearcher(t)
+		backend.EXPECT().Search(mock.Anything, artist, sites, from).Return(scraped, nil).Once()
+		s := newCachedSearcher(t, backend)
+
+		first, err := s.Search(ctx, artist, sites, from)
+		require.NoError(t, err)
+		second, err := s.Search(ctx, artist, sites, from.Add(time.Hour))
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+		assert.Equal(t, "Tour 2026", second[0].Title)
+	})
+
+	t.Run("different sites or horizon misses the cache", func(t *testing.T) {
+		t.Parallel()
+		otherSites := []*entity.OfficialSite{{URL: "https://other.example"}}
+		moreSites := []*entity.OfficialSite{sites[0], otherSites[0]}
+		nextDay := from.AddDate(0, 0, 1)
+		backend := mocks.NewMockConcertSearcher(t)
+		backend.EXPECT().Search(mock.Anything, artist, sites, from).Return(scraped, nil).Once()
+		backend.EXPECT().Search(mock.Anything, artist, otherSites, from).Return(scraped, nil).Once()
+		backend.EXPECT().Search(mock.Anything, artist, moreSites, from).Return(scraped, nil).Once()
+		backend.EXPECT().Search(mock.Anything, artist, sites, nextDay).Return(scraped, nil).Once()
+		s := newCachedSearcher(t, backend)
+
+		_, err := s.Search(ctx, artist, sites, from)
+		require.NoError(t, err)
+		_, err = s.Search(ctx, artist, otherSites, from)
+		require.NoError(t, err)
+		_, err = s.Search(ctx, artist, moreSites, from)
+		require.NoError(t, err)
+		_, err = s.Search(ctx, artist, sites, nextDay)
+		require.NoError(t, err)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		t.Parallel()
+		backend := mocks.NewMockConcertSearcher(t)
+		backend.EXPECT().Search(mock.Anything, artist, sites, from).Return(nil, apperr.ErrUnavailable).Once()
+		backend.EXPECT().Search(mock.Anything, artist, sites, from).Return(scraped, nil).Once()
+		s := newCachedSearcher(t, backend)
+
+		_, err := s.Search(ctx, artist, sites, from)
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+		got, err := s.Search(ctx, artist, sites, from)
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("cached results are isolated from caller mutation", func(t *testing.T) {
+		t.Parallel()
+		backend := mocks.NewMockConcertSearcher(t)
+		backend.EXPECT().Search(mock.Anything, artist, sites, from).
+			Return([]*entity.ScrapedConcert{{Title: "Tour 2026"}}, nil).Once()
+		s := newCachedSearcher(t, backend)
+
+		first, err := s.Search(ctx, artist, sites, from)
+		require.NoError(t, err)
+		first[0].Title = "mutated"
+
+		second, err := s.Search(ctx, artist, sites, from)
+		require.NoError(t, err)
+		assert.Equal(t, "Tour 2026", second[0].Title)
+	})
+
+	t.Run("safe for concurrent use", func(t *testing.T) {
+		t.Parallel()
+		backend := mocks.NewMockConcertSearcher(t)
+		backend.EXPECT().Search(mock.Anything, artist, sites, from).Return(scraped, nil)
+		s := newCachedSearcher(t, backend)
+
+		var wg sync.WaitGroup
+		for range 16 {
+			wg.Go(func() {
+				got, err := s.Search(ctx, artist, sites, from)
+				assert.NoError(t, err)
+				assert.Len(t, got, 1)
+			})
+		}
+		wg.Wait()
+	})
+}
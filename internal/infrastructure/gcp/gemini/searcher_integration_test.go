@@ -372,10 +372,10 @@ func runCell(
 	}
 
 	artist := &entity.Artist{ID: cell.Artist.ID, Name: cell.Artist.Name}
-	site := &entity.OfficialSite{URL: cell.Artist.OfficialSiteURL}
+	sites := []*entity.OfficialSite{{URL: cell.Artist.OfficialSiteURL}}
 
 	start := time.Now()
-	got, md, err := s.SearchExt(ctx, artist, site, from)
+	got, md, err := s.SearchExt(ctx, artist, sites, from)
 	res.LatencyMillis = time.Since(start).Milliseconds()
 	if md != nil {
 		res.PromptTokens = md.PromptTokens
@@ -27,6 +27,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -68,7 +69,7 @@ func TestConcertSearcher_Search(t *testing.T) {
 	ctx := context.Background()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	tests := []struct {
 		name         string
@@ -493,7 +494,7 @@ func TestConcertSearcher_Search(t *testing.T) {
 			}, httpClient, logger)
 			require.NoError(t, err)
 
-			got, err := s.Search(ctx, artist, officialSite, from)
+			got, err := s.Search(ctx, artist, officialSites, from)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -582,7 +583,7 @@ func TestConcertSearcher_Search_NoOfficialSite(t *testing.T) {
 	}, httpClient, logger)
 	require.NoError(t, err)
 
-	// nil officialSite — Step 1 still runs (grounded search), emits a
+	// nil officialSites — Step 1 still runs (grounded search), emits a
 	// per-field XML envelope; Step 2 parses it.
 	got, err := s.Search(ctx, artist, nil, from)
 
@@ -619,7 +620,7 @@ func TestConcertSearcher_Search_InvalidJSON_Permanent(t *testing.T) {
 	ctx := context.Background()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	var callCount atomic.Int32
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -637,7 +638,7 @@ func TestConcertSearcher_Search_InvalidJSON_Permanent(t *testing.T) {
 	}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
 	require.NoError(t, err)
 
-	got, err := s.Search(ctx, artist, officialSite, from)
+	got, err := s.Search(ctx, artist, officialSites, from)
 
 	assert.Nil(t, got)
 	require.Error(t, err)
@@ -654,7 +655,7 @@ func TestConcertSearcher_Search_StructuralMismatch(t *testing.T) {
 	ctx := context.Background()
 	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	artist := &entity.Artist{Name: "Test Artist"}
-	officialSite := &entity.OfficialSite{URL: "https://example.com"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 	// Valid JSON but wrong structure: "tours" is a string instead of an array.
 	wrongStructure := `{"tours": "not an array", "standalones": []}`
@@ -674,7 +675,7 @@ func TestConcertSearcher_Search_StructuralMismatch(t *testing.T) {
 	}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
 	require.NoError(t, err)
 
-	got, err := s.Search(ctx, artist, officialSite, from)
+	got, err := s.Search(ctx, artist, officialSites, from)
 
 	assert.Nil(t, got)
 	require.Error(t, err)
@@ -708,7 +709,7 @@ func TestConcertSearcher_Search_ConfigHonored(t *testing.T) {
 			ctx := context.Background()
 			from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 			artist := &entity.Artist{Name: "Test Artist"}
-			officialSite := &entity.OfficialSite{URL: "https://example.com"}
+			officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
 
 			// Step 1 fans out into 3 parallel slice goroutines and then
 			// fires Step 2 sequentially. All 4 hit this mock server. We
@@ -744,7 +745,7 @@ func TestConcertSearcher_Search_ConfigHonored(t *testing.T) {
 			}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
 			require.NoError(t, err)
 
-			_, err = s.Search(ctx, artist, officialSite, from)
+			_, err = s.Search(ctx, artist, officialSites, from)
 			require.NoError(t, err)
 			require.NotNil(t, capturedBody, "request body must be captured")
 
@@ -771,6 +772,62 @@ func TestConcertSearcher_Search_ConfigHonored(t *testing.T) {
 	}
 }
 
+// TestStep1Prompt_OfficialSites locks in how official sites reach the Step 1
+// prompt: the host line always names the primary (first) site, and the full
+// URL list is appended only when there is more than one, so artists with zero
+// or one site get the same prompt as before multi-site support.
+func TestStep1Prompt_OfficialSites(t *testing.T) {
+	t.Parallel()
+
+	const header = "開催日が 2026-01-01 から 2026-04-01 に含まれる Test Artist のツアーを全て抽出して。音楽フェスと単発公演は除外して。\n\n"
+
+	cases := []struct {
+		name  string
+		sites []*entity.OfficialSite
+		want  string
+	}{
+		{
+			name:  "no sites",
+			sites: nil,
+			want:  header + "公式サイト host: \n",
+		},
+		{
+			name:  "one site",
+			sites: []*entity.OfficialSite{{URL: "https://www.Example.com/news"}},
+			want:  header + "公式サイト host: www.example.com\n",
+		},
+		{
+			name: "multiple sites",
+			sites: []*entity.OfficialSite{
+				{URL: "https://example.com"},
+				{URL: "https://tour.example.com/2026"},
+				{URL: "https://label.example.jp/artists/test"},
+			},
+			want: header + "公式サイト host: example.com\n" +
+				"公式サイト URL 一覧:\n" +
+				"- https://example.com\n" +
+				"- https://tour.example.com/2026\n" +
+				"- https://label.example.jp/artists/test\n",
+		},
+		{
+			name: "nil and empty entries are skipped",
+			sites: []*entity.OfficialSite{
+				nil,
+				{URL: ""},
+				{URL: "https://example.com"},
+			},
+			want: header + "公式サイト host: example.com\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := gemini.Step1TourPrompt("2026-01-01", "2026-04-01", "Test Artist", tc.sites)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 // TestParseStep1Envelope_EmptyOrUnparseable locks in the contract from
 // the gemini-grounded-extract-and-coerce spec (R8): for empty input or
 // any input that does not unmarshal as the expected <extracted>...
@@ -852,3 +909,377 @@ func TestParseStep1Envelope_TourGrouping(t *testing.T) {
 	assert.False(t, standalone.IsTour, "standalone draft is standalone-origin")
 	assert.Zero(t, standalone.TourGroup, "standalone carries handle 0")
 }
+
+// TestParseStep1Envelope_Festival locks in that each <festival> block yields
+// one festival-origin draft carrying the artist's set time.
+func TestParseStep1Envelope_Festival(t *testing.T) {
+	t.Parallel()
+
+	envelope := `<extracted>
+  <standalone>
+    <title>Solo Show</title>
+    <source_url>https://example.com/solo</source_url>
+    <event><venue>Venue 5</venue><country>JP</country><local_date>2026-04-01</local_date><start_time>19:00</start_time></event>
+  </standalone>
+  <festival>
+    <title>Summer Fes 2026</title>
+    <source_url>https://example.com/fes</source_url>
+    <event><venue>Fes Park</venue><country>JP</country><local_date>2026-08-08</local_date><start_time>15:30</start_time></event>
+  </festival>
+</extracted>`
+
+	drafts := gemini.ParseStep1Envelope(envelope)
+	require.Len(t, drafts, 2)
+
+	assert.False(t, drafts[0].IsFestival, "standalone draft is not festival-origin")
+
+	festival := drafts[1]
+	assert.True(t, festival.IsFestival)
+	assert.False(t, festival.IsTour)
+	assert.Zero(t, festival.TourGroup)
+	assert.Equal(t, "Summer Fes 2026", festival.Title)
+	assert.Equal(t, "https://example.com/fes", festival.SourceURL)
+	assert.Equal(t, "Fes Park", festival.Venue)
+	assert.Equal(t, "15:30", festival.StartTime)
+}
+
+// TestConcertSearcher_Search_RetryPolicy drives each Step 1 slice through
+// two failing attempts before a successful one and checks that the retry
+// classifier, the configured attempt budget, and the jittered waits are
+// all honored. Slices run in parallel, so the mock server counts attempts
+// per request body (each slice sends a distinct prompt).
+func TestConcertSearcher_Search_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	const baseBackoff = time.Millisecond
+
+	tests := []struct {
+		name         string
+		status       int
+		wantAttempts int
+		wantErr      error
+	}{
+		{name: "504 Gateway Timeout is retried", status: http.StatusGatewayTimeout, wantAttempts: 3},
+		{name: "499 Client Cancelled is retried", status: 499, wantAttempts: 3},
+		{name: "400 Bad Request stops immediately", status: http.StatusBadRequest, wantAttempts: 1, wantErr: apperr.ErrInvalidArgument},
+		{name: "404 Not Found stops immediately", status: http.StatusNotFound, wantAttempts: 1, wantErr: apperr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			logger, _ := logging.New()
+			ctx := context.Background()
+			from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+			artist := &entity.Artist{Name: "Test Artist"}
+			officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
+
+			var (
+				mu       sync.Mutex
+				attempts = map[string]int{}
+				waits    []time.Duration
+			)
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				mu.Lock()
+				attempts[string(body)]++
+				n := attempts[string(body)]
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				if n <= 2 {
+					w.WriteHeader(tt.status)
+					_, _ = fmt.Fprintf(w, `{"error":{"code":%d,"message":"injected failure"}}`, tt.status)
+					return
+				}
+				// An envelope without events ends Search after Step 1.
+				_, _ = w.Write([]byte(geminiResponse("<extracted></extracted>", "STOP")))
+			}))
+			defer ts.Close()
+
+			s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+				APIKey:       "test",
+				ModelExtract: "gemini-pro",
+				ModelParse:   "gemini-pro",
+				Retry: gemini.RetryPolicy{
+					MaxRetries:   2,
+					BaseBackoff:  baseBackoff,
+					JitterFactor: 1.0,
+				},
+			}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
+			require.NoError(t, err)
+			gemini.SetBackoffObserver(s, func(d time.Duration) {
+				mu.Lock()
+				waits = append(waits, d)
+				mu.Unlock()
+			})
+
+			_, md, err := s.SearchExt(ctx, artist, officialSites, from)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotNil(t, md)
+			require.Len(t, md.Step1Slices, 3)
+			for i, pm := range md.Step1Slices {
+				require.NotNil(t, pm, "slice %d metadata", i)
+				assert.Equal(t, tt.wantAttempts, pm.RetryCount, "slice %d attempts", i)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(t, attempts, 3, "one distinct request body per slice")
+			for _, n := range attempts {
+				assert.Equal(t, tt.wantAttempts, n)
+			}
+
+			// Each slice sleeps once between consecutive attempts.
+			require.Len(t, waits, 3*(tt.wantAttempts-1))
+			distinct := map[time.Duration]struct{}{}
+			for _, d := range waits {
+				assert.GreaterOrEqual(t, d, time.Duration(0))
+				assert.Less(t, d, 2*baseBackoff, "full jitter stays below the exponential ceiling")
+				distinct[d] = struct{}{}
+			}
+			if len(waits) > 0 {
+				assert.Greater(t, len(distinct), 1, "jittered waits must not be identical")
+			}
+		})
+	}
+}
+
+// TestConcertSearcher_Search_TokenUsage checks that the usageMetadata of
+// every Gemini call a Search makes is parsed and summed into the single
+// OnTokenUsage report for the artist.
+func TestConcertSearcher_SearchExt_NoCandidates(t *testing.T) {
+	t.Parallel()
+	logger, _ := logging.New()
+	ctx := context.Background()
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	artist := &entity.Artist{ID: "artist-1", Name: "Test Artist"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
+
+	tests := []struct {
+		name             string
+		body             string
+		wantNoCandidates bool
+		wantBlockReason  string
+	}{
+		{
+			name: "refused prompt is flagged",
+			body: `{
+				"candidates": [],
+				"promptFeedback": {"blockReason": "PROHIBITED_CONTENT"},
+				"usageMetadata": {"promptTokenCount": 10, "totalTokenCount": 10}
+			}`,
+			wantNoCandidates: true,
+			wantBlockReason:  "PROHIBITED_CONTENT",
+		},
+		{
+			name: "model that found nothing is not flagged",
+			body: `{
+				"candidates": [{
+					"content": {"parts": [{"text": "<extracted></extracted>"}]},
+					"finishReason": "STOP"
+				}],
+				"usageMetadata": {"promptTokenCount": 10, "candidatesTokenCount": 5, "totalTokenCount": 15}
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+				APIKey:       "test",
+				ModelExtract: "gemini-pro",
+				ModelParse:   "gemini-pro",
+			}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
+			require.NoError(t, err)
+
+			got, md, err := s.SearchExt(ctx, artist, officialSites, from)
+
+			require.NoError(t, err)
+			assert.Empty(t, got)
+			assert.Equal(t, int32(3), calls.Load(), "one call per Step 1 slice, none retried")
+			require.NotNil(t, md.Step1Grounded)
+			assert.Equal(t, tt.wantNoCandidates, md.Step1Grounded.NoCandidates)
+			assert.Equal(t, tt.wantBlockReason, md.Step1Grounded.BlockReason)
+			assert.False(t, md.Step1Grounded.ExhaustedTransient)
+			for _, slice := range md.Step1Slices {
+				assert.Equal(t, tt.wantNoCandidates, slice.NoCandidates)
+			}
+		})
+	}
+}
+
+func TestConcertSearcher_Search_MaxEventsPerArtist(t *testing.T) {
+	t.Parallel()
+	logger, _ := logging.New()
+	ctx := context.Background()
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	artist := &entity.Artist{ID: "artist-1", Name: "Test Artist"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
+
+	// One standalone block listing three events on distinct dates.
+	var envelope strings.Builder
+	envelope.WriteString(`<extracted><standalone><title>Show</title><source_url>https://example.com/news/1</source_url>`)
+	for day := 1; day <= 3; day++ {
+		fmt.Fprintf(&envelope, `<event><venue>Test Hall</venue><country>JP</country><local_date>2026-03-%02d</local_date><start_time>18:00</start_time></event>`, day)
+	}
+	envelope.WriteString(`</standalone></extracted>`)
+
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(geminiResponse(envelope.String(), "STOP")))
+	}))
+	defer ts.Close()
+
+	s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+		APIKey:             "test",
+		ModelExtract:       "gemini-pro",
+		ModelParse:         "gemini-pro",
+		MaxEventsPerArtist: 2,
+	}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
+	require.NoError(t, err)
+
+	got, md, err := s.SearchExt(ctx, artist, officialSites, from)
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, gemini.ErrTooManyEvents)
+	assert.ErrorIs(t, err, apperr.ErrFailedPrecondition, "a rejected response must not be retried")
+	assert.Equal(t, 3, md.DraftCount)
+	assert.Nil(t, md.Step2Parse, "Step 2 must not run for a rejected result")
+	assert.Equal(t, int32(3), calls.Load(), "3 Step 1 slices, no Step 2 parse")
+}
+
+func TestConcertSearcher_Search_DiscoverFestivals(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	artist := &entity.Artist{ID: "artist-1", Name: "Test Artist"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
+
+	tests := []struct {
+		name              string
+		discoverFestivals bool
+		wantCalls         int32
+	}{
+		{name: "off by default runs the 3 base slices", discoverFestivals: false, wantCalls: 3},
+		{name: "on adds the festival slice", discoverFestivals: true, wantCalls: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			logger, _ := logging.New()
+			ctx := context.Background()
+
+			var calls atomic.Int32
+			var festivalPrompts atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				if strings.Contains(string(body), "音楽フェスへの出演告知") {
+					festivalPrompts.Add(1)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(geminiResponse("<extracted></extracted>", "STOP")))
+			}))
+			defer ts.Close()
+
+			s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+				APIKey:            "test",
+				ModelExtract:      "gemini-pro",
+				ModelParse:        "gemini-pro",
+				DiscoverFestivals: tt.discoverFestivals,
+			}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
+			require.NoError(t, err)
+
+			_, md, err := s.SearchExt(ctx, artist, officialSites, from)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCalls, calls.Load(), "one Step 1 call per slice, no Step 2 parse")
+			assert.Len(t, md.Step1Slices, int(tt.wantCalls))
+			assert.Equal(t, tt.discoverFestivals, festivalPrompts.Load() > 0)
+		})
+	}
+}
+
+func TestConcertSearcher_Search_TokenUsage(t *testing.T) {
+	t.Parallel()
+	logger, _ := logging.New()
+	ctx := context.Background()
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	artist := &entity.Artist{ID: "artist-1", Name: "Test Artist"}
+	officialSites := []*entity.OfficialSite{{URL: "https://example.com"}}
+
+	// Each of the 3 Step 1 slices reports a different usage; an envelope
+	// without events ends Search before Step 2.
+	var calls atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{
+			"candidates": [{
+				"content": {"parts": [{"text": "<extracted></extracted>"}]},
+				"finishReason": "STOP"
+			}],
+			"usageMetadata": {
+				"promptTokenCount": %d,
+				"candidatesTokenCount": %d,
+				"thoughtsTokenCount": %d,
+				"toolUsePromptTokenCount": %d,
+				"totalTokenCount": %d
+			}
+		}`, 100*n, 10*n, 5*n, 1000*n, 1115*n)
+	}))
+	defer ts.Close()
+
+	var (
+		mu      sync.Mutex
+		reports []entity.TokenUsage
+		gotID   string
+	)
+	s, err := gemini.NewConcertSearcher(ctx, gemini.Config{
+		APIKey:       "test",
+		ModelExtract: "gemini-pro",
+		ModelParse:   "gemini-pro",
+		OnTokenUsage: func(_ context.Context, artistID string, usage entity.TokenUsage) {
+			mu.Lock()
+			defer mu.Unlock()
+			gotID = artistID
+			reports = append(reports, usage)
+		},
+	}, &http.Client{Transport: &rewriteTransport{URL: ts.URL}}, logger)
+	require.NoError(t, err)
+
+	_, err = s.Search(ctx, artist, officialSites, from)
+	require.NoError(t, err)
+
+	require.Equal(t, int32(3), calls.Load())
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, reports, 1, "one report per Search")
+	assert.Equal(t, "artist-1", gotID)
+	// Slices n = 1, 2, 3 sum to 6 times the per-slice unit.
+	assert.Equal(t, entity.TokenUsage{
+		PromptTokens:     600,
+		CandidatesTokens: 60,
+		ThinkingTokens:   30,
+		ToolUseTokens:    6000,
+		TotalTokens:      6690,
+	}, reports[0])
+}
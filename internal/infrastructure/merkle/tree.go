@@ -13,26 +13,29 @@ const MaxDepth = 20
 
 // Builder constructs a Merkle tree from a list of leaves using Poseidon hash.
 type Builder struct {
-	depth int
+	maxDepth int
 }
 
-// NewBuilder creates a new Merkle tree builder with the specified depth.
-// The tree can hold up to 2^depth leaves.
-func NewBuilder(depth int) *Builder {
-	if depth > MaxDepth {
-		depth = MaxDepth
+// NewBuilder creates a new Merkle tree builder that builds trees of up to
+// maxDepth levels, capped at MaxDepth.
+func NewBuilder(maxDepth int) *Builder {
+	if maxDepth > MaxDepth {
+		maxDepth = MaxDepth
 	}
-	return &Builder{depth: depth}
+	return &Builder{maxDepth: maxDepth}
 }
 
-// Build constructs a full Merkle tree from the given leaves.
-// Empty leaf positions are filled with a zero hash.
-// Returns all nodes (including leaves) and the root hash.
-func (b *Builder) Build(eventID string, leaves [][]byte) ([]*entity.MerkleNode, []byte, error) {
-	numLeaves := 1 << b.depth
+// Build constructs a full Merkle tree of the given depth from the leaves.
+// The tree can hold up to 2^depth leaves; empty leaf positions are filled
+// with a zero hash. Returns all nodes (including leaves) and the root hash.
+func (b *Builder) Build(eventID string, depth int, leaves [][]byte) ([]*entity.MerkleNode, []byte, error) {
+	if depth < 1 || depth > b.maxDepth {
+		return nil, nil, apperr.New(codes.InvalidArgument, fmt.Sprintf("tree depth %d out of range [1, %d]", depth, b.maxDepth))
+	}
+	numLeaves := 1 << depth
 
 	if len(leaves) > numLeaves {
-		return nil, nil, apperr.New(codes.InvalidArgument, fmt.Sprintf("too many leaves: got %d, tree depth %d supports at most %d", len(leaves), b.depth, numLeaves))
+		return nil, nil, apperr.New(codes.InvalidArgument, fmt.Sprintf("too many leaves: got %d, tree depth %d supports at most %d", len(leaves), depth, numLeaves))
 	}
 
 	// Pad leaves with zero hashes if necessary.
@@ -64,17 +67,17 @@ func (b *Builder) Build(eventID string, leaves [][]byte) ([]*entity.MerkleNode,
 
 	// Build tree bottom-up.
 	currentLevel := paddedLeaves
-	for depth := 1; depth <= b.depth; depth++ {
+	for level := 1; level <= depth; level++ {
 		nextLevel := make([][]byte, len(currentLevel)/2)
 		for i := 0; i < len(currentLevel); i += 2 {
 			hash, err := PoseidonHash(currentLevel[i], currentLevel[i+1])
 			if err != nil {
-				return nil, nil, apperr.Wrap(err, codes.Internal, fmt.Sprintf("hash at depth %d, index %d", depth, i/2))
+				return nil, nil, apperr.Wrap(err, codes.Internal, fmt.Sprintf("hash at depth %d, index %d", level, i/2))
 			}
 			nextLevel[i/2] = hash
 			nodes = append(nodes, &entity.MerkleNode{
 				EventID:   eventID,
-				Depth:     depth,
+				Depth:     level,
 				NodeIndex: i / 2,
 				Hash:      hash,
 			})
@@ -95,7 +98,7 @@ func (b *Builder) IdentityCommitment(userID []byte) ([]byte, error) {
 	return IdentityCommitment(userID)
 }
 
-// Depth returns the depth of the tree.
+// Depth returns the maximum depth of tree the builder constructs.
 func (b *Builder) Depth() int {
-	return b.depth
+	return b.maxDepth
 }
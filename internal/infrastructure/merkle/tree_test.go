@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/liverty-music/backend/internal/infrastructure/merkle"
+	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -96,9 +97,9 @@ func TestBuilder_Build(t *testing.T) {
 			check: func(t *testing.T, builder *merkle.Builder, args args) {
 				t.Helper()
 				leaves := args.leaves(t)
-				_, root1, err := builder.Build(args.eventID, leaves)
+				_, root1, err := builder.Build(args.eventID, args.depth, leaves)
 				require.NoError(t, err)
-				_, root2, err := builder.Build(args.eventID, leaves)
+				_, root2, err := builder.Build(args.eventID, args.depth, leaves)
 				require.NoError(t, err)
 				assert.Equal(t, root1, root2, "same leaves should produce the same root")
 			},
@@ -117,9 +118,9 @@ func TestBuilder_Build(t *testing.T) {
 				leaf2, err := merkle.IdentityCommitment([]byte("user-2"))
 				require.NoError(t, err)
 
-				_, root1, err := builder.Build(args.eventID, [][]byte{leaf1})
+				_, root1, err := builder.Build(args.eventID, args.depth, [][]byte{leaf1})
 				require.NoError(t, err)
-				_, root2, err := builder.Build(args.eventID, [][]byte{leaf2})
+				_, root2, err := builder.Build(args.eventID, args.depth, [][]byte{leaf2})
 				require.NoError(t, err)
 
 				assert.NotEqual(t, root1, root2)
@@ -136,6 +137,39 @@ func TestBuilder_Build(t *testing.T) {
 				assert.Equal(t, merkle.MaxDepth, builder.Depth())
 			},
 		},
+		{
+			name: "build a tree shallower than the builder maximum",
+			args: args{
+				depth:   3,
+				eventID: "event-1",
+				leaves:  func(t *testing.T) [][]byte { return nil },
+			},
+			check: func(t *testing.T, _ *merkle.Builder, args args) {
+				t.Helper()
+				builder := merkle.NewBuilder(merkle.MaxDepth)
+				nodes, root, err := builder.Build(args.eventID, args.depth, nil)
+				require.NoError(t, err)
+				assert.Len(t, root, 32)
+				// depth 3 → 8 + 4 + 2 + 1
+				assert.Len(t, nodes, 15)
+				assert.Equal(t, args.depth, nodes[len(nodes)-1].Depth, "last node is the root")
+			},
+		},
+		{
+			name: "return error when depth is out of range",
+			args: args{
+				depth:   2,
+				eventID: "event-1",
+				leaves:  func(t *testing.T) [][]byte { return nil },
+			},
+			check: func(t *testing.T, builder *merkle.Builder, args args) {
+				t.Helper()
+				_, _, err := builder.Build(args.eventID, args.depth+1, nil)
+				assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+				_, _, err = builder.Build(args.eventID, 0, nil)
+				assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+			},
+		},
 		{
 			name: "return error when leaf count exceeds tree capacity",
 			args: args{
@@ -151,7 +185,7 @@ func TestBuilder_Build(t *testing.T) {
 					leaves[i], err = merkle.IdentityCommitment([]byte{byte(i + 1)})
 					require.NoError(t, err)
 				}
-				_, _, err := builder.Build(args.eventID, leaves)
+				_, _, err := builder.Build(args.eventID, args.depth, leaves)
 				assert.ErrorContains(t, err, "too many leaves")
 			},
 		},
@@ -170,7 +204,7 @@ func TestBuilder_Build(t *testing.T) {
 			}
 
 			leaves := tt.args.leaves(t)
-			nodes, root, err := builder.Build(tt.args.eventID, leaves)
+			nodes, root, err := builder.Build(tt.args.eventID, tt.args.depth, leaves)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, root)
@@ -0,0 +1,104 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// DeadLetterSubjectPrefix is prepended to a subject to form its dead-letter
+// subject, which places it in the DLQ stream rather than the subject's own
+// domain stream: dead-lettered messages are kept until triaged, longer than
+// domain events.
+const DeadLetterSubjectPrefix = "DLQ."
+
+// DefaultDeadLetterSuffix is appended to a subject to form its dead-letter
+// subject, e.g. DLQ.CONCERT.discovered_dlq.
+const DefaultDeadLetterSuffix = "_dlq"
+
+// Metadata keys set on a dead-lettered message, alongside the original
+// message's metadata.
+const (
+	// MetadataDeadLetterReason holds the error of the last failed attempt.
+	MetadataDeadLetterReason = "dlq_reason"
+	// MetadataDeadLetterTopic holds the subject the message was consumed from.
+	MetadataDeadLetterTopic = "dlq_topic"
+	// MetadataDeadLetterHandler holds the name of the handler that failed.
+	MetadataDeadLetterHandler = "dlq_handler"
+	// MetadataDeadLetterOriginalUUID holds the UUID of the original message.
+	MetadataDeadLetterOriginalUUID = "dlq_original_uuid"
+)
+
+// DeadLetterSubject returns the dead-letter subject of subject,
+// DLQ.<subject><suffix>.
+func DeadLetterSubject(subject, suffix string) string {
+	return DeadLetterSubjectPrefix + subject + suffix
+}
+
+// ValidateDeadLetterSuffix reports whether suffix extends the last token of a
+// subject into a valid dead-letter subject.
+func ValidateDeadLetterSuffix(suffix string) error {
+	if suffix == "" {
+		return errors.New("dead-letter suffix is required")
+	}
+	if strings.ContainsAny(suffix, ".*> \t\n") {
+		return fmt.Errorf("invalid dead-letter suffix %q: must not contain '.', '*', '>' or whitespace", suffix)
+	}
+	return nil
+}
+
+// DeadLetterQueue returns a middleware that moves a message whose handler
+// failed to the dead-letter subject of the subject it was consumed from,
+// DLQ.<subject><suffix>, and acks the original. Placed outside the Retry
+// middleware, it receives only the error of the final attempt, so a message
+// that keeps failing no longer blocks its stream with redeliveries.
+//
+// The dead-lettered message carries the original payload and metadata plus
+// the Metadata* keys above. It gets a fresh UUID, which the publisher uses as
+// the JetStream message ID; MetadataDeadLetterOriginalUUID links it back to
+// the original. When the dead-letter publish itself fails,
+// the original is nacked so it is not lost.
+func DeadLetterQueue(pub message.Publisher, suffix string, wmLogger watermill.LoggerAdapter) (message.HandlerMiddleware, error) {
+	if err := ValidateDeadLetterSuffix(suffix); err != nil {
+		return nil, err
+	}
+
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			produced, err := h(msg)
+			if err == nil {
+				return produced, nil
+			}
+
+			topic := message.SubscribeTopicFromCtx(msg.Context())
+			handler := message.HandlerNameFromCtx(msg.Context())
+
+			dead := message.NewMessage(watermill.NewUUID(), msg.Payload)
+			for k, v := range msg.Metadata {
+				dead.Metadata.Set(k, v)
+			}
+			dead.Metadata.Set(MetadataDeadLetterReason, err.Error())
+			dead.Metadata.Set(MetadataDeadLetterTopic, topic)
+			dead.Metadata.Set(MetadataDeadLetterHandler, handler)
+			dead.Metadata.Set(MetadataDeadLetterOriginalUUID, msg.UUID)
+			dead.SetContext(msg.Context())
+
+			dlqTopic := DeadLetterSubject(topic, suffix)
+			if pubErr := pub.Publish(dlqTopic, dead); pubErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("publish to dead-letter subject %s: %w", dlqTopic, pubErr))
+			}
+
+			wmLogger.Error("message moved to dead-letter subject", err, watermill.LogFields{
+				"uuid":      msg.UUID,
+				"topic":     topic,
+				"handler":   handler,
+				"dlq_topic": dlqTopic,
+				"dlq_uuid":  dead.UUID,
+			})
+			return nil, nil
+		}
+	}, nil
+}
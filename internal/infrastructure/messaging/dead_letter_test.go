@@ -0,0 +1,97 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRouter_DeadLettersMessageAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	const (
+		topic      = "CONCERT.discovered"
+		maxRetries = 2
+	)
+	logger := watermill.NopLogger{}
+	pubSub := gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: 16}, logger)
+	t.Cleanup(func() { _ = pubSub.Close() })
+
+	router, err := messaging.NewRouter(logger, pubSub, maxRetries, messaging.DefaultDeadLetterSuffix)
+	require.NoError(t, err)
+
+	var attempts atomic.Int32
+	router.AddConsumerHandler("always-fails", topic, pubSub, func(*message.Message) error {
+		attempts.Add(1)
+		return errors.New("venue search exploded")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	dead, err := pubSub.Subscribe(ctx, messaging.DeadLetterSubject(topic, messaging.DefaultDeadLetterSuffix))
+	require.NoError(t, err)
+
+	go func() { _ = router.Run(ctx) }()
+	<-router.Running()
+
+	original := message.NewMessage(watermill.NewUUID(), []byte(`{"artist_id":"a-1"}`))
+	original.Metadata.Set("ce_type", topic)
+	require.NoError(t, pubSub.Publish(topic, original))
+
+	select {
+	case msg := <-dead:
+		msg.Ack()
+		assert.Equal(t, int32(maxRetries+1), attempts.Load(), "dead-lettered only after the last retry")
+		assert.Equal(t, original.Payload, msg.Payload)
+		assert.NotEqual(t, original.UUID, msg.UUID, "a fresh UUID avoids JetStream deduplication")
+		assert.Equal(t, original.UUID, msg.Metadata.Get(messaging.MetadataDeadLetterOriginalUUID))
+		assert.Equal(t, topic, msg.Metadata.Get(messaging.MetadataDeadLetterTopic))
+		assert.Equal(t, "always-fails", msg.Metadata.Get(messaging.MetadataDeadLetterHandler))
+		assert.Contains(t, msg.Metadata.Get(messaging.MetadataDeadLetterReason), "venue search exploded")
+		assert.Equal(t, topic, msg.Metadata.Get("ce_type"), "original metadata is kept")
+	case <-time.After(10 * time.Second):
+		t.Fatal("message was not dead-lettered")
+	}
+
+	// The original was acked: it is not redelivered to the handler.
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, int32(maxRetries+1), attempts.Load())
+}
+
+func TestValidateDeadLetterSuffix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		suffix  string
+		wantErr bool
+	}{
+		{suffix: "_dlq"},
+		{suffix: "-dead"},
+		{suffix: "", wantErr: true},
+		{suffix: ".dlq", wantErr: true},
+		{suffix: "_*", wantErr: true},
+		{suffix: "_dl q", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.suffix, func(t *testing.T) {
+			t.Parallel()
+
+			err := messaging.ValidateDeadLetterSuffix(tt.suffix)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
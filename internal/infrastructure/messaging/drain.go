@@ -0,0 +1,308 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// drainAbandonGrace bounds how long Drain waits, once the deadline has passed,
+// for abandoned handlers to observe their cancelled contexts and return.
+const drainAbandonGrace = time.Second
+
+// ackFlushGrace is how long Drain keeps a subscriber open after its last
+// delivered message settled, so the subscriber can send the ack to the broker
+// before it is closed.
+const ackFlushGrace = 250 * time.Millisecond
+
+// DrainTimeout returns the share of a shutdown budget the router drain may
+// use. The rest is left to the shutdown phases that run after it, which flush
+// the publisher and close the datastores.
+func DrainTimeout(shutdownTimeout time.Duration) time.Duration {
+	return shutdownTimeout * 2 / 3
+}
+
+// AbandonedHandlersError reports handlers that were still running when a
+// drain gave up on them.
+type AbandonedHandlersError struct {
+	// InFlight is the number of handlers abandoned.
+	InFlight int
+	// Cause is why the drain gave up: the context's cause at the deadline, or
+	// the router's close error.
+	Cause error
+}
+
+func (e *AbandonedHandlersError) Error() string {
+	return fmt.Sprintf("abandoned %d in-flight handlers: %v", e.InFlight, e.Cause)
+}
+
+func (e *AbandonedHandlersError) Unwrap() error { return e.Cause }
+
+// Router is a Watermill Router that tracks its in-flight handler invocations
+// so shutdown can drain them within a deadline.
+type Router struct {
+	*message.Router
+
+	mu          sync.Mutex
+	nextID      uint64
+	inFlight    map[uint64]context.CancelFunc
+	subscribers map[message.Subscriber]*drainingSubscriber
+}
+
+func newRouter(router *message.Router) *Router {
+	r := &Router{
+		Router:      router,
+		inFlight:    map[uint64]context.CancelFunc{},
+		subscribers: map[message.Subscriber]*drainingSubscriber{},
+	}
+	router.AddMiddleware(r.track)
+	return r
+}
+
+// AddConsumerHandler adds a handler like message.Router.AddConsumerHandler.
+// The subscriber is shared by every handler added with it and is closed by
+// Drain once the acks of the messages it delivered are flushed, not when the
+// router closes.
+func (r *Router) AddConsumerHandler(
+	handlerName string,
+	subscribeTopic string,
+	subscriber message.Subscriber,
+	handlerFunc message.NoPublishHandlerFunc,
+) *message.Handler {
+	r.mu.Lock()
+	sub, ok := r.subscribers[subscriber]
+	if !ok {
+		sub = newDrainingSubscriber(subscriber)
+		r.subscribers[subscriber] = sub
+	}
+	r.mu.Unlock()
+
+	return r.Router.AddConsumerHandler(handlerName, subscribeTopic, sub, handlerFunc)
+}
+
+// track is the outermost router middleware. It detaches the message context
+// from the subscription, which Close cancels, so a handler in flight at
+// shutdown finishes its writes instead of failing halfway. Only Drain
+// cancels it, once the deadline has passed.
+func (r *Router) track(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		msgCtx := msg.Context()
+		ctx, cancel := context.WithCancel(context.WithoutCancel(msgCtx))
+		defer cancel()
+
+		r.mu.Lock()
+		id := r.nextID
+		r.nextID++
+		r.inFlight[id] = cancel
+		r.mu.Unlock()
+		defer func() {
+			r.mu.Lock()
+			delete(r.inFlight, id)
+			r.mu.Unlock()
+		}()
+
+		msg.SetContext(ctx)
+		defer msg.SetContext(msgCtx)
+
+		return h(msg)
+	}
+}
+
+// InFlight returns the number of handler invocations currently running.
+func (r *Router) InFlight() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.inFlight)
+}
+
+// Drain stops the router from accepting new messages and waits for the
+// in-flight handlers to finish, up to ctx's deadline. Handlers still running
+// at the deadline are abandoned: their contexts are cancelled and Drain
+// returns an *AbandonedHandlersError reporting how many there were. An
+// abandoned message is not acked, so JetStream redelivers it after the ack
+// wait.
+//
+// Closing the router only stops delivery. The subscribers of the consumer
+// handlers stay open until the messages they delivered are acked or nacked and
+// the acks are flushed, still within ctx's deadline, and are closed last.
+func (r *Router) Drain(ctx context.Context) error {
+	closed := make(chan error, 1)
+	go func() { closed <- r.Close() }()
+
+	select {
+	case err := <-closed:
+		// Close gives up after RouterConfig.CloseTimeout; handlers still
+		// running then are abandoned like at the drain deadline.
+		if n := r.abandon(); n > 0 {
+			return errors.Join(&AbandonedHandlersError{InFlight: n, Cause: err}, r.closeSubscribers())
+		}
+		return errors.Join(err, r.flushSubscribers(ctx))
+	case <-ctx.Done():
+	}
+
+	n := r.abandon()
+	select {
+	case <-closed:
+	case <-time.After(drainAbandonGrace):
+	}
+	closeErr := r.closeSubscribers()
+	if n == 0 {
+		return closeErr
+	}
+	return errors.Join(&AbandonedHandlersError{InFlight: n, Cause: context.Cause(ctx)}, closeErr)
+}
+
+// flushSubscribers waits, up to ctx's deadline, for the messages the
+// subscribers delivered to settle and for their acks to be sent, then closes
+// the subscribers.
+func (r *Router) flushSubscribers(ctx context.Context) error {
+	for _, sub := range r.drainingSubscribers() {
+		sub.waitSettled(ctx)
+	}
+	select {
+	case <-time.After(ackFlushGrace):
+	case <-ctx.Done():
+	}
+	return r.closeSubscribers()
+}
+
+// closeSubscribers closes the subscribers of the consumer handlers.
+func (r *Router) closeSubscribers() error {
+	var errs []error
+	for _, sub := range r.drainingSubscribers() {
+		errs = append(errs, sub.closeUnderlying())
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Router) drainingSubscribers() []*drainingSubscriber {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	subs := make([]*drainingSubscriber, 0, len(r.subscribers))
+	for _, sub := range r.subscribers {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// abandon cancels the contexts of the handlers still in flight and returns
+// how many there were.
+func (r *Router) abandon() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := len(r.inFlight)
+	if n == 0 {
+		return 0
+	}
+	for _, cancel := range r.inFlight {
+		cancel()
+	}
+	return n
+}
+
+// drainingSubscriber decouples a subscriber's lifetime from the router's.
+// The router closes it when it stops, which only ends delivery; Drain closes
+// the underlying subscriber once the delivered messages have settled, so their
+// acks are not discarded by a subscriber that is already closing.
+type drainingSubscriber struct {
+	message.Subscriber
+
+	stopOnce  sync.Once
+	stop      chan struct{}
+	closeOnce sync.Once
+	closed    chan struct{}
+	closeErr  error
+	// settling counts the delivered messages not yet acked or nacked.
+	settling sync.WaitGroup
+}
+
+func newDrainingSubscriber(sub message.Subscriber) *drainingSubscriber {
+	return &drainingSubscriber{
+		Subscriber: sub,
+		stop:       make(chan struct{}),
+		closed:     make(chan struct{}),
+	}
+}
+
+// Subscribe forwards the underlying subscription until Close is called.
+func (s *drainingSubscriber) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	in, err := s.Subscriber.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *message.Message)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					return
+				}
+				if !s.forward(out, msg) {
+					return
+				}
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// forward delivers msg on out and tracks it until it settles. A message caught
+// by Close before delivery is nacked so the broker redelivers it at once.
+func (s *drainingSubscriber) forward(out chan<- *message.Message, msg *message.Message) bool {
+	s.settling.Add(1)
+	select {
+	case out <- msg:
+	case <-s.stop:
+		s.settling.Done()
+		msg.Nack()
+		return false
+	}
+	go func() {
+		defer s.settling.Done()
+		select {
+		case <-msg.Acked():
+		case <-msg.Nacked():
+		case <-s.closed:
+		}
+	}()
+	return true
+}
+
+// Close stops delivery. The underlying subscriber stays open for Drain.
+func (s *drainingSubscriber) Close() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
+
+// waitSettled waits until every delivered message is acked or nacked, or ctx
+// is done.
+func (s *drainingSubscriber) waitSettled(ctx context.Context) {
+	settled := make(chan struct{})
+	go func() {
+		s.settling.Wait()
+		close(settled)
+	}()
+	select {
+	case <-settled:
+	case <-ctx.Done():
+	}
+}
+
+// closeUnderlying closes the underlying subscriber once.
+func (s *drainingSubscriber) closeUnderlying() error {
+	_ = s.Close()
+	s.closeOnce.Do(func() {
+		s.closeErr = s.Subscriber.Close()
+		close(s.closed)
+	})
+	return s.closeErr
+}
@@ -0,0 +1,169 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Drain(t *testing.T) {
+	t.Parallel()
+
+	const topic = "CONCERT.discovered"
+
+	// startSlowHandler runs a router whose handler sleeps for work unless its
+	// context is cancelled first, and returns once the handler is in flight.
+	// The handler's context error is sent on the returned channel when it ends.
+	startSlowHandler := func(t *testing.T, work time.Duration) (*messaging.Router, <-chan error) {
+		t.Helper()
+		logger := watermill.NopLogger{}
+		pubSub := gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: 16}, logger)
+		t.Cleanup(func() { _ = pubSub.Close() })
+
+		router, err := messaging.NewRouter(logger, pubSub, 0, messaging.DefaultDeadLetterSuffix)
+		require.NoError(t, err)
+
+		started := make(chan struct{})
+		finished := make(chan error, 1)
+		router.AddConsumerHandler("slow", topic, pubSub, func(msg *message.Message) error {
+			close(started)
+			select {
+			case <-time.After(work):
+			case <-msg.Context().Done():
+			}
+			finished <- msg.Context().Err()
+			return msg.Context().Err()
+		})
+
+		go func() { _ = router.Run(context.Background()) }()
+		<-router.Running()
+		require.NoError(t, pubSub.Publish(topic, message.NewMessage(watermill.NewUUID(), []byte(`{}`))))
+
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("handler did not start")
+		}
+		assert.Equal(t, 1, router.InFlight())
+		return router, finished
+	}
+
+	t.Run("handler finishing within the deadline completes", func(t *testing.T) {
+		t.Parallel()
+		router, finished := startSlowHandler(t, 300*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		require.NoError(t, router.Drain(ctx))
+
+		select {
+		case err := <-finished:
+			assert.NoError(t, err, "closing the router does not cancel the in-flight handler")
+		default:
+			t.Fatal("Drain returned before the handler finished")
+		}
+		assert.Zero(t, router.InFlight())
+		assert.True(t, router.IsClosed())
+	})
+
+	t.Run("handler over the deadline is abandoned", func(t *testing.T) {
+		t.Parallel()
+		router, finished := startSlowHandler(t, time.Minute)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		err := router.Drain(ctx)
+
+		var abandoned *messaging.AbandonedHandlersError
+		require.ErrorAs(t, err, &abandoned)
+		assert.Equal(t, 1, abandoned.InFlight)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+		select {
+		case err := <-finished:
+			assert.ErrorIs(t, err, context.Canceled, "the abandoned handler's context is cancelled")
+		case <-time.After(5 * time.Second):
+			t.Fatal("abandoned handler did not observe cancellation")
+		}
+	})
+}
+
+// ackCheckingSubscriber records, when it is closed, whether the message handed
+// to the handler had been acked by then.
+type ackCheckingSubscriber struct {
+	message.Subscriber
+
+	handled        chan *message.Message
+	closes         int
+	ackedWhenClose bool
+}
+
+func (s *ackCheckingSubscriber) Close() error {
+	s.closes++
+	select {
+	case msg := <-s.handled:
+		select {
+		case <-msg.Acked():
+			s.ackedWhenClose = true
+		default:
+		}
+	default:
+	}
+	return s.Subscriber.Close()
+}
+
+func TestRouter_Drain_ClosesSubscriberAfterAck(t *testing.T) {
+	t.Parallel()
+
+	const topic = "CONCERT.discovered"
+
+	logger := watermill.NopLogger{}
+	pubSub := gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: 16}, logger)
+	sub := &ackCheckingSubscriber{Subscriber: pubSub, handled: make(chan *message.Message, 1)}
+
+	router, err := messaging.NewRouter(logger, pubSub, 0, messaging.DefaultDeadLetterSuffix)
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	router.AddConsumerHandler("slow", topic, sub, func(msg *message.Message) error {
+		sub.handled <- msg
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	})
+	router.AddConsumerHandler("idle", "CONCERT.created", sub, func(*message.Message) error {
+		return nil
+	})
+
+	go func() { _ = router.Run(context.Background()) }()
+	<-router.Running()
+	require.NoError(t, pubSub.Publish(topic, message.NewMessage(watermill.NewUUID(), []byte(`{}`))))
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler did not start")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, router.Drain(ctx))
+
+	assert.Equal(t, 1, sub.closes, "the shared subscriber is closed once, by Drain")
+	assert.True(t, sub.ackedWhenClose, "the subscriber is closed only after the in-flight message is acked")
+}
+
+func TestDrainTimeout(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 20*time.Second, messaging.DrainTimeout(30*time.Second))
+	assert.Zero(t, messaging.DrainTimeout(0))
+}
@@ -29,6 +29,10 @@ type ConsumerHealth struct {
 	// is injected after the router is built (nil before then, treated as up so
 	// startup readiness — not liveness — gates traffic during initialization).
 	routerRunning func() bool
+	// routerStarted probes whether the message router has started consuming.
+	// Unlike routerRunning it reports false before the router runs, so
+	// readiness waits for it; nil is treated as not started.
+	routerStarted func() bool
 	// failures counts consecutive unhealthy observations for the grace window.
 	failures int
 	grace    int
@@ -86,6 +90,14 @@ func (h *ConsumerHealth) SetRouterProbe(probe func() bool) {
 	h.routerRunning = probe
 }
 
+// SetRouterStartedProbe injects a probe reporting whether the message router
+// has started and is still running. It gates readiness only.
+func (h *ConsumerHealth) SetRouterStartedProbe(probe func() bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.routerStarted = probe
+}
+
 // healthy reports the instantaneous health without applying the grace window.
 // The caller must hold h.mu.
 func (h *ConsumerHealth) healthy() bool {
@@ -118,3 +130,18 @@ func (h *ConsumerHealth) Live() bool {
 	h.failures++
 	return h.failures < h.grace
 }
+
+// Ready reports whether the consumer should receive traffic: the NATS
+// connection is up, the router has started, and every expected durable is
+// bound. Unlike Live it applies no grace window, so a NATS blip takes the pod
+// out of rotation at once while liveness keeps it running until the blip
+// outlasts the grace.
+func (h *ConsumerHealth) Ready() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.routerStarted == nil || !h.routerStarted() {
+		return false
+	}
+	return h.healthy()
+}
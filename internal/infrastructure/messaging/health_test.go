@@ -109,3 +109,35 @@ func TestConsumerHealth_GraceAbsorbsTransientBlip(t *testing.T) {
 	h.SetConnected(true)
 	assert.True(t, h.Live())
 }
+
+func TestConsumerHealth_ReadyFollowsConnection(t *testing.T) {
+	t.Parallel()
+
+	h := messaging.NewConsumerHealth()
+	h.Expect("CONCERT.created")
+	h.MarkBound("CONCERT.created")
+
+	started := false
+	h.SetRouterStartedProbe(func() bool { return started })
+	assert.False(t, h.Ready(), "not ready before the router starts")
+
+	started = true
+	assert.True(t, h.Ready())
+
+	// Readiness drops on the first observation of a NATS blip, while the
+	// grace window keeps the pod live.
+	h.SetConnected(false)
+	assert.False(t, h.Ready(), "not ready while NATS is down")
+	assert.True(t, h.Live(), "a transient blip does not fail liveness")
+
+	h.SetConnected(true)
+	assert.True(t, h.Ready(), "ready again once NATS reconnects")
+}
+
+func TestConsumerHealth_NotReadyWithoutRouterProbe(t *testing.T) {
+	t.Parallel()
+
+	h := messaging.NewConsumerHealth()
+
+	assert.False(t, h.Ready(), "a router that was never wired is not ready")
+}
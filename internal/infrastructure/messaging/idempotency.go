@@ -0,0 +1,122 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// MetadataDedupKey is the metadata key holding a message's dedup key: the
+// CloudEvents id set by NewEvent, stable across redeliveries.
+const MetadataDedupKey = "ce_id"
+
+// idempotencyPruneInterval is the minimum time between prunes of expired
+// processed-message records by one middleware.
+const idempotencyPruneInterval = time.Hour
+
+// IdempotencyStore records the messages a handler has processed.
+type IdempotencyStore interface {
+	// IsProcessed reports whether handlerName has recorded the message
+	// identified by dedupKey within window.
+	IsProcessed(ctx context.Context, handlerName, dedupKey string, window time.Duration) (bool, error)
+
+	// MarkProcessed records that handlerName processed the message identified
+	// by dedupKey, reporting false when it was already recorded within window.
+	MarkProcessed(ctx context.Context, handlerName, dedupKey string, window time.Duration) (bool, error)
+
+	// PruneProcessed deletes records older than window.
+	PruneProcessed(ctx context.Context, window time.Duration) (int64, error)
+}
+
+// Idempotent returns a handler middleware that processes each message at most
+// once within window. JetStream delivers at least once, so a handler can see
+// a message again after a lost ack or a consumer restart.
+//
+// A message whose dedup key is already recorded is acked without running the
+// handler. Otherwise the handler runs, and its key is recorded only once it
+// succeeds, so a failed attempt is retried. The handler runs outside any
+// transaction: it may call external services without holding a database
+// connection or a lock on the record, and its writes must be idempotent on
+// their own, since a delivery that races the first one before it is recorded
+// runs the handler again. Messages without a dedup key, from MetadataDedupKey
+// or else the message UUID, are handled unguarded.
+//
+// Add it per handler, inside the router's Retry middleware, so each attempt
+// checks the record again.
+func Idempotent(store IdempotencyStore, window time.Duration, wmLogger watermill.LoggerAdapter) message.HandlerMiddleware {
+	var (
+		mu        sync.Mutex
+		lastPrune time.Time
+	)
+	prune := func(ctx context.Context) {
+		mu.Lock()
+		due := time.Since(lastPrune) >= idempotencyPruneInterval
+		if due {
+			lastPrune = time.Now()
+		}
+		mu.Unlock()
+		if !due {
+			return
+		}
+		if _, err := store.PruneProcessed(ctx, window); err != nil {
+			wmLogger.Error("failed to prune processed messages", err, nil)
+		}
+	}
+
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			key := msg.Metadata.Get(MetadataDedupKey)
+			if key == "" {
+				key = msg.UUID
+			}
+			if key == "" {
+				return h(msg)
+			}
+
+			msgCtx := msg.Context()
+			prune(msgCtx)
+			handler := message.HandlerNameFromCtx(msgCtx)
+
+			processed, err := store.IsProcessed(msgCtx, handler, key, window)
+			if err != nil {
+				return nil, fmt.Errorf("check message %s processed: %w", key, err)
+			}
+			if processed {
+				wmLogger.Info("skipping already processed message", watermill.LogFields{
+					"uuid":      msg.UUID,
+					"dedup_key": key,
+					"handler":   handler,
+				})
+				return nil, nil
+			}
+
+			produced, err := h(msg)
+			if err != nil {
+				return nil, err
+			}
+
+			// The handler's work is done; failing the message now would only
+			// run it again. A lost record costs at most one repeat on
+			// redelivery.
+			fresh, err := store.MarkProcessed(msgCtx, handler, key, window)
+			if err != nil {
+				wmLogger.Error("failed to record processed message", err, watermill.LogFields{
+					"uuid":      msg.UUID,
+					"dedup_key": key,
+					"handler":   handler,
+				})
+			} else if !fresh {
+				wmLogger.Info("message was processed concurrently by another delivery", watermill.LogFields{
+					"uuid":      msg.UUID,
+					"dedup_key": key,
+					"handler":   handler,
+				})
+			}
+			return produced, nil
+		}
+	}
+}
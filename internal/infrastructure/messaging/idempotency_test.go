@@ -0,0 +1,127 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/liverty-music/backend/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memStore is an in-memory IdempotencyStore.
+type memStore struct {
+	mu        sync.Mutex
+	processed map[string]time.Time
+}
+
+func newMemStore() *memStore {
+	return &memStore{processed: map[string]time.Time{}}
+}
+
+func (s *memStore) IsProcessed(_ context.Context, handlerName, dedupKey string, window time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	at, ok := s.processed[handlerName+"/"+dedupKey]
+	return ok && time.Since(at) < window, nil
+}
+
+func (s *memStore) MarkProcessed(_ context.Context, handlerName, dedupKey string, window time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := handlerName + "/" + dedupKey
+	if at, ok := s.processed[key]; ok && time.Since(at) < window {
+		return false, nil
+	}
+	s.processed[key] = time.Now()
+	return true, nil
+}
+
+func (s *memStore) PruneProcessed(context.Context, time.Duration) (int64, error) { return 0, nil }
+
+func TestIdempotent(t *testing.T) {
+	t.Parallel()
+
+	newMsg := func(ceID string) *message.Message {
+		msg := message.NewMessage(watermill.NewUUID(), []byte(`{}`))
+		msg.Metadata.Set(messaging.MetadataDedupKey, ceID)
+		return msg
+	}
+
+	t.Run("redelivered message runs the handler once", func(t *testing.T) {
+		t.Parallel()
+		store := newMemStore()
+		var calls int
+		h := messaging.Idempotent(store, time.Hour, watermill.NopLogger{})(func(msg *message.Message) ([]*message.Message, error) {
+			calls++
+			return nil, nil
+		})
+
+		_, err := h(newMsg("event-1"))
+		require.NoError(t, err)
+		// The redelivery carries a new transport UUID but the same CloudEvents id.
+		_, err = h(newMsg("event-1"))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("distinct messages are each handled", func(t *testing.T) {
+		t.Parallel()
+		store := newMemStore()
+		var calls int
+		h := messaging.Idempotent(store, time.Hour, watermill.NopLogger{})(func(*message.Message) ([]*message.Message, error) {
+			calls++
+			return nil, nil
+		})
+
+		for _, id := range []string{"event-1", "event-2"} {
+			_, err := h(newMsg(id))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("failed attempt records nothing so the retry runs", func(t *testing.T) {
+		t.Parallel()
+		store := newMemStore()
+		var calls int
+		h := messaging.Idempotent(store, time.Hour, watermill.NopLogger{})(func(*message.Message) ([]*message.Message, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("place search unavailable")
+			}
+			return nil, nil
+		})
+
+		msg := newMsg("event-1")
+		_, err := h(msg)
+		require.Error(t, err)
+		_, err = h(msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("message is recorded only after the handler returns", func(t *testing.T) {
+		t.Parallel()
+		store := newMemStore()
+		h := messaging.Idempotent(store, time.Hour, watermill.NopLogger{})(func(msg *message.Message) ([]*message.Message, error) {
+			processed, err := store.IsProcessed(msg.Context(), "", "event-1", time.Hour)
+			require.NoError(t, err)
+			assert.False(t, processed, "the handler runs before the record is written")
+			return nil, nil
+		})
+
+		_, err := h(newMsg("event-1"))
+		require.NoError(t, err)
+		processed, err := store.IsProcessed(context.Background(), "", "event-1", time.Hour)
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+}
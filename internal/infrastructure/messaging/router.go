@@ -10,30 +10,37 @@ import (
 )
 
 // NewRouter creates a Watermill Router with standard middleware.
-// The router manages message handlers and provides retry, poison queue,
-// and logging middleware.
-func NewRouter(wmLogger watermill.LoggerAdapter, poisonQueuePub message.Publisher, poisonQueueTopic string) (*message.Router, error) {
-	router, err := message.NewRouter(message.RouterConfig{
+// The router manages message handlers and provides retry, dead-letter,
+// and logging middleware. A failed handler invocation is retried up to
+// maxRetries times with exponential backoff; a message that still fails is
+// published to its dead-letter subject, DLQ.<subject><deadLetterSuffix>, on
+// deadLetterPub and acked. Handlers in flight at shutdown are drained by
+// Router.Drain.
+func NewRouter(wmLogger watermill.LoggerAdapter, deadLetterPub message.Publisher, maxRetries int, deadLetterSuffix string) (*Router, error) {
+	wmRouter, err := message.NewRouter(message.RouterConfig{
 		// CloseTimeout bounds how long Router.Close() waits for in-flight
-		// handlers. The consumer shares a single deadline between Router
-		// drain and shutdown phases, so this value caps the worst-case
-		// drain duration. The remaining budget flows to shutdown phases.
+		// handlers. The consumer bounds its drain by the shared shutdown
+		// deadline instead, so this is only a backstop for a router closed
+		// without Drain.
 		CloseTimeout: 30 * time.Second,
 	}, wmLogger)
 	if err != nil {
 		return nil, err
 	}
 
-	// Poison queue: move messages that exceed max retries.
-	pq, err := middleware.PoisonQueue(poisonQueuePub, poisonQueueTopic)
+	// In-flight tracking: outermost, so a drained handler keeps its retries.
+	router := newRouter(wmRouter)
+
+	// Dead-letter queue: move messages that exceed max retries.
+	dlq, err := DeadLetterQueue(deadLetterPub, deadLetterSuffix, wmLogger)
 	if err != nil {
 		return nil, err
 	}
-	router.AddMiddleware(pq)
+	router.AddMiddleware(dlq)
 
 	// Retry failed handler invocations with exponential backoff.
 	router.AddMiddleware(middleware.Retry{
-		MaxRetries:      3,
+		MaxRetries:      maxRetries,
 		InitialInterval: 500 * time.Millisecond,
 		Multiplier:      2.0,
 		Logger:          wmLogger,
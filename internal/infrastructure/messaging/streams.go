@@ -12,9 +12,6 @@ import (
 	"github.com/nats-io/nats.go"
 )
 
-// PoisonQueueSubject is the NATS subject for messages that exceeded max retries.
-const PoisonQueueSubject = "POISON.queue"
-
 // natsConnectTimeout is the per-dial TCP timeout for NATS connections.
 // Set higher than the default 2s to accommodate kube-proxy rule propagation
 // on freshly provisioned GKE Autopilot Spot nodes.
@@ -155,6 +152,22 @@ var streams = []nats.StreamConfig{
 		Duplicates: 2 * time.Minute,
 	},
 	{
+		// Carries dead-lettered messages, DLQ.<subject><suffix> (see
+		// DeadLetterQueue). Apart from the domain streams so they are kept
+		// as long as POISON kept them, until someone triages them.
+		Name:       "DLQ",
+		Subjects:   []string{"DLQ.>"},
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     30 * 24 * time.Hour,
+		Storage:    nats.FileStorage,
+		Discard:    nats.DiscardOld,
+		Replicas:   1,
+		Duplicates: 2 * time.Minute,
+	},
+	{
+		// Held the shared POISON.queue subject before failed messages moved
+		// to per-subject dead-letter subjects in the DLQ stream. Kept so
+		// messages poisoned before then remain inspectable until MaxAge.
 		Name:       "POISON",
 		Subjects:   []string{"POISON.*"},
 		Retention:  nats.LimitsPolicy,
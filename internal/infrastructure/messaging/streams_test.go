@@ -31,6 +31,24 @@ func TestAllSubjectsCoveredByStream(t *testing.T) {
 	}
 }
 
+// TestDeadLetterSubjectsCoveredByStream guards the default dead-letter
+// subject: a failed message is published to DLQ.<subject><suffix>, which must
+// be captured by a stream or the dead-letter publish fails and the message is
+// redelivered indefinitely.
+func TestDeadLetterSubjectsCoveredByStream(t *testing.T) {
+	t.Parallel()
+
+	for _, subject := range entity.AllSubjects {
+		dlq := messaging.DeadLetterSubject(subject, messaging.DefaultDeadLetterSuffix)
+		t.Run(dlq, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Truef(t, messaging.SubjectCoveredByStream(dlq),
+				"dead-letter subject %q is not covered by any JetStream stream", dlq)
+		})
+	}
+}
+
 // TestSubjectCoveredByStream exercises the NATS token-matching semantics
 // directly, including the '*' (single token) vs '>' (trailing tokens) nuance
 // that made SALES_PHASE.reminder.due require a '>' filter.
@@ -52,15 +52,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/cenkalti/backoff/v5"
-
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/pkg/api"
 	"github.com/liverty-music/backend/pkg/httpx"
-	"github.com/liverty-music/backend/pkg/throttle"
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/pannpers/go-apperr/apperr/codes"
 	"github.com/pannpers/go-logging/logging"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -71,6 +69,14 @@ const (
 	rateLimitInterval = 1 * time.Second
 )
 
+// NewRateLimiter returns a token-bucket limiter admitting rps requests per
+// second with a burst of one, so requests never bunch up even after an idle
+// period. Share one limiter across every client calling MusicBrainz from the
+// same process, since the limit applies per IP.
+func NewRateLimiter(rps float64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(rps), 1)
+}
+
 type artistResponse struct {
 	ID        string        `json:"id"`
 	Name      string        `json:"name"`
@@ -98,16 +104,25 @@ type Place struct {
 	Latitude *float64
 	// Longitude is the WGS 84 longitude of the place.
 	Longitude *float64
+	// Area is the name of the MusicBrainz area the place is in (a city or
+	// prefecture). Empty when MusicBrainz has no area for the place.
+	Area string
+	// Address is the free-text postal address. Empty when unknown.
+	Address string
 }
 
 type placeSearchResponse struct {
 	Places []struct {
 		ID          string `json:"id"`
 		Name        string `json:"name"`
+		Address     string `json:"address"`
 		Coordinates struct {
 			Latitude  string `json:"latitude"`
 			Longitude string `json:"longitude"`
 		} `json:"coordinates"`
+		Area struct {
+			Name string `json:"name"`
+		} `json:"area"`
 	} `json:"places"`
 }
 
@@ -117,59 +132,55 @@ type client struct {
 	httpClient   *http.Client
 	baseURL      string
 	placeBaseURL string
-	throttler    *throttle.Throttler
+	limiter      *rate.Limiter
+	retrier      *httpx.Retrier
 	logger       *logging.Logger
 }
 
-// NewClient creates a new MusicBrainz client instance.
-func NewClient(httpClient *http.Client, logger *logging.Logger) *client {
+// NewClient creates a new MusicBrainz client instance. Every request waits
+// on limiter before it is sent; a nil limiter admits one request per
+// rateLimitInterval.
+func NewClient(httpClient *http.Client, limiter *rate.Limiter, logger *logging.Logger) *client {
 	if httpClient == nil {
 		httpClient = &http.Client{
 			Timeout: 10 * time.Second,
 		}
 	}
+	if limiter == nil {
+		limiter = rate.NewLimiter(rate.Every(rateLimitInterval), 1)
+	}
 	return &client{
 		httpClient:   httpClient,
 		baseURL:      baseURL,
 		placeBaseURL: placeBaseURL,
-		throttler:    throttle.New(rateLimitInterval, 100), // Buffer up to 100 requests
+		limiter:      limiter,
+		retrier:      httpx.NewRetrier(),
 		logger:       logger.With(slog.String("component", "musicbrainz")),
 	}
 }
 
-// doWithRetry executes an HTTP request through the throttler with retry on
-// transient errors (429, 503, 504). Retry wraps the throttle call (Pattern A)
-// so that backoff waits do not block the throttle slot for other callers.
+// doWithRetry executes an HTTP request through the rate limiter with retry
+// on transient errors (429, 503, 504). Retry wraps the limiter wait (Pattern
+// A) so that every attempt, retries included, spends a token while backoff
+// waits do not hold one, which is why the retrier runs here rather than as
+// the client's transport. The wait blocks until a token is available or ctx
+// is done.
 func (c *client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
-	return backoff.Retry(ctx, func() (*http.Response, error) {
-		var resp *http.Response
-		tErr := c.throttler.Do(ctx, func() error {
-			var err error
-			resp, err = c.httpClient.Do(req)
-			return err
-		})
-		if tErr != nil {
-			return nil, backoff.Permanent(tErr)
+	return c.retrier.Do(ctx, func() (*http.Response, error) {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
 		}
 
 		if httpx.IsRetryableStatus(resp.StatusCode) {
 			c.logger.Warn(ctx, "musicbrainz returned retryable status",
 				slog.Int("statusCode", resp.StatusCode))
-			_ = resp.Body.Close()
-			return nil, httpx.RetryAfterFromResponse(resp)
 		}
-
 		return resp, nil
-	},
-		backoff.WithBackOff(&backoff.ExponentialBackOff{
-			InitialInterval:     1 * time.Second,
-			RandomizationFactor: 0.5,
-			Multiplier:          2.0,
-			MaxInterval:         10 * time.Second,
-		}),
-		backoff.WithMaxTries(4),
-		backoff.WithMaxElapsedTime(0),
-	)
+	})
 }
 
 // GetArtist retrieves canonical artist data using an MBID.
@@ -297,9 +308,30 @@ func (c *client) SearchPlace(ctx context.Context, name, adminArea string) (*Plac
 	if adminArea != "" {
 		lucene += fmt.Sprintf(` AND area:"%s"`, escapeLucenePhrase(adminArea))
 	}
+	places, err := c.searchPlaces(ctx, lucene, 1)
+	if err != nil {
+		return nil, err
+	}
+	return places[0], nil
+}
+
+// SearchPlaceCandidates searches for venues whose name matches the phrase and
+// returns up to limit candidates in MusicBrainz relevance order. Unlike
+// SearchPlace it does not filter by area, so the caller can disambiguate
+// same-named venues itself. It returns apperr.ErrNotFound if no results are
+// returned.
+func (c *client) SearchPlaceCandidates(ctx context.Context, name string, limit int) ([]*Place, error) {
+	c.logger.Info(ctx, "searching place candidates", slog.String("venueName", name), slog.Int("limit", limit))
+	lucene := fmt.Sprintf(`place:"%s"`, escapeLucenePhrase(name))
+	return c.searchPlaces(ctx, lucene, limit)
+}
+
+// searchPlaces runs a Lucene place query and returns at most limit places.
+// The result is never empty: no results is reported as NotFound.
+func (c *client) searchPlaces(ctx context.Context, lucene string, limit int) ([]*Place, error) {
 	params := url.Values{}
 	params.Set("query", lucene)
-	params.Set("limit", "1")
+	params.Set("limit", strconv.Itoa(limit))
 	params.Set("fmt", "json")
 	endpoint := fmt.Sprintf("%s?%s", c.placeBaseURL, params.Encode())
 
@@ -316,7 +348,7 @@ func (c *client) SearchPlace(ctx context.Context, name, adminArea string) (*Plac
 	defer func() { _ = resp.Body.Close() }()
 
 	if err := api.FromHTTP(nil, resp, "musicbrainz place search failed"); err != nil {
-		c.logger.Error(ctx, "musicbrainz place search failed", err, slog.String("venueName", name))
+		c.logger.Error(ctx, "musicbrainz place search failed", err, slog.String("query", lucene))
 		return nil, err
 	}
 
@@ -327,17 +359,21 @@ func (c *client) SearchPlace(ctx context.Context, name, adminArea string) (*Plac
 	if len(data.Places) == 0 {
 		return nil, apperr.New(codes.NotFound, "no matching place found in musicbrainz")
 	}
-	r := data.Places[0]
-	place := &Place{ID: r.ID, Name: r.Name}
-	if r.Coordinates.Latitude != "" && r.Coordinates.Longitude != "" {
-		if lat, err := strconv.ParseFloat(r.Coordinates.Latitude, 64); err == nil {
-			place.Latitude = &lat
-		}
-		if lng, err := strconv.ParseFloat(r.Coordinates.Longitude, 64); err == nil {
-			place.Longitude = &lng
+
+	places := make([]*Place, 0, min(len(data.Places), limit))
+	for _, r := range data.Places[:min(len(data.Places), limit)] {
+		place := &Place{ID: r.ID, Name: r.Name, Area: r.Area.Name, Address: r.Address}
+		if r.Coordinates.Latitude != "" && r.Coordinates.Longitude != "" {
+			if lat, err := strconv.ParseFloat(r.Coordinates.Latitude, 64); err == nil {
+				place.Latitude = &lat
+			}
+			if lng, err := strconv.ParseFloat(r.Coordinates.Longitude, 64); err == nil {
+				place.Longitude = &lng
+			}
 		}
+		places = append(places, place)
 	}
-	return place, nil
+	return places, nil
 }
 
 // Compile-time interface compliance checks.
@@ -357,12 +393,3 @@ func (c *client) SetBaseURL(u string) {
 func (c *client) SetPlaceBaseURL(u string) {
 	c.placeBaseURL = u
 }
-
-// Close stops the background throttler goroutine and releases resources.
-// It should be called when the client is no longer needed.
-func (c *client) Close() error {
-	if c.throttler != nil {
-		c.throttler.Close()
-	}
-	return nil
-}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"testing/synctest"
+	"time"
 
 	"github.com/liverty-music/backend/internal/infrastructure/music/musicbrainz"
 	"github.com/pannpers/go-apperr/apperr"
@@ -34,10 +40,16 @@ type placeCoordinates struct {
 	Longitude string `json:"longitude"`
 }
 
+type placeArea struct {
+	Name string `json:"name"`
+}
+
 type placeEntry struct {
 	ID          string           `json:"id"`
 	Name        string           `json:"name"`
+	Address     string           `json:"address,omitempty"`
 	Coordinates placeCoordinates `json:"coordinates"`
+	Area        placeArea        `json:"area"`
 }
 
 type placeSearchResponse struct {
@@ -128,7 +140,7 @@ func TestClient_GetArtist(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client := musicbrainz.NewClient(server.Client(), testLogger(t))
+			client := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 			client.SetBaseURL(server.URL + "/")
 
 			artist, err := client.GetArtist(context.Background(), tt.args.mbid)
@@ -227,7 +239,7 @@ func TestClient_SearchPlace(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client := musicbrainz.NewClient(server.Client(), testLogger(t))
+			client := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 			client.SetPlaceBaseURL(server.URL + "/")
 
 			place, err := client.SearchPlace(context.Background(), tt.venueName, tt.adminArea)
@@ -306,7 +318,7 @@ func TestClient_SearchPlace_Coordinates(t *testing.T) {
 			}))
 			defer server.Close()
 
-			c := musicbrainz.NewClient(server.Client(), testLogger(t))
+			c := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 			c.SetPlaceBaseURL(server.URL + "/")
 
 			place, err := c.SearchPlace(context.Background(), "test", "")
@@ -341,7 +353,7 @@ func TestClient_GetArtist_ContextTimeout(t *testing.T) {
 		}))
 		defer server.Close()
 
-		client := musicbrainz.NewClient(server.Client(), testLogger(t))
+		client := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 		client.SetBaseURL(server.URL + "/")
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -445,7 +457,7 @@ func TestClient_ResolveOfficialSiteURL(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client := musicbrainz.NewClient(server.Client(), testLogger(t))
+			client := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 			client.SetBaseURL(server.URL + "/")
 
 			gotURL, err := client.ResolveOfficialSiteURL(context.Background(), tt.args.mbid)
@@ -479,7 +491,7 @@ func TestClient_GetArtist_RetryOnServiceUnavailable(t *testing.T) {
 		}))
 		defer server.Close()
 
-		client := musicbrainz.NewClient(server.Client(), testLogger(t))
+		client := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 		client.SetBaseURL(server.URL + "/")
 
 		artist, err := client.GetArtist(context.Background(), "a74b1b7f")
@@ -489,3 +501,83 @@ func TestClient_GetArtist_RetryOnServiceUnavailable(t *testing.T) {
 		assert.Equal(t, int32(2), calls.Load())
 	})
 }
+
+// roundTripFunc adapts a function to http.RoundTripper so a test can serve
+// responses without a listener, which a synctest bubble cannot dial.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("serializes concurrent resolves to the configured rate", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			const (
+				calls    = 5
+				interval = 200 * time.Millisecond
+			)
+			var (
+				mu   sync.Mutex
+				sent []time.Time
+			)
+			transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				mu.Lock()
+				sent = append(sent, time.Now())
+				mu.Unlock()
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"id":"mbid","name":"Artist","relations":[]}`)),
+					Request:    r,
+				}, nil
+			})
+			limiter := musicbrainz.NewRateLimiter(float64(time.Second / interval))
+			c := musicbrainz.NewClient(&http.Client{Transport: transport}, limiter, testLogger(t))
+
+			start := time.Now()
+			var wg sync.WaitGroup
+			for range calls {
+				wg.Go(func() {
+					_, err := c.ResolveOfficialSiteURL(context.Background(), "mbid")
+					assert.NoError(t, err)
+				})
+			}
+			wg.Wait()
+
+			require.Len(t, sent, calls)
+			slices.SortFunc(sent, func(a, b time.Time) int { return a.Compare(b) })
+			for i := 1; i < calls; i++ {
+				assert.GreaterOrEqual(t, sent[i].Sub(sent[i-1]), interval, "request %d sent before its token", i)
+			}
+			assert.Equal(t, (calls-1)*interval, time.Since(start))
+		})
+	})
+
+	t.Run("a blocked wait returns when the context is cancelled", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			var sent atomic.Int32
+			transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				sent.Add(1)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"id":"mbid","name":"Artist","relations":[]}`)),
+					Request:    r,
+				}, nil
+			})
+			c := musicbrainz.NewClient(&http.Client{Transport: transport}, musicbrainz.NewRateLimiter(1), testLogger(t))
+
+			_, err := c.ResolveOfficialSiteURL(context.Background(), "mbid")
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(100*time.Millisecond, cancel)
+			_, err = c.ResolveOfficialSiteURL(ctx, "mbid")
+
+			assert.Error(t, err)
+			assert.Equal(t, int32(1), sent.Load(), "the second request must not be sent without a token")
+		})
+	})
+}
@@ -2,10 +2,23 @@ package musicbrainz
 
 import (
 	"context"
+	"log/slog"
+	"regexp"
 
 	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/geo"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 )
 
+// placeCandidateLimit caps how many same-named places are fetched for
+// disambiguation. Venue names are specific enough that more than a handful of
+// matches means the name alone cannot identify the venue anyway.
+const placeCandidateLimit = 5
+
+// isoSubdivisionCode matches an ISO 3166-2 subdivision code such as "JP-13".
+var isoSubdivisionCode = regexp.MustCompile(`^[A-Z]{2}-[0-9A-Z]{1,3}$`)
+
 // PlaceSearcher adapts the MusicBrainz client to satisfy entity.VenuePlaceSearcher.
 type PlaceSearcher struct {
 	client *client
@@ -17,14 +30,75 @@ func NewPlaceSearcher(c *client) *PlaceSearcher {
 }
 
 // SearchPlace implements entity.VenuePlaceSearcher.
+//
+// It fetches every place sharing the venue name and picks one:
+//
+//   - with an admin area, the one candidate located in it (by MusicBrainz
+//     area name or postal address) is returned, however many were fetched;
+//   - without a recognizable admin area, only a single candidate is returned;
+//   - otherwise the match is ambiguous and FailedPrecondition is returned so
+//     the venue is left for manual review rather than bound to a guess.
 func (s *PlaceSearcher) SearchPlace(ctx context.Context, name, adminArea string) (*entity.VenuePlace, error) {
-	place, err := s.client.SearchPlace(ctx, name, adminArea)
+	candidates, err := s.client.SearchPlaceCandidates(ctx, name, placeCandidateLimit)
 	if err != nil {
 		return nil, err
 	}
+
+	place := pickPlace(candidates, adminArea)
+	if place == nil {
+		s.client.logger.Warn(ctx, "ambiguous musicbrainz place match, leaving venue for manual review",
+			slog.String("venueName", name),
+			slog.String("adminArea", adminArea),
+			slog.Int("candidates", len(candidates)),
+		)
+		return nil, apperr.New(codes.FailedPrecondition, "multiple musicbrainz places match the venue name",
+			slog.String("venueName", name),
+			slog.String("adminArea", adminArea),
+		)
+	}
+
 	var coords *entity.Coordinates
 	if place.Latitude != nil && place.Longitude != nil {
 		coords = &entity.Coordinates{Latitude: *place.Latitude, Longitude: *place.Longitude}
 	}
 	return &entity.VenuePlace{ExternalID: place.ID, Name: place.Name, Coordinates: coords}, nil
 }
+
+// pickPlace returns the only candidate located in adminArea, or the only
+// candidate when adminArea is empty or unrecognized. It returns nil when the
+// choice is ambiguous: zero or several candidates in the area, or several
+// candidates and no area to compare against.
+func pickPlace(candidates []*Place, adminArea string) *Place {
+	code := adminArea
+	if !isoSubdivisionCode.MatchString(code) {
+		normalized := geo.NormalizeAdminArea(adminArea)
+		if normalized == nil {
+			if len(candidates) == 1 {
+				return candidates[0]
+			}
+			return nil
+		}
+		code = *normalized
+	}
+
+	var match *Place
+	for _, p := range candidates {
+		if !placeInAdminArea(p, code) {
+			continue
+		}
+		if match != nil {
+			return nil
+		}
+		match = p
+	}
+	return match
+}
+
+// placeInAdminArea reports whether the place's MusicBrainz area or postal
+// address places it in the subdivision with the given ISO 3166-2 code.
+func placeInAdminArea(p *Place, code string) bool {
+	if area := geo.NormalizeAdminArea(p.Area); area != nil && *area == code {
+		return true
+	}
+	return geo.MentionsAdminArea(p.Address, code)
+}
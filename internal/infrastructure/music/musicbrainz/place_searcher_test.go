@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/liverty-music/backend/internal/infrastructure/music/musicbrainz"
+	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -70,7 +71,7 @@ func TestPlaceSearcher_SearchPlace_Coordinates(t *testing.T) {
 			}))
 			defer server.Close()
 
-			c := musicbrainz.NewClient(server.Client(), testLogger(t))
+			c := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
 			c.SetPlaceBaseURL(server.URL + "/")
 			searcher := musicbrainz.NewPlaceSearcher(c)
 
@@ -88,3 +89,127 @@ func TestPlaceSearcher_SearchPlace_Coordinates(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaceSearcher_SearchPlace_Candidates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		adminArea string
+		places    []placeEntry
+		wantID    string
+		wantErr   error
+	}{
+		{
+			name:      "zero candidates is NotFound",
+			adminArea: "JP-13",
+			places:    nil,
+			wantErr:   apperr.ErrNotFound,
+		},
+		{
+			name:      "single candidate in the admin area is returned",
+			adminArea: "JP-27",
+			places: []placeEntry{
+				{ID: "p-osaka", Name: "Club Quattro", Area: placeArea{Name: "Osaka"}},
+			},
+			wantID: "p-osaka",
+		},
+		{
+			name:      "single candidate outside the admin area needs review",
+			adminArea: "JP-13",
+			places: []placeEntry{
+				{ID: "p-osaka", Name: "Club Quattro", Area: placeArea{Name: "Osaka"}},
+			},
+			wantErr: apperr.ErrFailedPrecondition,
+		},
+		{
+			name:      "single candidate without an admin area is returned",
+			adminArea: "",
+			places: []placeEntry{
+				{ID: "p-osaka", Name: "Club Quattro", Area: placeArea{Name: "Osaka"}},
+			},
+			wantID: "p-osaka",
+		},
+		{
+			name:      "multiple candidates resolved by MusicBrainz area",
+			adminArea: "JP-27",
+			places: []placeEntry{
+				{ID: "p-shibuya", Name: "Club Quattro", Area: placeArea{Name: "Tokyo"}},
+				{ID: "p-umeda", Name: "Club Quattro", Area: placeArea{Name: "Osaka"}},
+			},
+			wantID: "p-umeda",
+		},
+		{
+			name:      "multiple candidates resolved by postal address",
+			adminArea: "JP-13",
+			places: []placeEntry{
+				{ID: "p-nagoya", Name: "Club Quattro", Area: placeArea{Name: "Naka-ku"}, Address: "愛知県名古屋市中区栄3-29-1"},
+				{ID: "p-shibuya", Name: "Club Quattro", Area: placeArea{Name: "Shibuya"}, Address: "東京都渋谷区宇田川町32-13"},
+			},
+			wantID: "p-shibuya",
+		},
+		{
+			name:      "admin area as a prefecture name is normalized",
+			adminArea: "Tokyo",
+			places: []placeEntry{
+				{ID: "p-shibuya", Name: "Club Quattro", Address: "32-13 Udagawacho, Shibuya, Tokyo"},
+				{ID: "p-umeda", Name: "Club Quattro", Address: "8-17 Taiyujicho, Kita-ku, Osaka"},
+			},
+			wantID: "p-shibuya",
+		},
+		{
+			name:      "multiple candidates in the same admin area need review",
+			adminArea: "JP-13",
+			places: []placeEntry{
+				{ID: "p-1", Name: "Live House", Area: placeArea{Name: "Tokyo"}},
+				{ID: "p-2", Name: "Live House", Address: "東京都新宿区"},
+			},
+			wantErr: apperr.ErrFailedPrecondition,
+		},
+		{
+			name:      "multiple candidates with none in the admin area need review",
+			adminArea: "JP-40",
+			places: []placeEntry{
+				{ID: "p-1", Name: "Live House", Area: placeArea{Name: "Tokyo"}},
+				{ID: "p-2", Name: "Live House", Area: placeArea{Name: "Osaka"}},
+			},
+			wantErr: apperr.ErrFailedPrecondition,
+		},
+		{
+			name:      "multiple candidates without an admin area need review",
+			adminArea: "",
+			places: []placeEntry{
+				{ID: "p-1", Name: "Live House", Area: placeArea{Name: "Tokyo"}},
+				{ID: "p-2", Name: "Live House", Area: placeArea{Name: "Osaka"}},
+			},
+			wantErr: apperr.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NotContains(t, r.URL.Query().Get("query"), "area:", "candidates are fetched by name only")
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(placeSearchResponse{Places: tt.places})
+			}))
+			defer server.Close()
+
+			c := musicbrainz.NewClient(server.Client(), nil, testLogger(t))
+			c.SetPlaceBaseURL(server.URL + "/")
+			searcher := musicbrainz.NewPlaceSearcher(c)
+
+			vp, err := searcher.SearchPlace(context.Background(), "Club Quattro", tt.adminArea)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, vp)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, vp.ExternalID)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Package wikidata provides a client for the Wikidata Query Service (SPARQL).
+//
+// Usage Guidelines (from the Wikidata Query Service User Manual and the
+// Wikimedia User-Agent policy):
+//
+//  1. User-Agent Identification
+//     A descriptive User-Agent with contact information is MANDATORY.
+//     Generic User-Agents are throttled or blocked.
+//
+//  2. Query Limits
+//     Each query is limited to 60 seconds of processing time, and clients
+//     sending too many expensive queries receive HTTP 429 with a Retry-After
+//     header. Keep queries narrow (a single-value lookup here) and honor
+//     Retry-After.
+//
+//  3. Licensing
+//     Wikidata content is CC0; no attribution is required.
+//
+// For more details, refer to:
+// https://www.mediawiki.org/wiki/Wikidata_Query_Service/User_Manual
+package wikidata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/pkg/api"
+	"github.com/liverty-music/backend/pkg/httpx"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+	"github.com/pannpers/go-logging/logging"
+)
+
+const (
+	sparqlEndpoint = "https://query.wikidata.org/sparql"
+	userAgent      = "LivertyMusic/1.0.0 ( contact: pannpers@gmail.com )"
+)
+
+// officialWebsiteQuery finds the official website (P856) of the item whose
+// MusicBrainz artist ID (P434) is the given MBID. wdt: yields only best-rank
+// statements, so a deprecated URL is never returned.
+const officialWebsiteQuery = `SELECT ?site WHERE {
+  ?artist wdt:P434 %q ;
+          wdt:P856 ?site .
+}
+LIMIT 1`
+
+type sparqlResponse struct {
+	Results struct {
+		Bindings []struct {
+			Site struct {
+				Value string `json:"value"`
+			} `json:"site"`
+		} `json:"bindings"`
+	} `json:"results"`
+}
+
+// client implements entity.OfficialSiteResolver using Wikidata's official
+// website property, reached through the MBID→Wikidata mapping (P434).
+type client struct {
+	httpClient *http.Client
+	endpoint   string
+	retrier    *httpx.Retrier
+	logger     *logging.Logger
+}
+
+var _ entity.OfficialSiteResolver = (*client)(nil)
+
+// NewClient creates a new Wikidata client.
+func NewClient(httpClient *http.Client, logger *logging.Logger) *client {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 10 * time.Second,
+		}
+	}
+	return &client{
+		httpClient: httpClient,
+		endpoint:   sparqlEndpoint,
+		retrier:    httpx.NewRetrier(),
+		logger:     logger.With(slog.String("component", "wikidata")),
+	}
+}
+
+// ResolveOfficialSiteURL returns the official website recorded on the
+// Wikidata item linked to the given MBID. Returns an empty string without
+// error when no item carries the MBID or the item has no official website.
+func (c *client) ResolveOfficialSiteURL(ctx context.Context, mbid string) (string, error) {
+	// The MBID is interpolated into the query, so only accept a well-formed one.
+	if _, err := uuid.Parse(mbid); err != nil {
+		return "", apperr.Wrap(err, codes.InvalidArgument, "invalid mbid for wikidata lookup", slog.String("mbid", mbid))
+	}
+
+	c.logger.Info(ctx, "resolving official site URL", slog.String("mbid", mbid))
+
+	q := url.Values{}
+	q.Set("query", fmt.Sprintf(officialWebsiteQuery, mbid))
+	q.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"?"+q.Encode(), nil)
+	if err != nil {
+		return "", apperr.Wrap(err, codes.Internal, "failed to create wikidata request")
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "application/sparql-results+json")
+
+	resp, err := c.retrier.Do(ctx, func() (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+	if err != nil {
+		return "", api.FromHTTP(err, nil, "wikidata sparql request failed")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := api.FromHTTP(nil, resp, "wikidata sparql request failed"); err != nil {
+		c.logger.Error(ctx, "wikidata sparql request failed", err, slog.String("mbid", mbid))
+		return "", err
+	}
+
+	var data sparqlResponse
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return "", apperr.Wrap(err, codes.Internal, "failed to decode wikidata sparql response")
+	}
+
+	if len(data.Results.Bindings) == 0 {
+		c.logger.Info(ctx, "no official website on wikidata", slog.String("mbid", mbid))
+		return "", nil
+	}
+	return data.Results.Bindings[0].Site.Value, nil
+}
+
+// SetEndpoint allows overriding the SPARQL endpoint used by the client. This
+// is primarily intended for tests to point the client at an httptest server.
+func (c *client) SetEndpoint(u string) {
+	c.endpoint = u
+}
@@ -0,0 +1,99 @@
+package wikidata_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/infrastructure/music/wikidata"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMBID = "5b11f4ce-a62d-471e-81fc-a69a8278c7da"
+
+func testLogger(t *testing.T) *logging.Logger {
+	t.Helper()
+	l, _ := logging.New()
+	return l
+}
+
+func TestClient_ResolveOfficialSiteURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		mbid         string
+		statusCode   int
+		responseBody string
+		want         string
+		wantErr      error
+	}{
+		{
+			name:         "hit - returns the official website",
+			mbid:         testMBID,
+			statusCode:   http.StatusOK,
+			responseBody: `{"head":{"vars":["site"]},"results":{"bindings":[{"site":{"type":"uri","value":"https://indie-band.example.jp/"}}]}}`,
+			want:         "https://indie-band.example.jp/",
+		},
+		{
+			name:         "miss - no item carries the MBID",
+			mbid:         testMBID,
+			statusCode:   http.StatusOK,
+			responseBody: `{"head":{"vars":["site"]},"results":{"bindings":[]}}`,
+			want:         "",
+		},
+		{
+			name:         "error - invalid JSON response",
+			mbid:         testMBID,
+			statusCode:   http.StatusOK,
+			responseBody: "invalid json{",
+			wantErr:      apperr.ErrInternal,
+		},
+		{
+			name:       "error - server error",
+			mbid:       testMBID,
+			statusCode: http.StatusInternalServerError,
+			wantErr:    apperr.ErrUnavailable,
+		},
+		{
+			name:    "error - malformed MBID is rejected before querying",
+			mbid:    `x" } ?s ?p ?o {`,
+			wantErr: apperr.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "json", r.URL.Query().Get("format"))
+				assert.Contains(t, r.URL.Query().Get("query"), `wdt:P434 "`+tt.mbid+`"`)
+				assert.Contains(t, r.URL.Query().Get("query"), "wdt:P856")
+				assert.Contains(t, r.Header.Get("User-Agent"), "LivertyMusic")
+
+				w.Header().Set("Content-Type", "application/sparql-results+json")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			client := wikidata.NewClient(server.Client(), testLogger(t))
+			client.SetEndpoint(server.URL)
+
+			got, err := client.ResolveOfficialSiteURL(context.Background(), tt.mbid)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package wikidata
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// fallbackResolver is an entity.OfficialSiteResolver decorator that consults
+// a second catalog when the primary one has no official site for an artist.
+// MusicBrainz URL relationships are sparse for Japanese indie artists, whose
+// Wikidata items often still carry an official website.
+type fallbackResolver struct {
+	primary  entity.OfficialSiteResolver
+	fallback entity.OfficialSiteResolver
+	logger   *logging.Logger
+}
+
+var _ entity.OfficialSiteResolver = (*fallbackResolver)(nil)
+
+// NewFallbackResolver returns a resolver that asks primary first and, only
+// when primary resolves no URL, asks fallback. An error from primary is
+// returned as is without consulting fallback.
+func NewFallbackResolver(primary, fallback entity.OfficialSiteResolver, logger *logging.Logger) entity.OfficialSiteResolver {
+	return &fallbackResolver{
+		primary:  primary,
+		fallback: fallback,
+		logger:   logger.With(slog.String("component", "official_site_fallback")),
+	}
+}
+
+// ResolveOfficialSiteURL implements entity.OfficialSiteResolver.
+func (r *fallbackResolver) ResolveOfficialSiteURL(ctx context.Context, mbid string) (string, error) {
+	url, err := r.primary.ResolveOfficialSiteURL(ctx, mbid)
+	if err != nil || url != "" {
+		return url, err
+	}
+
+	url, err = r.fallback.ResolveOfficialSiteURL(ctx, mbid)
+	if err != nil {
+		return "", err
+	}
+	if url != "" {
+		r.logger.Info(ctx, "official site resolved by fallback catalog",
+			slog.String("mbid", mbid), slog.String("url", url))
+	}
+	return url, nil
+}
@@ -0,0 +1,96 @@
+package wikidata_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/infrastructure/music/wikidata"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackResolver_ResolveOfficialSiteURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("primary hit skips the fallback", func(t *testing.T) {
+		t.Parallel()
+		primary := mocks.NewMockOfficialSiteResolver(t)
+		fallback := mocks.NewMockOfficialSiteResolver(t)
+		primary.EXPECT().ResolveOfficialSiteURL(ctx, testMBID).Return("https://mb.example.com", nil).Once()
+
+		got, err := wikidata.NewFallbackResolver(primary, fallback, testLogger(t)).ResolveOfficialSiteURL(ctx, testMBID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://mb.example.com", got)
+	})
+
+	t.Run("primary error is returned without the fallback", func(t *testing.T) {
+		t.Parallel()
+		primary := mocks.NewMockOfficialSiteResolver(t)
+		fallback := mocks.NewMockOfficialSiteResolver(t)
+		primary.EXPECT().ResolveOfficialSiteURL(ctx, testMBID).Return("", apperr.ErrUnavailable).Once()
+
+		got, err := wikidata.NewFallbackResolver(primary, fallback, testLogger(t)).ResolveOfficialSiteURL(ctx, testMBID)
+
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+		assert.Empty(t, got)
+	})
+
+	t.Run("fallback error is returned", func(t *testing.T) {
+		t.Parallel()
+		primary := mocks.NewMockOfficialSiteResolver(t)
+		fallback := mocks.NewMockOfficialSiteResolver(t)
+		primary.EXPECT().ResolveOfficialSiteURL(ctx, testMBID).Return("", nil).Once()
+		fallback.EXPECT().ResolveOfficialSiteURL(ctx, testMBID).Return("", apperr.ErrUnavailable).Once()
+
+		got, err := wikidata.NewFallbackResolver(primary, fallback, testLogger(t)).ResolveOfficialSiteURL(ctx, testMBID)
+
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+		assert.Empty(t, got)
+	})
+
+	// The remaining cases chain the real Wikidata client behind a primary
+	// that finds nothing, against a stubbed SPARQL endpoint.
+	tests := []struct {
+		name         string
+		responseBody string
+		want         string
+	}{
+		{
+			name:         "primary miss falls back to the Wikidata website",
+			responseBody: `{"results":{"bindings":[{"site":{"type":"uri","value":"https://indie-band.example.jp/"}}]}}`,
+			want:         "https://indie-band.example.jp/",
+		},
+		{
+			name:         "miss on both catalogs yields an empty URL",
+			responseBody: `{"results":{"bindings":[]}}`,
+			want:         "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/sparql-results+json")
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			wd := wikidata.NewClient(server.Client(), testLogger(t))
+			wd.SetEndpoint(server.URL)
+			primary := mocks.NewMockOfficialSiteResolver(t)
+			primary.EXPECT().ResolveOfficialSiteURL(ctx, testMBID).Return("", nil).Once()
+
+			got, err := wikidata.NewFallbackResolver(primary, wd, testLogger(t)).ResolveOfficialSiteURL(ctx, testMBID)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
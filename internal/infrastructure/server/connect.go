@@ -63,61 +63,7 @@ func NewConnectServer(
 	longTimeoutHandlers []LongTimeoutRPCHandler,
 	handlerFuncs ...RPCHandlerFunc,
 ) *ConnectServer {
-	// Create interceptors
-	tracingInterceptor, _ := otelconnect.NewInterceptor()
-	accessLogInterceptor := logging.NewAccessLogInterceptor(logger)
-	validationInterceptor := validate.NewInterceptor()
-
-	// Interceptor chain — execution order matters.
-	//
-	// Connect-RPC applies HandlerOptions in registration order. Within a single
-	// WithInterceptors() call, the first interceptor listed becomes the outermost
-	// layer. When multiple HandlerOptions are registered, each subsequent option's
-	// interceptors are appended inside the previous ones via chainWith().
-	//
-	// The enriched ctx created by an outer interceptor flows inward as a function
-	// argument to next(ctx, req), so all inner interceptors automatically receive
-	// context values (e.g., OTel span) set by outer ones.
-	//
-	// Execution order (outermost → innermost):
-	//
-	//   [1] tracingInterceptor        — Starts OTel span. ALL inner layers get trace_id/span_id.
-	//   [2] rateLimitInterceptor      — Rejects excess requests with CodeResourceExhausted.
-	//                                   After tracing so rejections are traced; before access log
-	//                                   so they are logged with correct status.
-	//   [3] accessLogInterceptor      — Logs after next() returns. Sees *connect.Error (converted
-	//                                   by [4]) for correct status codes. Outside [5] so it is NOT
-	//                                   bypassed when a panic unwinds the stack.
-	//   [4] errorHandlingInterceptor  — Converts AppErr → *connect.Error. Has trace context from [1].
-	//   [5] recoverHandler            — defer recover(). Returns *connect.Error on panic. Has trace
-	//                                   context from [1]. Inside [3] so access log still fires.
-	//   [6] claimsBridgeInterceptor        — Reads authn.infoKey (set by HTTP-layer authn.Middleware)
-	//                                        and writes auth.claimsKey for handlers.
-	//   [6b] extraInterceptors             — Optional per-server layers (e.g. the admin server's
-	//                                        REQUIRE-ADMIN authorization). Run after the claims bridge
-	//                                        so they see bridged claims, before validation.
-	//   [7] validationInterceptor          — Validates request proto via protovalidate. Innermost layer.
-	//
-	// Response path (innermost → outermost):
-	//   handler error (AppErr) → [7] pass → [6b] pass → [6] pass → [5] pass (or catch panic) →
-	//   [4] convert to *connect.Error → [3] log with correct status → [2] rate limit pass → [1] end span
-	//
-	// The claims bridge runs first so the bridged claims are visible to any
-	// extraInterceptors; validation runs last so it is innermost.
-	innerInterceptors := []connect.Interceptor{auth.ClaimsBridgeInterceptor{}}
-	innerInterceptors = append(innerInterceptors, extraInterceptors...)
-	innerInterceptors = append(innerInterceptors, validationInterceptor)
-
-	handlerOpts := []connect.HandlerOption{
-		connect.WithInterceptors(
-			tracingInterceptor,
-			ratelimit.NewInterceptor(rateLimiter),
-			accessLogInterceptor,
-			apperr_connect.NewErrorHandlingInterceptor(logger),
-		),
-		newRecoverHandler(logger),
-		connect.WithInterceptors(innerInterceptors...),
-	}
+	handlerOpts := rpcHandlerOptions(logger, rateLimiter, extraInterceptors)
 
 	// Health check opts — minimal chain for Kubernetes probes.
 	// No access log, tracing, error-handling, auth bridge, or validation;
@@ -207,6 +153,72 @@ func (s *ConnectServer) Close() error {
 	return nil
 }
 
+// rpcHandlerOptions returns the interceptor chain shared by every RPC handler.
+// extraInterceptors are inserted after the claims bridge and before
+// validation.
+func rpcHandlerOptions(logger *logging.Logger, rateLimiter *ratelimit.Limiter, extraInterceptors []connect.Interceptor) []connect.HandlerOption {
+	tracingInterceptor, _ := otelconnect.NewInterceptor()
+	accessLogInterceptor := logging.NewAccessLogInterceptor(logger)
+	validationInterceptor := validate.NewInterceptor()
+
+	// Interceptor chain — execution order matters.
+	//
+	// Connect-RPC applies HandlerOptions in registration order. Within a single
+	// WithInterceptors() call, the first interceptor listed becomes the outermost
+	// layer. When multiple HandlerOptions are registered, each subsequent option's
+	// interceptors are appended inside the previous ones via chainWith().
+	//
+	// The enriched ctx created by an outer interceptor flows inward as a function
+	// argument to next(ctx, req), so all inner interceptors automatically receive
+	// context values (e.g., OTel span) set by outer ones.
+	//
+	// Execution order (outermost → innermost):
+	//
+	//   [1] tracingInterceptor        — Starts OTel span. ALL inner layers get trace_id/span_id.
+	//   [2] rateLimitInterceptor      — Rejects excess requests with CodeResourceExhausted.
+	//                                   After tracing so rejections are traced; before access log
+	//                                   so they are logged with correct status.
+	//   [3] accessLogInterceptor      — Logs after next() returns. Sees *connect.Error (converted
+	//                                   by [4]) for correct status codes. Outside [5] so it is NOT
+	//                                   bypassed when a panic unwinds the stack.
+	//   [3b] fieldViolationInterceptor — Attaches a google.rpc.BadRequest detail to errors
+	//                                    carrying entity.ValidationError field violations. Outside
+	//                                    [4], which rebuilds the *connect.Error from the AppErr and
+	//                                    would drop a detail attached further in.
+	//   [4] errorHandlingInterceptor  — Converts AppErr → *connect.Error. Has trace context from [1].
+	//   [5] recoverHandler            — defer recover(). Returns *connect.Error on panic. Has trace
+	//                                   context from [1]. Inside [3] so access log still fires.
+	//   [6] claimsBridgeInterceptor        — Reads authn.infoKey (set by HTTP-layer authn.Middleware)
+	//                                        and writes auth.claimsKey for handlers.
+	//   [6b] extraInterceptors             — Optional per-server layers (e.g. the admin server's
+	//                                        REQUIRE-ADMIN authorization). Run after the claims bridge
+	//                                        so they see bridged claims, before validation.
+	//   [7] validationInterceptor          — Validates request proto via protovalidate. Innermost layer.
+	//
+	// Response path (innermost → outermost):
+	//   handler error (AppErr) → [7] pass → [6b] pass → [6] pass → [5] pass (or catch panic) →
+	//   [4] convert to *connect.Error → [3b] add field violations → [3] log with correct status →
+	//   [2] rate limit pass → [1] end span
+	//
+	// The claims bridge runs first so the bridged claims are visible to any
+	// extraInterceptors; validation runs last so it is innermost.
+	innerInterceptors := []connect.Interceptor{auth.ClaimsBridgeInterceptor{}}
+	innerInterceptors = append(innerInterceptors, extraInterceptors...)
+	innerInterceptors = append(innerInterceptors, validationInterceptor)
+
+	return []connect.HandlerOption{
+		connect.WithInterceptors(
+			tracingInterceptor,
+			ratelimit.NewInterceptor(rateLimiter),
+			accessLogInterceptor,
+			newFieldViolationInterceptor(),
+			apperr_connect.NewErrorHandlingInterceptor(logger),
+		),
+		newRecoverHandler(logger),
+		connect.WithInterceptors(innerInterceptors...),
+	}
+}
+
 func newRecoverHandler(logger *logging.Logger) connect.HandlerOption {
 	return connect.WithRecover(func(ctx context.Context, spec connect.Spec, _ http.Header, p any) error {
 		logger.Error(ctx, "Panic recovered in Connect handler", fmt.Errorf("panic: %v", p),
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// newFieldViolationInterceptor attaches a google.rpc.BadRequest detail to any
+// handler error carrying an *entity.ValidationError, so clients can map each
+// violation to its form field instead of parsing the free-text message.
+// It runs outside the apperr error-handling interceptor, so it receives the
+// *connect.Error that interceptor built; errors without field violations pass
+// through untouched.
+func newFieldViolationInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, withFieldViolations(err)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// withFieldViolations adds a BadRequest detail listing every field violation
+// to the *connect.Error in err's chain, or converts err into one whose status
+// code comes from the wrapping AppErr, falling back to InvalidArgument.
+func withFieldViolations(err error) error {
+	verr, ok := errors.AsType[*entity.ValidationError](err)
+	if !ok {
+		return err
+	}
+
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(verr.Violations)),
+	}
+	for i, v := range verr.Violations {
+		badRequest.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		}
+	}
+
+	connectErr, ok := errors.AsType[*connect.Error](err)
+	if !ok {
+		code := connect.CodeInvalidArgument
+		if appErr, ok := errors.AsType[*apperr.AppErr](err); ok && !appErr.Code.IsServerError() {
+			code = appErr.Code.ToConnect()
+		}
+		connectErr = connect.NewError(code, err)
+	}
+	if detail, detailErr := connect.NewErrorDetail(badRequest); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/infrastructure/server/ratelimit"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+	"github.com/pannpers/go-logging/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestWithFieldViolations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("attaches every violation as a BadRequest detail", func(t *testing.T) {
+		t.Parallel()
+
+		var verr entity.ValidationError
+		verr.Add("home.country_code", "must be a valid ISO 3166-1 alpha-2 code")
+		verr.Add("preferred_language", "must match ISO 639-1")
+		err := apperr.Wrap(verr.Err(), codes.InvalidArgument, "invalid user")
+
+		got := withFieldViolations(err)
+
+		connectErr, ok := errors.AsType[*connect.Error](got)
+		require.True(t, ok, "expected *connect.Error, got %T", got)
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+		assert.ErrorIs(t, got, apperr.ErrInvalidArgument, "the AppErr stays in the chain")
+
+		require.Len(t, connectErr.Details(), 1)
+		msg, err := connectErr.Details()[0].Value()
+		require.NoError(t, err)
+		badRequest, ok := msg.(*errdetails.BadRequest)
+		require.True(t, ok, "expected *errdetails.BadRequest, got %T", msg)
+		require.Len(t, badRequest.GetFieldViolations(), 2)
+		assert.Equal(t, "home.country_code", badRequest.GetFieldViolations()[0].GetField())
+		assert.Equal(t, "preferred_language", badRequest.GetFieldViolations()[1].GetField())
+	})
+
+	t.Run("passes errors without violations through", func(t *testing.T) {
+		t.Parallel()
+
+		err := apperr.New(codes.NotFound, "user not found")
+
+		assert.Same(t, err, withFieldViolations(err))
+	})
+}
+
+func TestFieldViolations_ThroughInterceptorChain(t *testing.T) {
+	t.Parallel()
+
+	logger, err := logging.New()
+	require.NoError(t, err)
+	limiter := ratelimit.NewLimiter(ratelimit.Config{AnonRPS: 100, AnonBurst: 100}, time.Minute)
+	t.Cleanup(func() { _ = limiter.Close() })
+
+	const procedure = "/test.v1.TestService/Update"
+	handler := connect.NewUnaryHandler(procedure,
+		func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			var verr entity.ValidationError
+			verr.Add("home.country_code", "must be a valid ISO 3166-1 alpha-2 code")
+			return nil, apperr.Wrap(verr.Err(), codes.InvalidArgument, "invalid user")
+		},
+		rpcHandlerOptions(logger, limiter, nil)...,
+	)
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client := connect.NewClient[emptypb.Empty, emptypb.Empty](http.DefaultClient, srv.URL+procedure)
+	_, err = client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+
+	connectErr, ok := errors.AsType[*connect.Error](err)
+	require.True(t, ok, "expected *connect.Error, got %T", err)
+	assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	require.Len(t, connectErr.Details(), 1, "the BadRequest detail survives the error-handling interceptor")
+	msg, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	badRequest, ok := msg.(*errdetails.BadRequest)
+	require.True(t, ok, "expected *errdetails.BadRequest, got %T", msg)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "home.country_code", badRequest.GetFieldViolations()[0].GetField())
+}
@@ -2,14 +2,18 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"net/http"
 	"sync/atomic"
 	"time"
+
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
 )
 
 // HealthServer provides a lightweight HTTP server for Kubernetes health probes.
-// It exposes /healthz (liveness) and /readyz (readiness) endpoints.
+// It exposes /healthz (liveness) and /readyz (readiness) endpoints, and
+// /statz/db-pool reporting database connection pool usage.
 // The server starts in a "not ready" state; call SetReady after the
 // application has finished initialization.
 type HealthServer struct {
@@ -22,6 +26,19 @@ type HealthServer struct {
 	// Kubernetes can observe the pod during initialization). Until set, /healthz
 	// reports healthy so a booting pod is not killed before it is ready.
 	liveness atomic.Pointer[func() bool]
+	// readiness, when set, additionally gates /readyz: it reports not ready
+	// (503) while the probe returns false, even after SetReady.
+	readiness atomic.Pointer[func() bool]
+	// poolStats, when set, serves /statz/db-pool. Until set the endpoint
+	// reports 404.
+	poolStats atomic.Pointer[func() rdb.PoolStats]
+}
+
+// poolStatsResponse is the /statz/db-pool body: the pool's gauges and their
+// saturation.
+type poolStatsResponse struct {
+	rdb.PoolStats
+	SaturationPercent int `json:"saturation_percent"`
 }
 
 // NewHealthServer creates a health probe server listening on the given address.
@@ -49,10 +66,26 @@ func NewHealthServer(addr string) *HealthServer {
 			_, _ = w.Write([]byte("not ready"))
 			return
 		}
+		if probe := h.readiness.Load(); probe != nil && !(*probe)() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("not ready"))
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok"))
 	})
 
+	mux.HandleFunc("GET /statz/db-pool", func(w http.ResponseWriter, r *http.Request) {
+		probe := h.poolStats.Load()
+		if probe == nil {
+			http.NotFound(w, r)
+			return
+		}
+		stats := (*probe)()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(poolStatsResponse{PoolStats: stats, SaturationPercent: stats.SaturationPercent()})
+	})
+
 	h.srv = &http.Server{
 		Addr:    addr,
 		Handler: mux,
@@ -93,6 +126,29 @@ func (h *HealthServer) SetLiveness(probe func() bool) {
 	h.liveness.Store(&probe)
 }
 
+// SetReadiness installs a readiness probe for /readyz. Once set, /readyz
+// reports not ready (503) whenever probe returns false, so Kubernetes routes
+// traffic, and rollouts proceed, only while the pod's dependencies are up.
+// Passing nil clears the probe.
+func (h *HealthServer) SetReadiness(probe func() bool) {
+	if probe == nil {
+		h.readiness.Store(nil)
+		return
+	}
+	h.readiness.Store(&probe)
+}
+
+// SetPoolStats installs the source of /statz/db-pool, which reports the
+// database connection pool's acquired, idle, max and waited gauges so pool
+// pressure can be inspected per pod. Passing nil clears it.
+func (h *HealthServer) SetPoolStats(stats func() rdb.PoolStats) {
+	if stats == nil {
+		h.poolStats.Store(nil)
+		return
+	}
+	h.poolStats.Store(&stats)
+}
+
 // SetShuttingDown transitions the readiness endpoint to return 503.
 func (h *HealthServer) SetShuttingDown() {
 	h.shuttingDown.Store(true)
@@ -1,12 +1,15 @@
 package server_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/liverty-music/backend/internal/infrastructure/database/rdb"
 	"github.com/liverty-music/backend/internal/infrastructure/server"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func get(t *testing.T, h *server.HealthServer, path string) int {
@@ -57,3 +60,48 @@ func TestHealthServer_ReadyzGatedUntilReady(t *testing.T) {
 	h.SetShuttingDown()
 	assert.Equal(t, http.StatusServiceUnavailable, get(t, h, "/readyz"), "not ready while shutting down")
 }
+
+func TestHealthServer_ReadyzReflectsReadiness(t *testing.T) {
+	t.Parallel()
+
+	h := server.NewHealthServer(":0")
+
+	connected := false
+	h.SetReadiness(func() bool { return connected })
+	h.SetReady()
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, h, "/readyz"), "not ready until the connection is established")
+
+	connected = true
+	assert.Equal(t, http.StatusOK, get(t, h, "/readyz"), "ready once the connection is up")
+
+	// A dropped connection takes the pod out of rotation without failing
+	// liveness.
+	connected = false
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, h, "/readyz"))
+	assert.Equal(t, http.StatusOK, get(t, h, "/healthz"), "readiness does not affect liveness")
+}
+
+func TestHealthServer_PoolStats(t *testing.T) {
+	t.Parallel()
+
+	h := server.NewHealthServer(":0")
+	assert.Equal(t, http.StatusNotFound, get(t, h, "/statz/db-pool"), "not served until a source is set")
+
+	h.SetPoolStats(func() rdb.PoolStats {
+		return rdb.PoolStats{Acquired: 8, Idle: 2, Max: 10, Waited: 5}
+	})
+
+	rec := httptest.NewRecorder()
+	h.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/statz/db-pool", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body map[string]int
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, map[string]int{
+		"acquired":           8,
+		"idle":               2,
+		"max":                10,
+		"waited":             5,
+		"saturation_percent": 80,
+	}, body)
+}
@@ -44,8 +44,10 @@ func NewBusinessMetrics() *BusinessMetrics {
 // RecordConcertSearch increments the concert.search.count counter, tagging
 // the run outcome via the status attribute. Accepted values are "success"
 // (the run discovered at least one new concert), "zero_results" (the run
-// completed without error but found no new concerts), and "error" (the run
-// failed). The zero_results outcome distinguishes a fruitless-but-healthy
+// completed without error but found no new concerts), "rejected" (the
+// searcher's response was rejected as implausible and the artist is skipped
+// until its next search), "error" (the run failed), and "unavailable" (the search breaker was open, so no run was
+// made). The zero_results outcome distinguishes a fruitless-but-healthy
 // run — quota burned, nothing found — from a fruitful one.
 func (m *BusinessMetrics) RecordConcertSearch(ctx context.Context, status string) {
 	m.concertSearch.Add(ctx, 1, metric.WithAttributes(attribute.String("status", status)))
@@ -0,0 +1,37 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/liverty-music/backend/internal/usecase"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Compile-time interface compliance check.
+var _ usecase.EntryMetrics = (*OTelEntryMetrics)(nil)
+
+// OTelEntryMetrics implements usecase.EntryMetrics using the OTel Metrics API.
+type OTelEntryMetrics struct {
+	checkedIn metric.Int64Gauge
+	capacity  metric.Int64Gauge
+}
+
+// NewOTelEntryMetrics creates a new OTelEntryMetrics with registered instruments.
+func NewOTelEntryMetrics() *OTelEntryMetrics {
+	meter := otel.Meter("usecase/entry")
+	checkedIn, _ := meter.Int64Gauge("entry.check_in.count",
+		metric.WithDescription("Attendees checked in to the event most recently checked in to"),
+	)
+	capacity, _ := meter.Int64Gauge("entry.check_in.capacity",
+		metric.WithDescription("Tickets issued for the event most recently checked in to, the most attendees that can check in"),
+	)
+	return &OTelEntryMetrics{checkedIn: checkedIn, capacity: capacity}
+}
+
+// RecordCheckInStatus sets the check-in gauges. They carry no event
+// attribute: one series per event would grow without bound.
+func (m *OTelEntryMetrics) RecordCheckInStatus(ctx context.Context, checkedIn, capacity int) {
+	m.checkedIn.Record(ctx, int64(checkedIn))
+	m.capacity.Record(ctx, int64(capacity))
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
@@ -47,6 +48,8 @@ type ArtistUseCase interface {
 	GetOfficialSite(ctx context.Context, artistID string) (*entity.OfficialSite, error)
 
 	// Search finds artists matching the query, prioritizing external discovery services.
+	// Like ListSimilar and ListTop, every returned artist is persisted (deduplicated
+	// by MBID), so its ID is always a valid database ID that follow flows can use.
 	//
 	// # Possible errors:
 	//
@@ -60,6 +63,7 @@ type ArtistUseCase interface {
 	// # Possible errors:
 	//
 	//   - NotFound: target artist not found in local or external catalogs.
+	//     Not cached, so the artist resolves once it has been created.
 	//   - Internal: service failure.
 	ListSimilar(ctx context.Context, artistID string, limit int32) ([]*entity.Artist, error)
 
@@ -73,6 +77,25 @@ type ArtistUseCase interface {
 	ListTop(ctx context.Context, country string, tag string, limit int32) ([]*entity.Artist, error)
 }
 
+// searchMissTTL is how long a search that found no artists is answered from
+// the cache. It is shorter than the search TTL so a newly listed artist
+// becomes findable soon after.
+const searchMissTTL = 5 * time.Minute
+
+// searchMiss is the cached outcome of a search that found no artists. Its
+// own type keeps it apart from both a cache miss (nil) and a cached result.
+type searchMiss struct{}
+
+// ArtistCacheTTLs holds how long each cached artist lookup stays fresh.
+type ArtistCacheTTLs struct {
+	// Search applies to Search results.
+	Search time.Duration
+	// Similar applies to ListSimilar results.
+	Similar time.Duration
+	// Top applies to ListTop results.
+	Top time.Duration
+}
+
 // artistUseCase implements the ArtistUseCase interface.
 type artistUseCase struct {
 	artistRepo     entity.ArtistRepository
@@ -80,6 +103,7 @@ type artistUseCase struct {
 	idManager      entity.ArtistIdentityManager
 	publisher      EventPublisher
 	cache          entity.Cache
+	cacheTTLs      ArtistCacheTTLs
 	logger         *logging.Logger
 }
 
@@ -93,6 +117,7 @@ func NewArtistUseCase(
 	idManager entity.ArtistIdentityManager,
 	publisher EventPublisher,
 	cache entity.Cache,
+	cacheTTLs ArtistCacheTTLs,
 	logger *logging.Logger,
 ) ArtistUseCase {
 	return &artistUseCase{
@@ -101,6 +126,7 @@ func NewArtistUseCase(
 		idManager:      idManager,
 		publisher:      publisher,
 		cache:          cache,
+		cacheTTLs:      cacheTTLs,
 		logger:         logger,
 	}
 }
@@ -166,12 +192,16 @@ func (uc *artistUseCase) GetOfficialSite(ctx context.Context, artistID string) (
 
 // Search finds artists matching the query using the primary external discovery service.
 // Results are cached to reduce external API calls.
+// Fetched artists are auto-persisted to ensure valid database IDs.
 func (uc *artistUseCase) Search(ctx context.Context, query string) ([]*entity.Artist, error) {
-	// Check cache first
+	// Check cache first. A cached miss answers NotFound until it expires.
 	cacheKey := fmt.Sprintf("search:%s", hashString(query))
 	if cached := uc.cache.Get(cacheKey); cached != nil {
-		if artists, ok := cached.([]*entity.Artist); ok {
-			return artists, nil
+		switch v := cached.(type) {
+		case []*entity.Artist:
+			return v, nil
+		case searchMiss:
+			return nil, apperr.New(codes.NotFound, "no artists found")
 		}
 	}
 
@@ -185,6 +215,9 @@ func (uc *artistUseCase) Search(ctx context.Context, query string) ([]*entity.Ar
 	filtered := entity.FilterArtistsByMBID(artists)
 
 	if len(filtered) == 0 {
+		// Typos are the usual cause; remember the miss briefly so a retyped
+		// query does not hit the searcher again.
+		uc.cache.SetWithTTL(cacheKey, searchMiss{}, searchMissTTL)
 		return nil, apperr.New(codes.NotFound, "no artists found")
 	}
 
@@ -195,7 +228,7 @@ func (uc *artistUseCase) Search(ctx context.Context, query string) ([]*entity.Ar
 	}
 
 	// Store in cache
-	uc.cache.Set(cacheKey, persisted)
+	uc.cache.SetWithTTL(cacheKey, persisted, uc.cacheTTLs.Search)
 
 	return persisted, nil
 }
@@ -212,7 +245,9 @@ func (uc *artistUseCase) ListSimilar(ctx context.Context, artistID string, limit
 		}
 	}
 
-	// Cache miss - fetch artist and get similar artists
+	// Cache miss - fetch artist and get similar artists. Errors, NotFound
+	// included, return before the cache is written: caching an unknown
+	// artist as having no similar artists would hide it after it is created.
 	artist, err := uc.artistRepo.Get(ctx, artistID)
 	if err != nil {
 		return nil, err
@@ -233,7 +268,7 @@ func (uc *artistUseCase) ListSimilar(ctx context.Context, artistID string, limit
 	}
 
 	// Store in cache
-	uc.cache.Set(cacheKey, persisted)
+	uc.cache.SetWithTTL(cacheKey, persisted, uc.cacheTTLs.Similar)
 
 	return persisted, nil
 }
@@ -266,7 +301,7 @@ func (uc *artistUseCase) ListTop(ctx context.Context, country string, tag string
 	}
 
 	// Store in cache
-	uc.cache.Set(cacheKey, persisted)
+	uc.cache.SetWithTTL(cacheKey, persisted, uc.cacheTTLs.Top)
 
 	return persisted, nil
 }
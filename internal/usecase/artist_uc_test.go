@@ -2,7 +2,9 @@ package usecase_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"testing/synctest"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill"
@@ -15,6 +17,7 @@ import (
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // anyCtx matches any context.Context regardless of type (e.g. context.WithoutCancel).
@@ -39,10 +42,20 @@ func newArtistTestDeps(t *testing.T) *artistTestDeps {
 		searcher:  mocks.NewMockArtistSearcher(t),
 		idManager: mocks.NewMockArtistIdentityManager(t),
 	}
-	d.uc = usecase.NewArtistUseCase(d.repo, d.searcher, d.idManager, messaging.NewEventPublisher(newTestPublisher()), cache.NewMemoryCache(1*time.Hour), newTestLogger(t))
+	artistCache := cache.NewMemoryCache(1 * time.Hour)
+	t.Cleanup(func() { _ = artistCache.Close() })
+	d.uc = usecase.NewArtistUseCase(d.repo, d.searcher, d.idManager, messaging.NewEventPublisher(newTestPublisher()), artistCache, testArtistCacheTTLs, newTestLogger(t))
 	return d
 }
 
+// testArtistCacheTTLs uses a distinct TTL per operation so tests can tell
+// which one was applied.
+var testArtistCacheTTLs = usecase.ArtistCacheTTLs{
+	Search:  1 * time.Hour,
+	Similar: 24 * time.Hour,
+	Top:     6 * time.Hour,
+}
+
 func TestArtistUseCase_CreateArtist(t *testing.T) {
 	t.Parallel()
 
@@ -201,6 +214,47 @@ func TestArtistUseCase_ListSimilar(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
+	t.Run("not found is not cached and a later-created artist resolves", func(t *testing.T) {
+		t.Parallel()
+		d := newArtistTestDeps(t)
+
+		seedArtist := &entity.Artist{ID: "late-id", Name: "Late", MBID: "late-mbid"}
+		persisted := []*entity.Artist{{ID: "id-sim-a", Name: "Similar A", MBID: "sim-a"}}
+
+		d.repo.EXPECT().Get(ctx, "late-id").Return(nil, apperr.ErrNotFound).Once()
+		_, err := d.uc.ListSimilar(ctx, "late-id", int32(0))
+		require.ErrorIs(t, err, apperr.ErrNotFound)
+
+		// The artist now exists; the next call must reach the repository.
+		d.repo.EXPECT().Get(ctx, "late-id").Return(seedArtist, nil).Once()
+		d.searcher.EXPECT().ListSimilar(ctx, seedArtist, int32(0)).
+			Return([]*entity.Artist{{Name: "Similar A", MBID: "sim-a"}}, nil).Once()
+		d.repo.EXPECT().ListByMBIDs(mock.Anything, []string{"sim-a"}).Return(persisted, nil).Once()
+
+		result, err := d.uc.ListSimilar(ctx, "late-id", int32(0))
+		require.NoError(t, err)
+		assert.Equal(t, persisted, result)
+
+		// The successful result is cached: no further repository or searcher calls.
+		result, err = d.uc.ListSimilar(ctx, "late-id", int32(0))
+		require.NoError(t, err)
+		assert.Equal(t, persisted, result)
+	})
+
+	t.Run("external not found is not cached", func(t *testing.T) {
+		t.Parallel()
+		d := newArtistTestDeps(t)
+
+		seedArtist := &entity.Artist{ID: "seed-id", Name: "Seed", MBID: "seed-mbid"}
+		d.repo.EXPECT().Get(ctx, "seed-id").Return(seedArtist, nil).Times(2)
+		d.searcher.EXPECT().ListSimilar(ctx, seedArtist, int32(0)).Return(nil, apperr.ErrNotFound).Times(2)
+
+		for range 2 {
+			_, err := d.uc.ListSimilar(ctx, "seed-id", int32(0))
+			assert.ErrorIs(t, err, apperr.ErrNotFound)
+		}
+	})
+
 	t.Run("returns persisted artists with valid IDs", func(t *testing.T) {
 		t.Parallel()
 		d := newArtistTestDeps(t)
@@ -301,6 +355,43 @@ func TestArtistUseCase_Search(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
+	t.Run("caches a miss so a repeated empty search skips the searcher", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			d := newArtistTestDeps(t)
+
+			d.searcher.EXPECT().Search(ctx, "yorushkia").Return([]*entity.Artist{}, nil).Once()
+
+			_, err := d.uc.Search(ctx, "yorushkia")
+			assert.ErrorIs(t, err, apperr.ErrNotFound)
+
+			result, err := d.uc.Search(ctx, "yorushkia")
+			assert.ErrorIs(t, err, apperr.ErrNotFound)
+			assert.Nil(t, result)
+		})
+	})
+
+	t.Run("searches again once the cached miss expires", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			d := newArtistTestDeps(t)
+			persisted := []*entity.Artist{{ID: "id-1", Name: "New Artist", MBID: "mbid-1"}}
+
+			d.searcher.EXPECT().Search(ctx, "new artist").Return(nil, nil).Once()
+			_, err := d.uc.Search(ctx, "new artist")
+			assert.ErrorIs(t, err, apperr.ErrNotFound)
+
+			time.Sleep(5*time.Minute + time.Second)
+			d.searcher.EXPECT().Search(ctx, "new artist").Return([]*entity.Artist{{Name: "New Artist", MBID: "mbid-1"}}, nil).Once()
+			d.repo.EXPECT().ListByMBIDs(mock.Anything, []string{"mbid-1"}).Return([]*entity.Artist{}, nil).Once()
+			d.repo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.Artist")).Return(persisted, nil).Once()
+
+			result, err := d.uc.Search(ctx, "new artist")
+			assert.NoError(t, err)
+			assert.Equal(t, persisted, result)
+		})
+	})
+
 	t.Run("returns cached results on second call", func(t *testing.T) {
 		t.Parallel()
 		d := newArtistTestDeps(t)
@@ -374,3 +465,57 @@ func TestArtistUseCase_Search(t *testing.T) {
 		assert.Equal(t, "db-b", result[1].ID)
 	})
 }
+
+func TestArtistUseCase_CacheTTLs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	external := []*entity.Artist{{Name: "Artist", MBID: "mbid-1"}}
+	persisted := []*entity.Artist{{ID: "id-1", Name: "Artist", MBID: "mbid-1"}}
+
+	newDeps := func(t *testing.T) (*artistTestDeps, *mocks.MockCache) {
+		t.Helper()
+		d := &artistTestDeps{
+			repo:      mocks.NewMockArtistRepository(t),
+			searcher:  mocks.NewMockArtistSearcher(t),
+			idManager: mocks.NewMockArtistIdentityManager(t),
+		}
+		artistCache := mocks.NewMockCache(t)
+		artistCache.EXPECT().Get(mock.Anything).Return(nil)
+		d.repo.EXPECT().ListByMBIDs(mock.Anything, []string{"mbid-1"}).Return(persisted, nil).Maybe()
+		d.uc = usecase.NewArtistUseCase(d.repo, d.searcher, d.idManager, messaging.NewEventPublisher(newTestPublisher()), artistCache, testArtistCacheTTLs, newTestLogger(t))
+		return d, artistCache
+	}
+
+	t.Run("search uses the search TTL", func(t *testing.T) {
+		t.Parallel()
+		d, artistCache := newDeps(t)
+		d.searcher.EXPECT().Search(ctx, "artist").Return(external, nil).Once()
+		artistCache.EXPECT().SetWithTTL(mock.MatchedBy(func(k string) bool { return strings.HasPrefix(k, "search:") }), mock.Anything, testArtistCacheTTLs.Search).Once()
+
+		_, err := d.uc.Search(ctx, "artist")
+		require.NoError(t, err)
+	})
+
+	t.Run("similar uses the similar TTL", func(t *testing.T) {
+		t.Parallel()
+		d, artistCache := newDeps(t)
+		source := &entity.Artist{ID: "artist-1", Name: "Source", MBID: "mbid-source"}
+		d.repo.EXPECT().Get(ctx, "artist-1").Return(source, nil).Once()
+		d.searcher.EXPECT().ListSimilar(ctx, source, int32(10)).Return(external, nil).Once()
+		artistCache.EXPECT().SetWithTTL("similar:artist-1:10", mock.Anything, testArtistCacheTTLs.Similar).Once()
+
+		_, err := d.uc.ListSimilar(ctx, "artist-1", 10)
+		require.NoError(t, err)
+	})
+
+	t.Run("top uses the top TTL", func(t *testing.T) {
+		t.Parallel()
+		d, artistCache := newDeps(t)
+		d.searcher.EXPECT().ListTop(ctx, "JP", "", int32(10)).Return(external, nil).Once()
+		artistCache.EXPECT().SetWithTTL("top:JP::10", mock.Anything, testArtistCacheTTLs.Top).Once()
+
+		_, err := d.uc.ListTop(ctx, "JP", "", 10)
+		require.NoError(t, err)
+	})
+}
@@ -51,13 +51,18 @@ type AdminConcertUseCase interface {
 	// is already gone (e.g. double-click), the method returns success without
 	// duplicating.
 	//
+	// A staged reschedule publishes nothing new: approval moves the published
+	// event it points at to the staged date and publishes CONCERT.rescheduled,
+	// which notifies the event's ticket holders.
+	//
 	// # Possible errors
 	//
 	//  - NotFound: If the staged concert does not exist (idempotent — treated as
 	//    success internally; callers should not distinguish this).
 	//  - FailedPrecondition: If an equivalent known-start event already covers
-	//    the same (venue, date) and the staged concert has no start time. The
-	//    staged row is preserved so a reviewer can reject it to clear the queue.
+	//    the same (venue, date) and the staged concert has no start time, or the
+	//    event a staged reschedule points at no longer exists. The staged row is
+	//    preserved so a reviewer can reject it to clear the queue.
 	//  - Internal: If the venue, series, or event insert fails.
 	Approve(ctx context.Context, stagedID string) error
 
@@ -130,7 +135,9 @@ func (uc *concertUseCase) ListPending(ctx context.Context) ([]*PendingConcertRev
 	return reviews, nil
 }
 
-// Approve promotes a pending staged concert to a published event.
+// Approve promotes a pending staged concert to a published event. A staged
+// reschedule instead moves the published event it points at (see
+// approveReschedule).
 func (uc *concertUseCase) Approve(ctx context.Context, stagedID string) error {
 	sc, err := uc.stagedConcertRepo.GetByID(ctx, stagedID)
 	if err != nil {
@@ -144,6 +151,10 @@ func (uc *concertUseCase) Approve(ctx context.Context, stagedID string) error {
 		return fmt.Errorf("get staged concert: %w", err)
 	}
 
+	if sc.ReschedulesEventID != nil {
+		return uc.approveReschedule(ctx, sc)
+	}
+
 	// Resolve or create the venues row from the staged resolved fields.
 	venueID, err := uc.resolveOrCreateVenue(ctx, sc)
 	if err != nil {
@@ -159,6 +170,7 @@ func (uc *concertUseCase) Approve(ctx context.Context, stagedID string) error {
 		sc.ArtistID,
 		scraped,
 		venueID,
+		sc.SearchSessionID,
 		uc.seriesRepo,
 		uc.concertRepo,
 		uc.logger,
@@ -207,6 +219,70 @@ func (uc *concertUseCase) Approve(ctx context.Context, stagedID string) error {
 	return nil
 }
 
+// approveReschedule applies an approved reschedule: it moves the published
+// event the staged row points at to the staged date and times, publishes
+// CONCERT.rescheduled so the event's ticket holders are notified, and deletes
+// the staged row. An event that is already on the staged date is not moved
+// again, so retrying after a failed delete keeps previous_local_event_date.
+// When the event no longer exists the staged row is preserved for the reviewer
+// to reject, as Approve does for an equivalent event.
+func (uc *concertUseCase) approveReschedule(ctx context.Context, sc *entity.StagedConcert) error {
+	eventID := *sc.ReschedulesEventID
+	attrs := []slog.Attr{
+		slog.String("artist_id", sc.ArtistID),
+		slog.String("staged_concert_id", sc.ID),
+		slog.String("event_id", eventID),
+	}
+	gone := func() error {
+		uc.logger.Warn(ctx, "approve: rescheduled event no longer exists — staged row preserved for manual rejection", attrs...)
+		return apperr.New(codes.FailedPrecondition,
+			"the event this entry reschedules no longer exists; reject this entry to remove it from the queue")
+	}
+
+	concerts, err := uc.concertRepo.ListByIDs(ctx, []string{eventID})
+	if err != nil {
+		return fmt.Errorf("get event %q rescheduled by staged concert %q: %w", eventID, sc.ID, err)
+	}
+	if len(concerts) == 0 {
+		return gone()
+	}
+	previous := concerts[0].LocalDate
+
+	if !previous.Equal(sc.LocalDate) {
+		if err := uc.concertRepo.Reschedule(ctx, eventID, sc.LocalDate, sc.StartTime, sc.OpenTime); err != nil {
+			if errors.Is(err, apperr.ErrNotFound) {
+				return gone()
+			}
+			return fmt.Errorf("reschedule event %q for staged concert %q: %w", eventID, sc.ID, err)
+		}
+	}
+
+	uc.logger.Info(ctx, "staged reschedule approved and applied",
+		append(attrs,
+			slog.String("previous_local_date", previous.Format("2006-01-02")),
+			slog.String("local_date", sc.LocalDate.Format("2006-01-02")),
+		)...,
+	)
+
+	rescheduled := entity.ConcertRescheduledData{
+		EventID:           eventID,
+		ArtistID:          sc.ArtistID,
+		Title:             sc.Title,
+		ListedVenueName:   sc.ListedVenueName,
+		PreviousLocalDate: previous,
+		LocalDate:         sc.LocalDate,
+	}
+	if err := uc.publisher.PublishEvent(ctx, entity.SubjectConcertRescheduled, rescheduled); err != nil {
+		uc.logger.Error(ctx, "failed to publish CONCERT.rescheduled after approval", err, attrs...)
+		// Non-fatal: the event has moved; only the ticket-holder notice is missed.
+	}
+
+	if err := uc.stagedConcertRepo.Delete(ctx, sc.ID); err != nil {
+		return fmt.Errorf("delete staged concert after approval: %w", err)
+	}
+	return nil
+}
+
 // Reject records the staged concert in the rejection log and deletes the
 // staged row.
 func (uc *concertUseCase) Reject(ctx context.Context, stagedID string, reason string, reviewedBy string) error {
@@ -293,13 +369,14 @@ func (uc *concertUseCase) resolveOrCreateVenue(ctx context.Context, sc *entity.S
 		return uc.createVenueFromStaged(ctx, sc)
 	}
 
-	// Step 2: unresolved venue — try to find by listed name.
-	existing, err := uc.venueRepo.GetByListedName(ctx, sc.ListedVenueName, sc.AdminArea)
+	// Step 2: unresolved venue — try to find by normalized name, so case,
+	// padding, and full-width variants of a listed name reuse one venue.
+	existing, err := uc.venueRepo.GetByNormalizedName(ctx, sc.ListedVenueName, sc.AdminArea)
 	if err == nil {
 		return existing.ID, nil
 	}
 	if !errors.Is(err, apperr.ErrNotFound) {
-		return "", fmt.Errorf("get venue by listed name: %w", err)
+		return "", fmt.Errorf("get venue by normalized name: %w", err)
 	}
 
 	// No existing venue found and no resolved place ID — create a minimal
@@ -344,6 +421,15 @@ func (uc *concertUseCase) createVenueFromStaged(ctx context.Context, sc *entity.
 	}
 
 	if err := uc.venueRepo.Create(ctx, venue); err != nil {
+		// A concurrent approval created the venue for the same place first;
+		// reuse it. It requested its own enrichment, so nothing is published.
+		if errors.Is(err, apperr.ErrAlreadyExists) && sc.ResolvedPlaceID != nil {
+			existing, getErr := uc.venueRepo.GetByPlaceID(ctx, *sc.ResolvedPlaceID)
+			if getErr != nil {
+				return "", fmt.Errorf("get venue created concurrently for place: %w", getErr)
+			}
+			return existing.ID, nil
+		}
 		return "", fmt.Errorf("create venue from staged concert: %w", err)
 	}
 
@@ -352,6 +438,17 @@ func (uc *concertUseCase) createVenueFromStaged(ctx context.Context, sc *entity.
 		slog.String("venue_name", name),
 	)
 
+	requested := entity.VenueEnrichmentRequestedData{
+		VenueID:         venue.ID,
+		ListedVenueName: sc.ListedVenueName,
+	}
+	if err := uc.publisher.PublishEvent(ctx, entity.SubjectVenueEnrichmentRequested, requested); err != nil {
+		uc.logger.Error(ctx, "failed to publish VENUE.enrichment_requested for new venue", err,
+			slog.String("venue_id", venue.ID),
+		)
+		// Non-fatal: the venue is usable as created; it stays unenriched.
+	}
+
 	return venue.ID, nil
 }
 
@@ -363,6 +460,7 @@ func stagedToScraped(sc *entity.StagedConcert) *entity.ScrapedConcert {
 		Title:           sc.Title,
 		ListedVenueName: sc.ListedVenueName,
 		LocalDate:       sc.LocalDate,
+		IsFestival:      sc.IsFestival,
 	}
 	if sc.StartTime != nil {
 		scraped.StartTime = *sc.StartTime
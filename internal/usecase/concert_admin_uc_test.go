@@ -51,7 +51,18 @@ func (r *fakeArtistRepo) GetOfficialSite(_ context.Context, _ string) (*entity.O
 	return nil, apperr.New(codes.NotFound, "official site not found")
 }
 
+func (r *fakeArtistRepo) ListOfficialSites(_ context.Context, _ string) ([]*entity.OfficialSite, error) {
+	return nil, nil
+}
+
+func (r *fakeArtistRepo) ListArtistsWithoutOfficialSite(_ context.Context, _ int) ([]*entity.Artist, error) {
+	return nil, nil
+}
+
 func (r *fakeArtistRepo) List(_ context.Context) ([]*entity.Artist, error) { return nil, nil }
+func (r *fakeArtistRepo) ListPage(_ context.Context, _ int, _ string) ([]*entity.Artist, string, error) {
+	return nil, "", nil
+}
 func (r *fakeArtistRepo) Create(_ context.Context, _ ...*entity.Artist) ([]*entity.Artist, error) {
 	return nil, nil
 }
@@ -102,21 +113,26 @@ func newApprovalTestDeps(t *testing.T, artist *entity.Artist) *approvalTestDeps
 		publisher:   pub,
 	}
 	// Pass nil for repos/deps that Approve/Reject/ListPending/List/Delete never touch:
-	// searchLogRepo, concertSearcher, centroidResolver, and metrics.
+	// userRepo, searchLogRepo, followRepo, concertSearcher, centroidResolver, feedCache, and breaker.
 	d.uc = usecase.NewConcertUseCase(
 		d.artistRepo,
+		nil, // userRepo — not used by admin methods
 		d.concertRepo,
 		d.venueRepo,
 		d.seriesRepo,
 		nil, // searchLogRepo — not used by admin methods
+		nil, // followRepo — not used by admin methods
 		d.stagedRepo,
 		d.rejectedLog,
 		nil, // concertSearcher — not used by admin methods
 		nil, // centroidResolver — not used by admin methods
 		messaging.NewEventPublisher(pub),
 		noopMetrics{},
-		0, // searchCacheTTL — not used by admin methods
-		0, // discoveryWindow — not used by admin methods
+		nil,                       // feedCache — not used by admin methods
+		usecase.SearchFreshness{}, // not used by admin methods
+		0,                         // discoveryWindow — not used by admin methods
+		0,                         // searchTimeout — not used by admin methods
+		nil,                       // breaker — not used by admin methods
 		newTestLogger(t),
 	)
 	t.Cleanup(func() { _ = pub.Close() })
@@ -187,6 +203,113 @@ func TestAdminConcertUseCase_Approve(t *testing.T) {
 		}
 	})
 
+	t.Run("approve records the staging search session on the event", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		sc := seedStaged(d, artist.ID)
+		sessionID := "01900000-0000-7000-8000-000000000001"
+		sc.SearchSessionID = &sessionID
+
+		require.NoError(t, d.uc.Approve(context.Background(), sc.ID))
+
+		require.Len(t, d.concertRepo.created, 1)
+		require.NotNil(t, d.concertRepo.created[0].SearchSessionID)
+		assert.Equal(t, sessionID, *d.concertRepo.created[0].SearchSessionID)
+	})
+
+	t.Run("approve files a festival appearance on the day's shared event", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		placeID := "place-abc"
+		d.venueRepo.venues["Venue ABC Canonical"] = &entity.Venue{ID: "venue-fes", Name: "Venue ABC Canonical", GooglePlaceID: &placeID}
+		sc := seedStaged(d, artist.ID)
+		sc.IsFestival = true
+		setTime := sc.LocalDate.Add(15 * time.Hour)
+		sc.StartTime = &setTime
+		// Another artist's approved appearance already created the day's
+		// event, keyed without a start time.
+		d.concertRepo.existing = map[string][]*entity.Event{
+			"venue-fes|" + sc.LocalDate.Format("2006-01-02"): {
+				{ID: "event-fes", SeriesID: "series-fes", VenueID: "venue-fes", LocalDate: sc.LocalDate},
+			},
+		}
+
+		require.NoError(t, d.uc.Approve(context.Background(), sc.ID))
+
+		assert.Empty(t, d.seriesRepo.created, "the festival's series is adopted")
+		assert.Empty(t, d.concertRepo.filledIDs, "a set time is not filled onto the shared event")
+		require.Len(t, d.concertRepo.created, 1)
+		got := d.concertRepo.created[0]
+		assert.Equal(t, "series-fes", got.SeriesID)
+		assert.Equal(t, entity.SeriesTypeFestival, got.Series.Type)
+		require.NotNil(t, got.StartTime)
+		assert.True(t, got.StartTime.Equal(setTime), "the set time travels with the appearance")
+	})
+
+	t.Run("approve applies a staged reschedule and notifies through CONCERT.rescheduled", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		oldDate := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+		d.concertRepo.published = []*entity.Concert{{
+			Event:  entity.Event{ID: "event-moved", LocalDate: oldDate},
+			Series: &entity.Series{Title: "Approval Test Concert"},
+		}}
+		sc := seedStaged(d, artist.ID)
+		sc.ReschedulesEventID = new("event-moved")
+
+		sub, err := d.publisher.Subscribe(context.Background(), entity.SubjectConcertRescheduled)
+		require.NoError(t, err)
+
+		require.NoError(t, d.uc.Approve(context.Background(), sc.ID))
+
+		assert.Equal(t, []string{"event-moved"}, d.concertRepo.rescheduled)
+		assert.Empty(t, d.concertRepo.created, "a reschedule publishes no new event")
+		assert.Empty(t, d.venueRepo.created)
+		assert.Empty(t, d.stagedRepo.upserted, "the staged row is deleted")
+
+		select {
+		case msg := <-sub:
+			msg.Ack()
+			var data entity.ConcertRescheduledData
+			require.NoError(t, messaging.ParseCloudEventData(msg, &data))
+			assert.Equal(t, "event-moved", data.EventID)
+			assert.Equal(t, artist.ID, data.ArtistID)
+			assert.True(t, oldDate.Equal(data.PreviousLocalDate))
+			assert.True(t, sc.LocalDate.Equal(data.LocalDate))
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for CONCERT.rescheduled")
+		}
+	})
+
+	t.Run("approve does not move an event already on the staged date", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		sc := seedStaged(d, artist.ID)
+		sc.ReschedulesEventID = new("event-moved")
+		// A previous approval moved the event but failed to delete the row.
+		d.concertRepo.published = []*entity.Concert{{
+			Event: entity.Event{ID: "event-moved", LocalDate: sc.LocalDate},
+		}}
+
+		require.NoError(t, d.uc.Approve(context.Background(), sc.ID))
+
+		assert.Empty(t, d.concertRepo.rescheduled, "the recorded previous date is kept")
+		assert.Empty(t, d.stagedRepo.upserted)
+	})
+
+	t.Run("approve keeps a reschedule whose event is gone for rejection", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		sc := seedStaged(d, artist.ID)
+		sc.ReschedulesEventID = new("event-deleted")
+
+		err := d.uc.Approve(context.Background(), sc.ID)
+		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+
+		assert.Empty(t, d.concertRepo.created, "a gone event is not republished")
+		assert.Len(t, d.stagedRepo.upserted, 1, "the staged row is preserved")
+	})
+
 	t.Run("approve is idempotent when staged row is already gone", func(t *testing.T) {
 		t.Parallel()
 		d := newApprovalTestDeps(t, artist)
@@ -232,7 +355,7 @@ func TestAdminConcertUseCase_Approve(t *testing.T) {
 		stagedRepo := &fakeStagedConcertRepo{}
 		ps := newStubPlaceSearcher()
 		ps.places["Hall X"] = &entity.VenuePlace{ExternalID: "place-x", Name: "Hall X Canonical"}
-		discoveryUC := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		discoveryUC := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		pubForDiscovery := newGoChannelPub(t)
 		ctx := context.Background()
@@ -274,6 +397,69 @@ func TestAdminConcertUseCase_Approve(t *testing.T) {
 			t.Fatal("expected CONCERT.created from Approve but got none")
 		}
 	})
+
+	t.Run("new venue publishes VENUE.enrichment_requested exactly once", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		first := seedStaged(d, artist.ID)
+		// A second show at the same place reuses the venue the first created.
+		second := *first
+		second.ID = "staged-002"
+		second.LocalDate = first.LocalDate.AddDate(0, 0, 1)
+		d.stagedRepo.upserted = append(d.stagedRepo.upserted, &second)
+
+		ctx := context.Background()
+		sub, err := d.publisher.Subscribe(ctx, entity.SubjectVenueEnrichmentRequested)
+		require.NoError(t, err)
+
+		require.NoError(t, d.uc.Approve(ctx, first.ID))
+		require.NoError(t, d.uc.Approve(ctx, second.ID))
+		require.Len(t, d.venueRepo.created, 1)
+
+		select {
+		case msg := <-sub:
+			msg.Ack()
+			var requested entity.VenueEnrichmentRequestedData
+			require.NoError(t, messaging.ParseCloudEventData(msg, &requested))
+			assert.Equal(t, d.venueRepo.created[0].ID, requested.VenueID)
+			assert.Equal(t, "Venue ABC", requested.ListedVenueName)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for VENUE.enrichment_requested event")
+		}
+		select {
+		case msg := <-sub:
+			t.Fatalf("unexpected second VENUE.enrichment_requested: %s", msg.Payload)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("venue created concurrently for the place is reused without publishing", func(t *testing.T) {
+		t.Parallel()
+		d := newApprovalTestDeps(t, artist)
+		sc := seedStaged(d, artist.ID)
+
+		// Another approval inserts the venue between the place lookup and
+		// this Create.
+		placeID := *sc.ResolvedPlaceID
+		d.venueRepo.onCreate = func(*entity.Venue) error {
+			d.venueRepo.venues["concurrent"] = &entity.Venue{ID: "venue-concurrent", Name: "Venue ABC Canonical", GooglePlaceID: &placeID}
+			return apperr.New(codes.AlreadyExists, "duplicate place ID")
+		}
+
+		ctx := context.Background()
+		sub, err := d.publisher.Subscribe(ctx, entity.SubjectVenueEnrichmentRequested)
+		require.NoError(t, err)
+
+		require.NoError(t, d.uc.Approve(ctx, sc.ID))
+
+		require.Len(t, d.concertRepo.created, 1)
+		assert.Equal(t, "venue-concurrent", d.concertRepo.created[0].VenueID)
+		select {
+		case msg := <-sub:
+			t.Fatalf("unexpected VENUE.enrichment_requested for a reused venue: %s", msg.Payload)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
 }
 
 func TestAdminConcertUseCase_Reject(t *testing.T) {
@@ -20,36 +20,57 @@ type ConcertCreationUseCase interface {
 	// CreateFromDiscovered processes a batch of scraped concerts for a single
 	// artist. For each concert it resolves a venue via Google Places API and
 	// stages the result in staged_concerts for admin review. Concerts whose
-	// venues cannot be resolved are skipped with a structured log. CONCERT.created
-	// is NOT published here; it is published only when a staged row is approved
-	// via AdminConcertUseCase.Approve.
+	// venues cannot be resolved are staged without a resolved venue; a failed
+	// place search aborts the batch unless the use case was built to create
+	// such concerts as venueless events instead. CONCERT.created is NOT
+	// published here; it is published only when a staged row is approved via
+	// AdminConcertUseCase.Approve.
 	CreateFromDiscovered(ctx context.Context, data entity.ConcertDiscoveredData) error
 }
 
 // concertCreationUseCase implements ConcertCreationUseCase.
 type concertCreationUseCase struct {
 	stagedConcertRepo entity.StagedConcertRepository
+	seriesRepo        entity.SeriesRepository
+	concertRepo       entity.ConcertRepository
 	placeSearcher     entity.VenuePlaceSearcher
-	logger            *logging.Logger
+	// createVenuelessOnPlaceError creates a concert whose place search fails
+	// as an event pending venue resolution, instead of failing the batch.
+	createVenuelessOnPlaceError bool
+	logger                      *logging.Logger
 }
 
 // Compile-time interface compliance check.
 var _ ConcertCreationUseCase = (*concertCreationUseCase)(nil)
 
 // NewConcertCreationUseCase creates a new ConcertCreationUseCase.
-// placeSearcher must not be nil; panics if not provided.
+// placeSearcher must not be nil; panics if not provided. When
+// createVenuelessOnPlaceError is set, a concert whose place search fails is
+// created as an event carrying only its listed venue name and a NULL venue_id,
+// for the venue-resolution backfill job to link later, rather than failing the
+// batch and losing its other concerts to the retry. seriesRepo and
+// concertRepo are only used on that path and must be set when it is enabled.
 func NewConcertCreationUseCase(
 	stagedConcertRepo entity.StagedConcertRepository,
+	seriesRepo entity.SeriesRepository,
+	concertRepo entity.ConcertRepository,
 	placeSearcher entity.VenuePlaceSearcher,
+	createVenuelessOnPlaceError bool,
 	logger *logging.Logger,
 ) ConcertCreationUseCase {
 	if placeSearcher == nil {
 		panic("placeSearcher is required")
 	}
+	if createVenuelessOnPlaceError && (seriesRepo == nil || concertRepo == nil) {
+		panic("seriesRepo and concertRepo are required to create venueless concerts")
+	}
 	return &concertCreationUseCase{
-		stagedConcertRepo: stagedConcertRepo,
-		placeSearcher:     placeSearcher,
-		logger:            logger,
+		stagedConcertRepo:           stagedConcertRepo,
+		seriesRepo:                  seriesRepo,
+		concertRepo:                 concertRepo,
+		placeSearcher:               placeSearcher,
+		createVenuelessOnPlaceError: createVenuelessOnPlaceError,
+		logger:                      logger,
 	}
 }
 
@@ -58,11 +79,17 @@ func NewConcertCreationUseCase(
 //
 // Venue resolution strategy (same as before):
 //  1. Call Google Places API to get canonical place_id, name, and coordinates.
-//  2. If NotFound, skip the concert with a Warn.
+//  2. If NotFound or ambiguous, stage the concert without resolved fields.
+//     Any other place search failure aborts the batch, or, with
+//     createVenuelessOnPlaceError, creates the concert as an event pending
+//     venue resolution (see createVenueless) instead of staging it.
 //  3. Denormalise the resolved venue fields onto the staged_concerts row.
 //
-// No venues row is created here. No events, series, or performers are
-// inserted. No CONCERT.created event is published.
+// A reschedule of a published concert skips venue resolution and is staged
+// with the event it moves, for approval to apply.
+//
+// No venues row is created here, and outside the venueless path no events,
+// series, or performers are inserted. No CONCERT.created event is published.
 func (uc *concertCreationUseCase) CreateFromDiscovered(ctx context.Context, data entity.ConcertDiscoveredData) error {
 	// Batch-local place cache: (listed_venue_name, admin_area) → *VenuePlace.
 	// Avoids redundant Places API calls for the same venue within one batch.
@@ -88,24 +115,43 @@ func (uc *concertCreationUseCase) CreateFromDiscovered(ctx context.Context, data
 			continue
 		}
 
-		place, err := uc.resolvePlace(ctx, sc.ListedVenueName, sc.AdminArea, newPlaces)
-		if err != nil {
-			return fmt.Errorf("resolve venue %q: %w", sc.ListedVenueName, err)
-		}
-
-		// A nil place means Google Places could not resolve the venue. We still
-		// stage the concert (with its resolved-venue preview absent) so a
-		// developer can review a venue the searcher could not resolve.
-		if place != nil {
-			newPlaces[venueKey(sc.ListedVenueName, sc.AdminArea)] = place
-		} else {
-			uc.logger.Info(ctx, "staging concert with unresolved venue for review",
-				slog.String("artist_id", data.ArtistID),
-				slog.String("title", sc.Title),
-				slog.String("listed_venue_name", sc.ListedVenueName),
-				slog.Any("admin_area", sc.AdminArea),
-				slog.String("local_date", sc.LocalDate.Format("2006-01-02")),
-			)
+		// A reschedule moves a published event that keeps its venue, so it is
+		// staged without a place lookup. In particular it must never take the
+		// venueless path, which would publish the new date as a second event.
+		var place *entity.VenuePlace
+		if sc.ReschedulesEventID == "" {
+			var err error
+			place, err = uc.resolvePlace(ctx, sc.ListedVenueName, sc.AdminArea, newPlaces)
+			if err != nil {
+				if !uc.createVenuelessOnPlaceError {
+					return fmt.Errorf("resolve venue %q: %w", sc.ListedVenueName, err)
+				}
+				uc.logger.Warn(ctx, "place search failed; creating concert pending venue resolution",
+					slog.String("artist_id", data.ArtistID),
+					slog.String("title", sc.Title),
+					slog.String("listed_venue_name", sc.ListedVenueName),
+					slog.Any("error", err),
+				)
+				if err := uc.createVenueless(ctx, data, sc); err != nil {
+					return fmt.Errorf("create venueless concert %q: %w", sc.Title, err)
+				}
+				continue
+			}
+
+			// A nil place means Google Places could not resolve the venue. We
+			// still stage the concert (with its resolved-venue preview absent)
+			// so a developer can review a venue the searcher could not resolve.
+			if place != nil {
+				newPlaces[venueKey(sc.ListedVenueName, sc.AdminArea)] = place
+			} else {
+				uc.logger.Info(ctx, "staging concert with unresolved venue for review",
+					slog.String("artist_id", data.ArtistID),
+					slog.String("title", sc.Title),
+					slog.String("listed_venue_name", sc.ListedVenueName),
+					slog.Any("admin_area", sc.AdminArea),
+					slog.String("local_date", sc.LocalDate.Format("2006-01-02")),
+				)
+			}
 		}
 
 		id, err := uuid.NewV7()
@@ -114,6 +160,10 @@ func (uc *concertCreationUseCase) CreateFromDiscovered(ctx context.Context, data
 		}
 
 		staged := buildStagedConcert(id.String(), data.ArtistID, sc, place)
+		if data.SearchSessionID != "" {
+			sessionID := data.SearchSessionID
+			staged.SearchSessionID = &sessionID
+		}
 
 		if err := uc.stagedConcertRepo.Upsert(ctx, staged); err != nil {
 			return fmt.Errorf("upsert staged concert %q: %w", sc.Title, err)
@@ -122,6 +172,7 @@ func (uc *concertCreationUseCase) CreateFromDiscovered(ctx context.Context, data
 		uc.logger.Info(ctx, "staged concert queued for approval",
 			slog.String("artist_id", data.ArtistID),
 			slog.String("staged_concert_id", staged.ID),
+			slog.String("search_session_id", data.SearchSessionID),
 			slog.String("title", sc.Title),
 			slog.String("local_date", sc.LocalDate.Format("2006-01-02")),
 		)
@@ -130,6 +181,50 @@ func (uc *concertCreationUseCase) CreateFromDiscovered(ctx context.Context, data
 	return nil
 }
 
+// createVenueless inserts sc as a published event with a NULL venue_id and its
+// listed venue name, under a newly minted series. The venue-resolution
+// backfill job links it to a venue once the place search recovers. A
+// redelivered batch collapses onto the same event through its natural key,
+// which keys a venueless event by its listed venue name.
+//
+// The concert bypasses staging because the staging review hinges on the
+// resolved venue this path does not have; CONCERT.created is still not
+// published for it.
+func (uc *concertCreationUseCase) createVenueless(ctx context.Context, data entity.ConcertDiscoveredData, sc *entity.ScrapedConcert) error {
+	seriesID, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("generate series ID: %w", err)
+	}
+	eventID, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("generate event ID: %w", err)
+	}
+
+	concert := sc.ToConcert(data.ArtistID, seriesID.String(), eventID.String(), "", sc.SeriesType())
+	if data.SearchSessionID != "" {
+		sessionID := data.SearchSessionID
+		concert.SearchSessionID = &sessionID
+	}
+
+	if _, err := uc.seriesRepo.Create(ctx, concert.Series); err != nil {
+		return fmt.Errorf("create series: %w", err)
+	}
+	insertedIDs, err := uc.concertRepo.Create(ctx, concert)
+	if err != nil {
+		return fmt.Errorf("create concert: %w", err)
+	}
+
+	uc.logger.Info(ctx, "created concert pending venue resolution",
+		slog.String("artist_id", data.ArtistID),
+		slog.String("event_id", concert.ID),
+		slog.String("title", sc.Title),
+		slog.String("listed_venue_name", sc.ListedVenueName),
+		slog.String("local_date", sc.LocalDate.Format("2006-01-02")),
+		slog.Int("inserted", len(insertedIDs)),
+	)
+	return nil
+}
+
 // buildStagedConcert constructs a StagedConcert from a scraped concert and the
 // resolved VenuePlace. When place is nil the resolved_* fields stay nil (the
 // venue could not be resolved — this path is only reached when the caller has
@@ -141,6 +236,11 @@ func buildStagedConcert(id, artistID string, sc *entity.ScrapedConcert, place *e
 		Title:           sc.Title,
 		LocalDate:       sc.LocalDate,
 		ListedVenueName: sc.ListedVenueName,
+		IsFestival:      sc.IsFestival,
+	}
+	if sc.ReschedulesEventID != "" {
+		eventID := sc.ReschedulesEventID
+		staged.ReschedulesEventID = &eventID
 	}
 	staged.StartTime = entity.NullableTime(sc.StartTime)
 	staged.OpenTime = entity.NullableTime(sc.OpenTime)
@@ -169,10 +269,11 @@ func buildStagedConcert(id, artistID string, sc *entity.ScrapedConcert, place *e
 // It uses a batch-local cache to avoid redundant API calls within one
 // CreateFromDiscovered invocation.
 //
-// Returns (place, nil) on success, (nil, nil) when the place is not found, or
-// (nil, err) on error. A nil place is NOT a skip signal: the concert is still
-// staged for review with its resolved-venue preview absent, so a developer can
-// judge a venue that Google Places could not resolve.
+// Returns (place, nil) on success, (nil, nil) when the place is not found or
+// the match is ambiguous, or (nil, err) on error. A nil place is NOT a skip
+// signal: the concert is still staged for review with its resolved-venue
+// preview absent, so a developer can judge a venue that the place search could
+// not resolve unambiguously.
 func (uc *concertCreationUseCase) resolvePlace(
 	ctx context.Context,
 	name string,
@@ -184,14 +285,14 @@ func (uc *concertCreationUseCase) resolvePlace(
 		return p, nil
 	}
 
-	// Step 2: call Google Places API.
+	// Step 2: call the external place search.
 	area := ""
 	if adminArea != nil {
 		area = *adminArea
 	}
 	place, err := uc.placeSearcher.SearchPlace(ctx, name, area)
 	if err != nil {
-		if errors.Is(err, apperr.ErrNotFound) {
+		if errors.Is(err, apperr.ErrNotFound) || errors.Is(err, apperr.ErrFailedPrecondition) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("search place %q: %w", name, err)
@@ -208,12 +309,15 @@ func (uc *concertCreationUseCase) resolvePlace(
 // venue-resolution step (already done at staging time).
 //
 // sc carries the approved scraped data; resolvedVenueID is the venues.id of
-// the resolved (or newly created) venue for this concert.
+// the resolved (or newly created) venue for this concert. searchSessionID is
+// the discovery session that staged it, recorded on the event if it is newly
+// inserted; nil when the staged row carries none.
 func buildAndInsertConcerts(
 	ctx context.Context,
 	artistID string,
 	sc *entity.ScrapedConcert,
 	resolvedVenueID string,
+	searchSessionID *string,
 	seriesRepo entity.SeriesRepository,
 	concertRepo entity.ConcertRepository,
 	logger *logging.Logger,
@@ -239,15 +343,13 @@ func buildAndInsertConcerts(
 			knownStartAt[venueDateKey(ev.VenueID, ev.LocalDate)] = true
 		}
 	}
-	if !sc.StartTime.IsZero() {
+	if sc.EventStartTime() != nil {
 		knownStartAt[venueDateKey(resolvedVenueID, sc.LocalDate)] = true
 	}
 
-	// Determine series type and match against existing events.
-	seriesType := entity.SeriesTypeSingle
-	if sc.IsTour {
-		seriesType = entity.SeriesTypeTour
-	}
+	// Determine series type and match against existing events. A festival
+	// appearance matches the day's shared event and adopts its series.
+	seriesType := sc.SeriesType()
 
 	claimedFill := make(map[string]bool)
 	cands := existingByVenueDate[venueDateKey(resolvedVenueID, sc.LocalDate)]
@@ -255,7 +357,9 @@ func buildAndInsertConcerts(
 
 	// If an unknown-start concert exists but a known-start row already covers
 	// this (venue, date), skip to avoid creating a phantom NULL-start duplicate.
-	if sc.StartTime.IsZero() && knownStartAt[venueDateKey(resolvedVenueID, sc.LocalDate)] && match == nil {
+	// A festival appearance is exempt: its event is keyed on the day alone and
+	// never collides with a show that has a start time.
+	if !sc.IsFestival && sc.StartTime.IsZero() && knownStartAt[venueDateKey(resolvedVenueID, sc.LocalDate)] && match == nil {
 		logger.Warn(ctx, "skipping approve: unknown-start concert, known-start row already exists",
 			slog.String("artist_id", artistID),
 			slog.String("title", sc.Title),
@@ -310,6 +414,7 @@ func buildAndInsertConcerts(
 		return nil, fmt.Errorf("generate event ID: %w", err)
 	}
 	concert := sc.ToConcert(artistID, seriesID, eventID.String(), resolvedVenueID, seriesType)
+	concert.SearchSessionID = searchSessionID
 
 	insertedIDs, err := concertRepo.Create(ctx, concert)
 	if err != nil {
@@ -338,7 +443,7 @@ func venueDateKey(venueID string, d time.Time) string {
 // unknown-start skip decision, and fill detection, so all three agree on what
 // "the same physical show" means.
 func resolveExistingEvent(cands []*entity.Event, sc *entity.ScrapedConcert, claimedFill map[string]bool) (*entity.Event, bool) {
-	incoming := entity.StartKey(entity.NullableTime(sc.StartTime))
+	incoming := entity.StartKey(sc.EventStartTime())
 	var nullRow *entity.Event
 	for _, ev := range cands {
 		evStart := entity.StartKey(ev.StartTime)
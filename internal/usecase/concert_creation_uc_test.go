@@ -2,6 +2,8 @@ package usecase_test
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,6 +24,9 @@ import (
 type fakeVenueRepo struct {
 	venues  map[string]*entity.Venue
 	created []*entity.Venue
+	// onCreate, when set, runs before Create stores a venue; a non-nil error
+	// is returned from Create and the venue is not stored.
+	onCreate func(v *entity.Venue) error
 }
 
 func newFakeVenueRepo() *fakeVenueRepo {
@@ -29,6 +34,11 @@ func newFakeVenueRepo() *fakeVenueRepo {
 }
 
 func (r *fakeVenueRepo) Create(_ context.Context, v *entity.Venue) error {
+	if r.onCreate != nil {
+		if err := r.onCreate(v); err != nil {
+			return err
+		}
+	}
 	r.venues[v.Name] = v
 	r.created = append(r.created, v)
 	return nil
@@ -67,6 +77,100 @@ func (r *fakeVenueRepo) GetByListedName(_ context.Context, listedVenueName strin
 	return nil, apperr.New(codes.NotFound, "venue not found")
 }
 
+func (r *fakeVenueRepo) GetByNormalizedName(_ context.Context, name string, adminArea *string) (*entity.Venue, error) {
+	want := strings.ToLower(strings.TrimSpace(name))
+	for _, v := range r.venues {
+		listed := v.ListedVenueName != nil && strings.ToLower(strings.TrimSpace(*v.ListedVenueName)) == want
+		if !listed && strings.ToLower(strings.TrimSpace(v.Name)) != want {
+			continue
+		}
+		if adminArea == nil && v.AdminArea == nil {
+			return v, nil
+		}
+		if adminArea != nil && v.AdminArea != nil && *adminArea == *v.AdminArea {
+			return v, nil
+		}
+	}
+	return nil, apperr.New(codes.NotFound, "venue not found")
+}
+
+func (r *fakeVenueRepo) UpdateEnriched(_ context.Context, venueID string, place *entity.VenuePlace) error {
+	for _, v := range r.venues {
+		if v.ID == venueID {
+			v.Name = place.Name
+			v.GooglePlaceID = &place.ExternalID
+			v.Coordinates = place.Coordinates
+			return nil
+		}
+	}
+	return apperr.New(codes.NotFound, "venue not found")
+}
+
+func (r *fakeVenueRepo) UpdateMBID(ctx context.Context, venueID, _ string) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) MarkFailed(ctx context.Context, venueID string) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) MarkRetryable(ctx context.Context, venueID string) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) MarkDuplicate(ctx context.Context, venueID, _ string) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) MarkNeedsReview(ctx context.Context, venueID string, _ *entity.VenuePlace, _ float64) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) ListNeedsReview(context.Context, int, int) ([]*entity.EnrichmentReview, error) {
+	return nil, nil
+}
+
+func (r *fakeVenueRepo) ApproveEnrichment(ctx context.Context, venueID string) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) RejectEnrichment(ctx context.Context, venueID string) error {
+	_, err := r.Get(ctx, venueID)
+	return err
+}
+
+func (r *fakeVenueRepo) ListByEnrichmentStatus(context.Context, entity.EnrichmentStatus, int, int) ([]*entity.Venue, error) {
+	return nil, nil
+}
+
+func (r *fakeVenueRepo) ListEnrichmentRetryCandidates(context.Context, time.Time, time.Duration, int, int) ([]*entity.Venue, error) {
+	return nil, nil
+}
+
+func (r *fakeVenueRepo) UpsertByNames(_ context.Context, venues []*entity.Venue) (map[string]*entity.Venue, error) {
+	out := make(map[string]*entity.Venue, len(venues))
+	for _, v := range venues {
+		existing, ok := r.venues[v.Name]
+		if !ok {
+			existing = v
+			r.venues[v.Name] = v
+			r.created = append(r.created, v)
+		}
+		out[v.Name] = existing
+	}
+	return out, nil
+}
+
+func (r *fakeVenueRepo) MergeVenues(_ context.Context, canonicalID, duplicateID string, dryRun bool) (*entity.MergeReport, error) {
+	return &entity.MergeReport{CanonicalID: canonicalID, DuplicateID: duplicateID, DryRun: dryRun}, nil
+}
+
 type fakeSeriesRepo struct {
 	created []*entity.Series
 }
@@ -110,8 +214,11 @@ type fakeConcertRepo struct {
 	// filledIDs / filledStarts capture FillEventStartTimes calls.
 	filledIDs    []string
 	filledStarts []*time.Time
-	// published holds concerts returned by List; admin tests seed this directly.
+	// published holds concerts returned by List and ListByIDs; admin tests
+	// seed this directly.
 	published []*entity.Concert
+	// rescheduled captures the event IDs passed to Reschedule.
+	rescheduled []string
 	// deleteCalled records whether Delete was invoked; admin tests assert on this.
 	deleteCalled bool
 }
@@ -120,6 +227,10 @@ func (r *fakeConcertRepo) ListByArtist(_ context.Context, _ string, _ bool) ([]*
 	return nil, nil
 }
 
+func (r *fakeConcertRepo) ListByVenue(_ context.Context, _ string, _ bool) ([]*entity.Concert, error) {
+	return nil, nil
+}
+
 func (r *fakeConcertRepo) FindEventsByVenueAndDate(_ context.Context, venueIDs []string, dates []time.Time) ([]*entity.Event, error) {
 	if r.existing == nil {
 		return nil, nil
@@ -144,18 +255,75 @@ func (r *fakeConcertRepo) FillEventStartTimes(_ context.Context, eventIDs []stri
 	return nil
 }
 
-func (r *fakeConcertRepo) ListByFollower(_ context.Context, _ string) ([]*entity.Concert, error) {
+func (r *fakeConcertRepo) Update(_ context.Context, _ *entity.Concert) error {
+	return nil
+}
+
+func (r *fakeConcertRepo) SoftDelete(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *fakeConcertRepo) ListByArtistAll(_ context.Context, _ string, _ bool) ([]*entity.Concert, error) {
+	return nil, nil
+}
+
+func (r *fakeConcertRepo) ExistsBySource(_ context.Context, _, _ string, _ time.Time, _ string) (bool, error) {
+	return false, nil
+}
+
+func (r *fakeConcertRepo) ListUpcomingGlobal(_ context.Context, _ entity.UpcomingConcertFilter, _ int, _ string) ([]*entity.Concert, string, error) {
+	return nil, "", nil
+}
+
+func (r *fakeConcertRepo) Search(_ context.Context, _ string, _ int) ([]*entity.Concert, error) {
 	return nil, nil
 }
 
+func (r *fakeConcertRepo) Reschedule(_ context.Context, eventID string, date time.Time, _, _ *time.Time) error {
+	for _, c := range r.published {
+		if c.ID == eventID {
+			r.rescheduled = append(r.rescheduled, eventID)
+			c.LocalDate = date
+			return nil
+		}
+	}
+	return apperr.New(codes.NotFound, "event not found")
+}
+
+func (r *fakeConcertRepo) ListByFollower(_ context.Context, _ string, _ int, _ string) ([]*entity.Concert, string, error) {
+	return nil, "", nil
+}
+
 func (r *fakeConcertRepo) ListByArtists(_ context.Context, _ []string) ([]*entity.Concert, error) {
 	return nil, nil
 }
 
-func (r *fakeConcertRepo) ListByIDs(_ context.Context, _ []string) ([]*entity.Concert, error) {
+func (r *fakeConcertRepo) ListByIDs(_ context.Context, ids []string) ([]*entity.Concert, error) {
+	var result []*entity.Concert
+	for _, c := range r.published {
+		if slices.Contains(ids, c.ID) {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakeConcertRepo) ListBySearchSession(_ context.Context, _ string) ([]*entity.Concert, error) {
+	return nil, nil
+}
+
+func (r *fakeConcertRepo) ListPendingVenue(_ context.Context, _ int) ([]*entity.Concert, error) {
 	return nil, nil
 }
 
+func (r *fakeConcertRepo) MarkVenueResolutionAttempted(_ context.Context, _ []string) error {
+	return nil
+}
+
+func (r *fakeConcertRepo) AssignVenue(_ context.Context, eventID, _ string) (string, error) {
+	return eventID, nil
+}
+
 func (r *fakeConcertRepo) Create(_ context.Context, concerts ...*entity.Concert) ([]string, error) {
 	r.created = append(r.created, concerts...)
 	ids := make([]string, 0, len(concerts))
@@ -227,13 +395,20 @@ func (r *fakeStagedConcertRepo) ListPendingDedupKeysByArtist(_ context.Context,
 // stubPlaceSearcher returns pre-configured results keyed by venue name.
 type stubPlaceSearcher struct {
 	places map[string]*entity.VenuePlace
+	errs   map[string]error
+	// searched records every name searched, in call order.
+	searched []string
 }
 
 func newStubPlaceSearcher() *stubPlaceSearcher {
-	return &stubPlaceSearcher{places: make(map[string]*entity.VenuePlace)}
+	return &stubPlaceSearcher{places: make(map[string]*entity.VenuePlace), errs: make(map[string]error)}
 }
 
 func (s *stubPlaceSearcher) SearchPlace(_ context.Context, name, _ string) (*entity.VenuePlace, error) {
+	s.searched = append(s.searched, name)
+	if err, ok := s.errs[name]; ok {
+		return nil, err
+	}
 	if p, ok := s.places[name]; ok {
 		return p, nil
 	}
@@ -278,7 +453,7 @@ func TestConcertCreationUseCase_CreateFromDiscovered(t *testing.T) {
 		ps := newStubPlaceSearcher()
 		ps.places["Venue X"] = &entity.VenuePlace{ExternalID: "place-x", Name: "Venue X Canonical"}
 		ps.places["Venue Y"] = &entity.VenuePlace{ExternalID: "place-y", Name: "Venue Y Canonical"}
-		uc := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		data := entity.ConcertDiscoveredData{
 			ArtistID:   "artist-1",
@@ -314,13 +489,56 @@ func TestConcertCreationUseCase_CreateFromDiscovered(t *testing.T) {
 		assert.True(t, stagedRepo.upserted[0].StartTime.Equal(startTime))
 	})
 
+	t.Run("stages concerts under the batch's search session", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		ps := newStubPlaceSearcher()
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
+
+		concerts := entity.ScrapedConcerts{
+			{Title: "Session Concert", ListedVenueName: "Venue S", LocalDate: localDate},
+		}
+		require.NoError(t, uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID:        "artist-s",
+			Concerts:        concerts,
+			SearchSessionID: "01900000-0000-7000-8000-000000000002",
+		}))
+		require.NoError(t, uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID: "artist-s",
+			Concerts: concerts,
+		}))
+
+		require.Len(t, stagedRepo.upserted, 2)
+		require.NotNil(t, stagedRepo.upserted[0].SearchSessionID)
+		assert.Equal(t, "01900000-0000-7000-8000-000000000002", *stagedRepo.upserted[0].SearchSessionID)
+		assert.Nil(t, stagedRepo.upserted[1].SearchSessionID, "a payload without a session stages without one")
+	})
+
+	t.Run("stages a festival appearance as one", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, newStubPlaceSearcher(), false, newTestLogger(t))
+
+		require.NoError(t, uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID: "artist-f",
+			Concerts: entity.ScrapedConcerts{
+				{Title: "Summer Fes", ListedVenueName: "Fes Park", LocalDate: localDate, StartTime: startTime, IsFestival: true},
+				{Title: "Solo Show", ListedVenueName: "Venue S", LocalDate: localDate},
+			},
+		}))
+
+		require.Len(t, stagedRepo.upserted, 2)
+		assert.True(t, stagedRepo.upserted[0].IsFestival)
+		assert.False(t, stagedRepo.upserted[1].IsFestival)
+	})
+
 	t.Run("stages concerts with unresolved venue for review (resolved fields absent)", func(t *testing.T) {
 		t.Parallel()
 		stagedRepo := &fakeStagedConcertRepo{}
 		ps := newStubPlaceSearcher()
 		ps.places["Known Venue"] = &entity.VenuePlace{ExternalID: "place-known", Name: "Known Venue"}
 		// "Unknown Venue" is NOT in ps.places → SearchPlace returns NotFound
-		uc := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		data := entity.ConcertDiscoveredData{
 			ArtistID:   "artist-4",
@@ -358,12 +576,143 @@ func TestConcertCreationUseCase_CreateFromDiscovered(t *testing.T) {
 		assert.Nil(t, byTitle["Concert at Unknown"].ResolvedVenueName)
 	})
 
+	t.Run("stages ambiguous venue match for review (resolved fields absent)", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		ps := newStubPlaceSearcher()
+		ps.errs["Club Quattro"] = apperr.New(codes.FailedPrecondition, "multiple places match the venue name")
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
+
+		data := entity.ConcertDiscoveredData{
+			ArtistID:   "artist-4b",
+			ArtistName: "Fourth Artist B",
+			Concerts: entity.ScrapedConcerts{
+				{
+					Title:           "Concert at Quattro",
+					ListedVenueName: "Club Quattro",
+					LocalDate:       localDate,
+					SourceURL:       "https://example.com/quattro",
+				},
+			},
+		}
+
+		err := uc.CreateFromDiscovered(context.Background(), data)
+		require.NoError(t, err)
+
+		require.Len(t, stagedRepo.upserted, 1)
+		assert.Nil(t, stagedRepo.upserted[0].ResolvedPlaceID)
+		assert.Nil(t, stagedRepo.upserted[0].ResolvedVenueName)
+	})
+
+	t.Run("place search failure aborts the batch by default", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		ps := newStubPlaceSearcher()
+		ps.errs["Flaky Hall"] = apperr.New(codes.Unavailable, "places API unavailable")
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
+
+		err := uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID: "artist-flaky",
+			Concerts: entity.ScrapedConcerts{
+				{Title: "Flaky Show", ListedVenueName: "Flaky Hall", LocalDate: localDate},
+			},
+		})
+
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+		assert.Empty(t, stagedRepo.upserted)
+	})
+
+	t.Run("place search failure creates a venueless concert when opted in", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		seriesRepo := &fakeSeriesRepo{}
+		concertRepo := &fakeConcertRepo{}
+		ps := newStubPlaceSearcher()
+		ps.places["Known Venue"] = &entity.VenuePlace{ExternalID: "place-known", Name: "Known Venue"}
+		ps.errs["Flaky Hall"] = apperr.New(codes.Unavailable, "places API unavailable")
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, seriesRepo, concertRepo, ps, true, newTestLogger(t))
+
+		err := uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID:        "artist-flaky",
+			SearchSessionID: "session-flaky",
+			Concerts: entity.ScrapedConcerts{
+				{Title: "Flaky Show", ListedVenueName: "Flaky Hall", LocalDate: localDate, SourceURL: "https://example.com/flaky"},
+				{Title: "Known Show", ListedVenueName: "Known Venue", LocalDate: localDate},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, concertRepo.created, 1, "the failed lookup must not drop its concert")
+		flaky := concertRepo.created[0]
+		assert.Empty(t, flaky.VenueID, "venue is pending resolution")
+		require.NotNil(t, flaky.ListedVenueName)
+		assert.Equal(t, "Flaky Hall", *flaky.ListedVenueName)
+		assert.Equal(t, localDate, flaky.LocalDate)
+		require.Len(t, flaky.Performers, 1)
+		assert.Equal(t, "artist-flaky", flaky.Performers[0].ID)
+		require.NotNil(t, flaky.SearchSessionID)
+		assert.Equal(t, "session-flaky", *flaky.SearchSessionID)
+
+		require.Len(t, seriesRepo.created, 1)
+		assert.Equal(t, flaky.SeriesID, seriesRepo.created[0].ID)
+		assert.Equal(t, "Flaky Show", seriesRepo.created[0].Title)
+		assert.Equal(t, "https://example.com/flaky", seriesRepo.created[0].SourceURL)
+
+		require.Len(t, stagedRepo.upserted, 1, "only the resolved concert is staged")
+		assert.Equal(t, "Known Show", stagedRepo.upserted[0].Title)
+		require.NotNil(t, stagedRepo.upserted[0].ResolvedPlaceID)
+		assert.Equal(t, "place-known", *stagedRepo.upserted[0].ResolvedPlaceID)
+	})
+
+	t.Run("reschedule is staged without a place lookup even when place search fails", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		concertRepo := &fakeConcertRepo{}
+		ps := newStubPlaceSearcher()
+		ps.errs["Flaky Hall"] = apperr.New(codes.Unavailable, "places API unavailable")
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, &fakeSeriesRepo{}, concertRepo, ps, true, newTestLogger(t))
+
+		err := uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID: "artist-moved",
+			Concerts: entity.ScrapedConcerts{
+				{Title: "Moved Show", ListedVenueName: "Flaky Hall", LocalDate: localDate, ReschedulesEventID: "event-moved"},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, ps.searched, "the moved event keeps its venue")
+		assert.Empty(t, concertRepo.created, "a reschedule never takes the venueless path")
+		require.Len(t, stagedRepo.upserted, 1)
+		require.NotNil(t, stagedRepo.upserted[0].ReschedulesEventID)
+		assert.Equal(t, "event-moved", *stagedRepo.upserted[0].ReschedulesEventID)
+		assert.Nil(t, stagedRepo.upserted[0].ResolvedPlaceID)
+	})
+
+	t.Run("not-found venue is still staged when opted in", func(t *testing.T) {
+		t.Parallel()
+		stagedRepo := &fakeStagedConcertRepo{}
+		concertRepo := &fakeConcertRepo{}
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, &fakeSeriesRepo{}, concertRepo, newStubPlaceSearcher(), true, newTestLogger(t))
+
+		err := uc.CreateFromDiscovered(context.Background(), entity.ConcertDiscoveredData{
+			ArtistID: "artist-unknown",
+			Concerts: entity.ScrapedConcerts{
+				{Title: "Unknown Show", ListedVenueName: "Unknown Hall", LocalDate: localDate},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, concertRepo.created)
+		require.Len(t, stagedRepo.upserted, 1)
+		assert.Nil(t, stagedRepo.upserted[0].ResolvedPlaceID)
+	})
+
 	t.Run("skips concert with empty venue name without poisoning the batch", func(t *testing.T) {
 		t.Parallel()
 		stagedRepo := &fakeStagedConcertRepo{}
 		ps := newStubPlaceSearcher()
 		ps.places["Known Venue"] = &entity.VenuePlace{ExternalID: "place-known", Name: "Known Venue"}
-		uc := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		data := entity.ConcertDiscoveredData{
 			ArtistID:   "artist-empty-venue",
@@ -396,7 +745,7 @@ func TestConcertCreationUseCase_CreateFromDiscovered(t *testing.T) {
 		t.Parallel()
 		stagedRepo := &fakeStagedConcertRepo{}
 		ps := newStubPlaceSearcher() // empty — all venues return NotFound
-		uc := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		data := entity.ConcertDiscoveredData{
 			ArtistID:   "artist-5",
@@ -425,7 +774,7 @@ func TestConcertCreationUseCase_CreateFromDiscovered(t *testing.T) {
 		stagedRepo := &fakeStagedConcertRepo{}
 		ps := newStubPlaceSearcher()
 		ps.places["Zepp Osaka"] = &entity.VenuePlace{ExternalID: "place-zepp-osaka", Name: "Zepp Namba Osaka"}
-		uc := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		data := entity.ConcertDiscoveredData{
 			ArtistID:   "artist-batch",
@@ -461,7 +810,7 @@ func TestConcertCreationUseCase_CreateFromDiscovered(t *testing.T) {
 		stagedRepo := &fakeStagedConcertRepo{}
 		ps := newStubPlaceSearcher()
 		ps.places["Hall A"] = &entity.VenuePlace{ExternalID: "place-a", Name: "Hall A Canonical"}
-		uc := usecase.NewConcertCreationUseCase(stagedRepo, ps, newTestLogger(t))
+		uc := usecase.NewConcertCreationUseCase(stagedRepo, nil, nil, ps, false, newTestLogger(t))
 
 		pub := newGoChannelPub(t)
 		ctx := context.Background()
@@ -497,6 +846,14 @@ func TestNewConcertCreationUseCase_PanicsOnNilPlaceSearcher(t *testing.T) {
 	t.Parallel()
 
 	assert.Panics(t, func() {
-		usecase.NewConcertCreationUseCase(&fakeStagedConcertRepo{}, nil, newTestLogger(t))
+		usecase.NewConcertCreationUseCase(&fakeStagedConcertRepo{}, nil, nil, nil, false, newTestLogger(t))
+	})
+}
+
+func TestNewConcertCreationUseCase_PanicsOnVenuelessWithoutRepos(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		usecase.NewConcertCreationUseCase(&fakeStagedConcertRepo{}, nil, nil, newStubPlaceSearcher(), true, newTestLogger(t))
 	})
 }
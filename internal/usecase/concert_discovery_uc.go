@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// discoveryMaxConsecutiveErrors is the number of back-to-back search failures
+// that trips the discovery circuit breaker. Consecutive failures point at a
+// systemic problem (quota, outage) that the remaining artists would hit too.
+const discoveryMaxConsecutiveErrors = 3
+
+// ConcertDiscoveryUseCase defines the interface for the scheduled concert
+// discovery run over every followed artist.
+type ConcertDiscoveryUseCase interface {
+	// DiscoverAll runs SearchNewConcerts for every followed artist with a
+	// bounded number of searches in flight. A failure for one artist is
+	// logged and does not stop the run, but the run stops dispatching once
+	// the circuit breaker trips on consecutive failures. Searches that fail
+	// fast on an open SearchBreaker are reported apart from failures in the
+	// run summary. Cancelling ctx stops dispatching too; searches already in
+	// flight are waited for.
+	//
+	// # Possible errors
+	//
+	//  - Internal: If the followed artists cannot be listed.
+	DiscoverAll(ctx context.Context) error
+}
+
+// TokenUsageTally aggregates the LLM token usage of one discovery run per
+// artist. Its Record method matches gemini.Config.OnTokenUsage, so the job
+// wires the searcher straight into the tally the discovery use case reads
+// from. It is safe for concurrent use; a nil *TokenUsageTally records nothing
+// and reports zero usage.
+type TokenUsageTally struct {
+	mu       sync.Mutex
+	byArtist map[string]entity.TokenUsage
+	total    entity.TokenUsage
+}
+
+// NewTokenUsageTally creates an empty tally.
+func NewTokenUsageTally() *TokenUsageTally {
+	return &TokenUsageTally{byArtist: make(map[string]entity.TokenUsage)}
+}
+
+// Record adds usage to the artist's and the run's totals.
+func (t *TokenUsageTally) Record(_ context.Context, artistID string, usage entity.TokenUsage) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.byArtist[artistID] = t.byArtist[artistID].Add(usage)
+	t.total = t.total.Add(usage)
+}
+
+// ForArtist returns the usage recorded for the artist so far.
+func (t *TokenUsageTally) ForArtist(artistID string) entity.TokenUsage {
+	if t == nil {
+		return entity.TokenUsage{}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.byArtist[artistID]
+}
+
+// Total returns the usage recorded across all artists so far.
+func (t *TokenUsageTally) Total() entity.TokenUsage {
+	if t == nil {
+		return entity.TokenUsage{}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.total
+}
+
+// concertDiscoveryUseCase implements the ConcertDiscoveryUseCase interface.
+type concertDiscoveryUseCase struct {
+	followRepo entity.FollowRepository
+	concertUC  ConcertUseCase
+	// tokens receives the searcher's token usage; nil when the searcher
+	// does not report it.
+	tokens *TokenUsageTally
+	// concurrency is the number of SearchNewConcerts calls kept in flight.
+	concurrency int
+	logger      *logging.Logger
+}
+
+// Compile-time interface compliance check
+var _ ConcertDiscoveryUseCase = (*concertDiscoveryUseCase)(nil)
+
+// NewConcertDiscoveryUseCase creates a new concert discovery use case.
+// A concurrency below 1 is treated as 1 (sequential). tokens may be nil.
+func NewConcertDiscoveryUseCase(
+	followRepo entity.FollowRepository,
+	concertUC ConcertUseCase,
+	tokens *TokenUsageTally,
+	concurrency int,
+	logger *logging.Logger,
+) ConcertDiscoveryUseCase {
+	return &concertDiscoveryUseCase{
+		followRepo:  followRepo,
+		concertUC:   concertUC,
+		tokens:      tokens,
+		concurrency: max(concurrency, 1),
+		logger:      logger,
+	}
+}
+
+// DiscoverAll searches new concerts for every followed artist.
+func (uc *concertDiscoveryUseCase) DiscoverAll(ctx context.Context) error {
+	artists, err := uc.followRepo.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Info(ctx, "followed artists loaded for processing",
+		slog.Int("count", len(artists)),
+		slog.Int("concurrency", uc.concurrency),
+	)
+
+	var (
+		attempted   atomic.Int32
+		failed      atomic.Int32
+		unavailable atomic.Int32
+		consecutive atomic.Int32
+		tripped     atomic.Bool
+		// stop is closed when the breaker trips so a blocked dispatch
+		// returns immediately.
+		stop = make(chan struct{})
+		jobs = make(chan *entity.Artist)
+		wg   sync.WaitGroup
+	)
+
+	for range uc.concurrency {
+		wg.Go(func() {
+			for artist := range jobs {
+				// Skip artists handed out after the breaker tripped or the
+				// job was signalled; the dispatcher is already stopping.
+				if tripped.Load() || ctx.Err() != nil {
+					continue
+				}
+				attempted.Add(1)
+
+				// SearchNewConcerts calls the external API, deduplicates, and
+				// publishes a concert.discovered.v1 event. Concert persistence
+				// and notification are handled asynchronously by consumers.
+				_, err := uc.concertUC.SearchNewConcerts(ctx, artist.ID)
+				uc.logArtistTokenUsage(ctx, artist)
+				if err != nil {
+					// A fast-fail from the open search breaker is tallied
+					// apart from real failures but still counts toward
+					// tripping the run: the searcher is down for every
+					// remaining artist too.
+					if errors.Is(err, ErrSearchBreakerOpen) {
+						unavailable.Add(1)
+						uc.logger.Warn(ctx, "skipped concert search: searcher unavailable",
+							slog.String("artist_id", artist.ID),
+						)
+					} else {
+						failed.Add(1)
+						uc.logger.Error(ctx, "failed to search concerts for artist", err,
+							slog.String("artist_id", artist.ID),
+							slog.String("artist_name", artist.Name),
+						)
+					}
+					n := consecutive.Add(1)
+					if n >= discoveryMaxConsecutiveErrors && tripped.CompareAndSwap(false, true) {
+						uc.logger.Error(ctx, "circuit breaker activated: stopping after consecutive failures", nil,
+							slog.Int("consecutive_errors", int(n)),
+						)
+						close(stop)
+					}
+					continue
+				}
+				consecutive.Store(0)
+			}
+		})
+	}
+
+dispatch:
+	for _, artist := range artists {
+		// Stop immediately on SIGTERM instead of waiting for the circuit
+		// breaker to trip after consecutive cancelled API calls.
+		select {
+		case jobs <- artist:
+		case <-stop:
+			break dispatch
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Go 1.26: context.Cause returns the specific OS signal if shutdown was triggered.
+	if cause := context.Cause(ctx); cause != nil {
+		uc.logger.Info(ctx, "job interrupted by signal",
+			slog.String("cause", cause.Error()),
+		)
+	}
+
+	total := uc.tokens.Total()
+	uc.logger.Info(ctx, "concert discovery job complete",
+		slog.Int("artists_total", len(artists)),
+		slog.Int("artists_attempted", int(attempted.Load())),
+		slog.Int("artists_succeeded", int(attempted.Load()-failed.Load()-unavailable.Load())),
+		slog.Int("artists_unavailable", int(unavailable.Load())),
+		slog.Int("failures", int(failed.Load())),
+		slog.Int("prompt_tokens", total.PromptTokens),
+		slog.Int("candidates_tokens", total.CandidatesTokens),
+		slog.Int("thinking_tokens", total.ThinkingTokens),
+		slog.Int("total_tokens", total.TotalTokens),
+	)
+	return nil
+}
+
+// logArtistTokenUsage logs the tokens the artist's search consumed. Searches
+// skipped as fresh or served from the search cache call no model and log
+// nothing.
+func (uc *concertDiscoveryUseCase) logArtistTokenUsage(ctx context.Context, artist *entity.Artist) {
+	usage := uc.tokens.ForArtist(artist.ID)
+	if usage.TotalTokens == 0 {
+		return
+	}
+	uc.logger.Info(ctx, "concert search token usage",
+		slog.String("artist_id", artist.ID),
+		slog.Int("prompt_tokens", usage.PromptTokens),
+		slog.Int("candidates_tokens", usage.CandidatesTokens),
+		slog.Int("thinking_tokens", usage.ThinkingTokens),
+		slog.Int("total_tokens", usage.TotalTokens),
+	)
+}
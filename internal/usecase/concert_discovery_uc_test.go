@@ -0,0 +1,172 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func discoveryTestArtists(n int) []*entity.Artist {
+	artists := make([]*entity.Artist, n)
+	for i := range artists {
+		artists[i] = &entity.Artist{ID: fmt.Sprintf("artist-%d", i+1), Name: fmt.Sprintf("Artist %d", i+1)}
+	}
+	return artists
+}
+
+func TestConcertDiscoveryUseCase_DiscoverAll(t *testing.T) {
+	t.Parallel()
+
+	const concurrency = 3
+
+	t.Run("searches every artist with bounded concurrency", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		followRepo.EXPECT().ListAll(ctx).Return(discoveryTestArtists(10), nil).Once()
+		var inFlight, peak, calls atomic.Int32
+		concertUC.EXPECT().SearchNewConcerts(ctx, mock.Anything).
+			RunAndReturn(func(context.Context, string) ([]*entity.Concert, error) {
+				calls.Add(1)
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				return nil, nil
+			}).Times(10)
+
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, nil, concurrency, newTestLogger(t))
+		require.NoError(t, uc.DiscoverAll(ctx))
+
+		assert.Equal(t, int32(10), calls.Load())
+		assert.LessOrEqual(t, peak.Load(), int32(concurrency))
+	})
+
+	t.Run("stops after the circuit breaker trips", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		followRepo.EXPECT().ListAll(ctx).Return(discoveryTestArtists(10), nil).Once()
+		var calls atomic.Int32
+		concertUC.EXPECT().SearchNewConcerts(ctx, mock.Anything).
+			RunAndReturn(func(context.Context, string) ([]*entity.Concert, error) {
+				calls.Add(1)
+				return nil, apperr.ErrUnavailable
+			}).Maybe()
+
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, nil, concurrency, newTestLogger(t))
+		require.NoError(t, uc.DiscoverAll(ctx))
+
+		// The breaker trips on the third consecutive failure. Workers that
+		// had already picked up an artist may finish one more call each.
+		assert.GreaterOrEqual(t, calls.Load(), int32(3))
+		assert.LessOrEqual(t, calls.Load(), int32(3+concurrency-1))
+	})
+
+	t.Run("a success resets the consecutive failure count", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		artists := discoveryTestArtists(6)
+		followRepo.EXPECT().ListAll(ctx).Return(artists, nil).Once()
+		// fail, fail, ok, fail, fail, ok — never three failures in a row.
+		for i, a := range artists {
+			var err error
+			if i%3 != 2 {
+				err = apperr.ErrUnavailable
+			}
+			concertUC.EXPECT().SearchNewConcerts(ctx, a.ID).Return(nil, err).Once()
+		}
+
+		// Sequential so the failure order is deterministic.
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, nil, 1, newTestLogger(t))
+		require.NoError(t, uc.DiscoverAll(ctx))
+	})
+
+	t.Run("searcher-unavailable fast-fails trip the breaker", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		artists := discoveryTestArtists(10)
+		followRepo.EXPECT().ListAll(ctx).Return(artists, nil).Once()
+		breakerOpen := apperr.Wrap(usecase.ErrSearchBreakerOpen, codes.Unavailable, "searcher unavailable")
+		for _, a := range artists[:3] {
+			concertUC.EXPECT().SearchNewConcerts(ctx, a.ID).Return(nil, breakerOpen).Once()
+		}
+
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, nil, 1, newTestLogger(t))
+		require.NoError(t, uc.DiscoverAll(ctx))
+	})
+
+	t.Run("cancelled context dispatches nothing", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		followRepo.EXPECT().ListAll(ctx).Return(discoveryTestArtists(10), nil).Once()
+
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, nil, concurrency, newTestLogger(t))
+		require.NoError(t, uc.DiscoverAll(ctx))
+		concertUC.AssertNotCalled(t, "SearchNewConcerts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("sums the token usage of every search", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+		tokens := usecase.NewTokenUsageTally()
+
+		followRepo.EXPECT().ListAll(ctx).Return(discoveryTestArtists(4), nil).Once()
+		// Stands in for the searcher's OnTokenUsage callback, which fires
+		// inside SearchNewConcerts.
+		concertUC.EXPECT().SearchNewConcerts(ctx, mock.Anything).
+			RunAndReturn(func(ctx context.Context, artistID string) ([]*entity.Concert, error) {
+				tokens.Record(ctx, artistID, entity.TokenUsage{PromptTokens: 100, CandidatesTokens: 20, ThinkingTokens: 5, TotalTokens: 125})
+				return nil, nil
+			}).Times(4)
+
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, tokens, concurrency, newTestLogger(t))
+		require.NoError(t, uc.DiscoverAll(ctx))
+
+		assert.Equal(t, entity.TokenUsage{PromptTokens: 400, CandidatesTokens: 80, ThinkingTokens: 20, TotalTokens: 500}, tokens.Total())
+		assert.Equal(t, 125, tokens.ForArtist("artist-1").TotalTokens)
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		followRepo.EXPECT().ListAll(ctx).Return(nil, apperr.ErrInternal).Once()
+
+		uc := usecase.NewConcertDiscoveryUseCase(followRepo, concertUC, nil, concurrency, newTestLogger(t))
+		assert.ErrorIs(t, uc.DiscoverAll(ctx), apperr.ErrInternal)
+	})
+}
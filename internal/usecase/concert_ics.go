@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/liverty-music/backend/internal/entity"
+)
+
+const (
+	// icsProductID identifies the exporter in the PRODID property (RFC 5545 §3.7.3).
+	icsProductID = "-//Liverty Music//Concerts//EN"
+	// icsUIDDomain qualifies event IDs so UIDs stay globally unique (RFC 5545 §3.8.4.7).
+	icsUIDDomain = "liverty-music.app"
+	// icsMaxLineOctets is the line length past which content lines are folded
+	// (RFC 5545 §3.1).
+	icsMaxLineOctets = 75
+
+	icsDateLayout      = "20060102"
+	icsLocalTimeLayout = "20060102T150405"
+	icsUTCTimeLayout   = "20060102T150405Z"
+)
+
+// icsVTimezones holds the VTIMEZONE component for each zone ID
+// entity.EventTimeZoneID can return. Japan has observed no daylight saving
+// time since 1951, so a single STANDARD rule describes it.
+var icsVTimezones = map[string][]string{
+	"Asia/Tokyo": {
+		"BEGIN:VTIMEZONE",
+		"TZID:Asia/Tokyo",
+		"BEGIN:STANDARD",
+		"DTSTART:19700101T000000",
+		"TZOFFSETFROM:+0900",
+		"TZOFFSETTO:+0900",
+		"TZNAME:JST",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+	},
+}
+
+// encodeConcertsICS renders the concerts as an iCalendar (RFC 5545) VCALENDAR
+// named calName with one VEVENT each, titled by summary. A concert with a
+// start time starts at that time in the venue's time zone, or in UTC when the
+// venue's zone is not a named one (see entity.EventTimeZoneID); its end is
+// left open because end times are not known. A concert without a start time
+// is an all-day event on its local date. now is used as the DTSTAMP of every
+// event.
+func encodeConcertsICS(calName string, concerts []*entity.Concert, summary func(*entity.Concert) string, now time.Time) []byte {
+	var lines []string
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:"+icsProductID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:"+icsEscape(calName),
+	)
+
+	emitted := make(map[string]bool)
+	for _, c := range concerts {
+		tzid := entity.EventTimeZoneID(concertAdminArea(c))
+		if c.StartTime == nil || tzid == "" || emitted[tzid] {
+			continue
+		}
+		lines = append(lines, icsVTimezones[tzid]...)
+		emitted[tzid] = true
+	}
+
+	stamp := now.UTC().Format(icsUTCTimeLayout)
+	for _, c := range concerts {
+		lines = append(lines, "BEGIN:VEVENT",
+			"UID:"+c.ID+"@"+icsUIDDomain,
+			"DTSTAMP:"+stamp,
+		)
+		lines = append(lines, icsEventTimes(c)...)
+		lines = append(lines, "SUMMARY:"+icsEscape(summary(c)))
+		if venue := concertVenueName(c); venue != "" {
+			lines = append(lines, "LOCATION:"+icsEscape(venue))
+		}
+		if names := performerNames(c); len(names) > 0 {
+			lines = append(lines, "DESCRIPTION:"+icsEscape(strings.Join(names, ", ")))
+		}
+		if c.Series != nil && c.Series.SourceURL != "" {
+			lines = append(lines, "URL:"+c.Series.SourceURL)
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(icsFold(line))
+		buf.WriteString("\r\n")
+	}
+	return buf.Bytes()
+}
+
+// icsEventTimes returns the DTSTART (and, for all-day events, DTEND)
+// properties of the concert.
+func icsEventTimes(c *entity.Concert) []string {
+	if c.StartTime == nil {
+		day := c.LocalDate.Format(icsDateLayout)
+		next := c.LocalDate.AddDate(0, 0, 1).Format(icsDateLayout)
+		return []string{"DTSTART;VALUE=DATE:" + day, "DTEND;VALUE=DATE:" + next}
+	}
+	adminArea := concertAdminArea(c)
+	if tzid := entity.EventTimeZoneID(adminArea); tzid != "" {
+		local := c.StartTime.In(entity.EventLocation(adminArea))
+		return []string{fmt.Sprintf("DTSTART;TZID=%s:%s", tzid, local.Format(icsLocalTimeLayout))}
+	}
+	return []string{"DTSTART:" + c.StartTime.UTC().Format(icsUTCTimeLayout)}
+}
+
+// concertAdminArea returns the admin area of the concert's venue, or nil
+// while the venue is pending resolution.
+func concertAdminArea(c *entity.Concert) *string {
+	if c.Venue == nil {
+		return nil
+	}
+	return c.Venue.AdminArea
+}
+
+// concertTitle returns the series title, falling back to the performer names
+// for a concert hydrated without its series.
+func concertTitle(c *entity.Concert) string {
+	if c.Series != nil && c.Series.Title != "" {
+		return c.Series.Title
+	}
+	return strings.Join(performerNames(c), ", ")
+}
+
+// artistConcertSummary returns the summary of a concert in an artist's feed:
+// the artist name and series title, followed by the venue when known, as in
+// "Artist: Autumn Tour @ Zepp Haneda".
+func artistConcertSummary(artist *entity.Artist) func(*entity.Concert) string {
+	return func(c *entity.Concert) string {
+		summary := artist.Name
+		if c.Series != nil && c.Series.Title != "" {
+			summary += ": " + c.Series.Title
+		}
+		if venue := concertVenueName(c); venue != "" {
+			summary += " @ " + venue
+		}
+		return summary
+	}
+}
+
+// concertVenueName returns the resolved venue name, or the listed venue name
+// while the venue is pending resolution.
+func concertVenueName(c *entity.Concert) string {
+	if c.Venue != nil && c.Venue.Name != "" {
+		return c.Venue.Name
+	}
+	if c.ListedVenueName != nil {
+		return *c.ListedVenueName
+	}
+	return ""
+}
+
+// performerNames returns the names of the concert's performers in display order.
+func performerNames(c *entity.Concert) []string {
+	names := make([]string, 0, len(c.Performers))
+	for _, p := range c.Performers {
+		if p != nil && p.Name != "" {
+			names = append(names, p.Name)
+		}
+	}
+	return names
+}
+
+// icsEscaper escapes the characters RFC 5545 §3.3.11 reserves in TEXT values.
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// icsEscape escapes a TEXT property value.
+func icsEscape(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+// icsFold splits a content line longer than 75 octets into continuation lines
+// that start with a single space, never splitting a UTF-8 sequence.
+func icsFold(line string) string {
+	if len(line) <= icsMaxLineOctets {
+		return line
+	}
+	var b strings.Builder
+	limit := icsMaxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines lose one octet to the leading space.
+		limit = icsMaxLineOctets - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/pkg/throttle"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertPrewarmUseCase defines the interface for proactively refreshing the
+// concerts of popular artists. Onboarding users overwhelmingly follow the same
+// handful of artists; refreshing those on a schedule means their first-follow
+// search finds a fresh search log and returns immediately instead of waiting
+// on Gemini.
+type ConcertPrewarmUseCase interface {
+	// Prewarm refreshes the concerts of the limit most-followed artists, one
+	// search at a time at the configured interval. A failure for one artist is
+	// logged and does not stop the run.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive.
+	//  - Internal: If the most-followed artists cannot be listed.
+	//  - Canceled / DeadlineExceeded: If ctx ends before the run completes.
+	Prewarm(ctx context.Context, limit int) error
+}
+
+// concertPrewarmUseCase implements the ConcertPrewarmUseCase interface.
+type concertPrewarmUseCase struct {
+	followRepo entity.FollowRepository
+	concertUC  ConcertUseCase
+	// interval is the minimum spacing between consecutive searches, shared
+	// with the onboarding search queue so both stay within Gemini quota.
+	interval time.Duration
+	logger   *logging.Logger
+}
+
+// Compile-time interface compliance check
+var _ ConcertPrewarmUseCase = (*concertPrewarmUseCase)(nil)
+
+// NewConcertPrewarmUseCase creates a new concert prewarm use case.
+func NewConcertPrewarmUseCase(
+	followRepo entity.FollowRepository,
+	concertUC ConcertUseCase,
+	interval time.Duration,
+	logger *logging.Logger,
+) ConcertPrewarmUseCase {
+	return &concertPrewarmUseCase{
+		followRepo: followRepo,
+		concertUC:  concertUC,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Prewarm refreshes the concerts of the most-followed artists.
+func (uc *concertPrewarmUseCase) Prewarm(ctx context.Context, limit int) error {
+	artists, err := uc.followRepo.ListMostFollowed(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Info(ctx, "most-followed artists loaded for prewarm", slog.Int("count", len(artists)))
+
+	throttler := throttle.New(uc.interval, 1)
+	defer throttler.Close()
+
+	var failed int
+	for _, artist := range artists {
+		err := throttler.Do(ctx, func() error {
+			return uc.concertUC.EnsureConcertsFresh(ctx, artist.ID)
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			failed++
+			uc.logger.Warn(ctx, "failed to prewarm concerts for artist",
+				slog.String("artist_id", artist.ID),
+				slog.String("artist_name", artist.Name),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	uc.logger.Info(ctx, "concert prewarm complete",
+		slog.Int("artists_attempted", len(artists)),
+		slog.Int("failures", failed),
+	)
+	return nil
+}
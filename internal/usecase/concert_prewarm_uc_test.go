@@ -0,0 +1,86 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testPrewarmInterval is the throttle interval used by the prewarm tests.
+const testPrewarmInterval = 10 * time.Second
+
+func TestConcertPrewarmUseCase_Prewarm(t *testing.T) {
+	t.Parallel()
+
+	top := []*entity.Artist{
+		{ID: "artist-1", Name: "First"},
+		{ID: "artist-2", Name: "Second"},
+		{ID: "artist-3", Name: "Third"},
+	}
+
+	t.Run("refreshes top artists one interval apart", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			followRepo := mocks.NewMockFollowRepository(t)
+			concertUC := ucmocks.NewMockConcertUseCase(t)
+
+			followRepo.EXPECT().ListMostFollowed(ctx, 3).Return(top, nil).Once()
+			var calls []string
+			var callTimes []time.Time
+			concertUC.EXPECT().EnsureConcertsFresh(ctx, mock.Anything).
+				RunAndReturn(func(_ context.Context, artistID string) error {
+					calls = append(calls, artistID)
+					callTimes = append(callTimes, time.Now())
+					return nil
+				}).Times(3)
+
+			uc := usecase.NewConcertPrewarmUseCase(followRepo, concertUC, testPrewarmInterval, newTestLogger(t))
+			require.NoError(t, uc.Prewarm(ctx, 3))
+
+			assert.Equal(t, []string{"artist-1", "artist-2", "artist-3"}, calls)
+			for i := 1; i < len(callTimes); i++ {
+				assert.GreaterOrEqual(t, callTimes[i].Sub(callTimes[i-1]), testPrewarmInterval)
+			}
+		})
+	})
+
+	t.Run("continues past a failed artist", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			followRepo := mocks.NewMockFollowRepository(t)
+			concertUC := ucmocks.NewMockConcertUseCase(t)
+
+			followRepo.EXPECT().ListMostFollowed(ctx, 3).Return(top, nil).Once()
+			concertUC.EXPECT().EnsureConcertsFresh(ctx, "artist-1").Return(apperr.ErrUnavailable).Once()
+			concertUC.EXPECT().EnsureConcertsFresh(ctx, "artist-2").Return(nil).Once()
+			concertUC.EXPECT().EnsureConcertsFresh(ctx, "artist-3").Return(nil).Once()
+
+			uc := usecase.NewConcertPrewarmUseCase(followRepo, concertUC, testPrewarmInterval, newTestLogger(t))
+			assert.NoError(t, uc.Prewarm(ctx, 3))
+		})
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		followRepo := mocks.NewMockFollowRepository(t)
+		concertUC := ucmocks.NewMockConcertUseCase(t)
+
+		followRepo.EXPECT().ListMostFollowed(ctx, 3).Return(nil, apperr.ErrInternal).Once()
+
+		uc := usecase.NewConcertPrewarmUseCase(followRepo, concertUC, testPrewarmInterval, newTestLogger(t))
+		assert.ErrorIs(t, uc.Prewarm(ctx, 3), apperr.ErrInternal)
+	})
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertReminderDeliveryUseCase delivers a single concert reminder event to
+// the target user, enforcing once-only delivery semantics.
+type ConcertReminderDeliveryUseCase interface {
+	// DeliverReminder dispatches the reminder described by data through the
+	// notification service and records it in the sent-log, with the same
+	// semantics as SalesReminderDeliveryUseCase.DeliverReminder: an already
+	// sent reminder or a nil payload is skipped, a user without a push device
+	// is recorded as reminded, and a transient send failure is left unrecorded
+	// so the next scan retries it.
+	DeliverReminder(ctx context.Context, data entity.ConcertReminderDueData) error
+}
+
+type concertReminderDeliveryUseCase struct {
+	reminderRepo   entity.ConcertReminderRepository
+	notificationUC NotificationUseCase
+	logger         *logging.Logger
+}
+
+// Compile-time interface compliance check.
+var _ ConcertReminderDeliveryUseCase = (*concertReminderDeliveryUseCase)(nil)
+
+// NewConcertReminderDeliveryUseCase wires the concert reminder delivery use case.
+func NewConcertReminderDeliveryUseCase(
+	reminderRepo entity.ConcertReminderRepository,
+	notificationUC NotificationUseCase,
+	logger *logging.Logger,
+) ConcertReminderDeliveryUseCase {
+	return &concertReminderDeliveryUseCase{
+		reminderRepo:   reminderRepo,
+		notificationUC: notificationUC,
+		logger:         logger,
+	}
+}
+
+// DeliverReminder implements [ConcertReminderDeliveryUseCase].
+func (uc *concertReminderDeliveryUseCase) DeliverReminder(ctx context.Context, data entity.ConcertReminderDueData) error {
+	attrs := []slog.Attr{
+		slog.String("user_id", data.UserID),
+		slog.String("event_id", data.EventID),
+	}
+
+	already, err := uc.reminderRepo.AlreadySent(ctx, data.UserID, data.EventID)
+	if err != nil {
+		return fmt.Errorf("concert_reminder_delivery: AlreadySent check: %w", err)
+	}
+	if already {
+		uc.logger.Info(ctx, "concert_reminder_delivery: already sent, skipping", attrs...)
+		return nil
+	}
+	if data.Payload == nil {
+		uc.logger.Warn(ctx, "concert_reminder_delivery: nil payload, skipping", attrs...)
+		return nil
+	}
+
+	n, err := uc.notificationUC.Notify(ctx, data.UserID, entity.NotificationTypeConcertReminder, data.Payload)
+	if err != nil {
+		return fmt.Errorf("concert_reminder_delivery: notify: %w", err)
+	}
+
+	outcome := "failed"
+	switch {
+	case n.DeliveryStatus == entity.NotificationDeliveryStatusDelivered:
+		outcome = "delivered"
+	case n.FailureReason == NotificationFailureReasonNoSubscription:
+		outcome = "no_subscription"
+	}
+	if outcome != "failed" {
+		if err := uc.reminderRepo.RecordSent(ctx, data.UserID, data.EventID); err != nil {
+			uc.logger.Error(ctx, "concert_reminder_delivery: RecordSent failed", err, attrs...)
+			// Non-fatal: the next scan re-publishes and AlreadySent re-checks.
+		}
+	}
+
+	attrs = append(attrs, slog.String("outcome", outcome))
+	if outcome == "delivered" {
+		uc.logger.Info(ctx, "concert_reminder delivery outcome", attrs...)
+	} else {
+		uc.logger.Warn(ctx, "concert_reminder delivery outcome", attrs...)
+	}
+	return nil
+}
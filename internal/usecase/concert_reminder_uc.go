@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertReminderUseCase scans for concerts whose reminder became due on each
+// user's own lead time and publishes a CONCERT.reminder_due event for each
+// (user, event) pair not yet recorded in the sent-log.
+type ConcertReminderUseCase interface {
+	// ScanDueReminders runs one scan pass. It lists unreminded concerts within
+	// each user's lead time, keeps those due on the user's local date outside
+	// quiet hours, and publishes a reminder for each. Returns the number of
+	// events published.
+	ScanDueReminders(ctx context.Context) (int, error)
+}
+
+type concertReminderUseCase struct {
+	reminderRepo entity.ConcertReminderRepository
+	publisher    EventPublisher
+	now          func() time.Time
+	logger       *logging.Logger
+}
+
+// Compile-time interface compliance check.
+var _ ConcertReminderUseCase = (*concertReminderUseCase)(nil)
+
+// NewConcertReminderUseCase wires the concert reminder scan use case.
+func NewConcertReminderUseCase(
+	reminderRepo entity.ConcertReminderRepository,
+	publisher EventPublisher,
+	logger *logging.Logger,
+) ConcertReminderUseCase {
+	return &concertReminderUseCase{
+		reminderRepo: reminderRepo,
+		publisher:    publisher,
+		now:          time.Now,
+		logger:       logger,
+	}
+}
+
+// ScanDueReminders implements [ConcertReminderUseCase].
+func (uc *concertReminderUseCase) ScanDueReminders(ctx context.Context) (int, error) {
+	now := uc.now().UTC()
+	candidates, err := uc.reminderRepo.ListCandidates(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("concert_reminder: list candidates: %w", err)
+	}
+
+	var published int
+	for _, c := range candidates {
+		if ctx.Err() != nil {
+			break
+		}
+		tz := timezoneOrFallback(c.TimeZone)
+		days, due := concertReminderDue(c, now, tz)
+		if !due {
+			continue
+		}
+		data := entity.ConcertReminderDueData{
+			UserID:  c.UserID,
+			EventID: c.EventID,
+			Payload: buildConcertReminderPayload(c, days, tz),
+		}
+		if err := uc.publisher.PublishEvent(ctx, entity.SubjectConcertReminderDue, data); err != nil {
+			uc.logger.Error(ctx, "concert_reminder: publish failed", err,
+				slog.String("user_id", c.UserID),
+				slog.String("event_id", c.EventID),
+			)
+			continue
+		}
+		// The sent-log is written by the consumer once the push is delivered,
+		// so a pair published here is published again by the next scan until
+		// then; the consumer's AlreadySent guard drops the duplicate.
+		published++
+	}
+
+	uc.logger.Info(ctx, "concert_reminder: scan complete",
+		slog.Int("candidates", len(candidates)),
+		slog.Int("reminders_published", published),
+	)
+	return published, nil
+}
+
+// concertReminderDue reports how many days remain until the concert on the
+// user's local date, and whether the reminder is due now: the concert is
+// within the user's lead time, not yet past, and it is not quiet hours for
+// the user. A reminder held back by quiet hours fires on a later scan.
+func concertReminderDue(c *entity.ConcertReminderCandidate, now time.Time, tz *time.Location) (int, bool) {
+	local := now.In(tz)
+	if h := local.Hour(); h >= quietStartHour || h < quietEndHour {
+		return 0, false
+	}
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	eventDay := time.Date(c.LocalDate.Year(), c.LocalDate.Month(), c.LocalDate.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(eventDay.Sub(today) / (24 * time.Hour))
+	return days, days >= 0 && days <= c.ReminderDays
+}
+
+// buildConcertReminderPayload renders the reminder in the user's preferred
+// language (default "en"), with the start time in the user's timezone.
+func buildConcertReminderPayload(c *entity.ConcertReminderCandidate, days int, tz *time.Location) *entity.NotificationPayload {
+	lang := c.PreferredLanguage
+	if lang == "" {
+		lang = "en"
+	}
+
+	var title string
+	switch lang {
+	case "ja":
+		switch days {
+		case 0:
+			title = "今日はライブの日です"
+		case 1:
+			title = "ライブは明日です"
+		default:
+			title = fmt.Sprintf("ライブまであと%d日", days)
+		}
+	default:
+		switch days {
+		case 0:
+			title = "Concert Today"
+		case 1:
+			title = "Concert Tomorrow"
+		default:
+			title = fmt.Sprintf("Concert in %d Days", days)
+		}
+	}
+
+	body := c.Title
+	if c.VenueName != "" {
+		body = fmt.Sprintf("%s @ %s", body, c.VenueName)
+	}
+	if c.StartTime != nil {
+		body = fmt.Sprintf("%s (%s)", body, formatLocalTime(*c.StartTime, tz, lang))
+	}
+
+	return entity.NewNotificationPayload(
+		title,
+		body,
+		"/dashboard",
+		fmt.Sprintf("concert-reminder-%s", c.EventID),
+	)
+}
@@ -0,0 +1,244 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcertReminderUseCase_ScanDueReminders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// 12:00 in Tokyo, 04:00 in London, 20:00 the previous day in Los Angeles.
+	now := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	concertDay := time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)
+
+	candidate := func(userID string, reminderDays int, timeZone string) *entity.ConcertReminderCandidate {
+		return &entity.ConcertReminderCandidate{
+			UserID:       userID,
+			EventID:      "event-1",
+			Title:        "Autumn Tour",
+			VenueName:    "Zepp Haneda",
+			LocalDate:    concertDay,
+			ReminderDays: reminderDays,
+			TimeZone:     timeZone,
+		}
+	}
+	due := func(userID, eventID string, match func(p *entity.NotificationPayload) bool) any {
+		return mock.MatchedBy(func(d entity.ConcertReminderDueData) bool {
+			return d.UserID == userID && d.EventID == eventID && match(d.Payload)
+		})
+	}
+	anyPayload := func(*entity.NotificationPayload) bool { return true }
+
+	type deps struct {
+		reminderRepo *mocks.MockConcertReminderRepository
+		publisher    *ucmocks.MockEventPublisher
+		uc           usecase.ConcertReminderUseCase
+	}
+	setup := func(t *testing.T) deps {
+		d := deps{
+			reminderRepo: mocks.NewMockConcertReminderRepository(t),
+			publisher:    ucmocks.NewMockEventPublisher(t),
+		}
+		d.uc = usecase.NewConcertReminderUseCase(d.reminderRepo, d.publisher, newTestLogger(t))
+		usecase.SetConcertReminderClock(d.uc, func() time.Time { return now })
+		return d
+	}
+
+	t.Run("users with different lead times for the same concert", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().ListCandidates(ctx, now).Return([]*entity.ConcertReminderCandidate{
+			candidate("week-ahead", 7, "Asia/Tokyo"),
+			candidate("day-before", 1, "Asia/Tokyo"),
+			candidate("week-ahead-la", 7, "America/Los_Angeles"),
+		}, nil).Once()
+		// Seven days out in Tokyo, so only the week-ahead user is due; in Los
+		// Angeles it is still eight days out.
+		d.publisher.EXPECT().PublishEvent(ctx, entity.SubjectConcertReminderDue,
+			due("week-ahead", "event-1", func(p *entity.NotificationPayload) bool {
+				return p.Title == "Concert in 7 Days" && p.Body == "Autumn Tour @ Zepp Haneda"
+			})).Return(nil).Once()
+
+		n, err := d.uc.ScanDueReminders(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("day-of lead time reminds on the concert day only", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		today := candidate("same-day", 0, "Asia/Tokyo")
+		today.LocalDate = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+		past := candidate("same-day", 0, "Asia/Tokyo")
+		past.EventID = "event-past"
+		past.LocalDate = time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+		d.reminderRepo.EXPECT().ListCandidates(ctx, now).Return([]*entity.ConcertReminderCandidate{today, past}, nil).Once()
+		d.publisher.EXPECT().PublishEvent(ctx, entity.SubjectConcertReminderDue,
+			due("same-day", "event-1", func(p *entity.NotificationPayload) bool { return p.Title == "Concert Today" })).
+			Return(nil).Once()
+
+		n, err := d.uc.ScanDueReminders(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("payload follows the user's language and time zone", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		c := candidate("ja-user", 1, "Asia/Tokyo")
+		c.PreferredLanguage = "ja"
+		c.LocalDate = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+		c.StartTime = new(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC))
+		d.reminderRepo.EXPECT().ListCandidates(ctx, now).Return([]*entity.ConcertReminderCandidate{c}, nil).Once()
+		d.publisher.EXPECT().PublishEvent(ctx, entity.SubjectConcertReminderDue,
+			due("ja-user", "event-1", func(p *entity.NotificationPayload) bool {
+				return p.Title == "ライブは明日です" && p.Body == "Autumn Tour @ Zepp Haneda (10月19日 18:00)"
+			})).Return(nil).Once()
+
+		n, err := d.uc.ScanDueReminders(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("quiet hours hold the reminder back", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().ListCandidates(ctx, now).Return([]*entity.ConcertReminderCandidate{
+			candidate("night-owl", 7, "Europe/London"),
+		}, nil).Once()
+
+		n, err := d.uc.ScanDueReminders(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("publish failure skips the pair and continues", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().ListCandidates(ctx, now).Return([]*entity.ConcertReminderCandidate{
+			candidate("unlucky", 7, "Asia/Tokyo"),
+			candidate("lucky", 7, "Asia/Tokyo"),
+		}, nil).Once()
+		d.publisher.EXPECT().PublishEvent(ctx, entity.SubjectConcertReminderDue, due("unlucky", "event-1", anyPayload)).
+			Return(errors.New("nats down")).Once()
+		d.publisher.EXPECT().PublishEvent(ctx, entity.SubjectConcertReminderDue, due("lucky", "event-1", anyPayload)).
+			Return(nil).Once()
+
+		n, err := d.uc.ScanDueReminders(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().ListCandidates(ctx, now).Return(nil, errors.New("db down")).Once()
+
+		_, err := d.uc.ScanDueReminders(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestConcertReminderDeliveryUseCase_DeliverReminder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := entity.ConcertReminderDueData{
+		UserID:  "user-1",
+		EventID: "event-1",
+		Payload: entity.NewNotificationPayload("Concert Tomorrow", "Autumn Tour", "/dashboard", "concert-reminder-event-1"),
+	}
+
+	type deps struct {
+		reminderRepo   *mocks.MockConcertReminderRepository
+		notificationUC *ucmocks.MockNotificationUseCase
+		uc             usecase.ConcertReminderDeliveryUseCase
+	}
+	setup := func(t *testing.T) deps {
+		d := deps{
+			reminderRepo:   mocks.NewMockConcertReminderRepository(t),
+			notificationUC: ucmocks.NewMockNotificationUseCase(t),
+		}
+		d.uc = usecase.NewConcertReminderDeliveryUseCase(d.reminderRepo, d.notificationUC, newTestLogger(t))
+		return d
+	}
+
+	t.Run("delivered reminder is recorded", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().AlreadySent(ctx, "user-1", "event-1").Return(false, nil).Once()
+		d.notificationUC.EXPECT().Notify(ctx, "user-1", entity.NotificationTypeConcertReminder, data.Payload).
+			Return(&entity.Notification{DeliveryStatus: entity.NotificationDeliveryStatusDelivered}, nil).Once()
+		d.reminderRepo.EXPECT().RecordSent(ctx, "user-1", "event-1").Return(nil).Once()
+
+		require.NoError(t, d.uc.DeliverReminder(ctx, data))
+	})
+
+	t.Run("already sent reminder is skipped", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().AlreadySent(ctx, "user-1", "event-1").Return(true, nil).Once()
+
+		require.NoError(t, d.uc.DeliverReminder(ctx, data))
+	})
+
+	t.Run("no push subscription is recorded as reminded", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().AlreadySent(ctx, "user-1", "event-1").Return(false, nil).Once()
+		d.notificationUC.EXPECT().Notify(ctx, "user-1", entity.NotificationTypeConcertReminder, data.Payload).
+			Return(&entity.Notification{
+				DeliveryStatus: entity.NotificationDeliveryStatusFailed,
+				FailureReason:  usecase.NotificationFailureReasonNoSubscription,
+			}, nil).Once()
+		d.reminderRepo.EXPECT().RecordSent(ctx, "user-1", "event-1").Return(nil).Once()
+
+		require.NoError(t, d.uc.DeliverReminder(ctx, data))
+	})
+
+	t.Run("failed send is left for the next scan", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().AlreadySent(ctx, "user-1", "event-1").Return(false, nil).Once()
+		d.notificationUC.EXPECT().Notify(ctx, "user-1", entity.NotificationTypeConcertReminder, data.Payload).
+			Return(&entity.Notification{
+				DeliveryStatus: entity.NotificationDeliveryStatusFailed,
+				FailureReason:  "push service unavailable",
+			}, nil).Once()
+
+		require.NoError(t, d.uc.DeliverReminder(ctx, data))
+	})
+
+	t.Run("notify error is returned for redelivery", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.reminderRepo.EXPECT().AlreadySent(ctx, "user-1", "event-1").Return(false, nil).Once()
+		d.notificationUC.EXPECT().Notify(ctx, "user-1", entity.NotificationTypeConcertReminder, data.Payload).
+			Return(nil, errors.New("insert failed")).Once()
+
+		assert.Error(t, d.uc.DeliverReminder(ctx, data))
+	})
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertRescheduleNoticeUseCase tells the ticket holders of a rescheduled
+// concert that its date changed.
+type ConcertRescheduleNoticeUseCase interface {
+	// NotifyTicketHolders pushes a reschedule notice to every user holding a
+	// ticket for the moved event. An event without tickets is a no-op (nil
+	// error). Only infrastructure failures return a non-nil error.
+	NotifyTicketHolders(ctx context.Context, data entity.ConcertRescheduledData) error
+}
+
+type concertRescheduleNoticeUseCase struct {
+	ticketRepo     entity.TicketRepository
+	userRepo       entity.UserRepository
+	notificationUC NotificationUseCase
+	logger         *logging.Logger
+}
+
+// Compile-time interface compliance check.
+var _ ConcertRescheduleNoticeUseCase = (*concertRescheduleNoticeUseCase)(nil)
+
+// NewConcertRescheduleNoticeUseCase wires the reschedule notice use case.
+func NewConcertRescheduleNoticeUseCase(
+	ticketRepo entity.TicketRepository,
+	userRepo entity.UserRepository,
+	notificationUC NotificationUseCase,
+	logger *logging.Logger,
+) ConcertRescheduleNoticeUseCase {
+	return &concertRescheduleNoticeUseCase{
+		ticketRepo:     ticketRepo,
+		userRepo:       userRepo,
+		notificationUC: notificationUC,
+		logger:         logger,
+	}
+}
+
+// NotifyTicketHolders implements [ConcertRescheduleNoticeUseCase].
+func (uc *concertRescheduleNoticeUseCase) NotifyTicketHolders(ctx context.Context, data entity.ConcertRescheduledData) error {
+	if data.EventID == "" {
+		uc.logger.Warn(ctx, "concert_reschedule_notice: empty event_id, skipping",
+			slog.String("artist_id", data.ArtistID),
+		)
+		return nil
+	}
+
+	tickets, err := uc.ticketRepo.ListByEvent(ctx, data.EventID)
+	if err != nil {
+		return fmt.Errorf("concert_reschedule_notice: list tickets: %w", err)
+	}
+
+	tag := fmt.Sprintf("concert-rescheduled-%s-%s", data.EventID, data.LocalDate.Format("20060102"))
+	notified := make(map[string]bool, len(tickets))
+	for _, t := range tickets {
+		if notified[t.UserID] {
+			continue
+		}
+		notified[t.UserID] = true
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		// Localize the notice by preferred language; a user that cannot be
+		// hydrated still gets the English copy rather than no notice.
+		// TODO(perf): batch user hydration when UserRepository gains ListByIDs.
+		var lang string
+		if u, err := uc.userRepo.Get(ctx, t.UserID); err != nil {
+			uc.logger.Warn(ctx, "concert_reschedule_notice: failed to hydrate user; notifying in English",
+				slog.String("user_id", t.UserID),
+				slog.String("event_id", data.EventID),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			lang = u.PreferredLanguage
+		}
+
+		payload := entity.NewNotificationPayload(
+			rescheduleNoticeTitle(lang),
+			rescheduleNoticeBody(data, lang),
+			"/dashboard",
+			tag,
+		)
+		if _, err := uc.notificationUC.Notify(ctx, t.UserID, entity.NotificationTypeConcertRescheduled, payload); err != nil {
+			// Record-create failure ("no record => no send"): surface so the
+			// consumer's at-least-once retry re-drives the batch. Repeat pushes
+			// are deduplicated browser-side by the per-move Tag.
+			return fmt.Errorf("concert_reschedule_notice: notify user %s: %w", t.UserID, err)
+		}
+	}
+
+	uc.logger.Info(ctx, "concert_reschedule_notice: ticket holders notified",
+		slog.String("event_id", data.EventID),
+		slog.Int("recipients", len(notified)),
+	)
+	return nil
+}
+
+// rescheduleNoticeTitle returns the reschedule notice title for the given
+// language, falling back to English for empty or unsupported codes.
+func rescheduleNoticeTitle(lang string) string {
+	switch lang {
+	case "ja":
+		return "ライブの日程が変更されました"
+	default:
+		return "Concert Rescheduled"
+	}
+}
+
+// rescheduleNoticeBody renders the moved concert and its old and new dates in
+// the given language, falling back to English for empty or unsupported codes.
+func rescheduleNoticeBody(data entity.ConcertRescheduledData, lang string) string {
+	concert := data.Title
+	if data.ListedVenueName != "" {
+		concert = fmt.Sprintf("%s @ %s", concert, data.ListedVenueName)
+	}
+	switch lang {
+	case "ja":
+		return fmt.Sprintf("%s: %s → %s", concert,
+			data.PreviousLocalDate.Format("1月2日"), data.LocalDate.Format("1月2日"))
+	default:
+		return fmt.Sprintf("%s: %s → %s", concert,
+			data.PreviousLocalDate.Format("Jan 2"), data.LocalDate.Format("Jan 2"))
+	}
+}
@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	entitymocks "github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// rescheduleNoticeTestDeps holds the mocks and use case for reschedule notice tests.
+type rescheduleNoticeTestDeps struct {
+	ticketRepo     *entitymocks.MockTicketRepository
+	userRepo       *entitymocks.MockUserRepository
+	notificationUC *ucmocks.MockNotificationUseCase
+	uc             usecase.ConcertRescheduleNoticeUseCase
+}
+
+func newRescheduleNoticeTestDeps(t *testing.T) *rescheduleNoticeTestDeps {
+	t.Helper()
+	d := &rescheduleNoticeTestDeps{
+		ticketRepo:     entitymocks.NewMockTicketRepository(t),
+		userRepo:       entitymocks.NewMockUserRepository(t),
+		notificationUC: ucmocks.NewMockNotificationUseCase(t),
+	}
+	d.uc = usecase.NewConcertRescheduleNoticeUseCase(
+		d.ticketRepo,
+		d.userRepo,
+		d.notificationUC,
+		newTestLogger(t),
+	)
+	return d
+}
+
+func rescheduledData() entity.ConcertRescheduledData {
+	return entity.ConcertRescheduledData{
+		EventID:           "event-1",
+		ArtistID:          "artist-1",
+		Title:             "Spring Live",
+		ListedVenueName:   "Zepp Haneda",
+		PreviousLocalDate: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		LocalDate:         time.Date(2026, 11, 8, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNotifyTicketHolders_LocalizesNoticePerHolder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	d := newRescheduleNoticeTestDeps(t)
+	data := rescheduledData()
+
+	d.ticketRepo.EXPECT().ListByEvent(ctx, "event-1").Return([]*entity.Ticket{
+		{ID: "ticket-1", EventID: "event-1", UserID: "user-ja"},
+		{ID: "ticket-2", EventID: "event-1", UserID: "user-en"},
+		// A second ticket for the same holder must not notify them twice.
+		{ID: "ticket-3", EventID: "event-1", UserID: "user-ja"},
+		// A holder that cannot be hydrated still gets the English notice.
+		{ID: "ticket-4", EventID: "event-1", UserID: "user-missing"},
+	}, nil).Once()
+	d.userRepo.EXPECT().Get(ctx, "user-ja").Return(&entity.User{ID: "user-ja", PreferredLanguage: "ja"}, nil).Once()
+	d.userRepo.EXPECT().Get(ctx, "user-en").Return(&entity.User{ID: "user-en", PreferredLanguage: "en"}, nil).Once()
+	d.userRepo.EXPECT().Get(ctx, "user-missing").Return(nil, apperr.ErrNotFound).Once()
+
+	const tag = "concert-rescheduled-event-1-20261108"
+	d.notificationUC.EXPECT().
+		Notify(anyCtx, "user-ja", entity.NotificationTypeConcertRescheduled,
+			mock.MatchedBy(func(p *entity.NotificationPayload) bool {
+				return p.Title == "ライブの日程が変更されました" &&
+					p.Body == "Spring Live @ Zepp Haneda: 11月1日 → 11月8日" &&
+					p.Tag == tag
+			})).
+		Return(deliveredNotif(), nil).
+		Once()
+	for _, userID := range []string{"user-en", "user-missing"} {
+		d.notificationUC.EXPECT().
+			Notify(anyCtx, userID, entity.NotificationTypeConcertRescheduled,
+				mock.MatchedBy(func(p *entity.NotificationPayload) bool {
+					return p.Title == "Concert Rescheduled" &&
+						p.Body == "Spring Live @ Zepp Haneda: Nov 1 → Nov 8" &&
+						p.Tag == tag
+				})).
+			Return(deliveredNotif(), nil).
+			Once()
+	}
+
+	require.NoError(t, d.uc.NotifyTicketHolders(ctx, data))
+}
+
+func TestNotifyTicketHolders_NoTicketsIsNoOp(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	d := newRescheduleNoticeTestDeps(t)
+	d.ticketRepo.EXPECT().ListByEvent(ctx, "event-1").Return(nil, nil).Once()
+
+	require.NoError(t, d.uc.NotifyTicketHolders(ctx, rescheduledData()))
+}
+
+func TestNotifyTicketHolders_SurfacesErrors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("ticket lookup failure", func(t *testing.T) {
+		t.Parallel()
+		d := newRescheduleNoticeTestDeps(t)
+		d.ticketRepo.EXPECT().ListByEvent(ctx, "event-1").Return(nil, errors.New("db down")).Once()
+
+		assert.Error(t, d.uc.NotifyTicketHolders(ctx, rescheduledData()))
+	})
+
+	t.Run("notification record failure is retried by the consumer", func(t *testing.T) {
+		t.Parallel()
+		d := newRescheduleNoticeTestDeps(t)
+		d.ticketRepo.EXPECT().ListByEvent(ctx, "event-1").
+			Return([]*entity.Ticket{{ID: "ticket-1", EventID: "event-1", UserID: "user-1"}}, nil).Once()
+		d.userRepo.EXPECT().Get(ctx, "user-1").Return(&entity.User{ID: "user-1"}, nil).Once()
+		d.notificationUC.EXPECT().
+			Notify(anyCtx, "user-1", entity.NotificationTypeConcertRescheduled, mock.Anything).
+			Return(nil, errors.New("insert failed")).
+			Once()
+
+		assert.Error(t, d.uc.NotifyTicketHolders(ctx, rescheduledData()))
+	})
+}
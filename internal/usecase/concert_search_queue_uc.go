@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// ConcertSearchQueueUseCase defines the interface for the background concert
+// search queue. Onboarding enqueues a task per followed artist and returns
+// immediately instead of blocking on Gemini; a worker drains the queue by
+// calling ProcessNext, and discovered concerts reach the user through the
+// regular concert.discovered → concert.created → notification pipeline.
+type ConcertSearchQueueUseCase interface {
+	// Enqueue schedules a background concert search for the artist. It is
+	// idempotent: an artist with an outstanding task is not enqueued twice.
+	//
+	// # Possible errors
+	//
+	//  - FailedPrecondition: If the artist does not exist.
+	//  - Internal: If the task cannot be persisted.
+	Enqueue(ctx context.Context, artistID string) error
+
+	// ProcessNext claims the oldest pending task and runs its search. It
+	// reports whether a task was claimed, so a worker can back off while the
+	// queue is empty. A failed search returns the task to the queue with
+	// exponential backoff and surfaces the search error; once the task has
+	// used up its attempts it is moved to the dead-letter table instead.
+	//
+	// # Possible errors
+	//
+	//  - Internal: If claiming fails, or the search itself fails.
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+// concertSearchQueueUseCase implements the ConcertSearchQueueUseCase interface.
+type concertSearchQueueUseCase struct {
+	taskRepo  entity.SearchTaskRepository
+	concertUC ConcertUseCase
+	// maxAttempts is how many times a task is tried before it is
+	// dead-lettered. Configured per environment.
+	maxAttempts int
+	logger      *logging.Logger
+}
+
+// searchTaskLease is how long a claimed task may run before another worker is
+// allowed to reclaim it. It matches pendingTimeout so a reclaimed task is never
+// short-circuited by the still-pending search log of the crashed attempt.
+const searchTaskLease = pendingTimeout
+
+// Retry backoff for failed search tasks: the delay doubles with every attempt,
+// starting at searchTaskBaseBackoff and capped at searchTaskMaxBackoff, so a
+// flaky Gemini call is retried quickly while a persistent outage does not
+// hammer the API.
+const (
+	searchTaskBaseBackoff = time.Minute
+	searchTaskMaxBackoff  = time.Hour
+)
+
+// Compile-time interface compliance check
+var _ ConcertSearchQueueUseCase = (*concertSearchQueueUseCase)(nil)
+
+// NewConcertSearchQueueUseCase creates a new concert search queue use case.
+func NewConcertSearchQueueUseCase(
+	taskRepo entity.SearchTaskRepository,
+	concertUC ConcertUseCase,
+	maxAttempts int,
+	logger *logging.Logger,
+) ConcertSearchQueueUseCase {
+	return &concertSearchQueueUseCase{
+		taskRepo:    taskRepo,
+		concertUC:   concertUC,
+		maxAttempts: maxAttempts,
+		logger:      logger,
+	}
+}
+
+// Enqueue schedules a background concert search for the artist.
+func (uc *concertSearchQueueUseCase) Enqueue(ctx context.Context, artistID string) error {
+	if err := uc.taskRepo.Enqueue(ctx, artistID); err != nil {
+		return err
+	}
+	uc.logger.Debug(ctx, "concert search task enqueued", slog.String("artist_id", artistID))
+	return nil
+}
+
+// ProcessNext claims one task and runs the concert search for it.
+func (uc *concertSearchQueueUseCase) ProcessNext(ctx context.Context) (bool, error) {
+	task, err := uc.taskRepo.Claim(ctx, searchTaskLease)
+	if err != nil {
+		if errors.Is(err, apperr.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	attrs := []slog.Attr{
+		slog.String("task_id", task.ID),
+		slog.String("artist_id", task.ArtistID),
+		slog.Int("attempt", task.Attempts),
+	}
+
+	// Status updates run on a detached context: a search that failed because
+	// the worker is shutting down must still hand its task back to the queue.
+	updateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), statusUpdateTimeout)
+	defer cancel()
+
+	// A task reclaimed from crashed workers can arrive already past its
+	// budget; retire it without running the search again.
+	if task.Attempts > uc.maxAttempts {
+		uc.deadLetter(ctx, updateCtx, task, task.LastError, attrs)
+		return true, nil
+	}
+
+	if _, searchErr := uc.concertUC.SearchNewConcerts(ctx, task.ArtistID); searchErr != nil {
+		if task.Attempts >= uc.maxAttempts {
+			uc.deadLetter(ctx, updateCtx, task, searchErr.Error(), attrs)
+			return true, searchErr
+		}
+
+		backoff := searchTaskBackoff(task.Attempts)
+		if err := uc.taskRepo.Release(updateCtx, task.ID, searchErr.Error(), backoff); err != nil {
+			uc.logger.Error(ctx, "failed to release concert search task", err, attrs...)
+		}
+		return true, searchErr
+	}
+
+	if err := uc.taskRepo.Complete(updateCtx, task.ID); err != nil {
+		return true, err
+	}
+
+	uc.logger.Info(ctx, "concert search task completed", attrs...)
+	return true, nil
+}
+
+// deadLetter moves an exhausted task out of the queue. Failures are logged
+// only: the task stays claimable and is retired on its next claim.
+func (uc *concertSearchQueueUseCase) deadLetter(ctx, updateCtx context.Context, task *entity.SearchTask, lastError string, attrs []slog.Attr) {
+	if err := uc.taskRepo.DeadLetter(updateCtx, task.ID, lastError); err != nil {
+		uc.logger.Error(ctx, "failed to dead-letter concert search task", err, attrs...)
+		return
+	}
+	uc.logger.Warn(ctx, "concert search task exhausted retries, moved to dead letters",
+		append(attrs, slog.String("last_error", lastError))...)
+}
+
+// searchTaskBackoff returns the delay before a task that has failed attempt
+// number attempt (1-based) may be claimed again.
+func searchTaskBackoff(attempt int) time.Duration {
+	backoff := searchTaskBaseBackoff
+	for i := 1; i < attempt && backoff < searchTaskMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, searchTaskMaxBackoff)
+}
@@ -0,0 +1,227 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testSearchQueueMaxAttempts is the retry budget used by the queue tests.
+const testSearchQueueMaxAttempts = 3
+
+// searchQueueTestDeps holds all dependencies for ConcertSearchQueueUseCase tests.
+type searchQueueTestDeps struct {
+	taskRepo  *mocks.MockSearchTaskRepository
+	concertUC *ucmocks.MockConcertUseCase
+	uc        usecase.ConcertSearchQueueUseCase
+}
+
+func newSearchQueueTestDeps(t *testing.T) *searchQueueTestDeps {
+	t.Helper()
+	d := &searchQueueTestDeps{
+		taskRepo:  mocks.NewMockSearchTaskRepository(t),
+		concertUC: ucmocks.NewMockConcertUseCase(t),
+	}
+	d.uc = usecase.NewConcertSearchQueueUseCase(d.taskRepo, d.concertUC, testSearchQueueMaxAttempts, newTestLogger(t))
+	return d
+}
+
+func TestConcertSearchQueueUseCase_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		setup   func(d *searchQueueTestDeps)
+		wantErr error
+	}{
+		{
+			name: "persists the task",
+			setup: func(d *searchQueueTestDeps) {
+				d.taskRepo.EXPECT().Enqueue(ctx, "artist-1").Return(nil).Once()
+			},
+		},
+		{
+			name: "propagates repository error",
+			setup: func(d *searchQueueTestDeps) {
+				d.taskRepo.EXPECT().Enqueue(ctx, "artist-1").Return(apperr.ErrFailedPrecondition).Once()
+			},
+			wantErr: apperr.ErrFailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := newSearchQueueTestDeps(t)
+			tt.setup(d)
+
+			err := d.uc.Enqueue(ctx, "artist-1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConcertSearchQueueUseCase_ProcessNext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	task := &entity.SearchTask{
+		ID:       "task-1",
+		ArtistID: "artist-1",
+		Status:   entity.SearchTaskStatusRunning,
+		Attempts: 1,
+	}
+
+	tests := []struct {
+		name          string
+		setup         func(d *searchQueueTestDeps)
+		wantProcessed bool
+		wantErr       error
+	}{
+		{
+			name: "empty queue reports nothing processed",
+			setup: func(d *searchQueueTestDeps) {
+				d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(nil, apperr.ErrNotFound).Once()
+			},
+			wantProcessed: false,
+		},
+		{
+			name: "claim failure is returned",
+			setup: func(d *searchQueueTestDeps) {
+				d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(nil, apperr.ErrInternal).Once()
+			},
+			wantProcessed: false,
+			wantErr:       apperr.ErrInternal,
+		},
+		{
+			name: "successful search completes the task",
+			setup: func(d *searchQueueTestDeps) {
+				d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(task, nil).Once()
+				d.concertUC.EXPECT().SearchNewConcerts(ctx, "artist-1").Return(nil, nil).Once()
+				d.taskRepo.EXPECT().Complete(mock.Anything, "task-1").Return(nil).Once()
+			},
+			wantProcessed: true,
+		},
+		{
+			name: "failed search releases the task for retry",
+			setup: func(d *searchQueueTestDeps) {
+				d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(task, nil).Once()
+				d.concertUC.EXPECT().SearchNewConcerts(ctx, "artist-1").Return(nil, apperr.ErrDeadlineExceeded).Once()
+				d.taskRepo.EXPECT().Release(mock.Anything, "task-1", mock.AnythingOfType("string"), time.Minute).Return(nil).Once()
+			},
+			wantProcessed: true,
+			wantErr:       apperr.ErrDeadlineExceeded,
+		},
+		{
+			name: "failed search on the last attempt dead-letters the task",
+			setup: func(d *searchQueueTestDeps) {
+				last := *task
+				last.Attempts = testSearchQueueMaxAttempts
+				d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(&last, nil).Once()
+				d.concertUC.EXPECT().SearchNewConcerts(ctx, "artist-1").Return(nil, apperr.ErrInternal).Once()
+				d.taskRepo.EXPECT().DeadLetter(mock.Anything, "task-1", mock.AnythingOfType("string")).Return(nil).Once()
+			},
+			wantProcessed: true,
+			wantErr:       apperr.ErrInternal,
+		},
+		{
+			name: "task reclaimed past its budget is dead-lettered without searching",
+			setup: func(d *searchQueueTestDeps) {
+				abandoned := *task
+				abandoned.Attempts = testSearchQueueMaxAttempts + 1
+				abandoned.LastError = "worker crashed"
+				d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(&abandoned, nil).Once()
+				d.taskRepo.EXPECT().DeadLetter(mock.Anything, "task-1", "worker crashed").Return(nil).Once()
+			},
+			wantProcessed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := newSearchQueueTestDeps(t)
+			tt.setup(d)
+
+			processed, err := d.uc.ProcessNext(ctx)
+
+			assert.Equal(t, tt.wantProcessed, processed)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestConcertSearchQueueUseCase_TransientThenSuccess verifies that a task
+// whose first search fails is handed back to the queue with backoff and
+// completes on the next claim.
+func TestConcertSearchQueueUseCase_TransientThenSuccess(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := newSearchQueueTestDeps(t)
+
+	first := &entity.SearchTask{ID: "task-1", ArtistID: "artist-1", Status: entity.SearchTaskStatusRunning, Attempts: 1}
+	retry := &entity.SearchTask{ID: "task-1", ArtistID: "artist-1", Status: entity.SearchTaskStatusRunning, Attempts: 2, LastError: "gemini timeout"}
+
+	d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(first, nil).Once()
+	d.concertUC.EXPECT().SearchNewConcerts(ctx, "artist-1").Return(nil, apperr.ErrDeadlineExceeded).Once()
+	d.taskRepo.EXPECT().Release(mock.Anything, "task-1", mock.AnythingOfType("string"), time.Minute).Return(nil).Once()
+
+	d.taskRepo.EXPECT().Claim(ctx, mock.Anything).Return(retry, nil).Once()
+	d.concertUC.EXPECT().SearchNewConcerts(ctx, "artist-1").Return([]*entity.Concert{{}}, nil).Once()
+	d.taskRepo.EXPECT().Complete(mock.Anything, "task-1").Return(nil).Once()
+
+	processed, err := d.uc.ProcessNext(ctx)
+	assert.True(t, processed)
+	assert.ErrorIs(t, err, apperr.ErrDeadlineExceeded)
+
+	processed, err = d.uc.ProcessNext(ctx)
+	assert.True(t, processed)
+	assert.NoError(t, err)
+}
+
+// TestConcertSearchQueueUseCase_PermanentFailure verifies that a task failing
+// on every attempt backs off exponentially and lands in the dead-letter table
+// once its attempts are used up.
+func TestConcertSearchQueueUseCase_PermanentFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := newSearchQueueTestDeps(t)
+
+	wantBackoffs := []time.Duration{time.Minute, 2 * time.Minute}
+	for attempt := 1; attempt <= testSearchQueueMaxAttempts; attempt++ {
+		d.taskRepo.EXPECT().Claim(ctx, mock.Anything).
+			Return(&entity.SearchTask{ID: "task-1", ArtistID: "artist-1", Status: entity.SearchTaskStatusRunning, Attempts: attempt}, nil).Once()
+		d.concertUC.EXPECT().SearchNewConcerts(ctx, "artist-1").Return(nil, apperr.ErrUnavailable).Once()
+		if attempt < testSearchQueueMaxAttempts {
+			d.taskRepo.EXPECT().Release(mock.Anything, "task-1", mock.AnythingOfType("string"), wantBackoffs[attempt-1]).Return(nil).Once()
+		}
+	}
+	d.taskRepo.EXPECT().DeadLetter(mock.Anything, "task-1", mock.AnythingOfType("string")).Return(nil).Once()
+
+	for range testSearchQueueMaxAttempts {
+		processed, err := d.uc.ProcessNext(ctx)
+		assert.True(t, processed)
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+	}
+}
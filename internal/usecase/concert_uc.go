@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,12 +28,53 @@ type ConcertUseCase interface {
 	//  - Internal: database query failure.
 	ListByArtist(ctx context.Context, artistID string) ([]*entity.Concert, error)
 
-	// ListByFollower returns all concerts for artists followed by the given user.
+	// ListByFollower returns one page of the concerts for artists followed by
+	// the given user, ordered by date and then ID. A limit of 0 selects the
+	// default page size; larger limits are capped. Pass an empty cursor for
+	// the first page and the returned nextCursor for each following page; an
+	// empty nextCursor marks the last page.
 	//
 	// # Possible errors
 	//
+	//  - InvalidArgument: If limit is negative or the cursor is malformed.
 	//  - NotFound: If the user does not exist.
-	ListByFollower(ctx context.Context, userID string) ([]*entity.Concert, error)
+	ListByFollower(ctx context.Context, userID string, limit int, cursor string) (concerts []*entity.Concert, nextCursor string, err error)
+
+	// ListForUser returns the concerts for artists followed by the user
+	// identified by externalUserID, the identity provider ID (Zitadel sub
+	// claim) carried by an authenticated request. The feed is read in pages
+	// and capped at followerFeedMaxPages of them.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If externalUserID is empty.
+	//  - NotFound: If no user has the given external ID.
+	//  - Internal: database query failure.
+	ListForUser(ctx context.Context, externalUserID string) ([]*entity.Concert, error)
+
+	// ExportICS renders the upcoming concerts of the artists followed by the
+	// user identified by externalUserID as an iCalendar (RFC 5545) file. Each
+	// concert is a VEVENT whose DTSTART is in the venue's time zone; concerts
+	// without a start time are all-day events on their local date.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If externalUserID is empty.
+	//  - NotFound: If no user has the given external ID.
+	//  - Internal: database query failure.
+	ExportICS(ctx context.Context, externalUserID string) ([]byte, error)
+
+	// ExportArtistICS renders the upcoming concerts of a single artist as an
+	// iCalendar (RFC 5545) feed that fans can subscribe to by URL. Each VEVENT
+	// summary names the artist, the series, and the venue; times follow
+	// ExportICS.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If artistID is empty.
+	//  - NotFound: If the artist does not exist.
+	//  - Internal: database query failure.
+	ExportArtistICS(ctx context.Context, artistID string) ([]byte, error)
 
 	// ListByFollowerGrouped returns concerts for followed artists, grouped by date
 	// and classified into home/nearby/away lanes based on proximity to the user's home.
@@ -57,8 +99,22 @@ type ConcertUseCase interface {
 	// # Possible errors
 	//
 	//  - NotFound: If the artist does not exist.
+	//  - DeadlineExceeded: If the per-artist search timeout fired.
+	//  - Unavailable: If the search breaker is open; the cause is
+	//    ErrSearchBreakerOpen and the searcher was not called.
 	//  - Internal: search or database failure.
 	SearchNewConcerts(ctx context.Context, artistID string) ([]*entity.Concert, error)
+
+	// EnsureConcertsFresh makes sure the artist's stored concerts reflect a
+	// recent external search. It runs discovery only when the artist's search
+	// log is missing or stale; otherwise it is a no-op. Discovered concerts
+	// are published asynchronously exactly as with SearchNewConcerts.
+	//
+	// # Possible errors
+	//
+	//  - NotFound: If the artist does not exist.
+	//  - Internal: search or database failure.
+	EnsureConcertsFresh(ctx context.Context, artistID string) error
 }
 
 // concertUseCase implements both the consumer-facing ConcertUseCase and the
@@ -67,25 +123,73 @@ type ConcertUseCase interface {
 // handler never sees the admin operations (approve/reject/delete).
 type concertUseCase struct {
 	artistRepo          entity.ArtistRepository
+	userRepo            entity.UserRepository
 	concertRepo         entity.ConcertRepository
 	venueRepo           entity.VenueRepository
 	seriesRepo          entity.SeriesRepository
 	searchLogRepo       entity.SearchLogRepository
+	followRepo          entity.FollowRepository
 	stagedConcertRepo   entity.StagedConcertRepository
 	rejectedConcertRepo entity.RejectedConcertLogRepository
 	concertSearcher     entity.ConcertSearcher
 	centroidResolver    CentroidResolver
 	publisher           EventPublisher
 	metrics             ConcertMetrics
-	// searchCacheTTL is how long a completed search is reused before a repeat
-	// external call is allowed. Configured per environment (prod runs longer).
-	searchCacheTTL time.Duration
+	// feedCache holds each user's followed-concert feed (ListByFollower
+	// result) keyed by followerFeedCacheKey. Follow changes evict the entry
+	// via InvalidateFollowerFeed; the cache TTL bounds staleness otherwise.
+	feedCache entity.Cache
+	// freshness decides how long a completed search is reused before a
+	// repeat external call is allowed. Configured per environment (prod runs
+	// longer).
+	freshness SearchFreshness
 	// discoveryWindow is how long after a successful discovery the external
 	// search is skipped, since announcements arrive in batches then go quiet.
 	discoveryWindow time.Duration
-	logger          *logging.Logger
+	// searchTimeout bounds each concertSearcher.Search call so one slow
+	// artist cannot consume the caller's whole deadline. Zero disables the
+	// per-artist bound and the call inherits the caller's deadline.
+	searchTimeout time.Duration
+	// breaker fails searches fast while the searcher is down. Nil disables it.
+	breaker *SearchBreaker
+	logger  *logging.Logger
+}
+
+// SearchFreshness decides how long a completed concert search stays fresh,
+// suppressing repeat external searches for the artist.
+type SearchFreshness struct {
+	// Window is how long a completed search stays fresh.
+	Window time.Duration
+	// ByHype replaces Window for artists whose most enthusiastic follower has
+	// the given hype, so artists fans care most about can be re-searched
+	// sooner. Hype levels without an entry use Window.
+	ByHype map[entity.Hype]time.Duration
+}
+
+// WindowFor returns the freshness window for an artist whose most
+// enthusiastic follower has hype top. An empty top, for an artist nobody
+// follows, uses Window.
+func (f SearchFreshness) WindowFor(top entity.Hype) time.Duration {
+	if w, ok := f.ByHype[top]; ok {
+		return w
+	}
+	return f.Window
 }
 
+// defaultFollowerFeedPageSize is the ListByFollower page size when the caller
+// does not choose one, and maxFollowerFeedPageSize the largest it may choose.
+const (
+	defaultFollowerFeedPageSize = 50
+	maxFollowerFeedPageSize     = 200
+)
+
+// followerFeedMaxPages caps how many ListByFollower pages followerFeed reads,
+// bounding the grouped feed and the ICS export at followerFeedMaxPages ×
+// maxFollowerFeedPageSize concerts. Pages run in date order, so a feed over
+// the cap loses its latest concerts; the cap sits far above real feeds and
+// only stops a runaway response.
+const followerFeedMaxPages = 10
+
 // pendingTimeout is the maximum age of a pending search log before it is
 // considered stale and treated as failed (self-healing for crashed workers).
 const pendingTimeout = 3 * time.Minute
@@ -97,8 +201,9 @@ const statusUpdateTimeout = 5 * time.Second
 
 // Compile-time interface compliance check
 var (
-	_ ConcertUseCase      = (*concertUseCase)(nil)
-	_ AdminConcertUseCase = (*concertUseCase)(nil)
+	_ ConcertUseCase          = (*concertUseCase)(nil)
+	_ AdminConcertUseCase     = (*concertUseCase)(nil)
+	_ FollowerFeedInvalidator = (*concertUseCase)(nil)
 )
 
 // NewConcertUseCase creates a new concert use case.
@@ -106,34 +211,44 @@ var (
 // admin approval/management operations (approve, reject, list, delete).
 func NewConcertUseCase(
 	artistRepo entity.ArtistRepository,
+	userRepo entity.UserRepository,
 	concertRepo entity.ConcertRepository,
 	venueRepo entity.VenueRepository,
 	seriesRepo entity.SeriesRepository,
 	searchLogRepo entity.SearchLogRepository,
+	followRepo entity.FollowRepository,
 	stagedConcertRepo entity.StagedConcertRepository,
 	rejectedConcertRepo entity.RejectedConcertLogRepository,
 	concertSearcher entity.ConcertSearcher,
 	centroidResolver CentroidResolver,
 	publisher EventPublisher,
 	metrics ConcertMetrics,
-	searchCacheTTL time.Duration,
+	feedCache entity.Cache,
+	freshness SearchFreshness,
 	discoveryWindow time.Duration,
+	searchTimeout time.Duration,
+	breaker *SearchBreaker,
 	logger *logging.Logger,
 ) *concertUseCase {
 	return &concertUseCase{
 		artistRepo:          artistRepo,
+		userRepo:            userRepo,
 		concertRepo:         concertRepo,
 		venueRepo:           venueRepo,
 		seriesRepo:          seriesRepo,
 		searchLogRepo:       searchLogRepo,
+		followRepo:          followRepo,
 		stagedConcertRepo:   stagedConcertRepo,
 		rejectedConcertRepo: rejectedConcertRepo,
 		concertSearcher:     concertSearcher,
 		centroidResolver:    centroidResolver,
 		publisher:           publisher,
 		metrics:             metrics,
-		searchCacheTTL:      searchCacheTTL,
+		feedCache:           feedCache,
+		freshness:           freshness,
 		discoveryWindow:     discoveryWindow,
+		searchTimeout:       searchTimeout,
+		breaker:             breaker,
 		logger:              logger,
 	}
 }
@@ -148,15 +263,89 @@ func (uc *concertUseCase) ListByArtist(ctx context.Context, artistID string) ([]
 	return concerts, nil
 }
 
-// ListByFollower returns all concerts for artists followed by the given user.
-func (uc *concertUseCase) ListByFollower(ctx context.Context, userID string) ([]*entity.Concert, error) {
-	return uc.concertRepo.ListByFollower(ctx, userID)
+// ListByFollower returns one page of the concerts for artists followed by the
+// given user. Pages are read from the repository directly rather than from
+// feedCache, so a cursor always continues from the current feed.
+func (uc *concertUseCase) ListByFollower(ctx context.Context, userID string, limit int, cursor string) ([]*entity.Concert, string, error) {
+	switch {
+	case limit < 0:
+		return nil, "", apperr.New(codes.InvalidArgument, "page limit must not be negative")
+	case limit == 0:
+		limit = defaultFollowerFeedPageSize
+	case limit > maxFollowerFeedPageSize:
+		limit = maxFollowerFeedPageSize
+	}
+
+	concerts, next, err := uc.concertRepo.ListByFollower(ctx, userID, limit, cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("list concerts by follower: %w", err)
+	}
+	return concerts, next, nil
+}
+
+// ListForUser resolves the external user ID to the internal user and returns
+// the concerts of the artists that user follows.
+func (uc *concertUseCase) ListForUser(ctx context.Context, externalUserID string) ([]*entity.Concert, error) {
+	if externalUserID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "external user ID is required")
+	}
+
+	user, err := uc.userRepo.GetByExternalID(ctx, externalUserID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve user by external ID: %w", err)
+	}
+
+	return uc.followerFeed(ctx, user.ID)
+}
+
+// ExportICS lists the user's followed concerts, drops those already past in
+// their venue's time zone, and encodes the rest as an iCalendar file.
+func (uc *concertUseCase) ExportICS(ctx context.Context, externalUserID string) ([]byte, error) {
+	concerts, err := uc.ListForUser(ctx, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return encodeConcertsICS("Liverty Music", upcomingConcerts(concerts, now), concertTitle, now), nil
+}
+
+// ExportArtistICS resolves the artist, lists its upcoming concerts, and
+// encodes them as an iCalendar feed named after the artist.
+func (uc *concertUseCase) ExportArtistICS(ctx context.Context, artistID string) ([]byte, error) {
+	if artistID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "artist ID is required")
+	}
+
+	artist, err := uc.artistRepo.Get(ctx, artistID)
+	if err != nil {
+		return nil, fmt.Errorf("get artist: %w", err)
+	}
+	concerts, err := uc.concertRepo.ListByArtist(ctx, artistID, true)
+	if err != nil {
+		return nil, fmt.Errorf("list concerts by artist: %w", err)
+	}
+
+	now := time.Now()
+	return encodeConcertsICS(artist.Name, upcomingConcerts(concerts, now), artistConcertSummary(artist), now), nil
+}
+
+// upcomingConcerts returns the concerts not yet past in their venue's time
+// zone as of now, preserving order.
+func upcomingConcerts(concerts []*entity.Concert, now time.Time) []*entity.Concert {
+	upcoming := make([]*entity.Concert, 0, len(concerts))
+	for _, c := range concerts {
+		if !c.IsPast(now) {
+			upcoming = append(upcoming, c)
+		}
+	}
+	return upcoming
 }
 
 // ListByFollowerGrouped returns concerts for followed artists, grouped by date
 // and classified into home/nearby/away lanes based on proximity to the user's home.
 func (uc *concertUseCase) ListByFollowerGrouped(ctx context.Context, userID string, home *entity.Home) ([]*entity.ProximityGroup, error) {
-	concerts, err := uc.concertRepo.ListByFollower(ctx, userID)
+	concerts, err := uc.followerFeed(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +353,58 @@ func (uc *concertUseCase) ListByFollowerGrouped(ctx context.Context, userID stri
 	return entity.GroupByDateAndProximity(concerts, home), nil
 }
 
+// InvalidateFollowerFeed evicts the user's cached followed-concert feed so
+// the next read reflects their current follows.
+func (uc *concertUseCase) InvalidateFollowerFeed(userID string) {
+	uc.feedCache.Delete(followerFeedCacheKey(userID))
+}
+
+// followerFeed returns the concerts of the artists the user follows, served
+// from feedCache when a recent result exists. It reads the feed through
+// ListByFollower in full pages, at most followerFeedMaxPages of them. Only
+// successful reads are cached. Every call returns a fresh slice so callers
+// may reorder it without affecting the cached copy.
+func (uc *concertUseCase) followerFeed(ctx context.Context, userID string) ([]*entity.Concert, error) {
+	key := followerFeedCacheKey(userID)
+	if cached, ok := uc.feedCache.Get(key).([]*entity.Concert); ok {
+		uc.logger.Debug(ctx, "follower feed served from cache",
+			slog.String("user_id", userID),
+			slog.Int("count", len(cached)),
+		)
+		return slices.Clone(cached), nil
+	}
+
+	var (
+		concerts []*entity.Concert
+		cursor   string
+	)
+	for range followerFeedMaxPages {
+		page, next, err := uc.ListByFollower(ctx, userID, maxFollowerFeedPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		concerts = append(concerts, page...)
+		cursor = next
+		if cursor == "" {
+			break
+		}
+	}
+	if cursor != "" {
+		uc.logger.Warn(ctx, "follower feed truncated at the page cap",
+			slog.String("user_id", userID),
+			slog.Int("count", len(concerts)),
+		)
+	}
+
+	uc.feedCache.Set(key, slices.Clone(concerts))
+	return concerts, nil
+}
+
+// followerFeedCacheKey is the feedCache key for a user's followed-concert feed.
+func followerFeedCacheKey(userID string) string {
+	return "follower-feed:" + userID
+}
+
 // ListWithProximity returns concerts for the specified artists, grouped by date
 // and classified by proximity to the given home.
 func (uc *concertUseCase) ListWithProximity(ctx context.Context, artistIDs []string, home *entity.Home) ([]*entity.ProximityGroup, error) {
@@ -181,6 +422,14 @@ func (uc *concertUseCase) ListWithProximity(ctx context.Context, artistIDs []str
 	return entity.GroupByDateAndProximity(concerts, home), nil
 }
 
+// EnsureConcertsFresh refreshes the artist's concerts when the last search is
+// stale. SearchNewConcerts already short-circuits fresh, pending, and
+// recently-discovered artists, so this only discards the result list.
+func (uc *concertUseCase) EnsureConcertsFresh(ctx context.Context, artistID string) error {
+	_, err := uc.SearchNewConcerts(ctx, artistID)
+	return err
+}
+
 // SearchNewConcerts discovers new concerts for the given artist synchronously.
 // It returns the newly discovered concerts after deduplication against
 // already-known upcoming events.
@@ -192,7 +441,7 @@ func (uc *concertUseCase) SearchNewConcerts(ctx context.Context, artistID string
 	}
 	if searchLog != nil {
 		now := time.Now()
-		if searchLog.IsFresh(now, uc.searchCacheTTL) {
+		if searchLog.IsFresh(now, uc.freshnessWindow(ctx, artistID)) {
 			uc.logger.Debug(ctx, "skipping external search, recently searched",
 				slog.String("artist_id", artistID),
 				slog.Time("search_time", searchLog.SearchTime),
@@ -217,6 +466,16 @@ func (uc *concertUseCase) SearchNewConcerts(ctx context.Context, artistID string
 		}
 	}
 
+	// Fail fast while the searcher is down rather than waiting out a
+	// timeout. The search log is left untouched so the artist is not
+	// recorded as failed for an outage it never reached.
+	if !uc.breaker.Allow(time.Now()) {
+		uc.metrics.RecordConcertSearch(ctx, "unavailable")
+		return nil, apperr.Wrap(ErrSearchBreakerOpen, codes.Unavailable, "concert search skipped: searcher is unavailable",
+			slog.String("artist_id", artistID),
+		)
+	}
+
 	// Mark as pending. If this fails, abort — without a pending row,
 	// downstream UpdateStatus calls silently no-op (0 rows affected).
 	if err := uc.searchLogRepo.Upsert(ctx, artistID, entity.SearchLogStatusPending); err != nil {
@@ -226,6 +485,24 @@ func (uc *concertUseCase) SearchNewConcerts(ctx context.Context, artistID string
 	return uc.executeSearch(ctx, artistID)
 }
 
+// freshnessWindow returns how long the artist's last completed search stays
+// fresh. The artist's top follower hype is looked up only when a per-hype
+// window is configured; if the lookup fails, the default window applies.
+func (uc *concertUseCase) freshnessWindow(ctx context.Context, artistID string) time.Duration {
+	if len(uc.freshness.ByHype) == 0 {
+		return uc.freshness.Window
+	}
+	top, err := uc.followRepo.TopHype(ctx, artistID)
+	if err != nil {
+		uc.logger.Warn(ctx, "failed to get top follower hype, using default search freshness window",
+			slog.String("artist_id", artistID),
+			slog.Any("error", err),
+		)
+		return uc.freshness.Window
+	}
+	return uc.freshness.WindowFor(top)
+}
+
 // executeSearch performs the actual Gemini search, deduplication, and event publishing.
 // It returns the newly discovered concerts and updates the search log status on exit.
 //
@@ -241,11 +518,25 @@ func (uc *concertUseCase) SearchNewConcerts(ctx context.Context, artistID string
 // is the source of truth and uses the resolved `venue_id` instead of the raw
 // listed name, so the application key is a best-effort upstream filter.
 func (uc *concertUseCase) executeSearch(ctx context.Context, artistID string) (result []*entity.Concert, err error) {
+	var rejected bool
 	defer func() {
+		// A panic leaves err and result zero, which would otherwise mark the
+		// search completed and suppress retries for the freshness window. Mark it
+		// failed instead, then let the panic reach the caller's recovery.
+		if r := recover(); r != nil {
+			uc.markSearchFailed(ctx, artistID)
+			uc.metrics.RecordConcertSearch(ctx, "error")
+			panic(r)
+		}
 		switch {
 		case err != nil:
 			uc.markSearchFailed(ctx, artistID)
 			uc.metrics.RecordConcertSearch(ctx, "error")
+		case rejected:
+			// Completed, not failed: the rejection is recorded and the artist is
+			// skipped until its freshness window lapses instead of every tick.
+			uc.markSearchCompleted(ctx, artistID)
+			uc.metrics.RecordConcertSearch(ctx, "rejected")
 		case len(result) == 0:
 			// A healthy run that discovered nothing new — distinct from a
 			// fruitful run so pipeline-health views can spot quota burned
@@ -283,14 +574,11 @@ func (uc *concertUseCase) executeSearch(ctx context.Context, artistID string) (r
 		)
 	}
 
-	// Get Official Site — missing site is not an error; search continues with nil
-	site, err := uc.artistRepo.GetOfficialSite(ctx, artistID)
-	if err != nil && !errors.Is(err, apperr.ErrNotFound) {
-		return nil, fmt.Errorf("failed to get official site: %w", err)
-	}
-	if errors.Is(err, apperr.ErrNotFound) {
-		site = nil
-		err = nil
+	// Get Official Sites — an artist without any is not an error; search
+	// continues ungrounded.
+	sites, err := uc.artistRepo.ListOfficialSites(ctx, artistID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list official sites: %w", err)
 	}
 
 	// Get existing upcoming concerts for deduplication.
@@ -307,21 +595,73 @@ func (uc *concertUseCase) executeSearch(ctx context.Context, artistID string) (r
 		return nil, fmt.Errorf("failed to list pending staged concert keys: %w", err)
 	}
 
-	// Search new concerts via external API (deadline inherited from HandlerTimeout)
-	scraped, err := uc.concertSearcher.Search(ctx, artist, site, time.Now())
+	// Search new concerts via external API. The deadline is inherited from
+	// the caller (HandlerTimeout for RPCs) unless a per-artist searchTimeout
+	// is configured.
+	searchCtx := ctx
+	if uc.searchTimeout > 0 {
+		var cancel context.CancelFunc
+		searchCtx, cancel = context.WithTimeout(ctx, uc.searchTimeout)
+		defer cancel()
+	}
+	searchTime := time.Now()
+	scraped, err := uc.concertSearcher.Search(searchCtx, artist, sites, searchTime)
+	if err != nil {
+		// Only the per-artist deadline is reported as such; a caller deadline
+		// or cancellation keeps its own error.
+		if ctx.Err() == nil && errors.Is(searchCtx.Err(), context.DeadlineExceeded) {
+			err = apperr.Wrap(err, codes.DeadlineExceeded, "concert search exceeded per-artist timeout",
+				slog.String("artist_id", artistID),
+				slog.Duration("search_timeout", uc.searchTimeout),
+			)
+		} else {
+			err = fmt.Errorf("failed to search concerts via external API: %w", err)
+		}
+	}
+	// A caller that gave up says nothing about the searcher's health.
+	if ctx.Err() == nil {
+		uc.breaker.Record(time.Now(), err)
+	}
+	// A rejected response is permanent: the same search returns the same
+	// answer, so retrying it would only burn quota.
+	if errors.Is(err, apperr.ErrFailedPrecondition) {
+		uc.logger.Warn(ctx, "concert search result rejected, skipping artist until the freshness window lapses",
+			slog.String("artist_id", artistID),
+			slog.Any("error", err),
+		)
+		rejected = true
+		return nil, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to search concerts via external API: %w", err)
+		return nil, err
 	}
 
-	// Deduplicate against published concerts.
+	// Drop events already past in their venue's time zone, then deduplicate
+	// against published concerts. The searcher filters past events too, but a
+	// response served from its cache may predate a local midnight.
 	_, filterSpan := otel.Tracer("usecase/concert").Start(ctx, "FilterNewConcerts")
-	newScraped := entity.ScrapedConcerts(scraped).FilterNew(existing)
+	upcoming := entity.ScrapedConcerts(scraped).Upcoming(searchTime)
+	newScraped := upcoming.FilterNew(existing)
 	filterSpan.SetAttributes(
 		attribute.Int("filter.scraped_count", len(scraped)),
 		attribute.Int("filter.new_count", len(newScraped)),
 	)
 	filterSpan.End()
 
+	// A published concert that reappears under a new date is a reschedule.
+	// It is staged like any other discovery, tagged with the event it moves,
+	// so the published date changes only once a reviewer approves the move.
+	for _, r := range upcoming.Reschedules(existing) {
+		r.Scraped.ReschedulesEventID = r.Existing.ID
+		uc.logger.Info(ctx, "concert reschedule detected; staging for review",
+			slog.String("artist_id", artistID),
+			slog.String("event_id", r.Existing.ID),
+			slog.String("title", r.Scraped.Title),
+			slog.String("previous_local_date", r.Existing.LocalDate.Format("2006-01-02")),
+			slog.String("local_date", r.Scraped.LocalDate.Format("2006-01-02")),
+		)
+	}
+
 	// Further deduplicate against pending staged rows. The staged dedup key
 	// uses (local_date, listed_venue_name) — the raw discovery-time identity —
 	// matching FilterNew's semantics.
@@ -340,6 +680,8 @@ func (uc *concertUseCase) executeSearch(ctx context.Context, artistID string) (r
 		newScraped = filtered
 	}
 
+	newScraped = uc.filterKnownSources(ctx, artistID, newScraped)
+
 	if filtered := len(scraped) - len(newScraped); filtered > 0 {
 		uc.logger.Debug(ctx, "filtered existing/duplicate events (same date)",
 			slog.String("artist_id", artistID),
@@ -355,15 +697,27 @@ func (uc *concertUseCase) executeSearch(ctx context.Context, artistID string) (r
 		return nil, nil
 	}
 
+	// Publish and return in a deterministic chronological order.
+	newScraped.Sort()
+
 	// Note: the artist.Name / MBID guard fires at the top of executeSearch
 	// (right after artistRepo.Get) so the Gemini call is never reached for
 	// data-quality failures. By the time we get here both fields are
 	// guaranteed non-empty.
 
+	// Each run gets its own session ID. It travels with the message, so a
+	// redelivery re-stages under the same session and every concert the run
+	// produced can be traced back to it.
+	sessionID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("generate search session ID: %w", err)
+	}
+
 	eventData := entity.ConcertDiscoveredData{
-		ArtistID:   artistID,
-		ArtistName: artist.Name,
-		Concerts:   newScraped,
+		ArtistID:        artistID,
+		ArtistName:      artist.Name,
+		Concerts:        newScraped,
+		SearchSessionID: sessionID.String(),
 	}
 
 	if err := uc.publisher.PublishEvent(ctx, entity.SubjectConcertDiscovered, eventData); err != nil {
@@ -379,6 +733,7 @@ func (uc *concertUseCase) executeSearch(ctx context.Context, artistID string) (r
 	uc.logger.Info(ctx, "published concert.discovered event",
 		slog.String("artist_id", artistID),
 		slog.String("artist_name", artist.Name),
+		slog.String("search_session_id", eventData.SearchSessionID),
 		slog.Int("concert_count", len(newScraped)),
 	)
 
@@ -423,6 +778,45 @@ func (uc *concertUseCase) markSearchCompleted(ctx context.Context, artistID stri
 	}
 }
 
+// filterKnownSources drops scraped concerts the artist already performs in:
+// a live concert with the same normalized source URL, local date, and venue.
+// FilterNew compares listed venue names only; this also matches the venue's
+// listed and canonical names, so a concert published under a differently
+// listed name of its venue is not staged again. Lookups are memoized per key
+// within the run. A failed lookup is logged and the concert is kept: the
+// creation path's natural-key UPSERT remains the authoritative dedup.
+func (uc *concertUseCase) filterKnownSources(ctx context.Context, artistID string, scraped entity.ScrapedConcerts) entity.ScrapedConcerts {
+	known := make(map[string]bool)
+	filtered := scraped[:0]
+	for _, sc := range scraped {
+		normalized := entity.NormalizeSourceURL(sc.SourceURL)
+		if normalized == "" {
+			filtered = append(filtered, sc)
+			continue
+		}
+		key := normalized + "|" + sc.LocalDate.Format(time.DateOnly) + "|" + sc.ListedVenueName
+		exists, checked := known[key]
+		if !checked {
+			var err error
+			exists, err = uc.concertRepo.ExistsBySource(ctx, artistID, normalized, sc.LocalDate, sc.ListedVenueName)
+			if err != nil {
+				uc.logger.Warn(ctx, "failed to check concert source, keeping concert",
+					slog.String("artist_id", artistID),
+					slog.String("source_url", normalized),
+					slog.String("error", err.Error()),
+				)
+				filtered = append(filtered, sc)
+				continue
+			}
+			known[key] = exists
+		}
+		if !exists {
+			filtered = append(filtered, sc)
+		}
+	}
+	return filtered
+}
+
 // markSearchFound records that the search discovered at least one new concert.
 // Failure is non-fatal and only logged: the discovery event was already
 // published, so a missed last_found_at update merely lets the next CronJob tick
@@ -3,9 +3,11 @@ package usecase_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"testing/synctest"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -14,7 +16,10 @@ import (
 	"github.com/liverty-music/backend/internal/entity/mocks"
 	"github.com/liverty-music/backend/internal/infrastructure/messaging"
 	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/liverty-music/backend/pkg/cache"
 	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -30,10 +35,12 @@ const (
 // concertTestDeps holds all dependencies for ConcertUseCase tests.
 type concertTestDeps struct {
 	artistRepo          *mocks.MockArtistRepository
+	userRepo            *mocks.MockUserRepository
 	concertRepo         *mocks.MockConcertRepository
 	venueRepo           *mocks.MockVenueRepository
 	seriesRepo          *mocks.MockSeriesRepository
 	searchLogRepo       *mocks.MockSearchLogRepository
+	followRepo          *mocks.MockFollowRepository
 	stagedConcertRepo   *mocks.MockStagedConcertRepository
 	rejectedConcertRepo *mocks.MockRejectedConcertLogRepository
 	searcher            *mocks.MockConcertSearcher
@@ -58,20 +65,26 @@ func newConcertTestDeps(t *testing.T) *concertTestDeps {
 	pub := gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: 64}, watermill.NopLogger{})
 	d := &concertTestDeps{
 		artistRepo:          mocks.NewMockArtistRepository(t),
+		userRepo:            mocks.NewMockUserRepository(t),
 		concertRepo:         mocks.NewMockConcertRepository(t),
 		venueRepo:           mocks.NewMockVenueRepository(t),
 		seriesRepo:          mocks.NewMockSeriesRepository(t),
 		searchLogRepo:       mocks.NewMockSearchLogRepository(t),
+		followRepo:          mocks.NewMockFollowRepository(t),
 		stagedConcertRepo:   mocks.NewMockStagedConcertRepository(t),
 		rejectedConcertRepo: mocks.NewMockRejectedConcertLogRepository(t),
 		searcher:            mocks.NewMockConcertSearcher(t),
 		centroidResolver:    noopCentroidResolver{},
 		publisher:           pub,
 	}
-	uc := usecase.NewConcertUseCase(d.artistRepo, d.concertRepo, d.venueRepo, d.seriesRepo, d.searchLogRepo, d.stagedConcertRepo, d.rejectedConcertRepo, d.searcher, d.centroidResolver, messaging.NewEventPublisher(pub), noopMetrics{}, testSearchCacheTTL, testDiscoveryWindow, logger)
+	feedCache := cache.NewMemoryCache(time.Minute)
+	uc := usecase.NewConcertUseCase(d.artistRepo, d.userRepo, d.concertRepo, d.venueRepo, d.seriesRepo, d.searchLogRepo, d.followRepo, d.stagedConcertRepo, d.rejectedConcertRepo, d.searcher, d.centroidResolver, messaging.NewEventPublisher(pub), noopMetrics{}, feedCache, usecase.SearchFreshness{Window: testSearchCacheTTL}, testDiscoveryWindow, 0, nil, logger)
 	d.uc = uc
 	d.adminUC = uc
-	t.Cleanup(func() { _ = pub.Close() })
+	t.Cleanup(func() {
+		_ = pub.Close()
+		_ = feedCache.Close()
+	})
 	return d
 }
 
@@ -126,6 +139,417 @@ func TestConcertUseCase_ListConcertsByArtist(t *testing.T) {
 	}
 }
 
+func TestConcertUseCase_ListForUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("resolves the user and lists followed artists' concerts", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		concerts := []*entity.Concert{
+			{Event: entity.Event{ID: "c1"}, Performers: []*entity.Artist{{ID: "a1"}}},
+		}
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(concerts, "", nil).Once()
+
+		got, err := d.uc.ListForUser(ctx, "ext-1")
+		assert.NoError(t, err)
+		assert.Equal(t, concerts, got)
+	})
+
+	t.Run("user resolution failure is returned without listing", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-unknown").
+			Return(nil, apperr.ErrNotFound).Once()
+
+		got, err := d.uc.ListForUser(ctx, "ext-unknown")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("empty external ID", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		got, err := d.uc.ListForUser(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Nil(t, got)
+	})
+}
+
+func TestConcertUseCase_ExportICS(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	jst := time.FixedZone("JST", 9*60*60)
+	tokyo := "JP-13"
+	california := "US-CA"
+	timedJP := &entity.Concert{
+		Event: entity.Event{
+			ID:        "c-tokyo",
+			Venue:     &entity.Venue{Name: "Zepp Haneda; Tokyo", AdminArea: &tokyo},
+			LocalDate: time.Date(2099, 10, 25, 0, 0, 0, 0, time.UTC),
+			StartTime: new(time.Date(2099, 10, 25, 18, 30, 0, 0, jst)),
+		},
+		Series:     &entity.Series{Title: "Autumn Tour, Final", SourceURL: "https://example.com/tour"},
+		Performers: []*entity.Artist{{Name: "Artist A"}, {Name: "Artist B"}},
+	}
+	timedUS := &entity.Concert{
+		Event: entity.Event{
+			ID:        "c-la",
+			Venue:     &entity.Venue{Name: "Hollywood Bowl", AdminArea: &california},
+			LocalDate: time.Date(2099, 11, 1, 0, 0, 0, 0, time.UTC),
+			StartTime: new(time.Date(2099, 11, 2, 3, 0, 0, 0, time.UTC)),
+		},
+		Series: &entity.Series{Title: "World Tour"},
+	}
+	allDay := &entity.Concert{
+		Event: entity.Event{
+			ID:        "c-festival",
+			Venue:     &entity.Venue{Name: "Makuhari Messe", AdminArea: new("JP-12")},
+			LocalDate: time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		Series: &entity.Series{Title: "Countdown Festival"},
+	}
+	past := &entity.Concert{
+		Event: entity.Event{
+			ID:        "c-past",
+			Venue:     &entity.Venue{Name: "Budokan", AdminArea: &tokyo},
+			LocalDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Series: &entity.Series{Title: "Old Show"},
+	}
+
+	t.Run("upcoming concerts become VEVENTs in the venue time zone", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").
+			Return([]*entity.Concert{past, timedJP, timedUS, allDay}, "", nil).Once()
+
+		got, err := d.uc.ExportICS(ctx, "ext-1")
+		require.NoError(t, err)
+		ics := string(got)
+
+		assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+		for line := range strings.SplitSeq(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), 75, "content line must be folded: %q", line)
+		}
+		assert.Equal(t, 3, strings.Count(ics, "BEGIN:VEVENT\r\n"))
+		assert.Equal(t, 1, strings.Count(ics, "BEGIN:VTIMEZONE\r\nTZID:Asia/Tokyo\r\n"))
+		assert.NotContains(t, ics, "c-past")
+
+		assert.Contains(t, ics, "UID:c-tokyo@liverty-music.app\r\n")
+		assert.Contains(t, ics, "DTSTART;TZID=Asia/Tokyo:20991025T183000\r\n")
+		assert.Contains(t, ics, `SUMMARY:Autumn Tour\, Final`+"\r\n")
+		assert.Contains(t, ics, `LOCATION:Zepp Haneda\; Tokyo`+"\r\n")
+		assert.Contains(t, ics, `DESCRIPTION:Artist A\, Artist B`+"\r\n")
+		assert.Contains(t, ics, "URL:https://example.com/tour\r\n")
+
+		assert.Contains(t, ics, "DTSTART:20991102T030000Z\r\n")
+		assert.Contains(t, ics, "DTSTART;VALUE=DATE:20991231\r\nDTEND;VALUE=DATE:21000101\r\n")
+	})
+
+	t.Run("no upcoming concerts is an empty calendar", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").
+			Return([]*entity.Concert{past}, "", nil).Once()
+
+		got, err := d.uc.ExportICS(ctx, "ext-1")
+		require.NoError(t, err)
+		assert.Contains(t, string(got), "BEGIN:VCALENDAR\r\n")
+		assert.NotContains(t, string(got), "BEGIN:VEVENT")
+		assert.NotContains(t, string(got), "BEGIN:VTIMEZONE")
+	})
+
+	t.Run("long lines are folded without splitting characters", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		long := *allDay
+		long.Series = &entity.Series{Title: strings.Repeat("ライブ", 20)}
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").
+			Return([]*entity.Concert{&long}, "", nil).Once()
+
+		got, err := d.uc.ExportICS(ctx, "ext-1")
+		require.NoError(t, err)
+		ics := string(got)
+		assert.True(t, utf8.ValidString(ics))
+		unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+		assert.Contains(t, unfolded, "SUMMARY:"+strings.Repeat("ライブ", 20)+"\r\n")
+		for line := range strings.SplitSeq(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), 75)
+			assert.True(t, utf8.ValidString(line), "fold split a character: %q", line)
+		}
+	})
+
+	t.Run("user resolution failure is returned", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-unknown").Return(nil, apperr.ErrNotFound).Once()
+
+		got, err := d.uc.ExportICS(ctx, "ext-unknown")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("empty external ID", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		got, err := d.uc.ExportICS(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Nil(t, got)
+	})
+}
+
+func TestConcertUseCase_ExportArtistICS(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	artist := &entity.Artist{ID: "a1", Name: "Artist A"}
+	tokyo := "JP-13"
+	upcoming := &entity.Concert{
+		Event: entity.Event{
+			ID:        "c-tokyo",
+			Venue:     &entity.Venue{Name: "Zepp Haneda", AdminArea: &tokyo},
+			LocalDate: time.Date(2099, 10, 25, 0, 0, 0, 0, time.UTC),
+			StartTime: new(time.Date(2099, 10, 25, 9, 30, 0, 0, time.UTC)),
+		},
+		Series:     &entity.Series{Title: "Autumn Tour"},
+		Performers: []*entity.Artist{artist},
+	}
+	pending := &entity.Concert{
+		Event: entity.Event{
+			ID:              "c-pending",
+			ListedVenueName: new("Club Quattro"),
+			LocalDate:       time.Date(2099, 11, 3, 0, 0, 0, 0, time.UTC),
+		},
+		Series:     &entity.Series{Title: "Club Circuit"},
+		Performers: []*entity.Artist{artist},
+	}
+	past := &entity.Concert{
+		Event: entity.Event{
+			ID:        "c-past",
+			Venue:     &entity.Venue{Name: "Budokan", AdminArea: &tokyo},
+			LocalDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Series: &entity.Series{Title: "Old Show"},
+	}
+
+	t.Run("summaries name the artist and venue", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.artistRepo.EXPECT().Get(ctx, "a1").Return(artist, nil).Once()
+		d.concertRepo.EXPECT().ListByArtist(ctx, "a1", true).Return([]*entity.Concert{past, upcoming, pending}, nil).Once()
+
+		got, err := d.uc.ExportArtistICS(ctx, "a1")
+		require.NoError(t, err)
+		ics := string(got)
+
+		assert.Contains(t, ics, "X-WR-CALNAME:Artist A\r\n")
+		assert.Equal(t, 2, strings.Count(ics, "BEGIN:VEVENT\r\n"))
+		assert.NotContains(t, ics, "c-past")
+		assert.Contains(t, ics, "SUMMARY:Artist A: Autumn Tour @ Zepp Haneda\r\n")
+		assert.Contains(t, ics, "DTSTART;TZID=Asia/Tokyo:20991025T183000\r\n")
+		assert.Contains(t, ics, "SUMMARY:Artist A: Club Circuit @ Club Quattro\r\n")
+		assert.Contains(t, ics, "DTSTART;VALUE=DATE:20991103\r\n")
+	})
+
+	t.Run("unknown artist is NotFound", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.artistRepo.EXPECT().Get(ctx, "missing").Return(nil, apperr.ErrNotFound).Once()
+
+		got, err := d.uc.ExportArtistICS(ctx, "missing")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+		assert.Nil(t, got)
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.artistRepo.EXPECT().Get(ctx, "a1").Return(artist, nil).Once()
+		d.concertRepo.EXPECT().ListByArtist(ctx, "a1", true).Return(nil, apperr.New(codes.Internal, "db down")).Once()
+
+		got, err := d.uc.ExportArtistICS(ctx, "a1")
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+		assert.Nil(t, got)
+	})
+
+	t.Run("empty artist ID", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		got, err := d.uc.ExportArtistICS(ctx, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Nil(t, got)
+	})
+}
+
+func TestConcertUseCase_ListByFollower(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	page := []*entity.Concert{{Event: entity.Event{ID: "c1"}, Performers: []*entity.Artist{{ID: "a1"}}}}
+
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+	}{
+		{name: "zero limit selects the default page size", limit: 0, wantLimit: 50},
+		{name: "limit within bounds is kept", limit: 20, wantLimit: 20},
+		{name: "limit above the maximum is capped", limit: 1000, wantLimit: 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := newConcertTestDeps(t)
+
+			d.concertRepo.EXPECT().ListByFollower(ctx, "u1", tt.wantLimit, "cursor-1").Return(page, "cursor-2", nil).Once()
+
+			got, next, err := d.uc.ListByFollower(ctx, "u1", tt.limit, "cursor-1")
+			require.NoError(t, err)
+			assert.Equal(t, page, got)
+			assert.Equal(t, "cursor-2", next)
+		})
+	}
+
+	t.Run("negative limit is rejected", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		_, _, err := d.uc.ListByFollower(ctx, "u1", -1, "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("repository errors are returned", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", 50, "bad").Return(nil, "", apperr.ErrInvalidArgument).Once()
+
+		_, _, err := d.uc.ListByFollower(ctx, "u1", 0, "bad")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestConcertUseCase_ListForUser_ReadsEveryPage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	d := newConcertTestDeps(t)
+
+	first := []*entity.Concert{{Event: entity.Event{ID: "c1"}}}
+	second := []*entity.Concert{{Event: entity.Event{ID: "c2"}}}
+	d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+	d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(first, "next", nil).Once()
+	d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "next").Return(second, "", nil).Once()
+
+	got, err := d.uc.ListForUser(ctx, "ext-1")
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.Concert{first[0], second[0]}, got)
+}
+
+func TestConcertUseCase_ListForUser_StopsAtThePageCap(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	d := newConcertTestDeps(t)
+
+	// Every page reports a next one; the feed stops after the cap
+	// (followerFeedMaxPages, 10) and keeps what it read.
+	d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+	d.concertRepo.EXPECT().ListByFollower(ctx, "u1", 200, mock.Anything).
+		Return([]*entity.Concert{{Event: entity.Event{ID: "c"}}}, "more", nil).
+		Times(10)
+
+	got, err := d.uc.ListForUser(ctx, "ext-1")
+	require.NoError(t, err)
+	assert.Len(t, got, 10)
+}
+
+func TestConcertUseCase_FollowerFeedCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	before := []*entity.Concert{{Event: entity.Event{ID: "c1"}, Performers: []*entity.Artist{{ID: "a1"}}}}
+	after := []*entity.Concert{
+		{Event: entity.Event{ID: "c1"}, Performers: []*entity.Artist{{ID: "a1"}}},
+		{Event: entity.Event{ID: "c2"}, Performers: []*entity.Artist{{ID: "a2"}}},
+	}
+
+	// feed reads u1's followed-concert feed through ListForUser, the cached
+	// path; ext-1 resolves to u1.
+	feed := func(d *concertTestDeps) ([]*entity.Concert, error) {
+		d.userRepo.EXPECT().GetByExternalID(ctx, "ext-1").Return(&entity.User{ID: "u1"}, nil).Once()
+		return d.uc.ListForUser(ctx, "ext-1")
+	}
+
+	t.Run("second read is served from cache", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(before, "", nil).Once()
+
+		first, err := feed(d)
+		require.NoError(t, err)
+		second, err := feed(d)
+		require.NoError(t, err)
+
+		assert.Equal(t, before, first)
+		assert.Equal(t, before, second)
+	})
+
+	t.Run("follow change refreshes the feed", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		followRepo := mocks.NewMockFollowRepository(t)
+		publisher := ucmocks.NewMockEventPublisher(t)
+		followUC := usecase.NewFollowUseCase(followRepo, d.artistRepo, nil, nil, nil, d.searchLogRepo, d.uc.(usecase.FollowerFeedInvalidator), publisher, noopMetrics{}, newTestLogger(t))
+
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(before, "", nil).Once()
+		got, err := feed(d)
+		require.NoError(t, err)
+		assert.Equal(t, before, got)
+
+		followRepo.EXPECT().SetHype(ctx, "u1", "a2", entity.HypeAway).Return(nil).Once()
+		require.NoError(t, followUC.SetHype(ctx, "u1", "a2", entity.HypeAway))
+
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(after, "", nil).Once()
+		got, err = feed(d)
+		require.NoError(t, err)
+		assert.Equal(t, after, got)
+	})
+
+	t.Run("failed reads are not cached", func(t *testing.T) {
+		t.Parallel()
+		d := newConcertTestDeps(t)
+
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(nil, "", apperr.ErrInternal).Once()
+		_, err := feed(d)
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+
+		d.concertRepo.EXPECT().ListByFollower(ctx, "u1", mock.Anything, "").Return(before, "", nil).Once()
+		got, err := feed(d)
+		require.NoError(t, err)
+		assert.Equal(t, before, got)
+	})
+}
+
 func TestConcertUseCase_ListByFollowerGrouped(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -166,7 +590,7 @@ func TestConcertUseCase_ListByFollowerGrouped(t *testing.T) {
 				Performers: []*entity.Artist{{ID: "a2"}},
 			},
 		}
-		d.concertRepo.EXPECT().ListByFollower(ctx, "user-1").Return(concerts, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "user-1", mock.Anything, "").Return(concerts, "", nil).Once()
 
 		groups, err := d.uc.ListByFollowerGrouped(ctx, "user-1", home)
 		assert.NoError(t, err)
@@ -196,7 +620,7 @@ func TestConcertUseCase_ListByFollowerGrouped(t *testing.T) {
 		concerts := []*entity.Concert{
 			{Event: entity.Event{ID: "c1", LocalDate: date1, Venue: &entity.Venue{ID: "v1", AdminArea: new("JP-13"), Coordinates: &entity.Coordinates{Latitude: tokyoLat, Longitude: tokyoLng}}}},
 		}
-		d.concertRepo.EXPECT().ListByFollower(ctx, "user-2").Return(concerts, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "user-2", mock.Anything, "").Return(concerts, "", nil).Once()
 
 		groups, err := d.uc.ListByFollowerGrouped(ctx, "user-2", nil)
 		assert.NoError(t, err)
@@ -211,7 +635,7 @@ func TestConcertUseCase_ListByFollowerGrouped(t *testing.T) {
 		d := newConcertTestDeps(t)
 
 		home := &entity.Home{Level1: "JP-13"}
-		d.concertRepo.EXPECT().ListByFollower(ctx, "user-3").Return(nil, nil).Once()
+		d.concertRepo.EXPECT().ListByFollower(ctx, "user-3", mock.Anything, "").Return(nil, "", nil).Once()
 
 		groups, err := d.uc.ListByFollowerGrouped(ctx, "user-3", home)
 		assert.NoError(t, err)
@@ -276,10 +700,11 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-				d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(site, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return([]*entity.OfficialSite{site}, nil).Once()
 				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-				d.searcher.EXPECT().Search(mock.Anything, artist, site, mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, []*entity.OfficialSite{site}, mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 				d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
 			},
@@ -302,10 +727,11 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(expiredLog, nil).Once()
 				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-				d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(site, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return([]*entity.OfficialSite{site}, nil).Once()
 				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-				d.searcher.EXPECT().Search(mock.Anything, artist, site, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, []*entity.OfficialSite{site}, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
 				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			},
 			wantErr: nil,
@@ -320,8 +746,9 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 				d.artistRepo.EXPECT().Get(ctx, artistID).Return(&entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}, nil).Once()
-				d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(&entity.OfficialSite{}, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return([]*entity.OfficialSite{{}}, nil).Once()
 				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
 				d.searcher.EXPECT().Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, apperr.ErrInternal).Once()
 				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusFailed).Return(nil).Once()
@@ -329,7 +756,7 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 			wantErr: apperr.ErrInternal,
 		},
 		{
-			name: "success - no official site record, search continues with nil site",
+			name: "success - no official site record, search continues without sites",
 			args: args{artistID: "artist-1"},
 			setup: func(t *testing.T, d *concertTestDeps) {
 				t.Helper()
@@ -342,15 +769,76 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-				d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-				d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 				d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
 			},
 			wantErr: nil,
 		},
+		{
+			name: "success - passes every official site to the searcher",
+			args: args{artistID: "artist-1"},
+			setup: func(t *testing.T, d *concertTestDeps) {
+				t.Helper()
+				artistID := "artist-1"
+				artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+				sites := []*entity.OfficialSite{
+					{ArtistID: artistID, URL: "https://example.com"},
+					{ArtistID: artistID, URL: "https://tour.example.com"},
+					{ArtistID: artistID, URL: "https://label.example.jp/artist"},
+				}
+
+				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(sites, nil).Once()
+				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, sites, mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+			},
+			wantErr: nil,
+		},
+		{
+			name: "failure - listing official sites fails, marks search as failed",
+			args: args{artistID: "artist-1"},
+			setup: func(t *testing.T, d *concertTestDeps) {
+				t.Helper()
+				artistID := "artist-1"
+
+				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+				d.artistRepo.EXPECT().Get(ctx, artistID).Return(&entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, apperr.ErrInternal).Once()
+				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusFailed).Return(nil).Once()
+			},
+			wantErr: apperr.ErrInternal,
+		},
+		{
+			name: "success - rejected search result marks search completed instead of failed",
+			args: args{artistID: "artist-1"},
+			setup: func(t *testing.T, d *concertTestDeps) {
+				t.Helper()
+				artistID := "artist-1"
+				artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+
+				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+					Return(nil, apperr.New(codes.FailedPrecondition, "gemini search result exceeds per-artist event limit")).Once()
+				// Completed, so the next tick skips the artist; failed would retry it.
+				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+			},
+			wantErr: nil,
+		},
 		{
 			name: "success - deduplicates against existing concerts (date-only key)",
 			args: args{artistID: "artist-1"},
@@ -358,7 +846,9 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 				t.Helper()
 				artistID := "artist-1"
 				artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
-				concertDate := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+				// Relative to now: a fixed date would turn into a past event and be
+				// dropped before deduplication.
+				concertDate := time.Now().UTC().AddDate(0, 1, 0).Truncate(24 * time.Hour)
 				existing := []*entity.Concert{
 					{Event: entity.Event{ID: "c1", LocalDate: concertDate}},
 				}
@@ -369,10 +859,11 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-				d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(existing, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-				d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 				// Existing has a nil venue name; the (date, venue) key differs, so the
 				// scraped concert is new and published → last_found_at is recorded.
@@ -406,6 +897,212 @@ func TestConcertUseCase_SearchNewConcerts(t *testing.T) {
 	}
 }
 
+// TestConcertUseCase_SearchNewConcerts_SearchTimeout verifies that a configured
+// per-artist search timeout cuts off a searcher that blocks past it, marks the
+// search log failed, and surfaces DeadlineExceeded for the job's circuit breaker.
+func TestConcertUseCase_SearchNewConcerts_SearchTimeout(t *testing.T) {
+	t.Parallel()
+
+	const searchTimeout = 30 * time.Second
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+
+	synctest.Test(t, func(t *testing.T) {
+		ctx := context.Background()
+		d := newConcertTestDeps(t)
+		feedCache := cache.NewMemoryCache(time.Minute)
+		t.Cleanup(func() { _ = feedCache.Close() })
+		uc := usecase.NewConcertUseCase(d.artistRepo, d.userRepo, d.concertRepo, d.venueRepo, d.seriesRepo, d.searchLogRepo, d.followRepo, d.stagedConcertRepo, d.rejectedConcertRepo, d.searcher, d.centroidResolver, messaging.NewEventPublisher(d.publisher), noopMetrics{}, feedCache, usecase.SearchFreshness{Window: testSearchCacheTTL}, testDiscoveryWindow, searchTimeout, nil, newTestLogger(t))
+
+		d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+		d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+		d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+		d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+		d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+		d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+		d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+		d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+			RunAndReturn(func(ctx context.Context, _ *entity.Artist, _ []*entity.OfficialSite, _ time.Time) ([]*entity.ScrapedConcert, error) {
+				// Block well past the per-artist deadline unless cancelled.
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(10 * searchTimeout):
+					return nil, nil
+				}
+			}).Once()
+		d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusFailed).Return(nil).Once()
+
+		start := time.Now()
+		got, err := uc.SearchNewConcerts(ctx, artistID)
+
+		assert.Nil(t, got)
+		assert.ErrorIs(t, err, apperr.ErrDeadlineExceeded)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, searchTimeout, time.Since(start), "search must be cut off at the per-artist deadline")
+	})
+}
+
+// TestConcertUseCase_SearchNewConcerts_Panic verifies that a panic after the
+// search log is marked pending resets it to failed rather than completed, so
+// the artist is retried on the next tick instead of skipped for
+// searchCacheTTL, and that the panic still propagates to the caller.
+func TestConcertUseCase_SearchNewConcerts_Panic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+
+	d := newConcertTestDeps(t)
+	d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+	d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+	d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+	d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+	d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+	d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+	d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+		RunAndReturn(func(context.Context, *entity.Artist, []*entity.OfficialSite, time.Time) ([]*entity.ScrapedConcert, error) {
+			panic("searcher exploded")
+		}).Once()
+	d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusFailed).Return(nil).Once()
+	// UpdateStatus(..., SearchLogStatusCompleted) MUST NOT be called.
+
+	assert.PanicsWithValue(t, "searcher exploded", func() {
+		_, _ = d.uc.SearchNewConcerts(ctx, artistID)
+	})
+}
+
+// TestConcertUseCase_SearchNewConcerts_SearchBreaker verifies that consecutive
+// searcher outages open the shared breaker, that an open breaker fails the
+// search fast with Unavailable before the search log or searcher is touched,
+// and that the next search after the cooldown probes the searcher again.
+func TestConcertUseCase_SearchNewConcerts_SearchBreaker(t *testing.T) {
+	t.Parallel()
+
+	const cooldown = time.Minute
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+	outage := apperr.New(codes.Unavailable, "gemini is unavailable")
+
+	newUC := func(t *testing.T, d *concertTestDeps, breaker *usecase.SearchBreaker) usecase.ConcertUseCase {
+		t.Helper()
+		feedCache := cache.NewMemoryCache(time.Minute)
+		t.Cleanup(func() { _ = feedCache.Close() })
+		return usecase.NewConcertUseCase(d.artistRepo, d.userRepo, d.concertRepo, d.venueRepo, d.seriesRepo, d.searchLogRepo, d.followRepo, d.stagedConcertRepo, d.rejectedConcertRepo, d.searcher, d.centroidResolver, messaging.NewEventPublisher(d.publisher), noopMetrics{}, feedCache, usecase.SearchFreshness{Window: testSearchCacheTTL}, testDiscoveryWindow, 0, breaker, newTestLogger(t))
+	}
+	expectSearchRuns := func(d *concertTestDeps, n int) {
+		d.searchLogRepo.EXPECT().Upsert(mock.Anything, artistID, entity.SearchLogStatusPending).Return(nil).Times(n)
+		d.artistRepo.EXPECT().Get(mock.Anything, artistID).Return(artist, nil).Times(n)
+		d.artistRepo.EXPECT().ListOfficialSites(mock.Anything, artistID).Return(nil, nil).Times(n)
+		d.concertRepo.EXPECT().ListByArtist(mock.Anything, artistID, true).Return(nil, nil).Times(n)
+		d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Times(n)
+	}
+
+	t.Run("open breaker fails fast without searching", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		d := newConcertTestDeps(t)
+		breaker := usecase.NewSearchBreaker(1, cooldown)
+		breaker.Record(time.Now(), outage)
+		uc := newUC(t, d, breaker)
+
+		d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+
+		got, err := uc.SearchNewConcerts(ctx, artistID)
+
+		assert.Nil(t, got)
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+		assert.ErrorIs(t, err, usecase.ErrSearchBreakerOpen)
+		d.searchLogRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything, mock.Anything)
+		d.searcher.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("consecutive outages open the breaker until the cooldown elapses", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			d := newConcertTestDeps(t)
+			uc := newUC(t, d, usecase.NewSearchBreaker(2, cooldown))
+
+			d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Times(4)
+			expectSearchRuns(d, 3)
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+				Return(nil, outage).Twice()
+			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusFailed).Return(nil).Twice()
+
+			for range 2 {
+				_, err := uc.SearchNewConcerts(ctx, artistID)
+				require.ErrorIs(t, err, apperr.ErrUnavailable)
+				require.NotErrorIs(t, err, usecase.ErrSearchBreakerOpen)
+			}
+
+			_, err := uc.SearchNewConcerts(ctx, artistID)
+			require.ErrorIs(t, err, usecase.ErrSearchBreakerOpen, "the breaker must be open after two outages")
+
+			time.Sleep(cooldown)
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+				Return(nil, nil).Once()
+			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+
+			_, err = uc.SearchNewConcerts(ctx, artistID)
+			assert.NoError(t, err, "the search after the cooldown must probe the searcher")
+		})
+	})
+}
+
+// TestConcertUseCase_SearchNewConcerts_SearchSession verifies that every
+// search run publishes its batch under a fresh search session ID.
+func TestConcertUseCase_SearchNewConcerts_SearchSession(t *testing.T) {
+	t.Parallel()
+
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+	scraped := []*entity.ScrapedConcert{
+		{Title: "New Concert", ListedVenueName: "Test Venue", LocalDate: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), SourceURL: "https://example.com"},
+	}
+
+	synctest.Test(t, func(t *testing.T) {
+		ctx := context.Background()
+		d := newConcertTestDeps(t)
+
+		d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Twice()
+		d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Twice()
+		d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Twice()
+		d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Twice()
+		d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Twice()
+		d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+		d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Twice()
+		d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Twice()
+		d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Twice()
+		d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Twice()
+
+		sub, err := d.publisher.Subscribe(ctx, entity.SubjectConcertDiscovered)
+		require.NoError(t, err)
+
+		var sessions []string
+		for range 2 {
+			_, err := d.uc.SearchNewConcerts(ctx, artistID)
+			require.NoError(t, err)
+
+			select {
+			case msg := <-sub:
+				msg.Ack()
+				var data entity.ConcertDiscoveredData
+				require.NoError(t, messaging.ParseCloudEventData(msg, &data))
+				sessions = append(sessions, data.SearchSessionID)
+			case <-time.After(200 * time.Millisecond):
+				t.Fatal("timed out waiting for concert.discovered event")
+			}
+		}
+
+		require.Len(t, sessions, 2)
+		assert.NotEmpty(t, sessions[0])
+		assert.NotEmpty(t, sessions[1])
+		assert.NotEqual(t, sessions[0], sessions[1], "each run gets its own session")
+	})
+}
+
 // TestSearchNewConcerts_TimingBoundaries verifies the cache TTL and pending timeout
 // boundaries using deterministic fake-clock time via testing/synctest. Each sub-test
 // runs inside a synctest.Test bubble so that time.Now() in production code uses virtual
@@ -459,10 +1156,11 @@ func TestSearchNewConcerts_TimingBoundaries(t *testing.T) {
 
 			d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 			d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-			d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+			d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 			d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+			d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-			d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
 
@@ -517,10 +1215,11 @@ func TestSearchNewConcerts_TimingBoundaries(t *testing.T) {
 
 			d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 			d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-			d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+			d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 			d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+			d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-			d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
 
@@ -536,6 +1235,254 @@ func TestSearchNewConcerts_TimingBoundaries(t *testing.T) {
 	})
 }
 
+// TestSearchNewConcerts_FreshnessWindow verifies when a completed search stops
+// being fresh: at exactly the window, and at the per-hype window chosen by the
+// artist's most enthusiastic follower.
+func TestSearchNewConcerts_FreshnessWindow(t *testing.T) {
+	t.Parallel()
+
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+	byHype := map[entity.Hype]time.Duration{
+		entity.HypeAway:   6 * time.Hour,
+		entity.HypeNearby: 12 * time.Hour,
+	}
+
+	tests := []struct {
+		name   string
+		byHype map[entity.Hype]time.Duration
+		// topHype and topHypeErr are returned by TopHype, which is expected
+		// only when byHype is set.
+		topHype    entity.Hype
+		topHypeErr error
+		age        time.Duration
+		wantSearch bool
+	}{
+		{
+			name:       "just inside the window is fresh",
+			age:        testSearchCacheTTL - time.Second,
+			wantSearch: false,
+		},
+		{
+			name:       "exactly at the window expires",
+			age:        testSearchCacheTTL,
+			wantSearch: true,
+		},
+		{
+			name:       "away follower shortens the window",
+			byHype:     byHype,
+			topHype:    entity.HypeAway,
+			age:        7 * time.Hour,
+			wantSearch: true,
+		},
+		{
+			name:       "nearby follower uses its own window",
+			byHype:     byHype,
+			topHype:    entity.HypeNearby,
+			age:        7 * time.Hour,
+			wantSearch: false,
+		},
+		{
+			name:       "nearby window expires at its boundary",
+			byHype:     byHype,
+			topHype:    entity.HypeNearby,
+			age:        12 * time.Hour,
+			wantSearch: true,
+		},
+		{
+			name:       "hype without an override uses the default window",
+			byHype:     byHype,
+			topHype:    entity.HypeWatch,
+			age:        23 * time.Hour,
+			wantSearch: false,
+		},
+		{
+			name:       "artist nobody follows uses the default window",
+			byHype:     byHype,
+			topHype:    "",
+			age:        23 * time.Hour,
+			wantSearch: false,
+		},
+		{
+			name:       "hype lookup failure falls back to the default window",
+			byHype:     byHype,
+			topHypeErr: apperr.ErrInternal,
+			age:        7 * time.Hour,
+			wantSearch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			synctest.Test(t, func(t *testing.T) {
+				ctx := context.Background()
+				d := newConcertTestDeps(t)
+				feedCache := cache.NewMemoryCache(time.Minute)
+				t.Cleanup(func() { _ = feedCache.Close() })
+				freshness := usecase.SearchFreshness{Window: testSearchCacheTTL, ByHype: tt.byHype}
+				uc := usecase.NewConcertUseCase(d.artistRepo, d.userRepo, d.concertRepo, d.venueRepo, d.seriesRepo, d.searchLogRepo, d.followRepo, d.stagedConcertRepo, d.rejectedConcertRepo, d.searcher, d.centroidResolver, messaging.NewEventPublisher(d.publisher), noopMetrics{}, feedCache, freshness, testDiscoveryWindow, 0, nil, newTestLogger(t))
+
+				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(&entity.SearchLog{
+					ArtistID:   artistID,
+					SearchTime: time.Now(),
+					Status:     entity.SearchLogStatusCompleted,
+				}, nil).Once()
+				if tt.byHype != nil {
+					d.followRepo.EXPECT().TopHype(ctx, artistID).Return(tt.topHype, tt.topHypeErr).Once()
+				}
+				if tt.wantSearch {
+					d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+					d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+					d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+					d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+					d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+					d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(nil, nil).Once()
+					d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+				}
+
+				time.Sleep(tt.age) // advance the fake clock
+
+				got, err := uc.SearchNewConcerts(ctx, artistID)
+				require.NoError(t, err)
+				assert.Empty(t, got)
+			})
+		})
+	}
+}
+
+// TestSearchNewConcerts_PastFilterTimeZone verifies that events are judged
+// past against today's date in the venue's time zone. The fake clock is
+// advanced to 15:00 UTC on Jan 1, which is already 00:00 JST on Jan 2.
+func TestSearchNewConcerts_PastFilterTimeZone(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		ctx := context.Background()
+		d := newConcertTestDeps(t)
+		artistID := "artist-1"
+		artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+
+		time.Sleep(15 * time.Hour) // bubble starts at 2000-01-01T00:00Z
+		jan1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		jan2 := jan1.AddDate(0, 0, 1)
+		scraped := []*entity.ScrapedConcert{
+			{Title: "Yesterday in Tokyo", ListedVenueName: "Zepp Haneda", AdminArea: new("JP-13"), LocalDate: jan1},
+			{Title: "Tonight in Tokyo", ListedVenueName: "Zepp Haneda", AdminArea: new("JP-13"), LocalDate: jan2},
+			{Title: "Tonight abroad", ListedVenueName: "O2 Academy Brixton", LocalDate: jan1},
+		}
+
+		d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+		d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+		d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+		d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+		d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+		d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+		d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+		d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+		d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+		d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
+
+		got, err := d.uc.SearchNewConcerts(ctx, artistID)
+		require.NoError(t, err)
+
+		var titles []string
+		for _, c := range got {
+			titles = append(titles, c.Series.Title)
+		}
+		assert.Equal(t, []string{"Tonight abroad", "Tonight in Tokyo"}, titles)
+	})
+}
+
+// TestSearchNewConcerts_Reschedule verifies that a published concert
+// re-scraped under a new date is staged for review tagged with the event it
+// moves, without touching the published event, while a multi-night run whose
+// earlier date is still listed is treated as a new show.
+func TestSearchNewConcerts_Reschedule(t *testing.T) {
+	t.Parallel()
+
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+	venue := "Zepp Haneda"
+	// The synctest bubble starts at 2000-01-01, so these dates are upcoming.
+	oldDate := time.Date(2000, 2, 10, 0, 0, 0, 0, time.UTC)
+	newDate := time.Date(2000, 3, 20, 0, 0, 0, 0, time.UTC)
+	stored := []*entity.Concert{{
+		Event:  entity.Event{ID: "event-1", ListedVenueName: &venue, LocalDate: oldDate},
+		Series: &entity.Series{Title: "Spring Live"},
+	}}
+
+	expectSearch := func(ctx context.Context, d *concertTestDeps, scraped []*entity.ScrapedConcert) {
+		d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+		d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+		d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+		d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+		d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(stored, nil).Once()
+		d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+		d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+		d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+		d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+	}
+
+	t.Run("moved date is staged for review as a reschedule", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			d := newConcertTestDeps(t)
+			scraped := []*entity.ScrapedConcert{
+				{Title: "Spring Live", ListedVenueName: venue, LocalDate: newDate},
+				{Title: "Summer Live", ListedVenueName: "Zepp Osaka Bayside", LocalDate: newDate},
+			}
+			expectSearch(ctx, d, scraped)
+			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
+
+			sub, err := d.publisher.Subscribe(ctx, entity.SubjectConcertDiscovered)
+			require.NoError(t, err)
+
+			_, err = d.uc.SearchNewConcerts(ctx, artistID)
+			require.NoError(t, err)
+
+			var data entity.ConcertDiscoveredData
+			select {
+			case msg := <-sub:
+				msg.Ack()
+				require.NoError(t, messaging.ParseCloudEventData(msg, &data))
+			case <-time.After(200 * time.Millisecond):
+				t.Fatal("timed out waiting for concert.discovered event")
+			}
+
+			reschedules := make(map[string]string, len(data.Concerts))
+			for _, c := range data.Concerts {
+				reschedules[c.Title] = c.ReschedulesEventID
+			}
+			assert.Equal(t, map[string]string{"Spring Live": "event-1", "Summer Live": ""}, reschedules,
+				"the moved concert is staged tagged with its event; the new one untagged")
+			d.concertRepo.AssertNotCalled(t, "Reschedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("old date still listed is a multi-night run, not a reschedule", func(t *testing.T) {
+		t.Parallel()
+		synctest.Test(t, func(t *testing.T) {
+			ctx := context.Background()
+			d := newConcertTestDeps(t)
+			scraped := []*entity.ScrapedConcert{
+				{Title: "Spring Live", ListedVenueName: venue, LocalDate: oldDate},
+				{Title: "Spring Live", ListedVenueName: venue, LocalDate: newDate},
+			}
+			expectSearch(ctx, d, scraped)
+			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
+
+			got, err := d.uc.SearchNewConcerts(ctx, artistID)
+			require.NoError(t, err)
+
+			require.Len(t, got, 1)
+			assert.True(t, newDate.Equal(got[0].LocalDate))
+			d.concertRepo.AssertNotCalled(t, "Reschedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+}
+
 // TestSearchNewConcerts_DiscoveryWindow verifies the recent-discovery skip gate:
 // a stale search is still skipped when a new concert was found within the
 // discovery window, but proceeds when last_found_at is unset (null) or beyond
@@ -590,10 +1537,11 @@ func TestSearchNewConcerts_DiscoveryWindow(t *testing.T) {
 
 			d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 			d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-			d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+			d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 			d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+			d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-			d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
 
@@ -626,10 +1574,11 @@ func TestSearchNewConcerts_DiscoveryWindow(t *testing.T) {
 
 			d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 			d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-			d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+			d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 			d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+			d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-			d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(scraped, nil).Once()
 			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
 
@@ -802,10 +1751,11 @@ func TestSearchNewConcerts_Deduplication(t *testing.T) {
 				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-				d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(tt.existing, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
-				d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(tt.scraped, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).Return(tt.scraped, nil).Once()
 				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 				// A published discovery records last_found_at; no publish → no MarkFound.
 				if tt.wantNewConcerts > 0 {
@@ -916,12 +1866,13 @@ func TestSearchNewConcerts_StagedDedup(t *testing.T) {
 			d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 			d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 			d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-			d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+			d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 			d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
 			// Return the pending key — the "Already Staged" concert must be filtered out.
+			d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).
 				Return([]entity.StagedConcertDedupKey{pendingKey}, nil).Once()
-			d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
 				Return(scraped, nil).Once()
 			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
@@ -951,12 +1902,13 @@ func TestSearchNewConcerts_StagedDedup(t *testing.T) {
 			d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
 			d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
 			d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
-			d.artistRepo.EXPECT().GetOfficialSite(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+			d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
 			d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
 			// No pending keys → rejection log not in the picture, the concert re-enters.
+			d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).
 				Return(nil, nil).Once()
-			d.searcher.EXPECT().Search(mock.Anything, artist, (*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+			d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
 				Return(scraped, nil).Once()
 			d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
 			d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
@@ -969,3 +1921,84 @@ func TestSearchNewConcerts_StagedDedup(t *testing.T) {
 		})
 	})
 }
+
+// TestSearchNewConcerts_SourceURLDedup verifies that a scraped concert whose
+// normalized source URL, date, and venue match one of the artist's concerts is
+// not re-published, so a retried discovery run does not stage duplicates.
+func TestSearchNewConcerts_SourceURLDedup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	artistID := "artist-1"
+	artist := &entity.Artist{ID: artistID, Name: "Test Artist", MBID: "11111111-1111-1111-1111-111111111111"}
+	concertDate := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	const knownURL = "https://example.com/tour/2026"
+
+	tests := []struct {
+		name          string
+		sourceURL     string
+		wantLookup    string
+		wantPublished int
+	}{
+		{
+			name:          "exact match is skipped",
+			sourceURL:     knownURL,
+			wantLookup:    knownURL,
+			wantPublished: 0,
+		},
+		{
+			name:          "match after stripping trailing slash and fragment is skipped",
+			sourceURL:     knownURL + "/#tickets",
+			wantLookup:    knownURL,
+			wantPublished: 0,
+		},
+		{
+			name:          "match after stripping tracking query params is skipped",
+			sourceURL:     knownURL + "?utm_source=x&ref=y",
+			wantLookup:    knownURL,
+			wantPublished: 0,
+		},
+		{
+			name:          "different path is not skipped",
+			sourceURL:     "https://example.com/tour/2026-encore",
+			wantLookup:    "https://example.com/tour/2026-encore",
+			wantPublished: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			synctest.Test(t, func(t *testing.T) {
+				d := newConcertTestDeps(t)
+
+				scraped := []*entity.ScrapedConcert{
+					{Title: "Tour Final", ListedVenueName: "Zepp Tokyo", LocalDate: concertDate, SourceURL: tt.sourceURL},
+				}
+
+				sub, err := d.publisher.Subscribe(ctx, entity.SubjectConcertDiscovered)
+				require.NoError(t, err)
+
+				d.searchLogRepo.EXPECT().GetByArtistID(ctx, artistID).Return(nil, apperr.ErrNotFound).Once()
+				d.searchLogRepo.EXPECT().Upsert(ctx, artistID, entity.SearchLogStatusPending).Return(nil).Once()
+				d.artistRepo.EXPECT().Get(ctx, artistID).Return(artist, nil).Once()
+				d.artistRepo.EXPECT().ListOfficialSites(ctx, artistID).Return(nil, nil).Once()
+				d.concertRepo.EXPECT().ListByArtist(ctx, artistID, true).Return(nil, nil).Once()
+				d.stagedConcertRepo.EXPECT().ListPendingDedupKeysByArtist(mock.Anything, artistID).Return(nil, nil).Once()
+				d.searcher.EXPECT().Search(mock.Anything, artist, ([]*entity.OfficialSite)(nil), mock.AnythingOfType("time.Time")).
+					Return(scraped, nil).Once()
+				d.concertRepo.EXPECT().ExistsBySource(mock.Anything, artistID, tt.wantLookup, concertDate, "Zepp Tokyo").
+					Return(tt.wantLookup == knownURL, nil).Once()
+				d.searchLogRepo.EXPECT().UpdateStatus(mock.Anything, artistID, entity.SearchLogStatusCompleted).Return(nil).Once()
+				if tt.wantPublished > 0 {
+					d.searchLogRepo.EXPECT().MarkFound(mock.Anything, artistID).Return(nil).Once()
+				}
+
+				_, err = d.uc.SearchNewConcerts(ctx, artistID)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.wantPublished, receivePublishedConcerts(t, ctx, sub))
+			})
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/pannpers/go-apperr/apperr"
@@ -14,10 +16,16 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-// DefaultTreeDepth is the default Merkle tree depth for event entry.
-// Must match the circom TicketCheck circuit depth (currently 20).
-// Supports up to 2^20 (~1M) ticket holders per event.
-const DefaultTreeDepth = 20
+// DefaultTreeDepth is the Merkle tree depth of the circom TicketCheck circuit
+// (currently 20), which the events.merkle_tree_depth column default mirrors
+// for new events. Supports up to 2^20 (~1M) ticket holders per event.
+// VerifyEntry refuses events whose recorded depth differs, since their proofs
+// cannot verify against this circuit.
+const DefaultTreeDepth = entity.DefaultMerkleTreeDepth
+
+// entryPassClockSkew is the tolerance applied to an entry pass's validity
+// window, since the window is stamped by the holder's device clock.
+const entryPassClockSkew = 30 * time.Second
 
 // EntryUseCase defines the interface for entry verification business logic.
 type EntryUseCase interface {
@@ -31,6 +39,61 @@ type EntryUseCase interface {
 	// BuildMerkleTree builds (or rebuilds) the Merkle tree for an event
 	// from all ticket holders' identity commitments.
 	BuildMerkleTree(ctx context.Context, eventID string) error
+
+	// CheckMerkleConsistency recomputes the event's Merkle tree from its
+	// stored leaves and compares the result with the stored internal nodes
+	// and the event's recorded root. Drift in either makes entry proofs fail:
+	// a wrong internal node corrupts the paths handed to holders, a wrong
+	// root rejects every valid proof.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - NotFound: the event does not exist.
+	//   - FailedPrecondition: the event's tree has not been built.
+	//   - Internal: the tree or root could not be read or rebuilt.
+	CheckMerkleConsistency(ctx context.Context, eventID string) (*MerkleConsistencyReport, error)
+
+	// GetAttendanceCount returns how many attendees have checked in to an
+	// event, counted from recorded nullifiers. An event without check-ins
+	// yields 0.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: the count could not be read.
+	GetAttendanceCount(ctx context.Context, eventID string) (int, error)
+
+	// ExportCheckIns returns every check-in recorded for an event, as the
+	// nullifier hashes in check-in order together with their count, for
+	// post-event reconciliation of who entered.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: the check-ins could not be read.
+	ExportCheckIns(ctx context.Context, eventID string) (*CheckInExport, error)
+
+	// GetCheckInStatus returns an event's live check-in count against its
+	// capacity for the gate dashboard, and records both as metrics. The
+	// capacity is the number of tickets issued for the event, the holders
+	// who can enter.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID is empty.
+	//   - Internal: the check-ins or tickets could not be counted.
+	GetCheckInStatus(ctx context.Context, eventID string) (*CheckInStatus, error)
+
+	// RevokeCheckIn removes a recorded nullifier, undoing a check-in made by
+	// scanning the wrong pass. The pass can then be verified again.
+	//
+	// # Possible errors
+	//
+	//   - InvalidArgument: eventID or nullifierHash is empty.
+	//   - NotFound: no check-in was recorded for this nullifier.
+	//   - Internal: the nullifier could not be removed.
+	RevokeCheckIn(ctx context.Context, eventID string, nullifierHash []byte) error
 }
 
 // VerifyEntryParams holds the inputs for entry verification.
@@ -38,6 +101,11 @@ type VerifyEntryParams struct {
 	EventID           string
 	ProofJSON         string
 	PublicSignalsJSON string
+	// NotBefore and ExpiresAt bound the validity window of the entry pass
+	// (the QR payload's issue time and `exp`). A zero value leaves that side
+	// of the window unchecked.
+	NotBefore time.Time
+	ExpiresAt time.Time
 }
 
 // VerifyEntryResult holds the result of entry verification.
@@ -54,6 +122,58 @@ type MerklePathResult struct {
 	Leaf         []byte
 }
 
+// CheckInExport is the set of check-ins recorded for an event.
+type CheckInExport struct {
+	EventID string
+	// CheckInCount is the number of attendees checked in.
+	CheckInCount int
+	// Nullifiers are the recorded nullifier hashes, oldest check-in first.
+	Nullifiers [][]byte
+}
+
+// CheckInStatus is an event's live check-in count against its capacity.
+type CheckInStatus struct {
+	EventID string
+	// CheckedIn is the number of attendees checked in so far.
+	CheckedIn int
+	// Capacity is the number of tickets issued for the event.
+	Capacity int
+	// Remaining is how many ticket holders have yet to check in; never
+	// negative.
+	Remaining int
+}
+
+// maxReportedNodeDrift caps the drifted node positions listed in a
+// MerkleConsistencyReport; DriftedNodeCount still counts all of them.
+const maxReportedNodeDrift = 20
+
+// MerkleConsistencyReport is the outcome of a Merkle tree consistency check.
+type MerkleConsistencyReport struct {
+	EventID string `json:"event_id"`
+	Depth   int    `json:"depth"`
+	// StoredRoot is the root recorded on the event; nil when none is set.
+	StoredRoot []byte `json:"stored_root"`
+	// ComputedRoot is the root recomputed from the stored leaves.
+	ComputedRoot []byte `json:"computed_root"`
+	// DriftedNodeCount is the number of internal nodes, root node included,
+	// whose stored hash differs from the recomputed one or is missing.
+	DriftedNodeCount int `json:"drifted_node_count"`
+	// DriftedNodes lists the first drifted node positions with their
+	// recomputed hashes, in depth then index order.
+	DriftedNodes []*entity.MerkleNode `json:"drifted_nodes,omitempty"`
+}
+
+// RootDrifted reports whether the event's recorded root differs from the
+// root of its stored leaves.
+func (r *MerkleConsistencyReport) RootDrifted() bool {
+	return !bytes.Equal(r.StoredRoot, r.ComputedRoot)
+}
+
+// Consistent reports whether neither the root nor any stored node drifted.
+func (r *MerkleConsistencyReport) Consistent() bool {
+	return !r.RootDrifted() && r.DriftedNodeCount == 0
+}
+
 // entryUseCase implements the EntryUseCase interface.
 type entryUseCase struct {
 	verifier      entity.ZKPVerifier
@@ -62,8 +182,14 @@ type entryUseCase struct {
 	merkleBuilder entity.MerkleTreeBuilder
 	eventRepo     entity.EventRepository
 	ticketRepo    entity.TicketRepository
+	audits        entity.EntryVerificationAuditRepository
 	publisher     EventPublisher
-	logger        *logging.Logger
+	metrics       EntryMetrics
+	// signalOpts are applied when parsing public signals.
+	signalOpts []entity.ZKPSignalsOption
+	logger     *logging.Logger
+	// now returns the current time; replaced in tests.
+	now func() time.Time
 }
 
 // Compile-time interface compliance check.
@@ -77,9 +203,17 @@ func NewEntryUseCase(
 	merkleBuilder entity.MerkleTreeBuilder,
 	eventRepo entity.EventRepository,
 	ticketRepo entity.TicketRepository,
+	audits entity.EntryVerificationAuditRepository,
 	publisher EventPublisher,
+	metrics EntryMetrics,
+	signalLayout entity.ZKPSignalLayout,
+	strictFieldValidation bool,
 	logger *logging.Logger,
 ) EntryUseCase {
+	signalOpts := []entity.ZKPSignalsOption{entity.WithSignalLayout(signalLayout)}
+	if strictFieldValidation {
+		signalOpts = append(signalOpts, entity.WithStrictFieldValidation())
+	}
 	return &entryUseCase{
 		verifier:      verifier,
 		nullifiers:    nullifiers,
@@ -87,20 +221,36 @@ func NewEntryUseCase(
 		merkleBuilder: merkleBuilder,
 		eventRepo:     eventRepo,
 		ticketRepo:    ticketRepo,
+		audits:        audits,
 		publisher:     publisher,
+		metrics:       metrics,
+		signalOpts:    signalOpts,
 		logger:        logger,
+		now:           time.Now,
 	}
 }
 
-// VerifyEntry verifies a ZKP and records the nullifier on success.
+// VerifyEntry verifies a ZKP and records the nullifier on success. An entry
+// pass outside its validity window is rejected before any lookup or proof
+// verification. Every verified or rejected attempt is recorded in the entry
+// verification audit, and every check-in refreshes the check-in metrics.
 func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryParams) (*VerifyEntryResult, error) {
 	// Parse public signals once and extract all fields.
-	// Public signals order: [merkleRoot, eventId, nullifierHash]
-	signals, err := entity.ParseZKPPublicSignals(params.PublicSignalsJSON)
+	// Signal positions follow the configured circuit's layout.
+	signals, err := entity.ParseZKPPublicSignals(params.PublicSignalsJSON, uc.signalOpts...)
 	if err != nil {
+		// No nullifier can be read from unparseable signals.
+		uc.audit(ctx, params.EventID, nil, entity.EntryRejectionMalformedSignals)
 		return nil, apperr.Wrap(err, codes.InvalidArgument, "failed to parse public signals")
 	}
 
+	// Reject a malformed proof before any lookup; the verifier would only
+	// fail on it after the Merkle root and tree checks.
+	if err := entity.ValidateGroth16ProofJSON(params.ProofJSON); err != nil {
+		uc.audit(ctx, params.EventID, signals.NullifierHash, entity.EntryRejectionMalformedProof)
+		return nil, apperr.Wrap(err, codes.InvalidArgument, "malformed proof")
+	}
+
 	// Verify that the eventId in the proof matches the request's EventID.
 	// This prevents an attacker from submitting a proof generated for a
 	// different event, which would produce a different nullifier and bypass
@@ -112,12 +262,41 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 		slog.Bool("match", eventIDErr == nil),
 	)
 	if eventIDErr != nil {
+		uc.audit(ctx, params.EventID, signals.NullifierHash, entity.EntryRejectionEventIDMismatch)
 		return nil, apperr.Wrap(eventIDErr, codes.InvalidArgument, "event ID mismatch in public signals")
 	}
 
 	nullifierHash := signals.NullifierHash
 	merkleRoot := signals.MerkleRoot
 
+	// A photographed QR code stays replayable until its holder checks in;
+	// the validity window bounds how long that is.
+	now := uc.now()
+	if !params.ExpiresAt.IsZero() && now.After(params.ExpiresAt.Add(entryPassClockSkew)) {
+		uc.logger.Info(ctx, "entry verification step",
+			slog.String("step", "expiry"),
+			slog.String("eventID", params.EventID),
+			slog.Time("expiresAt", params.ExpiresAt),
+		)
+		uc.reject(ctx, params.EventID, nullifierHash, entity.EntryRejectionPassExpired)
+		return &VerifyEntryResult{
+			Verified: false,
+			Message:  "entry pass expired",
+		}, nil
+	}
+	if !params.NotBefore.IsZero() && now.Before(params.NotBefore.Add(-entryPassClockSkew)) {
+		uc.logger.Info(ctx, "entry verification step",
+			slog.String("step", "notBefore"),
+			slog.String("eventID", params.EventID),
+			slog.Time("notBefore", params.NotBefore),
+		)
+		uc.reject(ctx, params.EventID, nullifierHash, entity.EntryRejectionPassNotYetValid)
+		return &VerifyEntryResult{
+			Verified: false,
+			Message:  "entry pass not yet valid",
+		}, nil
+	}
+
 	expectedRoot, err := uc.eventRepo.GetMerkleRoot(ctx, params.EventID)
 	if err != nil {
 		return nil, apperr.Wrap(err, codes.Internal, "failed to get expected merkle root")
@@ -130,7 +309,7 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 		slog.Bool("match", rootMatch),
 	)
 	if !rootMatch {
-		uc.publishRejected(ctx, params.EventID, nullifierHash, entity.EntryRejectionMerkleRootMismatch)
+		uc.reject(ctx, params.EventID, nullifierHash, entity.EntryRejectionMerkleRootMismatch)
 		return &VerifyEntryResult{
 			Verified: false,
 			Message:  "merkle root mismatch: proof does not match event membership set",
@@ -153,13 +332,27 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 			slog.String("eventID", params.EventID),
 			slog.String("nullifier", hex.EncodeToString(nullifierHash)),
 		)
-		uc.publishRejected(ctx, params.EventID, nullifierHash, entity.EntryRejectionAlreadyCheckedIn)
+		uc.reject(ctx, params.EventID, nullifierHash, entity.EntryRejectionAlreadyCheckedIn)
 		return &VerifyEntryResult{
 			Verified: false,
 			Message:  "already checked in for this event",
 		}, nil
 	}
 
+	// A tree of any other depth cannot produce a proof the circuit accepts;
+	// fail loudly rather than report every holder's proof as invalid.
+	depth, err := uc.eventRepo.GetTreeDepth(ctx, params.EventID)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to get merkle tree depth")
+	}
+	if depth != DefaultTreeDepth {
+		return nil, apperr.New(codes.FailedPrecondition, "event merkle tree depth does not match the entry circuit",
+			slog.String("event_id", params.EventID),
+			slog.Int("tree_depth", depth),
+			slog.Int("circuit_depth", DefaultTreeDepth),
+		)
+	}
+
 	// Verify the ZKP.
 	verified, err := uc.verifier.Verify(params.ProofJSON, params.PublicSignalsJSON)
 	if err != nil {
@@ -167,7 +360,7 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 	}
 
 	if !verified {
-		uc.publishRejected(ctx, params.EventID, nullifierHash, entity.EntryRejectionProofInvalid)
+		uc.reject(ctx, params.EventID, nullifierHash, entity.EntryRejectionProofInvalid)
 		return &VerifyEntryResult{
 			Verified: false,
 			Message:  "proof verification failed",
@@ -178,7 +371,7 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 	if err := uc.nullifiers.Insert(ctx, params.EventID, nullifierHash); err != nil {
 		if errors.Is(err, apperr.ErrAlreadyExists) {
 			// Concurrent verification succeeded first — treat as duplicate.
-			uc.publishRejected(ctx, params.EventID, nullifierHash, entity.EntryRejectionAlreadyCheckedIn)
+			uc.reject(ctx, params.EventID, nullifierHash, entity.EntryRejectionAlreadyCheckedIn)
 			return &VerifyEntryResult{
 				Verified: false,
 				Message:  "already checked in for this event",
@@ -191,6 +384,7 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 		slog.String("event_id", params.EventID),
 		slog.String("nullifier", hex.EncodeToString(nullifierHash)),
 	)
+	uc.audit(ctx, params.EventID, nullifierHash, "")
 
 	if err := uc.publisher.PublishEvent(ctx, entity.SubjectEntryZkProofVerified, entity.EntryZkProofVerifiedData{
 		NullifierHashHex: hex.EncodeToString(nullifierHash),
@@ -202,17 +396,27 @@ func (uc *entryUseCase) VerifyEntry(ctx context.Context, params *VerifyEntryPara
 		// Non-fatal: the nullifier insert already committed the verified state.
 	}
 
+	// Refresh the gate dashboard's check-in gauges. Non-fatal: the holder is
+	// already admitted.
+	if _, err := uc.GetCheckInStatus(ctx, params.EventID); err != nil {
+		uc.logger.Warn(ctx, "failed to refresh check-in status metrics",
+			slog.String("event_id", params.EventID),
+			slog.String("error", err.Error()),
+		)
+	}
+
 	return &VerifyEntryResult{
 		Verified: true,
 		Message:  "entry verified",
 	}, nil
 }
 
-// publishRejected fires the ENTRY.zk_proof_rejected analytics event.
-// Non-fatal helper: rejection-path callers MUST still return their
-// VerifyEntryResult; the analytics emission is observability and does
-// not block the user-facing response.
-func (uc *entryUseCase) publishRejected(ctx context.Context, eventID string, nullifierHash []byte, reason entity.EntryRejectionReason) {
+// reject audits a rejected attempt and fires the ENTRY.zk_proof_rejected
+// analytics event. Non-fatal helper: rejection-path callers MUST still
+// return their VerifyEntryResult; neither the audit nor the analytics
+// emission blocks the user-facing response.
+func (uc *entryUseCase) reject(ctx context.Context, eventID string, nullifierHash []byte, reason entity.EntryRejectionReason) {
+	uc.audit(ctx, eventID, nullifierHash, reason)
 	if err := uc.publisher.PublishEvent(ctx, entity.SubjectEntryZkProofRejected, entity.EntryZkProofRejectedData{
 		NullifierHashHex: hex.EncodeToString(nullifierHash),
 		EventID:          eventID,
@@ -225,11 +429,28 @@ func (uc *entryUseCase) publishRejected(ctx context.Context, eventID string, nul
 	}
 }
 
+// audit appends the attempt's outcome to the entry verification audit; an
+// empty reason records a verified entry. Non-fatal: the outcome already
+// stands, so a failed write is logged rather than failing the gate.
+func (uc *entryUseCase) audit(ctx context.Context, eventID string, nullifierHash []byte, reason entity.EntryRejectionReason) {
+	record := entity.NewEntryVerificationAudit(eventID, nullifierHash, reason, uc.now())
+	if err := uc.audits.Append(ctx, record); err != nil {
+		uc.logger.Error(ctx, "failed to audit entry verification", err,
+			slog.String("event_id", eventID),
+			slog.String("outcome", string(record.Outcome)),
+			slog.String("nullifier", hex.EncodeToString(nullifierHash)),
+		)
+	}
+}
+
 // GetMerklePath returns the Merkle path for a user at an event.
 func (uc *entryUseCase) GetMerklePath(ctx context.Context, eventID, userID string) (*MerklePathResult, error) {
-	// Get the user's leaf index from their ticket position.
+	// Get the leaf index persisted on the user's ticket.
 	leafIndex, err := uc.eventRepo.GetTicketLeafIndex(ctx, eventID, userID)
 	if err != nil {
+		if errors.Is(err, apperr.ErrNotFound) || errors.Is(err, apperr.ErrFailedPrecondition) {
+			return nil, err
+		}
 		return nil, apperr.Wrap(err, codes.Internal, "failed to get ticket leaf index")
 	}
 
@@ -243,8 +464,13 @@ func (uc *entryUseCase) GetMerklePath(ctx context.Context, eventID, userID strin
 		return nil, apperr.Wrap(err, codes.Internal, "failed to get merkle root")
 	}
 
+	depth, err := uc.eventRepo.GetTreeDepth(ctx, eventID)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to get merkle tree depth")
+	}
+
 	// Get the Merkle path.
-	pathElements, pathIndices, err := uc.merkleTree.GetPath(ctx, eventID, leafIndex, DefaultTreeDepth)
+	pathElements, pathIndices, err := uc.merkleTree.GetPath(ctx, eventID, leafIndex, depth)
 	if err != nil {
 		return nil, apperr.Wrap(err, codes.Internal, "failed to get merkle path")
 	}
@@ -263,47 +489,251 @@ func (uc *entryUseCase) GetMerklePath(ctx context.Context, eventID, userID strin
 	}, nil
 }
 
+// GetAttendanceCount returns the number of checked-in attendees for an event.
+func (uc *entryUseCase) GetAttendanceCount(ctx context.Context, eventID string) (int, error) {
+	if eventID == "" {
+		return 0, apperr.New(codes.InvalidArgument, "event ID is required")
+	}
+
+	count, err := uc.nullifiers.CountByEvent(ctx, eventID)
+	if err != nil {
+		return 0, apperr.Wrap(err, codes.Internal, "failed to count checked-in attendees",
+			slog.String("event_id", eventID),
+		)
+	}
+	return count, nil
+}
+
+// ExportCheckIns lists the nullifiers recorded for an event.
+func (uc *entryUseCase) ExportCheckIns(ctx context.Context, eventID string) (*CheckInExport, error) {
+	if eventID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "event ID is required")
+	}
+
+	nullifiers, err := uc.nullifiers.ListByEvent(ctx, eventID)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to list check-ins",
+			slog.String("event_id", eventID),
+		)
+	}
+	return &CheckInExport{
+		EventID:      eventID,
+		CheckInCount: len(nullifiers),
+		Nullifiers:   nullifiers,
+	}, nil
+}
+
+// GetCheckInStatus counts an event's check-ins and issued tickets.
+func (uc *entryUseCase) GetCheckInStatus(ctx context.Context, eventID string) (*CheckInStatus, error) {
+	if eventID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "event ID is required")
+	}
+
+	checkedIn, err := uc.nullifiers.CountByEvent(ctx, eventID)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to count checked-in attendees",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	capacity, err := uc.ticketRepo.CountByEvent(ctx, eventID)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to count issued tickets",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	uc.metrics.RecordCheckInStatus(ctx, checkedIn, capacity)
+
+	return &CheckInStatus{
+		EventID:   eventID,
+		CheckedIn: checkedIn,
+		Capacity:  capacity,
+		Remaining: max(capacity-checkedIn, 0),
+	}, nil
+}
+
+// RevokeCheckIn deletes a recorded nullifier so its pass verifies again.
+// Every revoke is logged at Warn level as the audit trail for the reversal.
+func (uc *entryUseCase) RevokeCheckIn(ctx context.Context, eventID string, nullifierHash []byte) error {
+	if eventID == "" {
+		return apperr.New(codes.InvalidArgument, "event ID is required")
+	}
+	if len(nullifierHash) == 0 {
+		return apperr.New(codes.InvalidArgument, "nullifier hash is required")
+	}
+
+	if err := uc.nullifiers.Delete(ctx, eventID, nullifierHash); err != nil {
+		if errors.Is(err, apperr.ErrNotFound) {
+			return err
+		}
+		return apperr.Wrap(err, codes.Internal, "failed to revoke check-in",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	uc.logger.Warn(ctx, "check-in revoked",
+		slog.String("event_id", eventID),
+		slog.String("nullifier", hex.EncodeToString(nullifierHash)),
+	)
+	return nil
+}
+
 // BuildMerkleTree builds the Merkle tree for an event from ticket holders.
+// Each holder's commitment sits at the leaf index persisted on their ticket;
+// tickets minted since the last build are assigned the next free indices
+// first, so paths already handed out stay valid across rebuilds.
 func (uc *entryUseCase) BuildMerkleTree(ctx context.Context, eventID string) error {
+	if _, err := uc.ticketRepo.AssignLeafIndexes(ctx, eventID); err != nil {
+		return apperr.Wrap(err, codes.Internal, "failed to assign ticket leaf indexes")
+	}
+
 	// Get all tickets for the event to build identity commitments.
 	tickets, err := uc.ticketRepo.ListByEvent(ctx, eventID)
 	if err != nil {
 		return apperr.Wrap(err, codes.Internal, "failed to list tickets for event")
 	}
 
+	depth, err := uc.eventRepo.GetTreeDepth(ctx, eventID)
+	if err != nil {
+		return apperr.Wrap(err, codes.Internal, "failed to get merkle tree depth")
+	}
+
 	// Compute identity commitments and build the tree — CPU-intensive crypto work.
 	ctx, span := otel.Tracer("usecase/entry").Start(ctx, "BuildMerkleTree")
 	defer span.End()
-	span.SetAttributes(attribute.Int("merkle.leaf_count", len(tickets)))
+	span.SetAttributes(
+		attribute.Int("merkle.leaf_count", len(tickets)),
+		attribute.Int("merkle.depth", depth),
+	)
 
-	leaves := make([][]byte, len(tickets))
-	for i, ticket := range tickets {
+	// Leaves run up to the highest assigned index; the slots of deleted
+	// tickets stay zero, as the builder pads unused leaves.
+	numLeaves := 0
+	for _, ticket := range tickets {
+		if ticket.LeafIndex == nil {
+			return apperr.New(codes.Internal, "ticket has no leaf index after assignment",
+				slog.String("ticket_id", ticket.ID),
+			)
+		}
+		numLeaves = max(numLeaves, *ticket.LeafIndex+1)
+	}
+	leaves := make([][]byte, numLeaves)
+	for i := range leaves {
+		leaves[i] = make([]byte, 32)
+	}
+	for _, ticket := range tickets {
 		commitment, err := uc.merkleBuilder.IdentityCommitment([]byte(ticket.UserID))
 		if err != nil {
 			return apperr.Wrap(err, codes.Internal, "failed to compute identity commitment",
 				slog.String("user_id", ticket.UserID),
 			)
 		}
-		leaves[i] = commitment
+		leaves[*ticket.LeafIndex] = commitment
 	}
 
 	// Build the Merkle tree.
-	nodes, root, err := uc.merkleBuilder.Build(eventID, leaves)
+	nodes, root, err := uc.merkleBuilder.Build(eventID, depth, leaves)
 	if err != nil {
 		return apperr.Wrap(err, codes.Internal, "failed to build merkle tree")
 	}
 
 	// Atomically store all nodes and update the Merkle root in a single
 	// transaction to prevent race conditions between concurrent builds.
-	if err := uc.merkleTree.StoreBatchWithRoot(ctx, eventID, nodes, root); err != nil {
+	version, err := uc.merkleTree.StoreBatchWithRoot(ctx, eventID, nodes, root)
+	if err != nil {
 		return apperr.Wrap(err, codes.Internal, "failed to store merkle tree and root")
 	}
+	span.SetAttributes(attribute.Int("merkle.root_version", version))
 
 	uc.logger.Info(ctx, "merkle tree built",
 		slog.String("event_id", eventID),
 		slog.Int("num_leaves", len(leaves)),
+		slog.Int("depth", depth),
 		slog.String("root", hex.EncodeToString(root)),
+		slog.Int("root_version", version),
 	)
 
 	return nil
 }
+
+// CheckMerkleConsistency rebuilds an event's tree from its stored leaves and
+// reports where the stored nodes and root disagree with the rebuild.
+func (uc *entryUseCase) CheckMerkleConsistency(ctx context.Context, eventID string) (*MerkleConsistencyReport, error) {
+	if eventID == "" {
+		return nil, apperr.New(codes.InvalidArgument, "event ID is required")
+	}
+
+	depth, err := uc.eventRepo.GetTreeDepth(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, apperr.ErrNotFound) {
+			return nil, err
+		}
+		return nil, apperr.Wrap(err, codes.Internal, "failed to get merkle tree depth")
+	}
+
+	var storedRoot []byte
+	root, err := uc.eventRepo.GetMerkleRoot(ctx, eventID)
+	switch {
+	case err == nil:
+		storedRoot = root
+	case !errors.Is(err, apperr.ErrNotFound):
+		return nil, apperr.Wrap(err, codes.Internal, "failed to get merkle root")
+	}
+
+	nodes, err := uc.merkleTree.ListNodes(ctx, eventID)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to list merkle nodes")
+	}
+	if len(nodes) == 0 {
+		return nil, apperr.New(codes.FailedPrecondition, "merkle tree has not been built for this event",
+			slog.String("event_id", eventID),
+		)
+	}
+
+	type position struct{ depth, index int }
+	stored := make(map[position][]byte, len(nodes))
+	var leaves [][]byte
+	for _, n := range nodes {
+		stored[position{n.Depth, n.NodeIndex}] = n.Hash
+		if n.Depth == 0 && n.NodeIndex < 1<<depth {
+			for len(leaves) <= n.NodeIndex {
+				leaves = append(leaves, make([]byte, 32))
+			}
+			leaves[n.NodeIndex] = n.Hash
+		}
+	}
+
+	rebuilt, computedRoot, err := uc.merkleBuilder.Build(eventID, depth, leaves)
+	if err != nil {
+		return nil, apperr.Wrap(err, codes.Internal, "failed to rebuild merkle tree from stored leaves")
+	}
+
+	report := &MerkleConsistencyReport{
+		EventID:      eventID,
+		Depth:        depth,
+		StoredRoot:   storedRoot,
+		ComputedRoot: computedRoot,
+	}
+	for _, n := range rebuilt {
+		if n.Depth == 0 {
+			continue
+		}
+		if bytes.Equal(stored[position{n.Depth, n.NodeIndex}], n.Hash) {
+			continue
+		}
+		report.DriftedNodeCount++
+		if len(report.DriftedNodes) < maxReportedNodeDrift {
+			report.DriftedNodes = append(report.DriftedNodes, n)
+		}
+	}
+
+	if !report.Consistent() {
+		uc.logger.Warn(ctx, "merkle tree drift detected",
+			slog.String("event_id", eventID),
+			slog.Bool("root_drifted", report.RootDrifted()),
+			slog.Int("drifted_nodes", report.DriftedNodeCount),
+		)
+	}
+	return report, nil
+}
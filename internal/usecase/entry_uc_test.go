@@ -1,17 +1,22 @@
 package usecase_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"math/big"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/entity/mocks"
+	inframerkle "github.com/liverty-music/backend/internal/infrastructure/merkle"
 	"github.com/liverty-music/backend/internal/usecase"
 	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
 	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,6 +25,10 @@ import (
 // testEventID is a consistent UUID used across tests.
 const testEventID = "550e8400-e29b-41d4-a716-446655440000"
 
+// testProofJSON has the shape of a snarkjs Groth16 proof; the stub verifiers
+// decide whether it verifies.
+const testProofJSON = `{"pi_a": ["1", "2", "1"], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "pi_c": ["7", "8", "1"], "protocol": "groth16"}`
+
 // --- Inline mocks for entry-specific interfaces ---
 
 type stubZKPVerifier struct {
@@ -36,10 +45,16 @@ type stubNullifierRepo struct {
 	existsErr    error
 	insertErr    error
 	inserted     [][]byte
+	countErr     error
+	listErr      error
+	deleteErr    error
 }
 
-func (s *stubNullifierRepo) Exists(_ context.Context, _ string, _ []byte) (bool, error) {
-	return s.existsResult, s.existsErr
+// Exists reports existsResult, or whether hash has been inserted and not
+// since deleted.
+func (s *stubNullifierRepo) Exists(_ context.Context, _ string, hash []byte) (bool, error) {
+	recorded := slices.ContainsFunc(s.inserted, func(h []byte) bool { return bytes.Equal(h, hash) })
+	return s.existsResult || recorded, s.existsErr
 }
 
 func (s *stubNullifierRepo) Insert(_ context.Context, _ string, hash []byte) error {
@@ -47,6 +62,40 @@ func (s *stubNullifierRepo) Insert(_ context.Context, _ string, hash []byte) err
 	return s.insertErr
 }
 
+func (s *stubNullifierRepo) ListByEvent(_ context.Context, _ string) ([][]byte, error) {
+	return s.inserted, s.listErr
+}
+
+func (s *stubNullifierRepo) Delete(_ context.Context, _ string, hash []byte) error {
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	i := slices.IndexFunc(s.inserted, func(h []byte) bool { return bytes.Equal(h, hash) })
+	if i < 0 {
+		return apperr.New(codes.NotFound, "nullifier not found")
+	}
+	s.inserted = slices.Delete(s.inserted, i, i+1)
+	return nil
+}
+
+func (s *stubNullifierRepo) CountByEvent(_ context.Context, _ string) (int, error) {
+	if s.countErr != nil {
+		return 0, s.countErr
+	}
+	return len(s.inserted), nil
+}
+
+// stubEntryAuditRepo records appended audits, failing with appendErr.
+type stubEntryAuditRepo struct {
+	appendErr error
+	appended  []*entity.EntryVerificationAudit
+}
+
+func (s *stubEntryAuditRepo) Append(_ context.Context, audit *entity.EntryVerificationAudit) error {
+	s.appended = append(s.appended, audit)
+	return s.appendErr
+}
+
 type stubMerkleTreeRepo struct {
 	storeBatchErr         error
 	storeBatchWithRootErr error
@@ -57,17 +106,24 @@ type stubMerkleTreeRepo struct {
 	rootErr               error
 	leaf                  []byte
 	leafErr               error
+	requestedDepth        int
+	nodes                 []*entity.MerkleNode
+	nodesErr              error
 }
 
 func (s *stubMerkleTreeRepo) StoreBatch(_ context.Context, _ string, _ []*entity.MerkleNode) error {
 	return s.storeBatchErr
 }
 
-func (s *stubMerkleTreeRepo) StoreBatchWithRoot(_ context.Context, _ string, _ []*entity.MerkleNode, _ []byte) error {
-	return s.storeBatchWithRootErr
+func (s *stubMerkleTreeRepo) StoreBatchWithRoot(_ context.Context, _ string, _ []*entity.MerkleNode, _ []byte) (int, error) {
+	if s.storeBatchWithRootErr != nil {
+		return 0, s.storeBatchWithRootErr
+	}
+	return 1, nil
 }
 
-func (s *stubMerkleTreeRepo) GetPath(_ context.Context, _ string, _ int, _ int) ([][]byte, []uint32, error) {
+func (s *stubMerkleTreeRepo) GetPath(_ context.Context, _ string, _ int, treeDepth int) ([][]byte, []uint32, error) {
+	s.requestedDepth = treeDepth
 	return s.pathElements, s.pathIndices, s.pathErr
 }
 
@@ -79,6 +135,14 @@ func (s *stubMerkleTreeRepo) GetLeaf(_ context.Context, _ string, _ int) ([]byte
 	return s.leaf, s.leafErr
 }
 
+func (s *stubMerkleTreeRepo) ListNodes(_ context.Context, _ string) ([]*entity.MerkleNode, error) {
+	return s.nodes, s.nodesErr
+}
+
+func (s *stubMerkleTreeRepo) GetRootHistory(_ context.Context, _ string) ([]*entity.MerkleRootVersion, error) {
+	return nil, nil
+}
+
 type stubEventRepo struct {
 	merkleRoot     []byte
 	merkleRootErr  error
@@ -86,21 +150,37 @@ type stubEventRepo struct {
 	leafIndex      int
 	leafIndexErr   error
 	updatedRootVal []byte
+	treeDepth      int // 0 means usecase.DefaultTreeDepth
+	treeDepthErr   error
 }
 
 func (s *stubEventRepo) GetMerkleRoot(_ context.Context, _ string) ([]byte, error) {
 	return s.merkleRoot, s.merkleRootErr
 }
 
-func (s *stubEventRepo) UpdateMerkleRoot(_ context.Context, _ string, root []byte) error {
+func (s *stubEventRepo) UpdateMerkleRoot(_ context.Context, _ string, root []byte) (int, error) {
 	s.updatedRootVal = root
-	return s.updateRootErr
+	if s.updateRootErr != nil {
+		return 0, s.updateRootErr
+	}
+	return 1, nil
 }
 
 func (s *stubEventRepo) GetTicketLeafIndex(_ context.Context, _, _ string) (int, error) {
 	return s.leafIndex, s.leafIndexErr
 }
 
+func (s *stubEventRepo) GetTreeDepth(_ context.Context, _ string) (int, error) {
+	if s.treeDepth == 0 {
+		return usecase.DefaultTreeDepth, s.treeDepthErr
+	}
+	return s.treeDepth, s.treeDepthErr
+}
+
+func (s *stubEventRepo) ListByVenueAndDateRange(_ context.Context, _ string, _, _ time.Time) ([]*entity.EventLineup, error) {
+	return nil, nil
+}
+
 // --- Helper to build public signals JSON ---
 
 func makePublicSignals(merkleRoot, nullifierHash *big.Int, eventUUID string) string {
@@ -126,6 +206,8 @@ func bigIntToBytes32(t *testing.T, n *big.Int) []byte {
 type stubMerkleBuilder struct {
 	identityCommitmentErr error
 	buildErr              error
+	builtDepth            int
+	builtLeaves           [][]byte
 }
 
 func (s *stubMerkleBuilder) IdentityCommitment(userID []byte) ([]byte, error) {
@@ -137,7 +219,9 @@ func (s *stubMerkleBuilder) IdentityCommitment(userID []byte) ([]byte, error) {
 	return buf, nil
 }
 
-func (s *stubMerkleBuilder) Build(_ string, leaves [][]byte) ([]*entity.MerkleNode, []byte, error) {
+func (s *stubMerkleBuilder) Build(_ string, depth int, leaves [][]byte) ([]*entity.MerkleNode, []byte, error) {
+	s.builtDepth = depth
+	s.builtLeaves = leaves
 	if s.buildErr != nil {
 		return nil, nil, s.buildErr
 	}
@@ -159,7 +243,10 @@ func newTestEntryUC(
 	ticketRepo entity.TicketRepository,
 ) usecase.EntryUseCase {
 	t.Helper()
-	return usecase.NewEntryUseCase(verifier, nullifiers, merkleTree, &stubMerkleBuilder{}, eventRepo, ticketRepo, newAcceptingPublisher(t), newTestLogger(t))
+	if ticketRepo == nil {
+		ticketRepo = stubTicketCounter{}
+	}
+	return usecase.NewEntryUseCase(verifier, nullifiers, merkleTree, &stubMerkleBuilder{}, eventRepo, ticketRepo, &stubEntryAuditRepo{}, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
 }
 
 func newTestEntryUCWithBuilder(
@@ -170,7 +257,33 @@ func newTestEntryUCWithBuilder(
 	ticketRepo entity.TicketRepository,
 ) usecase.EntryUseCase {
 	t.Helper()
-	return usecase.NewEntryUseCase(nil, nil, merkleTree, builder, eventRepo, ticketRepo, newAcceptingPublisher(t), newTestLogger(t))
+	return usecase.NewEntryUseCase(nil, nil, merkleTree, builder, eventRepo, ticketRepo, &stubEntryAuditRepo{}, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+}
+
+// stubTicketCounter answers the issued-ticket count read when a check-in
+// refreshes the check-in metrics; every other method panics.
+type stubTicketCounter struct {
+	entity.TicketRepository
+	count int
+	err   error
+}
+
+func (s stubTicketCounter) CountByEvent(context.Context, string) (int, error) {
+	return s.count, s.err
+}
+
+type noopEntryMetrics struct{}
+
+func (noopEntryMetrics) RecordCheckInStatus(context.Context, int, int) {}
+
+// recordingEntryMetrics captures the last recorded check-in status.
+type recordingEntryMetrics struct {
+	recorded            bool
+	checkedIn, capacity int
+}
+
+func (m *recordingEntryMetrics) RecordCheckInStatus(_ context.Context, checkedIn, capacity int) {
+	m.recorded, m.checkedIn, m.capacity = true, checkedIn, capacity
 }
 
 // newAcceptingPublisher returns a MockEventPublisher that accepts any
@@ -190,8 +303,6 @@ func newAcceptingPublisher(t *testing.T) *ucmocks.MockEventPublisher {
 func TestVerifyEntry_InvalidPublicSignals(t *testing.T) {
 	t.Parallel()
 
-	uc := newTestEntryUC(t, &stubZKPVerifier{}, &stubNullifierRepo{}, nil, &stubEventRepo{}, nil)
-
 	tests := []struct {
 		name          string
 		publicSignals string
@@ -205,17 +316,97 @@ func TestVerifyEntry_InvalidPublicSignals(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
+			audits := &stubEntryAuditRepo{}
+			uc := usecase.NewEntryUseCase(&stubZKPVerifier{}, &stubNullifierRepo{}, nil, &stubMerkleBuilder{},
+				&stubEventRepo{}, nil, audits, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+
 			result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 				EventID:           "event-1",
-				ProofJSON:         `{"proof": true}`,
+				ProofJSON:         testProofJSON,
 				PublicSignalsJSON: tc.publicSignals,
 			})
 			assert.Nil(t, result)
 			assert.Error(t, err)
+			require.Len(t, audits.appended, 1, "a forged pass is audited")
+			assert.Equal(t, entity.EntryVerificationRejected, audits.appended[0].Outcome)
+			assert.Equal(t, entity.EntryRejectionMalformedSignals, audits.appended[0].Reason)
+			assert.Nil(t, audits.appended[0].NullifierHash)
 		})
 	}
 }
 
+func TestVerifyEntry_StrictFieldValidation(t *testing.T) {
+	t.Parallel()
+
+	root := big.NewInt(42)
+	// The modulus itself fits in 32 bytes but is not a field element.
+	signals := makePublicSignals(root, entity.BN254ScalarField, testEventID)
+	params := &usecase.VerifyEntryParams{EventID: testEventID, ProofJSON: testProofJSON, PublicSignalsJSON: signals}
+
+	newUC := func(strict bool) usecase.EntryUseCase {
+		return usecase.NewEntryUseCase(&stubZKPVerifier{verified: false}, &stubNullifierRepo{}, nil, &stubMerkleBuilder{},
+			&stubEventRepo{merkleRoot: bigIntToBytes32(t, root)}, nil, &stubEntryAuditRepo{}, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, strict, newTestLogger(t))
+	}
+
+	t.Run("strict mode rejects the signals up front", func(t *testing.T) {
+		t.Parallel()
+		result, err := newUC(true).VerifyEntry(context.Background(), params)
+		assert.Nil(t, result)
+		require.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Contains(t, err.Error(), "not a BN254 field element")
+	})
+
+	t.Run("lenient mode leaves them to proof verification", func(t *testing.T) {
+		t.Parallel()
+		result, err := newUC(false).VerifyEntry(context.Background(), params)
+		require.NoError(t, err)
+		assert.False(t, result.Verified)
+	})
+}
+
+func TestVerifyEntry_MalformedProof(t *testing.T) {
+	t.Parallel()
+
+	root := big.NewInt(42)
+	signals := makePublicSignals(root, big.NewInt(7), testEventID)
+
+	// The verifier would reject the proof too, but only after the lookups.
+	newUC := func(audits *stubEntryAuditRepo) usecase.EntryUseCase {
+		return usecase.NewEntryUseCase(&stubZKPVerifier{verified: false}, &stubNullifierRepo{}, nil, &stubMerkleBuilder{},
+			&stubEventRepo{merkleRoot: bigIntToBytes32(t, root)}, nil, audits, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+	}
+
+	t.Run("valid-shaped proof reaches the verifier", func(t *testing.T) {
+		t.Parallel()
+		audits := &stubEntryAuditRepo{}
+		result, err := newUC(audits).VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
+			EventID:           testEventID,
+			ProofJSON:         testProofJSON,
+			PublicSignalsJSON: signals,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Verified)
+		require.Len(t, audits.appended, 1)
+		assert.Equal(t, entity.EntryRejectionProofInvalid, audits.appended[0].Reason)
+	})
+
+	t.Run("proof missing pi_c is rejected up front", func(t *testing.T) {
+		t.Parallel()
+		audits := &stubEntryAuditRepo{}
+		result, err := newUC(audits).VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
+			EventID:           testEventID,
+			ProofJSON:         `{"pi_a": ["1", "2", "1"], "pi_b": [["3", "4"], ["5", "6"], ["1", "0"]], "protocol": "groth16"}`,
+			PublicSignalsJSON: signals,
+		})
+		assert.Nil(t, result)
+		require.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		assert.Contains(t, err.Error(), "proof is missing pi_c")
+		require.Len(t, audits.appended, 1, "a malformed proof is audited")
+		assert.Equal(t, entity.EntryRejectionMalformedProof, audits.appended[0].Reason)
+		assert.Equal(t, bigIntToBytes32(t, big.NewInt(7)), audits.appended[0].NullifierHash)
+	})
+}
+
 func TestVerifyEntry_MerkleRootMismatch(t *testing.T) {
 	t.Parallel()
 
@@ -236,7 +427,7 @@ func TestVerifyEntry_MerkleRootMismatch(t *testing.T) {
 	signals := makePublicSignals(proofRoot, big.NewInt(1), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -266,7 +457,7 @@ func TestVerifyEntry_DuplicateNullifier(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -289,7 +480,7 @@ func TestVerifyEntry_ProofFails(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -312,7 +503,7 @@ func TestVerifyEntry_Success(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -322,6 +513,40 @@ func TestVerifyEntry_Success(t *testing.T) {
 	assert.Len(t, nullifiers.inserted, 1, "nullifier should be recorded")
 }
 
+func TestVerifyEntry_RefreshesCheckInMetrics(t *testing.T) {
+	t.Parallel()
+
+	root := big.NewInt(42)
+	signals := makePublicSignals(root, big.NewInt(100), testEventID)
+	newUC := func(tickets stubTicketCounter, metrics *recordingEntryMetrics) usecase.EntryUseCase {
+		return usecase.NewEntryUseCase(&stubZKPVerifier{verified: true}, &stubNullifierRepo{inserted: [][]byte{{1}}}, nil, &stubMerkleBuilder{},
+			&stubEventRepo{merkleRoot: bigIntToBytes32(t, root)}, tickets, &stubEntryAuditRepo{}, newAcceptingPublisher(t), metrics, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+	}
+	params := &usecase.VerifyEntryParams{EventID: testEventID, ProofJSON: testProofJSON, PublicSignalsJSON: signals}
+
+	t.Run("check-in records the event's status", func(t *testing.T) {
+		t.Parallel()
+		metrics := &recordingEntryMetrics{}
+
+		result, err := newUC(stubTicketCounter{count: 10}, metrics).VerifyEntry(context.Background(), params)
+
+		require.NoError(t, err)
+		assert.True(t, result.Verified)
+		assert.Equal(t, &recordingEntryMetrics{recorded: true, checkedIn: 2, capacity: 10}, metrics)
+	})
+
+	t.Run("refresh failure does not undo the check-in", func(t *testing.T) {
+		t.Parallel()
+		metrics := &recordingEntryMetrics{}
+
+		result, err := newUC(stubTicketCounter{err: apperr.ErrUnavailable}, metrics).VerifyEntry(context.Background(), params)
+
+		require.NoError(t, err)
+		assert.True(t, result.Verified)
+		assert.False(t, metrics.recorded)
+	})
+}
+
 func TestVerifyEntry_ConcurrentNullifierInsert(t *testing.T) {
 	t.Parallel()
 
@@ -341,7 +566,7 @@ func TestVerifyEntry_ConcurrentNullifierInsert(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -350,6 +575,77 @@ func TestVerifyEntry_ConcurrentNullifierInsert(t *testing.T) {
 	assert.Contains(t, result.Message, "already checked in")
 }
 
+func TestVerifyEntry_Audit(t *testing.T) {
+	t.Parallel()
+
+	root := big.NewInt(42)
+	nullifierHash := bigIntToBytes32(t, big.NewInt(100))
+	signals := makePublicSignals(root, big.NewInt(100), testEventID)
+
+	tests := []struct {
+		name        string
+		nullifiers  *stubNullifierRepo
+		verified    bool
+		appendErr   error
+		wantOutcome entity.EntryVerificationOutcome
+		wantReason  entity.EntryRejectionReason
+	}{
+		{
+			name:        "success is audited as verified",
+			nullifiers:  &stubNullifierRepo{},
+			verified:    true,
+			wantOutcome: entity.EntryVerificationVerified,
+		},
+		{
+			name:        "duplicate is audited as already checked in",
+			nullifiers:  &stubNullifierRepo{existsResult: true},
+			verified:    true,
+			wantOutcome: entity.EntryVerificationRejected,
+			wantReason:  entity.EntryRejectionAlreadyCheckedIn,
+		},
+		{
+			name:        "failed proof is audited as proof invalid",
+			nullifiers:  &stubNullifierRepo{},
+			verified:    false,
+			wantOutcome: entity.EntryVerificationRejected,
+			wantReason:  entity.EntryRejectionProofInvalid,
+		},
+		{
+			name:        "audit write failure does not fail the gate",
+			nullifiers:  &stubNullifierRepo{},
+			verified:    true,
+			appendErr:   apperr.ErrInternal,
+			wantOutcome: entity.EntryVerificationVerified,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			audits := &stubEntryAuditRepo{appendErr: tc.appendErr}
+			uc := usecase.NewEntryUseCase(&stubZKPVerifier{verified: tc.verified}, tc.nullifiers, nil, &stubMerkleBuilder{},
+				&stubEventRepo{merkleRoot: bigIntToBytes32(t, root)}, stubTicketCounter{}, audits, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+
+			result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
+				EventID:           testEventID,
+				ProofJSON:         testProofJSON,
+				PublicSignalsJSON: signals,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOutcome == entity.EntryVerificationVerified, result.Verified)
+
+			require.Len(t, audits.appended, 1, "exactly one audit per attempt")
+			got := audits.appended[0]
+			assert.NotEmpty(t, got.ID)
+			assert.Equal(t, testEventID, got.EventID)
+			assert.Equal(t, nullifierHash, got.NullifierHash)
+			assert.Equal(t, tc.wantOutcome, got.Outcome)
+			assert.Equal(t, tc.wantReason, got.Reason)
+			assert.False(t, got.VerifyTime.IsZero())
+		})
+	}
+}
+
 // --- EventID mismatch test ---
 
 func TestVerifyEntry_EventIDMismatch(t *testing.T) {
@@ -358,14 +654,16 @@ func TestVerifyEntry_EventIDMismatch(t *testing.T) {
 	root := big.NewInt(42)
 	eventRepo := &stubEventRepo{merkleRoot: bigIntToBytes32(t, root)}
 
-	uc := newTestEntryUC(t, &stubZKPVerifier{verified: true}, &stubNullifierRepo{}, nil, eventRepo, nil)
+	audits := &stubEntryAuditRepo{}
+	uc := usecase.NewEntryUseCase(&stubZKPVerifier{verified: true}, &stubNullifierRepo{}, nil, &stubMerkleBuilder{},
+		eventRepo, nil, audits, newAcceptingPublisher(t), noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
 
 	// Build signals with a different event UUID than the request.
 	differentEventID := "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
 	signals := makePublicSignals(root, big.NewInt(100), differentEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -373,10 +671,297 @@ func TestVerifyEntry_EventIDMismatch(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
 	assert.Contains(t, err.Error(), "event ID mismatch")
+	require.Len(t, audits.appended, 1, "a replayed pass is audited")
+	assert.Equal(t, testEventID, audits.appended[0].EventID)
+	assert.Equal(t, entity.EntryRejectionEventIDMismatch, audits.appended[0].Reason)
+	assert.Equal(t, bigIntToBytes32(t, big.NewInt(100)), audits.appended[0].NullifierHash)
 }
 
 // --- GetMerklePath tests ---
 
+func TestVerifyEntry_ValidityWindow(t *testing.T) {
+	t.Parallel()
+
+	root := big.NewInt(42)
+	now := time.Date(2026, 10, 17, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		notBefore    time.Time
+		expiresAt    time.Time
+		wantVerified bool
+		wantMessage  string
+	}{
+		{
+			name:        "expired pass is rejected",
+			notBefore:   now.Add(-10 * time.Minute),
+			expiresAt:   now.Add(-time.Minute),
+			wantMessage: "entry pass expired",
+		},
+		{
+			name:        "pass issued in the future is rejected",
+			notBefore:   now.Add(time.Minute),
+			expiresAt:   now.Add(10 * time.Minute),
+			wantMessage: "entry pass not yet valid",
+		},
+		{
+			name:         "pass within its window is verified",
+			notBefore:    now.Add(-time.Minute),
+			expiresAt:    now.Add(time.Minute),
+			wantVerified: true,
+			wantMessage:  "entry verified",
+		},
+		{
+			name:         "expiry within the clock skew tolerance is verified",
+			expiresAt:    now.Add(-10 * time.Second),
+			wantVerified: true,
+			wantMessage:  "entry verified",
+		},
+		{
+			name:         "no window is verified",
+			wantVerified: true,
+			wantMessage:  "entry verified",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			nullifiers := &stubNullifierRepo{}
+			uc := newTestEntryUC(t,
+				&stubZKPVerifier{verified: true},
+				nullifiers,
+				nil,
+				&stubEventRepo{merkleRoot: bigIntToBytes32(t, root)},
+				nil,
+			)
+			usecase.SetEntryClock(uc, func() time.Time { return now })
+
+			result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
+				EventID:           testEventID,
+				ProofJSON:         testProofJSON,
+				PublicSignalsJSON: makePublicSignals(root, big.NewInt(100), testEventID),
+				NotBefore:         tc.notBefore,
+				ExpiresAt:         tc.expiresAt,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantVerified, result.Verified)
+			assert.Equal(t, tc.wantMessage, result.Message)
+			if !tc.wantVerified {
+				assert.Empty(t, nullifiers.inserted, "a rejected pass must not record its nullifier")
+			}
+		})
+	}
+}
+
+func TestGetAttendanceCount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("counts recorded nullifiers", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{inserted: [][]byte{{1}, {2}, {3}}}
+		uc := newTestEntryUC(t, nil, nullifiers, nil, nil, nil)
+
+		got, err := uc.GetAttendanceCount(ctx, testEventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, got)
+	})
+
+	t.Run("event without check-ins yields zero", func(t *testing.T) {
+		t.Parallel()
+		uc := newTestEntryUC(t, nil, &stubNullifierRepo{}, nil, nil, nil)
+
+		got, err := uc.GetAttendanceCount(ctx, testEventID)
+
+		require.NoError(t, err)
+		assert.Zero(t, got)
+	})
+
+	t.Run("repository failure is Internal", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{countErr: apperr.ErrUnavailable}
+		uc := newTestEntryUC(t, nil, nullifiers, nil, nil, nil)
+
+		_, err := uc.GetAttendanceCount(ctx, testEventID)
+
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+
+	t.Run("empty event ID is rejected", func(t *testing.T) {
+		t.Parallel()
+		uc := newTestEntryUC(t, nil, &stubNullifierRepo{}, nil, nil, nil)
+
+		_, err := uc.GetAttendanceCount(ctx, "")
+
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestExportCheckIns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("lists recorded nullifiers with their count", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{inserted: [][]byte{{1}, {2}, {3}}}
+		uc := newTestEntryUC(t, nil, nullifiers, nil, nil, nil)
+
+		got, err := uc.ExportCheckIns(ctx, testEventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, testEventID, got.EventID)
+		assert.Equal(t, 3, got.CheckInCount)
+		assert.Equal(t, [][]byte{{1}, {2}, {3}}, got.Nullifiers)
+	})
+
+	t.Run("repository failure is Internal", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{listErr: apperr.ErrUnavailable}
+		uc := newTestEntryUC(t, nil, nullifiers, nil, nil, nil)
+
+		_, err := uc.ExportCheckIns(ctx, testEventID)
+
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+
+	t.Run("empty event ID is rejected", func(t *testing.T) {
+		t.Parallel()
+		uc := newTestEntryUC(t, nil, &stubNullifierRepo{}, nil, nil, nil)
+
+		_, err := uc.ExportCheckIns(ctx, "")
+
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestGetCheckInStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("reports check-ins against issued tickets", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{inserted: [][]byte{{1}, {2}, {3}}}
+		ticketRepo := mocks.NewMockTicketRepository(t)
+		ticketRepo.EXPECT().CountByEvent(ctx, testEventID).Return(10, nil).Once()
+		metrics := &recordingEntryMetrics{}
+		uc := usecase.NewEntryUseCase(nil, nullifiers, nil, nil, nil, ticketRepo, nil, nil, metrics, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+
+		got, err := uc.GetCheckInStatus(ctx, testEventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecase.CheckInStatus{EventID: testEventID, CheckedIn: 3, Capacity: 10, Remaining: 7}, got)
+		assert.Equal(t, &recordingEntryMetrics{recorded: true, checkedIn: 3, capacity: 10}, metrics)
+	})
+
+	t.Run("remaining capacity never goes negative", func(t *testing.T) {
+		t.Parallel()
+		// A revoked ticket whose holder already checked in.
+		nullifiers := &stubNullifierRepo{inserted: [][]byte{{1}, {2}}}
+		ticketRepo := mocks.NewMockTicketRepository(t)
+		ticketRepo.EXPECT().CountByEvent(ctx, testEventID).Return(1, nil).Once()
+		uc := usecase.NewEntryUseCase(nil, nullifiers, nil, nil, nil, ticketRepo, nil, nil, noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+
+		got, err := uc.GetCheckInStatus(ctx, testEventID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.CheckedIn)
+		assert.Zero(t, got.Remaining)
+	})
+
+	t.Run("ticket count failure is Internal and records nothing", func(t *testing.T) {
+		t.Parallel()
+		ticketRepo := mocks.NewMockTicketRepository(t)
+		ticketRepo.EXPECT().CountByEvent(ctx, testEventID).Return(0, apperr.ErrUnavailable).Once()
+		metrics := &recordingEntryMetrics{}
+		uc := usecase.NewEntryUseCase(nil, &stubNullifierRepo{}, nil, nil, nil, ticketRepo, nil, nil, metrics, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+
+		_, err := uc.GetCheckInStatus(ctx, testEventID)
+
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+		assert.False(t, metrics.recorded)
+	})
+
+	t.Run("empty event ID is rejected", func(t *testing.T) {
+		t.Parallel()
+		uc := newTestEntryUC(t, nil, &stubNullifierRepo{}, nil, nil, nil)
+
+		_, err := uc.GetCheckInStatus(ctx, "")
+
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+func TestRevokeCheckIn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	root := big.NewInt(42)
+	signals := makePublicSignals(root, big.NewInt(100), testEventID)
+	params := &usecase.VerifyEntryParams{
+		EventID:           testEventID,
+		ProofJSON:         testProofJSON,
+		PublicSignalsJSON: signals,
+	}
+	nullifierHash := bigIntToBytes32(t, big.NewInt(100))
+
+	t.Run("revoked pass verifies again", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{}
+		uc := newTestEntryUC(t, &stubZKPVerifier{verified: true}, nullifiers, nil,
+			&stubEventRepo{merkleRoot: bigIntToBytes32(t, root)}, nil)
+
+		result, err := uc.VerifyEntry(ctx, params)
+		require.NoError(t, err)
+		require.True(t, result.Verified)
+
+		result, err = uc.VerifyEntry(ctx, params)
+		require.NoError(t, err)
+		require.False(t, result.Verified, "second scan is a duplicate before revoke")
+
+		require.NoError(t, uc.RevokeCheckIn(ctx, testEventID, nullifierHash))
+		assert.Empty(t, nullifiers.inserted, "revoke must remove the nullifier")
+
+		result, err = uc.VerifyEntry(ctx, params)
+		require.NoError(t, err)
+		assert.True(t, result.Verified, "revoked pass must verify again")
+		assert.Len(t, nullifiers.inserted, 1)
+	})
+
+	t.Run("nullifier never recorded is NotFound", func(t *testing.T) {
+		t.Parallel()
+		uc := newTestEntryUC(t, nil, &stubNullifierRepo{}, nil, nil, nil)
+
+		err := uc.RevokeCheckIn(ctx, testEventID, nullifierHash)
+
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("repository failure is Internal", func(t *testing.T) {
+		t.Parallel()
+		nullifiers := &stubNullifierRepo{deleteErr: apperr.ErrUnavailable}
+		uc := newTestEntryUC(t, nil, nullifiers, nil, nil, nil)
+
+		err := uc.RevokeCheckIn(ctx, testEventID, nullifierHash)
+
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+
+	t.Run("empty arguments are rejected", func(t *testing.T) {
+		t.Parallel()
+		uc := newTestEntryUC(t, nil, &stubNullifierRepo{}, nil, nil, nil)
+
+		assert.ErrorIs(t, uc.RevokeCheckIn(ctx, "", nullifierHash), apperr.ErrInvalidArgument)
+		assert.ErrorIs(t, uc.RevokeCheckIn(ctx, testEventID, nil), apperr.ErrInvalidArgument)
+	})
+}
+
 func TestGetMerklePath_NoTicket(t *testing.T) {
 	t.Parallel()
 
@@ -422,9 +1007,10 @@ func TestBuildMerkleTree_Success(t *testing.T) {
 	t.Parallel()
 
 	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
 	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{
-		{UserID: "user-1"},
-		{UserID: "user-2"},
+		{UserID: "user-1", LeafIndex: new(0)},
+		{UserID: "user-2", LeafIndex: new(1)},
 	}, nil)
 
 	merkleTreeRepo := &stubMerkleTreeRepo{}
@@ -441,8 +1027,9 @@ func TestBuildMerkleTree_StoreBatchWithRootError(t *testing.T) {
 	t.Parallel()
 
 	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
 	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{
-		{UserID: "user-1"},
+		{UserID: "user-1", LeafIndex: new(0)},
 	}, nil)
 
 	merkleTreeRepo := &stubMerkleTreeRepo{
@@ -469,7 +1056,7 @@ func TestVerifyEntry_GetMerkleRootError(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -489,7 +1076,7 @@ func TestVerifyEntry_NullifierExistsError(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -510,7 +1097,7 @@ func TestVerifyEntry_VerifierError(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -531,7 +1118,7 @@ func TestVerifyEntry_InsertNullifierError(t *testing.T) {
 	signals := makePublicSignals(root, big.NewInt(100), testEventID)
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -550,7 +1137,7 @@ func TestVerifyEntry_OversizedPublicSignal(t *testing.T) {
 
 	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
 		EventID:           testEventID,
-		ProofJSON:         `{}`,
+		ProofJSON:         testProofJSON,
 		PublicSignalsJSON: signals,
 	})
 
@@ -617,6 +1204,7 @@ func TestBuildMerkleTree_ListByEventError(t *testing.T) {
 	t.Parallel()
 
 	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
 	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return(nil, apperr.ErrInternal)
 
 	uc := newTestEntryUC(t, nil, nil, nil, nil, ticketRepo)
@@ -629,6 +1217,7 @@ func TestBuildMerkleTree_EmptyTickets(t *testing.T) {
 	t.Parallel()
 
 	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
 	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{}, nil)
 
 	merkleTreeRepo := &stubMerkleTreeRepo{}
@@ -644,8 +1233,9 @@ func TestBuildMerkleTree_IdentityCommitmentError(t *testing.T) {
 	t.Parallel()
 
 	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
 	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{
-		{UserID: "user-1"},
+		{UserID: "user-1", LeafIndex: new(0)},
 	}, nil)
 
 	builder := &stubMerkleBuilder{identityCommitmentErr: apperr.ErrInternal}
@@ -660,8 +1250,9 @@ func TestBuildMerkleTree_BuildError(t *testing.T) {
 	t.Parallel()
 
 	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
 	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{
-		{UserID: "user-1"},
+		{UserID: "user-1", LeafIndex: new(0)},
 	}, nil)
 
 	builder := &stubMerkleBuilder{buildErr: apperr.ErrInternal}
@@ -671,3 +1262,239 @@ func TestBuildMerkleTree_BuildError(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, apperr.ErrInternal)
 }
+
+func TestBuildMerkleTree_PlacesLeavesAtPersistedIndex(t *testing.T) {
+	t.Parallel()
+
+	// user-2 held leaf 1 and its ticket was deleted; user-3 minted since.
+	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(1, nil)
+	ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{
+		{UserID: "user-1", LeafIndex: new(0)},
+		{UserID: "user-3", LeafIndex: new(2)},
+	}, nil)
+
+	builder := &stubMerkleBuilder{}
+	uc := newTestEntryUCWithBuilder(t, builder, &stubMerkleTreeRepo{}, &stubEventRepo{}, ticketRepo)
+
+	require.NoError(t, uc.BuildMerkleTree(context.Background(), "event-1"))
+	require.Len(t, builder.builtLeaves, 3)
+	user1, _ := builder.IdentityCommitment([]byte("user-1"))
+	user3, _ := builder.IdentityCommitment([]byte("user-3"))
+	assert.Equal(t, user1, builder.builtLeaves[0])
+	assert.Equal(t, make([]byte, 32), builder.builtLeaves[1], "a deleted ticket's leaf stays zero")
+	assert.Equal(t, user3, builder.builtLeaves[2])
+}
+
+func TestBuildMerkleTree_AssignLeafIndexesError(t *testing.T) {
+	t.Parallel()
+
+	ticketRepo := &mocks.MockTicketRepository{}
+	ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, apperr.ErrUnavailable)
+
+	uc := newTestEntryUC(t, nil, nil, &stubMerkleTreeRepo{}, &stubEventRepo{}, ticketRepo)
+
+	err := uc.BuildMerkleTree(context.Background(), "event-1")
+	assert.ErrorIs(t, err, apperr.ErrInternal)
+	ticketRepo.AssertNotCalled(t, "ListByEvent", mock.Anything, mock.Anything)
+}
+
+func TestGetMerklePath_TreeNotRebuiltSinceMint(t *testing.T) {
+	t.Parallel()
+
+	eventRepo := &stubEventRepo{leafIndex: -1, leafIndexErr: apperr.New(codes.FailedPrecondition, "no leaf index")}
+	uc := newTestEntryUC(t, nil, nil, &stubMerkleTreeRepo{}, eventRepo, nil)
+
+	result, err := uc.GetMerklePath(context.Background(), "event-1", "user-1")
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+}
+
+// --- Merkle consistency check ---
+
+// builtTree builds a real depth-2 Poseidon tree over three leaves and returns
+// its nodes and root, as BuildMerkleTree would have stored them.
+func builtTree(t *testing.T) ([]*entity.MerkleNode, []byte) {
+	t.Helper()
+	builder := inframerkle.NewBuilder(inframerkle.MaxDepth)
+	var leaves [][]byte
+	for _, user := range []string{"user-1", "user-2", "user-3"} {
+		leaf, err := builder.IdentityCommitment([]byte(user))
+		require.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	nodes, root, err := builder.Build(testEventID, 2, leaves)
+	require.NoError(t, err)
+	return nodes, root
+}
+
+func newConsistencyCheckUC(t *testing.T, merkleTree *stubMerkleTreeRepo, eventRepo *stubEventRepo) usecase.EntryUseCase {
+	t.Helper()
+	return usecase.NewEntryUseCase(nil, nil, merkleTree, inframerkle.NewBuilder(inframerkle.MaxDepth), eventRepo, nil, nil, nil, noopEntryMetrics{}, entity.ZKPSignalLayoutV1, false, newTestLogger(t))
+}
+
+func TestCheckMerkleConsistency(t *testing.T) {
+	t.Parallel()
+
+	// corrupt returns a copy of nodes with the node at (depth, index) replaced.
+	corrupt := func(nodes []*entity.MerkleNode, depth, index int) []*entity.MerkleNode {
+		out := make([]*entity.MerkleNode, len(nodes))
+		for i, n := range nodes {
+			cp := *n
+			if n.Depth == depth && n.NodeIndex == index {
+				cp.Hash = bytes.Repeat([]byte{0xee}, 32)
+			}
+			out[i] = &cp
+		}
+		return out
+	}
+
+	t.Run("consistent tree reports no drift", func(t *testing.T) {
+		t.Parallel()
+		nodes, root := builtTree(t)
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{nodes: nodes}, &stubEventRepo{merkleRoot: root, treeDepth: 2})
+
+		report, err := uc.CheckMerkleConsistency(context.Background(), testEventID)
+		require.NoError(t, err)
+		assert.True(t, report.Consistent())
+		assert.Equal(t, root, report.ComputedRoot)
+		assert.Zero(t, report.DriftedNodeCount)
+	})
+
+	t.Run("corrupted internal node is reported", func(t *testing.T) {
+		t.Parallel()
+		nodes, root := builtTree(t)
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{nodes: corrupt(nodes, 1, 1)}, &stubEventRepo{merkleRoot: root, treeDepth: 2})
+
+		report, err := uc.CheckMerkleConsistency(context.Background(), testEventID)
+		require.NoError(t, err)
+		assert.False(t, report.Consistent())
+		assert.False(t, report.RootDrifted(), "the leaves still hash to the recorded root")
+		assert.Equal(t, 1, report.DriftedNodeCount)
+		require.Len(t, report.DriftedNodes, 1)
+		assert.Equal(t, 1, report.DriftedNodes[0].Depth)
+		assert.Equal(t, 1, report.DriftedNodes[0].NodeIndex)
+	})
+
+	t.Run("corrupted leaf drifts its path and the root", func(t *testing.T) {
+		t.Parallel()
+		nodes, root := builtTree(t)
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{nodes: corrupt(nodes, 0, 2)}, &stubEventRepo{merkleRoot: root, treeDepth: 2})
+
+		report, err := uc.CheckMerkleConsistency(context.Background(), testEventID)
+		require.NoError(t, err)
+		assert.True(t, report.RootDrifted())
+		assert.Equal(t, root, report.StoredRoot)
+		assert.Equal(t, 2, report.DriftedNodeCount, "parent and root node no longer match the leaves")
+	})
+
+	t.Run("missing event root is drift", func(t *testing.T) {
+		t.Parallel()
+		nodes, _ := builtTree(t)
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{nodes: nodes}, &stubEventRepo{merkleRootErr: apperr.ErrNotFound, treeDepth: 2})
+
+		report, err := uc.CheckMerkleConsistency(context.Background(), testEventID)
+		require.NoError(t, err)
+		assert.Nil(t, report.StoredRoot)
+		assert.True(t, report.RootDrifted())
+	})
+
+	t.Run("tree never built", func(t *testing.T) {
+		t.Parallel()
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{}, &stubEventRepo{treeDepth: 2})
+
+		_, err := uc.CheckMerkleConsistency(context.Background(), testEventID)
+		assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		t.Parallel()
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{}, &stubEventRepo{treeDepthErr: apperr.ErrNotFound})
+
+		_, err := uc.CheckMerkleConsistency(context.Background(), testEventID)
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty event ID", func(t *testing.T) {
+		t.Parallel()
+		uc := newConsistencyCheckUC(t, &stubMerkleTreeRepo{}, &stubEventRepo{})
+
+		_, err := uc.CheckMerkleConsistency(context.Background(), "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
+
+// --- Per-event tree depth ---
+
+func TestVerifyEntry_TreeDepthMismatch(t *testing.T) {
+	t.Parallel()
+
+	root := big.NewInt(42)
+	eventRepo := &stubEventRepo{merkleRoot: bigIntToBytes32(t, root), treeDepth: 10}
+	nullifiers := &stubNullifierRepo{}
+	uc := newTestEntryUC(t, &stubZKPVerifier{verified: true}, nullifiers, nil, eventRepo, nil)
+
+	signals := makePublicSignals(root, big.NewInt(100), testEventID)
+	result, err := uc.VerifyEntry(context.Background(), &usecase.VerifyEntryParams{
+		EventID:           testEventID,
+		ProofJSON:         testProofJSON,
+		PublicSignalsJSON: signals,
+	})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperr.ErrFailedPrecondition)
+	assert.Empty(t, nullifiers.inserted, "no nullifier is spent on an unverifiable event")
+}
+
+func TestEntryUseCase_UsesEventTreeDepth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		treeDepth int
+	}{
+		{name: "depth-20 event", treeDepth: 20},
+		{name: "legacy depth-10 event", treeDepth: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("GetMerklePath", func(t *testing.T) {
+				t.Parallel()
+				eventRepo := &stubEventRepo{leafIndex: 0, merkleRoot: []byte{1}, treeDepth: tt.treeDepth}
+				merkleTreeRepo := &stubMerkleTreeRepo{}
+				uc := newTestEntryUC(t, nil, nil, merkleTreeRepo, eventRepo, nil)
+
+				_, err := uc.GetMerklePath(context.Background(), "event-1", "user-1")
+				require.NoError(t, err)
+				assert.Equal(t, tt.treeDepth, merkleTreeRepo.requestedDepth)
+			})
+
+			t.Run("BuildMerkleTree", func(t *testing.T) {
+				t.Parallel()
+				ticketRepo := &mocks.MockTicketRepository{}
+				ticketRepo.On("AssignLeafIndexes", context.Background(), "event-1").Return(0, nil)
+				ticketRepo.On("ListByEvent", context.Background(), "event-1").Return([]*entity.Ticket{{UserID: "user-1", LeafIndex: new(0)}}, nil)
+				builder := &stubMerkleBuilder{}
+				eventRepo := &stubEventRepo{treeDepth: tt.treeDepth}
+				uc := newTestEntryUCWithBuilder(t, builder, &stubMerkleTreeRepo{}, eventRepo, ticketRepo)
+
+				require.NoError(t, uc.BuildMerkleTree(context.Background(), "event-1"))
+				assert.Equal(t, tt.treeDepth, builder.builtDepth)
+			})
+		})
+	}
+}
+
+func TestGetMerklePath_GetTreeDepthError(t *testing.T) {
+	t.Parallel()
+
+	eventRepo := &stubEventRepo{leafIndex: 0, merkleRoot: []byte{1}, treeDepthErr: apperr.ErrInternal}
+	uc := newTestEntryUC(t, nil, nil, &stubMerkleTreeRepo{}, eventRepo, nil)
+
+	result, err := uc.GetMerklePath(context.Background(), "event-1", "user-1")
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, apperr.ErrInternal)
+}
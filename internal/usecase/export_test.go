@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/liverty-music/backend/internal/entity"
 )
@@ -58,3 +59,21 @@ var ExportedChannelDisplayName = channelDisplayName
 
 // ReminderScanLookbackMargin exposes reminderScanLookbackMargin for black-box tests.
 const ReminderScanLookbackMargin = reminderScanLookbackMargin
+
+// SetEntryClock replaces the clock VerifyEntry checks entry pass validity
+// windows against.
+var SetEntryClock = func(uc EntryUseCase, now func() time.Time) {
+	uc.(*entryUseCase).now = now
+}
+
+// SetVenueEnrichmentRetryClock replaces the clock RetryFailed schedules
+// retries against.
+var SetVenueEnrichmentRetryClock = func(uc VenueEnrichmentRetryUseCase, now func() time.Time) {
+	uc.(*venueEnrichmentRetryUseCase).now = now
+}
+
+// SetConcertReminderClock replaces the clock ScanDueReminders evaluates each
+// user's local date against.
+var SetConcertReminderClock = func(uc ConcertReminderUseCase, now func() time.Time) {
+	uc.(*concertReminderUseCase).now = now
+}
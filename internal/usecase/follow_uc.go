@@ -21,6 +21,19 @@ type FollowUseCase interface {
 	//   - Internal: unexpected failure during relationship establishment.
 	Follow(ctx context.Context, userID string, artistID string) error
 
+	// FollowByMBID follows the artist identified by a MusicBrainz ID, first
+	// registering the artist from the identity provider when no artist with
+	// that MBID exists yet. It returns the persisted artist so the caller
+	// learns its database ID.
+	//
+	// # Possible errors:
+	//
+	//   - InvalidArgument: the MBID is empty.
+	//   - NotFound: the MBID does not correspond to a MusicBrainz artist.
+	//   - Unavailable: the identity provider is down.
+	//   - Internal: unexpected failure during registration or follow.
+	FollowByMBID(ctx context.Context, userID, mbid string) (*entity.Artist, error)
+
 	// Unfollow terminates a subscription between a user and an artist.
 	//
 	// # Possible errors:
@@ -44,13 +57,23 @@ type FollowUseCase interface {
 	ListFollowed(ctx context.Context, userID string) ([]*entity.FollowedArtist, error)
 }
 
+// FollowerFeedInvalidator discards a user's cached followed-concert feed.
+// The follow use case calls it after every follow change so the feed never
+// outlives the follow set it was built from.
+type FollowerFeedInvalidator interface {
+	// InvalidateFollowerFeed evicts the cached feed for the given user.
+	InvalidateFollowerFeed(userID string)
+}
+
 // followUseCase implements the FollowUseCase interface.
 type followUseCase struct {
 	followRepo    entity.FollowRepository
 	artistRepo    entity.ArtistRepository
+	idManager     entity.ArtistIdentityManager
 	siteResolver  entity.OfficialSiteResolver
-	concertUC     ConcertUseCase
+	searchQueue   ConcertSearchQueueUseCase
 	searchLogRepo entity.SearchLogRepository
+	feed          FollowerFeedInvalidator
 	publisher     EventPublisher
 	metrics       FollowMetrics
 	logger        *logging.Logger
@@ -60,12 +83,15 @@ type followUseCase struct {
 var _ FollowUseCase = (*followUseCase)(nil)
 
 // NewFollowUseCase creates a new instance of the follow business logic handler.
+// A nil siteResolver disables resolving the official site after a follow.
 func NewFollowUseCase(
 	followRepo entity.FollowRepository,
 	artistRepo entity.ArtistRepository,
+	idManager entity.ArtistIdentityManager,
 	siteResolver entity.OfficialSiteResolver,
-	concertUC ConcertUseCase,
+	searchQueue ConcertSearchQueueUseCase,
 	searchLogRepo entity.SearchLogRepository,
+	feed FollowerFeedInvalidator,
 	publisher EventPublisher,
 	metrics FollowMetrics,
 	logger *logging.Logger,
@@ -73,9 +99,11 @@ func NewFollowUseCase(
 	return &followUseCase{
 		followRepo:    followRepo,
 		artistRepo:    artistRepo,
+		idManager:     idManager,
 		siteResolver:  siteResolver,
-		concertUC:     concertUC,
+		searchQueue:   searchQueue,
 		searchLogRepo: searchLogRepo,
+		feed:          feed,
 		publisher:     publisher,
 		metrics:       metrics,
 		logger:        logger,
@@ -84,7 +112,8 @@ func NewFollowUseCase(
 
 // Follow establishes a follow relationship between a user and an artist.
 // After the follow is persisted, it asynchronously resolves and stores the
-// artist's official site URL if one is not already recorded.
+// artist's official site URL if one is not already recorded, unless site
+// resolution is disabled.
 //
 // The first-call path publishes ARTIST.followed for the analytics-consumer
 // to forward as the catalogue event artist.follow.completed. The idempotent
@@ -100,6 +129,7 @@ func (uc *followUseCase) Follow(ctx context.Context, userID string, artistID str
 		return apperr.Wrap(err, codes.Internal, "failed to establish follow relationship")
 	}
 
+	uc.feed.InvalidateFollowerFeed(userID)
 	uc.logger.Info(ctx, "User followed artist", slog.String("user_id", userID), slog.String("artist_id", artistID))
 	uc.metrics.RecordFollow(ctx, "follow")
 
@@ -115,15 +145,92 @@ func (uc *followUseCase) Follow(ctx context.Context, userID string, artistID str
 	}
 
 	bgCtx := context.WithoutCancel(ctx)
-	go uc.resolveAndPersistOfficialSite(bgCtx, artistID)
+	if uc.siteResolver != nil {
+		go uc.resolveAndPersistOfficialSite(bgCtx, artistID)
+	}
 	go uc.triggerFirstFollowSearch(bgCtx, artistID)
 
 	return nil
 }
 
+// FollowByMBID resolves the MBID to a persisted artist, registering it from
+// MusicBrainz on first sight, and then follows it exactly like Follow.
+func (uc *followUseCase) FollowByMBID(ctx context.Context, userID, mbid string) (*entity.Artist, error) {
+	if mbid == "" {
+		return nil, apperr.New(codes.InvalidArgument, "mbid is required")
+	}
+
+	artist, err := uc.getOrCreateArtistByMBID(ctx, mbid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.Follow(ctx, userID, artist.ID); err != nil {
+		return nil, err
+	}
+
+	return artist, nil
+}
+
+// getOrCreateArtistByMBID returns the artist stored under mbid, creating it
+// from the canonical MusicBrainz record when it is not stored yet. Create
+// deduplicates on MBID, so a concurrent registration of the same artist
+// resolves to the same row; ARTIST.created is only published when this call
+// inserted it.
+func (uc *followUseCase) getOrCreateArtistByMBID(ctx context.Context, mbid string) (*entity.Artist, error) {
+	artist, err := uc.artistRepo.GetByMBID(ctx, mbid)
+	if err == nil {
+		return artist, nil
+	}
+	if !errors.Is(err, apperr.ErrNotFound) {
+		return nil, err
+	}
+
+	canonical, err := uc.idManager.GetArtist(ctx, mbid)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := uc.artistRepo.Create(ctx, canonical)
+	if err != nil {
+		return nil, err
+	}
+	if len(created) == 0 {
+		return nil, apperr.New(codes.Internal, "artist creation returned no results")
+	}
+	artist = created[0]
+
+	// Create hands back the pre-existing row when another request registered
+	// the MBID between GetByMBID and Create. Its ID differs from the one
+	// proposed here, and its ARTIST.created was already published.
+	if canonical.ID != "" && artist.ID != canonical.ID {
+		uc.logger.Info(ctx, "artist registered concurrently; following existing row",
+			slog.String("artist_id", artist.ID),
+			slog.String("mbid", mbid),
+		)
+		return artist, nil
+	}
+
+	if err := uc.publisher.PublishEvent(ctx, entity.SubjectArtistCreated, entity.ArtistCreatedData{
+		ArtistID:   artist.ID,
+		ArtistName: artist.Name,
+		MBID:       artist.MBID,
+	}); err != nil {
+		uc.logger.Warn(ctx, "failed to publish artist.created event",
+			slog.String("artist_id", artist.ID), slog.Any("error", err))
+	}
+
+	uc.logger.Info(ctx, "artist registered for follow by MBID",
+		slog.String("artist_id", artist.ID),
+		slog.String("mbid", mbid),
+	)
+	return artist, nil
+}
+
 // triggerFirstFollowSearch checks whether the artist has been searched before
-// and, if not, triggers a background concert search. All errors are logged and
-// swallowed so that the follow operation is never affected.
+// and, if not, enqueues a background concert search. The search itself runs
+// on the queue worker so onboarding never waits on Gemini. All errors are
+// logged and swallowed so that the follow operation is never affected.
 func (uc *followUseCase) triggerFirstFollowSearch(ctx context.Context, artistID string) {
 	_, err := uc.searchLogRepo.GetByArtistID(ctx, artistID)
 	if err == nil {
@@ -136,12 +243,12 @@ func (uc *followUseCase) triggerFirstFollowSearch(ctx context.Context, artistID
 		return
 	}
 
-	// No search log — this is a first follow. Trigger concert discovery.
-	uc.logger.Info(ctx, "First follow detected, triggering concert search",
+	// No search log — this is a first follow. Queue concert discovery.
+	uc.logger.Info(ctx, "First follow detected, enqueueing concert search",
 		slog.String("artist_id", artistID))
 
-	if _, err := uc.concertUC.SearchNewConcerts(ctx, artistID); err != nil {
-		uc.logger.Warn(ctx, "background concert search failed after first follow",
+	if err := uc.searchQueue.Enqueue(ctx, artistID); err != nil {
+		uc.logger.Warn(ctx, "failed to enqueue concert search after first follow",
 			slog.String("artist_id", artistID), slog.Any("error", err))
 	}
 }
@@ -197,6 +304,7 @@ func (uc *followUseCase) Unfollow(ctx context.Context, userID, artistID string)
 		return err
 	}
 
+	uc.feed.InvalidateFollowerFeed(userID)
 	uc.logger.Info(ctx, "Artist unfollowed", slog.String("user_id", userID), slog.String("artist_id", artistID))
 	uc.metrics.RecordFollow(ctx, "unfollow")
 
@@ -221,6 +329,7 @@ func (uc *followUseCase) SetHype(ctx context.Context, userID, artistID string, h
 		return err
 	}
 
+	uc.feed.InvalidateFollowerFeed(userID)
 	uc.logger.Info(ctx, "Hype updated",
 		slog.String("user_id", userID),
 		slog.String("artist_id", artistID),
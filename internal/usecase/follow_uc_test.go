@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"testing/synctest"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/entity/mocks"
@@ -11,15 +12,18 @@ import (
 	"github.com/pannpers/go-apperr/apperr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // followTestDeps holds all dependencies for FollowUseCase tests.
 type followTestDeps struct {
 	followRepo    *mocks.MockFollowRepository
 	artistRepo    *mocks.MockArtistRepository
+	idManager     *mocks.MockArtistIdentityManager
 	siteResolver  *mocks.MockOfficialSiteResolver
-	concertUC     *ucmocks.MockConcertUseCase
+	searchQueue   *ucmocks.MockConcertSearchQueueUseCase
 	searchLogRepo *mocks.MockSearchLogRepository
+	feed          *ucmocks.MockFollowerFeedInvalidator
 	publisher     *ucmocks.MockEventPublisher
 	uc            usecase.FollowUseCase
 }
@@ -29,17 +33,21 @@ func newFollowTestDeps(t *testing.T) *followTestDeps {
 	d := &followTestDeps{
 		followRepo:    mocks.NewMockFollowRepository(t),
 		artistRepo:    mocks.NewMockArtistRepository(t),
+		idManager:     mocks.NewMockArtistIdentityManager(t),
 		siteResolver:  mocks.NewMockOfficialSiteResolver(t),
-		concertUC:     ucmocks.NewMockConcertUseCase(t),
+		searchQueue:   ucmocks.NewMockConcertSearchQueueUseCase(t),
 		searchLogRepo: mocks.NewMockSearchLogRepository(t),
+		feed:          ucmocks.NewMockFollowerFeedInvalidator(t),
 		publisher:     ucmocks.NewMockEventPublisher(t),
 	}
 	d.uc = usecase.NewFollowUseCase(
 		d.followRepo,
 		d.artistRepo,
+		d.idManager,
 		d.siteResolver,
-		d.concertUC,
+		d.searchQueue,
 		d.searchLogRepo,
+		d.feed,
 		d.publisher,
 		noopMetrics{},
 		newTestLogger(t),
@@ -77,6 +85,7 @@ func TestFollowUseCase_SetHype(t *testing.T) {
 					SetHype(ctx, "internal-uuid-1", "artist-1", entity.HypeAway).
 					Return(nil).
 					Once()
+				d.feed.EXPECT().InvalidateFollowerFeed("internal-uuid-1").Once()
 			},
 			wantErr: nil,
 		},
@@ -121,7 +130,7 @@ func TestFollowUseCase_SetHype(t *testing.T) {
 // successful Follow publishes ARTIST.followed via the injected
 // EventPublisher. The background goroutines (resolveAndPersistOfficialSite,
 // triggerFirstFollowSearch) run with context.WithoutCancel and touch
-// the artist + searchLog + concert mocks; their EXPECT()s are declared
+// the artist + searchLog + search queue mocks; their EXPECT()s are declared
 // .Maybe() so the test exits deterministically without waiting on
 // background work that this test isn't asserting.
 func TestFollowUseCase_Follow_PublishesAnalyticsEvent(t *testing.T) {
@@ -136,6 +145,7 @@ func TestFollowUseCase_Follow_PublishesAnalyticsEvent(t *testing.T) {
 		d.followRepo.EXPECT().
 			Follow(ctx, "user-1", "artist-1").
 			Return(nil).Once()
+		d.feed.EXPECT().InvalidateFollowerFeed("user-1").Once()
 		d.publisher.EXPECT().
 			PublishEvent(ctx, entity.SubjectArtistFollowed, entity.ArtistFollowedData{
 				UserID:   "user-1",
@@ -152,8 +162,8 @@ func TestFollowUseCase_Follow_PublishesAnalyticsEvent(t *testing.T) {
 			Return(&entity.Artist{ID: "artist-1"}, nil).Maybe()
 		d.searchLogRepo.EXPECT().GetByArtistID(mock.Anything, "artist-1").
 			Return(nil, apperr.ErrNotFound).Maybe()
-		d.concertUC.EXPECT().SearchNewConcerts(mock.Anything, "artist-1").
-			Return(nil, nil).Maybe()
+		d.searchQueue.EXPECT().Enqueue(mock.Anything, "artist-1").
+			Return(nil).Maybe()
 
 		err := d.uc.Follow(ctx, "user-1", "artist-1")
 		assert.NoError(t, err)
@@ -186,6 +196,50 @@ func TestFollowUseCase_Follow_PublishesAnalyticsEvent(t *testing.T) {
 	})
 }
 
+// TestFollowUseCase_Follow_OfficialSiteResolutionDisabled verifies that a
+// use case built without a siteResolver never spawns the official-site
+// resolution goroutine on follow.
+func TestFollowUseCase_Follow_OfficialSiteResolutionDisabled(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		ctx := context.Background()
+		d := newFollowTestDeps(t)
+		d.uc = usecase.NewFollowUseCase(
+			d.followRepo,
+			d.artistRepo,
+			d.idManager,
+			nil,
+			d.searchQueue,
+			d.searchLogRepo,
+			d.feed,
+			d.publisher,
+			noopMetrics{},
+			newTestLogger(t),
+		)
+
+		d.followRepo.EXPECT().Follow(ctx, "user-1", "artist-1").Return(nil).Once()
+		d.feed.EXPECT().InvalidateFollowerFeed("user-1").Once()
+		d.publisher.EXPECT().
+			PublishEvent(ctx, entity.SubjectArtistFollowed, entity.ArtistFollowedData{
+				UserID:   "user-1",
+				ArtistID: "artist-1",
+			}).
+			Return(nil).Once()
+		d.searchLogRepo.EXPECT().GetByArtistID(mock.Anything, "artist-1").
+			Return(nil, apperr.ErrNotFound).Once()
+		d.searchQueue.EXPECT().Enqueue(mock.Anything, "artist-1").
+			Return(nil).Once()
+
+		err := d.uc.Follow(ctx, "user-1", "artist-1")
+		require.NoError(t, err)
+
+		// Wait for every background goroutine to finish before asserting.
+		synctest.Wait()
+		d.artistRepo.AssertNotCalled(t, "GetOfficialSite", mock.Anything, mock.Anything)
+	})
+}
+
 // TestFollowUseCase_Unfollow_PublishesAnalyticsEvent verifies that a
 // successful Unfollow publishes ARTIST.unfollowed. No goroutines in the
 // Unfollow path, so the test is straightforward.
@@ -201,6 +255,7 @@ func TestFollowUseCase_Unfollow_PublishesAnalyticsEvent(t *testing.T) {
 		d.followRepo.EXPECT().
 			Unfollow(ctx, "user-1", "artist-1").
 			Return(nil).Once()
+		d.feed.EXPECT().InvalidateFollowerFeed("user-1").Once()
 		d.publisher.EXPECT().
 			PublishEvent(ctx, entity.SubjectArtistUnfollowed, entity.ArtistUnfollowedData{
 				UserID:   "user-1",
@@ -232,6 +287,7 @@ func TestFollowUseCase_Unfollow_PublishesAnalyticsEvent(t *testing.T) {
 		d.followRepo.EXPECT().
 			Unfollow(ctx, "user-1", "artist-1").
 			Return(nil).Once()
+		d.feed.EXPECT().InvalidateFollowerFeed("user-1").Once()
 		d.publisher.EXPECT().
 			PublishEvent(ctx, entity.SubjectArtistUnfollowed, mock.Anything).
 			Return(apperr.ErrInternal).Once()
@@ -242,3 +298,107 @@ func TestFollowUseCase_Unfollow_PublishesAnalyticsEvent(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// TestFollowUseCase_FollowByMBID verifies the get-or-create step in front of
+// Follow. Background goroutine EXPECT()s are .Maybe() for the same reason as
+// in TestFollowUseCase_Follow_PublishesAnalyticsEvent.
+func TestFollowUseCase_FollowByMBID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	expectFollow := func(d *followTestDeps, artistID string) {
+		d.followRepo.EXPECT().Follow(ctx, "user-1", artistID).Return(nil).Once()
+		d.feed.EXPECT().InvalidateFollowerFeed("user-1").Once()
+		d.publisher.EXPECT().
+			PublishEvent(ctx, entity.SubjectArtistFollowed, entity.ArtistFollowedData{
+				UserID:   "user-1",
+				ArtistID: artistID,
+			}).
+			Return(nil).Once()
+
+		d.artistRepo.EXPECT().GetOfficialSite(mock.Anything, artistID).
+			Return(nil, apperr.ErrNotFound).Maybe()
+		d.artistRepo.EXPECT().Get(mock.Anything, artistID).
+			Return(&entity.Artist{ID: artistID}, nil).Maybe()
+		d.siteResolver.EXPECT().ResolveOfficialSiteURL(mock.Anything, mock.Anything).
+			Return("", nil).Maybe()
+		d.searchLogRepo.EXPECT().GetByArtistID(mock.Anything, artistID).
+			Return(nil, apperr.ErrNotFound).Maybe()
+		d.searchQueue.EXPECT().Enqueue(mock.Anything, artistID).
+			Return(nil).Maybe()
+	}
+
+	t.Run("follows an existing artist without registering it", func(t *testing.T) {
+		t.Parallel()
+		d := newFollowTestDeps(t)
+
+		existing := &entity.Artist{ID: "artist-1", Name: "Existing", MBID: "mbid-1"}
+		d.artistRepo.EXPECT().GetByMBID(ctx, "mbid-1").Return(existing, nil).Once()
+		expectFollow(d, "artist-1")
+
+		got, err := d.uc.FollowByMBID(ctx, "user-1", "mbid-1")
+		require.NoError(t, err)
+		assert.Equal(t, existing, got)
+	})
+
+	t.Run("registers a new MBID before following", func(t *testing.T) {
+		t.Parallel()
+		d := newFollowTestDeps(t)
+
+		canonical := &entity.Artist{ID: "artist-2", Name: "New Artist", MBID: "mbid-2"}
+		created := &entity.Artist{ID: "artist-2", Name: "New Artist", MBID: "mbid-2"}
+		d.artistRepo.EXPECT().GetByMBID(ctx, "mbid-2").Return(nil, apperr.ErrNotFound).Once()
+		d.idManager.EXPECT().GetArtist(ctx, "mbid-2").Return(canonical, nil).Once()
+		d.artistRepo.EXPECT().Create(ctx, canonical).Return([]*entity.Artist{created}, nil).Once()
+		d.publisher.EXPECT().
+			PublishEvent(ctx, entity.SubjectArtistCreated, entity.ArtistCreatedData{
+				ArtistID:   "artist-2",
+				ArtistName: "New Artist",
+				MBID:       "mbid-2",
+			}).
+			Return(nil).Once()
+		expectFollow(d, "artist-2")
+
+		got, err := d.uc.FollowByMBID(ctx, "user-1", "mbid-2")
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+	})
+
+	t.Run("concurrently registered MBID is followed without a second ARTIST.created", func(t *testing.T) {
+		t.Parallel()
+		d := newFollowTestDeps(t)
+
+		canonical := &entity.Artist{ID: "artist-proposed", Name: "Raced Artist", MBID: "mbid-3"}
+		existing := &entity.Artist{ID: "artist-3", Name: "Raced Artist", MBID: "mbid-3"}
+		d.artistRepo.EXPECT().GetByMBID(ctx, "mbid-3").Return(nil, apperr.ErrNotFound).Once()
+		d.idManager.EXPECT().GetArtist(ctx, "mbid-3").Return(canonical, nil).Once()
+		d.artistRepo.EXPECT().Create(ctx, canonical).Return([]*entity.Artist{existing}, nil).Once()
+		// No SubjectArtistCreated expectation: the mock fails the test if
+		// one is published.
+		expectFollow(d, "artist-3")
+
+		got, err := d.uc.FollowByMBID(ctx, "user-1", "mbid-3")
+		require.NoError(t, err)
+		assert.Equal(t, existing, got)
+	})
+
+	t.Run("unknown MBID is not followed", func(t *testing.T) {
+		t.Parallel()
+		d := newFollowTestDeps(t)
+
+		d.artistRepo.EXPECT().GetByMBID(ctx, "mbid-404").Return(nil, apperr.ErrNotFound).Once()
+		d.idManager.EXPECT().GetArtist(ctx, "mbid-404").Return(nil, apperr.ErrNotFound).Once()
+
+		_, err := d.uc.FollowByMBID(ctx, "user-1", "mbid-404")
+		assert.ErrorIs(t, err, apperr.ErrNotFound)
+	})
+
+	t.Run("empty MBID", func(t *testing.T) {
+		t.Parallel()
+		d := newFollowTestDeps(t)
+
+		_, err := d.uc.FollowByMBID(ctx, "user-1", "")
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
@@ -33,3 +33,12 @@ type AnalyticsConsumerMetrics interface {
 	// timestamp and the moment the consumer finishes processing.
 	RecordLag(ctx context.Context, seconds float64)
 }
+
+// EntryMetrics records the live check-in state for the gate dashboard.
+type EntryMetrics interface {
+	// RecordCheckInStatus sets the checked-in attendee count and the
+	// capacity, the number of tickets issued, of the event most recently
+	// checked in to. The event is not recorded, to keep metric cardinality
+	// bounded.
+	RecordCheckInStatus(ctx context.Context, checkedIn, capacity int)
+}
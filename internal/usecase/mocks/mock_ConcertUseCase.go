@@ -22,6 +22,171 @@ func (_m *MockConcertUseCase) EXPECT() *MockConcertUseCase_Expecter {
 	return &MockConcertUseCase_Expecter{mock: &_m.Mock}
 }
 
+// EnsureConcertsFresh provides a mock function with given fields: ctx, artistID
+func (_m *MockConcertUseCase) EnsureConcertsFresh(ctx context.Context, artistID string) error {
+	ret := _m.Called(ctx, artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureConcertsFresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, artistID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConcertUseCase_EnsureConcertsFresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureConcertsFresh'
+type MockConcertUseCase_EnsureConcertsFresh_Call struct {
+	*mock.Call
+}
+
+// EnsureConcertsFresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistID string
+func (_e *MockConcertUseCase_Expecter) EnsureConcertsFresh(ctx interface{}, artistID interface{}) *MockConcertUseCase_EnsureConcertsFresh_Call {
+	return &MockConcertUseCase_EnsureConcertsFresh_Call{Call: _e.mock.On("EnsureConcertsFresh", ctx, artistID)}
+}
+
+func (_c *MockConcertUseCase_EnsureConcertsFresh_Call) Run(run func(ctx context.Context, artistID string)) *MockConcertUseCase_EnsureConcertsFresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertUseCase_EnsureConcertsFresh_Call) Return(_a0 error) *MockConcertUseCase_EnsureConcertsFresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConcertUseCase_EnsureConcertsFresh_Call) RunAndReturn(run func(context.Context, string) error) *MockConcertUseCase_EnsureConcertsFresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportArtistICS provides a mock function with given fields: ctx, artistID
+func (_m *MockConcertUseCase) ExportArtistICS(ctx context.Context, artistID string) ([]byte, error) {
+	ret := _m.Called(ctx, artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportArtistICS")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, artistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, artistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, artistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertUseCase_ExportArtistICS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportArtistICS'
+type MockConcertUseCase_ExportArtistICS_Call struct {
+	*mock.Call
+}
+
+// ExportArtistICS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - artistID string
+func (_e *MockConcertUseCase_Expecter) ExportArtistICS(ctx interface{}, artistID interface{}) *MockConcertUseCase_ExportArtistICS_Call {
+	return &MockConcertUseCase_ExportArtistICS_Call{Call: _e.mock.On("ExportArtistICS", ctx, artistID)}
+}
+
+func (_c *MockConcertUseCase_ExportArtistICS_Call) Run(run func(ctx context.Context, artistID string)) *MockConcertUseCase_ExportArtistICS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertUseCase_ExportArtistICS_Call) Return(_a0 []byte, _a1 error) *MockConcertUseCase_ExportArtistICS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertUseCase_ExportArtistICS_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *MockConcertUseCase_ExportArtistICS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportICS provides a mock function with given fields: ctx, externalUserID
+func (_m *MockConcertUseCase) ExportICS(ctx context.Context, externalUserID string) ([]byte, error) {
+	ret := _m.Called(ctx, externalUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportICS")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, externalUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, externalUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, externalUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertUseCase_ExportICS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportICS'
+type MockConcertUseCase_ExportICS_Call struct {
+	*mock.Call
+}
+
+// ExportICS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalUserID string
+func (_e *MockConcertUseCase_Expecter) ExportICS(ctx interface{}, externalUserID interface{}) *MockConcertUseCase_ExportICS_Call {
+	return &MockConcertUseCase_ExportICS_Call{Call: _e.mock.On("ExportICS", ctx, externalUserID)}
+}
+
+func (_c *MockConcertUseCase_ExportICS_Call) Run(run func(ctx context.Context, externalUserID string)) *MockConcertUseCase_ExportICS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertUseCase_ExportICS_Call) Return(_a0 []byte, _a1 error) *MockConcertUseCase_ExportICS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertUseCase_ExportICS_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *MockConcertUseCase_ExportICS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByArtist provides a mock function with given fields: ctx, artistID
 func (_m *MockConcertUseCase) ListByArtist(ctx context.Context, artistID string) ([]*entity.Concert, error) {
 	ret := _m.Called(ctx, artistID)
@@ -81,34 +246,41 @@ func (_c *MockConcertUseCase_ListByArtist_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// ListByFollower provides a mock function with given fields: ctx, userID
-func (_m *MockConcertUseCase) ListByFollower(ctx context.Context, userID string) ([]*entity.Concert, error) {
-	ret := _m.Called(ctx, userID)
+// ListByFollower provides a mock function with given fields: ctx, userID, limit, cursor
+func (_m *MockConcertUseCase) ListByFollower(ctx context.Context, userID string, limit int, cursor string) ([]*entity.Concert, string, error) {
+	ret := _m.Called(ctx, userID, limit, cursor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListByFollower")
 	}
 
 	var r0 []*entity.Concert
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Concert, error)); ok {
-		return rf(ctx, userID)
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) ([]*entity.Concert, string, error)); ok {
+		return rf(ctx, userID, limit, cursor)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Concert); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) []*entity.Concert); ok {
+		r0 = rf(ctx, userID, limit, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*entity.Concert)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) string); ok {
+		r1 = rf(ctx, userID, limit, cursor)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, string) error); ok {
+		r2 = rf(ctx, userID, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockConcertUseCase_ListByFollower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByFollower'
@@ -119,23 +291,25 @@ type MockConcertUseCase_ListByFollower_Call struct {
 // ListByFollower is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-func (_e *MockConcertUseCase_Expecter) ListByFollower(ctx interface{}, userID interface{}) *MockConcertUseCase_ListByFollower_Call {
-	return &MockConcertUseCase_ListByFollower_Call{Call: _e.mock.On("ListByFollower", ctx, userID)}
+//   - limit int
+//   - cursor string
+func (_e *MockConcertUseCase_Expecter) ListByFollower(ctx interface{}, userID interface{}, limit interface{}, cursor interface{}) *MockConcertUseCase_ListByFollower_Call {
+	return &MockConcertUseCase_ListByFollower_Call{Call: _e.mock.On("ListByFollower", ctx, userID, limit, cursor)}
 }
 
-func (_c *MockConcertUseCase_ListByFollower_Call) Run(run func(ctx context.Context, userID string)) *MockConcertUseCase_ListByFollower_Call {
+func (_c *MockConcertUseCase_ListByFollower_Call) Run(run func(ctx context.Context, userID string, limit int, cursor string)) *MockConcertUseCase_ListByFollower_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockConcertUseCase_ListByFollower_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertUseCase_ListByFollower_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockConcertUseCase_ListByFollower_Call) Return(_a0 []*entity.Concert, _a1 string, _a2 error) *MockConcertUseCase_ListByFollower_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockConcertUseCase_ListByFollower_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Concert, error)) *MockConcertUseCase_ListByFollower_Call {
+func (_c *MockConcertUseCase_ListByFollower_Call) RunAndReturn(run func(context.Context, string, int, string) ([]*entity.Concert, string, error)) *MockConcertUseCase_ListByFollower_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -200,6 +374,65 @@ func (_c *MockConcertUseCase_ListByFollowerGrouped_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ListForUser provides a mock function with given fields: ctx, externalUserID
+func (_m *MockConcertUseCase) ListForUser(ctx context.Context, externalUserID string) ([]*entity.Concert, error) {
+	ret := _m.Called(ctx, externalUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListForUser")
+	}
+
+	var r0 []*entity.Concert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entity.Concert, error)); ok {
+		return rf(ctx, externalUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entity.Concert); ok {
+		r0 = rf(ctx, externalUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Concert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, externalUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConcertUseCase_ListForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListForUser'
+type MockConcertUseCase_ListForUser_Call struct {
+	*mock.Call
+}
+
+// ListForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalUserID string
+func (_e *MockConcertUseCase_Expecter) ListForUser(ctx interface{}, externalUserID interface{}) *MockConcertUseCase_ListForUser_Call {
+	return &MockConcertUseCase_ListForUser_Call{Call: _e.mock.On("ListForUser", ctx, externalUserID)}
+}
+
+func (_c *MockConcertUseCase_ListForUser_Call) Run(run func(ctx context.Context, externalUserID string)) *MockConcertUseCase_ListForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConcertUseCase_ListForUser_Call) Return(_a0 []*entity.Concert, _a1 error) *MockConcertUseCase_ListForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConcertUseCase_ListForUser_Call) RunAndReturn(run func(context.Context, string) ([]*entity.Concert, error)) *MockConcertUseCase_ListForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWithProximity provides a mock function with given fields: ctx, artistIDs, home
 func (_m *MockConcertUseCase) ListWithProximity(ctx context.Context, artistIDs []string, home *entity.Home) ([]*entity.ProximityGroup, error) {
 	ret := _m.Called(ctx, artistIDs, home)
@@ -69,6 +69,240 @@ func (_c *MockEntryUseCase_BuildMerkleTree_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CheckMerkleConsistency provides a mock function with given fields: ctx, eventID
+func (_m *MockEntryUseCase) CheckMerkleConsistency(ctx context.Context, eventID string) (*usecase.MerkleConsistencyReport, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckMerkleConsistency")
+	}
+
+	var r0 *usecase.MerkleConsistencyReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*usecase.MerkleConsistencyReport, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *usecase.MerkleConsistencyReport); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.MerkleConsistencyReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEntryUseCase_CheckMerkleConsistency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckMerkleConsistency'
+type MockEntryUseCase_CheckMerkleConsistency_Call struct {
+	*mock.Call
+}
+
+// CheckMerkleConsistency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEntryUseCase_Expecter) CheckMerkleConsistency(ctx interface{}, eventID interface{}) *MockEntryUseCase_CheckMerkleConsistency_Call {
+	return &MockEntryUseCase_CheckMerkleConsistency_Call{Call: _e.mock.On("CheckMerkleConsistency", ctx, eventID)}
+}
+
+func (_c *MockEntryUseCase_CheckMerkleConsistency_Call) Run(run func(ctx context.Context, eventID string)) *MockEntryUseCase_CheckMerkleConsistency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEntryUseCase_CheckMerkleConsistency_Call) Return(_a0 *usecase.MerkleConsistencyReport, _a1 error) *MockEntryUseCase_CheckMerkleConsistency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEntryUseCase_CheckMerkleConsistency_Call) RunAndReturn(run func(context.Context, string) (*usecase.MerkleConsistencyReport, error)) *MockEntryUseCase_CheckMerkleConsistency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportCheckIns provides a mock function with given fields: ctx, eventID
+func (_m *MockEntryUseCase) ExportCheckIns(ctx context.Context, eventID string) (*usecase.CheckInExport, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCheckIns")
+	}
+
+	var r0 *usecase.CheckInExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*usecase.CheckInExport, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *usecase.CheckInExport); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CheckInExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEntryUseCase_ExportCheckIns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCheckIns'
+type MockEntryUseCase_ExportCheckIns_Call struct {
+	*mock.Call
+}
+
+// ExportCheckIns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEntryUseCase_Expecter) ExportCheckIns(ctx interface{}, eventID interface{}) *MockEntryUseCase_ExportCheckIns_Call {
+	return &MockEntryUseCase_ExportCheckIns_Call{Call: _e.mock.On("ExportCheckIns", ctx, eventID)}
+}
+
+func (_c *MockEntryUseCase_ExportCheckIns_Call) Run(run func(ctx context.Context, eventID string)) *MockEntryUseCase_ExportCheckIns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEntryUseCase_ExportCheckIns_Call) Return(_a0 *usecase.CheckInExport, _a1 error) *MockEntryUseCase_ExportCheckIns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEntryUseCase_ExportCheckIns_Call) RunAndReturn(run func(context.Context, string) (*usecase.CheckInExport, error)) *MockEntryUseCase_ExportCheckIns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttendanceCount provides a mock function with given fields: ctx, eventID
+func (_m *MockEntryUseCase) GetAttendanceCount(ctx context.Context, eventID string) (int, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttendanceCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEntryUseCase_GetAttendanceCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttendanceCount'
+type MockEntryUseCase_GetAttendanceCount_Call struct {
+	*mock.Call
+}
+
+// GetAttendanceCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEntryUseCase_Expecter) GetAttendanceCount(ctx interface{}, eventID interface{}) *MockEntryUseCase_GetAttendanceCount_Call {
+	return &MockEntryUseCase_GetAttendanceCount_Call{Call: _e.mock.On("GetAttendanceCount", ctx, eventID)}
+}
+
+func (_c *MockEntryUseCase_GetAttendanceCount_Call) Run(run func(ctx context.Context, eventID string)) *MockEntryUseCase_GetAttendanceCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEntryUseCase_GetAttendanceCount_Call) Return(_a0 int, _a1 error) *MockEntryUseCase_GetAttendanceCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEntryUseCase_GetAttendanceCount_Call) RunAndReturn(run func(context.Context, string) (int, error)) *MockEntryUseCase_GetAttendanceCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCheckInStatus provides a mock function with given fields: ctx, eventID
+func (_m *MockEntryUseCase) GetCheckInStatus(ctx context.Context, eventID string) (*usecase.CheckInStatus, error) {
+	ret := _m.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCheckInStatus")
+	}
+
+	var r0 *usecase.CheckInStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*usecase.CheckInStatus, error)); ok {
+		return rf(ctx, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *usecase.CheckInStatus); ok {
+		r0 = rf(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CheckInStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEntryUseCase_GetCheckInStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCheckInStatus'
+type MockEntryUseCase_GetCheckInStatus_Call struct {
+	*mock.Call
+}
+
+// GetCheckInStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+func (_e *MockEntryUseCase_Expecter) GetCheckInStatus(ctx interface{}, eventID interface{}) *MockEntryUseCase_GetCheckInStatus_Call {
+	return &MockEntryUseCase_GetCheckInStatus_Call{Call: _e.mock.On("GetCheckInStatus", ctx, eventID)}
+}
+
+func (_c *MockEntryUseCase_GetCheckInStatus_Call) Run(run func(ctx context.Context, eventID string)) *MockEntryUseCase_GetCheckInStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockEntryUseCase_GetCheckInStatus_Call) Return(_a0 *usecase.CheckInStatus, _a1 error) *MockEntryUseCase_GetCheckInStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEntryUseCase_GetCheckInStatus_Call) RunAndReturn(run func(context.Context, string) (*usecase.CheckInStatus, error)) *MockEntryUseCase_GetCheckInStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMerklePath provides a mock function with given fields: ctx, eventID, userID
 func (_m *MockEntryUseCase) GetMerklePath(ctx context.Context, eventID string, userID string) (*usecase.MerklePathResult, error) {
 	ret := _m.Called(ctx, eventID, userID)
@@ -129,6 +363,54 @@ func (_c *MockEntryUseCase_GetMerklePath_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RevokeCheckIn provides a mock function with given fields: ctx, eventID, nullifierHash
+func (_m *MockEntryUseCase) RevokeCheckIn(ctx context.Context, eventID string, nullifierHash []byte) error {
+	ret := _m.Called(ctx, eventID, nullifierHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeCheckIn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) error); ok {
+		r0 = rf(ctx, eventID, nullifierHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEntryUseCase_RevokeCheckIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeCheckIn'
+type MockEntryUseCase_RevokeCheckIn_Call struct {
+	*mock.Call
+}
+
+// RevokeCheckIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID string
+//   - nullifierHash []byte
+func (_e *MockEntryUseCase_Expecter) RevokeCheckIn(ctx interface{}, eventID interface{}, nullifierHash interface{}) *MockEntryUseCase_RevokeCheckIn_Call {
+	return &MockEntryUseCase_RevokeCheckIn_Call{Call: _e.mock.On("RevokeCheckIn", ctx, eventID, nullifierHash)}
+}
+
+func (_c *MockEntryUseCase_RevokeCheckIn_Call) Run(run func(ctx context.Context, eventID string, nullifierHash []byte)) *MockEntryUseCase_RevokeCheckIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockEntryUseCase_RevokeCheckIn_Call) Return(_a0 error) *MockEntryUseCase_RevokeCheckIn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEntryUseCase_RevokeCheckIn_Call) RunAndReturn(run func(context.Context, string, []byte) error) *MockEntryUseCase_RevokeCheckIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyEntry provides a mock function with given fields: ctx, params
 func (_m *MockEntryUseCase) VerifyEntry(ctx context.Context, params *usecase.VerifyEntryParams) (*usecase.VerifyEntryResult, error) {
 	ret := _m.Called(ctx, params)
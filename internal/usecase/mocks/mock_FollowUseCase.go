@@ -70,6 +70,66 @@ func (_c *MockFollowUseCase_Follow_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FollowByMBID provides a mock function with given fields: ctx, userID, mbid
+func (_m *MockFollowUseCase) FollowByMBID(ctx context.Context, userID string, mbid string) (*entity.Artist, error) {
+	ret := _m.Called(ctx, userID, mbid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowByMBID")
+	}
+
+	var r0 *entity.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Artist, error)); ok {
+		return rf(ctx, userID, mbid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.Artist); ok {
+		r0 = rf(ctx, userID, mbid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, mbid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFollowUseCase_FollowByMBID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowByMBID'
+type MockFollowUseCase_FollowByMBID_Call struct {
+	*mock.Call
+}
+
+// FollowByMBID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - mbid string
+func (_e *MockFollowUseCase_Expecter) FollowByMBID(ctx interface{}, userID interface{}, mbid interface{}) *MockFollowUseCase_FollowByMBID_Call {
+	return &MockFollowUseCase_FollowByMBID_Call{Call: _e.mock.On("FollowByMBID", ctx, userID, mbid)}
+}
+
+func (_c *MockFollowUseCase_FollowByMBID_Call) Run(run func(ctx context.Context, userID string, mbid string)) *MockFollowUseCase_FollowByMBID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFollowUseCase_FollowByMBID_Call) Return(_a0 *entity.Artist, _a1 error) *MockFollowUseCase_FollowByMBID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFollowUseCase_FollowByMBID_Call) RunAndReturn(run func(context.Context, string, string) (*entity.Artist, error)) *MockFollowUseCase_FollowByMBID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListFollowed provides a mock function with given fields: ctx, userID
 func (_m *MockFollowUseCase) ListFollowed(ctx context.Context, userID string) ([]*entity.FollowedArtist, error) {
 	ret := _m.Called(ctx, userID)
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// backfillMaxConsecutiveErrors stops a backfill run once this many artists in
+// a row fail to resolve, which indicates the catalog is unavailable rather
+// than a problem with individual artists.
+const backfillMaxConsecutiveErrors = 3
+
+// OfficialSiteBackfillUseCase defines the interface for resolving the official
+// sites of artists that have none. It complements the follow-time resolution,
+// which can be disabled to keep MusicBrainz traffic off the request path.
+type OfficialSiteBackfillUseCase interface {
+	// Backfill resolves and persists the official site of up to limit artists
+	// without one, returning how many sites were stored. Artists whose site
+	// cannot be found are skipped; a resolution failure for one artist is
+	// logged and does not stop the run unless it repeats for several artists
+	// in a row.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive.
+	//  - Internal: If the artists cannot be listed.
+	//  - Canceled / DeadlineExceeded: If ctx ends before the run completes.
+	Backfill(ctx context.Context, limit int) (int, error)
+}
+
+// officialSiteBackfillUseCase implements the OfficialSiteBackfillUseCase interface.
+type officialSiteBackfillUseCase struct {
+	artistRepo entity.ArtistRepository
+	// siteResolver is expected to wait on the process-wide MusicBrainz rate
+	// limiter, so the run needs no pacing of its own.
+	siteResolver entity.OfficialSiteResolver
+	logger       *logging.Logger
+}
+
+// Compile-time interface compliance check
+var _ OfficialSiteBackfillUseCase = (*officialSiteBackfillUseCase)(nil)
+
+// NewOfficialSiteBackfillUseCase creates a new official site backfill use case.
+func NewOfficialSiteBackfillUseCase(
+	artistRepo entity.ArtistRepository,
+	siteResolver entity.OfficialSiteResolver,
+	logger *logging.Logger,
+) OfficialSiteBackfillUseCase {
+	return &officialSiteBackfillUseCase{
+		artistRepo:   artistRepo,
+		siteResolver: siteResolver,
+		logger:       logger,
+	}
+}
+
+// Backfill resolves the official sites of artists that have none.
+func (uc *officialSiteBackfillUseCase) Backfill(ctx context.Context, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, apperr.New(codes.InvalidArgument, "limit must be positive")
+	}
+
+	artists, err := uc.artistRepo.ListArtistsWithoutOfficialSite(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.logger.Info(ctx, "artists loaded for official site backfill", slog.Int("count", len(artists)))
+
+	var persisted, notFound, failed, consecutiveErrors int
+	for _, artist := range artists {
+		url, err := uc.siteResolver.ResolveOfficialSiteURL(ctx, artist.MBID)
+		if ctx.Err() != nil {
+			return persisted, ctx.Err()
+		}
+		if err != nil {
+			failed++
+			consecutiveErrors++
+			uc.logger.Warn(ctx, "failed to resolve official site URL",
+				slog.String("artist_id", artist.ID),
+				slog.String("mbid", artist.MBID),
+				slog.Any("error", err),
+			)
+			if consecutiveErrors >= backfillMaxConsecutiveErrors {
+				uc.logger.Error(ctx, "stopping official site backfill after consecutive failures", nil,
+					slog.Int("consecutive_errors", consecutiveErrors),
+				)
+				break
+			}
+			continue
+		}
+		consecutiveErrors = 0
+
+		if url == "" {
+			notFound++
+			continue
+		}
+
+		if err := uc.artistRepo.CreateOfficialSite(ctx, entity.NewOfficialSite(artist.ID, url)); err != nil {
+			// A concurrent follow may have stored the same site first.
+			if !errors.Is(err, apperr.ErrAlreadyExists) {
+				failed++
+				uc.logger.Warn(ctx, "failed to persist official site",
+					slog.String("artist_id", artist.ID),
+					slog.String("url", url),
+					slog.Any("error", err),
+				)
+			}
+			continue
+		}
+		persisted++
+	}
+
+	uc.logger.Info(ctx, "official site backfill complete",
+		slog.Int("artists_attempted", len(artists)),
+		slog.Int("sites_persisted", persisted),
+		slog.Int("not_found", notFound),
+		slog.Int("failures", failed),
+	)
+	return persisted, nil
+}
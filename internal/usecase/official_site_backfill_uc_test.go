@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfficialSiteBackfillUseCase_Backfill(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	batch := []*entity.Artist{
+		{ID: "artist-1", Name: "First", MBID: "mbid-1"},
+		{ID: "artist-2", Name: "Second", MBID: "mbid-2"},
+		{ID: "artist-3", Name: "Third", MBID: "mbid-3"},
+		{ID: "artist-4", Name: "Fourth", MBID: "mbid-4"},
+	}
+
+	siteFor := func(artistID, url string) any {
+		return mock.MatchedBy(func(s *entity.OfficialSite) bool {
+			return s.ArtistID == artistID && s.URL == url
+		})
+	}
+
+	t.Run("processes a batch and persists resolved sites", func(t *testing.T) {
+		t.Parallel()
+		artistRepo := mocks.NewMockArtistRepository(t)
+		resolver := mocks.NewMockOfficialSiteResolver(t)
+
+		artistRepo.EXPECT().ListArtistsWithoutOfficialSite(ctx, 4).Return(batch, nil).Once()
+		resolver.EXPECT().ResolveOfficialSiteURL(ctx, "mbid-1").Return("https://first.example.com", nil).Once()
+		// No site in any catalog: skipped without persisting.
+		resolver.EXPECT().ResolveOfficialSiteURL(ctx, "mbid-2").Return("", nil).Once()
+		// A transient failure for one artist does not stop the run.
+		resolver.EXPECT().ResolveOfficialSiteURL(ctx, "mbid-3").Return("", apperr.ErrUnavailable).Once()
+		resolver.EXPECT().ResolveOfficialSiteURL(ctx, "mbid-4").Return("https://fourth.example.com", nil).Once()
+		artistRepo.EXPECT().CreateOfficialSite(ctx, siteFor("artist-1", "https://first.example.com")).Return(nil).Once()
+		artistRepo.EXPECT().CreateOfficialSite(ctx, siteFor("artist-4", "https://fourth.example.com")).Return(nil).Once()
+
+		uc := usecase.NewOfficialSiteBackfillUseCase(artistRepo, resolver, newTestLogger(t))
+		got, err := uc.Backfill(ctx, 4)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, got)
+	})
+
+	t.Run("site stored concurrently is not counted or reported", func(t *testing.T) {
+		t.Parallel()
+		artistRepo := mocks.NewMockArtistRepository(t)
+		resolver := mocks.NewMockOfficialSiteResolver(t)
+
+		artistRepo.EXPECT().ListArtistsWithoutOfficialSite(ctx, 1).Return(batch[:1], nil).Once()
+		resolver.EXPECT().ResolveOfficialSiteURL(ctx, "mbid-1").Return("https://first.example.com", nil).Once()
+		artistRepo.EXPECT().CreateOfficialSite(ctx, mock.Anything).Return(apperr.ErrAlreadyExists).Once()
+
+		uc := usecase.NewOfficialSiteBackfillUseCase(artistRepo, resolver, newTestLogger(t))
+		got, err := uc.Backfill(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Zero(t, got)
+	})
+
+	t.Run("stops after consecutive resolution failures", func(t *testing.T) {
+		t.Parallel()
+		artistRepo := mocks.NewMockArtistRepository(t)
+		resolver := mocks.NewMockOfficialSiteResolver(t)
+
+		artistRepo.EXPECT().ListArtistsWithoutOfficialSite(ctx, 4).Return(batch, nil).Once()
+		resolver.EXPECT().ResolveOfficialSiteURL(ctx, mock.Anything).Return("", apperr.ErrUnavailable).Times(3)
+		// mbid-4 MUST NOT be resolved — the run stops after three failures.
+
+		uc := usecase.NewOfficialSiteBackfillUseCase(artistRepo, resolver, newTestLogger(t))
+		got, err := uc.Backfill(ctx, 4)
+
+		require.NoError(t, err)
+		assert.Zero(t, got)
+	})
+
+	t.Run("list failure is returned", func(t *testing.T) {
+		t.Parallel()
+		artistRepo := mocks.NewMockArtistRepository(t)
+		resolver := mocks.NewMockOfficialSiteResolver(t)
+
+		artistRepo.EXPECT().ListArtistsWithoutOfficialSite(ctx, 4).Return(nil, apperr.ErrInternal).Once()
+
+		uc := usecase.NewOfficialSiteBackfillUseCase(artistRepo, resolver, newTestLogger(t))
+		_, err := uc.Backfill(ctx, 4)
+
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+
+	t.Run("non-positive limit is rejected", func(t *testing.T) {
+		t.Parallel()
+		artistRepo := mocks.NewMockArtistRepository(t)
+		resolver := mocks.NewMockOfficialSiteResolver(t)
+
+		uc := usecase.NewOfficialSiteBackfillUseCase(artistRepo, resolver, newTestLogger(t))
+		_, err := uc.Backfill(ctx, 0)
+
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
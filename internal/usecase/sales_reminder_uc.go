@@ -328,8 +328,14 @@ func scheduledFireTime(stage entity.ReminderStage, phase *entity.SalesPhase, tz
 // userTimezone parses the user's IANA timezone. Never returns nil: falls back to
 // Asia/Tokyo, then UTC if both LoadLocation calls fail (e.g. tzdata not embedded).
 func userTimezone(u *entity.User) *time.Location {
-	if u.TimeZone != "" {
-		if tz, err := time.LoadLocation(u.TimeZone); err == nil {
+	return timezoneOrFallback(u.TimeZone)
+}
+
+// timezoneOrFallback parses an IANA timezone name with the same fallbacks as
+// userTimezone.
+func timezoneOrFallback(name string) *time.Location {
+	if name != "" {
+		if tz, err := time.LoadLocation(name); err == nil {
 			return tz
 		}
 	}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pannpers/go-apperr/apperr"
+)
+
+// ErrSearchBreakerOpen is the cause of the Unavailable error SearchNewConcerts
+// returns without calling the searcher while the SearchBreaker is open.
+// Callers match it with errors.Is to tell a fast-fail from a real search
+// failure.
+var ErrSearchBreakerOpen = errors.New("concert searcher circuit breaker is open")
+
+// SearchBreaker is a circuit breaker over the external concert searcher,
+// shared by every SearchNewConcerts call in the process. It opens after
+// threshold consecutive outage failures (Unavailable or DeadlineExceeded) and
+// stays open for cooldown, during which searches fail fast instead of waiting
+// out a timeout against a provider that is down. Once the cooldown elapses
+// the next search is let through as a probe: a success closes the breaker, an
+// outage failure reopens it. Any other result means the searcher answered
+// and resets the failure streak.
+//
+// It is safe for concurrent use; a nil *SearchBreaker never opens.
+type SearchBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu          sync.Mutex
+	consecutive int
+	openUntil   time.Time
+}
+
+// NewSearchBreaker creates a closed breaker that opens for cooldown after
+// threshold consecutive outage failures. A threshold below 1 is treated as 1.
+func NewSearchBreaker(threshold int, cooldown time.Duration) *SearchBreaker {
+	return &SearchBreaker{threshold: max(threshold, 1), cooldown: cooldown}
+}
+
+// Allow reports whether a search may be attempted at now.
+func (b *SearchBreaker) Allow(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openUntil)
+}
+
+// Record feeds the outcome of one search attempted at now into the breaker.
+func (b *SearchBreaker) Record(now time.Time, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !isSearcherOutage(err) {
+		b.consecutive = 0
+		return
+	}
+	b.consecutive++
+	if b.consecutive >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// isSearcherOutage reports whether err indicates the searcher itself is
+// unreachable or not answering, as opposed to a failure specific to one
+// request.
+func isSearcherOutage(err error) bool {
+	return errors.Is(err, apperr.ErrUnavailable) || errors.Is(err, apperr.ErrDeadlineExceeded)
+}
@@ -29,6 +29,7 @@ type TicketUseCase interface {
 	// # Possible errors
 	//
 	//  - InvalidArgument: If eventID, userID, recipientAddress, or tokenID are invalid.
+	//  - AlreadyExists: If the token ID is already minted on-chain.
 	//  - Internal: If the on-chain mint transaction fails after retries.
 	MintTicket(ctx context.Context, params *MintTicketParams) (*entity.Ticket, error)
 
@@ -162,7 +163,12 @@ func (uc *ticketUseCase) mintOrReconcile(ctx context.Context, params *MintTicket
 	// Submit the mint transaction. Retry logic is inside the minter implementation.
 	txHash, err := uc.minter.Mint(ctx, params.RecipientAddress, tokenID)
 	if err != nil {
-		return "", 0, apperr.Wrap(err, codes.Internal, "failed to mint ticket on-chain",
+		// A duplicate mint is the caller's conflict, not a server failure.
+		code := codes.Internal
+		if errors.Is(err, apperr.ErrAlreadyExists) {
+			code = codes.AlreadyExists
+		}
+		return "", 0, apperr.Wrap(err, code, "failed to mint ticket on-chain",
 			slog.String("event_id", params.EventID),
 			slog.String("user_id", params.UserID),
 			slog.Uint64("token_id", tokenID),
@@ -10,6 +10,7 @@ import (
 	"github.com/liverty-music/backend/internal/usecase"
 	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
 	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -263,6 +264,33 @@ func TestMintTicket_IsTokenMintedError(t *testing.T) {
 	assert.True(t, errors.Is(err, apperr.ErrInternal), "expected Internal, got %v", err)
 }
 
+func TestMintTicket_MintAlreadyExists(t *testing.T) {
+	t.Parallel()
+
+	// A mint reverted because the token ID is taken keeps its AlreadyExists
+	// code instead of surfacing as Internal.
+	// Never reaches persist, so publisher is nil.
+	repo := mocks.NewMockTicketRepository(t)
+	minter := mocks.NewMockTicketMinter(t)
+	uc := newTestTicketUC(t, repo, minter)
+
+	repo.EXPECT().GetByEventAndUser(anyCtx, "event-1", "user-1").Return(nil, apperr.ErrNotFound)
+	repo.EXPECT().EventExists(anyCtx, "event-1").Return(true, nil)
+	minter.EXPECT().IsTokenMinted(anyCtx, mock.AnythingOfType("uint64")).Return(false, nil)
+	minter.EXPECT().Mint(anyCtx, "0xaAbBcCdDeEfF0011223344556677889900aAbBcC", mock.AnythingOfType("uint64")).
+		Return("", apperr.New(codes.AlreadyExists, "ticketsbt: mint reverted: ERC721InvalidSender"))
+
+	_, err := uc.MintTicket(context.Background(), &usecase.MintTicketParams{
+		EventID:          "event-1",
+		UserID:           "user-1",
+		RecipientAddress: "0xaAbBcCdDeEfF0011223344556677889900aAbBcC",
+	})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, apperr.ErrAlreadyExists), "expected AlreadyExists, got %v", err)
+	assert.False(t, errors.Is(err, apperr.ErrInternal))
+}
+
 // TestMintTicket_PublishNonFatal verifies that a publish failure does not
 // cause MintTicket to return an error — the ticket is already persisted and
 // must be returned to the caller.
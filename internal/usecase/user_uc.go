@@ -93,10 +93,11 @@ func NewUserUseCase(userRepo entity.UserRepository, publisher EventPublisher, lo
 // Create creates a new user, or returns the existing user on duplicate
 // external_id (idempotent).
 func (uc *userUseCase) Create(ctx context.Context, params *entity.NewUser) (*entity.User, error) {
+	// Every field is checked before returning so the client can flag all
+	// invalid inputs at once.
+	var verr entity.ValidationError
 	if params.Home != nil {
-		if err := params.Home.Validate(); err != nil {
-			return nil, apperr.Wrap(err, codes.InvalidArgument, err.Error())
-		}
+		verr.Merge("home", params.Home.Validate())
 	}
 	// preferred_language is optional at Create (old clients omit it, which
 	// is allowed — the row is created NULL and the client backfills on
@@ -105,10 +106,10 @@ func (uc *userUseCase) Create(ctx context.Context, params *entity.NewUser) (*ent
 	// DB unchanged and the frontend's i18n.setLocale would silently
 	// fall back to the fallbackLng.
 	if params.PreferredLanguage != "" && !entity.IsValidLanguageCode(params.PreferredLanguage) {
-		return nil, apperr.New(codes.InvalidArgument,
-			"preferred_language must match ISO 639-1 (^[a-z]{2}$)",
-			slog.String("preferred_language", params.PreferredLanguage),
-		)
+		verr.Add("preferred_language", "must match ISO 639-1 (^[a-z]{2}$), got %q", params.PreferredLanguage)
+	}
+	if err := verr.Err(); err != nil {
+		return nil, apperr.Wrap(err, codes.InvalidArgument, "invalid user")
 	}
 
 	user, err := uc.userRepo.Create(ctx, params)
@@ -209,14 +210,15 @@ func (uc *userUseCase) GetByExternalID(ctx context.Context, externalID string) (
 // non-RPC callers (integration tests, future internal handlers, scripts)
 // don't bypass the wire-layer protovalidate constraint.
 func (uc *userUseCase) UpdatePreferredLanguage(ctx context.Context, id, lang string) (*entity.User, error) {
+	var verr entity.ValidationError
 	if id == "" {
-		return nil, apperr.New(codes.InvalidArgument, "user id is required")
+		verr.Add("user_id", "is required")
 	}
 	if !entity.IsValidLanguageCode(lang) {
-		return nil, apperr.New(codes.InvalidArgument,
-			"preferred_language must match ISO 639-1 (^[a-z]{2}$)",
-			slog.String("preferred_language", lang),
-		)
+		verr.Add("preferred_language", "must match ISO 639-1 (^[a-z]{2}$), got %q", lang)
+	}
+	if err := verr.Err(); err != nil {
+		return nil, apperr.Wrap(err, codes.InvalidArgument, "invalid preferred language update")
 	}
 
 	user, err := uc.userRepo.UpdatePreferredLanguage(ctx, id, lang)
@@ -234,8 +236,10 @@ func (uc *userUseCase) UpdatePreferredLanguage(ctx context.Context, id, lang str
 
 // UpdateHome sets or changes the user's home area after validating the structured Home.
 func (uc *userUseCase) UpdateHome(ctx context.Context, id string, home *entity.Home) (*entity.User, error) {
-	if err := home.Validate(); err != nil {
-		return nil, apperr.Wrap(err, codes.InvalidArgument, err.Error())
+	var verr entity.ValidationError
+	verr.Merge("home", home.Validate())
+	if err := verr.Err(); err != nil {
+		return nil, apperr.Wrap(err, codes.InvalidArgument, "invalid home")
 	}
 
 	user, err := uc.userRepo.UpdateHome(ctx, id, home)
@@ -2,9 +2,11 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/liverty-music/backend/internal/entity"
 	"github.com/liverty-music/backend/internal/entity/mocks"
@@ -128,6 +130,32 @@ func TestUserUseCase_CreateUser(t *testing.T) {
 		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
 	})
 
+	t.Run("error - aggregates every invalid field", func(t *testing.T) {
+		t.Parallel()
+		d := newUserTestDeps(t)
+		// repo MUST NOT be called.
+
+		result, err := d.uc.Create(ctx, &entity.NewUser{
+			Name:              "John Doe",
+			Email:             "john@example.com",
+			PreferredLanguage: "EN",
+			Home: &entity.Home{
+				CountryCode: "jp",
+				Level1:      "13",
+			},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+		verr, ok := errors.AsType[*entity.ValidationError](err)
+		require.True(t, ok, "expected *entity.ValidationError in chain, got %T", err)
+		fields := make([]string, len(verr.Violations))
+		for i, v := range verr.Violations {
+			fields[i] = v.Field
+		}
+		assert.Equal(t, []string{"home.country_code", "home.level_1", "preferred_language"}, fields)
+	})
+
 	t.Run("error - repository returns nil user without error", func(t *testing.T) {
 		t.Parallel()
 		d := newUserTestDeps(t)
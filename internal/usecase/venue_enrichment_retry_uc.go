@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+	"github.com/pannpers/go-logging/logging"
+	"golang.org/x/time/rate"
+)
+
+// enrichmentRetryMaxConsecutiveErrors stops a retry run once this many venues
+// in a row fail with an error, which points to a place search outage rather
+// than to the venues.
+const enrichmentRetryMaxConsecutiveErrors = 3
+
+// VenueEnrichmentRetryUseCase defines the interface for retrying the place
+// enrichment of venues whose earlier attempts failed.
+type VenueEnrichmentRetryUseCase interface {
+	// RetryFailed re-runs enrichment for up to limit failed venues whose
+	// backoff has elapsed and returns how many were attempted. A venue that
+	// fails again, unresolved or with an error, is marked failed once more,
+	// which doubles its backoff; after the configured number of attempts it is
+	// no longer retried. A failure for one venue is logged and does not stop
+	// the run unless it repeats for several venues in a row.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive.
+	//  - Internal: If the retry candidates cannot be listed.
+	//  - Canceled / DeadlineExceeded: If ctx ends before the run completes.
+	RetryFailed(ctx context.Context, limit int) (int, error)
+}
+
+// venueEnrichmentRetryUseCase implements the VenueEnrichmentRetryUseCase interface.
+type venueEnrichmentRetryUseCase struct {
+	venueRepo    entity.VenueRepository
+	enrichmentUC VenueEnrichmentUseCase
+	// placeLimiter paces the place searches enrichment issues.
+	placeLimiter *rate.Limiter
+	// baseBackoff is the wait after a venue's first failed attempt; it
+	// doubles with every further attempt.
+	baseBackoff time.Duration
+	// maxAttempts is the number of failed attempts after which a venue is no
+	// longer retried.
+	maxAttempts int
+	logger      *logging.Logger
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+}
+
+// Compile-time interface compliance check
+var _ VenueEnrichmentRetryUseCase = (*venueEnrichmentRetryUseCase)(nil)
+
+// NewVenueEnrichmentRetryUseCase creates a new venue enrichment retry use case.
+func NewVenueEnrichmentRetryUseCase(
+	venueRepo entity.VenueRepository,
+	enrichmentUC VenueEnrichmentUseCase,
+	placeLimiter *rate.Limiter,
+	baseBackoff time.Duration,
+	maxAttempts int,
+	logger *logging.Logger,
+) VenueEnrichmentRetryUseCase {
+	return &venueEnrichmentRetryUseCase{
+		venueRepo:    venueRepo,
+		enrichmentUC: enrichmentUC,
+		placeLimiter: placeLimiter,
+		baseBackoff:  baseBackoff,
+		maxAttempts:  maxAttempts,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// RetryFailed retries the enrichment of failed venues due for another attempt.
+func (uc *venueEnrichmentRetryUseCase) RetryFailed(ctx context.Context, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, apperr.New(codes.InvalidArgument, "limit must be positive")
+	}
+
+	venues, err := uc.venueRepo.ListEnrichmentRetryCandidates(ctx, uc.now(), uc.baseBackoff, uc.maxAttempts, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.logger.Info(ctx, "venues loaded for enrichment retry", slog.Int("count", len(venues)))
+
+	var attempted, failed, consecutiveErrors int
+	for _, v := range venues {
+		if err := uc.placeLimiter.Wait(ctx); err != nil {
+			return attempted, err
+		}
+		attempted++
+
+		err := uc.enrichmentUC.EnrichVenue(ctx, v.ID)
+		if ctx.Err() != nil {
+			return attempted, ctx.Err()
+		}
+		if err == nil {
+			consecutiveErrors = 0
+			continue
+		}
+
+		failed++
+		consecutiveErrors++
+		uc.logger.Warn(ctx, "venue enrichment retry failed",
+			slog.String("venue_id", v.ID),
+			slog.Any("error", err),
+		)
+		// Count the attempt so the venue backs off like an unresolved one.
+		// EnrichVenue has already marked a transient failure retryable
+		// without counting it, so an outage cannot exhaust the retries.
+		if !isTransientEnrichmentError(err) {
+			if err := uc.venueRepo.MarkFailed(ctx, v.ID); err != nil {
+				uc.logger.Warn(ctx, "failed to record venue enrichment attempt",
+					slog.String("venue_id", v.ID),
+					slog.Any("error", err),
+				)
+			}
+		}
+		if consecutiveErrors >= enrichmentRetryMaxConsecutiveErrors {
+			uc.logger.Error(ctx, "stopping venue enrichment retry after consecutive failures", nil,
+				slog.Int("consecutive_errors", consecutiveErrors),
+			)
+			break
+		}
+	}
+
+	uc.logger.Info(ctx, "venue enrichment retry complete",
+		slog.Int("venues_attempted", attempted),
+		slog.Int("failures", failed),
+	)
+	return attempted, nil
+}
@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	ucmocks "github.com/liverty-music/backend/internal/usecase/mocks"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestVenueEnrichmentRetryUseCase_RetryFailed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+	const (
+		baseBackoff = time.Hour
+		maxAttempts = 5
+	)
+	venues := func(ids ...string) []*entity.Venue {
+		out := make([]*entity.Venue, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, &entity.Venue{ID: id, Name: id})
+		}
+		return out
+	}
+
+	type deps struct {
+		venueRepo    *mocks.MockVenueRepository
+		enrichmentUC *ucmocks.MockVenueEnrichmentUseCase
+		uc           usecase.VenueEnrichmentRetryUseCase
+	}
+	setup := func(t *testing.T) deps {
+		d := deps{
+			venueRepo:    mocks.NewMockVenueRepository(t),
+			enrichmentUC: ucmocks.NewMockVenueEnrichmentUseCase(t),
+		}
+		d.uc = usecase.NewVenueEnrichmentRetryUseCase(d.venueRepo, d.enrichmentUC, rate.NewLimiter(rate.Inf, 1), baseBackoff, maxAttempts, newTestLogger(t))
+		usecase.SetVenueEnrichmentRetryClock(d.uc, func() time.Time { return now })
+		return d
+	}
+
+	t.Run("re-enriches every due venue", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 10).
+			Return(venues("venue-1", "venue-2"), nil).Once()
+		d.enrichmentUC.EXPECT().EnrichVenue(ctx, "venue-1").Return(nil).Once()
+		d.enrichmentUC.EXPECT().EnrichVenue(ctx, "venue-2").Return(nil).Once()
+
+		attempted, err := d.uc.RetryFailed(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempted)
+	})
+
+	t.Run("an erroring venue is counted as a failed attempt and the run continues", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 10).
+			Return(venues("venue-1", "venue-2"), nil).Once()
+		d.enrichmentUC.EXPECT().EnrichVenue(ctx, "venue-1").Return(apperr.ErrInternal).Once()
+		d.venueRepo.EXPECT().MarkFailed(ctx, "venue-1").Return(nil).Once()
+		d.enrichmentUC.EXPECT().EnrichVenue(ctx, "venue-2").Return(nil).Once()
+
+		attempted, err := d.uc.RetryFailed(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempted)
+	})
+
+	t.Run("consecutive errors stop the run", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 10).
+			Return(venues("venue-1", "venue-2", "venue-3", "venue-4"), nil).Once()
+		for _, id := range []string{"venue-1", "venue-2", "venue-3"} {
+			d.enrichmentUC.EXPECT().EnrichVenue(ctx, id).Return(apperr.ErrInternal).Once()
+			d.venueRepo.EXPECT().MarkFailed(ctx, id).Return(nil).Once()
+		}
+		// venue-4 MUST NOT be attempted.
+
+		attempted, err := d.uc.RetryFailed(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempted)
+	})
+
+	t.Run("a transient failure is not counted as an attempt", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().ListEnrichmentRetryCandidates(ctx, now, baseBackoff, maxAttempts, 10).
+			Return(venues("venue-1", "venue-2"), nil).Once()
+		d.enrichmentUC.EXPECT().EnrichVenue(ctx, "venue-1").Return(apperr.ErrUnavailable).Once()
+		d.enrichmentUC.EXPECT().EnrichVenue(ctx, "venue-2").Return(apperr.ErrDeadlineExceeded).Once()
+		// MarkFailed MUST NOT be called: EnrichVenue marked both retryable.
+
+		attempted, err := d.uc.RetryFailed(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempted)
+	})
+
+	t.Run("non-positive limit returns InvalidArgument", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		_, err := d.uc.RetryFailed(ctx, 0)
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+}
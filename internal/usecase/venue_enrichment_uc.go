@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-logging/logging"
+)
+
+// VenueEnrichmentUseCase defines the interface for canonicalizing venues
+// created from a raw listed name.
+type VenueEnrichmentUseCase interface {
+	// EnrichVenue resolves the venue's listed name through the place search
+	// and records the canonical place on the venue. When another venue already
+	// holds that place, the venue is flagged as its duplicate for an
+	// administrator to merge; concerts are never moved or deleted here. A venue that
+	// already has a place or no longer exists is left as is, so the call can
+	// be repeated safely. A venue whose name the place search cannot resolve
+	// unambiguously is marked failed for later reprocessing; one the search
+	// could not be reached for is marked retryable, which schedules the retry
+	// without counting it against the venue's attempts. A place whose name
+	// matches the listed name too loosely is not applied: it is held on the
+	// venue for an administrator to approve or reject. An enriched venue is
+	// also looked up in MusicBrainz and its MBID recorded; that lookup is best
+	// effort and never fails the enrichment.
+	//
+	// # Possible errors
+	//
+	//  - Unavailable / DeadlineExceeded: If the place search is unreachable or
+	//    times out. The venue has been marked retryable.
+	//  - Internal: If the venue cannot be read, updated, marked failed,
+	//    retryable, or duplicate.
+	EnrichVenue(ctx context.Context, venueID string) error
+}
+
+// venueEnrichmentUseCase implements the VenueEnrichmentUseCase interface.
+type venueEnrichmentUseCase struct {
+	venueRepo     entity.VenueRepository
+	placeSearcher entity.VenuePlaceSearcher
+	mbidSearcher  entity.VenuePlaceSearcher
+	logger        *logging.Logger
+}
+
+// Compile-time interface compliance check
+var _ VenueEnrichmentUseCase = (*venueEnrichmentUseCase)(nil)
+
+// NewVenueEnrichmentUseCase creates a new venue enrichment use case.
+// placeSearcher resolves the canonical place; mbidSearcher is the MusicBrainz
+// place search that resolves the venue's MBID.
+func NewVenueEnrichmentUseCase(
+	venueRepo entity.VenueRepository,
+	placeSearcher entity.VenuePlaceSearcher,
+	mbidSearcher entity.VenuePlaceSearcher,
+	logger *logging.Logger,
+) VenueEnrichmentUseCase {
+	return &venueEnrichmentUseCase{
+		venueRepo:     venueRepo,
+		placeSearcher: placeSearcher,
+		mbidSearcher:  mbidSearcher,
+		logger:        logger,
+	}
+}
+
+// EnrichVenue resolves a venue to its canonical place.
+func (uc *venueEnrichmentUseCase) EnrichVenue(ctx context.Context, venueID string) error {
+	venue, err := uc.venueRepo.Get(ctx, venueID)
+	if err != nil {
+		// Merged into another venue since the request was published.
+		if errors.Is(err, apperr.ErrNotFound) {
+			uc.logger.Info(ctx, "skipping enrichment: venue no longer exists", slog.String("venue_id", venueID))
+			return nil
+		}
+		return fmt.Errorf("get venue %s: %w", venueID, err)
+	}
+	if venue.GooglePlaceID != nil {
+		uc.logger.Info(ctx, "skipping enrichment: venue already resolved to a place",
+			slog.String("venue_id", venueID),
+			slog.String("place_id", *venue.GooglePlaceID),
+		)
+		return nil
+	}
+
+	name := venue.Name
+	if venue.ListedVenueName != nil {
+		name = *venue.ListedVenueName
+	}
+	var adminArea string
+	if venue.AdminArea != nil {
+		adminArea = *venue.AdminArea
+	}
+
+	place, err := uc.placeSearcher.SearchPlace(ctx, name, adminArea)
+	if err != nil {
+		if errors.Is(err, apperr.ErrNotFound) || errors.Is(err, apperr.ErrFailedPrecondition) {
+			if err := uc.venueRepo.MarkFailed(ctx, venueID); err != nil {
+				return fmt.Errorf("mark venue %s enrichment failed: %w", venueID, err)
+			}
+			uc.logger.Info(ctx, "venue enrichment failed: no unambiguous place match",
+				slog.String("venue_id", venueID),
+				slog.String("listed_venue_name", name),
+			)
+			return nil
+		}
+		if isTransientEnrichmentError(err) {
+			if err := uc.venueRepo.MarkRetryable(ctx, venueID); err != nil {
+				return fmt.Errorf("mark venue %s enrichment retryable: %w", venueID, err)
+			}
+		}
+		return fmt.Errorf("search place %q: %w", name, err)
+	}
+
+	if confidence := placeMatchConfidence(name, place.Name); confidence < enrichmentReviewThreshold {
+		// An uncertain match could bind the venue, and through it every
+		// concert, to the wrong place, so it waits for an administrator.
+		if err := uc.venueRepo.MarkNeedsReview(ctx, venueID, place, confidence); err != nil {
+			return fmt.Errorf("mark venue %s for enrichment review: %w", venueID, err)
+		}
+		uc.logger.Info(ctx, "uncertain place match held for review",
+			slog.String("venue_id", venueID),
+			slog.String("listed_venue_name", name),
+			slog.String("place_name", place.Name),
+			slog.Float64("confidence", confidence),
+		)
+		return nil
+	}
+
+	existing, err := uc.venueRepo.GetByPlaceID(ctx, place.ExternalID)
+	switch {
+	case err == nil && existing.ID != venueID:
+		// Merging can delete colliding events, which must not happen without
+		// review, so the pair is left for an administrator to merge.
+		if err := uc.venueRepo.MarkDuplicate(ctx, venueID, existing.ID); err != nil {
+			return fmt.Errorf("mark venue %s duplicate of %s: %w", venueID, existing.ID, err)
+		}
+		uc.logger.Warn(ctx, "enriched venue duplicates the venue holding its place; awaiting admin merge",
+			slog.String("venue_id", venueID),
+			slog.String("canonical_venue_id", existing.ID),
+			slog.String("place_id", place.ExternalID),
+		)
+		return nil
+	case err != nil && !errors.Is(err, apperr.ErrNotFound):
+		return fmt.Errorf("get venue by place ID: %w", err)
+	}
+
+	if err := uc.venueRepo.UpdateEnriched(ctx, venueID, place); err != nil {
+		return fmt.Errorf("update enriched venue %s: %w", venueID, err)
+	}
+
+	uc.logger.Info(ctx, "venue enriched",
+		slog.String("venue_id", venueID),
+		slog.String("venue_name", place.Name),
+		slog.String("place_id", place.ExternalID),
+	)
+
+	uc.resolveMBID(ctx, venueID, name, adminArea)
+	return nil
+}
+
+// resolveMBID looks the enriched venue up in MusicBrainz and records its
+// MBID. The venue is already enriched, so a miss, an ambiguous match, or a
+// failure is only logged and leaves the MBID unset.
+func (uc *venueEnrichmentUseCase) resolveMBID(ctx context.Context, venueID, name, adminArea string) {
+	mbPlace, err := uc.mbidSearcher.SearchPlace(ctx, name, adminArea)
+	switch {
+	case errors.Is(err, apperr.ErrNotFound):
+		uc.logger.Info(ctx, "no musicbrainz place for venue",
+			slog.String("venue_id", venueID),
+			slog.String("venue_name", name),
+		)
+		return
+	case errors.Is(err, apperr.ErrFailedPrecondition):
+		uc.logger.Warn(ctx, "ambiguous musicbrainz place for venue; leaving MBID for manual review",
+			slog.String("venue_id", venueID),
+			slog.String("venue_name", name),
+			slog.String("admin_area", adminArea),
+		)
+		return
+	case err != nil:
+		uc.logger.Warn(ctx, "musicbrainz place search failed; leaving venue MBID unset",
+			slog.String("venue_id", venueID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	if err := uc.venueRepo.UpdateMBID(ctx, venueID, mbPlace.ExternalID); err != nil {
+		uc.logger.Warn(ctx, "failed to record venue MBID",
+			slog.String("venue_id", venueID),
+			slog.String("mbid", mbPlace.ExternalID),
+			slog.Any("error", err),
+		)
+		return
+	}
+	uc.logger.Info(ctx, "venue MBID resolved",
+		slog.String("venue_id", venueID),
+		slog.String("mbid", mbPlace.ExternalID),
+	)
+}
+
+const (
+	// enrichmentReviewThreshold is the match confidence below which a place
+	// is held for review instead of applied.
+	enrichmentReviewThreshold = 0.5
+	// containmentConfidence scores a place name that contains the listed name
+	// or is contained in it, such as "Zepp Haneda (TOKYO)" for "Zepp Haneda".
+	containmentConfidence = 0.9
+)
+
+// placeMatchConfidence scores how well the place search's canonical name
+// matches the listed venue name, from 0 (unrelated) to 1 (the same name).
+// Names are compared ignoring case, full-width forms, spaces, and
+// punctuation. One name containing the other scores containmentConfidence;
+// anything else scores the Dice coefficient of their character bigrams.
+func placeMatchConfidence(listedName, placeName string) float64 {
+	a, b := normalizePlaceName(listedName), normalizePlaceName(placeName)
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	as, bs := string(a), string(b)
+	switch {
+	case as == bs:
+		return 1
+	case strings.Contains(as, bs) || strings.Contains(bs, as):
+		return containmentConfidence
+	}
+	return bigramDice(a, b)
+}
+
+// normalizePlaceName lower-cases name, folds full-width ASCII to half-width,
+// and keeps only its letters and digits.
+func normalizePlaceName(name string) []rune {
+	out := make([]rune, 0, len(name))
+	for _, r := range name {
+		if r >= '！' && r <= '～' {
+			r -= '！' - '!'
+		}
+		r = unicode.ToLower(r)
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// bigramDice returns the Dice coefficient of the character bigram multisets
+// of a and b: 1 when they share every bigram, 0 when they share none or
+// either is a single character.
+func bigramDice(a, b []rune) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return 0
+	}
+	counts := make(map[[2]rune]int, len(a)-1)
+	for i := range len(a) - 1 {
+		counts[[2]rune{a[i], a[i+1]}]++
+	}
+	shared := 0
+	for i := range len(b) - 1 {
+		bg := [2]rune{b[i], b[i+1]}
+		if counts[bg] > 0 {
+			counts[bg]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)-1+len(b)-1)
+}
+
+// isTransientEnrichmentError reports whether err is a place search failure
+// that says nothing about the venue: the search was unreachable or timed out.
+func isTransientEnrichmentError(err error) bool {
+	return errors.Is(err, apperr.ErrUnavailable) || errors.Is(err, apperr.ErrDeadlineExceeded)
+}
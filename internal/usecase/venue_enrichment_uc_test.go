@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVenueEnrichmentUseCase_EnrichVenue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unresolved := func(id, listedName string) *entity.Venue {
+		return &entity.Venue{ID: id, Name: listedName, ListedVenueName: &listedName}
+	}
+
+	type deps struct {
+		venueRepo     *mocks.MockVenueRepository
+		placeSearcher *stubPlaceSearcher
+		mbidSearcher  *stubPlaceSearcher
+		uc            usecase.VenueEnrichmentUseCase
+	}
+	setup := func(t *testing.T) deps {
+		d := deps{
+			venueRepo:     mocks.NewMockVenueRepository(t),
+			placeSearcher: newStubPlaceSearcher(),
+			mbidSearcher:  newStubPlaceSearcher(),
+		}
+		d.uc = usecase.NewVenueEnrichmentUseCase(d.venueRepo, d.placeSearcher, d.mbidSearcher, newTestLogger(t))
+		return d
+	}
+
+	t.Run("records the resolved place on the venue", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Zepp Haneda"), nil).Once()
+		place := &entity.VenuePlace{
+			ExternalID:  "place-zepp",
+			Name:        "Zepp Haneda (TOKYO)",
+			Coordinates: &entity.Coordinates{Latitude: 35.54, Longitude: 139.75},
+		}
+		d.placeSearcher.places["Zepp Haneda"] = place
+		d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-zepp").Return(nil, apperr.ErrNotFound).Once()
+		d.venueRepo.EXPECT().UpdateEnriched(ctx, "venue-1", place).Return(nil).Once()
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		assert.Equal(t, []string{"Zepp Haneda"}, d.placeSearcher.searched)
+	})
+
+	t.Run("uncertain match is held for review instead of applied", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Secret Live House"), nil).Once()
+		place := &entity.VenuePlace{ExternalID: "place-dome", Name: "Tokyo Dome"}
+		d.placeSearcher.places["Secret Live House"] = place
+		d.venueRepo.EXPECT().MarkNeedsReview(ctx, "venue-1", place, 0.0).Return(nil).Once()
+		// Neither GetByPlaceID nor UpdateEnriched may be called.
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		assert.Empty(t, d.mbidSearcher.searched)
+	})
+
+	t.Run("width and case variants of the listed name are applied", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "ＺＥＰＰ　ＨＡＮＥＤＡ"), nil).Once()
+		place := &entity.VenuePlace{ExternalID: "place-zepp", Name: "Zepp Haneda"}
+		d.placeSearcher.places["ＺＥＰＰ　ＨＡＮＥＤＡ"] = place
+		d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-zepp").Return(nil, apperr.ErrNotFound).Once()
+		d.venueRepo.EXPECT().UpdateEnriched(ctx, "venue-1", place).Return(nil).Once()
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+	})
+
+	t.Run("failure to hold the match for review is returned for redelivery", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Secret Live House"), nil).Once()
+		d.placeSearcher.places["Secret Live House"] = &entity.VenuePlace{ExternalID: "place-dome", Name: "Tokyo Dome"}
+		d.venueRepo.EXPECT().MarkNeedsReview(ctx, "venue-1", mock.Anything, mock.Anything).Return(apperr.ErrInternal).Once()
+
+		err := d.uc.EnrichVenue(ctx, "venue-1")
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+
+	t.Run("records the MusicBrainz place of the enriched venue", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Zepp Haneda"), nil).Once()
+		place := &entity.VenuePlace{ExternalID: "place-zepp", Name: "Zepp Haneda (TOKYO)"}
+		d.placeSearcher.places["Zepp Haneda"] = place
+		d.mbidSearcher.places["Zepp Haneda"] = &entity.VenuePlace{ExternalID: "mbid-zepp", Name: "Zepp Haneda"}
+		d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-zepp").Return(nil, apperr.ErrNotFound).Once()
+		d.venueRepo.EXPECT().UpdateEnriched(ctx, "venue-1", place).Return(nil).Once()
+		d.venueRepo.EXPECT().UpdateMBID(ctx, "venue-1", "mbid-zepp").Return(nil).Once()
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		assert.Equal(t, []string{"Zepp Haneda"}, d.mbidSearcher.searched)
+	})
+
+	t.Run("ambiguous or failed MusicBrainz lookup leaves the venue enriched without an MBID", func(t *testing.T) {
+		t.Parallel()
+
+		for _, mbErr := range []error{apperr.ErrFailedPrecondition, apperr.ErrUnavailable} {
+			d := setup(t)
+
+			d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Club Quattro"), nil).Once()
+			place := &entity.VenuePlace{ExternalID: "place-quattro", Name: "Shibuya Club Quattro"}
+			d.placeSearcher.places["Club Quattro"] = place
+			d.mbidSearcher.errs["Club Quattro"] = mbErr
+			d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-quattro").Return(nil, apperr.ErrNotFound).Once()
+			d.venueRepo.EXPECT().UpdateEnriched(ctx, "venue-1", place).Return(nil).Once()
+			// UpdateMBID must not be called.
+
+			require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		}
+	})
+
+	t.Run("flags a duplicate of the venue already holding the place", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Budokan"), nil).Once()
+		d.placeSearcher.places["Budokan"] = &entity.VenuePlace{ExternalID: "place-budokan", Name: "Nippon Budokan"}
+		d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-budokan").Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.venueRepo.EXPECT().MarkDuplicate(ctx, "venue-1", "venue-budokan").Return(nil).Once()
+		// Neither MergeVenues nor UpdateEnriched may be called.
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		assert.Empty(t, d.mbidSearcher.searched)
+	})
+
+	t.Run("venue with a place is left as is", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		placeID := "place-zepp"
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(&entity.Venue{ID: "venue-1", GooglePlaceID: &placeID}, nil).Once()
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		assert.Empty(t, d.placeSearcher.searched)
+	})
+
+	t.Run("venue removed since the request is skipped", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(nil, apperr.ErrNotFound).Once()
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+		assert.Empty(t, d.placeSearcher.searched)
+	})
+
+	t.Run("unresolvable name marks the venue failed", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Secret Venue"), nil).Once()
+		d.placeSearcher.errs["Secret Venue"] = apperr.ErrFailedPrecondition
+		d.venueRepo.EXPECT().MarkFailed(ctx, "venue-1").Return(nil).Once()
+
+		require.NoError(t, d.uc.EnrichVenue(ctx, "venue-1"))
+	})
+
+	t.Run("failure to mark the venue failed is returned for redelivery", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Secret Venue"), nil).Once()
+		d.placeSearcher.errs["Secret Venue"] = apperr.ErrNotFound
+		d.venueRepo.EXPECT().MarkFailed(ctx, "venue-1").Return(apperr.ErrInternal).Once()
+
+		err := d.uc.EnrichVenue(ctx, "venue-1")
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+
+	t.Run("place search outage marks the venue retryable", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Budokan"), nil).Once()
+		d.placeSearcher.errs["Budokan"] = apperr.ErrUnavailable
+		d.venueRepo.EXPECT().MarkRetryable(ctx, "venue-1").Return(nil).Once()
+
+		err := d.uc.EnrichVenue(ctx, "venue-1")
+		assert.ErrorIs(t, err, apperr.ErrUnavailable)
+	})
+
+	t.Run("failure to mark the venue retryable is returned for redelivery", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.venueRepo.EXPECT().Get(ctx, "venue-1").Return(unresolved("venue-1", "Budokan"), nil).Once()
+		d.placeSearcher.errs["Budokan"] = apperr.ErrDeadlineExceeded
+		d.venueRepo.EXPECT().MarkRetryable(ctx, "venue-1").Return(apperr.ErrInternal).Once()
+
+		err := d.uc.EnrichVenue(ctx, "venue-1")
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/pannpers/go-apperr/apperr/codes"
+	"github.com/pannpers/go-logging/logging"
+	"golang.org/x/time/rate"
+)
+
+// VenueResolutionBackfillUseCase defines the interface for linking concerts
+// recorded before their venue was resolved to a venue.
+type VenueResolutionBackfillUseCase interface {
+	// Backfill resolves the listed venue names of up to limit concerts pending
+	// venue resolution and links each to its venue, creating the venue from
+	// the place search when none is stored yet. A concert whose show the
+	// venue already holds is merged into that event. It returns how many
+	// concerts were linked or merged. A name the place search cannot resolve
+	// unambiguously leaves its concerts pending and moves them behind
+	// concerts not yet attempted, so they cannot starve later runs; a failure
+	// for one name is logged and does not stop the run unless it repeats for
+	// several names in a row. Concerts linked by an earlier or concurrent run
+	// are skipped, so the run can be repeated safely.
+	//
+	// # Possible errors
+	//
+	//  - InvalidArgument: If limit is not positive.
+	//  - Internal: If the pending concerts cannot be listed.
+	//  - Canceled / DeadlineExceeded: If ctx ends before the run completes.
+	Backfill(ctx context.Context, limit int) (int, error)
+}
+
+// venueResolutionBackfillUseCase implements the VenueResolutionBackfillUseCase interface.
+type venueResolutionBackfillUseCase struct {
+	concertRepo   entity.ConcertRepository
+	venueRepo     entity.VenueRepository
+	placeSearcher entity.VenuePlaceSearcher
+	// placeLimiter paces place searches; the Places client does not limit
+	// its own rate.
+	placeLimiter *rate.Limiter
+	logger       *logging.Logger
+}
+
+// Compile-time interface compliance check
+var _ VenueResolutionBackfillUseCase = (*venueResolutionBackfillUseCase)(nil)
+
+// NewVenueResolutionBackfillUseCase creates a new venue resolution backfill use case.
+func NewVenueResolutionBackfillUseCase(
+	concertRepo entity.ConcertRepository,
+	venueRepo entity.VenueRepository,
+	placeSearcher entity.VenuePlaceSearcher,
+	placeLimiter *rate.Limiter,
+	logger *logging.Logger,
+) VenueResolutionBackfillUseCase {
+	return &venueResolutionBackfillUseCase{
+		concertRepo:   concertRepo,
+		venueRepo:     venueRepo,
+		placeSearcher: placeSearcher,
+		placeLimiter:  placeLimiter,
+		logger:        logger,
+	}
+}
+
+// Backfill links concerts pending venue resolution to their venues.
+func (uc *venueResolutionBackfillUseCase) Backfill(ctx context.Context, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, apperr.New(codes.InvalidArgument, "limit must be positive")
+	}
+
+	concerts, err := uc.concertRepo.ListPendingVenue(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	uc.logger.Info(ctx, "concerts loaded for venue resolution backfill", slog.Int("count", len(concerts)))
+
+	// venueIDs caches each listed name's venue for the run; an empty ID marks
+	// a name that could not be resolved, so it is searched only once.
+	venueIDs := make(map[string]string)
+	var linked, merged, unresolved, failed, consecutiveErrors int
+	// attempted collects the concerts left pending after their name was
+	// tried, to move them to the back of the next run.
+	var attempted []string
+	for _, c := range concerts {
+		if c.ListedVenueName == nil {
+			continue
+		}
+		name := *c.ListedVenueName
+
+		venueID, ok := venueIDs[name]
+		if !ok {
+			venueID, err = uc.resolveVenue(ctx, name)
+			if ctx.Err() != nil {
+				return linked, ctx.Err()
+			}
+			venueIDs[name] = venueID
+			if err != nil {
+				failed++
+				consecutiveErrors++
+				attempted = append(attempted, c.ID)
+				uc.logger.Warn(ctx, "failed to resolve listed venue",
+					slog.String("listed_venue_name", name),
+					slog.Any("error", err),
+				)
+				if consecutiveErrors >= backfillMaxConsecutiveErrors {
+					uc.logger.Error(ctx, "stopping venue resolution backfill after consecutive failures", nil,
+						slog.Int("consecutive_errors", consecutiveErrors),
+					)
+					break
+				}
+				continue
+			}
+			consecutiveErrors = 0
+		}
+
+		if venueID == "" {
+			unresolved++
+			attempted = append(attempted, c.ID)
+			continue
+		}
+
+		heldBy, err := uc.concertRepo.AssignVenue(ctx, c.ID, venueID)
+		if err != nil {
+			// Linked by a concurrent run, or deleted since it was listed.
+			if !errors.Is(err, apperr.ErrNotFound) {
+				failed++
+				attempted = append(attempted, c.ID)
+				uc.logger.Warn(ctx, "failed to link concert to resolved venue",
+					slog.String("concert_id", c.ID),
+					slog.String("venue_id", venueID),
+					slog.Any("error", err),
+				)
+			}
+			continue
+		}
+		if heldBy != c.ID {
+			merged++
+			uc.logger.Info(ctx, "merged concert into the venue's existing event",
+				slog.String("concert_id", c.ID),
+				slog.String("event_id", heldBy),
+				slog.String("venue_id", venueID),
+			)
+		}
+		linked++
+	}
+
+	if len(attempted) > 0 {
+		if err := uc.concertRepo.MarkVenueResolutionAttempted(ctx, attempted); err != nil {
+			uc.logger.Warn(ctx, "failed to record venue resolution attempts",
+				slog.Int("count", len(attempted)),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	uc.logger.Info(ctx, "venue resolution backfill complete",
+		slog.Int("concerts_attempted", len(concerts)),
+		slog.Int("concerts_linked", linked),
+		slog.Int("concerts_merged", merged),
+		slog.Int("unresolved", unresolved),
+		slog.Int("failures", failed),
+	)
+	return linked, nil
+}
+
+// resolveVenue returns the ID of the venue listed under name: a stored venue
+// whose normalized listed or canonical name matches it, a stored venue with
+// the matching place, or one created from the place search. It returns an
+// empty ID when the place search finds no unambiguous match.
+func (uc *venueResolutionBackfillUseCase) resolveVenue(ctx context.Context, name string) (string, error) {
+	existing, err := uc.venueRepo.GetByNormalizedName(ctx, name, nil)
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !errors.Is(err, apperr.ErrNotFound) {
+		return "", fmt.Errorf("get venue by normalized name: %w", err)
+	}
+
+	if err := uc.placeLimiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	place, err := uc.placeSearcher.SearchPlace(ctx, name, "")
+	if err != nil {
+		if errors.Is(err, apperr.ErrNotFound) || errors.Is(err, apperr.ErrFailedPrecondition) {
+			return "", nil
+		}
+		return "", fmt.Errorf("search place %q: %w", name, err)
+	}
+
+	existing, err = uc.venueRepo.GetByPlaceID(ctx, place.ExternalID)
+	if err == nil {
+		return existing.ID, nil
+	}
+	if !errors.Is(err, apperr.ErrNotFound) {
+		return "", fmt.Errorf("get venue by place ID: %w", err)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate venue ID: %w", err)
+	}
+	venue := &entity.Venue{
+		ID:              id.String(),
+		Name:            place.Name,
+		GooglePlaceID:   &place.ExternalID,
+		Coordinates:     place.Coordinates,
+		ListedVenueName: &name,
+	}
+	if err := uc.venueRepo.Create(ctx, venue); err != nil {
+		return "", fmt.Errorf("create venue from place: %w", err)
+	}
+
+	uc.logger.Info(ctx, "created venue for concerts pending resolution",
+		slog.String("venue_id", venue.ID),
+		slog.String("venue_name", venue.Name),
+	)
+	return venue.ID, nil
+}
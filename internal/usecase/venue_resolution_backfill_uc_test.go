@@ -0,0 +1,239 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liverty-music/backend/internal/entity"
+	"github.com/liverty-music/backend/internal/entity/mocks"
+	"github.com/liverty-music/backend/internal/usecase"
+	"github.com/pannpers/go-apperr/apperr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestVenueResolutionBackfillUseCase_Backfill(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pending := func(id, listedName string) *entity.Concert {
+		return &entity.Concert{Event: entity.Event{ID: id, ListedVenueName: &listedName}}
+	}
+
+	type deps struct {
+		concertRepo   *mocks.MockConcertRepository
+		venueRepo     *mocks.MockVenueRepository
+		placeSearcher *stubPlaceSearcher
+		uc            usecase.VenueResolutionBackfillUseCase
+	}
+	setup := func(t *testing.T) deps {
+		d := deps{
+			concertRepo:   mocks.NewMockConcertRepository(t),
+			venueRepo:     mocks.NewMockVenueRepository(t),
+			placeSearcher: newStubPlaceSearcher(),
+		}
+		d.uc = usecase.NewVenueResolutionBackfillUseCase(d.concertRepo, d.venueRepo, d.placeSearcher, rate.NewLimiter(rate.Inf, 1), newTestLogger(t))
+		return d
+	}
+
+	t.Run("links a venueless concert to a venue created from the place search", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{
+			pending("concert-1", "Zepp Haneda"),
+			pending("concert-2", "Zepp Haneda"),
+		}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Zepp Haneda", (*string)(nil)).Return(nil, apperr.ErrNotFound).Once()
+		d.placeSearcher.places["Zepp Haneda"] = &entity.VenuePlace{
+			ExternalID:  "place-zepp",
+			Name:        "Zepp Haneda (TOKYO)",
+			Coordinates: &entity.Coordinates{Latitude: 35.54, Longitude: 139.75},
+		}
+		d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-zepp").Return(nil, apperr.ErrNotFound).Once()
+
+		var created *entity.Venue
+		d.venueRepo.EXPECT().Create(ctx, mock.Anything).RunAndReturn(func(_ context.Context, v *entity.Venue) error {
+			created = v
+			return nil
+		}).Once()
+		venueID := mock.MatchedBy(func(id string) bool { return created != nil && id == created.ID })
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-1", venueID).Return("concert-1", nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-2", venueID).Return("concert-2", nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, got)
+		// Both concerts share the name, so the place is searched only once.
+		assert.Equal(t, []string{"Zepp Haneda"}, d.placeSearcher.searched)
+		require.NotNil(t, created)
+		assert.NotEmpty(t, created.ID)
+		assert.Equal(t, "Zepp Haneda (TOKYO)", created.Name)
+		assert.Equal(t, "place-zepp", *created.GooglePlaceID)
+		assert.Equal(t, "Zepp Haneda", *created.ListedVenueName)
+		assert.Equal(t, &entity.Coordinates{Latitude: 35.54, Longitude: 139.75}, created.Coordinates)
+	})
+
+	t.Run("reuses a stored venue without searching", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{pending("concert-1", "Budokan")}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Budokan", (*string)(nil)).Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-1", "venue-budokan").Return("concert-1", nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+		assert.Empty(t, d.placeSearcher.searched)
+	})
+
+	t.Run("reuses the venue of an already stored place", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{pending("concert-1", "Nippon Budokan Hall")}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Nippon Budokan Hall", (*string)(nil)).Return(nil, apperr.ErrNotFound).Once()
+		d.placeSearcher.places["Nippon Budokan Hall"] = &entity.VenuePlace{ExternalID: "place-budokan"}
+		d.venueRepo.EXPECT().GetByPlaceID(ctx, "place-budokan").Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-1", "venue-budokan").Return("concert-1", nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+
+	t.Run("unresolvable name leaves the concert pending", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{pending("concert-1", "Secret Venue")}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Secret Venue", (*string)(nil)).Return(nil, apperr.ErrNotFound).Once()
+		d.placeSearcher.errs["Secret Venue"] = apperr.ErrFailedPrecondition
+		// AssignVenue and Create MUST NOT be called.
+		d.concertRepo.EXPECT().MarkVenueResolutionAttempted(ctx, []string{"concert-1"}).Return(nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Zero(t, got)
+	})
+
+	t.Run("concert linked concurrently is not counted or reported", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{pending("concert-1", "Budokan")}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Budokan", (*string)(nil)).Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-1", "venue-budokan").Return("", apperr.ErrNotFound).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Zero(t, got)
+	})
+
+	t.Run("limit is passed to the listing", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 1).Return([]*entity.Concert{pending("concert-1", "Budokan")}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Budokan", (*string)(nil)).Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-1", "venue-budokan").Return("concert-1", nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+
+	t.Run("merges a concert whose show the venue already holds", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{pending("concert-1", "Budokan")}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Budokan", (*string)(nil)).Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-1", "venue-budokan").Return("existing-event", nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+
+	t.Run("records attempts on concerts left pending", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{
+			pending("concert-1", "Secret Venue"),
+			pending("concert-2", "Budokan"),
+			pending("concert-3", "Secret Venue"),
+			pending("concert-4", "Flaky Hall"),
+			pending("concert-5", "Budokan"),
+		}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Secret Venue", (*string)(nil)).Return(nil, apperr.ErrNotFound).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Flaky Hall", (*string)(nil)).Return(nil, apperr.ErrNotFound).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, "Budokan", (*string)(nil)).Return(&entity.Venue{ID: "venue-budokan"}, nil).Once()
+		d.placeSearcher.errs["Secret Venue"] = apperr.ErrNotFound
+		d.placeSearcher.errs["Flaky Hall"] = apperr.ErrUnavailable
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-2", "venue-budokan").Return("concert-2", nil).Once()
+		d.concertRepo.EXPECT().AssignVenue(ctx, "concert-5", "venue-budokan").Return("", apperr.ErrFailedPrecondition).Once()
+		d.concertRepo.EXPECT().MarkVenueResolutionAttempted(ctx, []string{"concert-1", "concert-3", "concert-4", "concert-5"}).Return(nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, got)
+	})
+
+	t.Run("stops after consecutive resolution failures", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return([]*entity.Concert{
+			pending("concert-1", "Hall A"),
+			pending("concert-2", "Hall B"),
+			pending("concert-3", "Hall C"),
+			pending("concert-4", "Hall D"),
+		}, nil).Once()
+		d.venueRepo.EXPECT().GetByNormalizedName(ctx, mock.Anything, (*string)(nil)).Return(nil, apperr.ErrNotFound).Times(3)
+		for _, name := range []string{"Hall A", "Hall B", "Hall C", "Hall D"} {
+			d.placeSearcher.errs[name] = apperr.ErrUnavailable
+		}
+		// concert-4 is never tried, so it keeps its place in the queue.
+		d.concertRepo.EXPECT().MarkVenueResolutionAttempted(ctx, []string{"concert-1", "concert-2", "concert-3"}).Return(nil).Once()
+
+		got, err := d.uc.Backfill(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Zero(t, got)
+		// Hall D is not searched — the run stops after three failures.
+		assert.Equal(t, []string{"Hall A", "Hall B", "Hall C"}, d.placeSearcher.searched)
+	})
+
+	t.Run("rejects a non-positive limit", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		_, err := d.uc.Backfill(ctx, 0)
+
+		assert.ErrorIs(t, err, apperr.ErrInvalidArgument)
+	})
+
+	t.Run("listing failure is returned", func(t *testing.T) {
+		t.Parallel()
+		d := setup(t)
+
+		d.concertRepo.EXPECT().ListPendingVenue(ctx, 10).Return(nil, apperr.ErrInternal).Once()
+
+		_, err := d.uc.Backfill(ctx, 10)
+
+		assert.ErrorIs(t, err, apperr.ErrInternal)
+	})
+}
@@ -0,0 +1,36 @@
+-- Create "concert_search_tasks" table
+CREATE TABLE "concert_search_tasks" (
+  "id" uuid NOT NULL,
+  "artist_id" uuid NOT NULL,
+  "status" text NOT NULL DEFAULT 'queued',
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" text NULL,
+  "enqueued_at" timestamptz NOT NULL DEFAULT now(),
+  "claimed_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uq_concert_search_tasks_artist_id" UNIQUE ("artist_id"),
+  CONSTRAINT "chk_concert_search_tasks_attempts" CHECK (attempts >= 0),
+  CONSTRAINT "chk_concert_search_tasks_id_uuidv7" CHECK ("substring"((id)::text, 15, 1) = '7'::text),
+  CONSTRAINT "chk_concert_search_tasks_status" CHECK (status = ANY (ARRAY['queued'::text, 'running'::text])),
+  CONSTRAINT "concert_search_tasks_artist_id_fkey" FOREIGN KEY ("artist_id") REFERENCES "artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Set comment to table: "concert_search_tasks"
+COMMENT ON TABLE "concert_search_tasks" IS 'Durable queue of background concert searches enqueued during onboarding. Holds only outstanding tasks; a successful search deletes its row, so there is at most one task per artist.';
+-- Set comment to column: "id" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."id" IS 'Unique task identifier (UUIDv7, application-generated)';
+-- Set comment to column: "artist_id" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."artist_id" IS 'The artist whose concerts should be searched. Unique so repeated enqueues collapse onto one task.';
+-- Set comment to column: "status" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."status" IS 'Task state: queued (waiting for a worker) or running (claimed by a worker)';
+-- Set comment to column: "attempts" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."attempts" IS 'Number of times a worker has claimed the task, including the in-flight attempt';
+-- Set comment to column: "last_error" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."last_error" IS 'Error message from the most recent failed attempt; NULL if no attempt has failed';
+-- Set comment to column: "enqueued_at" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."enqueued_at" IS 'Timestamp when the task was enqueued. The queue drains in FIFO order on this column.';
+-- Set comment to column: "claimed_at" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."claimed_at" IS 'Timestamp when a worker last claimed the task; a running task with an expired claim is reclaimable. NULL until first claimed.';
+-- Create index "idx_concert_search_tasks_status_enqueued" to table: "concert_search_tasks"
+CREATE INDEX "idx_concert_search_tasks_status_enqueued" ON "concert_search_tasks" ("status", "enqueued_at");
+-- Set comment to index: "idx_concert_search_tasks_status_enqueued"
+COMMENT ON INDEX "idx_concert_search_tasks_status_enqueued" IS 'Supports the worker claim query: oldest claimable task first';
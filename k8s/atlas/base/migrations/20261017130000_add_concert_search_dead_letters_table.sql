@@ -0,0 +1,40 @@
+-- Modify "concert_search_tasks" table
+ALTER TABLE "concert_search_tasks" ADD COLUMN "next_attempt_at" timestamptz NOT NULL DEFAULT now();
+-- Set comment to column: "next_attempt_at" on table: "concert_search_tasks"
+COMMENT ON COLUMN "concert_search_tasks"."next_attempt_at" IS 'Earliest time the task may be claimed. Pushed into the future with exponential backoff after each failed attempt.';
+-- Drop index "idx_concert_search_tasks_status_enqueued" from table: "concert_search_tasks"
+DROP INDEX "idx_concert_search_tasks_status_enqueued";
+-- Create index "idx_concert_search_tasks_status_next_attempt" to table: "concert_search_tasks"
+CREATE INDEX "idx_concert_search_tasks_status_next_attempt" ON "concert_search_tasks" ("status", "next_attempt_at");
+-- Set comment to index: "idx_concert_search_tasks_status_next_attempt" on table: "concert_search_tasks"
+COMMENT ON INDEX "idx_concert_search_tasks_status_next_attempt" IS 'Supports the worker claim query: queued tasks whose backoff has elapsed';
+-- Create "concert_search_dead_letters" table
+CREATE TABLE "concert_search_dead_letters" (
+  "id" uuid NOT NULL,
+  "artist_id" uuid NOT NULL,
+  "attempts" integer NOT NULL,
+  "last_error" text NULL,
+  "enqueued_at" timestamptz NOT NULL,
+  "dead_lettered_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "chk_concert_search_dead_letters_id_uuidv7" CHECK ("substring"((id)::text, 15, 1) = '7'::text),
+  CONSTRAINT "concert_search_dead_letters_artist_id_fkey" FOREIGN KEY ("artist_id") REFERENCES "artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_concert_search_dead_letters_artist_id" to table: "concert_search_dead_letters"
+CREATE INDEX "idx_concert_search_dead_letters_artist_id" ON "concert_search_dead_letters" ("artist_id");
+-- Set comment to table: "concert_search_dead_letters"
+COMMENT ON TABLE "concert_search_dead_letters" IS 'Background concert search tasks that exhausted their retries. Moved out of concert_search_tasks so a permanently failing artist no longer occupies the worker; kept for operator inspection.';
+-- Set comment to column: "id" on table: "concert_search_dead_letters"
+COMMENT ON COLUMN "concert_search_dead_letters"."id" IS 'Identifier of the original task (UUIDv7), preserved from concert_search_tasks';
+-- Set comment to column: "artist_id" on table: "concert_search_dead_letters"
+COMMENT ON COLUMN "concert_search_dead_letters"."artist_id" IS 'The artist whose concert search kept failing';
+-- Set comment to column: "attempts" on table: "concert_search_dead_letters"
+COMMENT ON COLUMN "concert_search_dead_letters"."attempts" IS 'Number of attempts made before the task was dead-lettered';
+-- Set comment to column: "last_error" on table: "concert_search_dead_letters"
+COMMENT ON COLUMN "concert_search_dead_letters"."last_error" IS 'Error message from the final failed attempt; NULL when the task was abandoned by crashed workers without a recorded error';
+-- Set comment to column: "enqueued_at" on table: "concert_search_dead_letters"
+COMMENT ON COLUMN "concert_search_dead_letters"."enqueued_at" IS 'Timestamp when the original task was enqueued';
+-- Set comment to column: "dead_lettered_at" on table: "concert_search_dead_letters"
+COMMENT ON COLUMN "concert_search_dead_letters"."dead_lettered_at" IS 'Timestamp when the task was moved to the dead-letter table';
+-- Set comment to index: "idx_concert_search_dead_letters_artist_id" on table: "concert_search_dead_letters"
+COMMENT ON INDEX "idx_concert_search_dead_letters_artist_id" IS 'Supports looking up dead-lettered searches for an artist';
@@ -0,0 +1,4 @@
+-- Modify "events" table
+ALTER TABLE "events" ADD COLUMN "previous_local_event_date" date NULL;
+-- Set comment to column: "previous_local_event_date" on table: "events"
+COMMENT ON COLUMN "events"."previous_local_event_date" IS 'Date the event was scheduled for before its most recent reschedule; NULL when the event has never been rescheduled';
@@ -0,0 +1,4 @@
+-- Modify "event_performers" table
+ALTER TABLE "event_performers" ADD COLUMN "set_start_at" timestamptz NULL;
+-- Set comment to column: "set_start_at" on table: "event_performers"
+COMMENT ON COLUMN "event_performers"."set_start_at" IS 'The performer''s own set time within the event. A festival day shared by several artists is one event with no start time of its own, so each artist''s slot is kept here; NULL when unknown or when the performer plays at the event''s start time';
@@ -0,0 +1,12 @@
+-- Modify "events" table
+ALTER TABLE "events" ADD COLUMN "search_session_id" uuid NULL;
+-- Set comment to column: "search_session_id" on table: "events"
+COMMENT ON COLUMN "events"."search_session_id" IS 'Discovery search session that first created this event. Kept when a later session re-discovers the same physical event; NULL for events created outside discovery or before sessions were recorded';
+-- Create index "idx_events_search_session_id" to table: "events"
+CREATE INDEX "idx_events_search_session_id" ON "events" ("search_session_id") WHERE (search_session_id IS NOT NULL);
+-- Set comment to index: "idx_events_search_session_id" on table: "events"
+COMMENT ON INDEX "idx_events_search_session_id" IS 'Optimizes auditing the events a discovery search session produced';
+-- Modify "staged_concerts" table
+ALTER TABLE "staged_concerts" ADD COLUMN "search_session_id" uuid NULL;
+-- Set comment to column: "search_session_id" on table: "staged_concerts"
+COMMENT ON COLUMN "staged_concerts"."search_session_id" IS 'Discovery search session that first staged this concert; copied onto the event on approval. NULL for rows staged before sessions were recorded';
@@ -0,0 +1,4 @@
+-- Modify "events" table
+ALTER TABLE "events" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Set comment to column: "deleted_at" on table: "events"
+COMMENT ON COLUMN "events"."deleted_at" IS 'When an admin soft-deleted this event (e.g. a hallucinated discovery). Soft-deleted events are hidden from artist and follower listings but kept for audit; NULL while the event is live';
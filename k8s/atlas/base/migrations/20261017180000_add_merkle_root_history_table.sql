@@ -0,0 +1,29 @@
+-- Create "merkle_root_history" table
+CREATE TABLE "merkle_root_history" (
+  "id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "root" bytea NOT NULL,
+  "leaf_count" integer NOT NULL,
+  "built_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "chk_merkle_root_history_id_uuidv7" CHECK ("substring"((id)::text, 15, 1) = '7'::text),
+  CONSTRAINT "chk_merkle_root_history_leaf_count" CHECK (leaf_count >= 0),
+  CONSTRAINT "chk_merkle_root_history_root_size" CHECK (octet_length(root) = 32),
+  CONSTRAINT "merkle_root_history_event_id_fkey" FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_merkle_root_history_event_built" to table: "merkle_root_history"
+CREATE INDEX "idx_merkle_root_history_event_built" ON "merkle_root_history" ("event_id", "built_at" DESC);
+-- Set comment to table: "merkle_root_history"
+COMMENT ON TABLE "merkle_root_history" IS 'Append-only log of every Merkle root an event has had. One row per tree build, written in the same transaction that replaces the tree, so entry-verification disputes can be traced to the root in force at the time.';
+-- Set comment to column: "id" on table: "merkle_root_history"
+COMMENT ON COLUMN "merkle_root_history"."id" IS 'Unique history entry identifier (UUIDv7, application-generated); breaks ties between builds in the same instant';
+-- Set comment to column: "event_id" on table: "merkle_root_history"
+COMMENT ON COLUMN "merkle_root_history"."event_id" IS 'The event whose Merkle tree was built';
+-- Set comment to column: "root" on table: "merkle_root_history"
+COMMENT ON COLUMN "merkle_root_history"."root" IS 'Merkle root hash produced by the build';
+-- Set comment to column: "leaf_count" on table: "merkle_root_history"
+COMMENT ON COLUMN "merkle_root_history"."leaf_count" IS 'Number of identity commitments in the tree, excluding zero-hash padding leaves';
+-- Set comment to column: "built_at" on table: "merkle_root_history"
+COMMENT ON COLUMN "merkle_root_history"."built_at" IS 'Timestamp when the build was committed';
+-- Set comment to index: "idx_merkle_root_history_event_built" on table: "merkle_root_history"
+COMMENT ON INDEX "idx_merkle_root_history_event_built" IS 'Supports listing an event''s root history newest first';
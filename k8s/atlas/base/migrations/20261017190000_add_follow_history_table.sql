@@ -0,0 +1,33 @@
+-- Create "follow_history" table
+CREATE TABLE "follow_history" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "artist_id" uuid NOT NULL,
+  "action" text NOT NULL,
+  "hype" text NULL,
+  "occurred_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "chk_follow_history_id_uuidv7" CHECK ("substring"((id)::text, 15, 1) = '7'::text),
+  CONSTRAINT "chk_follow_history_action" CHECK (action = ANY (ARRAY['follow'::text, 'unfollow'::text, 'hype_change'::text])),
+  CONSTRAINT "chk_follow_history_hype" CHECK ((hype IS NULL) OR (hype = ANY (ARRAY['watch'::text, 'home'::text, 'nearby'::text, 'away'::text]))),
+  CONSTRAINT "follow_history_artist_id_fkey" FOREIGN KEY ("artist_id") REFERENCES "artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "follow_history_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idx_follow_history_user_occurred" to table: "follow_history"
+CREATE INDEX "idx_follow_history_user_occurred" ON "follow_history" ("user_id", "occurred_at", "id");
+-- Set comment to table: "follow_history"
+COMMENT ON TABLE "follow_history" IS 'Append-only log of follow, unfollow, and hype changes per user. Written in the same statement as the followed_artists mutation so a user''s follow history can be reconstructed when debugging onboarding.';
+-- Set comment to column: "id" on table: "follow_history"
+COMMENT ON COLUMN "follow_history"."id" IS 'Unique history entry identifier (UUIDv7, application-generated); breaks ties between actions in the same instant';
+-- Set comment to column: "user_id" on table: "follow_history"
+COMMENT ON COLUMN "follow_history"."user_id" IS 'The user who performed the action';
+-- Set comment to column: "artist_id" on table: "follow_history"
+COMMENT ON COLUMN "follow_history"."artist_id" IS 'The artist the action applies to';
+-- Set comment to column: "action" on table: "follow_history"
+COMMENT ON COLUMN "follow_history"."action" IS 'What changed: follow, unfollow, or hype_change';
+-- Set comment to column: "hype" on table: "follow_history"
+COMMENT ON COLUMN "follow_history"."hype" IS 'Hype tier in effect after the action; NULL for unfollow';
+-- Set comment to column: "occurred_at" on table: "follow_history"
+COMMENT ON COLUMN "follow_history"."occurred_at" IS 'Timestamp when the action was committed';
+-- Set comment to index: "idx_follow_history_user_occurred" on table: "follow_history"
+COMMENT ON INDEX "idx_follow_history_user_occurred" IS 'Supports listing a user''s follow history in chronological order';
@@ -0,0 +1,8 @@
+-- Modify "events" table
+-- The column is added without a default so rows that predate it stay NULL
+-- (the depth of their stored tree, if any), then the default is set for events
+-- created from now on.
+ALTER TABLE "events" ADD COLUMN "merkle_tree_depth" integer NULL, ADD CONSTRAINT "chk_events_merkle_tree_depth" CHECK ((merkle_tree_depth >= 1) AND (merkle_tree_depth <= 20));
+ALTER TABLE "events" ALTER COLUMN "merkle_tree_depth" SET DEFAULT 20;
+-- Set comment to column: "merkle_tree_depth" on table: "events"
+COMMENT ON COLUMN "events"."merkle_tree_depth" IS 'Depth of the event''s ZKP entry Merkle tree; must match the depth of the circuit that proves membership. NULL for events created before the depth was recorded, which are read as the depth of their stored tree, or 20 (the circuit depth) when none was built';
@@ -0,0 +1,10 @@
+-- Modify "artist_official_site" table
+-- An artist may list several official sites (e.g. a label page next to the
+-- band's own domain); the same URL is still recorded once per artist.
+ALTER TABLE "artist_official_site" DROP CONSTRAINT "artist_official_site_artist_id_key", ADD CONSTRAINT "uq_artist_official_site_artist_url" UNIQUE ("artist_id", "url");
+-- Set comment to table: "artist_official_site"
+COMMENT ON TABLE "artist_official_site" IS 'Stores the official website URLs for each artist, used for concert search grounding.';
+-- Set comment to column: "artist_id" on table: "artist_official_site"
+COMMENT ON COLUMN "artist_official_site"."artist_id" IS 'Reference to the artist (1:N relationship; the earliest row is the primary site)';
+-- Set comment to index: "idx_artist_official_site_artist_id" on table: "artist_official_site"
+COMMENT ON INDEX "idx_artist_official_site_artist_id" IS 'Optimizes retrieval of official sites for an artist';
@@ -0,0 +1,21 @@
+-- Allow an event to be recorded before its venue is resolved.
+--
+-- venue_id becomes nullable; an event without one must carry the scraped
+-- listed_venue_name so it can be resolved later. The physical natural key
+-- cannot key such an event on venue_id alone — NULLS NOT DISTINCT would
+-- collapse every venueless show on a date — so pending_venue_name (the listed
+-- name, only while venue_id is NULL) joins the key. Resolved events keep a
+-- NULL pending_venue_name, so their identity is unchanged.
+-- Modify "events" table
+ALTER TABLE "events" ALTER COLUMN "venue_id" DROP NOT NULL, ADD COLUMN "pending_venue_name" text NULL GENERATED ALWAYS AS (CASE WHEN venue_id IS NULL THEN listed_venue_name END) STORED, ADD CONSTRAINT "chk_events_venue_or_listed_name" CHECK ((venue_id IS NOT NULL) OR (listed_venue_name IS NOT NULL));
+ALTER TABLE "events" DROP CONSTRAINT "uq_events_natural_key", ADD CONSTRAINT "uq_events_natural_key" UNIQUE NULLS NOT DISTINCT ("venue_id", "pending_venue_name", "local_event_date", "start_at");
+-- Create index "idx_events_pending_venue" to table: "events"
+CREATE INDEX "idx_events_pending_venue" ON "events" ("local_event_date") WHERE (venue_id IS NULL);
+-- Set comment to column: "venue_id" on table: "events"
+COMMENT ON COLUMN "events"."venue_id" IS 'Reference to the venue hosting the event; NULL while the venue is pending resolution, in which case listed_venue_name is set';
+-- Set comment to column: "pending_venue_name" on table: "events"
+COMMENT ON COLUMN "events"."pending_venue_name" IS 'listed_venue_name while venue_id is NULL, otherwise NULL. Generated; keys a venueless event in uq_events_natural_key';
+-- Set comment to constraint: "uq_events_natural_key" on table: "events"
+COMMENT ON CONSTRAINT "uq_events_natural_key" ON "events" IS 'Physical identity of a performance: one row per (venue, local date, start time), independent of series or performing artist. start_at is part of the key so two shows at one venue on one date with different start times (matinee/evening) are distinct; NULLS NOT DISTINCT collapses two shows whose start time is not yet published. The same physical show discovered via different artists/series resolves to one row. An event pending venue resolution is keyed by its listed venue name (pending_venue_name) instead.';
+-- Set comment to index: "idx_events_pending_venue" on table: "events"
+COMMENT ON INDEX "idx_events_pending_venue" IS 'Optimizes listing events whose venue is pending resolution';
@@ -0,0 +1,32 @@
+-- Index concert titles and venue names for keyword search.
+--
+-- Each table gets a generated tsvector over its searchable text, built with
+-- the language-agnostic 'simple' configuration so Japanese and romanized
+-- names tokenize the same way: no stemming, no stop words. Series titles
+-- carry weight A and venue names weight B, so a title match outranks a venue
+-- match. The search query keeps a substring fallback for partial matches the
+-- word tokenizer cannot see, such as part of an unspaced Japanese name.
+-- Modify "series" table
+ALTER TABLE "series" ADD COLUMN "search_vector" tsvector NOT NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, title), 'A'::"char")) STORED;
+-- Create index "idx_series_search_vector" to table: "series"
+CREATE INDEX "idx_series_search_vector" ON "series" USING gin ("search_vector");
+-- Modify "venues" table
+ALTER TABLE "venues" ADD COLUMN "search_vector" tsvector NOT NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, ((name || ' '::text) || COALESCE(listed_venue_name, ''::text))), 'B'::"char")) STORED;
+-- Create index "idx_venues_search_vector" to table: "venues"
+CREATE INDEX "idx_venues_search_vector" ON "venues" USING gin ("search_vector");
+-- Modify "events" table
+ALTER TABLE "events" ADD COLUMN "search_vector" tsvector NOT NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple'::regconfig, COALESCE(listed_venue_name, ''::text)), 'B'::"char")) STORED;
+-- Create index "idx_events_search_vector" to table: "events"
+CREATE INDEX "idx_events_search_vector" ON "events" USING gin ("search_vector");
+-- Set comment to column: "search_vector" on table: "series"
+COMMENT ON COLUMN "series"."search_vector" IS 'Generated keyword-search lexemes of the title (simple configuration, weight A)';
+-- Set comment to index: "idx_series_search_vector" on table: "series"
+COMMENT ON INDEX "idx_series_search_vector" IS 'Supports keyword search over series titles';
+-- Set comment to column: "search_vector" on table: "venues"
+COMMENT ON COLUMN "venues"."search_vector" IS 'Generated keyword-search lexemes of the canonical and listed venue names (simple configuration, weight B)';
+-- Set comment to index: "idx_venues_search_vector" on table: "venues"
+COMMENT ON INDEX "idx_venues_search_vector" IS 'Supports keyword search over venue names';
+-- Set comment to column: "search_vector" on table: "events"
+COMMENT ON COLUMN "events"."search_vector" IS 'Generated keyword-search lexemes of the listed venue name (simple configuration, weight B)';
+-- Set comment to index: "idx_events_search_vector" on table: "events"
+COMMENT ON INDEX "idx_events_search_vector" IS 'Supports keyword search over listed venue names';
@@ -0,0 +1,10 @@
+-- Number each Merkle root an event is given.
+--
+-- Every write of merkle_root increments merkle_root_version, so a tree
+-- builder or indexer can record which version it produced and a verifier can
+-- tell recent roots apart. Events that already carry a root start at version 1.
+-- Modify "events" table
+ALTER TABLE "events" ADD COLUMN "merkle_root_version" integer NOT NULL DEFAULT 0, ADD CONSTRAINT "chk_events_merkle_root_version" CHECK (merkle_root_version >= 0);
+UPDATE "events" SET "merkle_root_version" = 1 WHERE "merkle_root" IS NOT NULL;
+-- Set comment to column: "merkle_root_version" on table: "events"
+COMMENT ON COLUMN "events"."merkle_root_version" IS 'Number of times merkle_root has been written; 0 while no root has been set. Incremented by every root update';
@@ -0,0 +1,20 @@
+-- Persist each ticket's position among its event's Merkle tree leaves.
+--
+-- Leaf indices were derived from mint order on every read, so deleting a
+-- ticket shifted every later holder to a different leaf and invalidated the
+-- paths they had already fetched. A tree build now assigns leaf_index once,
+-- appending newly minted tickets after the highest index in use, and later
+-- builds keep it. Existing tickets receive the positions they held under the
+-- derived ordering.
+-- Modify "tickets" table
+ALTER TABLE "tickets" ADD COLUMN "leaf_index" integer NULL, ADD CONSTRAINT "chk_tickets_leaf_index" CHECK (leaf_index >= 0);
+UPDATE "tickets" t SET "leaf_index" = n.idx
+FROM (
+  SELECT "id", ROW_NUMBER() OVER (PARTITION BY "event_id" ORDER BY "minted_at", "id") - 1 AS idx
+  FROM "tickets"
+) n
+WHERE t."id" = n."id";
+-- Set comment to column: "leaf_index" on table: "tickets"
+COMMENT ON COLUMN "tickets"."leaf_index" IS 'Position of the holder''s identity commitment among the event''s Merkle tree leaves; NULL until the first tree build after mint, then never changed';
+-- Create index "idx_tickets_event_leaf_index" to table: "tickets"
+CREATE UNIQUE INDEX "idx_tickets_event_leaf_index" ON "tickets" ("event_id", "leaf_index");
@@ -0,0 +1,19 @@
+-- Create "processed_messages" table
+CREATE TABLE "processed_messages" (
+  "handler_name" text NOT NULL,
+  "dedup_key" text NOT NULL,
+  "processed_at" timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY ("handler_name", "dedup_key")
+);
+-- Set comment to table: "processed_messages"
+COMMENT ON TABLE "processed_messages" IS 'Event messages already handled by an idempotent consumer handler. A row commits together with the handler''s writes, so a redelivered message is skipped; rows older than the dedup window are pruned.';
+-- Set comment to column: "handler_name" on table: "processed_messages"
+COMMENT ON COLUMN "processed_messages"."handler_name" IS 'Name of the consumer handler that processed the message; handlers subscribed to the same subject deduplicate independently';
+-- Set comment to column: "dedup_key" on table: "processed_messages"
+COMMENT ON COLUMN "processed_messages"."dedup_key" IS 'Message dedup key: the CloudEvents id (ce_id) header, or the message UUID when absent';
+-- Set comment to column: "processed_at" on table: "processed_messages"
+COMMENT ON COLUMN "processed_messages"."processed_at" IS 'Timestamp when the message was processed; a record older than the dedup window no longer suppresses redelivery';
+-- Create index "idx_processed_messages_processed_at" to table: "processed_messages"
+CREATE INDEX "idx_processed_messages_processed_at" ON "processed_messages" ("processed_at");
+-- Set comment to index: "idx_processed_messages_processed_at"
+COMMENT ON INDEX "idx_processed_messages_processed_at" IS 'Supports pruning records older than the dedup window';
@@ -0,0 +1,31 @@
+-- Create "entry_verification_audit" table
+CREATE TABLE "entry_verification_audit" (
+  "id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "nullifier_hash" bytea NOT NULL,
+  "outcome" text NOT NULL,
+  "reason" text NULL,
+  "verified_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "chk_entry_verification_audit_id_uuidv7" CHECK ("substring"((id)::text, 15, 1) = '7'::text),
+  CONSTRAINT "chk_entry_verification_audit_outcome" CHECK (outcome = ANY (ARRAY['verified'::text, 'rejected'::text])),
+  CONSTRAINT "chk_entry_verification_audit_reason" CHECK ((outcome = 'rejected'::text) = (reason IS NOT NULL))
+);
+-- Set comment to table: "entry_verification_audit"
+COMMENT ON TABLE "entry_verification_audit" IS 'Append-only audit of every entry verification outcome, for reconstructing gate activity and investigating entry disputes.';
+-- Set comment to column: "id" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."id" IS 'Unique audit record identifier (UUIDv7, application-generated).';
+-- Set comment to column: "event_id" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."event_id" IS 'The event the entry pass was presented for. Intentionally not a foreign key so the audit survives event deletion.';
+-- Set comment to column: "nullifier_hash" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."nullifier_hash" IS 'The nullifier hash from the proof''s public signals, identifying the entry pass without revealing its holder';
+-- Set comment to column: "outcome" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."outcome" IS 'Verification outcome: verified (holder admitted) or rejected';
+-- Set comment to column: "reason" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."reason" IS 'Why a rejected attempt was turned away, e.g. already_checked_in or proof_invalid. NULL when verified.';
+-- Set comment to column: "verified_at" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."verified_at" IS 'Timestamp when the verification outcome was decided';
+-- Create index "idx_entry_verification_audit_event_verified_at" to table: "entry_verification_audit"
+CREATE INDEX "idx_entry_verification_audit_event_verified_at" ON "entry_verification_audit" ("event_id", "verified_at");
+-- Set comment to index: "idx_entry_verification_audit_event_verified_at"
+COMMENT ON INDEX "idx_entry_verification_audit_event_verified_at" IS 'Supports replaying an event''s gate activity in time order';
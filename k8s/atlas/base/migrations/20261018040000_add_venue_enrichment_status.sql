@@ -0,0 +1,15 @@
+-- Track where each venue stands in place enrichment.
+--
+-- A venue created from a raw listed name is enriched asynchronously; until now
+-- a venue whose name the place search could not resolve looked the same as one
+-- still waiting, so failed venues could not be found again for reprocessing.
+-- Venues already resolved to a place start as enriched.
+-- Modify "venues" table
+ALTER TABLE "venues" ADD COLUMN "enrichment_status" text NOT NULL DEFAULT 'pending', ADD CONSTRAINT "chk_venues_enrichment_status" CHECK (enrichment_status = ANY (ARRAY['pending'::text, 'enriched'::text, 'failed'::text]));
+UPDATE "venues" SET "enrichment_status" = 'enriched' WHERE "google_place_id" IS NOT NULL;
+-- Set comment to column: "enrichment_status" on table: "venues"
+COMMENT ON COLUMN "venues"."enrichment_status" IS 'Place enrichment state: pending (default, awaiting enrichment), enriched (resolved to a place), or failed (no unambiguous place match)';
+-- Create index "idx_venues_enrichment_status" to table: "venues"
+CREATE INDEX "idx_venues_enrichment_status" ON "venues" ("enrichment_status", "id");
+-- Set comment to index: "idx_venues_enrichment_status"
+COMMENT ON INDEX "idx_venues_enrichment_status" IS 'Supports paging through venues by enrichment status in creation order';
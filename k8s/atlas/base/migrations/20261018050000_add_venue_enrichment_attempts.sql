@@ -0,0 +1,14 @@
+-- Count place enrichment attempts so failed venues can be retried with backoff.
+--
+-- A venue whose enrichment failed was never looked at again. The
+-- venue-enrichment job now retries failed venues once their last attempt is
+-- older than a backoff that doubles with every attempt, and gives up after a
+-- capped number of attempts. Venues that already failed count one attempt of
+-- unknown time, so the first run retries them.
+-- Modify "venues" table
+ALTER TABLE "venues" ADD COLUMN "enrichment_attempts" integer NOT NULL DEFAULT 0, ADD COLUMN "last_enrichment_attempt_at" timestamptz NULL, ADD CONSTRAINT "chk_venues_enrichment_attempts" CHECK (enrichment_attempts >= 0);
+UPDATE "venues" SET "enrichment_attempts" = 1 WHERE "enrichment_status" = 'failed';
+-- Set comment to column: "enrichment_attempts" on table: "venues"
+COMMENT ON COLUMN "venues"."enrichment_attempts" IS 'Number of failed place enrichment attempts; incremented each time the venue is marked failed';
+-- Set comment to column: "last_enrichment_attempt_at" on table: "venues"
+COMMENT ON COLUMN "venues"."last_enrichment_attempt_at" IS 'When the venue was last marked failed; NULL when it never failed, or failed before attempts were timed';
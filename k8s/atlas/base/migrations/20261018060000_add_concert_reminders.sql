@@ -0,0 +1,38 @@
+-- Let each user choose how many days before a concert to be reminded.
+--
+-- The concert-reminders job reminds a user of every concert they hold a live
+-- ticket journey for once the concert is within the user's own lead time,
+-- instead of one global window. concert_reminders is the sent-log that keeps
+-- each (user, event) reminder to a single delivery.
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "concert_reminder_days" smallint NOT NULL DEFAULT 1, ADD CONSTRAINT "chk_users_concert_reminder_days" CHECK (concert_reminder_days BETWEEN 0 AND 30);
+-- Set comment to column: "concert_reminder_days" on table: "users"
+COMMENT ON COLUMN "users"."concert_reminder_days" IS 'How many days before a concert the user is reminded of it (0 = on the day itself)';
+-- Create "concert_reminders" table
+CREATE TABLE "concert_reminders" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "sent_at" timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uq_concert_reminders" UNIQUE ("user_id", "event_id"),
+  CONSTRAINT "chk_concert_reminders_id_uuidv7" CHECK ("substring"((id)::text, 15, 1) = '7'),
+  CONSTRAINT "concert_reminders_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "concert_reminders_event_id_fkey" FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Set comment to table: "concert_reminders"
+COMMENT ON TABLE "concert_reminders" IS 'Sent-log for concert reminder notifications. UNIQUE (user_id, event_id) prevents duplicate dispatches.';
+-- Set comment to column: "id" on table: "concert_reminders"
+COMMENT ON COLUMN "concert_reminders"."id" IS 'Unique reminder record identifier (UUIDv7, application-generated)';
+-- Set comment to column: "user_id" on table: "concert_reminders"
+COMMENT ON COLUMN "concert_reminders"."user_id" IS 'Reference to the user who received the reminder';
+-- Set comment to column: "event_id" on table: "concert_reminders"
+COMMENT ON COLUMN "concert_reminders"."event_id" IS 'Reference to the event the reminder was for';
+-- Set comment to column: "sent_at" on table: "concert_reminders"
+COMMENT ON COLUMN "concert_reminders"."sent_at" IS 'Timestamp when the reminder was dispatched';
+-- Create index "idx_concert_reminders_event_id" to table: "concert_reminders"
+CREATE INDEX "idx_concert_reminders_event_id" ON "concert_reminders" ("event_id");
+-- Set comment to index: "idx_concert_reminders_event_id" on table: "concert_reminders"
+COMMENT ON INDEX "idx_concert_reminders_event_id" IS 'Optimizes cascading deletes of reminder records for an event';
+-- Set comment to column: "type" on table: "notifications"
+COMMENT ON COLUMN "notifications"."type" IS 'Notification type: new_concerts, sales_reminder, sales_phase_announcement, concert_reminder';
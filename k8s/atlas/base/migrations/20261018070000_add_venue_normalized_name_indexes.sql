@@ -0,0 +1,14 @@
+-- Index venue names by their normalized form for fuzzy venue matching.
+--
+-- GetByNormalizedName matches a venue whose listed or canonical name equals
+-- the requested name after NFKC width normalization, trimming, and case
+-- folding, so "Zepp Nagoya", "ZEPP NAGOYA", and "Ｚｅｐｐ Ｎａｇｏｙａ " resolve to
+-- one venue instead of creating duplicates.
+-- Create index "idx_venues_normalized_listed_venue_name" to table: "venues"
+CREATE INDEX "idx_venues_normalized_listed_venue_name" ON "venues" ((lower(btrim(normalize(listed_venue_name, NFKC)))));
+-- Set comment to index: "idx_venues_normalized_listed_venue_name" on table: "venues"
+COMMENT ON INDEX "idx_venues_normalized_listed_venue_name" IS 'Supports fuzzy venue lookup by NFKC-normalized, trimmed, case-folded listed venue name';
+-- Create index "idx_venues_normalized_name" to table: "venues"
+CREATE INDEX "idx_venues_normalized_name" ON "venues" ((lower(btrim(normalize(name, NFKC)))));
+-- Set comment to index: "idx_venues_normalized_name" on table: "venues"
+COMMENT ON INDEX "idx_venues_normalized_name" IS 'Supports fuzzy venue lookup by NFKC-normalized, trimmed, case-folded venue name';
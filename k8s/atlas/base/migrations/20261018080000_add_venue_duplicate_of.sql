@@ -0,0 +1,11 @@
+-- Flag venues that enrichment resolves to a place another venue holds.
+--
+-- Enrichment used to merge such a venue into the one holding its place from
+-- the consumer, deleting colliding events unreviewed. It now only records the
+-- venue it duplicates, and an administrator merges the pair.
+-- Modify "venues" table
+ALTER TABLE "venues" DROP CONSTRAINT "chk_venues_enrichment_status", ADD CONSTRAINT "chk_venues_enrichment_status" CHECK (enrichment_status = ANY (ARRAY['pending'::text, 'enriched'::text, 'failed'::text, 'duplicate'::text])), ADD COLUMN "duplicate_of_venue_id" uuid NULL, ADD CONSTRAINT "venues_duplicate_of_venue_id_fkey" FOREIGN KEY ("duplicate_of_venue_id") REFERENCES "venues" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Set comment to column: "enrichment_status" on table: "venues"
+COMMENT ON COLUMN "venues"."enrichment_status" IS 'Place enrichment state: pending (default, awaiting enrichment), enriched (resolved to a place), failed (no unambiguous place match), or duplicate (its place is held by duplicate_of_venue_id, awaiting an admin merge)';
+-- Set comment to column: "duplicate_of_venue_id" on table: "venues"
+COMMENT ON COLUMN "venues"."duplicate_of_venue_id" IS 'Venue already holding this venue''s place, set when enrichment flags it as a duplicate; NULL otherwise';
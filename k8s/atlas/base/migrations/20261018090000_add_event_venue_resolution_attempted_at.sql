@@ -0,0 +1,13 @@
+-- Record when the venue-resolution backfill last failed to link a venueless
+-- event, so the backfill takes never-attempted events first and names that
+-- keep failing cannot starve the rest.
+-- Modify "events" table
+ALTER TABLE "events" ADD COLUMN "venue_resolution_attempted_at" timestamptz NULL;
+-- Set comment to column: "venue_resolution_attempted_at" on table: "events"
+COMMENT ON COLUMN "events"."venue_resolution_attempted_at" IS 'When the venue-resolution backfill last tried and failed to link this event pending venue resolution; NULL if it never has. Orders the backfill so names that keep failing do not starve the rest';
+-- Drop index "idx_events_pending_venue" from table: "events"
+DROP INDEX "idx_events_pending_venue";
+-- Create index "idx_events_pending_venue" to table: "events"
+CREATE INDEX "idx_events_pending_venue" ON "events" ("venue_resolution_attempted_at" NULLS FIRST, "local_event_date", "id") WHERE (venue_id IS NULL);
+-- Set comment to index: "idx_events_pending_venue" on table: "events"
+COMMENT ON INDEX "idx_events_pending_venue" IS 'Optimizes listing events whose venue is pending resolution';
@@ -0,0 +1,6 @@
+-- Transient place search outages now record an attempt time without
+-- counting an attempt, so an outage cannot exhaust a venue's retries.
+-- Set comment to column: "enrichment_attempts" on table: "venues"
+COMMENT ON COLUMN "venues"."enrichment_attempts" IS 'Number of failed place enrichment attempts; incremented each time the venue is marked failed, but not for a transient place search outage';
+-- Set comment to column: "last_enrichment_attempt_at" on table: "venues"
+COMMENT ON COLUMN "venues"."last_enrichment_attempt_at" IS 'When place enrichment last failed, including transient place search outages; NULL when it never failed, or failed before attempts were timed';
@@ -0,0 +1,24 @@
+-- Serve the concert search substring match from trigram indexes.
+--
+-- The search ORed its keyword and ILIKE conditions across series, venues,
+-- events, and performers, which no index can serve, so every search scanned
+-- every event. It now unions one indexable branch per table; each ILIKE
+-- branch uses the trigram index below.
+-- Add extension "pg_trgm"
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+-- Create index "idx_artists_name_trgm" to table: "artists"
+CREATE INDEX "idx_artists_name_trgm" ON "artists" USING gin ("name" gin_trgm_ops);
+-- Set comment to index: "idx_artists_name_trgm" on table: "artists"
+COMMENT ON INDEX "idx_artists_name_trgm" IS 'Supports case-insensitive substring concert search over performer names';
+-- Create index "idx_venues_name_trgm" to table: "venues"
+CREATE INDEX "idx_venues_name_trgm" ON "venues" USING gin ("name" gin_trgm_ops);
+-- Set comment to index: "idx_venues_name_trgm" on table: "venues"
+COMMENT ON INDEX "idx_venues_name_trgm" IS 'Supports case-insensitive substring concert search over canonical venue names';
+-- Create index "idx_series_title_trgm" to table: "series"
+CREATE INDEX "idx_series_title_trgm" ON "series" USING gin ("title" gin_trgm_ops);
+-- Set comment to index: "idx_series_title_trgm" on table: "series"
+COMMENT ON INDEX "idx_series_title_trgm" IS 'Supports case-insensitive substring concert search over series titles';
+-- Create index "idx_events_listed_venue_name_trgm" to table: "events"
+CREATE INDEX "idx_events_listed_venue_name_trgm" ON "events" USING gin ("listed_venue_name" gin_trgm_ops);
+-- Set comment to index: "idx_events_listed_venue_name_trgm" on table: "events"
+COMMENT ON INDEX "idx_events_listed_venue_name_trgm" IS 'Supports case-insensitive substring concert search over listed venue names';
@@ -0,0 +1,6 @@
+-- Festival appearances keep their festival flag through review, so approval
+-- files them under a FESTIVAL series and the lineup shares one event.
+-- Modify "staged_concerts" table
+ALTER TABLE "staged_concerts" ADD COLUMN "is_festival" boolean NOT NULL DEFAULT false;
+-- Set comment to column: "is_festival" on table: "staged_concerts"
+COMMENT ON COLUMN "staged_concerts"."is_festival" IS 'Whether the concert is an appearance on a festival lineup. Approval files it under a FESTIVAL series so every artist on the day shares one event.';
@@ -0,0 +1,8 @@
+-- A rescheduled concert is staged for review instead of moving the published
+-- event directly; the staged row records which event approval moves.
+-- Modify "staged_concerts" table
+ALTER TABLE "staged_concerts" ADD COLUMN "reschedules_event_id" uuid NULL, ADD CONSTRAINT "staged_concerts_reschedules_event_id_fkey" FOREIGN KEY ("reschedules_event_id") REFERENCES "events" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Set comment to column: "reschedules_event_id" on table: "staged_concerts"
+COMMENT ON COLUMN "staged_concerts"."reschedules_event_id" IS 'Published event this concert reschedules. Approval moves that event to local_date and notifies its ticket holders instead of publishing a new event. NULL for a new concert';
+-- Set comment to column: "type" on table: "notifications"
+COMMENT ON COLUMN "notifications"."type" IS 'Notification type: new_concerts, sales_reminder, sales_phase_announcement, concert_reminder, concert_rescheduled';
@@ -0,0 +1,6 @@
+-- Venue enrichment also resolves the venue's MusicBrainz place, so venues can
+-- be cross-referenced with MusicBrainz event and artist data.
+-- Modify "venues" table
+ALTER TABLE "venues" ADD COLUMN "mbid" text NULL;
+-- Set comment to column: "mbid" on table: "venues"
+COMMENT ON COLUMN "venues"."mbid" IS 'MusicBrainz Place ID (MBID) resolved during enrichment; NULL when MusicBrainz has no unambiguous place in the venue''s admin area';
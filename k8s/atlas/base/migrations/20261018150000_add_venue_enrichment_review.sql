@@ -0,0 +1,21 @@
+-- Hold uncertain venue enrichment matches for manual review.
+--
+-- Enrichment scores how well the place it found matches the venue's listed
+-- name. A match below the review threshold is no longer applied: the proposed
+-- place is stored next to the venue as needs_review until an administrator
+-- approves it (the venue becomes enriched) or rejects it (rejected, and not
+-- retried).
+-- Modify "venues" table
+ALTER TABLE "venues" DROP CONSTRAINT "chk_venues_enrichment_status", ADD CONSTRAINT "chk_venues_enrichment_status" CHECK (enrichment_status = ANY (ARRAY['pending'::text, 'enriched'::text, 'failed'::text, 'duplicate'::text, 'needs_review'::text, 'rejected'::text])), ADD COLUMN "enrichment_confidence" double precision NULL, ADD COLUMN "proposed_place_id" text NULL, ADD COLUMN "proposed_name" text NULL, ADD COLUMN "proposed_latitude" double precision NULL, ADD COLUMN "proposed_longitude" double precision NULL, ADD CONSTRAINT "chk_venues_enrichment_confidence" CHECK ((enrichment_confidence >= (0)::double precision) AND (enrichment_confidence <= (1)::double precision));
+-- Set comment to column: "enrichment_status" on table: "venues"
+COMMENT ON COLUMN "venues"."enrichment_status" IS 'Place enrichment state: pending (default, awaiting enrichment), enriched (resolved to a place), failed (no unambiguous place match), duplicate (its place is held by duplicate_of_venue_id, awaiting an admin merge), needs_review (an uncertain match awaits an admin decision on the proposed_* place), or rejected (an admin rejected the proposed place; not retried)';
+-- Set comment to column: "enrichment_confidence" on table: "venues"
+COMMENT ON COLUMN "venues"."enrichment_confidence" IS 'Confidence (0 to 1) that the place held for review matches the listed venue name; kept after the review is decided; NULL for matches applied without review';
+-- Set comment to column: "proposed_place_id" on table: "venues"
+COMMENT ON COLUMN "venues"."proposed_place_id" IS 'Google Maps Place ID of the uncertain match awaiting review; NULL unless enrichment_status is needs_review';
+-- Set comment to column: "proposed_name" on table: "venues"
+COMMENT ON COLUMN "venues"."proposed_name" IS 'Canonical name of the uncertain match awaiting review; NULL unless enrichment_status is needs_review';
+-- Set comment to column: "proposed_latitude" on table: "venues"
+COMMENT ON COLUMN "venues"."proposed_latitude" IS 'WGS 84 latitude of the uncertain match awaiting review; NULL unless enrichment_status is needs_review or the place has no coordinates';
+-- Set comment to column: "proposed_longitude" on table: "venues"
+COMMENT ON COLUMN "venues"."proposed_longitude" IS 'WGS 84 longitude of the uncertain match awaiting review; NULL unless enrichment_status is needs_review or the place has no coordinates';
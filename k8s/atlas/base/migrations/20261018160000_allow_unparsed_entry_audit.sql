@@ -0,0 +1,11 @@
+-- Audit entry attempts whose public signals could not be parsed.
+--
+-- Forged or corrupted passes are rejected before a nullifier hash can be read
+-- from them, so the column becomes nullable for rejected attempts. A verified
+-- attempt always carries its nullifier hash.
+-- Modify "entry_verification_audit" table
+ALTER TABLE "entry_verification_audit" ALTER COLUMN "nullifier_hash" DROP NOT NULL, ADD CONSTRAINT "chk_entry_verification_audit_nullifier" CHECK ((outcome = 'rejected'::text) OR (nullifier_hash IS NOT NULL));
+-- Set comment to column: "nullifier_hash" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."nullifier_hash" IS 'The nullifier hash from the proof''s public signals, identifying the entry pass without revealing its holder; NULL when a rejected attempt''s signals could not be parsed';
+-- Set comment to column: "reason" on table: "entry_verification_audit"
+COMMENT ON COLUMN "entry_verification_audit"."reason" IS 'Why a rejected attempt was turned away, e.g. already_checked_in, proof_invalid or malformed_signals. NULL when verified.';
@@ -2,6 +2,7 @@
 package cache
 
 import (
+	"container/list"
 	"context"
 	"sync"
 	"time"
@@ -9,40 +10,64 @@ import (
 
 // entry represents a cached value with expiration metadata.
 type entry struct {
+	key        string
 	value      any
 	expiration time.Time
 }
 
-// MemoryCache is a thread-safe in-memory cache with TTL support.
-// A background goroutine periodically removes expired entries.
-// Close stops the goroutine and blocks until it exits.
+// MemoryCache is a thread-safe in-memory cache with TTL support and an
+// optional LRU bound on the number of entries.
+// Expired entries are evicted lazily by Get, and a background goroutine
+// periodically removes the rest. Close stops the goroutine and blocks until
+// it exits.
 type MemoryCache struct {
-	mu      sync.RWMutex
-	entries map[string]entry
-	ttl     time.Duration
+	mu sync.Mutex
+	// entries indexes the elements of order by key.
+	entries map[string]*list.Element
+	// order holds *entry values from most to least recently used.
+	order      *list.List
+	ttl        time.Duration
+	maxEntries int
 
 	cancel context.CancelFunc
 	done   chan struct{}
 }
 
+// Option configures a MemoryCache.
+type Option func(*MemoryCache)
+
+// WithMaxEntries bounds the cache to n entries. Setting a new key on a full
+// cache evicts the least recently used entry. Zero or negative n leaves the
+// cache unbounded, which is the default.
+func WithMaxEntries(n int) Option {
+	return func(c *MemoryCache) {
+		c.maxEntries = n
+	}
+}
+
 // NewMemoryCache creates a new in-memory cache with the specified TTL and
 // starts a background goroutine that removes expired entries at an interval
 // derived from the TTL (ttl / 6). Call Close to stop the goroutine.
-func NewMemoryCache(ttl time.Duration) *MemoryCache {
+func NewMemoryCache(ttl time.Duration, opts ...Option) *MemoryCache {
 	ctx, cancel := context.WithCancel(context.Background())
 	done := make(chan struct{})
 
 	c := &MemoryCache{
-		entries: make(map[string]entry),
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
 		ttl:     ttl,
 		cancel:  cancel,
 		done:    done,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 
 	go func() {
 		defer close(done)
 		ticker := time.NewTicker(ttl / 6)
 		defer ticker.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -65,31 +90,53 @@ func (c *MemoryCache) Close() error {
 	return nil
 }
 
-// Get retrieves a value from the cache. Returns nil if not found or expired.
+// Get retrieves a value from the cache and marks it most recently used.
+// Returns nil if not found or expired; an expired entry is removed.
 func (c *MemoryCache) Get(key string) any {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	e, ok := c.entries[key]
+	el, ok := c.entries[key]
 	if !ok {
 		return nil
 	}
 
+	e := el.Value.(*entry)
 	if time.Now().After(e.expiration) {
+		c.remove(el)
 		return nil
 	}
 
+	c.order.MoveToFront(el)
 	return e.value
 }
 
-// Set stores a value in the cache with the configured TTL.
+// Set stores a value in the cache with the configured TTL and marks it most
+// recently used, evicting the least recently used entry when the cache is
+// over its size bound.
 func (c *MemoryCache) Set(key string, value any) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL is like Set but the entry expires after ttl. The background
+// cleanup interval stays derived from the configured TTL, so an entry with a
+// shorter ttl may linger until Get finds it expired.
+func (c *MemoryCache) SetWithTTL(key string, value any, ttl time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.entries[key] = entry{
-		value:      value,
-		expiration: time.Now().Add(c.ttl),
+	expiration := time.Now().Add(ttl)
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*entry)
+		e.value = value
+		e.expiration = expiration
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry{key: key, value: value, expiration: expiration})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
 	}
 }
 
@@ -98,7 +145,9 @@ func (c *MemoryCache) Delete(key string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	delete(c.entries, key)
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
 }
 
 // Clear removes all entries from the cache.
@@ -106,7 +155,17 @@ func (c *MemoryCache) Clear() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	c.entries = make(map[string]entry)
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// Len returns the number of entries held, including expired entries not yet
+// removed.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
 }
 
 // cleanup removes expired entries from the cache.
@@ -115,9 +174,17 @@ func (c *MemoryCache) cleanup() {
 	defer c.mu.Unlock()
 
 	now := time.Now()
-	for key, e := range c.entries {
-		if now.After(e.expiration) {
-			delete(c.entries, key)
+	for el := c.order.Back(); el != nil; {
+		prev := el.Prev()
+		if now.After(el.Value.(*entry).expiration) {
+			c.remove(el)
 		}
+		el = prev
 	}
 }
+
+// remove unlinks el from the cache. The caller must hold c.mu.
+func (c *MemoryCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
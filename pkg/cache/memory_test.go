@@ -1,6 +1,8 @@
 package cache_test
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 	"testing/synctest"
 	"time"
@@ -35,6 +37,21 @@ func TestMemoryCache_Expiration(t *testing.T) {
 	})
 }
 
+func TestMemoryCache_SetWithTTL(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := cache.NewMemoryCache(1 * time.Hour)
+		t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+		c.SetWithTTL("short", "value1", 100*time.Millisecond)
+		c.Set("default", "value2")
+
+		time.Sleep(150 * time.Millisecond)
+
+		assert.Nil(t, c.Get("short"))
+		assert.Equal(t, "value2", c.Get("default"))
+	})
+}
+
 func TestMemoryCache_Delete(t *testing.T) {
 	synctest.Test(t, func(t *testing.T) {
 		c := cache.NewMemoryCache(1 * time.Hour)
@@ -115,3 +132,107 @@ func TestMemoryCache_Concurrent(t *testing.T) {
 		assert.NotNil(t, c.Get("key"))
 	})
 }
+
+func TestMemoryCache_ExpiredEntryEvictedOnGet(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := cache.NewMemoryCache(1 * time.Hour)
+		t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+		c.Set("key1", "value1")
+		// Cleanup ticks every ttl / 6 = 10m. The 60m tick still sees the
+		// entry as live; waking between it and the 70m tick leaves an
+		// expired entry only Get can remove.
+		time.Sleep(65 * time.Minute)
+		assert.Equal(t, 1, c.Len())
+
+		assert.Nil(t, c.Get("key1"))
+		assert.Equal(t, 0, c.Len())
+	})
+}
+
+func TestMemoryCache_MaxEntries(t *testing.T) {
+	t.Run("evicts the least recently set entry", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			c := cache.NewMemoryCache(1*time.Hour, cache.WithMaxEntries(2))
+			t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+			c.Set("key1", "value1")
+			c.Set("key2", "value2")
+			c.Set("key3", "value3")
+
+			assert.Equal(t, 2, c.Len())
+			assert.Nil(t, c.Get("key1"))
+			assert.Equal(t, "value2", c.Get("key2"))
+			assert.Equal(t, "value3", c.Get("key3"))
+		})
+	})
+
+	t.Run("a Get refreshes recency", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			c := cache.NewMemoryCache(1*time.Hour, cache.WithMaxEntries(2))
+			t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+			c.Set("key1", "value1")
+			c.Set("key2", "value2")
+			assert.Equal(t, "value1", c.Get("key1"))
+			c.Set("key3", "value3")
+
+			assert.Equal(t, "value1", c.Get("key1"))
+			assert.Nil(t, c.Get("key2"))
+			assert.Equal(t, "value3", c.Get("key3"))
+		})
+	})
+
+	t.Run("overwriting a key refreshes recency without growing", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			c := cache.NewMemoryCache(1*time.Hour, cache.WithMaxEntries(2))
+			t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+			c.Set("key1", "value1")
+			c.Set("key2", "value2")
+			c.Set("key1", "value1b")
+			assert.Equal(t, 2, c.Len())
+			c.Set("key3", "value3")
+
+			assert.Equal(t, "value1b", c.Get("key1"))
+			assert.Nil(t, c.Get("key2"))
+		})
+	})
+
+	t.Run("zero leaves the cache unbounded", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			c := cache.NewMemoryCache(1*time.Hour, cache.WithMaxEntries(0))
+			t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+			for i := range 100 {
+				c.Set(fmt.Sprintf("key%d", i), i)
+			}
+			assert.Equal(t, 100, c.Len())
+		})
+	})
+}
+
+func TestMemoryCache_ConcurrentBounded(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		const maxEntries = 8
+		c := cache.NewMemoryCache(1*time.Hour, cache.WithMaxEntries(maxEntries))
+		t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Go(func() {
+				for j := range 50 {
+					key := fmt.Sprintf("key%d", (i*50+j)%32)
+					c.Set(key, j)
+					_ = c.Get(key)
+					if j%10 == 0 {
+						c.Delete(key)
+					}
+				}
+			})
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, c.Len(), maxEntries)
+	})
+}
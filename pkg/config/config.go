@@ -36,6 +36,7 @@ package config
 import (
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -98,6 +99,26 @@ type ServerConfig struct {
 
 	// LastFM API Key
 	LastFMAPIKey string `envconfig:"LASTFM_API_KEY"`
+
+	// MusicBrainzRPS is the sustained request rate to the MusicBrainz API,
+	// which allows one request per second per IP.
+	MusicBrainzRPS float64 `envconfig:"MUSICBRAINZ_RPS" default:"1"`
+
+	// FollowResolveOfficialSite enables resolving an artist's official site
+	// from MusicBrainz (and Wikidata) in the background of every follow.
+	// High-traffic deployments can disable it to shed MusicBrainz load and
+	// leave site enrichment to the official-site-backfill job.
+	FollowResolveOfficialSite bool `envconfig:"FOLLOW_RESOLVE_OFFICIAL_SITE" default:"true"`
+
+	// ArtistSearchCacheTTL is how long artist search results are cached.
+	ArtistSearchCacheTTL time.Duration `envconfig:"ARTIST_SEARCH_CACHE_TTL" default:"1h"`
+
+	// ArtistSimilarCacheTTL is how long similar-artist lists are cached.
+	// Similarity data changes slowly, so it can outlive search results.
+	ArtistSimilarCacheTTL time.Duration `envconfig:"ARTIST_SIMILAR_CACHE_TTL" default:"24h"`
+
+	// ArtistTopCacheTTL is how long top-artist charts are cached.
+	ArtistTopCacheTTL time.Duration `envconfig:"ARTIST_TOP_CACHE_TTL" default:"6h"`
 }
 
 // JobConfig is the configuration for batch job workloads (e.g., concert-discovery CronJob).
@@ -112,6 +133,27 @@ type JobConfig struct {
 
 	// FanartTV API Key for artist image sync job
 	FanartTVAPIKey string `envconfig:"FANARTTV_API_KEY"`
+
+	// Number of artists the concert-discovery job searches concurrently.
+	// Zero runs the searches sequentially.
+	DiscoveryConcurrency int `envconfig:"DISCOVERY_CONCURRENCY" default:"3"`
+
+	// MusicBrainzRPS is the sustained request rate to the MusicBrainz API,
+	// which allows one request per second per IP.
+	MusicBrainzRPS float64 `envconfig:"MUSICBRAINZ_RPS" default:"1"`
+
+	// VenueBackfillPlacesRPS is the sustained rate of Google Places searches
+	// the venue-resolution-backfill and venue-enrichment jobs issue.
+	VenueBackfillPlacesRPS float64 `envconfig:"VENUE_BACKFILL_PLACES_RPS" default:"2"`
+
+	// VenueEnrichmentRetryBaseBackoff is how long the venue-enrichment job
+	// waits after a venue's first failed enrichment attempt before retrying
+	// it. The wait doubles with every further attempt.
+	VenueEnrichmentRetryBaseBackoff time.Duration `envconfig:"VENUE_ENRICHMENT_RETRY_BASE_BACKOFF" default:"1h"`
+
+	// VenueEnrichmentMaxAttempts is the number of failed enrichment attempts
+	// after which the venue-enrichment job stops retrying a venue.
+	VenueEnrichmentMaxAttempts int `envconfig:"VENUE_ENRICHMENT_MAX_ATTEMPTS" default:"6"`
 }
 
 // ConsumerConfig is the configuration for the event consumer workload.
@@ -143,6 +185,30 @@ type ConsumerConfig struct {
 
 	// FanartTV API Key for artist image resolution
 	FanartTVAPIKey string `envconfig:"FANARTTV_API_KEY"`
+
+	// MusicBrainzRPS is the sustained request rate to the MusicBrainz API,
+	// which allows one request per second per IP.
+	MusicBrainzRPS float64 `envconfig:"MUSICBRAINZ_RPS" default:"1"`
+
+	// CreateVenuelessConcertsOnPlaceSearchError creates a discovered concert
+	// as an event with only its listed venue name, pending venue resolution,
+	// when the venue place search fails, instead of failing (and redelivering)
+	// the whole discovered batch.
+	CreateVenuelessConcertsOnPlaceSearchError bool `envconfig:"CREATE_VENUELESS_CONCERTS_ON_PLACE_SEARCH_ERROR" default:"false"`
+
+	// ConsumerMaxRetries is how many times a failed handler invocation is
+	// retried before the message is moved to its dead-letter subject.
+	ConsumerMaxRetries int `envconfig:"CONSUMER_MAX_RETRIES" default:"3"`
+
+	// ConsumerDeadLetterSuffix is appended to a subject to form its
+	// dead-letter subject, DLQ.<subject><suffix>. It must not contain '.',
+	// so it extends the subject's last token.
+	ConsumerDeadLetterSuffix string `envconfig:"CONSUMER_DLQ_SUFFIX" default:"_dlq"`
+
+	// ConsumerDedupWindow is how long an idempotent handler remembers a
+	// processed message. The default matches the streams' 7-day MaxAge,
+	// beyond which JetStream no longer redelivers a message.
+	ConsumerDedupWindow time.Duration `envconfig:"CONSUMER_DEDUP_WINDOW" default:"168h"`
 }
 
 // ServerSettings represents HTTP server settings (port, host, timeouts, CORS).
@@ -230,6 +296,12 @@ type DatabaseConfig struct {
 	// after idle periods. Maps to pgxpool MinConns.
 	MaxIdleConns int `envconfig:"DATABASE_MAX_IDLE_CONNS" default:"2"`
 
+	// Number of connections opened during initialization, before the server
+	// accepts traffic, so the first requests after a deploy do not pay
+	// connection-establishment latency. Capped by MaxOpenConns; 0 disables
+	// the warmup.
+	WarmupConns int `envconfig:"DATABASE_WARMUP_CONNS" default:"4"`
+
 	// Maximum lifetime of a connection in seconds before it is closed and replaced.
 	// Set to 30 minutes (1800s) to ensure periodic recycling for server-side resource
 	// hygiene and graceful handling of Cloud SQL maintenance events.
@@ -248,6 +320,21 @@ type DatabaseConfig struct {
 	// network interruptions. Matches pgxpool default of 1 minute.
 	HealthCheckPeriod int `envconfig:"DATABASE_HEALTH_CHECK_PERIOD" default:"60"`
 
+	// Maximum time in seconds a single repository query may run before it is
+	// canceled, unless the caller's context already carries an earlier
+	// deadline. 0 disables the cap.
+	QueryTimeout int `envconfig:"DATABASE_QUERY_TIMEOUT" default:"30"`
+
+	// Duration in milliseconds at or above which a repository query is logged
+	// as a WARNING with its operation, table, and duration, to surface slow
+	// (e.g. unindexed) queries in production. 0 disables the log.
+	SlowQueryThresholdMs int `envconfig:"DATABASE_SLOW_QUERY_THRESHOLD_MS" default:"500"`
+
+	// Share of MaxOpenConns in use, in percent, at or above which the pool
+	// logs a WARNING, giving early warning of connection exhaustion before
+	// acquires start failing. 0 disables the warning.
+	PoolSaturationWarnPercent int `envconfig:"DATABASE_POOL_SATURATION_WARN_PERCENT" default:"80"`
+
 	// Instance Connection Name (e.g., project:region:instance)
 	// Required for Cloud SQL Connector (non-local environments)
 	InstanceConnectionName string `envconfig:"DATABASE_INSTANCE_CONNECTION_NAME"`
@@ -338,12 +425,60 @@ type GCPConfig struct {
 	// to defaultSearchCacheTTL via SearchCacheTTL(); prod sets 72h.
 	GeminiSearchCacheTTL time.Duration `envconfig:"GCP_GEMINI_SEARCH_CACHE_TTL"`
 
+	// Per-hype overrides of the search-log freshness window, keyed by the
+	// highest hype among an artist's followers (watch, home, nearby, away),
+	// e.g. "away:6h,nearby:12h". Lets artists fans care most about be
+	// re-searched sooner. Hype levels without an entry use SearchCacheTTL().
+	GeminiSearchCacheTTLByHype map[string]time.Duration `envconfig:"GCP_GEMINI_SEARCH_CACHE_TTL_BY_HYPE"`
+
 	// Skip window after a successful discovery. If a new concert was found
 	// for an artist within this window, the external search is skipped
 	// (announcements are batch-then-quiet, so re-searching just re-finds the
 	// same events). Empty/zero falls back to defaultSearchDiscoveryWindow.
 	GeminiSearchDiscoveryWindow time.Duration `envconfig:"GCP_GEMINI_SEARCH_DISCOVERY_WINDOW"`
 
+	// Per-artist deadline for a single concert search. Bounds each external
+	// search so one slow artist cannot starve the rest of a discovery run.
+	// Empty/zero disables the bound; the search then inherits the caller's
+	// deadline (HandlerTimeout for RPCs).
+	GeminiSearchTimeout time.Duration `envconfig:"GCP_GEMINI_SEARCH_TIMEOUT"`
+
+	// Minimum spacing between background concert searches drained from the
+	// onboarding search queue. Keeps a burst of first-follows from fanning
+	// out into parallel Gemini calls. Empty/zero falls back to
+	// defaultSearchQueueInterval.
+	GeminiSearchQueueInterval time.Duration `envconfig:"GCP_GEMINI_SEARCH_QUEUE_INTERVAL"`
+
+	// How long the search queue worker sleeps after finding the queue empty
+	// before polling again. Empty/zero falls back to
+	// defaultSearchQueuePollInterval.
+	GeminiSearchQueuePollInterval time.Duration `envconfig:"GCP_GEMINI_SEARCH_QUEUE_POLL_INTERVAL"`
+
+	// Number of attempts a background concert search task gets before it is
+	// moved to the dead-letter table. Zero falls back to
+	// defaultSearchQueueMaxAttempts.
+	GeminiSearchQueueMaxAttempts int `envconfig:"GCP_GEMINI_SEARCH_QUEUE_MAX_ATTEMPTS"`
+
+	// Maximum number of events one concert search may extract for an
+	// artist; a larger result is rejected as a likely hallucination.
+	// Zero falls back to the searcher's built-in default.
+	GeminiSearchMaxEventsPerArtist int `envconfig:"GCP_GEMINI_SEARCH_MAX_EVENTS_PER_ARTIST"`
+
+	// Adds a grounded Step 1 call per concert search that looks for the
+	// artist's festival appearances. Off by default for its extra cost.
+	GeminiSearchDiscoverFestivals bool `envconfig:"GCP_GEMINI_SEARCH_DISCOVER_FESTIVALS" default:"false"`
+
+	// Lifetime of the in-process Gemini response cache keyed on (artist,
+	// official site, search horizon). Lets onboarding users who follow the
+	// same artist minutes apart share one Gemini call. Empty/zero falls back
+	// to defaultSearchResponseCacheTTL.
+	GeminiSearchResponseCacheTTL time.Duration `envconfig:"GCP_GEMINI_SEARCH_RESPONSE_CACHE_TTL"`
+
+	// Number of most-followed artists the concert-prewarm job refreshes per
+	// run, so onboarding users following popular artists find their concerts
+	// already stored. Zero falls back to defaultSearchPrewarmArtistLimit.
+	GeminiSearchPrewarmArtistLimit int `envconfig:"GCP_GEMINI_SEARCH_PREWARM_ARTIST_LIMIT"`
+
 	// Model name for the merch-url discovery job's single-step grounded
 	// search. Empty falls back to defaultMerchModel (Flash-Lite): merch
 	// resolution is a single best-URL lookup, far cheaper than the two-step
@@ -395,6 +530,27 @@ const (
 	defaultSearchDiscoveryWindow = 14 * 24 * time.Hour
 )
 
+// Defaults for the background search queue worker. One search every 10s keeps
+// Gemini well under quota even when many users onboard at once; a 5s idle
+// poll bounds how long a freshly enqueued task waits.
+const (
+	defaultSearchQueueInterval     = 10 * time.Second
+	defaultSearchQueuePollInterval = 5 * time.Second
+	// defaultSearchQueueMaxAttempts gives a task five tries; with the
+	// doubling 1m backoff the last retry lands ~15 minutes after the first.
+	defaultSearchQueueMaxAttempts = 5
+)
+
+// defaultSearchResponseCacheTTL keeps Gemini responses for 15 minutes: long
+// enough to cover a burst of onboarding follows for the same artist, short
+// enough that a freshly announced concert is not hidden for long.
+const defaultSearchResponseCacheTTL = 15 * time.Minute
+
+// defaultSearchPrewarmArtistLimit covers the head of the follow distribution,
+// where most onboarding follows land, while keeping one prewarm run at about
+// ten minutes of throttled searches.
+const defaultSearchPrewarmArtistLimit = 50
+
 // Defaults for the merch-url discovery job. Flash-Lite is the cheapest model
 // that handles the single best-URL lookup well; the 60-day window matches when
 // tour merch is typically announced relative to the earliest event.
@@ -480,6 +636,55 @@ func (c *GCPConfig) SearchDiscoveryWindow() time.Duration {
 	return defaultSearchDiscoveryWindow
 }
 
+// SearchQueueInterval returns the minimum spacing between background searches.
+// Resolution: env override (GCP_GEMINI_SEARCH_QUEUE_INTERVAL) → built-in default.
+func (c *GCPConfig) SearchQueueInterval() time.Duration {
+	if c.GeminiSearchQueueInterval > 0 {
+		return c.GeminiSearchQueueInterval
+	}
+	return defaultSearchQueueInterval
+}
+
+// SearchQueuePollInterval returns the idle poll interval of the search queue
+// worker. Resolution: env override (GCP_GEMINI_SEARCH_QUEUE_POLL_INTERVAL) →
+// built-in default.
+func (c *GCPConfig) SearchQueuePollInterval() time.Duration {
+	if c.GeminiSearchQueuePollInterval > 0 {
+		return c.GeminiSearchQueuePollInterval
+	}
+	return defaultSearchQueuePollInterval
+}
+
+// SearchQueueMaxAttempts returns how many attempts a search task gets before
+// it is dead-lettered. Resolution: env override
+// (GCP_GEMINI_SEARCH_QUEUE_MAX_ATTEMPTS) → built-in default.
+func (c *GCPConfig) SearchQueueMaxAttempts() int {
+	if c.GeminiSearchQueueMaxAttempts > 0 {
+		return c.GeminiSearchQueueMaxAttempts
+	}
+	return defaultSearchQueueMaxAttempts
+}
+
+// SearchResponseCacheTTL returns the lifetime of cached Gemini search
+// responses. Resolution: env override (GCP_GEMINI_SEARCH_RESPONSE_CACHE_TTL) →
+// built-in default.
+func (c *GCPConfig) SearchResponseCacheTTL() time.Duration {
+	if c.GeminiSearchResponseCacheTTL > 0 {
+		return c.GeminiSearchResponseCacheTTL
+	}
+	return defaultSearchResponseCacheTTL
+}
+
+// SearchPrewarmArtistLimit returns how many of the most-followed artists the
+// prewarm job refreshes. Resolution: env override
+// (GCP_GEMINI_SEARCH_PREWARM_ARTIST_LIMIT) → built-in default.
+func (c *GCPConfig) SearchPrewarmArtistLimit() int {
+	if c.GeminiSearchPrewarmArtistLimit > 0 {
+		return c.GeminiSearchPrewarmArtistLimit
+	}
+	return defaultSearchPrewarmArtistLimit
+}
+
 // SearchModelExtract returns the model name for Step 1 (grounded extract:
 // GoogleSearch + URLContext, no schema). Resolution: step-specific env
 // override → built-in default.
@@ -525,6 +730,10 @@ type BlockchainConfig struct {
 	// TicketSBTAddress is the deployed TicketSBT contract address.
 	TicketSBTAddress string `envconfig:"TICKET_SBT_ADDRESS"`
 
+	// ConfirmTimeout bounds how long a contract write waits for its
+	// transaction receipt before giving up.
+	ConfirmTimeout time.Duration `envconfig:"BLOCKCHAIN_CONFIRM_TIMEOUT" default:"2m"`
+
 	// SafeProxyFactory is the canonical Safe{Wallet} ProxyFactory contract address.
 	// Default: Safe v1.4.1 canonical deployment on all EVM chains.
 	SafeProxyFactory string `envconfig:"SAFE_PROXY_FACTORY" default:"0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67"`
@@ -551,6 +760,17 @@ type ZKPConfig struct {
 	// VerificationKeyPath is the file path to the snarkjs verification_key.json.
 	// When empty, ZKP-based entry verification is disabled.
 	VerificationKeyPath string `envconfig:"ZKP_VERIFICATION_KEY_PATH"`
+
+	// StrictFieldValidation rejects entry proofs whose public signals are not
+	// canonical BN254 field elements (negative, or not less than the field
+	// modulus) before any lookup, instead of leaving them to fail proof
+	// verification.
+	StrictFieldValidation bool `envconfig:"ZKP_STRICT_FIELD_VALIDATION" default:"false"`
+
+	// CircuitVersion selects the public signal layout of the entry circuit
+	// whose proofs are verified (see entity.LookupZKPSignalLayout). It changes
+	// together with the verification key when the circuit is upgraded.
+	CircuitVersion string `envconfig:"ZKP_CIRCUIT_VERSION" default:"v1"`
 }
 
 // NATSConfig holds configuration for NATS JetStream event messaging.
@@ -656,9 +876,40 @@ func (c *GCPConfig) Validate() error {
 	if c.GeminiSearchCacheTTL < 0 {
 		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_CACHE_TTL: %s (must be >= 0)", c.GeminiSearchCacheTTL)
 	}
+	// validHypes must mirror entity.Hype.
+	validHypes := []string{"watch", "home", "nearby", "away"}
+	for hype, ttl := range c.GeminiSearchCacheTTLByHype {
+		if !slices.Contains(validHypes, hype) {
+			return fmt.Errorf("invalid GCP_GEMINI_SEARCH_CACHE_TTL_BY_HYPE: unknown hype %q (allowed: watch, home, nearby, away)", hype)
+		}
+		if ttl <= 0 {
+			return fmt.Errorf("invalid GCP_GEMINI_SEARCH_CACHE_TTL_BY_HYPE: %s for %q (must be > 0)", ttl, hype)
+		}
+	}
 	if c.GeminiSearchDiscoveryWindow < 0 {
 		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_DISCOVERY_WINDOW: %s (must be >= 0)", c.GeminiSearchDiscoveryWindow)
 	}
+	if c.GeminiSearchTimeout < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_TIMEOUT: %s (must be >= 0)", c.GeminiSearchTimeout)
+	}
+	if c.GeminiSearchQueueInterval < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_QUEUE_INTERVAL: %s (must be >= 0)", c.GeminiSearchQueueInterval)
+	}
+	if c.GeminiSearchQueuePollInterval < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_QUEUE_POLL_INTERVAL: %s (must be >= 0)", c.GeminiSearchQueuePollInterval)
+	}
+	if c.GeminiSearchQueueMaxAttempts < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_QUEUE_MAX_ATTEMPTS: %d (must be >= 0)", c.GeminiSearchQueueMaxAttempts)
+	}
+	if c.GeminiSearchMaxEventsPerArtist < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_MAX_EVENTS_PER_ARTIST: %d (must be >= 0)", c.GeminiSearchMaxEventsPerArtist)
+	}
+	if c.GeminiSearchResponseCacheTTL < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_RESPONSE_CACHE_TTL: %s (must be >= 0)", c.GeminiSearchResponseCacheTTL)
+	}
+	if c.GeminiSearchPrewarmArtistLimit < 0 {
+		return fmt.Errorf("invalid GCP_GEMINI_SEARCH_PREWARM_ARTIST_LIMIT: %d (must be >= 0)", c.GeminiSearchPrewarmArtistLimit)
+	}
 	if c.MerchDiscoveryWindow < 0 {
 		return fmt.Errorf("invalid GCP_MERCH_DISCOVERY_WINDOW: %s (must be >= 0)", c.MerchDiscoveryWindow)
 	}
@@ -667,6 +918,9 @@ func (c *GCPConfig) Validate() error {
 
 // Validate validates BaseConfig fields shared by all workloads:
 //   - Database port: 1-65535 range
+//   - Database query timeout and slow-query threshold: non-negative
+//   - Database pool saturation warning: 0-100 percent
+//   - Database warmup connections: non-negative
 //   - Environment: local, development, staging, or production
 //   - Log level: debug, info, warn, or error
 //   - Log format: json or text
@@ -675,6 +929,18 @@ func (c *BaseConfig) Validate() error {
 	if c.Database.Port <= 0 || c.Database.Port > 65535 {
 		return fmt.Errorf("invalid database port: %d", c.Database.Port)
 	}
+	if c.Database.QueryTimeout < 0 {
+		return fmt.Errorf("invalid database query timeout: %d", c.Database.QueryTimeout)
+	}
+	if c.Database.SlowQueryThresholdMs < 0 {
+		return fmt.Errorf("invalid database slow query threshold: %d", c.Database.SlowQueryThresholdMs)
+	}
+	if c.Database.WarmupConns < 0 {
+		return fmt.Errorf("invalid database warmup conns: %d", c.Database.WarmupConns)
+	}
+	if c.Database.PoolSaturationWarnPercent < 0 || c.Database.PoolSaturationWarnPercent > 100 {
+		return fmt.Errorf("invalid database pool saturation warn percent: %d", c.Database.PoolSaturationWarnPercent)
+	}
 
 	validEnvironments := []string{"local", "development", "staging", "production"}
 	valid := slices.Contains(validEnvironments, c.Environment)
@@ -710,6 +976,7 @@ func (c *BaseConfig) Validate() error {
 //   - NATS URL: required for non-local environments
 //   - JWT issuer: required
 //   - JWKS refresh interval: must be positive
+//   - Artist cache TTLs: must be positive
 func (c *ServerConfig) Validate() error {
 	if err := c.BaseConfig.Validate(); err != nil {
 		return err
@@ -751,6 +1018,20 @@ func (c *ServerConfig) Validate() error {
 		return fmt.Errorf("webhook pre-access-token audience is required")
 	}
 
+	if c.MusicBrainzRPS <= 0 {
+		return fmt.Errorf("invalid MUSICBRAINZ_RPS: %g (must be > 0)", c.MusicBrainzRPS)
+	}
+
+	if c.ArtistSearchCacheTTL <= 0 {
+		return fmt.Errorf("invalid ARTIST_SEARCH_CACHE_TTL: %s (must be > 0)", c.ArtistSearchCacheTTL)
+	}
+	if c.ArtistSimilarCacheTTL <= 0 {
+		return fmt.Errorf("invalid ARTIST_SIMILAR_CACHE_TTL: %s (must be > 0)", c.ArtistSimilarCacheTTL)
+	}
+	if c.ArtistTopCacheTTL <= 0 {
+		return fmt.Errorf("invalid ARTIST_TOP_CACHE_TTL: %s (must be > 0)", c.ArtistTopCacheTTL)
+	}
+
 	return nil
 }
 
@@ -761,6 +1042,18 @@ func (c *JobConfig) Validate() error {
 	if err := c.BaseConfig.Validate(); err != nil {
 		return err
 	}
+	if c.DiscoveryConcurrency < 0 {
+		return fmt.Errorf("invalid DISCOVERY_CONCURRENCY: %d (must be >= 0)", c.DiscoveryConcurrency)
+	}
+	if c.MusicBrainzRPS <= 0 {
+		return fmt.Errorf("invalid MUSICBRAINZ_RPS: %g (must be > 0)", c.MusicBrainzRPS)
+	}
+	if c.VenueEnrichmentRetryBaseBackoff < 0 {
+		return fmt.Errorf("invalid VENUE_ENRICHMENT_RETRY_BASE_BACKOFF: %s (must be >= 0)", c.VenueEnrichmentRetryBaseBackoff)
+	}
+	if c.VenueEnrichmentMaxAttempts <= 0 {
+		return fmt.Errorf("invalid VENUE_ENRICHMENT_MAX_ATTEMPTS: %d (must be > 0)", c.VenueEnrichmentMaxAttempts)
+	}
 	return c.GCP.Validate()
 }
 
@@ -778,6 +1071,22 @@ func (c *ConsumerConfig) Validate() error {
 		return fmt.Errorf("NATS URL is required for non-local environments")
 	}
 
+	if c.MusicBrainzRPS <= 0 {
+		return fmt.Errorf("invalid MUSICBRAINZ_RPS: %g (must be > 0)", c.MusicBrainzRPS)
+	}
+
+	if c.ConsumerMaxRetries < 0 {
+		return fmt.Errorf("invalid CONSUMER_MAX_RETRIES: %d (must be >= 0)", c.ConsumerMaxRetries)
+	}
+
+	if c.ConsumerDeadLetterSuffix == "" || strings.ContainsAny(c.ConsumerDeadLetterSuffix, ".*> \t\n") {
+		return fmt.Errorf("invalid CONSUMER_DLQ_SUFFIX: %q (must be non-empty without '.', '*', '>' or whitespace)", c.ConsumerDeadLetterSuffix)
+	}
+
+	if c.ConsumerDedupWindow <= 0 {
+		return fmt.Errorf("invalid CONSUMER_DEDUP_WINDOW: %s (must be > 0)", c.ConsumerDedupWindow)
+	}
+
 	return nil
 }
 
@@ -39,17 +39,21 @@ func TestLoad_ServerConfig(t *testing.T) {
 					Environment:     "local",
 					ShutdownTimeout: 30 * time.Second,
 					Database: DatabaseConfig{
-						Host:              "localhost",
-						Port:              5432,
-						Name:              "defaultdb",
-						User:              "defaultuser",
-						SSLMode:           "disable",
-						Schema:            "app",
-						MaxOpenConns:      10,
-						MaxIdleConns:      2,
-						ConnMaxLifetime:   1800,
-						MaxConnIdleTime:   600,
-						HealthCheckPeriod: 60,
+						Host:                      "localhost",
+						Port:                      5432,
+						Name:                      "defaultdb",
+						User:                      "defaultuser",
+						SSLMode:                   "disable",
+						Schema:                    "app",
+						MaxOpenConns:              10,
+						MaxIdleConns:              2,
+						WarmupConns:               4,
+						ConnMaxLifetime:           1800,
+						MaxConnIdleTime:           600,
+						HealthCheckPeriod:         60,
+						QueryTimeout:              30,
+						SlowQueryThresholdMs:      500,
+						PoolSaturationWarnPercent: 80,
 					},
 					Logging: LoggingConfig{
 						Level:         "info",
@@ -99,11 +103,20 @@ func TestLoad_ServerConfig(t *testing.T) {
 					ChainID:          84532,
 					SafeProxyFactory: "0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67",
 					SafeInitCodeHash: "0x52bede2892dc6ee239117844c91b0bdd458c318980592ab4152f5ea44af17f34",
+					ConfirmTimeout:   2 * time.Minute,
+				},
+				ZKP: ZKPConfig{
+					CircuitVersion: "v1",
 				},
 				VAPID: VAPIDConfig{
 					Contact: "mailto:pepperoni9@gmail.com",
 				},
-				NATS: NATSConfig{},
+				NATS:                      NATSConfig{},
+				MusicBrainzRPS:            1,
+				FollowResolveOfficialSite: true,
+				ArtistSearchCacheTTL:      time.Hour,
+				ArtistSimilarCacheTTL:     24 * time.Hour,
+				ArtistTopCacheTTL:         6 * time.Hour,
 			},
 		},
 		{
@@ -128,23 +141,31 @@ func TestLoad_ServerConfig(t *testing.T) {
 				"GCP_VERTEX_AI_SEARCH_DATA_STORE": "custom-datastore",
 				"OIDC_ISSUER_URL":                 "https://custom-issuer.com",
 				"JWKS_REFRESH_INTERVAL":           "30m",
+				"FOLLOW_RESOLVE_OFFICIAL_SITE":    "false",
+				"ARTIST_SEARCH_CACHE_TTL":         "30m",
+				"ARTIST_SIMILAR_CACHE_TTL":        "48h",
+				"ARTIST_TOP_CACHE_TTL":            "2h",
 			},
 			want: &ServerConfig{
 				BaseConfig: BaseConfig{
 					Environment:     "production",
 					ShutdownTimeout: 15 * time.Second,
 					Database: DatabaseConfig{
-						Host:              "localhost",
-						Port:              5432,
-						Name:              "testdb",
-						User:              "testuser",
-						SSLMode:           "disable",
-						Schema:            "app",
-						MaxOpenConns:      10,
-						MaxIdleConns:      2,
-						ConnMaxLifetime:   1800,
-						MaxConnIdleTime:   600,
-						HealthCheckPeriod: 60,
+						Host:                      "localhost",
+						Port:                      5432,
+						Name:                      "testdb",
+						User:                      "testuser",
+						SSLMode:                   "disable",
+						Schema:                    "app",
+						MaxOpenConns:              10,
+						MaxIdleConns:              2,
+						WarmupConns:               4,
+						ConnMaxLifetime:           1800,
+						MaxConnIdleTime:           600,
+						HealthCheckPeriod:         60,
+						QueryTimeout:              30,
+						SlowQueryThresholdMs:      500,
+						PoolSaturationWarnPercent: 80,
 					},
 					Logging: LoggingConfig{
 						Level:         "debug",
@@ -194,11 +215,20 @@ func TestLoad_ServerConfig(t *testing.T) {
 					ChainID:          84532,
 					SafeProxyFactory: "0x4e1DCf7AD4e460CfD30791CCC4F9c8a4f820ec67",
 					SafeInitCodeHash: "0x52bede2892dc6ee239117844c91b0bdd458c318980592ab4152f5ea44af17f34",
+					ConfirmTimeout:   2 * time.Minute,
+				},
+				ZKP: ZKPConfig{
+					CircuitVersion: "v1",
 				},
 				VAPID: VAPIDConfig{
 					Contact: "mailto:pepperoni9@gmail.com",
 				},
-				NATS: NATSConfig{},
+				NATS:                      NATSConfig{},
+				MusicBrainzRPS:            1,
+				FollowResolveOfficialSite: false,
+				ArtistSearchCacheTTL:      30 * time.Minute,
+				ArtistSimilarCacheTTL:     48 * time.Hour,
+				ArtistTopCacheTTL:         2 * time.Hour,
 			},
 		},
 	}
@@ -269,6 +299,10 @@ func TestServerConfig_Validate(t *testing.T) {
 					Issuer:              "https://test-issuer.com",
 					JWKSRefreshInterval: 15 * time.Minute,
 				},
+				MusicBrainzRPS:        1,
+				ArtistSearchCacheTTL:  time.Hour,
+				ArtistSimilarCacheTTL: 24 * time.Hour,
+				ArtistTopCacheTTL:     6 * time.Hour,
 			},
 			wantErr: false,
 		},
@@ -336,9 +370,51 @@ func TestServerConfig_Validate(t *testing.T) {
 					Issuer:              "https://test-issuer.com",
 					JWKSRefreshInterval: 15 * time.Minute,
 				},
+				MusicBrainzRPS:        1,
+				ArtistSearchCacheTTL:  time.Hour,
+				ArtistSimilarCacheTTL: 24 * time.Hour,
+				ArtistTopCacheTTL:     6 * time.Hour,
 			},
 			wantErr: false,
 		},
+		{
+			name: "rejects non-positive MusicBrainz rate",
+			config: &ServerConfig{
+				BaseConfig: BaseConfig{
+					Environment: "local",
+					Database:    DatabaseConfig{Port: 5432},
+					Logging:     LoggingConfig{Level: "info", Format: "json"},
+				},
+				Server:  ServerSettings{Port: 8080},
+				Webhook: validWebhookSettings(),
+				JWT: JWTConfig{
+					Issuer:              "https://test-issuer.com",
+					JWKSRefreshInterval: 15 * time.Minute,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects non-positive artist cache TTL",
+			config: &ServerConfig{
+				BaseConfig: BaseConfig{
+					Environment: "local",
+					Database:    DatabaseConfig{Port: 5432},
+					Logging:     LoggingConfig{Level: "info", Format: "json"},
+				},
+				Server:  ServerSettings{Port: 8080},
+				Webhook: validWebhookSettings(),
+				JWT: JWTConfig{
+					Issuer:              "https://test-issuer.com",
+					JWKSRefreshInterval: 15 * time.Minute,
+				},
+				MusicBrainzRPS:        1,
+				ArtistSearchCacheTTL:  time.Hour,
+				ArtistSimilarCacheTTL: 0,
+				ArtistTopCacheTTL:     6 * time.Hour,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -361,6 +437,8 @@ func TestJobConfig_Validate(t *testing.T) {
 				Database:    DatabaseConfig{Port: 5432},
 				Logging:     LoggingConfig{Level: "info", Format: "json"},
 			},
+			MusicBrainzRPS:             1,
+			VenueEnrichmentMaxAttempts: 6,
 		}
 		assert.NoError(t, cfg.Validate())
 	})
@@ -375,9 +453,80 @@ func TestJobConfig_Validate(t *testing.T) {
 				},
 				Logging: LoggingConfig{Level: "info", Format: "json"},
 			},
+			MusicBrainzRPS:             1,
+			VenueEnrichmentMaxAttempts: 6,
 		}
 		assert.NoError(t, cfg.Validate())
 	})
+
+	t.Run("rejects negative discovery concurrency", func(t *testing.T) {
+		cfg := &JobConfig{
+			BaseConfig: BaseConfig{
+				Environment: "local",
+				Database:    DatabaseConfig{Port: 5432},
+				Logging:     LoggingConfig{Level: "info", Format: "json"},
+			},
+			DiscoveryConcurrency: -1,
+			MusicBrainzRPS:       1,
+		}
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "DISCOVERY_CONCURRENCY")
+	})
+	t.Run("rejects non-positive MusicBrainz rate", func(t *testing.T) {
+		cfg := &JobConfig{
+			BaseConfig: BaseConfig{
+				Environment: "local",
+				Database:    DatabaseConfig{Port: 5432},
+				Logging:     LoggingConfig{Level: "info", Format: "json"},
+			},
+		}
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MUSICBRAINZ_RPS")
+	})
+	t.Run("rejects non-positive venue enrichment max attempts", func(t *testing.T) {
+		cfg := &JobConfig{
+			BaseConfig: BaseConfig{
+				Environment: "local",
+				Database:    DatabaseConfig{Port: 5432},
+				Logging:     LoggingConfig{Level: "info", Format: "json"},
+			},
+			MusicBrainzRPS: 1,
+		}
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "VENUE_ENRICHMENT_MAX_ATTEMPTS")
+	})
+}
+
+func TestBaseConfig_Validate_QueryGuard(t *testing.T) {
+	base := func(db DatabaseConfig) *BaseConfig {
+		db.Port = 5432
+		return &BaseConfig{
+			Environment: "local",
+			Database:    db,
+			Logging:     LoggingConfig{Level: "info", Format: "json"},
+		}
+	}
+
+	t.Run("accepts zero to disable", func(t *testing.T) {
+		assert.NoError(t, base(DatabaseConfig{}).Validate())
+	})
+	t.Run("rejects negative query timeout", func(t *testing.T) {
+		assert.Error(t, base(DatabaseConfig{QueryTimeout: -1}).Validate())
+	})
+	t.Run("rejects negative slow query threshold", func(t *testing.T) {
+		assert.Error(t, base(DatabaseConfig{SlowQueryThresholdMs: -1}).Validate())
+	})
+	t.Run("rejects negative warmup conns", func(t *testing.T) {
+		assert.Error(t, base(DatabaseConfig{WarmupConns: -1}).Validate())
+	})
+	t.Run("rejects pool saturation warn percent outside 0-100", func(t *testing.T) {
+		assert.Error(t, base(DatabaseConfig{PoolSaturationWarnPercent: -1}).Validate())
+		assert.Error(t, base(DatabaseConfig{PoolSaturationWarnPercent: 101}).Validate())
+		assert.NoError(t, base(DatabaseConfig{PoolSaturationWarnPercent: 100}).Validate())
+	})
 }
 
 func TestConsumerConfig_Validate(t *testing.T) {
@@ -388,10 +537,56 @@ func TestConsumerConfig_Validate(t *testing.T) {
 				Database:    DatabaseConfig{Port: 5432},
 				Logging:     LoggingConfig{Level: "info", Format: "json"},
 			},
+			MusicBrainzRPS:           1,
+			ConsumerDeadLetterSuffix: "_dlq",
+			ConsumerDedupWindow:      7 * 24 * time.Hour,
 		}
 		assert.NoError(t, cfg.Validate())
 	})
 
+	t.Run("rejects non-positive dedup window", func(t *testing.T) {
+		cfg := &ConsumerConfig{
+			BaseConfig: BaseConfig{
+				Environment: "local",
+				Database:    DatabaseConfig{Port: 5432},
+				Logging:     LoggingConfig{Level: "info", Format: "json"},
+			},
+			MusicBrainzRPS:           1,
+			ConsumerDeadLetterSuffix: "_dlq",
+		}
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CONSUMER_DEDUP_WINDOW")
+	})
+
+	t.Run("rejects dead-letter suffix outside the subject's stream", func(t *testing.T) {
+		cfg := &ConsumerConfig{
+			BaseConfig: BaseConfig{
+				Environment: "local",
+				Database:    DatabaseConfig{Port: 5432},
+				Logging:     LoggingConfig{Level: "info", Format: "json"},
+			},
+			MusicBrainzRPS:           1,
+			ConsumerDeadLetterSuffix: ".dlq",
+		}
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CONSUMER_DLQ_SUFFIX")
+	})
+
+	t.Run("rejects non-positive MusicBrainz rate", func(t *testing.T) {
+		cfg := &ConsumerConfig{
+			BaseConfig: BaseConfig{
+				Environment: "local",
+				Database:    DatabaseConfig{Port: 5432},
+				Logging:     LoggingConfig{Level: "info", Format: "json"},
+			},
+		}
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MUSICBRAINZ_RPS")
+	})
+
 	t.Run("missing NATS URL in development", func(t *testing.T) {
 		cfg := &ConsumerConfig{
 			BaseConfig: BaseConfig{
@@ -465,6 +660,47 @@ func TestGCPConfig_SearchDiscoveryWindowResolution(t *testing.T) {
 	})
 }
 
+func TestGCPConfig_SearchQueueMaxAttemptsResolution(t *testing.T) {
+	t.Run("env override takes precedence", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchQueueMaxAttempts: 8}
+		assert.Equal(t, 8, c.SearchQueueMaxAttempts())
+	})
+	t.Run("default applied when unset", func(t *testing.T) {
+		c := GCPConfig{}
+		assert.Equal(t, defaultSearchQueueMaxAttempts, c.SearchQueueMaxAttempts())
+	})
+	t.Run("negative rejected by Validate", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchQueueMaxAttempts: -1}
+		assert.Error(t, c.Validate())
+	})
+}
+
+func TestGCPConfig_Validate_SearchMaxEventsPerArtist(t *testing.T) {
+	t.Run("zero keeps the searcher default", func(t *testing.T) {
+		assert.NoError(t, (&GCPConfig{}).Validate())
+	})
+	t.Run("negative rejected", func(t *testing.T) {
+		err := (&GCPConfig{GeminiSearchMaxEventsPerArtist: -1}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GCP_GEMINI_SEARCH_MAX_EVENTS_PER_ARTIST")
+	})
+}
+
+func TestGCPConfig_SearchPrewarmArtistLimitResolution(t *testing.T) {
+	t.Run("env override takes precedence", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchPrewarmArtistLimit: 20}
+		assert.Equal(t, 20, c.SearchPrewarmArtistLimit())
+	})
+	t.Run("default applied when unset", func(t *testing.T) {
+		c := GCPConfig{}
+		assert.Equal(t, defaultSearchPrewarmArtistLimit, c.SearchPrewarmArtistLimit())
+	})
+	t.Run("negative rejected by Validate", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchPrewarmArtistLimit: -1}
+		assert.Error(t, c.Validate())
+	})
+}
+
 func TestGCPConfig_Validate_SearchDurations(t *testing.T) {
 	t.Run("accepts zero (falls back to default)", func(t *testing.T) {
 		c := GCPConfig{}
@@ -486,6 +722,28 @@ func TestGCPConfig_Validate_SearchDurations(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "GCP_GEMINI_SEARCH_DISCOVERY_WINDOW")
 	})
+	t.Run("rejects negative search timeout", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchTimeout: -1 * time.Second}
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GCP_GEMINI_SEARCH_TIMEOUT")
+	})
+	t.Run("accepts per-hype TTLs", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchCacheTTLByHype: map[string]time.Duration{"away": 6 * time.Hour, "nearby": 12 * time.Hour}}
+		assert.NoError(t, c.Validate())
+	})
+	t.Run("rejects per-hype TTL for unknown hype", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchCacheTTLByHype: map[string]time.Duration{"superfan": time.Hour}}
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GCP_GEMINI_SEARCH_CACHE_TTL_BY_HYPE")
+	})
+	t.Run("rejects non-positive per-hype TTL", func(t *testing.T) {
+		c := GCPConfig{GeminiSearchCacheTTLByHype: map[string]time.Duration{"away": 0}}
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GCP_GEMINI_SEARCH_CACHE_TTL_BY_HYPE")
+	})
 }
 
 func TestGCPConfig_Validate_ThinkingLevel(t *testing.T) {
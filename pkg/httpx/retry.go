@@ -2,6 +2,7 @@
 package httpx
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"time"
@@ -17,84 +18,75 @@ var retryableStatusCodes = map[int]bool{
 	http.StatusGatewayTimeout:     true, // 504
 }
 
-// RetryTransport wraps an http.RoundTripper with automatic retry logic
-// using exponential backoff for transient HTTP errors (429, 503, 504).
-// It respects Retry-After headers and replays request bodies via GetBody.
-type RetryTransport struct {
-	base            http.RoundTripper
+// Retrier runs an HTTP exchange again, with exponential backoff, while it
+// yields a transient status (429, 503, 504). A Retry-After header on the
+// transient response replaces the backoff wait. Retrying stops when the
+// attempt cap is reached or the context is done, including mid-wait.
+//
+// RetryTransport applies a Retrier to every request. Clients that must retry
+// around something other than the round trip itself, such as a rate limiter
+// slot, call Do directly.
+type Retrier struct {
 	maxTries        uint
 	initialInterval time.Duration
 	maxInterval     time.Duration
 }
 
-// Option configures a RetryTransport.
-type Option func(*RetryTransport)
+// Option configures a Retrier or RetryTransport.
+type Option func(*Retrier)
 
 // WithMaxRetries sets the maximum number of total attempts (including the first).
 // Default is 4 (1 initial + 3 retries).
 func WithMaxRetries(n uint) Option {
-	return func(rt *RetryTransport) {
-		rt.maxTries = n
+	return func(r *Retrier) {
+		r.maxTries = n
 	}
 }
 
 // WithInitialInterval sets the initial backoff interval before randomization.
 // Default is 1 second.
 func WithInitialInterval(d time.Duration) Option {
-	return func(rt *RetryTransport) {
-		rt.initialInterval = d
+	return func(r *Retrier) {
+		r.initialInterval = d
 	}
 }
 
 // WithMaxInterval caps the exponential backoff interval.
 // Default is 10 seconds.
 func WithMaxInterval(d time.Duration) Option {
-	return func(rt *RetryTransport) {
-		rt.maxInterval = d
+	return func(r *Retrier) {
+		r.maxInterval = d
 	}
 }
 
-// NewRetryTransport creates a RetryTransport that wraps base with exponential
-// backoff retry on transient HTTP status codes (429, 503, 504).
-//
-// If base is nil, http.DefaultTransport is used.
-func NewRetryTransport(base http.RoundTripper, opts ...Option) *RetryTransport {
-	if base == nil {
-		base = http.DefaultTransport
-	}
-	rt := &RetryTransport{
-		base:            base,
+// NewRetrier creates a Retrier with the default policy adjusted by opts.
+func NewRetrier(opts ...Option) *Retrier {
+	r := &Retrier{
 		maxTries:        4,
 		initialInterval: 1 * time.Second,
 		maxInterval:     10 * time.Second,
 	}
 	for _, o := range opts {
-		o(rt)
+		o(r)
 	}
-	return rt
+	return r
 }
 
-// RoundTrip executes the HTTP request with automatic retry on transient errors.
-// For retries involving POST/PUT requests, the body is replayed via req.GetBody.
-func (rt *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+// Do calls send until it returns a response with a non-transient status, and
+// returns that response. A transport error from send is returned as is,
+// without retrying. When every attempt yields a transient status, or ctx is
+// done first, Do returns a nil response and the last error. The body of each
+// discarded transient response is closed.
+func (r *Retrier) Do(ctx context.Context, send func() (*http.Response, error)) (*http.Response, error) {
 	bo := &backoff.ExponentialBackOff{
-		InitialInterval:     rt.initialInterval,
+		InitialInterval:     r.initialInterval,
 		RandomizationFactor: 0.5,
 		Multiplier:          2.0,
-		MaxInterval:         rt.maxInterval,
+		MaxInterval:         r.maxInterval,
 	}
 
-	resp, err := backoff.Retry(req.Context(), func() (*http.Response, error) {
-		// Replay the request body for retries (required for POST/PUT).
-		if req.GetBody != nil {
-			body, err := req.GetBody()
-			if err != nil {
-				return nil, backoff.Permanent(err)
-			}
-			req.Body = body
-		}
-
-		resp, err := rt.base.RoundTrip(req)
+	return backoff.Retry(ctx, func() (*http.Response, error) {
+		resp, err := send()
 		if err != nil {
 			return nil, backoff.Permanent(err)
 		}
@@ -107,11 +99,48 @@ func (rt *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, RetryAfterFromResponse(resp)
 	},
 		backoff.WithBackOff(bo),
-		backoff.WithMaxTries(rt.maxTries),
+		backoff.WithMaxTries(r.maxTries),
 		backoff.WithMaxElapsedTime(0), // No total time limit; controlled by maxTries and context.
 	)
+}
+
+// RetryTransport wraps an http.RoundTripper with automatic retry logic
+// using exponential backoff for transient HTTP errors (429, 503, 504).
+// It respects Retry-After headers and replays request bodies via GetBody.
+type RetryTransport struct {
+	base    http.RoundTripper
+	retrier *Retrier
+}
+
+// NewRetryTransport creates a RetryTransport that wraps base with exponential
+// backoff retry on transient HTTP status codes (429, 503, 504).
+//
+// If base is nil, http.DefaultTransport is used.
+func NewRetryTransport(base http.RoundTripper, opts ...Option) *RetryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{
+		base:    base,
+		retrier: NewRetrier(opts...),
+	}
+}
+
+// RoundTrip executes the HTTP request with automatic retry on transient errors.
+// For retries involving POST/PUT requests, the body is replayed via req.GetBody.
+func (rt *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt.retrier.Do(req.Context(), func() (*http.Response, error) {
+		// Replay the request body for retries (required for POST/PUT).
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
 
-	return resp, err
+		return rt.base.RoundTrip(req)
+	})
 }
 
 // IsRetryableStatus reports whether the HTTP status code is transient and
@@ -3,6 +3,7 @@ package httpx_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -244,3 +245,99 @@ func TestRetryTransport_POSTBodyReplay(t *testing.T) {
 	assert.Equal(t, int32(2), calls.Load())
 	assert.Equal(t, `{"key":"value"}`, lastBody)
 }
+
+func TestRetryTransport_HonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// Backoff alone would retry within milliseconds.
+	client := &http.Client{
+		Transport: httpx.NewRetryTransport(nil, shortRetryOpts(3)...),
+	}
+
+	start := time.Now()
+	resp, err := client.Get(srv.URL)
+	elapsed := time.Since(start)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.GreaterOrEqual(t, elapsed, 900*time.Millisecond, "the retry must wait for Retry-After, not the backoff interval")
+}
+
+func TestRetryTransport_CancelDuringRetryAfterWait(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		// Cancel once the transient response is on its way, so the transport
+		// is inside the Retry-After wait when the context is done.
+		time.AfterFunc(50*time.Millisecond, cancel)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: httpx.NewRetryTransport(nil, shortRetryOpts(3)...),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	resp, err := client.Do(req)
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 5*time.Second, "cancellation must cut the 30s wait short")
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetrier_Do(t *testing.T) {
+	t.Parallel()
+
+	respond := func(status int) *http.Response {
+		return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil))}
+	}
+
+	t.Run("retries transient statuses until a final response", func(t *testing.T) {
+		t.Parallel()
+		statuses := []int{http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusNotFound}
+		var calls int
+		resp, err := httpx.NewRetrier(shortRetryOpts(4)...).Do(t.Context(), func() (*http.Response, error) {
+			status := statuses[calls]
+			calls++
+			return respond(status), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("does not retry a send error", func(t *testing.T) {
+		t.Parallel()
+		sendErr := errors.New("connection refused")
+		var calls int
+		resp, err := httpx.NewRetrier(shortRetryOpts(4)...).Do(t.Context(), func() (*http.Response, error) {
+			calls++
+			return nil, sendErr
+		})
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, sendErr)
+		assert.Equal(t, 1, calls)
+	})
+}
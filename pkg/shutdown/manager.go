@@ -27,7 +27,7 @@
 //	shutdown.Init(logger)
 //	shutdown.AddDrainPhase(cache)
 //	shutdown.AddFlushPhase(publisher)
-//	shutdown.AddExternalPhase(lastfmClient, musicbrainzClient)
+//	shutdown.AddExternalPhase(lastfmClient, fanarttvClient)
 //	shutdown.AddObservePhase(telemetryCloser)
 //	shutdown.AddDatastorePhase(db)
 //
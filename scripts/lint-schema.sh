@@ -47,9 +47,34 @@ check_timestamp() {
 }
 
 # ── Check 3: audit columns ────────────────────────────────────────────
+# ALLOWED_STATE_COLUMNS lists table.column pairs that share an audit-column
+# name but carry domain state the application reads (not bookkeeping).
+#   - events.deleted_at: admin soft-delete; hides the event from listings.
+ALLOWED_STATE_COLUMNS=("events.deleted_at")
+
 check_audit_columns() {
-  local hits
-  hits=$(grep -nE '\b(created_at|updated_at|deleted_at)\b' "$SCHEMA" || true)
+  local hits allowed
+  # Resolve each hit to table.column so the allowlist can match it: column
+  # definitions take the table from the enclosing CREATE TABLE, COMMENT ON
+  # COLUMN lines name it directly.
+  hits=$(awk '
+    match($0, /CREATE TABLE IF NOT EXISTS [a-zA-Z_]+/) {
+      table = substr($0, RSTART + 27, RLENGTH - 27)
+    }
+    /(^|[^a-z_])(created_at|updated_at|deleted_at)([^a-z_]|$)/ {
+      if (match($0, /COMMENT ON COLUMN [a-zA-Z_]+\.[a-zA-Z_]+/)) {
+        key = substr($0, RSTART + 18, RLENGTH - 18)
+      } else {
+        match($0, /(created_at|updated_at|deleted_at)/)
+        key = table "." substr($0, RSTART, RLENGTH)
+      }
+      print key "\t" NR ":" $0
+    }
+  ' "$SCHEMA")
+  for allowed in "${ALLOWED_STATE_COLUMNS[@]}"; do
+    hits=$(printf '%s\n' "$hits" | grep -vF "$(printf '%s\t' "$allowed")" || true)
+  done
+  hits=$(printf '%s\n' "$hits" | cut -f2- | sed '/^$/d')
   if [ -n "$hits" ]; then
     echo "FAIL: Prohibited audit columns detected:" >&2
     echo "$hits" >&2